@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 
 	"github.com/sembraniteam/setetes/internal/bootstrap"
+	"github.com/sembraniteam/setetes/internal/rbac"
+	"github.com/sembraniteam/setetes/internal/region"
 	"github.com/spf13/cobra"
 )
 
@@ -72,3 +74,150 @@ func Seed() *cobra.Command {
 
 	return c
 }
+
+func Import() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "import",
+		Short:   "Import reference datasets into the database",
+		Version: "0.0.1",
+	}
+
+	c.AddCommand(importRegions(), importPolicies())
+
+	return c
+}
+
+func Export() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "export",
+		Short:   "Export data from the database",
+		Version: "0.0.1",
+	}
+
+	c.AddCommand(exportPolicies())
+
+	return c
+}
+
+func importRegions() *cobra.Command {
+	var (
+		path      string
+		file      string
+		batchSize int
+	)
+	c := &cobra.Command{
+		Use:     "regions",
+		Short:   "Import BPS provinces, cities, districts and subdistricts",
+		Example: "setetes import regions --config ./path/to/config.yml --file ./path/to/regions.csv",
+		Version: "0.0.1",
+		Run: func(_ *cobra.Command, _ []string) {
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				fmt.Printf("failed to get absolute path of Setetes: %v\n", err)
+				os.Exit(1)
+			}
+
+			bts := bootstrap.New(absPath)
+			if err = bts.ImportRegions(file, batchSize); err != nil {
+				fmt.Printf("failed to import regions: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	c.Flags().
+		StringVar(&path, "config", "", "path to the Setetes config file. Must be '.yml' or '.yaml' file.")
+	c.Flags().
+		StringVar(&file, "file", "", "path to the BPS region dataset. Must be '.csv' or '.json' file.")
+	c.Flags().
+		IntVar(&batchSize, "batch-size", region.DefaultBatchSize, "number of rows written per transaction.")
+	for _, flag := range []string{"config", "file"} {
+		if err := c.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+
+	return c
+}
+
+func importPolicies() *cobra.Command {
+	var (
+		path      string
+		file      string
+		batchSize int
+	)
+	c := &cobra.Command{
+		Use:     "policies",
+		Short:   "Import casbin policies and role groupings",
+		Example: "setetes import policies --config ./path/to/config.yml --file ./path/to/policies.csv",
+		Version: "0.0.1",
+		Run: func(_ *cobra.Command, _ []string) {
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				fmt.Printf("failed to get absolute path of Setetes: %v\n", err)
+				os.Exit(1)
+			}
+
+			bts := bootstrap.New(absPath)
+			if err = bts.ImportPolicies(file, batchSize); err != nil {
+				fmt.Printf("failed to import policies: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	c.Flags().
+		StringVar(&path, "config", "", "path to the Setetes config file. Must be '.yml' or '.yaml' file.")
+	c.Flags().
+		StringVar(&file, "file", "", "path to the policy file, one 'p' or 'g' rule per line.")
+	c.Flags().
+		IntVar(&batchSize, "batch-size", rbac.DefaultBatchSize, "number of rules written per statement.")
+	for _, flag := range []string{"config", "file"} {
+		if err := c.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+
+	return c
+}
+
+func exportPolicies() *cobra.Command {
+	var (
+		path      string
+		file      string
+		batchSize int
+	)
+	c := &cobra.Command{
+		Use:     "policies",
+		Short:   "Export casbin policies and role groupings",
+		Example: "setetes export policies --config ./path/to/config.yml --file ./path/to/policies.csv",
+		Version: "0.0.1",
+		Run: func(_ *cobra.Command, _ []string) {
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				fmt.Printf("failed to get absolute path of Setetes: %v\n", err)
+				os.Exit(1)
+			}
+
+			bts := bootstrap.New(absPath)
+			if err = bts.ExportPolicies(file, batchSize); err != nil {
+				fmt.Printf("failed to export policies: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	c.Flags().
+		StringVar(&path, "config", "", "path to the Setetes config file. Must be '.yml' or '.yaml' file.")
+	c.Flags().
+		StringVar(&file, "file", "", "path of the policy file to write.")
+	c.Flags().
+		IntVar(&batchSize, "batch-size", rbac.DefaultBatchSize, "number of rules read per query.")
+	for _, flag := range []string{"config", "file"} {
+		if err := c.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+
+	return c
+}
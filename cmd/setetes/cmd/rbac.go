@@ -0,0 +1,251 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sembraniteam/setetes/internal/bootstrap"
+	"github.com/sembraniteam/setetes/internal/rbac"
+	"github.com/spf13/cobra"
+)
+
+const (
+	enforceArgs = 4
+	tabPadding  = 2
+)
+
+func RBAC() *cobra.Command {
+	var path string
+	c := &cobra.Command{
+		Use:     "rbac",
+		Short:   "Inspect policies and dry-run authorization decisions",
+		Version: "0.0.1",
+	}
+
+	c.PersistentFlags().
+		StringVar(&path, "config", "", "path to the Setetes config file. Must be '.yml' or '.yaml' file.")
+	if err := c.MarkPersistentFlagRequired("config"); err != nil {
+		panic(err)
+	}
+
+	c.AddCommand(
+		rbacPolicies(&path),
+		rbacGroupings(&path),
+		rbacUser(&path),
+		rbacEnforce(&path),
+	)
+
+	return c
+}
+
+func rbacPolicies(path *string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "policies",
+		Short:   "List every policy as role, domain, resource and action",
+		Example: "setetes rbac policies --config ./path/to/config.yml",
+		Version: "0.0.1",
+		Run: func(_ *cobra.Command, _ []string) {
+			policies, err := manager(*path).ListPolicies()
+			if err != nil {
+				fmt.Printf("failed to list policies: %v\n", err)
+				os.Exit(1)
+			}
+
+			printRules(
+				[]string{"ROLE", "DOMAIN", "RESOURCE", "ACTION"},
+				policies,
+			)
+		},
+	}
+}
+
+func rbacGroupings(path *string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "groupings",
+		Short:   "List every grouping as subject, role and domain",
+		Example: "setetes rbac groupings --config ./path/to/config.yml",
+		Version: "0.0.1",
+		Run: func(_ *cobra.Command, _ []string) {
+			groupings, err := manager(*path).ListGroupings()
+			if err != nil {
+				fmt.Printf("failed to list groupings: %v\n", err)
+				os.Exit(1)
+			}
+
+			printRules([]string{"SUBJECT", "ROLE", "DOMAIN"}, groupings)
+		},
+	}
+}
+
+func rbacUser(path *string) *cobra.Command {
+	var domain string
+	c := &cobra.Command{
+		Use:     "user <account-id>",
+		Short:   "Show the roles and effective permissions of an account per domain",
+		Example: "setetes rbac user 0b7d3f4e-1f0a-4c1e-9a57-4d3e8f6c2b10 --config ./path/to/config.yml",
+		Version: "0.0.1",
+		Args:    cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			rm := manager(*path)
+			subject := args[0]
+
+			domains := []string{domain}
+			if domain == "" {
+				var err error
+				if domains, err = rm.Domains(subject); err != nil {
+					fmt.Printf("failed to get domains: %v\n", err)
+					os.Exit(1)
+				}
+			}
+
+			if len(domains) == 0 {
+				fmt.Printf("%s holds no role\n", subject)
+				return
+			}
+
+			for _, d := range domains {
+				roles, err := rm.Roles(subject, d)
+				if err != nil {
+					fmt.Printf("failed to get roles in %s: %v\n", d, err)
+					os.Exit(1)
+				}
+
+				perms, err := rm.Permissions(subject, d)
+				if err != nil {
+					fmt.Printf("failed to get permissions in %s: %v\n", d, err)
+					os.Exit(1)
+				}
+
+				fmt.Printf(
+					"domain: %s\nroles: %s\n",
+					d,
+					strings.Join(roles, ", "),
+				)
+				printRules(
+					[]string{"ROLE", "DOMAIN", "RESOURCE", "ACTION"},
+					perms,
+				)
+				fmt.Println()
+			}
+		},
+	}
+
+	c.Flags().
+		StringVar(&domain, "domain", "", "domain to inspect. Defaults to every domain the account holds a role in.")
+
+	return c
+}
+
+func rbacEnforce(path *string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "enforce <sub> <dom> <obj> <act>",
+		Short:   "Dry-run an authorization decision and explain which policy matched",
+		Example: "setetes rbac enforce 0b7d3f4e-1f0a-4c1e-9a57-4d3e8f6c2b10 region:3171 /stock/v1/locations/5c2e9a1d-7b4f-4e8a-9c3d-2f1a6b8e0d47 GET --config ./path/to/config.yml",
+		Version: "0.0.1",
+		Args:    cobra.ExactArgs(enforceArgs),
+		Run: func(cmd *cobra.Command, args []string) {
+			rm := manager(*path)
+			trace, err := rm.Explain(
+				cmd.Context(),
+				args[0],
+				args[1],
+				args[2],
+				args[3],
+			)
+			if err != nil {
+				fmt.Printf("failed to enforce: %v\n", err)
+				os.Exit(1)
+			}
+
+			printTrace(trace)
+		},
+	}
+}
+
+// manager connects to the configured database or exits.
+func manager(path string) *rbac.Manager {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		fmt.Printf("failed to get absolute path of Setetes: %v\n", err)
+		os.Exit(1)
+	}
+
+	rm, err := bootstrap.New(absPath).RBAC()
+	if err != nil {
+		fmt.Printf("failed to initialize: %v\n", err)
+		os.Exit(1)
+	}
+
+	return rm
+}
+
+func printRules(header []string, rules [][]string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, tabPadding, ' ', 0)
+	_, _ = fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, rule := range rules {
+		_, _ = fmt.Fprintln(w, strings.Join(rule, "\t"))
+	}
+
+	_ = w.Flush()
+}
+
+func printTrace(trace rbac.Trace) {
+	decision := "DENY"
+	if trace.Allowed {
+		decision = "ALLOW"
+	}
+
+	fmt.Printf("decision: %s\n", decision)
+	if len(trace.Matched) > 0 {
+		fmt.Printf("matched: %s\n", strings.Join(trace.Matched, ", "))
+	}
+
+	if len(trace.Roles) == 0 {
+		fmt.Println("roles: none in this domain")
+		return
+	}
+
+	fmt.Printf("roles: %s\n", strings.Join(trace.Roles, ", "))
+	if len(trace.Steps) == 0 {
+		fmt.Println("no policy of these roles covers the resource")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, tabPadding, ' ', 0)
+	_, _ = fmt.Fprintln(
+		w,
+		"ROLE\tDOMAIN\tRESOURCE\tACTION\tCONDITIONS\tOUTCOME",
+	)
+	for _, step := range trace.Steps {
+		outcome := step.Outcome
+		if step.Error != nil {
+			outcome += ": " + step.Error.Error()
+		}
+
+		conditions := make([]string, 0, len(step.Conditions))
+		for _, name := range step.Conditions {
+			if name == "" {
+				name = "none"
+			}
+
+			conditions = append(conditions, name)
+		}
+
+		if len(conditions) == 0 {
+			conditions = append(conditions, "-")
+		}
+
+		_, _ = fmt.Fprintf(
+			w,
+			"%s\t%s\t%s\n",
+			strings.Join(step.Policy, "\t"),
+			strings.Join(conditions, ", "),
+			outcome,
+		)
+	}
+
+	_ = w.Flush()
+}
@@ -21,6 +21,12 @@ func main() {
 	println(string(data))
 
 	c.CompletionOptions.DisableDefaultCmd = true
-	c.AddCommand(cmd.Start(), cmd.Seed())
+	c.AddCommand(
+		cmd.Start(),
+		cmd.Seed(),
+		cmd.Import(),
+		cmd.Export(),
+		cmd.RBAC(),
+	)
 	cobra.CheckErr(c.Execute())
 }
@@ -5,17 +5,20 @@ go 1.25.5
 require (
 	aidanwoods.dev/go-paseto v1.6.0
 	entgo.io/ent v0.14.5
+	github.com/alicebob/miniredis/v2 v2.39.0
 	github.com/casbin/casbin/v3 v3.9.0
 	github.com/fsnotify/fsnotify v1.9.0
 	github.com/gin-contrib/gzip v1.2.5
 	github.com/gin-contrib/timeout v1.1.0
 	github.com/gin-gonic/gin v1.11.0
+	github.com/go-pdf/fpdf v0.9.0
 	github.com/go-playground/locales v0.14.1
 	github.com/go-playground/universal-translator v0.18.1
 	github.com/go-playground/validator/v10 v10.30.1
 	github.com/gobwas/glob v0.2.3
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.8.0
+	github.com/mattn/go-sqlite3 v1.14.28
 	github.com/pkg/errors v0.9.1
 	github.com/redis/go-redis/v9 v9.17.2
 	github.com/samber/do/v2 v2.0.0
@@ -23,6 +26,7 @@ require (
 	github.com/spf13/viper v1.21.0
 	github.com/twpayne/go-geom v1.6.1
 	golang.org/x/crypto v0.46.0
+	rsc.io/qr v0.2.0
 )
 
 require (
@@ -69,6 +73,7 @@ require (
 	github.com/subosito/gotenv v1.6.0 // indirect
 	github.com/twitchyliquid64/golang-asm v0.15.1 // indirect
 	github.com/ugorji/go/codec v1.3.1 // indirect
+	github.com/yuin/gopher-lua v1.1.1 // indirect
 	github.com/zclconf/go-cty v1.17.0 // indirect
 	github.com/zclconf/go-cty-yaml v1.2.0 // indirect
 	go.yaml.in/yaml/v3 v3.0.4 // indirect
@@ -0,0 +1,254 @@
+package abac
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/certificate"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+	"github.com/sembraniteam/setetes/internal/rbac"
+)
+
+// Names of the conditions a permission may carry. Every condition reads the
+// target entity from the id path parameter.
+const (
+	OwnAccount       = "own-account"
+	OwnCertificate   = "own-certificate"
+	OwnEvent         = "own-event"
+	HospitalRequest  = "hospital-request"
+	LocationInRegion = "location-in-region"
+	UnitInRegion     = "unit-in-region"
+	RequestInRegion  = "request-in-region"
+	EventInRegion    = "event-in-region"
+
+	idParam = "id"
+)
+
+type resolver struct {
+	client *ent.Client
+}
+
+// Conditions returns the conditions permissions may refer to, resolving
+// entity attributes with the client.
+func Conditions(client *ent.Client) map[string]rbac.Condition {
+	r := resolver{client: client}
+
+	return map[string]rbac.Condition{
+		OwnAccount:       r.ownAccount,
+		OwnCertificate:   r.ownCertificate,
+		OwnEvent:         r.ownEvent,
+		HospitalRequest:  r.hospitalRequest,
+		LocationInRegion: r.locationInRegion,
+		UnitInRegion:     r.unitInRegion,
+		RequestInRegion:  r.requestInRegion,
+		EventInRegion:    r.eventInRegion,
+	}
+}
+
+// Region returns the BPS code prefix the domain is scoped to. A domain that
+// covers every region returns an empty prefix, and ok is false for domains
+// that are not region domains.
+func Region(domain string) (string, bool) {
+	if domain == rbac.AnyDomain {
+		return "", true
+	}
+
+	code, ok := strings.CutPrefix(domain, rbac.RegionPrefix)
+	if !ok || code == "" {
+		return "", false
+	}
+
+	if code == rbac.AnyDomain {
+		return "", true
+	}
+
+	return code, true
+}
+
+// ownAccount holds when the target account is the subject.
+func (r resolver) ownAccount(
+	_ context.Context,
+	req rbac.Request,
+) (bool, error) {
+	return req.Params[idParam] != "" && req.Params[idParam] == req.Subject, nil
+}
+
+// ownCertificate holds when the target certificate was awarded to the
+// subject.
+func (r resolver) ownCertificate(
+	ctx context.Context,
+	req rbac.Request,
+) (bool, error) {
+	id, subject, ok := ids(req)
+	if !ok {
+		return false, nil
+	}
+
+	return r.client.Certificate.Query().
+		Where(
+			certificate.IDEQ(id),
+			certificate.HasAccountWith(account.IDEQ(subject)),
+		).
+		Exist(ctx)
+}
+
+// ownEvent holds when the target donation event is organized by the subject.
+func (r resolver) ownEvent(
+	ctx context.Context,
+	req rbac.Request,
+) (bool, error) {
+	id, subject, ok := ids(req)
+	if !ok {
+		return false, nil
+	}
+
+	return r.client.DonationEvent.Query().
+		Where(
+			donationevent.IDEQ(id),
+			donationevent.HasOrganizerWith(account.IDEQ(subject)),
+		).
+		Exist(ctx)
+}
+
+// hospitalRequest holds when the target blood request was submitted by the
+// hospital of the subject.
+func (r resolver) hospitalRequest(
+	ctx context.Context,
+	req rbac.Request,
+) (bool, error) {
+	id, subject, ok := ids(req)
+	if !ok {
+		return false, nil
+	}
+
+	return r.client.BloodRequest.Query().
+		Where(
+			bloodrequest.IDEQ(id),
+			bloodrequest.HasHospitalWith(
+				hospital.HasMembersWith(account.IDEQ(subject)),
+			),
+		).
+		Exist(ctx)
+}
+
+// locationInRegion holds when the target PMI location lies in the region of
+// the request domain.
+func (r resolver) locationInRegion(
+	ctx context.Context,
+	req rbac.Request,
+) (bool, error) {
+	return r.inRegion(
+		req,
+		func(id uuid.UUID, in predicate.PMILocation) (bool, error) {
+			return r.client.PMILocation.Query().
+				Where(pmilocation.IDEQ(id), in).
+				Exist(ctx)
+		},
+	)
+}
+
+// unitInRegion holds when the target blood unit is kept at a PMI location in
+// the region of the request domain.
+func (r resolver) unitInRegion(
+	ctx context.Context,
+	req rbac.Request,
+) (bool, error) {
+	return r.inRegion(
+		req,
+		func(id uuid.UUID, in predicate.PMILocation) (bool, error) {
+			return r.client.BloodUnit.Query().
+				Where(bloodunit.IDEQ(id), bloodunit.HasPmiLocationWith(in)).
+				Exist(ctx)
+		},
+	)
+}
+
+// requestInRegion holds when the target blood request was sent to a PMI
+// location in the region of the request domain.
+func (r resolver) requestInRegion(
+	ctx context.Context,
+	req rbac.Request,
+) (bool, error) {
+	return r.inRegion(
+		req,
+		func(id uuid.UUID, in predicate.PMILocation) (bool, error) {
+			return r.client.BloodRequest.Query().
+				Where(bloodrequest.IDEQ(id), bloodrequest.HasPmiLocationWith(in)).
+				Exist(ctx)
+		},
+	)
+}
+
+// eventInRegion holds when the target donation event is served by a PMI
+// location in the region of the request domain.
+func (r resolver) eventInRegion(
+	ctx context.Context,
+	req rbac.Request,
+) (bool, error) {
+	return r.inRegion(
+		req,
+		func(id uuid.UUID, in predicate.PMILocation) (bool, error) {
+			return r.client.DonationEvent.Query().
+				Where(
+					donationevent.IDEQ(id),
+					donationevent.HasPmiLocationWith(in),
+				).
+				Exist(ctx)
+		},
+	)
+}
+
+// inRegion runs the query with a predicate matching the PMI locations in the
+// region of the request domain. A domain covering every region needs no
+// query.
+func (r resolver) inRegion(
+	req rbac.Request,
+	query func(id uuid.UUID, in predicate.PMILocation) (bool, error),
+) (bool, error) {
+	id, ok := target(req.Params)
+	if !ok {
+		return false, nil
+	}
+
+	code, ok := Region(req.Domain)
+	if !ok {
+		return false, nil
+	}
+
+	if code == "" {
+		return true, nil
+	}
+
+	return query(id, pmilocation.HasSubdistrictWith(
+		subdistrict.BpsCodeHasPrefix(code),
+	))
+}
+
+// target parses the id path parameter.
+func target(params map[string]string) (uuid.UUID, bool) {
+	return param(params, idParam)
+}
+
+// param parses the named parameter as a UUID.
+func param(params map[string]string, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(params[name])
+
+	return id, err == nil
+}
+
+// ids parses the id path parameter and the subject of the request.
+func ids(req rbac.Request) (uuid.UUID, uuid.UUID, bool) {
+	id, ok := target(req.Params)
+	subject, err := uuid.Parse(req.Subject)
+
+	return id, subject, ok && err == nil
+}
@@ -0,0 +1,169 @@
+package abac
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/rbac"
+)
+
+// Parameters naming the target of routes that have no id path parameter.
+const (
+	locationField = "pmi_location_id"
+	barcodeParam  = "barcode"
+)
+
+// Domains returns the resolvers of the routes whose target resource belongs
+// to a PMI location, so a request is evaluated in the region of that
+// location. Routes creating a resource at a PMI location resolve the
+// location from the pmi_location_id field of their body.
+func Domains(client *ent.Client) map[string]rbac.DomainResolver {
+	r := resolver{client: client}
+
+	return map[string]rbac.DomainResolver{
+		"/donation/v1/donations":             r.bodyLocationDomain,
+		"/stock/v1/movements":                r.bodyLocationDomain,
+		"/stock/v1/locations/:id":            r.locationDomain,
+		"/stock/v1/locations/:id/movements":  r.locationDomain,
+		"/request/v1/locations/:id":          r.locationDomain,
+		"/event/v1/locations/:id":            r.locationDomain,
+		"/unit/v1/units":                     r.bodyLocationDomain,
+		"/unit/v1/units/:id":                 r.unitDomain,
+		"/unit/v1/units/:id/status":          r.unitDomain,
+		"/unit/v1/barcodes/:barcode":         r.barcodeDomain,
+		"/request/v1/requests/:id":           r.requestDomain,
+		"/request/v1/requests/:id/accept":    r.requestDomain,
+		"/request/v1/requests/:id/reject":    r.requestDomain,
+		"/request/v1/requests/:id/fulfil":    r.requestDomain,
+		"/emergency/v1/campaigns":            r.bodyLocationDomain,
+		"/emergency/v1/campaigns/:id":        r.campaignDomain,
+		"/emergency/v1/campaigns/:id/cancel": r.campaignDomain,
+		"/emergency/v1/campaigns/:id/waves":  r.campaignDomain,
+		"/event/v1/reviews/:id/approve":      r.eventDomain,
+		"/event/v1/reviews/:id/reject":       r.eventDomain,
+	}
+}
+
+func (r resolver) bodyLocationDomain(
+	ctx context.Context,
+	params map[string]string,
+) (string, error) {
+	id, ok := param(params, locationField)
+	if !ok {
+		return "", nil
+	}
+
+	return r.region(
+		ctx,
+		r.client.PMILocation.Query().Where(pmilocation.IDEQ(id)),
+	)
+}
+
+func (r resolver) barcodeDomain(
+	ctx context.Context,
+	params map[string]string,
+) (string, error) {
+	barcode := params[barcodeParam]
+	if barcode == "" {
+		return "", nil
+	}
+
+	return r.region(
+		ctx,
+		r.client.BloodUnit.Query().
+			Where(bloodunit.BarcodeEQ(barcode)).
+			QueryPmiLocation(),
+	)
+}
+
+func (r resolver) locationDomain(
+	ctx context.Context,
+	params map[string]string,
+) (string, error) {
+	return r.domain(ctx, params, func(id uuid.UUID) *ent.PMILocationQuery {
+		return r.client.PMILocation.Query().Where(pmilocation.IDEQ(id))
+	})
+}
+
+func (r resolver) unitDomain(
+	ctx context.Context,
+	params map[string]string,
+) (string, error) {
+	return r.domain(ctx, params, func(id uuid.UUID) *ent.PMILocationQuery {
+		return r.client.BloodUnit.Query().
+			Where(bloodunit.IDEQ(id)).
+			QueryPmiLocation()
+	})
+}
+
+func (r resolver) requestDomain(
+	ctx context.Context,
+	params map[string]string,
+) (string, error) {
+	return r.domain(ctx, params, func(id uuid.UUID) *ent.PMILocationQuery {
+		return r.client.BloodRequest.Query().
+			Where(bloodrequest.IDEQ(id)).
+			QueryPmiLocation()
+	})
+}
+
+func (r resolver) campaignDomain(
+	ctx context.Context,
+	params map[string]string,
+) (string, error) {
+	return r.domain(ctx, params, func(id uuid.UUID) *ent.PMILocationQuery {
+		return r.client.EmergencyCampaign.Query().
+			Where(emergencycampaign.IDEQ(id)).
+			QueryPmiLocation()
+	})
+}
+
+func (r resolver) eventDomain(
+	ctx context.Context,
+	params map[string]string,
+) (string, error) {
+	return r.domain(ctx, params, func(id uuid.UUID) *ent.PMILocationQuery {
+		return r.client.DonationEvent.Query().
+			Where(donationevent.IDEQ(id)).
+			QueryPmiLocation()
+	})
+}
+
+// domain returns the region domain of the PMI location found by the query
+// for the id path parameter. A missing resource has no domain.
+func (r resolver) domain(
+	ctx context.Context,
+	params map[string]string,
+	location func(id uuid.UUID) *ent.PMILocationQuery,
+) (string, error) {
+	id, ok := target(params)
+	if !ok {
+		return "", nil
+	}
+
+	return r.region(ctx, location(id))
+}
+
+// region returns the region domain of the subdistrict of the PMI location
+// found by the query, or an empty string when there is none.
+func (r resolver) region(
+	ctx context.Context,
+	location *ent.PMILocationQuery,
+) (string, error) {
+	sub, err := location.QuerySubdistrict().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return rbac.RegionDomain(sub.BpsCode), nil
+}
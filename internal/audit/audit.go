@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+)
+
+// Security-sensitive actions recorded explicitly. Data changes are recorded
+// by Hook with the name of the mutation operation.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+
+	ActionLogin        = "login"
+	ActionLoginFailed  = "login.failed"
+	ActionLoginLocked  = "login.locked"
+	ActionRoleAssign   = "role.assign"
+	ActionRoleUnassign = "role.unassign"
+
+	ActionImpersonationStart   = "impersonation.start"
+	ActionImpersonationRequest = "impersonation.request"
+)
+
+type (
+	// Actor is who a request is made by. It is carried by the context of
+	// the request, so every record written while serving it is attributed.
+	// SubjectID is the account the actor impersonates, if any.
+	Actor struct {
+		ID        *uuid.UUID
+		SubjectID *uuid.UUID
+		SessionID string
+		RequestID *uuid.UUID
+		IP        string
+	}
+
+	// Entry is a record to write. ActorID and SessionID override the actor
+	// of the context, such as the account logging in on a public route.
+	Entry struct {
+		Action    string
+		Entity    string
+		EntityID  string
+		ActorID   *uuid.UUID
+		SessionID string
+		Before    map[string]any
+		After     map[string]any
+	}
+
+	actorKey struct{}
+)
+
+// NewContext returns a copy of ctx carrying the actor.
+func NewContext(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// FromContext returns the actor carried by ctx.
+func FromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+
+	return actor, ok
+}
+
+// Record writes the entry with the actor of the context. Pass the client of
+// a transaction to keep the record with the change it describes.
+func Record(ctx context.Context, client *ent.Client, e Entry) error {
+	actor, _ := FromContext(ctx)
+	if e.ActorID != nil {
+		actor.ID = e.ActorID
+	}
+
+	if e.SessionID != "" {
+		actor.SessionID = e.SessionID
+	}
+
+	return client.AuditLog.Create().
+		SetNillableActorID(actor.ID).
+		SetNillableSubjectID(actor.SubjectID).
+		SetSessionID(actor.SessionID).
+		SetNillableRequestID(actor.RequestID).
+		SetAction(e.Action).
+		SetEntity(e.Entity).
+		SetEntityID(e.EntityID).
+		SetBefore(e.Before).
+		SetAfter(e.After).
+		SetIP(actor.IP).
+		Exec(ctx)
+}
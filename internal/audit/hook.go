@@ -0,0 +1,171 @@
+package audit
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/hook"
+)
+
+// redacted replaces the values of fields holding secrets, such as hashes and
+// tokens, so they never reach the log.
+const redacted = "[REDACTED]"
+
+var secretFields = []string{"hash", "password", "secret", "token"}
+
+// bookkeepingFields change without anyone acting on the data, such as the
+// last use of an API key, so an update of only these is not recorded.
+var bookkeepingFields = []string{"updated_at", "last_used_at"}
+
+type mutation interface {
+	ent.Mutation
+	ID() (uuid.UUID, bool)
+	IDs(ctx context.Context) ([]uuid.UUID, error)
+	Client() *ent.Client
+}
+
+// Register records every mutation of the client and makes the audit log
+// append-only by rejecting updates and deletes of its records.
+func Register(client *ent.Client) {
+	client.Use(Hook())
+	client.AuditLog.Use(
+		hook.Reject(
+			ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne,
+		),
+	)
+}
+
+// Hook records a create, update or delete of any entity with the values of
+// the changed fields. Updates of a single entity also record the values the
+// fields had before. The record is written with the client of the mutation,
+// so it is committed or rolled back with the change.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(
+			func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				am, ok := m.(mutation)
+				if !ok || m.Type() == ent.TypeAuditLog || bookkeeping(m) {
+					return next.Mutate(ctx, m)
+				}
+
+				before, ids, err := prepare(ctx, am)
+				if err != nil {
+					return nil, err
+				}
+
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+
+				if m.Op().Is(ent.OpCreate) {
+					id, _ := am.ID()
+					ids = []uuid.UUID{id}
+				}
+
+				after := changes(am)
+				for _, id := range ids {
+					if err = Record(ctx, am.Client(), Entry{
+						Action:   action(m.Op()),
+						Entity:   m.Type(),
+						EntityID: id.String(),
+						Before:   before,
+						After:    after,
+					}); err != nil {
+						return nil, err
+					}
+				}
+
+				return v, nil
+			},
+		)
+	}
+}
+
+// prepare reads what is lost once the mutation runs: the IDs of the entities
+// an update or delete matches and the old values of an update of one entity.
+func prepare(
+	ctx context.Context,
+	m mutation,
+) (map[string]any, []uuid.UUID, error) {
+	if m.Op().Is(ent.OpCreate) {
+		return nil, nil, nil
+	}
+
+	ids, err := m.IDs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !m.Op().Is(ent.OpUpdateOne) {
+		return nil, ids, nil
+	}
+
+	before := make(map[string]any)
+	for _, name := range append(m.Fields(), m.ClearedFields()...) {
+		old, err := m.OldField(ctx, name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		before[name] = redact(name, old)
+	}
+
+	return before, ids, nil
+}
+
+func changes(m mutation) map[string]any {
+	after := make(map[string]any)
+	for _, name := range m.Fields() {
+		v, _ := m.Field(name)
+		after[name] = redact(name, v)
+	}
+
+	for _, name := range m.ClearedFields() {
+		after[name] = nil
+	}
+
+	return after
+}
+
+func bookkeeping(m ent.Mutation) bool {
+	if !m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) ||
+		len(m.ClearedFields()) > 0 ||
+		len(m.AddedEdges()) > 0 ||
+		len(m.RemovedEdges()) > 0 ||
+		len(m.ClearedEdges()) > 0 {
+		return false
+	}
+
+	for _, name := range m.Fields() {
+		if !slices.Contains(bookkeepingFields, name) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func redact(name string, v any) any {
+	for _, s := range secretFields {
+		if strings.Contains(name, s) {
+			return redacted
+		}
+	}
+
+	return v
+}
+
+func action(op ent.Op) string {
+	switch {
+	case op.Is(ent.OpCreate):
+		return ActionCreate
+	case op.Is(ent.OpUpdate | ent.OpUpdateOne):
+		return ActionUpdate
+	default:
+		return ActionDelete
+	}
+}
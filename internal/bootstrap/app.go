@@ -1,27 +1,43 @@
 package bootstrap
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/gin-contrib/gzip"
 	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/abac"
+	"github.com/sembraniteam/setetes/internal/audit"
 	"github.com/sembraniteam/setetes/internal/config"
+	"github.com/sembraniteam/setetes/internal/credential"
 	"github.com/sembraniteam/setetes/internal/cryptox"
 	"github.com/sembraniteam/setetes/internal/cryptox/pasetox"
 	"github.com/sembraniteam/setetes/internal/database/postgresx"
+	"github.com/sembraniteam/setetes/internal/database/redisx"
 	"github.com/sembraniteam/setetes/internal/ent"
 	"github.com/sembraniteam/setetes/internal/httpx"
 	"github.com/sembraniteam/setetes/internal/httpx/handler"
 	"github.com/sembraniteam/setetes/internal/httpx/middleware"
+	"github.com/sembraniteam/setetes/internal/httpx/route"
 	"github.com/sembraniteam/setetes/internal/httpx/web"
+	"github.com/sembraniteam/setetes/internal/job"
+	"github.com/sembraniteam/setetes/internal/notify"
 	"github.com/sembraniteam/setetes/internal/rbac"
+	"github.com/sembraniteam/setetes/internal/region"
 	"github.com/sembraniteam/setetes/internal/seed"
 	"github.com/sembraniteam/setetes/internal/service"
 )
 
+const (
+	defaultExpiryInterval   = time.Minute * 15
+	defaultReminderInterval = time.Minute * 15
+)
+
 type (
 	App struct {
 		configPath string
@@ -30,6 +46,10 @@ type (
 	Bootstrap interface {
 		Init() error
 		Seeder() error
+		ImportRegions(file string, batchSize int) error
+		ImportPolicies(file string, batchSize int) error
+		ExportPolicies(file string, batchSize int) error
+		RBAC() (*rbac.Manager, error)
 	}
 )
 
@@ -54,7 +74,10 @@ func (a App) Init() error {
 		injector,
 		func(_ do.Injector) (*ent.Client, error) {
 			return pcl, nil
-		})
+		},
+	)
+
+	audit.Register(pcl)
 
 	rateLimiter := middleware.DefaultTokenBucket()
 	defer rateLimiter.Stop()
@@ -64,6 +87,15 @@ func (a App) Init() error {
 		return err
 	}
 
+	rm.RegisterConditions(abac.Conditions(pcl))
+	rm.RegisterDomainResolvers(abac.Domains(pcl))
+
+	unwatch, err := watch(rm)
+	if err != nil {
+		return err
+	}
+	defer unwatch()
+
 	do.Provide[*rbac.Manager](
 		injector,
 		func(_ do.Injector) (*rbac.Manager, error) {
@@ -76,13 +108,56 @@ func (a App) Init() error {
 		return err
 	}
 
+	do.Provide[*cryptox.Keypair](
+		injector,
+		func(_ do.Injector) (*cryptox.Keypair, error) {
+			return keypair, nil
+		},
+	)
+
+	do.Provide[notify.Sender](
+		injector,
+		func(_ do.Injector) (notify.Sender, error) {
+			return sender(), nil
+		},
+	)
+
+	do.Provide[route.Registry](
+		injector,
+		func(_ do.Injector) (route.Registry, error) {
+			return web.Registry(), nil
+		},
+	)
+
+	rdx := redisx.New()
+	rdb, err := rdx.Connect()
+	if err != nil {
+		return err
+	}
+	defer rdx.Disconnect(rdb)
+
+	sig := config.Get().Signature
+	signatures := middleware.NewSignatureConfig(
+		rdb,
+		pcl,
+		sig.Secrets,
+		sig.MaxSkew,
+	)
+
+	do.Provide[*middleware.SignatureConfig](
+		injector,
+		func(_ do.Injector) (*middleware.SignatureConfig, error) {
+			return signatures, nil
+		},
+	)
+
 	verifier := pasetox.NewVerifier(keypair)
 
 	auth := middleware.NewAuthorizationConfig(
 		rm,
 		verifier,
 		web.PublicRoutes(),
-	)
+	).WithAPIKeys(credential.NewVerifier(pcl), signatures, web.Registry())
 
 	server := httpx.NewServer(
 		httpx.Injector(injector),
@@ -92,10 +167,31 @@ func (a App) Init() error {
 			middleware.RateLimitByIP(rateLimiter),
 			middleware.RequestID(),
 			auth.Authorization(),
+			middleware.Audit(pcl),
 		),
 		httpx.UseRouter(web.Routes),
 	)
 
+	jobs := job.NewRunner(
+		job.Job{
+			Name:     "expire-blood-units",
+			Interval: expiryInterval(),
+			Run:      do.MustInvoke[service.BloodUnit](injector).Expire,
+		},
+		job.Job{
+			Name:     "advance-emergency-campaigns",
+			Interval: time.Minute,
+			Run:      do.MustInvoke[service.Emergency](injector).Advance,
+		},
+		job.Job{
+			Name:     "send-donation-reminders",
+			Interval: reminderInterval(),
+			Run:      do.MustInvoke[service.Reminder](injector).Send,
+		},
+	)
+	jobs.Start()
+	defer jobs.Stop()
+
 	serverErrors := make(chan error, 1)
 	go func() {
 		serverErrors <- server.Run()
@@ -142,6 +238,12 @@ func (a App) Seeder() error {
 		return err
 	}
 
+	unwatch, err := watch(rbacMan)
+	if err != nil {
+		return err
+	}
+	defer unwatch()
+
 	do.Provide[*rbac.Manager](
 		injector,
 		func(_ do.Injector) (*rbac.Manager, error) {
@@ -154,3 +256,220 @@ func (a App) Seeder() error {
 
 	return nil
 }
+
+func (a App) ImportRegions(file string, batchSize int) error {
+	_, err := config.LoadConfig(a.configPath)
+	if err != nil {
+		return err
+	}
+
+	records, err := region.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	pdb := postgresx.New()
+	pcl, err := pdb.Connect()
+	if err != nil {
+		return err
+	}
+
+	importer := region.NewImporter(pcl, batchSize)
+	report, err := importer.Import(records)
+	for _, level := range region.Levels {
+		counts, ok := report[level]
+		if !ok {
+			continue
+		}
+
+		fmt.Printf(
+			"%-12s inserted: %d, updated: %d, skipped: %d\n",
+			level,
+			counts.Inserted,
+			counts.Updated,
+			counts.Skipped,
+		)
+	}
+
+	return err
+}
+
+// ImportPolicies adds the casbin rules of the file, as written by
+// ExportPolicies, to the stored ones.
+func (a App) ImportPolicies(file string, batchSize int) error {
+	rm, closeFn, err := a.policyManager()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	report, err := rm.Import(context.Background(), f, batchSize)
+	fmt.Printf(
+		"policies inserted: %d, skipped: %d\n",
+		report.Inserted,
+		report.Skipped,
+	)
+
+	return err
+}
+
+// ExportPolicies writes every casbin rule to the file.
+func (a App) ExportPolicies(file string, batchSize int) error {
+	rm, closeFn, err := a.policyManager()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	f, err := os.Create(filepath.Clean(file))
+	if err != nil {
+		return err
+	}
+
+	written, err := rm.Export(context.Background(), f, batchSize)
+	if err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	fmt.Printf("policies exported: %d\n", written)
+
+	return f.Close()
+}
+
+// RBAC connects a manager to the configured database for inspecting the
+// policies. It evaluates conditions and resolves domains like the server.
+func (a App) RBAC() (*rbac.Manager, error) {
+	_, err := config.LoadConfig(a.configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pdb := postgresx.New()
+	pcl, err := pdb.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	rm, err := rbac.New(pcl)
+	if err != nil {
+		return nil, err
+	}
+
+	rm.RegisterConditions(abac.Conditions(pcl))
+	rm.RegisterDomainResolvers(abac.Domains(pcl))
+
+	return rm, nil
+}
+
+// policyManager connects the manager used by the policy commands. The
+// returned function stops watching for changes.
+func (a App) policyManager() (*rbac.Manager, func(), error) {
+	_, err := config.LoadConfig(a.configPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pdb := postgresx.New()
+	pcl, err := pdb.Connect()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rm, err := rbac.New(pcl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unwatch, err := watch(rm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rm, unwatch, nil
+}
+
+func expiryInterval() time.Duration {
+	if d := config.Get().Inventory.ExpiryInterval; d > 0 {
+		return d
+	}
+
+	return defaultExpiryInterval
+}
+
+func reminderInterval() time.Duration {
+	if d := config.Get().Reminder.Interval; d > 0 {
+		return d
+	}
+
+	return defaultReminderInterval
+}
+
+// watch keeps the policies of the manager in sync with the other instances
+// through Redis. The returned function stops watching and disconnects.
+func watch(rm *rbac.Manager) (func(), error) {
+	rdx := redisx.New()
+	rdb, err := rdx.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := rbac.NewWatcher(rdb, redisx.PolicyChannel.String())
+	if err != nil {
+		rdx.Disconnect(rdb)
+
+		return nil, err
+	}
+
+	if err = rm.SetWatcher(w); err != nil {
+		w.Close()
+		rdx.Disconnect(rdb)
+
+		return nil, err
+	}
+
+	return func() {
+		w.Close()
+		rdx.Disconnect(rdb)
+	}, nil
+}
+
+// sender delivers each channel through its configured provider. Channels
+// without a provider are written to the log.
+func sender() notify.Sender {
+	cfg := config.Get().Notification
+	mux := notify.NewMux(notify.NewLogSender())
+
+	if cfg.Email.Host != "" {
+		mux.Handle(notify.ChannelEmail, notify.NewEmailSender(
+			cfg.Email.Host,
+			cfg.Email.Port,
+			cfg.Email.Username,
+			cfg.Email.Password,
+			cfg.Email.From,
+		))
+	}
+
+	if cfg.Push.URL != "" {
+		mux.Handle(
+			notify.ChannelPush,
+			notify.NewWebhookSender(cfg.Push.URL, cfg.Push.Token),
+		)
+	}
+
+	if cfg.SMS.URL != "" {
+		mux.Handle(
+			notify.ChannelSMS,
+			notify.NewWebhookSender(cfg.SMS.URL, cfg.SMS.Token),
+		)
+	}
+
+	return mux
+}
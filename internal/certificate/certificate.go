@@ -0,0 +1,48 @@
+package certificate
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/config"
+)
+
+const defaultVerifyURL = "https://setetes.sembraniteam.com/certificate/v1/verify"
+
+// Milestones are the donation counts PMI awards a certificate for.
+var Milestones = []int{10, 25, 50, 75, 100}
+
+// Reached returns the milestones a donor with count donations has reached.
+func Reached(count int) []int {
+	out := make([]int, 0, len(Milestones))
+	for _, m := range Milestones {
+		if count >= m {
+			out = append(out, m)
+		}
+	}
+
+	return out
+}
+
+// Next returns the next milestone after count donations, or 0 once every
+// milestone is reached.
+func Next(count int) int {
+	for _, m := range Milestones {
+		if count < m {
+			return m
+		}
+	}
+
+	return 0
+}
+
+// VerifyURL returns the public page confirming the certificate is authentic.
+// It is encoded in the QR code of the certificate.
+func VerifyURL(id uuid.UUID) string {
+	base := config.Get().Certificate.VerifyURL
+	if base == "" {
+		base = defaultVerifyURL
+	}
+
+	return strings.TrimSuffix(base, "/") + "/" + id.String()
+}
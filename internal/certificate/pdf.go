@@ -0,0 +1,112 @@
+package certificate
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"rsc.io/qr"
+)
+
+const (
+	pageWidth  = 297.0
+	pageHeight = 210.0
+	margin     = 12.0
+	qrSize     = 36.0
+	font       = "Helvetica"
+	dateLayout = "2 January 2006"
+)
+
+// Render draws the certificate as an A4 landscape PDF. The QR code links to
+// the verification URL of the certificate.
+//
+//nolint:mnd // font sizes, colors and positions of the page layout
+func Render(c *ent.Certificate, loc *time.Location) ([]byte, error) {
+	code, err := qr.Encode(VerifyURL(c.ID), qr.M)
+	if err != nil {
+		return nil, err
+	}
+
+	pdf := fpdf.New("L", "mm", "A4", "")
+	pdf.SetTitle("Setetes donation certificate", true)
+	pdf.SetAuthor("Palang Merah Indonesia", true)
+	pdf.SetMargins(margin, margin, margin)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AddPage()
+
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	awardedAt := time.UnixMilli(c.AwardedAt).In(loc)
+
+	pdf.SetDrawColor(190, 30, 45)
+	pdf.SetLineWidth(1.5)
+	pdf.Rect(margin, margin, pageWidth-margin*2, pageHeight-margin*2, "D")
+
+	pdf.SetY(38)
+	pdf.SetTextColor(190, 30, 45)
+	centered(pdf, "B", 30, 14, "Certificate of Appreciation")
+
+	pdf.SetTextColor(40, 40, 40)
+	centered(pdf, "", 14, 12, "This certificate is proudly presented to")
+	centered(pdf, "B", 26, 16, tr(c.HolderName))
+	centered(pdf, "", 14, 10, fmt.Sprintf(
+		"in recognition of %d voluntary blood donations",
+		c.Milestone,
+	))
+	centered(pdf, "", 14, 10, "Awarded on "+awardedAt.Format(dateLayout))
+
+	drawQR(pdf, code, pageWidth-margin*2-qrSize, pageHeight-margin*2-qrSize)
+
+	pdf.SetFont(font, "", 9)
+	pdf.SetTextColor(110, 110, 110)
+	pdf.SetXY(margin*2, pageHeight-margin*2-8)
+	pdf.Write(5, "Certificate ID: "+c.ID.String())
+	pdf.SetXY(margin*2, pageHeight-margin*2-3)
+	pdf.Write(5, "Scan the QR code to verify this certificate.")
+
+	var buf bytes.Buffer
+	if err = pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// centered writes one line of text centered between the page margins.
+func centered(pdf *fpdf.Fpdf, style string, size, height float64, text string) {
+	pdf.SetFont(font, style, size)
+	pdf.SetX(margin)
+	pdf.CellFormat(
+		pageWidth-margin*2,
+		height,
+		text,
+		"",
+		1,
+		"C",
+		false,
+		0,
+		"",
+	)
+}
+
+// drawQR draws every dark module of the code as a filled square so the QR
+// code stays sharp at any zoom level.
+func drawQR(pdf *fpdf.Fpdf, code *qr.Code, x, y float64) {
+	module := qrSize / float64(code.Size)
+
+	pdf.SetFillColor(0, 0, 0)
+	for row := range code.Size {
+		for col := range code.Size {
+			if code.Black(col, row) {
+				pdf.Rect(
+					x+float64(col)*module,
+					y+float64(row)*module,
+					module,
+					module,
+					"F",
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package compatibility
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+)
+
+// Type is an ABO group with its Rh factor. An empty Rhesus means the factor
+// is unknown and is handled as the least permissive value: positive for a
+// donor and negative for a recipient.
+type Type struct {
+	Group  bloodtype.Group
+	Rhesus bloodtype.Rhesus
+}
+
+var (
+	groups = []bloodtype.Group{
+		bloodtype.GroupBloodO,
+		bloodtype.GroupBloodA,
+		bloodtype.GroupBloodB,
+		bloodtype.GroupBloodAB,
+	}
+
+	// Types lists every known blood type, Rh negative first.
+	Types = []Type{
+		{Group: bloodtype.GroupBloodO, Rhesus: bloodtype.RhesusNegative},
+		{Group: bloodtype.GroupBloodA, Rhesus: bloodtype.RhesusNegative},
+		{Group: bloodtype.GroupBloodB, Rhesus: bloodtype.RhesusNegative},
+		{Group: bloodtype.GroupBloodAB, Rhesus: bloodtype.RhesusNegative},
+		{Group: bloodtype.GroupBloodO, Rhesus: bloodtype.RhesusPositive},
+		{Group: bloodtype.GroupBloodA, Rhesus: bloodtype.RhesusPositive},
+		{Group: bloodtype.GroupBloodB, Rhesus: bloodtype.RhesusPositive},
+		{Group: bloodtype.GroupBloodAB, Rhesus: bloodtype.RhesusPositive},
+	}
+)
+
+// Of returns the Type of a stored blood type.
+func Of(bt *ent.BloodType) Type {
+	return Type{Group: bt.Group, Rhesus: bt.Rhesus}
+}
+
+// Compatible reports whether blood of the donor type may be transfused to the
+// recipient type as the given component.
+//
+// Red cells carry the ABO antigens, so the recipient must not have
+// antibodies against the donor group. Plasma carries the antibodies, so the
+// rule is reversed. Whole blood carries both and must be ABO identical.
+// Platelet concentrate follows the plasma rule. Every cellular component
+// may carry RhD positive red cells, so an Rh negative recipient only gets Rh
+// negative cells. Plasma ignores the Rh factor.
+func Compatible(donor, recipient Type, c bloodstock.Component) bool {
+	switch c {
+	case bloodstock.ComponentPRC:
+		return redCells(donor.Group, recipient.Group) &&
+			rhesus(donor.Rhesus, recipient.Rhesus)
+	case bloodstock.ComponentFFP:
+		return plasma(donor.Group, recipient.Group)
+	case bloodstock.ComponentTC:
+		return plasma(donor.Group, recipient.Group) &&
+			rhesus(donor.Rhesus, recipient.Rhesus)
+	default:
+		return donor.Group == recipient.Group &&
+			rhesus(donor.Rhesus, recipient.Rhesus)
+	}
+}
+
+// Donors returns the donor types that can supply the recipient, ordered by
+// preference: the identical type first, then a matching group, then a
+// matching Rh factor. Ties go to the donor type that fits the fewest
+// recipients, so universal types such as O negative red cells are kept for
+// when nothing closer fits.
+func Donors(recipient Type, c bloodstock.Component) []Type {
+	out := make([]Type, 0, len(Types))
+	for _, donor := range Types {
+		if Compatible(donor, recipient, c) {
+			out = append(out, donor)
+		}
+	}
+
+	slices.SortStableFunc(out, func(a, b Type) int {
+		return cmp.Or(
+			cmp.Compare(distance(a, recipient), distance(b, recipient)),
+			cmp.Compare(len(Recipients(a, c)), len(Recipients(b, c))),
+		)
+	})
+
+	return out
+}
+
+// Recipients returns the recipient types that blood of the donor type can be
+// given to as the component.
+func Recipients(donor Type, c bloodstock.Component) []Type {
+	out := make([]Type, 0, len(Types))
+	for _, recipient := range Types {
+		if Compatible(donor, recipient, c) {
+			out = append(out, recipient)
+		}
+	}
+
+	return out
+}
+
+func redCells(donor, recipient bloodtype.Group) bool {
+	switch donor {
+	case bloodtype.GroupBloodO:
+		return slices.Contains(groups, recipient)
+	case bloodtype.GroupBloodA, bloodtype.GroupBloodB:
+		return recipient == donor || recipient == bloodtype.GroupBloodAB
+	default:
+		return recipient == bloodtype.GroupBloodAB
+	}
+}
+
+func plasma(donor, recipient bloodtype.Group) bool {
+	return redCells(recipient, donor)
+}
+
+func rhesus(donor, recipient bloodtype.Rhesus) bool {
+	return donor == bloodtype.RhesusNegative ||
+		recipient == bloodtype.RhesusPositive
+}
+
+func distance(donor, recipient Type) int {
+	d := 0
+	if donor.Group != recipient.Group {
+		d += 2
+	}
+
+	if donor.Rhesus != recipient.Rhesus {
+		d++
+	}
+
+	return d
+}
@@ -0,0 +1,211 @@
+package compatibility
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+)
+
+const (
+	o   = bloodtype.GroupBloodO
+	a   = bloodtype.GroupBloodA
+	b   = bloodtype.GroupBloodB
+	ab  = bloodtype.GroupBloodAB
+	neg = bloodtype.RhesusNegative
+	pos = bloodtype.RhesusPositive
+)
+
+var (
+	oNeg  = Type{Group: o, Rhesus: neg}
+	aNeg  = Type{Group: a, Rhesus: neg}
+	bNeg  = Type{Group: b, Rhesus: neg}
+	abNeg = Type{Group: ab, Rhesus: neg}
+	oPos  = Type{Group: o, Rhesus: pos}
+	aPos  = Type{Group: a, Rhesus: pos}
+	bPos  = Type{Group: b, Rhesus: pos}
+	abPos = Type{Group: ab, Rhesus: pos}
+
+	// order is the column order of the rows in compatibilityTable.
+	order = []Type{oNeg, aNeg, bNeg, abNeg, oPos, aPos, bPos, abPos}
+
+	components = []bloodstock.Component{
+		bloodstock.ComponentWholeBlood,
+		bloodstock.ComponentPRC,
+		bloodstock.ComponentFFP,
+		bloodstock.ComponentTC,
+	}
+
+	// compatibilityTable lists, per component and donor type, the recipient
+	// types in the order O-, A-, B-, AB-, O+, A+, B+, AB+, where X marks a
+	// compatible transfusion.
+	compatibilityTable = map[bloodstock.Component]map[Type]string{
+		bloodstock.ComponentWholeBlood: {
+			oNeg:  "X...X...",
+			aNeg:  ".X...X..",
+			bNeg:  "..X...X.",
+			abNeg: "...X...X",
+			oPos:  "....X...",
+			aPos:  ".....X..",
+			bPos:  "......X.",
+			abPos: ".......X",
+		},
+		bloodstock.ComponentPRC: {
+			oNeg:  "XXXXXXXX",
+			aNeg:  ".X.X.X.X",
+			bNeg:  "..XX..XX",
+			abNeg: "...X...X",
+			oPos:  "....XXXX",
+			aPos:  ".....X.X",
+			bPos:  "......XX",
+			abPos: ".......X",
+		},
+		bloodstock.ComponentFFP: {
+			oNeg:  "X...X...",
+			aNeg:  "XX..XX..",
+			bNeg:  "X.X.X.X.",
+			abNeg: "XXXXXXXX",
+			oPos:  "X...X...",
+			aPos:  "XX..XX..",
+			bPos:  "X.X.X.X.",
+			abPos: "XXXXXXXX",
+		},
+		bloodstock.ComponentTC: {
+			oNeg:  "X...X...",
+			aNeg:  "XX..XX..",
+			bNeg:  "X.X.X.X.",
+			abNeg: "XXXXXXXX",
+			oPos:  "....X...",
+			aPos:  "....XX..",
+			bPos:  "....X.X.",
+			abPos: "....XXXX",
+		},
+	}
+)
+
+func TestCompatible(t *testing.T) {
+	for _, c := range components {
+		rows := compatibilityTable[c]
+		for _, donor := range order {
+			row := rows[donor]
+			for i, recipient := range order {
+				want := row[i] == 'X'
+				if got := Compatible(donor, recipient, c); got != want {
+					t.Errorf(
+						"Compatible(%s, %s, %s) = %t, want %t",
+						name(donor), name(recipient), c, got, want,
+					)
+				}
+			}
+		}
+	}
+}
+
+func TestTypesCoverTable(t *testing.T) {
+	if len(Types) != len(order) {
+		t.Fatalf("len(Types) = %d, want %d", len(Types), len(order))
+	}
+
+	for _, typ := range order {
+		if !slices.Contains(Types, typ) {
+			t.Errorf("Types does not contain %s", name(typ))
+		}
+	}
+}
+
+func TestDonorsAndRecipientsAgreeWithCompatible(t *testing.T) {
+	for _, c := range components {
+		for _, donor := range order {
+			for _, recipient := range order {
+				want := Compatible(donor, recipient, c)
+				if got := slices.Contains(
+					Donors(recipient, c),
+					donor,
+				); got != want {
+					t.Errorf(
+						"Donors(%s, %s) contains %s = %t, want %t",
+						name(recipient), c, name(donor), got, want,
+					)
+				}
+
+				if got := slices.Contains(
+					Recipients(donor, c),
+					recipient,
+				); got != want {
+					t.Errorf(
+						"Recipients(%s, %s) contains %s = %t, want %t",
+						name(donor), c, name(recipient), got, want,
+					)
+				}
+			}
+		}
+	}
+}
+
+func TestDonorsPreferIdenticalType(t *testing.T) {
+	for _, c := range components {
+		for _, recipient := range order {
+			donors := Donors(recipient, c)
+			if len(donors) == 0 || donors[0] != recipient {
+				t.Errorf(
+					"Donors(%s, %s) = %v, want %s first",
+					name(recipient), c, names(donors), name(recipient),
+				)
+			}
+		}
+	}
+}
+
+func TestDonorsKeepUniversalTypeLast(t *testing.T) {
+	donors := Donors(abPos, bloodstock.ComponentPRC)
+	if got := donors[len(donors)-1]; got != oNeg {
+		t.Errorf(
+			"Donors(AB+, PRC) = %v, want O- last",
+			names(donors),
+		)
+	}
+}
+
+func TestCompatibleUnknownRhesus(t *testing.T) {
+	unknown := Type{Group: o}
+	tests := []struct {
+		donor, recipient Type
+		want             bool
+	}{
+		{donor: unknown, recipient: oPos, want: true},
+		{donor: unknown, recipient: oNeg, want: false},
+		{donor: oNeg, recipient: unknown, want: true},
+		{donor: oPos, recipient: unknown, want: false},
+	}
+
+	for _, tt := range tests {
+		got := Compatible(tt.donor, tt.recipient, bloodstock.ComponentPRC)
+		if got != tt.want {
+			t.Errorf(
+				"Compatible(%s, %s, PRC) = %t, want %t",
+				name(tt.donor), name(tt.recipient), got, tt.want,
+			)
+		}
+	}
+}
+
+func name(t Type) string {
+	switch t.Rhesus {
+	case pos:
+		return string(t.Group) + "+"
+	case neg:
+		return string(t.Group) + "-"
+	default:
+		return string(t.Group) + "?"
+	}
+}
+
+func names(types []Type) []string {
+	out := make([]string, 0, len(types))
+	for _, t := range types {
+		out = append(out, name(t))
+	}
+
+	return out
+}
@@ -69,6 +69,80 @@ type (
 			PrivateKeyPath string `mapstructure:"private_key_path"`
 			PublicKeyPath  string `mapstructure:"public_key_path"`
 		} `mapstructure:"ed25519"`
+
+		Eligibility struct {
+			MinAge    int `mapstructure:"min_age"`
+			MaxAge    int `mapstructure:"max_age"`
+			Intervals struct {
+				WholeBlood         int `mapstructure:"whole_blood"`
+				ApheresisPlatelets int `mapstructure:"apheresis_platelets"`
+				ApheresisPlasma    int `mapstructure:"apheresis_plasma"`
+			} `mapstructure:"intervals"`
+			MaxWholeBloodPerYear struct {
+				Male   int `mapstructure:"male"`
+				Female int `mapstructure:"female"`
+			} `mapstructure:"max_whole_blood_per_year"`
+		} `mapstructure:"eligibility"`
+
+		Inventory struct {
+			ShelfLife struct {
+				WholeBlood int `mapstructure:"whole_blood"`
+				PRC        int `mapstructure:"prc"`
+				TC         int `mapstructure:"tc"`
+				FFP        int `mapstructure:"ffp"`
+			} `mapstructure:"shelf_life"`
+			ExpiryInterval time.Duration `mapstructure:"expiry_interval"`
+		} `mapstructure:"inventory"`
+
+		Emergency struct {
+			WaveInterval time.Duration `mapstructure:"wave_interval"`
+		} `mapstructure:"emergency"`
+
+		Notification struct {
+			Email struct {
+				Host     string `mapstructure:"host"`
+				Port     int    `mapstructure:"port"`
+				Username string `mapstructure:"username"`
+				Password string `mapstructure:"password"`
+				From     string `mapstructure:"from"`
+			} `mapstructure:"email"`
+			Push struct {
+				URL   string `mapstructure:"url"`
+				Token string `mapstructure:"token"`
+			} `mapstructure:"push"`
+			SMS struct {
+				URL   string `mapstructure:"url"`
+				Token string `mapstructure:"token"`
+			} `mapstructure:"sms"`
+		} `mapstructure:"notification"`
+
+		Certificate struct {
+			VerifyURL string `mapstructure:"verify_url"`
+		} `mapstructure:"certificate"`
+
+		DonorCard struct {
+			TokenTTL time.Duration `mapstructure:"token_ttl"`
+		} `mapstructure:"donor_card"`
+
+		Impersonation struct {
+			TokenTTL time.Duration `mapstructure:"token_ttl"`
+		} `mapstructure:"impersonation"`
+
+		APIKey struct {
+			TTL           time.Duration `mapstructure:"ttl"`
+			RotationGrace time.Duration `mapstructure:"rotation_grace"`
+		} `mapstructure:"api_key"`
+
+		Signature struct {
+			MaxSkew time.Duration     `mapstructure:"max_skew"`
+			Secrets map[string]string `mapstructure:"secrets"`
+		} `mapstructure:"signature"`
+
+		Reminder struct {
+			DaysBefore int           `mapstructure:"days_before"`
+			Interval   time.Duration `mapstructure:"interval"`
+			Timezone   string        `mapstructure:"timezone"`
+		} `mapstructure:"reminder"`
 	}
 )
 
@@ -0,0 +1,126 @@
+package credential
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/sembraniteam/setetes/internal/cryptox"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/apikey"
+)
+
+const (
+	// keyScheme starts every API key, so a leaked key is recognizable, such
+	// as stk_<prefix>_<secret>.
+	keyScheme = "stk"
+	keyParts  = 3
+	prefixLen = 8
+
+	// lastUsedInterval bounds how often the last use of a key is written.
+	lastUsedInterval = time.Minute
+)
+
+var ErrInvalidAPIKey = errors.New("api key is invalid, expired or revoked")
+
+type (
+	// APIKey is a generated key. Token is shown to its owner once; only
+	// the hash of its secret is stored.
+	APIKey struct {
+		Token      string
+		Prefix     string
+		SecretHash string
+	}
+
+	Verifier struct {
+		client *ent.Client
+	}
+)
+
+// NewAPIKey generates a key with a random prefix and secret.
+func NewAPIKey() (APIKey, error) {
+	prefix, err := cryptox.RandChars(prefixLen)
+	if err != nil {
+		return APIKey{}, err
+	}
+
+	secret := strings.TrimRight(cryptox.RandToken(), "=")
+
+	return APIKey{
+		Token:      strings.Join([]string{keyScheme, prefix, secret}, "_"),
+		Prefix:     prefix,
+		SecretHash: cryptox.Sha256(secret),
+	}, nil
+}
+
+func NewVerifier(client *ent.Client) *Verifier {
+	return &Verifier{client: client}
+}
+
+// Verify returns the key the token belongs to with its account and hospital
+// when it is neither expired nor revoked, and its account is still an
+// active member of the hospital. It does not record the use of the key, see
+// Touch.
+func (v *Verifier) Verify(
+	ctx context.Context,
+	token string,
+) (*ent.APIKey, error) {
+	parts := strings.SplitN(token, "_", keyParts)
+	if len(parts) != keyParts || parts[0] != keyScheme {
+		return nil, ErrInvalidAPIKey
+	}
+
+	now := time.Now().UnixMilli()
+	key, err := v.client.APIKey.Query().
+		Where(
+			apikey.PrefixEQ(parts[1]),
+			apikey.RevokedAtIsNil(),
+			apikey.ExpiresAtGT(now),
+			apikey.DeletedAtIsNil(),
+			apikey.HasAccountWith(
+				account.ActivatedEQ(true),
+				account.LockedEQ(false),
+				account.DeletedAtIsNil(),
+			),
+		).
+		WithAccount(func(q *ent.AccountQuery) {
+			q.WithHospital()
+		}).
+		WithHospital().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidAPIKey
+		}
+
+		return nil, err
+	}
+
+	if !cryptox.VerifySha256(parts[2], key.SecretHash) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	member := key.Edges.Account.Edges.Hospital
+	if member == nil || member.ID != key.Edges.Hospital.ID ||
+		!key.Edges.Hospital.Activated {
+		return nil, ErrInvalidAPIKey
+	}
+
+	return key, nil
+}
+
+// Touch records the use of a key that authorized a request, at most once
+// per lastUsedInterval.
+func (v *Verifier) Touch(ctx context.Context, key *ent.APIKey) error {
+	now := time.Now().UnixMilli()
+	if key.LastUsedAt != nil &&
+		now-*key.LastUsedAt < lastUsedInterval.Milliseconds() {
+		return nil
+	}
+
+	return v.client.APIKey.UpdateOneID(key.ID).
+		SetLastUsedAt(now).
+		Exec(ctx)
+}
@@ -0,0 +1,384 @@
+package credential
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sembraniteam/setetes/internal/cryptox"
+	"github.com/sembraniteam/setetes/internal/database/sqlitex"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	key, err := NewAPIKey()
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+
+	parts := strings.SplitN(key.Token, "_", keyParts)
+	if len(parts) != keyParts || parts[0] != keyScheme {
+		t.Fatalf(
+			"token %q is not formatted as stk_<prefix>_<secret>",
+			key.Token,
+		)
+	}
+
+	if parts[1] != key.Prefix || len(key.Prefix) != prefixLen {
+		t.Errorf(
+			"prefix = %q in token %q, want %d characters",
+			key.Prefix, key.Token, prefixLen,
+		)
+	}
+
+	if key.SecretHash != cryptox.Sha256(parts[2]) {
+		t.Errorf("secret hash does not match the secret of the token")
+	}
+
+	if strings.Contains(key.Token, key.SecretHash) {
+		t.Errorf("token contains the secret hash")
+	}
+
+	other, err := NewAPIKey()
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+
+	if other.Prefix == key.Prefix || other.Token == key.Token {
+		t.Errorf("two generated keys share prefix %q", key.Prefix)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	hour := time.Hour.Milliseconds()
+
+	tests := []struct {
+		name  string
+		token func(token string) string
+		setup func(t *testing.T, f fixture)
+		valid bool
+	}{
+		{
+			name:  "valid key",
+			valid: true,
+		},
+		{
+			name: "empty token",
+			token: func(string) string {
+				return ""
+			},
+		},
+		{
+			name: "other scheme",
+			token: func(token string) string {
+				return "sk" + strings.TrimPrefix(token, keyScheme)
+			},
+		},
+		{
+			name: "missing secret",
+			token: func(token string) string {
+				return token[:strings.LastIndex(token, "_")]
+			},
+		},
+		{
+			name: "wrong secret",
+			token: func(token string) string {
+				return token + "x"
+			},
+		},
+		{
+			name: "unknown prefix",
+			token: func(token string) string {
+				parts := strings.SplitN(token, "_", keyParts)
+
+				return strings.Join(
+					[]string{keyScheme, "zzzzzzzz", parts[2]},
+					"_",
+				)
+			},
+		},
+		{
+			name: "revoked key",
+			setup: func(t *testing.T, f fixture) {
+				f.update(t, f.key.Update().
+					SetRevokedAt(time.Now().UnixMilli()).
+					Exec)
+			},
+		},
+		{
+			name: "expired key",
+			setup: func(t *testing.T, f fixture) {
+				f.update(t, f.key.Update().
+					SetExpiresAt(time.Now().UnixMilli()-hour).
+					Exec)
+			},
+		},
+		{
+			name: "deleted key",
+			setup: func(t *testing.T, f fixture) {
+				f.update(t, f.key.Update().
+					SetDeletedAt(time.Now().UnixMilli()).
+					Exec)
+			},
+		},
+		{
+			name: "locked account",
+			setup: func(t *testing.T, f fixture) {
+				f.update(t, f.account.Update().SetLocked(true).Exec)
+			},
+		},
+		{
+			name: "deactivated account",
+			setup: func(t *testing.T, f fixture) {
+				f.update(t, f.account.Update().SetActivated(false).Exec)
+			},
+		},
+		{
+			name: "account left the hospital",
+			setup: func(t *testing.T, f fixture) {
+				f.update(t, f.account.Update().ClearHospital().Exec)
+			},
+		},
+		{
+			name: "account moved to another hospital",
+			setup: func(t *testing.T, f fixture) {
+				other := newHospital(t, f.client, "RS002", "3271010002")
+				f.update(t, f.account.Update().SetHospital(other).Exec)
+			},
+		},
+		{
+			name: "deactivated hospital",
+			setup: func(t *testing.T, f fixture) {
+				f.update(t, f.hospital.Update().SetActivated(false).Exec)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			token := f.token
+			if tt.token != nil {
+				token = tt.token(token)
+			}
+
+			key, err := NewVerifier(f.client).Verify(ctx, token)
+			if !tt.valid {
+				if !errors.Is(err, ErrInvalidAPIKey) {
+					t.Errorf(
+						"Verify() error = %v, want %v",
+						err,
+						ErrInvalidAPIKey,
+					)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+
+			if key.ID != f.key.ID {
+				t.Errorf("Verify() = key %s, want %s", key.ID, f.key.ID)
+			}
+
+			if key.Edges.Hospital == nil ||
+				key.Edges.Hospital.ID != f.hospital.ID {
+				t.Errorf("Verify() did not load the hospital of the key")
+			}
+
+			if key.LastUsedAt != nil {
+				t.Errorf("Verify() recorded the use of the key")
+			}
+		})
+	}
+}
+
+func TestTouch(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	v := NewVerifier(f.client)
+
+	if err := v.Touch(ctx, f.key); err != nil {
+		t.Fatalf("Touch: %v", err)
+	}
+
+	if f.lastUsedAt(t) == nil {
+		t.Fatalf("Touch did not record the first use")
+	}
+
+	// A use within lastUsedInterval of the recorded one is not written.
+	recent := time.Now().Add(-lastUsedInterval / 2).UnixMilli()
+	f.update(t, f.key.Update().SetLastUsedAt(recent).Exec)
+	if err := v.Touch(ctx, &ent.APIKey{
+		ID:         f.key.ID,
+		LastUsedAt: &recent,
+	}); err != nil {
+		t.Fatalf("Touch: %v", err)
+	}
+
+	if got := f.lastUsedAt(t); got == nil || *got != recent {
+		t.Errorf("Touch wrote a use within %s", lastUsedInterval)
+	}
+
+	stale := time.Now().Add(-2 * lastUsedInterval).UnixMilli()
+	if err := v.Touch(ctx, &ent.APIKey{
+		ID:         f.key.ID,
+		LastUsedAt: &stale,
+	}); err != nil {
+		t.Fatalf("Touch: %v", err)
+	}
+
+	if got := f.lastUsedAt(t); got == nil || *got <= stale {
+		t.Errorf("Touch did not record a use after %s", lastUsedInterval)
+	}
+}
+
+// fixture is an active key of an activated account that is a member of the
+// hospital the key belongs to.
+type fixture struct {
+	client   *ent.Client
+	hospital *ent.Hospital
+	account  *ent.Account
+	key      *ent.APIKey
+	token    string
+}
+
+func newFixture(t *testing.T) fixture {
+	t.Helper()
+
+	ctx := context.Background()
+	client := sqlitex.Open(t)
+	h := newHospital(t, client, "RS001", "3171010001")
+
+	acc, err := client.Account.Create().
+		SetNationalIDHash(cryptox.Sha256("3171010101900001")).
+		SetNationalIDMasked("****0001").
+		SetFullName("Hospital Staff").
+		SetGender(account.GenderFemale).
+		SetEmail("staff@hospital.test").
+		SetCountryIsoCode("ID").
+		SetDialCode("62").
+		SetPhoneNumber("081234567890").
+		SetActivated(true).
+		SetHospital(h).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	generated, err := NewAPIKey()
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+
+	key, err := client.APIKey.Create().
+		SetName("Hospital system").
+		SetPrefix(generated.Prefix).
+		SetSecretHash(generated.SecretHash).
+		SetScopes([]string{"create-blood-request"}).
+		SetExpiresAt(time.Now().Add(time.Hour).UnixMilli()).
+		SetAccount(acc).
+		SetHospital(h).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create api key: %v", err)
+	}
+
+	return fixture{
+		client:   client,
+		hospital: h,
+		account:  acc,
+		key:      key,
+		token:    generated.Token,
+	}
+}
+
+func (f fixture) update(t *testing.T, exec func(context.Context) error) {
+	t.Helper()
+
+	if err := exec(context.Background()); err != nil {
+		t.Fatalf("update fixture: %v", err)
+	}
+}
+
+func (f fixture) lastUsedAt(t *testing.T) *int64 {
+	t.Helper()
+
+	key, err := f.client.APIKey.Get(context.Background(), f.key.ID)
+	if err != nil {
+		t.Fatalf("get api key: %v", err)
+	}
+
+	return key.LastUsedAt
+}
+
+// newHospital creates a hospital in a new subdistrict with the BPS code,
+// whose province, city and district must not exist yet.
+func newHospital(
+	t *testing.T,
+	client *ent.Client,
+	code, bpsCode string,
+) *ent.Hospital {
+	t.Helper()
+
+	ctx := context.Background()
+	province, err := client.Province.Create().
+		SetBpsCode(bpsCode[:2]).
+		SetName("Province " + code).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create province: %v", err)
+	}
+
+	city, err := client.City.Create().
+		SetBpsCode(bpsCode[:4]).
+		SetName("City " + code).
+		SetProvince(province).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create city: %v", err)
+	}
+
+	district, err := client.District.Create().
+		SetBpsCode(bpsCode[:7]).
+		SetName("District " + code).
+		SetCity(city).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create district: %v", err)
+	}
+
+	subdistrict, err := client.Subdistrict.Create().
+		SetBpsCode(bpsCode).
+		SetPostalCode("10110").
+		SetName("Subdistrict " + code).
+		SetDistrict(district).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create subdistrict: %v", err)
+	}
+
+	h, err := client.Hospital.Create().
+		SetCode(code).
+		SetName("Hospital " + code).
+		SetStreet("Jl. Kesehatan No. 1").
+		SetDialCode("62").
+		SetPhoneNumber("02155500" + code[2:]).
+		SetSubdistrict(subdistrict).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create hospital: %v", err)
+	}
+
+	return h
+}
@@ -106,6 +106,14 @@ func (k *Keypair) PublicKey() ed25519.PublicKey {
 	return k.publicKey
 }
 
+func (k *Keypair) Sign(message []byte) []byte {
+	return ed25519.Sign(k.privateKey, message)
+}
+
+func (k *Keypair) Verify(message, signature []byte) bool {
+	return ed25519.Verify(k.publicKey, message, signature)
+}
+
 func validatePath(path string) ([]byte, error) {
 	clean := filepath.Clean(path)
 	if strings.Contains(clean, "..") {
@@ -11,16 +11,28 @@ const (
 	audience = "com.sembraniteam.setetes"
 	issuer   = "https://setetes.sembraniteam.com"
 	keyType  = "ed25519-v1"
+
+	// actorClaim holds the account acting for the subject of an
+	// impersonation token.
+	actorClaim = "act"
+
+	// PurposeDonorCard marks the short-lived token shown as the QR code of a
+	// donor card.
+	PurposeDonorCard = "donor-card"
 )
 
 type (
 	Claims struct {
+		Purpose         string
 		Platform        string
 		Subject         string
 		Expiration      time.Time
 		NotBefore       time.Time
 		IssuedAt        time.Time
 		TokenIdentifier string
+		// Actor is the account impersonating Subject. It is empty unless the
+		// token was issued for impersonation.
+		Actor string
 	}
 
 	TokenPair struct {
@@ -60,6 +72,9 @@ func (c *Config) Signed() (string, error) {
 	token.SetIssuedAt(c.claims.IssuedAt)
 	token.SetJti(c.claims.TokenIdentifier)
 	token.SetString("platform", c.claims.Platform)
+	if c.claims.Actor != "" {
+		token.SetString(actorClaim, c.claims.Actor)
+	}
 
 	secretKey, err := paseto.NewV4AsymmetricSecretKeyFromEd25519(
 		c.keypair.PrivateKey(),
@@ -68,10 +83,17 @@ func (c *Config) Signed() (string, error) {
 		return "", err
 	}
 
-	return token.V4Sign(secretKey, []byte(keyType)), nil
+	return token.V4Sign(secretKey, implicit(c.claims.Purpose)), nil
 }
 
+// Verify parses a session access token.
 func (v *Verifier) Verify(token string) (*Claims, error) {
+	return v.VerifyPurpose(token, "")
+}
+
+// VerifyPurpose parses a token signed for purpose. The purpose is bound to
+// the signature, so a token is never accepted for another purpose.
+func (v *Verifier) VerifyPurpose(token, purpose string) (*Claims, error) {
 	publicKey, err := paseto.NewV4AsymmetricPublicKeyFromEd25519(
 		v.keypair.PublicKey(),
 	)
@@ -87,7 +109,7 @@ func (v *Verifier) Verify(token string) (*Claims, error) {
 		paseto.IssuedBy(issuer),
 	)
 
-	parsed, err := parser.ParseV4Public(publicKey, token, []byte(keyType))
+	parsed, err := parser.ParseV4Public(publicKey, token, implicit(purpose))
 	if err != nil {
 		return nil, err
 	}
@@ -122,12 +144,28 @@ func (v *Verifier) Verify(token string) (*Claims, error) {
 		return nil, err
 	}
 
+	// Only impersonation tokens carry an actor.
+	act, _ := parsed.GetString(actorClaim)
+
 	return &Claims{
+		Purpose:         purpose,
 		Platform:        plat,
 		Subject:         sub,
 		Expiration:      exp,
 		NotBefore:       nbf,
 		IssuedAt:        iat,
 		TokenIdentifier: jti,
+		Actor:           act,
 	}, nil
 }
+
+// implicit returns the implicit assertion of a token. Session access tokens
+// keep the plain key type so tokens issued before purposes existed stay
+// valid.
+func implicit(purpose string) []byte {
+	if purpose == "" {
+		return []byte(keyType)
+	}
+
+	return []byte(keyType + ":" + purpose)
+}
@@ -4,7 +4,15 @@ import "github.com/google/uuid"
 
 type Key string
 
-const AuthKey Key = "auth:"
+const (
+	AuthKey Key = "auth:"
+
+	// PolicyChannel is the channel instances broadcast policy changes on.
+	PolicyChannel Key = "rbac:policy"
+
+	// NonceKey prefixes the nonces of signed requests already seen.
+	NonceKey Key = "signature:nonce:"
+)
 
 func (k Key) String() string {
 	return string(k)
@@ -13,3 +21,7 @@ func (k Key) String() string {
 func (k Key) WithSession(jti uuid.UUID) Key {
 	return k + Key("session:"+jti.String())
 }
+
+func (k Key) WithNonce(partner, nonce string) Key {
+	return k + Key(partner+":"+nonce)
+}
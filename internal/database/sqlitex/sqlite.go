@@ -0,0 +1,102 @@
+// Package sqlitex opens in-memory SQLite databases with the schema migrated,
+// so tests can run against ent without a PostgreSQL server.
+package sqlitex
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/migrate"
+)
+
+// nowMillis is the SQLite counterpart of the default of created_at.
+const nowMillis = "(CAST(strftime('%s', 'now') AS INTEGER) * 1000)"
+
+var seq atomic.Int64
+
+// init adapts the PostgreSQL schema to SQLite. IDs are generated by the
+// generateID hook instead of uuid_generate_v4, created_at defaults to the
+// current time in milliseconds, and columns typed for PostgreSQL only, such
+// as the PostGIS points, are stored as text, which SQLite accepts for any
+// value.
+func init() {
+	for _, t := range migrate.Tables {
+		for _, c := range t.Columns {
+			if _, ok := c.Default.(schema.Expr); ok {
+				switch c.Name {
+				case "id":
+					c.Default = nil
+				case "created_at":
+					c.Default = schema.Expr(nowMillis)
+				}
+			}
+
+			if c.Type != field.TypeOther {
+				continue
+			}
+
+			if c.SchemaType == nil {
+				c.SchemaType = make(map[string]string)
+			}
+
+			if _, ok := c.SchemaType[dialect.SQLite]; !ok {
+				c.SchemaType[dialect.SQLite] = "text"
+			}
+		}
+	}
+}
+
+// Open returns a client of a new in-memory database with the schema
+// migrated. The client is closed when the test ends.
+func Open(t testing.TB) *ent.Client {
+	t.Helper()
+
+	dsn := fmt.Sprintf(
+		"file:test%d?mode=memory&cache=shared&_fk=1",
+		seq.Add(1),
+	)
+	client, err := ent.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	if err = client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("migrate sqlite: %v", err)
+	}
+
+	client.Use(generateID)
+
+	return client
+}
+
+// generateID sets the ID of a created entity, which PostgreSQL generates
+// with uuid_generate_v4.
+func generateID(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(
+		func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			im, ok := m.(interface {
+				ID() (uuid.UUID, bool)
+				SetID(id uuid.UUID)
+			})
+			if ok && m.Op().Is(ent.OpCreate) {
+				if _, set := im.ID(); !set {
+					im.SetID(uuid.New())
+				}
+			}
+
+			return next.Mutate(ctx, m)
+		},
+	)
+}
@@ -0,0 +1,247 @@
+package eligibility
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/sembraniteam/setetes/internal/config"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+)
+
+const (
+	day  = time.Hour * 24
+	year = day * 365
+
+	defaultMinAge           = 17
+	defaultMaxAge           = 60
+	defaultWholeBloodDays   = 60
+	defaultApheresisDays    = 14
+	defaultMaleMaxPerYear   = 5
+	defaultFemaleMaxPerYear = 4
+)
+
+const (
+	ReasonUnderAge       ReasonCode = "UNDER_AGE"
+	ReasonOverAge        ReasonCode = "OVER_AGE"
+	ReasonInterval       ReasonCode = "MIN_INTERVAL"
+	ReasonYearlyLimit    ReasonCode = "YEARLY_LIMIT"
+	ReasonTemporaryDefer ReasonCode = "TEMPORARY_DEFERRAL"
+	ReasonPermanentDefer ReasonCode = "PERMANENT_DEFERRAL"
+)
+
+type (
+	ReasonCode string
+
+	Rules struct {
+		MinAge     int
+		MaxAge     int
+		Intervals  map[donation.Type]time.Duration
+		MaxPerYear map[account.Gender]int
+	}
+
+	Donor struct {
+		BirthDate *time.Time
+		Gender    account.Gender
+		Donations []Donation
+		Deferrals []Deferral
+	}
+
+	Donation struct {
+		Type      donation.Type
+		DonatedAt time.Time
+	}
+
+	Deferral struct {
+		Type     deferral.Type
+		Reason   string
+		StartsAt time.Time
+		EndsAt   *time.Time
+	}
+
+	Reason struct {
+		Code    ReasonCode
+		Message string
+		Until   *time.Time
+	}
+
+	Result struct {
+		Eligible       bool
+		Permanent      bool
+		NextEligibleAt *time.Time
+		Reasons        []Reason
+	}
+)
+
+// DefaultRules returns the PMI donor selection rules, with any value set in
+// the eligibility config section taking precedence.
+func DefaultRules() Rules {
+	cfg := config.Get().Eligibility
+	intervals := cfg.Intervals
+	perYear := cfg.MaxWholeBloodPerYear
+
+	return Rules{
+		MinAge: orDefault(cfg.MinAge, defaultMinAge),
+		MaxAge: orDefault(cfg.MaxAge, defaultMaxAge),
+		Intervals: map[donation.Type]time.Duration{
+			donation.TypeWholeBlood: days(
+				orDefault(intervals.WholeBlood, defaultWholeBloodDays),
+			),
+			donation.TypeApheresisPlatelets: days(
+				orDefault(intervals.ApheresisPlatelets, defaultApheresisDays),
+			),
+			donation.TypeApheresisPlasma: days(
+				orDefault(intervals.ApheresisPlasma, defaultApheresisDays),
+			),
+		},
+		MaxPerYear: map[account.Gender]int{
+			account.GenderMale: orDefault(
+				perYear.Male,
+				defaultMaleMaxPerYear,
+			),
+			account.GenderFemale: orDefault(
+				perYear.Female,
+				defaultFemaleMaxPerYear,
+			),
+		},
+	}
+}
+
+// Evaluate checks whether the donor may give a donation of the requested
+// type at now. Every failed rule is reported, and the next eligible date is
+// the latest date at which all temporary rules are satisfied.
+func (r Rules) Evaluate(d Donor, t donation.Type, now time.Time) Result {
+	res := Result{Reasons: make([]Reason, 0)}
+
+	r.checkAge(d, now, &res)
+	r.checkInterval(d, now, &res)
+	if t == donation.TypeWholeBlood {
+		r.checkYearlyLimit(d, now, &res)
+	}
+	checkDeferrals(d, now, &res)
+
+	res.Eligible = len(res.Reasons) == 0
+	if res.Permanent {
+		res.NextEligibleAt = nil
+	}
+
+	return res
+}
+
+func (r Rules) checkAge(d Donor, now time.Time, res *Result) {
+	if d.BirthDate == nil {
+		return
+	}
+
+	birth := *d.BirthDate
+	minDate := birth.AddDate(r.MinAge, 0, 0)
+	if now.Before(minDate) {
+		res.add(ReasonUnderAge, fmt.Sprintf(
+			"donor must be at least %d years old",
+			r.MinAge,
+		), &minDate)
+	}
+
+	if !now.Before(birth.AddDate(r.MaxAge+1, 0, 0)) {
+		res.add(ReasonOverAge, fmt.Sprintf(
+			"donor must not be older than %d years",
+			r.MaxAge,
+		), nil)
+	}
+}
+
+func (r Rules) checkInterval(d Donor, now time.Time, res *Result) {
+	var until *time.Time
+	for _, don := range d.Donations {
+		next := don.DonatedAt.Add(r.Intervals[don.Type])
+		if now.Before(next) && (until == nil || next.After(*until)) {
+			until = &next
+		}
+	}
+
+	if until != nil {
+		res.add(
+			ReasonInterval,
+			"minimum interval since the last donation has not passed",
+			until,
+		)
+	}
+}
+
+func (r Rules) checkYearlyLimit(d Donor, now time.Time, res *Result) {
+	limit, ok := r.MaxPerYear[d.Gender]
+	if !ok || limit <= 0 {
+		return
+	}
+
+	window := now.Add(-year)
+	inWindow := make([]time.Time, 0, len(d.Donations))
+	for _, don := range d.Donations {
+		if don.Type == donation.TypeWholeBlood &&
+			don.DonatedAt.After(window) {
+			inWindow = append(inWindow, don.DonatedAt)
+		}
+	}
+
+	if len(inWindow) < limit {
+		return
+	}
+
+	// The donor becomes eligible once enough donations drop out of the
+	// rolling one year window to get back under the limit.
+	slices.SortFunc(inWindow, time.Time.Compare)
+	until := inWindow[len(inWindow)-limit].Add(year)
+
+	res.add(ReasonYearlyLimit, fmt.Sprintf(
+		"maximum of %d whole blood donations per year reached",
+		limit,
+	), &until)
+}
+
+func checkDeferrals(d Donor, now time.Time, res *Result) {
+	for _, def := range d.Deferrals {
+		if now.Before(def.StartsAt) {
+			continue
+		}
+
+		if def.Type == deferral.TypePermanent {
+			res.add(ReasonPermanentDefer, def.Reason, nil)
+			continue
+		}
+
+		if def.EndsAt != nil && now.Before(*def.EndsAt) {
+			res.add(ReasonTemporaryDefer, def.Reason, def.EndsAt)
+		}
+	}
+}
+
+func (r *Result) add(code ReasonCode, message string, until *time.Time) {
+	r.Reasons = append(r.Reasons, Reason{
+		Code:    code,
+		Message: message,
+		Until:   until,
+	})
+
+	if until == nil {
+		r.Permanent = true
+		return
+	}
+
+	if r.NextEligibleAt == nil || until.After(*r.NextEligibleAt) {
+		r.NextEligibleAt = until
+	}
+}
+
+func days(n int) time.Duration {
+	return time.Duration(n) * day
+}
+
+func orDefault(v, def int) int {
+	if v <= 0 {
+		return def
+	}
+
+	return v
+}
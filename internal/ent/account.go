@@ -5,14 +5,18 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/sembraniteam/setetes/internal/ent/account"
 	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/notificationpreference"
 	"github.com/sembraniteam/setetes/internal/ent/password"
 	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/schema"
 )
 
 // Account is the model entity for the Account schema.
@@ -32,6 +36,8 @@ type Account struct {
 	NationalIDMasked string `json:"national_id_masked"`
 	// FullName holds the value of the "full_name" field.
 	FullName string `json:"full_name"`
+	// Date of birth derived from the national identity number, used for donor age checks.
+	BirthDate *time.Time `json:"birth_date"`
 	// Gender holds the value of the "gender" field.
 	Gender account.Gender `json:"gender"`
 	// Email holds the value of the "email" field.
@@ -42,6 +48,8 @@ type Account struct {
 	DialCode string `json:"dial_code"`
 	// PhoneNumber holds the value of the "phone_number" field.
 	PhoneNumber string `json:"phone_number"`
+	// Home location shared by the donor, used to find nearby donors in emergencies.
+	HomeLatLng *schema.GeoPoint `json:"home_lat_lng"`
 	// Activated holds the value of the "activated" field.
 	Activated bool `json:"activated"`
 	// Permanently locked by this account.
@@ -53,6 +61,8 @@ type Account struct {
 	Edges         AccountEdges `json:"edges"`
 	blood_type_id *uuid.UUID
 	role_id       *uuid.UUID
+	hospital_id   *uuid.UUID
+	account_id    *uuid.UUID
 	selectValues  sql.SelectValues
 }
 
@@ -66,9 +76,25 @@ type AccountEdges struct {
 	Otp []*OTP `json:"otp,omitempty"`
 	// Role holds the value of the role edge.
 	Role *Role `json:"role,omitempty"`
+	// Donations holds the value of the donations edge.
+	Donations []*Donation `json:"donations,omitempty"`
+	// Appointments holds the value of the appointments edge.
+	Appointments []*Appointment `json:"appointments,omitempty"`
+	// Deferrals holds the value of the deferrals edge.
+	Deferrals []*Deferral `json:"deferrals,omitempty"`
+	// EmergencyContacts holds the value of the emergency_contacts edge.
+	EmergencyContacts []*EmergencyContact `json:"emergency_contacts,omitempty"`
+	// NotificationPreference holds the value of the notification_preference edge.
+	NotificationPreference *NotificationPreference `json:"notification_preference,omitempty"`
+	// Certificates holds the value of the certificates edge.
+	Certificates []*Certificate `json:"certificates,omitempty"`
+	// RoleAssignments holds the value of the role_assignments edge.
+	RoleAssignments []*RoleAssignment `json:"role_assignments,omitempty"`
+	// Hospital the account acts for when requesting blood.
+	Hospital *Hospital `json:"hospital,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [12]bool
 }
 
 // BloodTypeOrErr returns the BloodType value or an error if the edge
@@ -113,23 +139,107 @@ func (e AccountEdges) RoleOrErr() (*Role, error) {
 	return nil, &NotLoadedError{edge: "role"}
 }
 
+// DonationsOrErr returns the Donations value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) DonationsOrErr() ([]*Donation, error) {
+	if e.loadedTypes[4] {
+		return e.Donations, nil
+	}
+	return nil, &NotLoadedError{edge: "donations"}
+}
+
+// AppointmentsOrErr returns the Appointments value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) AppointmentsOrErr() ([]*Appointment, error) {
+	if e.loadedTypes[5] {
+		return e.Appointments, nil
+	}
+	return nil, &NotLoadedError{edge: "appointments"}
+}
+
+// DeferralsOrErr returns the Deferrals value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) DeferralsOrErr() ([]*Deferral, error) {
+	if e.loadedTypes[6] {
+		return e.Deferrals, nil
+	}
+	return nil, &NotLoadedError{edge: "deferrals"}
+}
+
+// EmergencyContactsOrErr returns the EmergencyContacts value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) EmergencyContactsOrErr() ([]*EmergencyContact, error) {
+	if e.loadedTypes[7] {
+		return e.EmergencyContacts, nil
+	}
+	return nil, &NotLoadedError{edge: "emergency_contacts"}
+}
+
+// NotificationPreferenceOrErr returns the NotificationPreference value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) NotificationPreferenceOrErr() (*NotificationPreference, error) {
+	if e.NotificationPreference != nil {
+		return e.NotificationPreference, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: notificationpreference.Label}
+	}
+	return nil, &NotLoadedError{edge: "notification_preference"}
+}
+
+// CertificatesOrErr returns the Certificates value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) CertificatesOrErr() ([]*Certificate, error) {
+	if e.loadedTypes[9] {
+		return e.Certificates, nil
+	}
+	return nil, &NotLoadedError{edge: "certificates"}
+}
+
+// RoleAssignmentsOrErr returns the RoleAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) RoleAssignmentsOrErr() ([]*RoleAssignment, error) {
+	if e.loadedTypes[10] {
+		return e.RoleAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "role_assignments"}
+}
+
+// HospitalOrErr returns the Hospital value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) HospitalOrErr() (*Hospital, error) {
+	if e.Hospital != nil {
+		return e.Hospital, nil
+	} else if e.loadedTypes[11] {
+		return nil, &NotFoundError{label: hospital.Label}
+	}
+	return nil, &NotLoadedError{edge: "hospital"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Account) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case account.FieldHomeLatLng:
+			values[i] = new(schema.GeoPoint)
 		case account.FieldActivated, account.FieldLocked:
 			values[i] = new(sql.NullBool)
 		case account.FieldCreatedAt, account.FieldUpdatedAt, account.FieldDeletedAt, account.FieldTempLockedAt:
 			values[i] = new(sql.NullInt64)
 		case account.FieldNationalIDHash, account.FieldNationalIDMasked, account.FieldFullName, account.FieldGender, account.FieldEmail, account.FieldCountryIsoCode, account.FieldDialCode, account.FieldPhoneNumber:
 			values[i] = new(sql.NullString)
+		case account.FieldBirthDate:
+			values[i] = new(sql.NullTime)
 		case account.FieldID:
 			values[i] = new(uuid.UUID)
 		case account.ForeignKeys[0]: // blood_type_id
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case account.ForeignKeys[1]: // role_id
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case account.ForeignKeys[2]: // hospital_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case account.ForeignKeys[3]: // account_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -187,6 +297,13 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.FullName = value.String
 			}
+		case account.FieldBirthDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field birth_date", values[i])
+			} else if value.Valid {
+				_m.BirthDate = new(time.Time)
+				*_m.BirthDate = value.Time
+			}
 		case account.FieldGender:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field gender", values[i])
@@ -217,6 +334,12 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PhoneNumber = value.String
 			}
+		case account.FieldHomeLatLng:
+			if value, ok := values[i].(*schema.GeoPoint); !ok {
+				return fmt.Errorf("unexpected type %T for field home_lat_lng", values[i])
+			} else if value != nil {
+				_m.HomeLatLng = value
+			}
 		case account.FieldActivated:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field activated", values[i])
@@ -249,6 +372,20 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 				_m.role_id = new(uuid.UUID)
 				*_m.role_id = *value.S.(*uuid.UUID)
 			}
+		case account.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field hospital_id", values[i])
+			} else if value.Valid {
+				_m.hospital_id = new(uuid.UUID)
+				*_m.hospital_id = *value.S.(*uuid.UUID)
+			}
+		case account.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.account_id = new(uuid.UUID)
+				*_m.account_id = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -282,6 +419,46 @@ func (_m *Account) QueryRole() *RoleQuery {
 	return NewAccountClient(_m.config).QueryRole(_m)
 }
 
+// QueryDonations queries the "donations" edge of the Account entity.
+func (_m *Account) QueryDonations() *DonationQuery {
+	return NewAccountClient(_m.config).QueryDonations(_m)
+}
+
+// QueryAppointments queries the "appointments" edge of the Account entity.
+func (_m *Account) QueryAppointments() *AppointmentQuery {
+	return NewAccountClient(_m.config).QueryAppointments(_m)
+}
+
+// QueryDeferrals queries the "deferrals" edge of the Account entity.
+func (_m *Account) QueryDeferrals() *DeferralQuery {
+	return NewAccountClient(_m.config).QueryDeferrals(_m)
+}
+
+// QueryEmergencyContacts queries the "emergency_contacts" edge of the Account entity.
+func (_m *Account) QueryEmergencyContacts() *EmergencyContactQuery {
+	return NewAccountClient(_m.config).QueryEmergencyContacts(_m)
+}
+
+// QueryNotificationPreference queries the "notification_preference" edge of the Account entity.
+func (_m *Account) QueryNotificationPreference() *NotificationPreferenceQuery {
+	return NewAccountClient(_m.config).QueryNotificationPreference(_m)
+}
+
+// QueryCertificates queries the "certificates" edge of the Account entity.
+func (_m *Account) QueryCertificates() *CertificateQuery {
+	return NewAccountClient(_m.config).QueryCertificates(_m)
+}
+
+// QueryRoleAssignments queries the "role_assignments" edge of the Account entity.
+func (_m *Account) QueryRoleAssignments() *RoleAssignmentQuery {
+	return NewAccountClient(_m.config).QueryRoleAssignments(_m)
+}
+
+// QueryHospital queries the "hospital" edge of the Account entity.
+func (_m *Account) QueryHospital() *HospitalQuery {
+	return NewAccountClient(_m.config).QueryHospital(_m)
+}
+
 // Update returns a builder for updating this Account.
 // Note that you need to call Account.Unwrap() before calling this method if this Account
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -322,6 +499,11 @@ func (_m *Account) String() string {
 	builder.WriteString("full_name=")
 	builder.WriteString(_m.FullName)
 	builder.WriteString(", ")
+	if v := _m.BirthDate; v != nil {
+		builder.WriteString("birth_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("gender=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Gender))
 	builder.WriteString(", ")
@@ -337,6 +519,9 @@ func (_m *Account) String() string {
 	builder.WriteString("phone_number=")
 	builder.WriteString(_m.PhoneNumber)
 	builder.WriteString(", ")
+	builder.WriteString("home_lat_lng=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HomeLatLng))
+	builder.WriteString(", ")
 	builder.WriteString("activated=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Activated))
 	builder.WriteString(", ")
@@ -26,6 +26,8 @@ const (
 	FieldNationalIDMasked = "national_id_masked"
 	// FieldFullName holds the string denoting the full_name field in the database.
 	FieldFullName = "full_name"
+	// FieldBirthDate holds the string denoting the birth_date field in the database.
+	FieldBirthDate = "birth_date"
 	// FieldGender holds the string denoting the gender field in the database.
 	FieldGender = "gender"
 	// FieldEmail holds the string denoting the email field in the database.
@@ -36,6 +38,8 @@ const (
 	FieldDialCode = "dial_code"
 	// FieldPhoneNumber holds the string denoting the phone_number field in the database.
 	FieldPhoneNumber = "phone_number"
+	// FieldHomeLatLng holds the string denoting the home_lat_lng field in the database.
+	FieldHomeLatLng = "home_lat_lng"
 	// FieldActivated holds the string denoting the activated field in the database.
 	FieldActivated = "activated"
 	// FieldLocked holds the string denoting the locked field in the database.
@@ -50,6 +54,22 @@ const (
 	EdgeOtp = "otp"
 	// EdgeRole holds the string denoting the role edge name in mutations.
 	EdgeRole = "role"
+	// EdgeDonations holds the string denoting the donations edge name in mutations.
+	EdgeDonations = "donations"
+	// EdgeAppointments holds the string denoting the appointments edge name in mutations.
+	EdgeAppointments = "appointments"
+	// EdgeDeferrals holds the string denoting the deferrals edge name in mutations.
+	EdgeDeferrals = "deferrals"
+	// EdgeEmergencyContacts holds the string denoting the emergency_contacts edge name in mutations.
+	EdgeEmergencyContacts = "emergency_contacts"
+	// EdgeNotificationPreference holds the string denoting the notification_preference edge name in mutations.
+	EdgeNotificationPreference = "notification_preference"
+	// EdgeCertificates holds the string denoting the certificates edge name in mutations.
+	EdgeCertificates = "certificates"
+	// EdgeRoleAssignments holds the string denoting the role_assignments edge name in mutations.
+	EdgeRoleAssignments = "role_assignments"
+	// EdgeHospital holds the string denoting the hospital edge name in mutations.
+	EdgeHospital = "hospital"
 	// Table holds the table name of the account in the database.
 	Table = "accounts"
 	// BloodTypeTable is the table that holds the blood_type relation/edge.
@@ -80,6 +100,62 @@ const (
 	RoleInverseTable = "roles"
 	// RoleColumn is the table column denoting the role relation/edge.
 	RoleColumn = "role_id"
+	// DonationsTable is the table that holds the donations relation/edge.
+	DonationsTable = "donations"
+	// DonationsInverseTable is the table name for the Donation entity.
+	// It exists in this package in order to avoid circular dependency with the "donation" package.
+	DonationsInverseTable = "donations"
+	// DonationsColumn is the table column denoting the donations relation/edge.
+	DonationsColumn = "account_id"
+	// AppointmentsTable is the table that holds the appointments relation/edge.
+	AppointmentsTable = "appointments"
+	// AppointmentsInverseTable is the table name for the Appointment entity.
+	// It exists in this package in order to avoid circular dependency with the "appointment" package.
+	AppointmentsInverseTable = "appointments"
+	// AppointmentsColumn is the table column denoting the appointments relation/edge.
+	AppointmentsColumn = "account_id"
+	// DeferralsTable is the table that holds the deferrals relation/edge.
+	DeferralsTable = "deferrals"
+	// DeferralsInverseTable is the table name for the Deferral entity.
+	// It exists in this package in order to avoid circular dependency with the "deferral" package.
+	DeferralsInverseTable = "deferrals"
+	// DeferralsColumn is the table column denoting the deferrals relation/edge.
+	DeferralsColumn = "account_id"
+	// EmergencyContactsTable is the table that holds the emergency_contacts relation/edge.
+	EmergencyContactsTable = "emergency_contacts"
+	// EmergencyContactsInverseTable is the table name for the EmergencyContact entity.
+	// It exists in this package in order to avoid circular dependency with the "emergencycontact" package.
+	EmergencyContactsInverseTable = "emergency_contacts"
+	// EmergencyContactsColumn is the table column denoting the emergency_contacts relation/edge.
+	EmergencyContactsColumn = "account_id"
+	// NotificationPreferenceTable is the table that holds the notification_preference relation/edge.
+	NotificationPreferenceTable = "accounts"
+	// NotificationPreferenceInverseTable is the table name for the NotificationPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationpreference" package.
+	NotificationPreferenceInverseTable = "notification_preferences"
+	// NotificationPreferenceColumn is the table column denoting the notification_preference relation/edge.
+	NotificationPreferenceColumn = "account_id"
+	// CertificatesTable is the table that holds the certificates relation/edge.
+	CertificatesTable = "certificates"
+	// CertificatesInverseTable is the table name for the Certificate entity.
+	// It exists in this package in order to avoid circular dependency with the "certificate" package.
+	CertificatesInverseTable = "certificates"
+	// CertificatesColumn is the table column denoting the certificates relation/edge.
+	CertificatesColumn = "account_id"
+	// RoleAssignmentsTable is the table that holds the role_assignments relation/edge.
+	RoleAssignmentsTable = "role_assignments"
+	// RoleAssignmentsInverseTable is the table name for the RoleAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "roleassignment" package.
+	RoleAssignmentsInverseTable = "role_assignments"
+	// RoleAssignmentsColumn is the table column denoting the role_assignments relation/edge.
+	RoleAssignmentsColumn = "account_id"
+	// HospitalTable is the table that holds the hospital relation/edge.
+	HospitalTable = "accounts"
+	// HospitalInverseTable is the table name for the Hospital entity.
+	// It exists in this package in order to avoid circular dependency with the "hospital" package.
+	HospitalInverseTable = "hospitals"
+	// HospitalColumn is the table column denoting the hospital relation/edge.
+	HospitalColumn = "hospital_id"
 )
 
 // Columns holds all SQL columns for account fields.
@@ -91,11 +167,13 @@ var Columns = []string{
 	FieldNationalIDHash,
 	FieldNationalIDMasked,
 	FieldFullName,
+	FieldBirthDate,
 	FieldGender,
 	FieldEmail,
 	FieldCountryIsoCode,
 	FieldDialCode,
 	FieldPhoneNumber,
+	FieldHomeLatLng,
 	FieldActivated,
 	FieldLocked,
 	FieldTempLockedAt,
@@ -106,6 +184,8 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"blood_type_id",
 	"role_id",
+	"hospital_id",
+	"account_id",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -215,6 +295,11 @@ func ByFullName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFullName, opts...).ToFunc()
 }
 
+// ByBirthDate orders the results by the birth_date field.
+func ByBirthDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBirthDate, opts...).ToFunc()
+}
+
 // ByGender orders the results by the gender field.
 func ByGender(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGender, opts...).ToFunc()
@@ -240,6 +325,11 @@ func ByPhoneNumber(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPhoneNumber, opts...).ToFunc()
 }
 
+// ByHomeLatLng orders the results by the home_lat_lng field.
+func ByHomeLatLng(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHomeLatLng, opts...).ToFunc()
+}
+
 // ByActivated orders the results by the activated field.
 func ByActivated(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldActivated, opts...).ToFunc()
@@ -289,6 +379,104 @@ func ByRoleField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRoleStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByDonationsCount orders the results by donations count.
+func ByDonationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDonationsStep(), opts...)
+	}
+}
+
+// ByDonations orders the results by donations terms.
+func ByDonations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDonationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAppointmentsCount orders the results by appointments count.
+func ByAppointmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAppointmentsStep(), opts...)
+	}
+}
+
+// ByAppointments orders the results by appointments terms.
+func ByAppointments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAppointmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDeferralsCount orders the results by deferrals count.
+func ByDeferralsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeferralsStep(), opts...)
+	}
+}
+
+// ByDeferrals orders the results by deferrals terms.
+func ByDeferrals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeferralsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmergencyContactsCount orders the results by emergency_contacts count.
+func ByEmergencyContactsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmergencyContactsStep(), opts...)
+	}
+}
+
+// ByEmergencyContacts orders the results by emergency_contacts terms.
+func ByEmergencyContacts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmergencyContactsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationPreferenceField orders the results by notification_preference field.
+func ByNotificationPreferenceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationPreferenceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCertificatesCount orders the results by certificates count.
+func ByCertificatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCertificatesStep(), opts...)
+	}
+}
+
+// ByCertificates orders the results by certificates terms.
+func ByCertificates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCertificatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRoleAssignmentsCount orders the results by role_assignments count.
+func ByRoleAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRoleAssignmentsStep(), opts...)
+	}
+}
+
+// ByRoleAssignments orders the results by role_assignments terms.
+func ByRoleAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHospitalField orders the results by hospital field.
+func ByHospitalField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHospitalStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newBloodTypeStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -317,3 +505,59 @@ func newRoleStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, RoleTable, RoleColumn),
 	)
 }
+func newDonationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DonationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, DonationsTable, DonationsColumn),
+	)
+}
+func newAppointmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AppointmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, AppointmentsTable, AppointmentsColumn),
+	)
+}
+func newDeferralsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeferralsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, DeferralsTable, DeferralsColumn),
+	)
+}
+func newEmergencyContactsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmergencyContactsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, EmergencyContactsTable, EmergencyContactsColumn),
+	)
+}
+func newNotificationPreferenceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationPreferenceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, NotificationPreferenceTable, NotificationPreferenceColumn),
+	)
+}
+func newCertificatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CertificatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, CertificatesTable, CertificatesColumn),
+	)
+}
+func newRoleAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, RoleAssignmentsTable, RoleAssignmentsColumn),
+	)
+}
+func newHospitalStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HospitalInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, HospitalTable, HospitalColumn),
+	)
+}
@@ -3,10 +3,13 @@
 package account
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/schema"
 )
 
 // ID filters vertices based on their ID field.
@@ -84,6 +87,11 @@ func FullName(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldFullName, v))
 }
 
+// BirthDate applies equality check predicate on the "birth_date" field. It's identical to BirthDateEQ.
+func BirthDate(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldBirthDate, v))
+}
+
 // Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
 func Email(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldEmail, v))
@@ -104,6 +112,11 @@ func PhoneNumber(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldPhoneNumber, v))
 }
 
+// HomeLatLng applies equality check predicate on the "home_lat_lng" field. It's identical to HomeLatLngEQ.
+func HomeLatLng(v *schema.GeoPoint) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldHomeLatLng, v))
+}
+
 // Activated applies equality check predicate on the "activated" field. It's identical to ActivatedEQ.
 func Activated(v bool) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldActivated, v))
@@ -454,6 +467,56 @@ func FullNameContainsFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldFullName, v))
 }
 
+// BirthDateEQ applies the EQ predicate on the "birth_date" field.
+func BirthDateEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldBirthDate, v))
+}
+
+// BirthDateNEQ applies the NEQ predicate on the "birth_date" field.
+func BirthDateNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldBirthDate, v))
+}
+
+// BirthDateIn applies the In predicate on the "birth_date" field.
+func BirthDateIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldBirthDate, vs...))
+}
+
+// BirthDateNotIn applies the NotIn predicate on the "birth_date" field.
+func BirthDateNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldBirthDate, vs...))
+}
+
+// BirthDateGT applies the GT predicate on the "birth_date" field.
+func BirthDateGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldBirthDate, v))
+}
+
+// BirthDateGTE applies the GTE predicate on the "birth_date" field.
+func BirthDateGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldBirthDate, v))
+}
+
+// BirthDateLT applies the LT predicate on the "birth_date" field.
+func BirthDateLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldBirthDate, v))
+}
+
+// BirthDateLTE applies the LTE predicate on the "birth_date" field.
+func BirthDateLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldBirthDate, v))
+}
+
+// BirthDateIsNil applies the IsNil predicate on the "birth_date" field.
+func BirthDateIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldBirthDate))
+}
+
+// BirthDateNotNil applies the NotNil predicate on the "birth_date" field.
+func BirthDateNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldBirthDate))
+}
+
 // GenderEQ applies the EQ predicate on the "gender" field.
 func GenderEQ(v Gender) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldGender, v))
@@ -734,6 +797,56 @@ func PhoneNumberContainsFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldPhoneNumber, v))
 }
 
+// HomeLatLngEQ applies the EQ predicate on the "home_lat_lng" field.
+func HomeLatLngEQ(v *schema.GeoPoint) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldHomeLatLng, v))
+}
+
+// HomeLatLngNEQ applies the NEQ predicate on the "home_lat_lng" field.
+func HomeLatLngNEQ(v *schema.GeoPoint) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldHomeLatLng, v))
+}
+
+// HomeLatLngIn applies the In predicate on the "home_lat_lng" field.
+func HomeLatLngIn(vs ...*schema.GeoPoint) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldHomeLatLng, vs...))
+}
+
+// HomeLatLngNotIn applies the NotIn predicate on the "home_lat_lng" field.
+func HomeLatLngNotIn(vs ...*schema.GeoPoint) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldHomeLatLng, vs...))
+}
+
+// HomeLatLngGT applies the GT predicate on the "home_lat_lng" field.
+func HomeLatLngGT(v *schema.GeoPoint) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldHomeLatLng, v))
+}
+
+// HomeLatLngGTE applies the GTE predicate on the "home_lat_lng" field.
+func HomeLatLngGTE(v *schema.GeoPoint) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldHomeLatLng, v))
+}
+
+// HomeLatLngLT applies the LT predicate on the "home_lat_lng" field.
+func HomeLatLngLT(v *schema.GeoPoint) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldHomeLatLng, v))
+}
+
+// HomeLatLngLTE applies the LTE predicate on the "home_lat_lng" field.
+func HomeLatLngLTE(v *schema.GeoPoint) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldHomeLatLng, v))
+}
+
+// HomeLatLngIsNil applies the IsNil predicate on the "home_lat_lng" field.
+func HomeLatLngIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldHomeLatLng))
+}
+
+// HomeLatLngNotNil applies the NotNil predicate on the "home_lat_lng" field.
+func HomeLatLngNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldHomeLatLng))
+}
+
 // ActivatedEQ applies the EQ predicate on the "activated" field.
 func ActivatedEQ(v bool) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldActivated, v))
@@ -896,6 +1009,190 @@ func HasRoleWith(preds ...predicate.Role) predicate.Account {
 	})
 }
 
+// HasDonations applies the HasEdge predicate on the "donations" edge.
+func HasDonations() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, DonationsTable, DonationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDonationsWith applies the HasEdge predicate on the "donations" edge with a given conditions (other predicates).
+func HasDonationsWith(preds ...predicate.Donation) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newDonationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAppointments applies the HasEdge predicate on the "appointments" edge.
+func HasAppointments() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, AppointmentsTable, AppointmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppointmentsWith applies the HasEdge predicate on the "appointments" edge with a given conditions (other predicates).
+func HasAppointmentsWith(preds ...predicate.Appointment) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newAppointmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeferrals applies the HasEdge predicate on the "deferrals" edge.
+func HasDeferrals() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, DeferralsTable, DeferralsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeferralsWith applies the HasEdge predicate on the "deferrals" edge with a given conditions (other predicates).
+func HasDeferralsWith(preds ...predicate.Deferral) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newDeferralsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmergencyContacts applies the HasEdge predicate on the "emergency_contacts" edge.
+func HasEmergencyContacts() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, EmergencyContactsTable, EmergencyContactsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmergencyContactsWith applies the HasEdge predicate on the "emergency_contacts" edge with a given conditions (other predicates).
+func HasEmergencyContactsWith(preds ...predicate.EmergencyContact) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newEmergencyContactsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationPreference applies the HasEdge predicate on the "notification_preference" edge.
+func HasNotificationPreference() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, NotificationPreferenceTable, NotificationPreferenceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationPreferenceWith applies the HasEdge predicate on the "notification_preference" edge with a given conditions (other predicates).
+func HasNotificationPreferenceWith(preds ...predicate.NotificationPreference) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newNotificationPreferenceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCertificates applies the HasEdge predicate on the "certificates" edge.
+func HasCertificates() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, CertificatesTable, CertificatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCertificatesWith applies the HasEdge predicate on the "certificates" edge with a given conditions (other predicates).
+func HasCertificatesWith(preds ...predicate.Certificate) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newCertificatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoleAssignments applies the HasEdge predicate on the "role_assignments" edge.
+func HasRoleAssignments() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, RoleAssignmentsTable, RoleAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleAssignmentsWith applies the HasEdge predicate on the "role_assignments" edge with a given conditions (other predicates).
+func HasRoleAssignmentsWith(preds ...predicate.RoleAssignment) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newRoleAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHospital applies the HasEdge predicate on the "hospital" edge.
+func HasHospital() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, HospitalTable, HospitalColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHospitalWith applies the HasEdge predicate on the "hospital" edge with a given conditions (other predicates).
+func HasHospitalWith(preds ...predicate.Hospital) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newHospitalStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Account) predicate.Account {
 	return predicate.Account(sql.AndPredicates(predicates...))
@@ -6,15 +6,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
 	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/certificate"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/notificationpreference"
 	"github.com/sembraniteam/setetes/internal/ent/otp"
 	"github.com/sembraniteam/setetes/internal/ent/password"
 	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/roleassignment"
+	"github.com/sembraniteam/setetes/internal/ent/schema"
 )
 
 // AccountCreate is the builder for creating a Account entity.
@@ -76,6 +86,20 @@ func (_c *AccountCreate) SetFullName(v string) *AccountCreate {
 	return _c
 }
 
+// SetBirthDate sets the "birth_date" field.
+func (_c *AccountCreate) SetBirthDate(v time.Time) *AccountCreate {
+	_c.mutation.SetBirthDate(v)
+	return _c
+}
+
+// SetNillableBirthDate sets the "birth_date" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableBirthDate(v *time.Time) *AccountCreate {
+	if v != nil {
+		_c.SetBirthDate(*v)
+	}
+	return _c
+}
+
 // SetGender sets the "gender" field.
 func (_c *AccountCreate) SetGender(v account.Gender) *AccountCreate {
 	_c.mutation.SetGender(v)
@@ -106,6 +130,12 @@ func (_c *AccountCreate) SetPhoneNumber(v string) *AccountCreate {
 	return _c
 }
 
+// SetHomeLatLng sets the "home_lat_lng" field.
+func (_c *AccountCreate) SetHomeLatLng(v *schema.GeoPoint) *AccountCreate {
+	_c.mutation.SetHomeLatLng(v)
+	return _c
+}
+
 // SetActivated sets the "activated" field.
 func (_c *AccountCreate) SetActivated(v bool) *AccountCreate {
 	_c.mutation.SetActivated(v)
@@ -226,6 +256,134 @@ func (_c *AccountCreate) SetRole(v *Role) *AccountCreate {
 	return _c.SetRoleID(v.ID)
 }
 
+// AddDonationIDs adds the "donations" edge to the Donation entity by IDs.
+func (_c *AccountCreate) AddDonationIDs(ids ...uuid.UUID) *AccountCreate {
+	_c.mutation.AddDonationIDs(ids...)
+	return _c
+}
+
+// AddDonations adds the "donations" edges to the Donation entity.
+func (_c *AccountCreate) AddDonations(v ...*Donation) *AccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDonationIDs(ids...)
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by IDs.
+func (_c *AccountCreate) AddAppointmentIDs(ids ...uuid.UUID) *AccountCreate {
+	_c.mutation.AddAppointmentIDs(ids...)
+	return _c
+}
+
+// AddAppointments adds the "appointments" edges to the Appointment entity.
+func (_c *AccountCreate) AddAppointments(v ...*Appointment) *AccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAppointmentIDs(ids...)
+}
+
+// AddDeferralIDs adds the "deferrals" edge to the Deferral entity by IDs.
+func (_c *AccountCreate) AddDeferralIDs(ids ...uuid.UUID) *AccountCreate {
+	_c.mutation.AddDeferralIDs(ids...)
+	return _c
+}
+
+// AddDeferrals adds the "deferrals" edges to the Deferral entity.
+func (_c *AccountCreate) AddDeferrals(v ...*Deferral) *AccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeferralIDs(ids...)
+}
+
+// AddEmergencyContactIDs adds the "emergency_contacts" edge to the EmergencyContact entity by IDs.
+func (_c *AccountCreate) AddEmergencyContactIDs(ids ...uuid.UUID) *AccountCreate {
+	_c.mutation.AddEmergencyContactIDs(ids...)
+	return _c
+}
+
+// AddEmergencyContacts adds the "emergency_contacts" edges to the EmergencyContact entity.
+func (_c *AccountCreate) AddEmergencyContacts(v ...*EmergencyContact) *AccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEmergencyContactIDs(ids...)
+}
+
+// SetNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID.
+func (_c *AccountCreate) SetNotificationPreferenceID(id uuid.UUID) *AccountCreate {
+	_c.mutation.SetNotificationPreferenceID(id)
+	return _c
+}
+
+// SetNillableNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID if the given value is not nil.
+func (_c *AccountCreate) SetNillableNotificationPreferenceID(id *uuid.UUID) *AccountCreate {
+	if id != nil {
+		_c = _c.SetNotificationPreferenceID(*id)
+	}
+	return _c
+}
+
+// SetNotificationPreference sets the "notification_preference" edge to the NotificationPreference entity.
+func (_c *AccountCreate) SetNotificationPreference(v *NotificationPreference) *AccountCreate {
+	return _c.SetNotificationPreferenceID(v.ID)
+}
+
+// AddCertificateIDs adds the "certificates" edge to the Certificate entity by IDs.
+func (_c *AccountCreate) AddCertificateIDs(ids ...uuid.UUID) *AccountCreate {
+	_c.mutation.AddCertificateIDs(ids...)
+	return _c
+}
+
+// AddCertificates adds the "certificates" edges to the Certificate entity.
+func (_c *AccountCreate) AddCertificates(v ...*Certificate) *AccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCertificateIDs(ids...)
+}
+
+// AddRoleAssignmentIDs adds the "role_assignments" edge to the RoleAssignment entity by IDs.
+func (_c *AccountCreate) AddRoleAssignmentIDs(ids ...uuid.UUID) *AccountCreate {
+	_c.mutation.AddRoleAssignmentIDs(ids...)
+	return _c
+}
+
+// AddRoleAssignments adds the "role_assignments" edges to the RoleAssignment entity.
+func (_c *AccountCreate) AddRoleAssignments(v ...*RoleAssignment) *AccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRoleAssignmentIDs(ids...)
+}
+
+// SetHospitalID sets the "hospital" edge to the Hospital entity by ID.
+func (_c *AccountCreate) SetHospitalID(id uuid.UUID) *AccountCreate {
+	_c.mutation.SetHospitalID(id)
+	return _c
+}
+
+// SetNillableHospitalID sets the "hospital" edge to the Hospital entity by ID if the given value is not nil.
+func (_c *AccountCreate) SetNillableHospitalID(id *uuid.UUID) *AccountCreate {
+	if id != nil {
+		_c = _c.SetHospitalID(*id)
+	}
+	return _c
+}
+
+// SetHospital sets the "hospital" edge to the Hospital entity.
+func (_c *AccountCreate) SetHospital(v *Hospital) *AccountCreate {
+	return _c.SetHospitalID(v.ID)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_c *AccountCreate) Mutation() *AccountMutation {
 	return _c.mutation
@@ -422,6 +580,10 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldFullName, field.TypeString, value)
 		_node.FullName = value
 	}
+	if value, ok := _c.mutation.BirthDate(); ok {
+		_spec.SetField(account.FieldBirthDate, field.TypeTime, value)
+		_node.BirthDate = &value
+	}
 	if value, ok := _c.mutation.Gender(); ok {
 		_spec.SetField(account.FieldGender, field.TypeEnum, value)
 		_node.Gender = value
@@ -442,6 +604,10 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldPhoneNumber, field.TypeString, value)
 		_node.PhoneNumber = value
 	}
+	if value, ok := _c.mutation.HomeLatLng(); ok {
+		_spec.SetField(account.FieldHomeLatLng, field.TypeOther, value)
+		_node.HomeLatLng = value
+	}
 	if value, ok := _c.mutation.Activated(); ok {
 		_spec.SetField(account.FieldActivated, field.TypeBool, value)
 		_node.Activated = value
@@ -520,6 +686,136 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_node.role_id = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.DonationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DonationsTable,
+			Columns: []string{account.DonationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AppointmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AppointmentsTable,
+			Columns: []string{account.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DeferralsTable,
+			Columns: []string{account.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EmergencyContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.EmergencyContactsTable,
+			Columns: []string{account.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationPreferenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   account.NotificationPreferenceTable,
+			Columns: []string{account.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CertificatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.CertificatesTable,
+			Columns: []string{account.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RoleAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.RoleAssignmentsTable,
+			Columns: []string{account.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HospitalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   account.HospitalTable,
+			Columns: []string{account.HospitalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hospital.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.hospital_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
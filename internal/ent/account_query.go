@@ -14,25 +14,41 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
 	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/certificate"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/notificationpreference"
 	"github.com/sembraniteam/setetes/internal/ent/otp"
 	"github.com/sembraniteam/setetes/internal/ent/password"
 	"github.com/sembraniteam/setetes/internal/ent/predicate"
 	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/roleassignment"
 )
 
 // AccountQuery is the builder for querying Account entities.
 type AccountQuery struct {
 	config
-	ctx           *QueryContext
-	order         []account.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Account
-	withBloodType *BloodTypeQuery
-	withPassword  *PasswordQuery
-	withOtp       *OTPQuery
-	withRole      *RoleQuery
-	withFKs       bool
+	ctx                        *QueryContext
+	order                      []account.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.Account
+	withBloodType              *BloodTypeQuery
+	withPassword               *PasswordQuery
+	withOtp                    *OTPQuery
+	withRole                   *RoleQuery
+	withDonations              *DonationQuery
+	withAppointments           *AppointmentQuery
+	withDeferrals              *DeferralQuery
+	withEmergencyContacts      *EmergencyContactQuery
+	withNotificationPreference *NotificationPreferenceQuery
+	withCertificates           *CertificateQuery
+	withRoleAssignments        *RoleAssignmentQuery
+	withHospital               *HospitalQuery
+	withFKs                    bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -157,6 +173,182 @@ func (_q *AccountQuery) QueryRole() *RoleQuery {
 	return query
 }
 
+// QueryDonations chains the current query on the "donations" edge.
+func (_q *AccountQuery) QueryDonations() *DonationQuery {
+	query := (&DonationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(donation.Table, donation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.DonationsTable, account.DonationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAppointments chains the current query on the "appointments" edge.
+func (_q *AccountQuery) QueryAppointments() *AppointmentQuery {
+	query := (&AppointmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(appointment.Table, appointment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.AppointmentsTable, account.AppointmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeferrals chains the current query on the "deferrals" edge.
+func (_q *AccountQuery) QueryDeferrals() *DeferralQuery {
+	query := (&DeferralClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(deferral.Table, deferral.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.DeferralsTable, account.DeferralsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEmergencyContacts chains the current query on the "emergency_contacts" edge.
+func (_q *AccountQuery) QueryEmergencyContacts() *EmergencyContactQuery {
+	query := (&EmergencyContactClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(emergencycontact.Table, emergencycontact.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.EmergencyContactsTable, account.EmergencyContactsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationPreference chains the current query on the "notification_preference" edge.
+func (_q *AccountQuery) QueryNotificationPreference() *NotificationPreferenceQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(notificationpreference.Table, notificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, account.NotificationPreferenceTable, account.NotificationPreferenceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCertificates chains the current query on the "certificates" edge.
+func (_q *AccountQuery) QueryCertificates() *CertificateQuery {
+	query := (&CertificateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(certificate.Table, certificate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.CertificatesTable, account.CertificatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRoleAssignments chains the current query on the "role_assignments" edge.
+func (_q *AccountQuery) QueryRoleAssignments() *RoleAssignmentQuery {
+	query := (&RoleAssignmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(roleassignment.Table, roleassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.RoleAssignmentsTable, account.RoleAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHospital chains the current query on the "hospital" edge.
+func (_q *AccountQuery) QueryHospital() *HospitalQuery {
+	query := (&HospitalClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(hospital.Table, hospital.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, account.HospitalTable, account.HospitalColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Account entity from the query.
 // Returns a *NotFoundError when no Account was found.
 func (_q *AccountQuery) First(ctx context.Context) (*Account, error) {
@@ -344,15 +536,23 @@ func (_q *AccountQuery) Clone() *AccountQuery {
 		return nil
 	}
 	return &AccountQuery{
-		config:        _q.config,
-		ctx:           _q.ctx.Clone(),
-		order:         append([]account.OrderOption{}, _q.order...),
-		inters:        append([]Interceptor{}, _q.inters...),
-		predicates:    append([]predicate.Account{}, _q.predicates...),
-		withBloodType: _q.withBloodType.Clone(),
-		withPassword:  _q.withPassword.Clone(),
-		withOtp:       _q.withOtp.Clone(),
-		withRole:      _q.withRole.Clone(),
+		config:                     _q.config,
+		ctx:                        _q.ctx.Clone(),
+		order:                      append([]account.OrderOption{}, _q.order...),
+		inters:                     append([]Interceptor{}, _q.inters...),
+		predicates:                 append([]predicate.Account{}, _q.predicates...),
+		withBloodType:              _q.withBloodType.Clone(),
+		withPassword:               _q.withPassword.Clone(),
+		withOtp:                    _q.withOtp.Clone(),
+		withRole:                   _q.withRole.Clone(),
+		withDonations:              _q.withDonations.Clone(),
+		withAppointments:           _q.withAppointments.Clone(),
+		withDeferrals:              _q.withDeferrals.Clone(),
+		withEmergencyContacts:      _q.withEmergencyContacts.Clone(),
+		withNotificationPreference: _q.withNotificationPreference.Clone(),
+		withCertificates:           _q.withCertificates.Clone(),
+		withRoleAssignments:        _q.withRoleAssignments.Clone(),
+		withHospital:               _q.withHospital.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -403,6 +603,94 @@ func (_q *AccountQuery) WithRole(opts ...func(*RoleQuery)) *AccountQuery {
 	return _q
 }
 
+// WithDonations tells the query-builder to eager-load the nodes that are connected to
+// the "donations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithDonations(opts ...func(*DonationQuery)) *AccountQuery {
+	query := (&DonationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDonations = query
+	return _q
+}
+
+// WithAppointments tells the query-builder to eager-load the nodes that are connected to
+// the "appointments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithAppointments(opts ...func(*AppointmentQuery)) *AccountQuery {
+	query := (&AppointmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAppointments = query
+	return _q
+}
+
+// WithDeferrals tells the query-builder to eager-load the nodes that are connected to
+// the "deferrals" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithDeferrals(opts ...func(*DeferralQuery)) *AccountQuery {
+	query := (&DeferralClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDeferrals = query
+	return _q
+}
+
+// WithEmergencyContacts tells the query-builder to eager-load the nodes that are connected to
+// the "emergency_contacts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithEmergencyContacts(opts ...func(*EmergencyContactQuery)) *AccountQuery {
+	query := (&EmergencyContactClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEmergencyContacts = query
+	return _q
+}
+
+// WithNotificationPreference tells the query-builder to eager-load the nodes that are connected to
+// the "notification_preference" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithNotificationPreference(opts ...func(*NotificationPreferenceQuery)) *AccountQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotificationPreference = query
+	return _q
+}
+
+// WithCertificates tells the query-builder to eager-load the nodes that are connected to
+// the "certificates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithCertificates(opts ...func(*CertificateQuery)) *AccountQuery {
+	query := (&CertificateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCertificates = query
+	return _q
+}
+
+// WithRoleAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "role_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithRoleAssignments(opts ...func(*RoleAssignmentQuery)) *AccountQuery {
+	query := (&RoleAssignmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRoleAssignments = query
+	return _q
+}
+
+// WithHospital tells the query-builder to eager-load the nodes that are connected to
+// the "hospital" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithHospital(opts ...func(*HospitalQuery)) *AccountQuery {
+	query := (&HospitalClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHospital = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -482,14 +770,22 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 		nodes       = []*Account{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [12]bool{
 			_q.withBloodType != nil,
 			_q.withPassword != nil,
 			_q.withOtp != nil,
 			_q.withRole != nil,
+			_q.withDonations != nil,
+			_q.withAppointments != nil,
+			_q.withDeferrals != nil,
+			_q.withEmergencyContacts != nil,
+			_q.withNotificationPreference != nil,
+			_q.withCertificates != nil,
+			_q.withRoleAssignments != nil,
+			_q.withHospital != nil,
 		}
 	)
-	if _q.withBloodType != nil || _q.withRole != nil {
+	if _q.withBloodType != nil || _q.withRole != nil || _q.withNotificationPreference != nil || _q.withHospital != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -538,6 +834,62 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 			return nil, err
 		}
 	}
+	if query := _q.withDonations; query != nil {
+		if err := _q.loadDonations(ctx, query, nodes,
+			func(n *Account) { n.Edges.Donations = []*Donation{} },
+			func(n *Account, e *Donation) { n.Edges.Donations = append(n.Edges.Donations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAppointments; query != nil {
+		if err := _q.loadAppointments(ctx, query, nodes,
+			func(n *Account) { n.Edges.Appointments = []*Appointment{} },
+			func(n *Account, e *Appointment) { n.Edges.Appointments = append(n.Edges.Appointments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDeferrals; query != nil {
+		if err := _q.loadDeferrals(ctx, query, nodes,
+			func(n *Account) { n.Edges.Deferrals = []*Deferral{} },
+			func(n *Account, e *Deferral) { n.Edges.Deferrals = append(n.Edges.Deferrals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEmergencyContacts; query != nil {
+		if err := _q.loadEmergencyContacts(ctx, query, nodes,
+			func(n *Account) { n.Edges.EmergencyContacts = []*EmergencyContact{} },
+			func(n *Account, e *EmergencyContact) {
+				n.Edges.EmergencyContacts = append(n.Edges.EmergencyContacts, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotificationPreference; query != nil {
+		if err := _q.loadNotificationPreference(ctx, query, nodes, nil,
+			func(n *Account, e *NotificationPreference) { n.Edges.NotificationPreference = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCertificates; query != nil {
+		if err := _q.loadCertificates(ctx, query, nodes,
+			func(n *Account) { n.Edges.Certificates = []*Certificate{} },
+			func(n *Account, e *Certificate) { n.Edges.Certificates = append(n.Edges.Certificates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRoleAssignments; query != nil {
+		if err := _q.loadRoleAssignments(ctx, query, nodes,
+			func(n *Account) { n.Edges.RoleAssignments = []*RoleAssignment{} },
+			func(n *Account, e *RoleAssignment) { n.Edges.RoleAssignments = append(n.Edges.RoleAssignments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withHospital; query != nil {
+		if err := _q.loadHospital(ctx, query, nodes, nil,
+			func(n *Account, e *Hospital) { n.Edges.Hospital = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -664,6 +1016,256 @@ func (_q *AccountQuery) loadRole(ctx context.Context, query *RoleQuery, nodes []
 	}
 	return nil
 }
+func (_q *AccountQuery) loadDonations(ctx context.Context, query *DonationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Donation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Donation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.DonationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.account_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadAppointments(ctx context.Context, query *AppointmentQuery, nodes []*Account, init func(*Account), assign func(*Account, *Appointment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Appointment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.AppointmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.account_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadDeferrals(ctx context.Context, query *DeferralQuery, nodes []*Account, init func(*Account), assign func(*Account, *Deferral)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Deferral(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.DeferralsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.account_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadEmergencyContacts(ctx context.Context, query *EmergencyContactQuery, nodes []*Account, init func(*Account), assign func(*Account, *EmergencyContact)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.EmergencyContact(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.EmergencyContactsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.account_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadNotificationPreference(ctx context.Context, query *NotificationPreferenceQuery, nodes []*Account, init func(*Account), assign func(*Account, *NotificationPreference)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Account)
+	for i := range nodes {
+		if nodes[i].account_id == nil {
+			continue
+		}
+		fk := *nodes[i].account_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(notificationpreference.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *AccountQuery) loadCertificates(ctx context.Context, query *CertificateQuery, nodes []*Account, init func(*Account), assign func(*Account, *Certificate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Certificate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.CertificatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.account_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadRoleAssignments(ctx context.Context, query *RoleAssignmentQuery, nodes []*Account, init func(*Account), assign func(*Account, *RoleAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RoleAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.RoleAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.account_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadHospital(ctx context.Context, query *HospitalQuery, nodes []*Account, init func(*Account), assign func(*Account, *Hospital)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Account)
+	for i := range nodes {
+		if nodes[i].hospital_id == nil {
+			continue
+		}
+		fk := *nodes[i].hospital_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(hospital.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "hospital_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *AccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
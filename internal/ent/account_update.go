@@ -6,17 +6,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
 	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/certificate"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/notificationpreference"
 	"github.com/sembraniteam/setetes/internal/ent/otp"
 	"github.com/sembraniteam/setetes/internal/ent/password"
 	"github.com/sembraniteam/setetes/internal/ent/predicate"
 	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/roleassignment"
+	"github.com/sembraniteam/setetes/internal/ent/schema"
 )
 
 // AccountUpdate is the builder for updating Account entities.
@@ -120,6 +130,26 @@ func (_u *AccountUpdate) SetNillableFullName(v *string) *AccountUpdate {
 	return _u
 }
 
+// SetBirthDate sets the "birth_date" field.
+func (_u *AccountUpdate) SetBirthDate(v time.Time) *AccountUpdate {
+	_u.mutation.SetBirthDate(v)
+	return _u
+}
+
+// SetNillableBirthDate sets the "birth_date" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableBirthDate(v *time.Time) *AccountUpdate {
+	if v != nil {
+		_u.SetBirthDate(*v)
+	}
+	return _u
+}
+
+// ClearBirthDate clears the value of the "birth_date" field.
+func (_u *AccountUpdate) ClearBirthDate() *AccountUpdate {
+	_u.mutation.ClearBirthDate()
+	return _u
+}
+
 // SetGender sets the "gender" field.
 func (_u *AccountUpdate) SetGender(v account.Gender) *AccountUpdate {
 	_u.mutation.SetGender(v)
@@ -190,6 +220,18 @@ func (_u *AccountUpdate) SetNillablePhoneNumber(v *string) *AccountUpdate {
 	return _u
 }
 
+// SetHomeLatLng sets the "home_lat_lng" field.
+func (_u *AccountUpdate) SetHomeLatLng(v *schema.GeoPoint) *AccountUpdate {
+	_u.mutation.SetHomeLatLng(v)
+	return _u
+}
+
+// ClearHomeLatLng clears the value of the "home_lat_lng" field.
+func (_u *AccountUpdate) ClearHomeLatLng() *AccountUpdate {
+	_u.mutation.ClearHomeLatLng()
+	return _u
+}
+
 // SetActivated sets the "activated" field.
 func (_u *AccountUpdate) SetActivated(v bool) *AccountUpdate {
 	_u.mutation.SetActivated(v)
@@ -317,6 +359,134 @@ func (_u *AccountUpdate) SetRole(v *Role) *AccountUpdate {
 	return _u.SetRoleID(v.ID)
 }
 
+// AddDonationIDs adds the "donations" edge to the Donation entity by IDs.
+func (_u *AccountUpdate) AddDonationIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.AddDonationIDs(ids...)
+	return _u
+}
+
+// AddDonations adds the "donations" edges to the Donation entity.
+func (_u *AccountUpdate) AddDonations(v ...*Donation) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDonationIDs(ids...)
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by IDs.
+func (_u *AccountUpdate) AddAppointmentIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.AddAppointmentIDs(ids...)
+	return _u
+}
+
+// AddAppointments adds the "appointments" edges to the Appointment entity.
+func (_u *AccountUpdate) AddAppointments(v ...*Appointment) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAppointmentIDs(ids...)
+}
+
+// AddDeferralIDs adds the "deferrals" edge to the Deferral entity by IDs.
+func (_u *AccountUpdate) AddDeferralIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.AddDeferralIDs(ids...)
+	return _u
+}
+
+// AddDeferrals adds the "deferrals" edges to the Deferral entity.
+func (_u *AccountUpdate) AddDeferrals(v ...*Deferral) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeferralIDs(ids...)
+}
+
+// AddEmergencyContactIDs adds the "emergency_contacts" edge to the EmergencyContact entity by IDs.
+func (_u *AccountUpdate) AddEmergencyContactIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.AddEmergencyContactIDs(ids...)
+	return _u
+}
+
+// AddEmergencyContacts adds the "emergency_contacts" edges to the EmergencyContact entity.
+func (_u *AccountUpdate) AddEmergencyContacts(v ...*EmergencyContact) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEmergencyContactIDs(ids...)
+}
+
+// SetNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID.
+func (_u *AccountUpdate) SetNotificationPreferenceID(id uuid.UUID) *AccountUpdate {
+	_u.mutation.SetNotificationPreferenceID(id)
+	return _u
+}
+
+// SetNillableNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID if the given value is not nil.
+func (_u *AccountUpdate) SetNillableNotificationPreferenceID(id *uuid.UUID) *AccountUpdate {
+	if id != nil {
+		_u = _u.SetNotificationPreferenceID(*id)
+	}
+	return _u
+}
+
+// SetNotificationPreference sets the "notification_preference" edge to the NotificationPreference entity.
+func (_u *AccountUpdate) SetNotificationPreference(v *NotificationPreference) *AccountUpdate {
+	return _u.SetNotificationPreferenceID(v.ID)
+}
+
+// AddCertificateIDs adds the "certificates" edge to the Certificate entity by IDs.
+func (_u *AccountUpdate) AddCertificateIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.AddCertificateIDs(ids...)
+	return _u
+}
+
+// AddCertificates adds the "certificates" edges to the Certificate entity.
+func (_u *AccountUpdate) AddCertificates(v ...*Certificate) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCertificateIDs(ids...)
+}
+
+// AddRoleAssignmentIDs adds the "role_assignments" edge to the RoleAssignment entity by IDs.
+func (_u *AccountUpdate) AddRoleAssignmentIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.AddRoleAssignmentIDs(ids...)
+	return _u
+}
+
+// AddRoleAssignments adds the "role_assignments" edges to the RoleAssignment entity.
+func (_u *AccountUpdate) AddRoleAssignments(v ...*RoleAssignment) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleAssignmentIDs(ids...)
+}
+
+// SetHospitalID sets the "hospital" edge to the Hospital entity by ID.
+func (_u *AccountUpdate) SetHospitalID(id uuid.UUID) *AccountUpdate {
+	_u.mutation.SetHospitalID(id)
+	return _u
+}
+
+// SetNillableHospitalID sets the "hospital" edge to the Hospital entity by ID if the given value is not nil.
+func (_u *AccountUpdate) SetNillableHospitalID(id *uuid.UUID) *AccountUpdate {
+	if id != nil {
+		_u = _u.SetHospitalID(*id)
+	}
+	return _u
+}
+
+// SetHospital sets the "hospital" edge to the Hospital entity.
+func (_u *AccountUpdate) SetHospital(v *Hospital) *AccountUpdate {
+	return _u.SetHospitalID(v.ID)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_u *AccountUpdate) Mutation() *AccountMutation {
 	return _u.mutation
@@ -361,6 +531,144 @@ func (_u *AccountUpdate) ClearRole() *AccountUpdate {
 	return _u
 }
 
+// ClearDonations clears all "donations" edges to the Donation entity.
+func (_u *AccountUpdate) ClearDonations() *AccountUpdate {
+	_u.mutation.ClearDonations()
+	return _u
+}
+
+// RemoveDonationIDs removes the "donations" edge to Donation entities by IDs.
+func (_u *AccountUpdate) RemoveDonationIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.RemoveDonationIDs(ids...)
+	return _u
+}
+
+// RemoveDonations removes "donations" edges to Donation entities.
+func (_u *AccountUpdate) RemoveDonations(v ...*Donation) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDonationIDs(ids...)
+}
+
+// ClearAppointments clears all "appointments" edges to the Appointment entity.
+func (_u *AccountUpdate) ClearAppointments() *AccountUpdate {
+	_u.mutation.ClearAppointments()
+	return _u
+}
+
+// RemoveAppointmentIDs removes the "appointments" edge to Appointment entities by IDs.
+func (_u *AccountUpdate) RemoveAppointmentIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.RemoveAppointmentIDs(ids...)
+	return _u
+}
+
+// RemoveAppointments removes "appointments" edges to Appointment entities.
+func (_u *AccountUpdate) RemoveAppointments(v ...*Appointment) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAppointmentIDs(ids...)
+}
+
+// ClearDeferrals clears all "deferrals" edges to the Deferral entity.
+func (_u *AccountUpdate) ClearDeferrals() *AccountUpdate {
+	_u.mutation.ClearDeferrals()
+	return _u
+}
+
+// RemoveDeferralIDs removes the "deferrals" edge to Deferral entities by IDs.
+func (_u *AccountUpdate) RemoveDeferralIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.RemoveDeferralIDs(ids...)
+	return _u
+}
+
+// RemoveDeferrals removes "deferrals" edges to Deferral entities.
+func (_u *AccountUpdate) RemoveDeferrals(v ...*Deferral) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeferralIDs(ids...)
+}
+
+// ClearEmergencyContacts clears all "emergency_contacts" edges to the EmergencyContact entity.
+func (_u *AccountUpdate) ClearEmergencyContacts() *AccountUpdate {
+	_u.mutation.ClearEmergencyContacts()
+	return _u
+}
+
+// RemoveEmergencyContactIDs removes the "emergency_contacts" edge to EmergencyContact entities by IDs.
+func (_u *AccountUpdate) RemoveEmergencyContactIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.RemoveEmergencyContactIDs(ids...)
+	return _u
+}
+
+// RemoveEmergencyContacts removes "emergency_contacts" edges to EmergencyContact entities.
+func (_u *AccountUpdate) RemoveEmergencyContacts(v ...*EmergencyContact) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmergencyContactIDs(ids...)
+}
+
+// ClearNotificationPreference clears the "notification_preference" edge to the NotificationPreference entity.
+func (_u *AccountUpdate) ClearNotificationPreference() *AccountUpdate {
+	_u.mutation.ClearNotificationPreference()
+	return _u
+}
+
+// ClearCertificates clears all "certificates" edges to the Certificate entity.
+func (_u *AccountUpdate) ClearCertificates() *AccountUpdate {
+	_u.mutation.ClearCertificates()
+	return _u
+}
+
+// RemoveCertificateIDs removes the "certificates" edge to Certificate entities by IDs.
+func (_u *AccountUpdate) RemoveCertificateIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.RemoveCertificateIDs(ids...)
+	return _u
+}
+
+// RemoveCertificates removes "certificates" edges to Certificate entities.
+func (_u *AccountUpdate) RemoveCertificates(v ...*Certificate) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCertificateIDs(ids...)
+}
+
+// ClearRoleAssignments clears all "role_assignments" edges to the RoleAssignment entity.
+func (_u *AccountUpdate) ClearRoleAssignments() *AccountUpdate {
+	_u.mutation.ClearRoleAssignments()
+	return _u
+}
+
+// RemoveRoleAssignmentIDs removes the "role_assignments" edge to RoleAssignment entities by IDs.
+func (_u *AccountUpdate) RemoveRoleAssignmentIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.RemoveRoleAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveRoleAssignments removes "role_assignments" edges to RoleAssignment entities.
+func (_u *AccountUpdate) RemoveRoleAssignments(v ...*RoleAssignment) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleAssignmentIDs(ids...)
+}
+
+// ClearHospital clears the "hospital" edge to the Hospital entity.
+func (_u *AccountUpdate) ClearHospital() *AccountUpdate {
+	_u.mutation.ClearHospital()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AccountUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -496,6 +804,12 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.FullName(); ok {
 		_spec.SetField(account.FieldFullName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.BirthDate(); ok {
+		_spec.SetField(account.FieldBirthDate, field.TypeTime, value)
+	}
+	if _u.mutation.BirthDateCleared() {
+		_spec.ClearField(account.FieldBirthDate, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Gender(); ok {
 		_spec.SetField(account.FieldGender, field.TypeEnum, value)
 	}
@@ -511,6 +825,12 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.PhoneNumber(); ok {
 		_spec.SetField(account.FieldPhoneNumber, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.HomeLatLng(); ok {
+		_spec.SetField(account.FieldHomeLatLng, field.TypeOther, value)
+	}
+	if _u.mutation.HomeLatLngCleared() {
+		_spec.ClearField(account.FieldHomeLatLng, field.TypeOther)
+	}
 	if value, ok := _u.mutation.Activated(); ok {
 		_spec.SetField(account.FieldActivated, field.TypeBool, value)
 	}
@@ -658,74 +978,402 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{account.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.DonationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DonationsTable,
+			Columns: []string{account.DonationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// AccountUpdateOne is the builder for updating a single Account entity.
-type AccountUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *AccountMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *AccountUpdateOne) SetUpdatedAt(v int64) *AccountUpdateOne {
-	_u.mutation.ResetUpdatedAt()
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// AddUpdatedAt adds value to the "updated_at" field.
-func (_u *AccountUpdateOne) AddUpdatedAt(v int64) *AccountUpdateOne {
-	_u.mutation.AddUpdatedAt(v)
-	return _u
-}
-
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (_u *AccountUpdateOne) ClearUpdatedAt() *AccountUpdateOne {
-	_u.mutation.ClearUpdatedAt()
-	return _u
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *AccountUpdateOne) SetDeletedAt(v int64) *AccountUpdateOne {
-	_u.mutation.ResetDeletedAt()
-	_u.mutation.SetDeletedAt(v)
-	return _u
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableDeletedAt(v *int64) *AccountUpdateOne {
-	if v != nil {
-		_u.SetDeletedAt(*v)
+	if nodes := _u.mutation.RemovedDonationsIDs(); len(nodes) > 0 && !_u.mutation.DonationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DonationsTable,
+			Columns: []string{account.DonationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// AddDeletedAt adds value to the "deleted_at" field.
-func (_u *AccountUpdateOne) AddDeletedAt(v int64) *AccountUpdateOne {
-	_u.mutation.AddDeletedAt(v)
-	return _u
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *AccountUpdateOne) ClearDeletedAt() *AccountUpdateOne {
-	_u.mutation.ClearDeletedAt()
-	return _u
-}
-
-// SetNationalIDHash sets the "national_id_hash" field.
-func (_u *AccountUpdateOne) SetNationalIDHash(v string) *AccountUpdateOne {
+	if nodes := _u.mutation.DonationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DonationsTable,
+			Columns: []string{account.DonationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AppointmentsTable,
+			Columns: []string{account.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAppointmentsIDs(); len(nodes) > 0 && !_u.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AppointmentsTable,
+			Columns: []string{account.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppointmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AppointmentsTable,
+			Columns: []string{account.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DeferralsTable,
+			Columns: []string{account.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeferralsIDs(); len(nodes) > 0 && !_u.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DeferralsTable,
+			Columns: []string{account.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DeferralsTable,
+			Columns: []string{account.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EmergencyContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.EmergencyContactsTable,
+			Columns: []string{account.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEmergencyContactsIDs(); len(nodes) > 0 && !_u.mutation.EmergencyContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.EmergencyContactsTable,
+			Columns: []string{account.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmergencyContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.EmergencyContactsTable,
+			Columns: []string{account.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationPreferenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   account.NotificationPreferenceTable,
+			Columns: []string{account.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationPreferenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   account.NotificationPreferenceTable,
+			Columns: []string{account.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CertificatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.CertificatesTable,
+			Columns: []string{account.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCertificatesIDs(); len(nodes) > 0 && !_u.mutation.CertificatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.CertificatesTable,
+			Columns: []string{account.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CertificatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.CertificatesTable,
+			Columns: []string{account.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RoleAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.RoleAssignmentsTable,
+			Columns: []string{account.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRoleAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.RoleAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.RoleAssignmentsTable,
+			Columns: []string{account.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.RoleAssignmentsTable,
+			Columns: []string{account.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HospitalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   account.HospitalTable,
+			Columns: []string{account.HospitalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hospital.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HospitalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   account.HospitalTable,
+			Columns: []string{account.HospitalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hospital.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{account.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountUpdateOne is the builder for updating a single Account entity.
+type AccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccountMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountUpdateOne) SetUpdatedAt(v int64) *AccountUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *AccountUpdateOne) AddUpdatedAt(v int64) *AccountUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AccountUpdateOne) ClearUpdatedAt() *AccountUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AccountUpdateOne) SetDeletedAt(v int64) *AccountUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableDeletedAt(v *int64) *AccountUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *AccountUpdateOne) AddDeletedAt(v int64) *AccountUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AccountUpdateOne) ClearDeletedAt() *AccountUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetNationalIDHash sets the "national_id_hash" field.
+func (_u *AccountUpdateOne) SetNationalIDHash(v string) *AccountUpdateOne {
 	_u.mutation.SetNationalIDHash(v)
 	return _u
 }
@@ -766,6 +1414,26 @@ func (_u *AccountUpdateOne) SetNillableFullName(v *string) *AccountUpdateOne {
 	return _u
 }
 
+// SetBirthDate sets the "birth_date" field.
+func (_u *AccountUpdateOne) SetBirthDate(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetBirthDate(v)
+	return _u
+}
+
+// SetNillableBirthDate sets the "birth_date" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableBirthDate(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetBirthDate(*v)
+	}
+	return _u
+}
+
+// ClearBirthDate clears the value of the "birth_date" field.
+func (_u *AccountUpdateOne) ClearBirthDate() *AccountUpdateOne {
+	_u.mutation.ClearBirthDate()
+	return _u
+}
+
 // SetGender sets the "gender" field.
 func (_u *AccountUpdateOne) SetGender(v account.Gender) *AccountUpdateOne {
 	_u.mutation.SetGender(v)
@@ -836,6 +1504,18 @@ func (_u *AccountUpdateOne) SetNillablePhoneNumber(v *string) *AccountUpdateOne
 	return _u
 }
 
+// SetHomeLatLng sets the "home_lat_lng" field.
+func (_u *AccountUpdateOne) SetHomeLatLng(v *schema.GeoPoint) *AccountUpdateOne {
+	_u.mutation.SetHomeLatLng(v)
+	return _u
+}
+
+// ClearHomeLatLng clears the value of the "home_lat_lng" field.
+func (_u *AccountUpdateOne) ClearHomeLatLng() *AccountUpdateOne {
+	_u.mutation.ClearHomeLatLng()
+	return _u
+}
+
 // SetActivated sets the "activated" field.
 func (_u *AccountUpdateOne) SetActivated(v bool) *AccountUpdateOne {
 	_u.mutation.SetActivated(v)
@@ -963,6 +1643,134 @@ func (_u *AccountUpdateOne) SetRole(v *Role) *AccountUpdateOne {
 	return _u.SetRoleID(v.ID)
 }
 
+// AddDonationIDs adds the "donations" edge to the Donation entity by IDs.
+func (_u *AccountUpdateOne) AddDonationIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.AddDonationIDs(ids...)
+	return _u
+}
+
+// AddDonations adds the "donations" edges to the Donation entity.
+func (_u *AccountUpdateOne) AddDonations(v ...*Donation) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDonationIDs(ids...)
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by IDs.
+func (_u *AccountUpdateOne) AddAppointmentIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.AddAppointmentIDs(ids...)
+	return _u
+}
+
+// AddAppointments adds the "appointments" edges to the Appointment entity.
+func (_u *AccountUpdateOne) AddAppointments(v ...*Appointment) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAppointmentIDs(ids...)
+}
+
+// AddDeferralIDs adds the "deferrals" edge to the Deferral entity by IDs.
+func (_u *AccountUpdateOne) AddDeferralIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.AddDeferralIDs(ids...)
+	return _u
+}
+
+// AddDeferrals adds the "deferrals" edges to the Deferral entity.
+func (_u *AccountUpdateOne) AddDeferrals(v ...*Deferral) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeferralIDs(ids...)
+}
+
+// AddEmergencyContactIDs adds the "emergency_contacts" edge to the EmergencyContact entity by IDs.
+func (_u *AccountUpdateOne) AddEmergencyContactIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.AddEmergencyContactIDs(ids...)
+	return _u
+}
+
+// AddEmergencyContacts adds the "emergency_contacts" edges to the EmergencyContact entity.
+func (_u *AccountUpdateOne) AddEmergencyContacts(v ...*EmergencyContact) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEmergencyContactIDs(ids...)
+}
+
+// SetNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID.
+func (_u *AccountUpdateOne) SetNotificationPreferenceID(id uuid.UUID) *AccountUpdateOne {
+	_u.mutation.SetNotificationPreferenceID(id)
+	return _u
+}
+
+// SetNillableNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableNotificationPreferenceID(id *uuid.UUID) *AccountUpdateOne {
+	if id != nil {
+		_u = _u.SetNotificationPreferenceID(*id)
+	}
+	return _u
+}
+
+// SetNotificationPreference sets the "notification_preference" edge to the NotificationPreference entity.
+func (_u *AccountUpdateOne) SetNotificationPreference(v *NotificationPreference) *AccountUpdateOne {
+	return _u.SetNotificationPreferenceID(v.ID)
+}
+
+// AddCertificateIDs adds the "certificates" edge to the Certificate entity by IDs.
+func (_u *AccountUpdateOne) AddCertificateIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.AddCertificateIDs(ids...)
+	return _u
+}
+
+// AddCertificates adds the "certificates" edges to the Certificate entity.
+func (_u *AccountUpdateOne) AddCertificates(v ...*Certificate) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCertificateIDs(ids...)
+}
+
+// AddRoleAssignmentIDs adds the "role_assignments" edge to the RoleAssignment entity by IDs.
+func (_u *AccountUpdateOne) AddRoleAssignmentIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.AddRoleAssignmentIDs(ids...)
+	return _u
+}
+
+// AddRoleAssignments adds the "role_assignments" edges to the RoleAssignment entity.
+func (_u *AccountUpdateOne) AddRoleAssignments(v ...*RoleAssignment) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleAssignmentIDs(ids...)
+}
+
+// SetHospitalID sets the "hospital" edge to the Hospital entity by ID.
+func (_u *AccountUpdateOne) SetHospitalID(id uuid.UUID) *AccountUpdateOne {
+	_u.mutation.SetHospitalID(id)
+	return _u
+}
+
+// SetNillableHospitalID sets the "hospital" edge to the Hospital entity by ID if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableHospitalID(id *uuid.UUID) *AccountUpdateOne {
+	if id != nil {
+		_u = _u.SetHospitalID(*id)
+	}
+	return _u
+}
+
+// SetHospital sets the "hospital" edge to the Hospital entity.
+func (_u *AccountUpdateOne) SetHospital(v *Hospital) *AccountUpdateOne {
+	return _u.SetHospitalID(v.ID)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_u *AccountUpdateOne) Mutation() *AccountMutation {
 	return _u.mutation
@@ -980,30 +1788,168 @@ func (_u *AccountUpdateOne) ClearPassword() *AccountUpdateOne {
 	return _u
 }
 
-// ClearOtp clears all "otp" edges to the OTP entity.
-func (_u *AccountUpdateOne) ClearOtp() *AccountUpdateOne {
-	_u.mutation.ClearOtp()
+// ClearOtp clears all "otp" edges to the OTP entity.
+func (_u *AccountUpdateOne) ClearOtp() *AccountUpdateOne {
+	_u.mutation.ClearOtp()
+	return _u
+}
+
+// RemoveOtpIDs removes the "otp" edge to OTP entities by IDs.
+func (_u *AccountUpdateOne) RemoveOtpIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.RemoveOtpIDs(ids...)
+	return _u
+}
+
+// RemoveOtp removes "otp" edges to OTP entities.
+func (_u *AccountUpdateOne) RemoveOtp(v ...*OTP) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOtpIDs(ids...)
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (_u *AccountUpdateOne) ClearRole() *AccountUpdateOne {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// ClearDonations clears all "donations" edges to the Donation entity.
+func (_u *AccountUpdateOne) ClearDonations() *AccountUpdateOne {
+	_u.mutation.ClearDonations()
+	return _u
+}
+
+// RemoveDonationIDs removes the "donations" edge to Donation entities by IDs.
+func (_u *AccountUpdateOne) RemoveDonationIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.RemoveDonationIDs(ids...)
+	return _u
+}
+
+// RemoveDonations removes "donations" edges to Donation entities.
+func (_u *AccountUpdateOne) RemoveDonations(v ...*Donation) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDonationIDs(ids...)
+}
+
+// ClearAppointments clears all "appointments" edges to the Appointment entity.
+func (_u *AccountUpdateOne) ClearAppointments() *AccountUpdateOne {
+	_u.mutation.ClearAppointments()
+	return _u
+}
+
+// RemoveAppointmentIDs removes the "appointments" edge to Appointment entities by IDs.
+func (_u *AccountUpdateOne) RemoveAppointmentIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.RemoveAppointmentIDs(ids...)
+	return _u
+}
+
+// RemoveAppointments removes "appointments" edges to Appointment entities.
+func (_u *AccountUpdateOne) RemoveAppointments(v ...*Appointment) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAppointmentIDs(ids...)
+}
+
+// ClearDeferrals clears all "deferrals" edges to the Deferral entity.
+func (_u *AccountUpdateOne) ClearDeferrals() *AccountUpdateOne {
+	_u.mutation.ClearDeferrals()
+	return _u
+}
+
+// RemoveDeferralIDs removes the "deferrals" edge to Deferral entities by IDs.
+func (_u *AccountUpdateOne) RemoveDeferralIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.RemoveDeferralIDs(ids...)
+	return _u
+}
+
+// RemoveDeferrals removes "deferrals" edges to Deferral entities.
+func (_u *AccountUpdateOne) RemoveDeferrals(v ...*Deferral) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeferralIDs(ids...)
+}
+
+// ClearEmergencyContacts clears all "emergency_contacts" edges to the EmergencyContact entity.
+func (_u *AccountUpdateOne) ClearEmergencyContacts() *AccountUpdateOne {
+	_u.mutation.ClearEmergencyContacts()
+	return _u
+}
+
+// RemoveEmergencyContactIDs removes the "emergency_contacts" edge to EmergencyContact entities by IDs.
+func (_u *AccountUpdateOne) RemoveEmergencyContactIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.RemoveEmergencyContactIDs(ids...)
+	return _u
+}
+
+// RemoveEmergencyContacts removes "emergency_contacts" edges to EmergencyContact entities.
+func (_u *AccountUpdateOne) RemoveEmergencyContacts(v ...*EmergencyContact) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmergencyContactIDs(ids...)
+}
+
+// ClearNotificationPreference clears the "notification_preference" edge to the NotificationPreference entity.
+func (_u *AccountUpdateOne) ClearNotificationPreference() *AccountUpdateOne {
+	_u.mutation.ClearNotificationPreference()
+	return _u
+}
+
+// ClearCertificates clears all "certificates" edges to the Certificate entity.
+func (_u *AccountUpdateOne) ClearCertificates() *AccountUpdateOne {
+	_u.mutation.ClearCertificates()
+	return _u
+}
+
+// RemoveCertificateIDs removes the "certificates" edge to Certificate entities by IDs.
+func (_u *AccountUpdateOne) RemoveCertificateIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.RemoveCertificateIDs(ids...)
+	return _u
+}
+
+// RemoveCertificates removes "certificates" edges to Certificate entities.
+func (_u *AccountUpdateOne) RemoveCertificates(v ...*Certificate) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCertificateIDs(ids...)
+}
+
+// ClearRoleAssignments clears all "role_assignments" edges to the RoleAssignment entity.
+func (_u *AccountUpdateOne) ClearRoleAssignments() *AccountUpdateOne {
+	_u.mutation.ClearRoleAssignments()
 	return _u
 }
 
-// RemoveOtpIDs removes the "otp" edge to OTP entities by IDs.
-func (_u *AccountUpdateOne) RemoveOtpIDs(ids ...uuid.UUID) *AccountUpdateOne {
-	_u.mutation.RemoveOtpIDs(ids...)
+// RemoveRoleAssignmentIDs removes the "role_assignments" edge to RoleAssignment entities by IDs.
+func (_u *AccountUpdateOne) RemoveRoleAssignmentIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.RemoveRoleAssignmentIDs(ids...)
 	return _u
 }
 
-// RemoveOtp removes "otp" edges to OTP entities.
-func (_u *AccountUpdateOne) RemoveOtp(v ...*OTP) *AccountUpdateOne {
+// RemoveRoleAssignments removes "role_assignments" edges to RoleAssignment entities.
+func (_u *AccountUpdateOne) RemoveRoleAssignments(v ...*RoleAssignment) *AccountUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveOtpIDs(ids...)
+	return _u.RemoveRoleAssignmentIDs(ids...)
 }
 
-// ClearRole clears the "role" edge to the Role entity.
-func (_u *AccountUpdateOne) ClearRole() *AccountUpdateOne {
-	_u.mutation.ClearRole()
+// ClearHospital clears the "hospital" edge to the Hospital entity.
+func (_u *AccountUpdateOne) ClearHospital() *AccountUpdateOne {
+	_u.mutation.ClearHospital()
 	return _u
 }
 
@@ -1172,6 +2118,12 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 	if value, ok := _u.mutation.FullName(); ok {
 		_spec.SetField(account.FieldFullName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.BirthDate(); ok {
+		_spec.SetField(account.FieldBirthDate, field.TypeTime, value)
+	}
+	if _u.mutation.BirthDateCleared() {
+		_spec.ClearField(account.FieldBirthDate, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Gender(); ok {
 		_spec.SetField(account.FieldGender, field.TypeEnum, value)
 	}
@@ -1187,6 +2139,12 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 	if value, ok := _u.mutation.PhoneNumber(); ok {
 		_spec.SetField(account.FieldPhoneNumber, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.HomeLatLng(); ok {
+		_spec.SetField(account.FieldHomeLatLng, field.TypeOther, value)
+	}
+	if _u.mutation.HomeLatLngCleared() {
+		_spec.ClearField(account.FieldHomeLatLng, field.TypeOther)
+	}
 	if value, ok := _u.mutation.Activated(); ok {
 		_spec.SetField(account.FieldActivated, field.TypeBool, value)
 	}
@@ -1334,6 +2292,334 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DonationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DonationsTable,
+			Columns: []string{account.DonationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDonationsIDs(); len(nodes) > 0 && !_u.mutation.DonationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DonationsTable,
+			Columns: []string{account.DonationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DonationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DonationsTable,
+			Columns: []string{account.DonationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AppointmentsTable,
+			Columns: []string{account.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAppointmentsIDs(); len(nodes) > 0 && !_u.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AppointmentsTable,
+			Columns: []string{account.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppointmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AppointmentsTable,
+			Columns: []string{account.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DeferralsTable,
+			Columns: []string{account.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeferralsIDs(); len(nodes) > 0 && !_u.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DeferralsTable,
+			Columns: []string{account.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.DeferralsTable,
+			Columns: []string{account.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EmergencyContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.EmergencyContactsTable,
+			Columns: []string{account.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEmergencyContactsIDs(); len(nodes) > 0 && !_u.mutation.EmergencyContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.EmergencyContactsTable,
+			Columns: []string{account.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmergencyContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.EmergencyContactsTable,
+			Columns: []string{account.EmergencyContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationPreferenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   account.NotificationPreferenceTable,
+			Columns: []string{account.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationPreferenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   account.NotificationPreferenceTable,
+			Columns: []string{account.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CertificatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.CertificatesTable,
+			Columns: []string{account.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCertificatesIDs(); len(nodes) > 0 && !_u.mutation.CertificatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.CertificatesTable,
+			Columns: []string{account.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CertificatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.CertificatesTable,
+			Columns: []string{account.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RoleAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.RoleAssignmentsTable,
+			Columns: []string{account.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRoleAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.RoleAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.RoleAssignmentsTable,
+			Columns: []string{account.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.RoleAssignmentsTable,
+			Columns: []string{account.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HospitalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   account.HospitalTable,
+			Columns: []string{account.HospitalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hospital.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HospitalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   account.HospitalTable,
+			Columns: []string{account.HospitalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hospital.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Account{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/apikey"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+)
+
+// APIKey is the model entity for the APIKey schema.
+type APIKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// Public part of the key used to look it up, such as stk_<prefix>_<secret>.
+	Prefix string `json:"prefix"`
+	// SHA256 hash of the secret part of the key.
+	SecretHash string `json:"-"`
+	// Keys of the permissions the key may use.
+	Scopes []string `json:"scopes"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt int64 `json:"expires_at"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *int64 `json:"last_used_at"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *int64 `json:"revoked_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the APIKeyQuery when eager-loading is set.
+	Edges        APIKeyEdges `json:"edges"`
+	account_id   *uuid.UUID
+	hospital_id  *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// APIKeyEdges holds the relations/edges for other nodes in the graph.
+type APIKeyEdges struct {
+	// Account the key acts as.
+	Account *Account `json:"account,omitempty"`
+	// Organization the key is issued for.
+	Hospital *Hospital `json:"hospital,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e APIKeyEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// HospitalOrErr returns the Hospital value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e APIKeyEdges) HospitalOrErr() (*Hospital, error) {
+	if e.Hospital != nil {
+		return e.Hospital, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: hospital.Label}
+	}
+	return nil, &NotLoadedError{edge: "hospital"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*APIKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case apikey.FieldScopes:
+			values[i] = new([]byte)
+		case apikey.FieldCreatedAt, apikey.FieldUpdatedAt, apikey.FieldDeletedAt, apikey.FieldExpiresAt, apikey.FieldLastUsedAt, apikey.FieldRevokedAt:
+			values[i] = new(sql.NullInt64)
+		case apikey.FieldName, apikey.FieldPrefix, apikey.FieldSecretHash:
+			values[i] = new(sql.NullString)
+		case apikey.FieldID:
+			values[i] = new(uuid.UUID)
+		case apikey.ForeignKeys[0]: // account_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case apikey.ForeignKeys[1]: // hospital_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the APIKey fields.
+func (_m *APIKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case apikey.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case apikey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case apikey.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case apikey.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case apikey.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case apikey.FieldPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefix", values[i])
+			} else if value.Valid {
+				_m.Prefix = value.String
+			}
+		case apikey.FieldSecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_hash", values[i])
+			} else if value.Valid {
+				_m.SecretHash = value.String
+			}
+		case apikey.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case apikey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Int64
+			}
+		case apikey.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = new(int64)
+				*_m.LastUsedAt = value.Int64
+			}
+		case apikey.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(int64)
+				*_m.RevokedAt = value.Int64
+			}
+		case apikey.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.account_id = new(uuid.UUID)
+				*_m.account_id = *value.S.(*uuid.UUID)
+			}
+		case apikey.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field hospital_id", values[i])
+			} else if value.Valid {
+				_m.hospital_id = new(uuid.UUID)
+				*_m.hospital_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the APIKey.
+// This includes values selected through modifiers, order, etc.
+func (_m *APIKey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the APIKey entity.
+func (_m *APIKey) QueryAccount() *AccountQuery {
+	return NewAPIKeyClient(_m.config).QueryAccount(_m)
+}
+
+// QueryHospital queries the "hospital" edge of the APIKey entity.
+func (_m *APIKey) QueryHospital() *HospitalQuery {
+	return NewAPIKeyClient(_m.config).QueryHospital(_m)
+}
+
+// Update returns a builder for updating this APIKey.
+// Note that you need to call APIKey.Unwrap() before calling this method if this APIKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *APIKey) Update() *APIKeyUpdateOne {
+	return NewAPIKeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the APIKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *APIKey) Unwrap() *APIKey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: APIKey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *APIKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("APIKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("prefix=")
+	builder.WriteString(_m.Prefix)
+	builder.WriteString(", ")
+	builder.WriteString("secret_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpiresAt))
+	builder.WriteString(", ")
+	if v := _m.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// APIKeys is a parsable slice of APIKey.
+type APIKeys []*APIKey
@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/apikey"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+)
+
+// APIKeyCreate is the builder for creating a APIKey entity.
+type APIKeyCreate struct {
+	config
+	mutation *APIKeyMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *APIKeyCreate) SetCreatedAt(v int64) *APIKeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *APIKeyCreate) SetUpdatedAt(v int64) *APIKeyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableUpdatedAt(v *int64) *APIKeyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *APIKeyCreate) SetDeletedAt(v int64) *APIKeyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableDeletedAt(v *int64) *APIKeyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *APIKeyCreate) SetName(v string) *APIKeyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPrefix sets the "prefix" field.
+func (_c *APIKeyCreate) SetPrefix(v string) *APIKeyCreate {
+	_c.mutation.SetPrefix(v)
+	return _c
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (_c *APIKeyCreate) SetSecretHash(v string) *APIKeyCreate {
+	_c.mutation.SetSecretHash(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *APIKeyCreate) SetScopes(v []string) *APIKeyCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *APIKeyCreate) SetExpiresAt(v int64) *APIKeyCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *APIKeyCreate) SetLastUsedAt(v int64) *APIKeyCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableLastUsedAt(v *int64) *APIKeyCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *APIKeyCreate) SetRevokedAt(v int64) *APIKeyCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableRevokedAt(v *int64) *APIKeyCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *APIKeyCreate) SetID(v uuid.UUID) *APIKeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *APIKeyCreate) SetAccountID(id uuid.UUID) *APIKeyCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *APIKeyCreate) SetAccount(v *Account) *APIKeyCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetHospitalID sets the "hospital" edge to the Hospital entity by ID.
+func (_c *APIKeyCreate) SetHospitalID(id uuid.UUID) *APIKeyCreate {
+	_c.mutation.SetHospitalID(id)
+	return _c
+}
+
+// SetHospital sets the "hospital" edge to the Hospital entity.
+func (_c *APIKeyCreate) SetHospital(v *Hospital) *APIKeyCreate {
+	return _c.SetHospitalID(v.ID)
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (_c *APIKeyCreate) Mutation() *APIKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the APIKey in the database.
+func (_c *APIKeyCreate) Save(ctx context.Context) (*APIKey, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *APIKeyCreate) SaveX(ctx context.Context) *APIKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *APIKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *APIKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *APIKeyCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := apikey.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "APIKey.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := apikey.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "APIKey.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := apikey.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "APIKey.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "APIKey.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New(`ent: missing required field "APIKey.prefix"`)}
+	}
+	if v, ok := _c.mutation.Prefix(); ok {
+		if err := apikey.PrefixValidator(v); err != nil {
+			return &ValidationError{Name: "prefix", err: fmt.Errorf(`ent: validator failed for field "APIKey.prefix": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SecretHash(); !ok {
+		return &ValidationError{Name: "secret_hash", err: errors.New(`ent: missing required field "APIKey.secret_hash"`)}
+	}
+	if v, ok := _c.mutation.SecretHash(); ok {
+		if err := apikey.SecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "secret_hash", err: fmt.Errorf(`ent: validator failed for field "APIKey.secret_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "APIKey.scopes"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "APIKey.expires_at"`)}
+	}
+	if v, ok := _c.mutation.ExpiresAt(); ok {
+		if err := apikey.ExpiresAtValidator(v); err != nil {
+			return &ValidationError{Name: "expires_at", err: fmt.Errorf(`ent: validator failed for field "APIKey.expires_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastUsedAt(); ok {
+		if err := apikey.LastUsedAtValidator(v); err != nil {
+			return &ValidationError{Name: "last_used_at", err: fmt.Errorf(`ent: validator failed for field "APIKey.last_used_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RevokedAt(); ok {
+		if err := apikey.RevokedAtValidator(v); err != nil {
+			return &ValidationError{Name: "revoked_at", err: fmt.Errorf(`ent: validator failed for field "APIKey.revoked_at": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "APIKey.account"`)}
+	}
+	if len(_c.mutation.HospitalIDs()) == 0 {
+		return &ValidationError{Name: "hospital", err: errors.New(`ent: missing required edge "APIKey.hospital"`)}
+	}
+	return nil
+}
+
+func (_c *APIKeyCreate) sqlSave(ctx context.Context) (*APIKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APIKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(apikey.Table, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(apikey.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(apikey.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(apikey.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Prefix(); ok {
+		_spec.SetField(apikey.FieldPrefix, field.TypeString, value)
+		_node.Prefix = value
+	}
+	if value, ok := _c.mutation.SecretHash(); ok {
+		_spec.SetField(apikey.FieldSecretHash, field.TypeString, value)
+		_node.SecretHash = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeInt64, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeInt64, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(apikey.FieldRevokedAt, field.TypeInt64, value)
+		_node.RevokedAt = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   apikey.AccountTable,
+			Columns: []string{apikey.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HospitalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   apikey.HospitalTable,
+			Columns: []string{apikey.HospitalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hospital.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.hospital_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// APIKeyCreateBulk is the builder for creating many APIKey entities in bulk.
+type APIKeyCreateBulk struct {
+	config
+	err      error
+	builders []*APIKeyCreate
+}
+
+// Save creates the APIKey entities in the database.
+func (_c *APIKeyCreateBulk) Save(ctx context.Context) ([]*APIKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*APIKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APIKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *APIKeyCreateBulk) SaveX(ctx context.Context) []*APIKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *APIKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *APIKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+)
+
+// Appointment is the model entity for the Appointment schema.
+type Appointment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Scheduled donation time in milliseconds.
+	ScheduledAt int64 `json:"scheduled_at"`
+	// Status holds the value of the "status" field.
+	Status appointment.Status `json:"status"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AppointmentQuery when eager-loading is set.
+	Edges           AppointmentEdges `json:"edges"`
+	account_id      *uuid.UUID
+	pmi_location_id *uuid.UUID
+	appointment_id  *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// AppointmentEdges holds the relations/edges for other nodes in the graph.
+type AppointmentEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// PmiLocation holds the value of the pmi_location edge.
+	PmiLocation *PMILocation `json:"pmi_location,omitempty"`
+	// Donation holds the value of the donation edge.
+	Donation *Donation `json:"donation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AppointmentEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// PmiLocationOrErr returns the PmiLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AppointmentEdges) PmiLocationOrErr() (*PMILocation, error) {
+	if e.PmiLocation != nil {
+		return e.PmiLocation, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: pmilocation.Label}
+	}
+	return nil, &NotLoadedError{edge: "pmi_location"}
+}
+
+// DonationOrErr returns the Donation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AppointmentEdges) DonationOrErr() (*Donation, error) {
+	if e.Donation != nil {
+		return e.Donation, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: donation.Label}
+	}
+	return nil, &NotLoadedError{edge: "donation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Appointment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case appointment.FieldCreatedAt, appointment.FieldUpdatedAt, appointment.FieldDeletedAt, appointment.FieldScheduledAt:
+			values[i] = new(sql.NullInt64)
+		case appointment.FieldStatus:
+			values[i] = new(sql.NullString)
+		case appointment.FieldID:
+			values[i] = new(uuid.UUID)
+		case appointment.ForeignKeys[0]: // account_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case appointment.ForeignKeys[1]: // pmi_location_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case appointment.ForeignKeys[2]: // appointment_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Appointment fields.
+func (_m *Appointment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case appointment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case appointment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case appointment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case appointment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case appointment.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				_m.ScheduledAt = value.Int64
+			}
+		case appointment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = appointment.Status(value.String)
+			}
+		case appointment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.account_id = new(uuid.UUID)
+				*_m.account_id = *value.S.(*uuid.UUID)
+			}
+		case appointment.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field pmi_location_id", values[i])
+			} else if value.Valid {
+				_m.pmi_location_id = new(uuid.UUID)
+				*_m.pmi_location_id = *value.S.(*uuid.UUID)
+			}
+		case appointment.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field appointment_id", values[i])
+			} else if value.Valid {
+				_m.appointment_id = new(uuid.UUID)
+				*_m.appointment_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Appointment.
+// This includes values selected through modifiers, order, etc.
+func (_m *Appointment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Appointment entity.
+func (_m *Appointment) QueryAccount() *AccountQuery {
+	return NewAppointmentClient(_m.config).QueryAccount(_m)
+}
+
+// QueryPmiLocation queries the "pmi_location" edge of the Appointment entity.
+func (_m *Appointment) QueryPmiLocation() *PMILocationQuery {
+	return NewAppointmentClient(_m.config).QueryPmiLocation(_m)
+}
+
+// QueryDonation queries the "donation" edge of the Appointment entity.
+func (_m *Appointment) QueryDonation() *DonationQuery {
+	return NewAppointmentClient(_m.config).QueryDonation(_m)
+}
+
+// Update returns a builder for updating this Appointment.
+// Note that you need to call Appointment.Unwrap() before calling this method if this Appointment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Appointment) Update() *AppointmentUpdateOne {
+	return NewAppointmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Appointment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Appointment) Unwrap() *Appointment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Appointment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Appointment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Appointment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScheduledAt))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Appointments is a parsable slice of Appointment.
+type Appointments []*Appointment
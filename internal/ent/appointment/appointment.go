@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package appointment
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the appointment type in the database.
+	Label = "appointment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldScheduledAt holds the string denoting the scheduled_at field in the database.
+	FieldScheduledAt = "scheduled_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgePmiLocation holds the string denoting the pmi_location edge name in mutations.
+	EdgePmiLocation = "pmi_location"
+	// EdgeDonation holds the string denoting the donation edge name in mutations.
+	EdgeDonation = "donation"
+	// Table holds the table name of the appointment in the database.
+	Table = "appointments"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "appointments"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// PmiLocationTable is the table that holds the pmi_location relation/edge.
+	PmiLocationTable = "appointments"
+	// PmiLocationInverseTable is the table name for the PMILocation entity.
+	// It exists in this package in order to avoid circular dependency with the "pmilocation" package.
+	PmiLocationInverseTable = "pmi_locations"
+	// PmiLocationColumn is the table column denoting the pmi_location relation/edge.
+	PmiLocationColumn = "pmi_location_id"
+	// DonationTable is the table that holds the donation relation/edge.
+	DonationTable = "appointments"
+	// DonationInverseTable is the table name for the Donation entity.
+	// It exists in this package in order to avoid circular dependency with the "donation" package.
+	DonationInverseTable = "donations"
+	// DonationColumn is the table column denoting the donation relation/edge.
+	DonationColumn = "appointment_id"
+)
+
+// Columns holds all SQL columns for appointment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldScheduledAt,
+	FieldStatus,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "appointments"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"account_id",
+	"pmi_location_id",
+	"appointment_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// ScheduledAtValidator is a validator for the "scheduled_at" field. It is called by the builders before save.
+	ScheduledAtValidator func(int64) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusBooked is the default value of the Status enum.
+const DefaultStatus = StatusBooked
+
+// Status values.
+const (
+	StatusBooked    Status = "BOOKED"
+	StatusAttended  Status = "ATTENDED"
+	StatusCancelled Status = "CANCELLED"
+	StatusNoShow    Status = "NO_SHOW"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusBooked, StatusAttended, StatusCancelled, StatusNoShow:
+		return nil
+	default:
+		return fmt.Errorf("appointment: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Appointment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByScheduledAt orders the results by the scheduled_at field.
+func ByScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPmiLocationField orders the results by pmi_location field.
+func ByPmiLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPmiLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDonationField orders the results by donation field.
+func ByDonationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDonationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+	)
+}
+func newPmiLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PmiLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+	)
+}
+func newDonationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DonationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, DonationTable, DonationColumn),
+	)
+}
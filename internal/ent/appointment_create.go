@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+)
+
+// AppointmentCreate is the builder for creating a Appointment entity.
+type AppointmentCreate struct {
+	config
+	mutation *AppointmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AppointmentCreate) SetCreatedAt(v int64) *AppointmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AppointmentCreate) SetUpdatedAt(v int64) *AppointmentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AppointmentCreate) SetNillableUpdatedAt(v *int64) *AppointmentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AppointmentCreate) SetDeletedAt(v int64) *AppointmentCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AppointmentCreate) SetNillableDeletedAt(v *int64) *AppointmentCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (_c *AppointmentCreate) SetScheduledAt(v int64) *AppointmentCreate {
+	_c.mutation.SetScheduledAt(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *AppointmentCreate) SetStatus(v appointment.Status) *AppointmentCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *AppointmentCreate) SetNillableStatus(v *appointment.Status) *AppointmentCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AppointmentCreate) SetID(v uuid.UUID) *AppointmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *AppointmentCreate) SetAccountID(id uuid.UUID) *AppointmentCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *AppointmentCreate) SetAccount(v *Account) *AppointmentCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_c *AppointmentCreate) SetPmiLocationID(id uuid.UUID) *AppointmentCreate {
+	_c.mutation.SetPmiLocationID(id)
+	return _c
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_c *AppointmentCreate) SetPmiLocation(v *PMILocation) *AppointmentCreate {
+	return _c.SetPmiLocationID(v.ID)
+}
+
+// SetDonationID sets the "donation" edge to the Donation entity by ID.
+func (_c *AppointmentCreate) SetDonationID(id uuid.UUID) *AppointmentCreate {
+	_c.mutation.SetDonationID(id)
+	return _c
+}
+
+// SetNillableDonationID sets the "donation" edge to the Donation entity by ID if the given value is not nil.
+func (_c *AppointmentCreate) SetNillableDonationID(id *uuid.UUID) *AppointmentCreate {
+	if id != nil {
+		_c = _c.SetDonationID(*id)
+	}
+	return _c
+}
+
+// SetDonation sets the "donation" edge to the Donation entity.
+func (_c *AppointmentCreate) SetDonation(v *Donation) *AppointmentCreate {
+	return _c.SetDonationID(v.ID)
+}
+
+// Mutation returns the AppointmentMutation object of the builder.
+func (_c *AppointmentCreate) Mutation() *AppointmentMutation {
+	return _c.mutation
+}
+
+// Save creates the Appointment in the database.
+func (_c *AppointmentCreate) Save(ctx context.Context) (*Appointment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AppointmentCreate) SaveX(ctx context.Context) *Appointment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AppointmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AppointmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AppointmentCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := appointment.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AppointmentCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := appointment.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "Appointment.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := appointment.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Appointment.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := appointment.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Appointment.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScheduledAt(); !ok {
+		return &ValidationError{Name: "scheduled_at", err: errors.New(`ent: missing required field "Appointment.scheduled_at"`)}
+	}
+	if v, ok := _c.mutation.ScheduledAt(); ok {
+		if err := appointment.ScheduledAtValidator(v); err != nil {
+			return &ValidationError{Name: "scheduled_at", err: fmt.Errorf(`ent: validator failed for field "Appointment.scheduled_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Appointment.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := appointment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Appointment.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Appointment.account"`)}
+	}
+	if len(_c.mutation.PmiLocationIDs()) == 0 {
+		return &ValidationError{Name: "pmi_location", err: errors.New(`ent: missing required edge "Appointment.pmi_location"`)}
+	}
+	return nil
+}
+
+func (_c *AppointmentCreate) sqlSave(ctx context.Context) (*Appointment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AppointmentCreate) createSpec() (*Appointment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Appointment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(appointment.Table, sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(appointment.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(appointment.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(appointment.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.ScheduledAt(); ok {
+		_spec.SetField(appointment.FieldScheduledAt, field.TypeInt64, value)
+		_node.ScheduledAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(appointment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   appointment.AccountTable,
+			Columns: []string{appointment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   appointment.PmiLocationTable,
+			Columns: []string{appointment.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pmi_location_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DonationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   appointment.DonationTable,
+			Columns: []string{appointment.DonationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.appointment_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AppointmentCreateBulk is the builder for creating many Appointment entities in bulk.
+type AppointmentCreateBulk struct {
+	config
+	err      error
+	builders []*AppointmentCreate
+}
+
+// Save creates the Appointment entities in the database.
+func (_c *AppointmentCreateBulk) Save(ctx context.Context) ([]*Appointment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Appointment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AppointmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AppointmentCreateBulk) SaveX(ctx context.Context) []*Appointment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AppointmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AppointmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
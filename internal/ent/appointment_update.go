@@ -0,0 +1,773 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// AppointmentUpdate is the builder for updating Appointment entities.
+type AppointmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AppointmentMutation
+}
+
+// Where appends a list predicates to the AppointmentUpdate builder.
+func (_u *AppointmentUpdate) Where(ps ...predicate.Appointment) *AppointmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AppointmentUpdate) SetUpdatedAt(v int64) *AppointmentUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *AppointmentUpdate) AddUpdatedAt(v int64) *AppointmentUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AppointmentUpdate) ClearUpdatedAt() *AppointmentUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AppointmentUpdate) SetDeletedAt(v int64) *AppointmentUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AppointmentUpdate) SetNillableDeletedAt(v *int64) *AppointmentUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *AppointmentUpdate) AddDeletedAt(v int64) *AppointmentUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AppointmentUpdate) ClearDeletedAt() *AppointmentUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (_u *AppointmentUpdate) SetScheduledAt(v int64) *AppointmentUpdate {
+	_u.mutation.ResetScheduledAt()
+	_u.mutation.SetScheduledAt(v)
+	return _u
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (_u *AppointmentUpdate) SetNillableScheduledAt(v *int64) *AppointmentUpdate {
+	if v != nil {
+		_u.SetScheduledAt(*v)
+	}
+	return _u
+}
+
+// AddScheduledAt adds value to the "scheduled_at" field.
+func (_u *AppointmentUpdate) AddScheduledAt(v int64) *AppointmentUpdate {
+	_u.mutation.AddScheduledAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AppointmentUpdate) SetStatus(v appointment.Status) *AppointmentUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AppointmentUpdate) SetNillableStatus(v *appointment.Status) *AppointmentUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *AppointmentUpdate) SetAccountID(id uuid.UUID) *AppointmentUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AppointmentUpdate) SetAccount(v *Account) *AppointmentUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_u *AppointmentUpdate) SetPmiLocationID(id uuid.UUID) *AppointmentUpdate {
+	_u.mutation.SetPmiLocationID(id)
+	return _u
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_u *AppointmentUpdate) SetPmiLocation(v *PMILocation) *AppointmentUpdate {
+	return _u.SetPmiLocationID(v.ID)
+}
+
+// SetDonationID sets the "donation" edge to the Donation entity by ID.
+func (_u *AppointmentUpdate) SetDonationID(id uuid.UUID) *AppointmentUpdate {
+	_u.mutation.SetDonationID(id)
+	return _u
+}
+
+// SetNillableDonationID sets the "donation" edge to the Donation entity by ID if the given value is not nil.
+func (_u *AppointmentUpdate) SetNillableDonationID(id *uuid.UUID) *AppointmentUpdate {
+	if id != nil {
+		_u = _u.SetDonationID(*id)
+	}
+	return _u
+}
+
+// SetDonation sets the "donation" edge to the Donation entity.
+func (_u *AppointmentUpdate) SetDonation(v *Donation) *AppointmentUpdate {
+	return _u.SetDonationID(v.ID)
+}
+
+// Mutation returns the AppointmentMutation object of the builder.
+func (_u *AppointmentUpdate) Mutation() *AppointmentMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AppointmentUpdate) ClearAccount() *AppointmentUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearPmiLocation clears the "pmi_location" edge to the PMILocation entity.
+func (_u *AppointmentUpdate) ClearPmiLocation() *AppointmentUpdate {
+	_u.mutation.ClearPmiLocation()
+	return _u
+}
+
+// ClearDonation clears the "donation" edge to the Donation entity.
+func (_u *AppointmentUpdate) ClearDonation() *AppointmentUpdate {
+	_u.mutation.ClearDonation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AppointmentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AppointmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AppointmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AppointmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AppointmentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := appointment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AppointmentUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := appointment.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Appointment.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := appointment.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Appointment.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduledAt(); ok {
+		if err := appointment.ScheduledAtValidator(v); err != nil {
+			return &ValidationError{Name: "scheduled_at", err: fmt.Errorf(`ent: validator failed for field "Appointment.scheduled_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := appointment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Appointment.status": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Appointment.account"`)
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Appointment.pmi_location"`)
+	}
+	return nil
+}
+
+func (_u *AppointmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(appointment.Table, appointment.Columns, sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(appointment.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(appointment.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(appointment.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(appointment.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(appointment.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(appointment.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ScheduledAt(); ok {
+		_spec.SetField(appointment.FieldScheduledAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedScheduledAt(); ok {
+		_spec.AddField(appointment.FieldScheduledAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(appointment.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   appointment.AccountTable,
+			Columns: []string{appointment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   appointment.AccountTable,
+			Columns: []string{appointment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PmiLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   appointment.PmiLocationTable,
+			Columns: []string{appointment.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   appointment.PmiLocationTable,
+			Columns: []string{appointment.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DonationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   appointment.DonationTable,
+			Columns: []string{appointment.DonationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DonationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   appointment.DonationTable,
+			Columns: []string{appointment.DonationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{appointment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AppointmentUpdateOne is the builder for updating a single Appointment entity.
+type AppointmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AppointmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AppointmentUpdateOne) SetUpdatedAt(v int64) *AppointmentUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *AppointmentUpdateOne) AddUpdatedAt(v int64) *AppointmentUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AppointmentUpdateOne) ClearUpdatedAt() *AppointmentUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AppointmentUpdateOne) SetDeletedAt(v int64) *AppointmentUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AppointmentUpdateOne) SetNillableDeletedAt(v *int64) *AppointmentUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *AppointmentUpdateOne) AddDeletedAt(v int64) *AppointmentUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AppointmentUpdateOne) ClearDeletedAt() *AppointmentUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (_u *AppointmentUpdateOne) SetScheduledAt(v int64) *AppointmentUpdateOne {
+	_u.mutation.ResetScheduledAt()
+	_u.mutation.SetScheduledAt(v)
+	return _u
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (_u *AppointmentUpdateOne) SetNillableScheduledAt(v *int64) *AppointmentUpdateOne {
+	if v != nil {
+		_u.SetScheduledAt(*v)
+	}
+	return _u
+}
+
+// AddScheduledAt adds value to the "scheduled_at" field.
+func (_u *AppointmentUpdateOne) AddScheduledAt(v int64) *AppointmentUpdateOne {
+	_u.mutation.AddScheduledAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AppointmentUpdateOne) SetStatus(v appointment.Status) *AppointmentUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AppointmentUpdateOne) SetNillableStatus(v *appointment.Status) *AppointmentUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *AppointmentUpdateOne) SetAccountID(id uuid.UUID) *AppointmentUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AppointmentUpdateOne) SetAccount(v *Account) *AppointmentUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_u *AppointmentUpdateOne) SetPmiLocationID(id uuid.UUID) *AppointmentUpdateOne {
+	_u.mutation.SetPmiLocationID(id)
+	return _u
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_u *AppointmentUpdateOne) SetPmiLocation(v *PMILocation) *AppointmentUpdateOne {
+	return _u.SetPmiLocationID(v.ID)
+}
+
+// SetDonationID sets the "donation" edge to the Donation entity by ID.
+func (_u *AppointmentUpdateOne) SetDonationID(id uuid.UUID) *AppointmentUpdateOne {
+	_u.mutation.SetDonationID(id)
+	return _u
+}
+
+// SetNillableDonationID sets the "donation" edge to the Donation entity by ID if the given value is not nil.
+func (_u *AppointmentUpdateOne) SetNillableDonationID(id *uuid.UUID) *AppointmentUpdateOne {
+	if id != nil {
+		_u = _u.SetDonationID(*id)
+	}
+	return _u
+}
+
+// SetDonation sets the "donation" edge to the Donation entity.
+func (_u *AppointmentUpdateOne) SetDonation(v *Donation) *AppointmentUpdateOne {
+	return _u.SetDonationID(v.ID)
+}
+
+// Mutation returns the AppointmentMutation object of the builder.
+func (_u *AppointmentUpdateOne) Mutation() *AppointmentMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AppointmentUpdateOne) ClearAccount() *AppointmentUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearPmiLocation clears the "pmi_location" edge to the PMILocation entity.
+func (_u *AppointmentUpdateOne) ClearPmiLocation() *AppointmentUpdateOne {
+	_u.mutation.ClearPmiLocation()
+	return _u
+}
+
+// ClearDonation clears the "donation" edge to the Donation entity.
+func (_u *AppointmentUpdateOne) ClearDonation() *AppointmentUpdateOne {
+	_u.mutation.ClearDonation()
+	return _u
+}
+
+// Where appends a list predicates to the AppointmentUpdate builder.
+func (_u *AppointmentUpdateOne) Where(ps ...predicate.Appointment) *AppointmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AppointmentUpdateOne) Select(field string, fields ...string) *AppointmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Appointment entity.
+func (_u *AppointmentUpdateOne) Save(ctx context.Context) (*Appointment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AppointmentUpdateOne) SaveX(ctx context.Context) *Appointment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AppointmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AppointmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AppointmentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := appointment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AppointmentUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := appointment.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Appointment.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := appointment.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Appointment.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduledAt(); ok {
+		if err := appointment.ScheduledAtValidator(v); err != nil {
+			return &ValidationError{Name: "scheduled_at", err: fmt.Errorf(`ent: validator failed for field "Appointment.scheduled_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := appointment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Appointment.status": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Appointment.account"`)
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Appointment.pmi_location"`)
+	}
+	return nil
+}
+
+func (_u *AppointmentUpdateOne) sqlSave(ctx context.Context) (_node *Appointment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(appointment.Table, appointment.Columns, sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Appointment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, appointment.FieldID)
+		for _, f := range fields {
+			if !appointment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != appointment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(appointment.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(appointment.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(appointment.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(appointment.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(appointment.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(appointment.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ScheduledAt(); ok {
+		_spec.SetField(appointment.FieldScheduledAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedScheduledAt(); ok {
+		_spec.AddField(appointment.FieldScheduledAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(appointment.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   appointment.AccountTable,
+			Columns: []string{appointment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   appointment.AccountTable,
+			Columns: []string{appointment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PmiLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   appointment.PmiLocationTable,
+			Columns: []string{appointment.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   appointment.PmiLocationTable,
+			Columns: []string{appointment.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DonationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   appointment.DonationTable,
+			Columns: []string{appointment.DonationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DonationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   appointment.DonationTable,
+			Columns: []string{appointment.DonationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Appointment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{appointment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
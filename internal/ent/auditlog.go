@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/auditlog"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Account that acted. Empty for anonymous requests and system jobs.
+	ActorID *uuid.UUID `json:"actor_id"`
+	// Account the actor impersonated. Empty unless the actor impersonated one.
+	SubjectID *uuid.UUID `json:"subject_id"`
+	// Token identifier (jti) of the session the actor used.
+	SessionID string `json:"session_id"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID *uuid.UUID `json:"request_id"`
+	// Mutation operation, such as create, or security action, such as login.failed.
+	Action string `json:"action"`
+	// Entity holds the value of the "entity" field.
+	Entity string `json:"entity"`
+	// EntityID holds the value of the "entity_id" field.
+	EntityID string `json:"entity_id"`
+	// Values of the changed fields before the change.
+	Before map[string]interface{} `json:"before"`
+	// Values of the changed fields after the change.
+	After map[string]interface{} `json:"after"`
+	// IP holds the value of the "ip" field.
+	IP           string `json:"ip"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldActorID, auditlog.FieldSubjectID, auditlog.FieldRequestID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case auditlog.FieldBefore, auditlog.FieldAfter:
+			values[i] = new([]byte)
+		case auditlog.FieldCreatedAt, auditlog.FieldUpdatedAt, auditlog.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case auditlog.FieldSessionID, auditlog.FieldAction, auditlog.FieldEntity, auditlog.FieldEntityID, auditlog.FieldIP:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (_m *AuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case auditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case auditlog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case auditlog.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case auditlog.FieldActorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				_m.ActorID = new(uuid.UUID)
+				*_m.ActorID = *value.S.(*uuid.UUID)
+			}
+		case auditlog.FieldSubjectID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value.Valid {
+				_m.SubjectID = new(uuid.UUID)
+				*_m.SubjectID = *value.S.(*uuid.UUID)
+			}
+		case auditlog.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				_m.SessionID = value.String
+			}
+		case auditlog.FieldRequestID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = new(uuid.UUID)
+				*_m.RequestID = *value.S.(*uuid.UUID)
+			}
+		case auditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case auditlog.FieldEntity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity", values[i])
+			} else if value.Valid {
+				_m.Entity = value.String
+			}
+		case auditlog.FieldEntityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				_m.EntityID = value.String
+			}
+		case auditlog.FieldBefore:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field before", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Before); err != nil {
+					return fmt.Errorf("unmarshal field before: %w", err)
+				}
+			}
+		case auditlog.FieldAfter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field after", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.After); err != nil {
+					return fmt.Errorf("unmarshal field after: %w", err)
+				}
+			}
+		case auditlog.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuditLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuditLog) Update() *AuditLogUpdateOne {
+	return NewAuditLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuditLog) Unwrap() *AuditLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	if v := _m.ActorID; v != nil {
+		builder.WriteString("actor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SubjectID; v != nil {
+		builder.WriteString("subject_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(_m.SessionID)
+	builder.WriteString(", ")
+	if v := _m.RequestID; v != nil {
+		builder.WriteString("request_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("entity=")
+	builder.WriteString(_m.Entity)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(_m.EntityID)
+	builder.WriteString(", ")
+	builder.WriteString("before=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Before))
+	builder.WriteString(", ")
+	builder.WriteString("after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.After))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/auditlog"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuditLogCreate) SetCreatedAt(v int64) *AuditLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AuditLogCreate) SetUpdatedAt(v int64) *AuditLogCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableUpdatedAt(v *int64) *AuditLogCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AuditLogCreate) SetDeletedAt(v int64) *AuditLogCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableDeletedAt(v *int64) *AuditLogCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *AuditLogCreate) SetActorID(v uuid.UUID) *AuditLogCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableActorID(v *uuid.UUID) *AuditLogCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_c *AuditLogCreate) SetSubjectID(v uuid.UUID) *AuditLogCreate {
+	_c.mutation.SetSubjectID(v)
+	return _c
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableSubjectID(v *uuid.UUID) *AuditLogCreate {
+	if v != nil {
+		_c.SetSubjectID(*v)
+	}
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *AuditLogCreate) SetSessionID(v string) *AuditLogCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableSessionID(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetSessionID(*v)
+	}
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *AuditLogCreate) SetRequestID(v uuid.UUID) *AuditLogCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableRequestID(v *uuid.UUID) *AuditLogCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *AuditLogCreate) SetAction(v string) *AuditLogCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetEntity sets the "entity" field.
+func (_c *AuditLogCreate) SetEntity(v string) *AuditLogCreate {
+	_c.mutation.SetEntity(v)
+	return _c
+}
+
+// SetNillableEntity sets the "entity" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableEntity(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetEntity(*v)
+	}
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *AuditLogCreate) SetEntityID(v string) *AuditLogCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableEntityID(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetEntityID(*v)
+	}
+	return _c
+}
+
+// SetBefore sets the "before" field.
+func (_c *AuditLogCreate) SetBefore(v map[string]interface{}) *AuditLogCreate {
+	_c.mutation.SetBefore(v)
+	return _c
+}
+
+// SetAfter sets the "after" field.
+func (_c *AuditLogCreate) SetAfter(v map[string]interface{}) *AuditLogCreate {
+	_c.mutation.SetAfter(v)
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *AuditLogCreate) SetIP(v string) *AuditLogCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableIP(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuditLogCreate) SetID(v uuid.UUID) *AuditLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (_c *AuditLogCreate) Mutation() *AuditLogMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (_c *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditLogCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := auditlog.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "AuditLog.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := auditlog.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "AuditLog.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := auditlog.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "AuditLog.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SessionID(); ok {
+		if err := auditlog.SessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "session_id", err: fmt.Errorf(`ent: validator failed for field "AuditLog.session_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditLog.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Entity(); ok {
+		if err := auditlog.EntityValidator(v); err != nil {
+			return &ValidationError{Name: "entity", err: fmt.Errorf(`ent: validator failed for field "AuditLog.entity": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EntityID(); ok {
+		if err := auditlog.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "AuditLog.entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := auditlog.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuditLog.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlog.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = &value
+	}
+	if value, ok := _c.mutation.SubjectID(); ok {
+		_spec.SetField(auditlog.FieldSubjectID, field.TypeUUID, value)
+		_node.SubjectID = &value
+	}
+	if value, ok := _c.mutation.SessionID(); ok {
+		_spec.SetField(auditlog.FieldSessionID, field.TypeString, value)
+		_node.SessionID = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(auditlog.FieldRequestID, field.TypeUUID, value)
+		_node.RequestID = &value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Entity(); ok {
+		_spec.SetField(auditlog.FieldEntity, field.TypeString, value)
+		_node.Entity = value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(auditlog.FieldEntityID, field.TypeString, value)
+		_node.EntityID = value
+	}
+	if value, ok := _c.mutation.Before(); ok {
+		_spec.SetField(auditlog.FieldBefore, field.TypeJSON, value)
+		_node.Before = value
+	}
+	if value, ok := _c.mutation.After(); ok {
+		_spec.SetField(auditlog.FieldAfter, field.TypeJSON, value)
+		_node.After = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	return _node, _spec
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+}
+
+// Save creates the AuditLog entities in the database.
+func (_c *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,411 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sembraniteam/setetes/internal/ent/auditlog"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (_u *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogUpdate) SetUpdatedAt(v int64) *AuditLogUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *AuditLogUpdate) AddUpdatedAt(v int64) *AuditLogUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AuditLogUpdate) ClearUpdatedAt() *AuditLogUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AuditLogUpdate) SetDeletedAt(v int64) *AuditLogUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableDeletedAt(v *int64) *AuditLogUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *AuditLogUpdate) AddDeletedAt(v int64) *AuditLogUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AuditLogUpdate) ClearDeletedAt() *AuditLogUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (_u *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AuditLogUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := auditlog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditLogUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := auditlog.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "AuditLog.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := auditlog.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "AuditLog.deleted_at": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(auditlog.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(auditlog.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlog.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(auditlog.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(auditlog.FieldDeletedAt, field.TypeInt64)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeUUID)
+	}
+	if _u.mutation.SubjectIDCleared() {
+		_spec.ClearField(auditlog.FieldSubjectID, field.TypeUUID)
+	}
+	if _u.mutation.SessionIDCleared() {
+		_spec.ClearField(auditlog.FieldSessionID, field.TypeString)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(auditlog.FieldRequestID, field.TypeUUID)
+	}
+	if _u.mutation.EntityCleared() {
+		_spec.ClearField(auditlog.FieldEntity, field.TypeString)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(auditlog.FieldEntityID, field.TypeString)
+	}
+	if _u.mutation.BeforeCleared() {
+		_spec.ClearField(auditlog.FieldBefore, field.TypeJSON)
+	}
+	if _u.mutation.AfterCleared() {
+		_spec.ClearField(auditlog.FieldAfter, field.TypeJSON)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(auditlog.FieldIP, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogUpdateOne) SetUpdatedAt(v int64) *AuditLogUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *AuditLogUpdateOne) AddUpdatedAt(v int64) *AuditLogUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AuditLogUpdateOne) ClearUpdatedAt() *AuditLogUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AuditLogUpdateOne) SetDeletedAt(v int64) *AuditLogUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableDeletedAt(v *int64) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *AuditLogUpdateOne) AddDeletedAt(v int64) *AuditLogUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AuditLogUpdateOne) ClearDeletedAt() *AuditLogUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (_u *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (_u *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (_u *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AuditLogUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := auditlog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditLogUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := auditlog.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "AuditLog.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := auditlog.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "AuditLog.deleted_at": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(auditlog.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(auditlog.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlog.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(auditlog.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(auditlog.FieldDeletedAt, field.TypeInt64)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeUUID)
+	}
+	if _u.mutation.SubjectIDCleared() {
+		_spec.ClearField(auditlog.FieldSubjectID, field.TypeUUID)
+	}
+	if _u.mutation.SessionIDCleared() {
+		_spec.ClearField(auditlog.FieldSessionID, field.TypeString)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(auditlog.FieldRequestID, field.TypeUUID)
+	}
+	if _u.mutation.EntityCleared() {
+		_spec.ClearField(auditlog.FieldEntity, field.TypeString)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(auditlog.FieldEntityID, field.TypeString)
+	}
+	if _u.mutation.BeforeCleared() {
+		_spec.ClearField(auditlog.FieldBefore, field.TypeJSON)
+	}
+	if _u.mutation.AfterCleared() {
+		_spec.ClearField(auditlog.FieldAfter, field.TypeJSON)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(auditlog.FieldIP, field.TypeString)
+	}
+	_node = &AuditLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+)
+
+// BloodRequest is the model entity for the BloodRequest schema.
+type BloodRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// PatientName holds the value of the "patient_name" field.
+	PatientName string `json:"patient_name"`
+	// Medical record number of the patient at the hospital.
+	PatientRecordNumber string `json:"patient_record_number"`
+	// Component holds the value of the "component" field.
+	Component bloodrequest.Component `json:"component"`
+	// Number of bags requested.
+	Quantity int `json:"quantity"`
+	// Number of bags issued to the hospital so far.
+	FulfilledQuantity int `json:"fulfilled_quantity"`
+	// Urgency holds the value of the "urgency" field.
+	Urgency bloodrequest.Urgency `json:"urgency"`
+	// Time the blood is needed at the hospital in milliseconds.
+	RequiredBy int64 `json:"required_by"`
+	// Status holds the value of the "status" field.
+	Status bloodrequest.Status `json:"status"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note"`
+	// Why the request was rejected or cancelled.
+	Reason string `json:"reason"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BloodRequestQuery when eager-loading is set.
+	Edges           BloodRequestEdges `json:"edges"`
+	hospital_id     *uuid.UUID
+	blood_type_id   *uuid.UUID
+	pmi_location_id *uuid.UUID
+	requested_by_id *uuid.UUID
+	handled_by_id   *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// BloodRequestEdges holds the relations/edges for other nodes in the graph.
+type BloodRequestEdges struct {
+	// Hospital holds the value of the hospital edge.
+	Hospital *Hospital `json:"hospital,omitempty"`
+	// Blood type of the patient.
+	BloodType *BloodType `json:"blood_type,omitempty"`
+	// PMI location the blood is requested from.
+	PmiLocation *PMILocation `json:"pmi_location,omitempty"`
+	// RequestedBy holds the value of the requested_by edge.
+	RequestedBy *Account `json:"requested_by,omitempty"`
+	// HandledBy holds the value of the handled_by edge.
+	HandledBy *Account `json:"handled_by,omitempty"`
+	// Units holds the value of the units edge.
+	Units []*BloodUnit `json:"units,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// HospitalOrErr returns the Hospital value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodRequestEdges) HospitalOrErr() (*Hospital, error) {
+	if e.Hospital != nil {
+		return e.Hospital, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: hospital.Label}
+	}
+	return nil, &NotLoadedError{edge: "hospital"}
+}
+
+// BloodTypeOrErr returns the BloodType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodRequestEdges) BloodTypeOrErr() (*BloodType, error) {
+	if e.BloodType != nil {
+		return e.BloodType, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: bloodtype.Label}
+	}
+	return nil, &NotLoadedError{edge: "blood_type"}
+}
+
+// PmiLocationOrErr returns the PmiLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodRequestEdges) PmiLocationOrErr() (*PMILocation, error) {
+	if e.PmiLocation != nil {
+		return e.PmiLocation, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: pmilocation.Label}
+	}
+	return nil, &NotLoadedError{edge: "pmi_location"}
+}
+
+// RequestedByOrErr returns the RequestedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodRequestEdges) RequestedByOrErr() (*Account, error) {
+	if e.RequestedBy != nil {
+		return e.RequestedBy, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "requested_by"}
+}
+
+// HandledByOrErr returns the HandledBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodRequestEdges) HandledByOrErr() (*Account, error) {
+	if e.HandledBy != nil {
+		return e.HandledBy, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "handled_by"}
+}
+
+// UnitsOrErr returns the Units value or an error if the edge
+// was not loaded in eager-loading.
+func (e BloodRequestEdges) UnitsOrErr() ([]*BloodUnit, error) {
+	if e.loadedTypes[5] {
+		return e.Units, nil
+	}
+	return nil, &NotLoadedError{edge: "units"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BloodRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bloodrequest.FieldCreatedAt, bloodrequest.FieldUpdatedAt, bloodrequest.FieldDeletedAt, bloodrequest.FieldQuantity, bloodrequest.FieldFulfilledQuantity, bloodrequest.FieldRequiredBy:
+			values[i] = new(sql.NullInt64)
+		case bloodrequest.FieldPatientName, bloodrequest.FieldPatientRecordNumber, bloodrequest.FieldComponent, bloodrequest.FieldUrgency, bloodrequest.FieldStatus, bloodrequest.FieldNote, bloodrequest.FieldReason:
+			values[i] = new(sql.NullString)
+		case bloodrequest.FieldID:
+			values[i] = new(uuid.UUID)
+		case bloodrequest.ForeignKeys[0]: // hospital_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bloodrequest.ForeignKeys[1]: // blood_type_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bloodrequest.ForeignKeys[2]: // pmi_location_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bloodrequest.ForeignKeys[3]: // requested_by_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bloodrequest.ForeignKeys[4]: // handled_by_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BloodRequest fields.
+func (_m *BloodRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bloodrequest.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bloodrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case bloodrequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case bloodrequest.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case bloodrequest.FieldPatientName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_name", values[i])
+			} else if value.Valid {
+				_m.PatientName = value.String
+			}
+		case bloodrequest.FieldPatientRecordNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_record_number", values[i])
+			} else if value.Valid {
+				_m.PatientRecordNumber = value.String
+			}
+		case bloodrequest.FieldComponent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field component", values[i])
+			} else if value.Valid {
+				_m.Component = bloodrequest.Component(value.String)
+			}
+		case bloodrequest.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case bloodrequest.FieldFulfilledQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfilled_quantity", values[i])
+			} else if value.Valid {
+				_m.FulfilledQuantity = int(value.Int64)
+			}
+		case bloodrequest.FieldUrgency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field urgency", values[i])
+			} else if value.Valid {
+				_m.Urgency = bloodrequest.Urgency(value.String)
+			}
+		case bloodrequest.FieldRequiredBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field required_by", values[i])
+			} else if value.Valid {
+				_m.RequiredBy = value.Int64
+			}
+		case bloodrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = bloodrequest.Status(value.String)
+			}
+		case bloodrequest.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case bloodrequest.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case bloodrequest.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field hospital_id", values[i])
+			} else if value.Valid {
+				_m.hospital_id = new(uuid.UUID)
+				*_m.hospital_id = *value.S.(*uuid.UUID)
+			}
+		case bloodrequest.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field blood_type_id", values[i])
+			} else if value.Valid {
+				_m.blood_type_id = new(uuid.UUID)
+				*_m.blood_type_id = *value.S.(*uuid.UUID)
+			}
+		case bloodrequest.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field pmi_location_id", values[i])
+			} else if value.Valid {
+				_m.pmi_location_id = new(uuid.UUID)
+				*_m.pmi_location_id = *value.S.(*uuid.UUID)
+			}
+		case bloodrequest.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by_id", values[i])
+			} else if value.Valid {
+				_m.requested_by_id = new(uuid.UUID)
+				*_m.requested_by_id = *value.S.(*uuid.UUID)
+			}
+		case bloodrequest.ForeignKeys[4]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field handled_by_id", values[i])
+			} else if value.Valid {
+				_m.handled_by_id = new(uuid.UUID)
+				*_m.handled_by_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BloodRequest.
+// This includes values selected through modifiers, order, etc.
+func (_m *BloodRequest) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryHospital queries the "hospital" edge of the BloodRequest entity.
+func (_m *BloodRequest) QueryHospital() *HospitalQuery {
+	return NewBloodRequestClient(_m.config).QueryHospital(_m)
+}
+
+// QueryBloodType queries the "blood_type" edge of the BloodRequest entity.
+func (_m *BloodRequest) QueryBloodType() *BloodTypeQuery {
+	return NewBloodRequestClient(_m.config).QueryBloodType(_m)
+}
+
+// QueryPmiLocation queries the "pmi_location" edge of the BloodRequest entity.
+func (_m *BloodRequest) QueryPmiLocation() *PMILocationQuery {
+	return NewBloodRequestClient(_m.config).QueryPmiLocation(_m)
+}
+
+// QueryRequestedBy queries the "requested_by" edge of the BloodRequest entity.
+func (_m *BloodRequest) QueryRequestedBy() *AccountQuery {
+	return NewBloodRequestClient(_m.config).QueryRequestedBy(_m)
+}
+
+// QueryHandledBy queries the "handled_by" edge of the BloodRequest entity.
+func (_m *BloodRequest) QueryHandledBy() *AccountQuery {
+	return NewBloodRequestClient(_m.config).QueryHandledBy(_m)
+}
+
+// QueryUnits queries the "units" edge of the BloodRequest entity.
+func (_m *BloodRequest) QueryUnits() *BloodUnitQuery {
+	return NewBloodRequestClient(_m.config).QueryUnits(_m)
+}
+
+// Update returns a builder for updating this BloodRequest.
+// Note that you need to call BloodRequest.Unwrap() before calling this method if this BloodRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BloodRequest) Update() *BloodRequestUpdateOne {
+	return NewBloodRequestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BloodRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BloodRequest) Unwrap() *BloodRequest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BloodRequest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BloodRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("BloodRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("patient_name=")
+	builder.WriteString(_m.PatientName)
+	builder.WriteString(", ")
+	builder.WriteString("patient_record_number=")
+	builder.WriteString(_m.PatientRecordNumber)
+	builder.WriteString(", ")
+	builder.WriteString("component=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Component))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("fulfilled_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FulfilledQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("urgency=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Urgency))
+	builder.WriteString(", ")
+	builder.WriteString("required_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiredBy))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BloodRequests is a parsable slice of BloodRequest.
+type BloodRequests []*BloodRequest
@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bloodrequest
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the bloodrequest type in the database.
+	Label = "blood_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldPatientName holds the string denoting the patient_name field in the database.
+	FieldPatientName = "patient_name"
+	// FieldPatientRecordNumber holds the string denoting the patient_record_number field in the database.
+	FieldPatientRecordNumber = "patient_record_number"
+	// FieldComponent holds the string denoting the component field in the database.
+	FieldComponent = "component"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldFulfilledQuantity holds the string denoting the fulfilled_quantity field in the database.
+	FieldFulfilledQuantity = "fulfilled_quantity"
+	// FieldUrgency holds the string denoting the urgency field in the database.
+	FieldUrgency = "urgency"
+	// FieldRequiredBy holds the string denoting the required_by field in the database.
+	FieldRequiredBy = "required_by"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// EdgeHospital holds the string denoting the hospital edge name in mutations.
+	EdgeHospital = "hospital"
+	// EdgeBloodType holds the string denoting the blood_type edge name in mutations.
+	EdgeBloodType = "blood_type"
+	// EdgePmiLocation holds the string denoting the pmi_location edge name in mutations.
+	EdgePmiLocation = "pmi_location"
+	// EdgeRequestedBy holds the string denoting the requested_by edge name in mutations.
+	EdgeRequestedBy = "requested_by"
+	// EdgeHandledBy holds the string denoting the handled_by edge name in mutations.
+	EdgeHandledBy = "handled_by"
+	// EdgeUnits holds the string denoting the units edge name in mutations.
+	EdgeUnits = "units"
+	// Table holds the table name of the bloodrequest in the database.
+	Table = "blood_requests"
+	// HospitalTable is the table that holds the hospital relation/edge.
+	HospitalTable = "blood_requests"
+	// HospitalInverseTable is the table name for the Hospital entity.
+	// It exists in this package in order to avoid circular dependency with the "hospital" package.
+	HospitalInverseTable = "hospitals"
+	// HospitalColumn is the table column denoting the hospital relation/edge.
+	HospitalColumn = "hospital_id"
+	// BloodTypeTable is the table that holds the blood_type relation/edge.
+	BloodTypeTable = "blood_requests"
+	// BloodTypeInverseTable is the table name for the BloodType entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodtype" package.
+	BloodTypeInverseTable = "blood_types"
+	// BloodTypeColumn is the table column denoting the blood_type relation/edge.
+	BloodTypeColumn = "blood_type_id"
+	// PmiLocationTable is the table that holds the pmi_location relation/edge.
+	PmiLocationTable = "blood_requests"
+	// PmiLocationInverseTable is the table name for the PMILocation entity.
+	// It exists in this package in order to avoid circular dependency with the "pmilocation" package.
+	PmiLocationInverseTable = "pmi_locations"
+	// PmiLocationColumn is the table column denoting the pmi_location relation/edge.
+	PmiLocationColumn = "pmi_location_id"
+	// RequestedByTable is the table that holds the requested_by relation/edge.
+	RequestedByTable = "blood_requests"
+	// RequestedByInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	RequestedByInverseTable = "accounts"
+	// RequestedByColumn is the table column denoting the requested_by relation/edge.
+	RequestedByColumn = "requested_by_id"
+	// HandledByTable is the table that holds the handled_by relation/edge.
+	HandledByTable = "blood_requests"
+	// HandledByInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	HandledByInverseTable = "accounts"
+	// HandledByColumn is the table column denoting the handled_by relation/edge.
+	HandledByColumn = "handled_by_id"
+	// UnitsTable is the table that holds the units relation/edge.
+	UnitsTable = "blood_units"
+	// UnitsInverseTable is the table name for the BloodUnit entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodunit" package.
+	UnitsInverseTable = "blood_units"
+	// UnitsColumn is the table column denoting the units relation/edge.
+	UnitsColumn = "blood_request_id"
+)
+
+// Columns holds all SQL columns for bloodrequest fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldPatientName,
+	FieldPatientRecordNumber,
+	FieldComponent,
+	FieldQuantity,
+	FieldFulfilledQuantity,
+	FieldUrgency,
+	FieldRequiredBy,
+	FieldStatus,
+	FieldNote,
+	FieldReason,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "blood_requests"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"hospital_id",
+	"blood_type_id",
+	"pmi_location_id",
+	"requested_by_id",
+	"handled_by_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// PatientNameValidator is a validator for the "patient_name" field. It is called by the builders before save.
+	PatientNameValidator func(string) error
+	// PatientRecordNumberValidator is a validator for the "patient_record_number" field. It is called by the builders before save.
+	PatientRecordNumberValidator func(string) error
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+	// DefaultFulfilledQuantity holds the default value on creation for the "fulfilled_quantity" field.
+	DefaultFulfilledQuantity int
+	// FulfilledQuantityValidator is a validator for the "fulfilled_quantity" field. It is called by the builders before save.
+	FulfilledQuantityValidator func(int) error
+	// RequiredByValidator is a validator for the "required_by" field. It is called by the builders before save.
+	RequiredByValidator func(int64) error
+	// NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	NoteValidator func(string) error
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+)
+
+// Component defines the type for the "component" enum field.
+type Component string
+
+// Component values.
+const (
+	ComponentWholeBlood Component = "WHOLE_BLOOD"
+	ComponentPRC        Component = "PRC"
+	ComponentTC         Component = "TC"
+	ComponentFFP        Component = "FFP"
+)
+
+func (c Component) String() string {
+	return string(c)
+}
+
+// ComponentValidator is a validator for the "component" field enum values. It is called by the builders before save.
+func ComponentValidator(c Component) error {
+	switch c {
+	case ComponentWholeBlood, ComponentPRC, ComponentTC, ComponentFFP:
+		return nil
+	default:
+		return fmt.Errorf("bloodrequest: invalid enum value for component field: %q", c)
+	}
+}
+
+// Urgency defines the type for the "urgency" enum field.
+type Urgency string
+
+// Urgency values.
+const (
+	UrgencyRoutine   Urgency = "ROUTINE"
+	UrgencyUrgent    Urgency = "URGENT"
+	UrgencyEmergency Urgency = "EMERGENCY"
+)
+
+func (u Urgency) String() string {
+	return string(u)
+}
+
+// UrgencyValidator is a validator for the "urgency" field enum values. It is called by the builders before save.
+func UrgencyValidator(u Urgency) error {
+	switch u {
+	case UrgencyRoutine, UrgencyUrgent, UrgencyEmergency:
+		return nil
+	default:
+		return fmt.Errorf("bloodrequest: invalid enum value for urgency field: %q", u)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusSubmitted is the default value of the Status enum.
+const DefaultStatus = StatusSubmitted
+
+// Status values.
+const (
+	StatusSubmitted          Status = "SUBMITTED"
+	StatusAccepted           Status = "ACCEPTED"
+	StatusPartiallyFulfilled Status = "PARTIALLY_FULFILLED"
+	StatusFulfilled          Status = "FULFILLED"
+	StatusRejected           Status = "REJECTED"
+	StatusCancelled          Status = "CANCELLED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusSubmitted, StatusAccepted, StatusPartiallyFulfilled, StatusFulfilled, StatusRejected, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("bloodrequest: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the BloodRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByPatientName orders the results by the patient_name field.
+func ByPatientName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatientName, opts...).ToFunc()
+}
+
+// ByPatientRecordNumber orders the results by the patient_record_number field.
+func ByPatientRecordNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatientRecordNumber, opts...).ToFunc()
+}
+
+// ByComponent orders the results by the component field.
+func ByComponent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComponent, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByFulfilledQuantity orders the results by the fulfilled_quantity field.
+func ByFulfilledQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfilledQuantity, opts...).ToFunc()
+}
+
+// ByUrgency orders the results by the urgency field.
+func ByUrgency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUrgency, opts...).ToFunc()
+}
+
+// ByRequiredBy orders the results by the required_by field.
+func ByRequiredBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiredBy, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByHospitalField orders the results by hospital field.
+func ByHospitalField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHospitalStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBloodTypeField orders the results by blood_type field.
+func ByBloodTypeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBloodTypeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPmiLocationField orders the results by pmi_location field.
+func ByPmiLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPmiLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRequestedByField orders the results by requested_by field.
+func ByRequestedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequestedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByHandledByField orders the results by handled_by field.
+func ByHandledByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHandledByStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUnitsCount orders the results by units count.
+func ByUnitsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUnitsStep(), opts...)
+	}
+}
+
+// ByUnits orders the results by units terms.
+func ByUnits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUnitsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newHospitalStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HospitalInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, HospitalTable, HospitalColumn),
+	)
+}
+func newBloodTypeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BloodTypeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, BloodTypeTable, BloodTypeColumn),
+	)
+}
+func newPmiLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PmiLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+	)
+}
+func newRequestedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RequestedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RequestedByTable, RequestedByColumn),
+	)
+}
+func newHandledByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HandledByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, HandledByTable, HandledByColumn),
+	)
+}
+func newUnitsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UnitsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, UnitsTable, UnitsColumn),
+	)
+}
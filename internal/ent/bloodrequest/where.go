@@ -0,0 +1,868 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bloodrequest
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// PatientName applies equality check predicate on the "patient_name" field. It's identical to PatientNameEQ.
+func PatientName(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldPatientName, v))
+}
+
+// PatientRecordNumber applies equality check predicate on the "patient_record_number" field. It's identical to PatientRecordNumberEQ.
+func PatientRecordNumber(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldPatientRecordNumber, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldQuantity, v))
+}
+
+// FulfilledQuantity applies equality check predicate on the "fulfilled_quantity" field. It's identical to FulfilledQuantityEQ.
+func FulfilledQuantity(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldFulfilledQuantity, v))
+}
+
+// RequiredBy applies equality check predicate on the "required_by" field. It's identical to RequiredByEQ.
+func RequiredBy(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldRequiredBy, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldNote, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// PatientNameEQ applies the EQ predicate on the "patient_name" field.
+func PatientNameEQ(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldPatientName, v))
+}
+
+// PatientNameNEQ applies the NEQ predicate on the "patient_name" field.
+func PatientNameNEQ(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldPatientName, v))
+}
+
+// PatientNameIn applies the In predicate on the "patient_name" field.
+func PatientNameIn(vs ...string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldPatientName, vs...))
+}
+
+// PatientNameNotIn applies the NotIn predicate on the "patient_name" field.
+func PatientNameNotIn(vs ...string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldPatientName, vs...))
+}
+
+// PatientNameGT applies the GT predicate on the "patient_name" field.
+func PatientNameGT(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldPatientName, v))
+}
+
+// PatientNameGTE applies the GTE predicate on the "patient_name" field.
+func PatientNameGTE(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldPatientName, v))
+}
+
+// PatientNameLT applies the LT predicate on the "patient_name" field.
+func PatientNameLT(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldPatientName, v))
+}
+
+// PatientNameLTE applies the LTE predicate on the "patient_name" field.
+func PatientNameLTE(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldPatientName, v))
+}
+
+// PatientNameContains applies the Contains predicate on the "patient_name" field.
+func PatientNameContains(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldContains(FieldPatientName, v))
+}
+
+// PatientNameHasPrefix applies the HasPrefix predicate on the "patient_name" field.
+func PatientNameHasPrefix(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldHasPrefix(FieldPatientName, v))
+}
+
+// PatientNameHasSuffix applies the HasSuffix predicate on the "patient_name" field.
+func PatientNameHasSuffix(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldHasSuffix(FieldPatientName, v))
+}
+
+// PatientNameEqualFold applies the EqualFold predicate on the "patient_name" field.
+func PatientNameEqualFold(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEqualFold(FieldPatientName, v))
+}
+
+// PatientNameContainsFold applies the ContainsFold predicate on the "patient_name" field.
+func PatientNameContainsFold(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldContainsFold(FieldPatientName, v))
+}
+
+// PatientRecordNumberEQ applies the EQ predicate on the "patient_record_number" field.
+func PatientRecordNumberEQ(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldPatientRecordNumber, v))
+}
+
+// PatientRecordNumberNEQ applies the NEQ predicate on the "patient_record_number" field.
+func PatientRecordNumberNEQ(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldPatientRecordNumber, v))
+}
+
+// PatientRecordNumberIn applies the In predicate on the "patient_record_number" field.
+func PatientRecordNumberIn(vs ...string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldPatientRecordNumber, vs...))
+}
+
+// PatientRecordNumberNotIn applies the NotIn predicate on the "patient_record_number" field.
+func PatientRecordNumberNotIn(vs ...string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldPatientRecordNumber, vs...))
+}
+
+// PatientRecordNumberGT applies the GT predicate on the "patient_record_number" field.
+func PatientRecordNumberGT(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldPatientRecordNumber, v))
+}
+
+// PatientRecordNumberGTE applies the GTE predicate on the "patient_record_number" field.
+func PatientRecordNumberGTE(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldPatientRecordNumber, v))
+}
+
+// PatientRecordNumberLT applies the LT predicate on the "patient_record_number" field.
+func PatientRecordNumberLT(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldPatientRecordNumber, v))
+}
+
+// PatientRecordNumberLTE applies the LTE predicate on the "patient_record_number" field.
+func PatientRecordNumberLTE(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldPatientRecordNumber, v))
+}
+
+// PatientRecordNumberContains applies the Contains predicate on the "patient_record_number" field.
+func PatientRecordNumberContains(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldContains(FieldPatientRecordNumber, v))
+}
+
+// PatientRecordNumberHasPrefix applies the HasPrefix predicate on the "patient_record_number" field.
+func PatientRecordNumberHasPrefix(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldHasPrefix(FieldPatientRecordNumber, v))
+}
+
+// PatientRecordNumberHasSuffix applies the HasSuffix predicate on the "patient_record_number" field.
+func PatientRecordNumberHasSuffix(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldHasSuffix(FieldPatientRecordNumber, v))
+}
+
+// PatientRecordNumberIsNil applies the IsNil predicate on the "patient_record_number" field.
+func PatientRecordNumberIsNil() predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIsNull(FieldPatientRecordNumber))
+}
+
+// PatientRecordNumberNotNil applies the NotNil predicate on the "patient_record_number" field.
+func PatientRecordNumberNotNil() predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotNull(FieldPatientRecordNumber))
+}
+
+// PatientRecordNumberEqualFold applies the EqualFold predicate on the "patient_record_number" field.
+func PatientRecordNumberEqualFold(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEqualFold(FieldPatientRecordNumber, v))
+}
+
+// PatientRecordNumberContainsFold applies the ContainsFold predicate on the "patient_record_number" field.
+func PatientRecordNumberContainsFold(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldContainsFold(FieldPatientRecordNumber, v))
+}
+
+// ComponentEQ applies the EQ predicate on the "component" field.
+func ComponentEQ(v Component) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldComponent, v))
+}
+
+// ComponentNEQ applies the NEQ predicate on the "component" field.
+func ComponentNEQ(v Component) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldComponent, v))
+}
+
+// ComponentIn applies the In predicate on the "component" field.
+func ComponentIn(vs ...Component) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldComponent, vs...))
+}
+
+// ComponentNotIn applies the NotIn predicate on the "component" field.
+func ComponentNotIn(vs ...Component) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldComponent, vs...))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldQuantity, v))
+}
+
+// FulfilledQuantityEQ applies the EQ predicate on the "fulfilled_quantity" field.
+func FulfilledQuantityEQ(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldFulfilledQuantity, v))
+}
+
+// FulfilledQuantityNEQ applies the NEQ predicate on the "fulfilled_quantity" field.
+func FulfilledQuantityNEQ(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldFulfilledQuantity, v))
+}
+
+// FulfilledQuantityIn applies the In predicate on the "fulfilled_quantity" field.
+func FulfilledQuantityIn(vs ...int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldFulfilledQuantity, vs...))
+}
+
+// FulfilledQuantityNotIn applies the NotIn predicate on the "fulfilled_quantity" field.
+func FulfilledQuantityNotIn(vs ...int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldFulfilledQuantity, vs...))
+}
+
+// FulfilledQuantityGT applies the GT predicate on the "fulfilled_quantity" field.
+func FulfilledQuantityGT(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldFulfilledQuantity, v))
+}
+
+// FulfilledQuantityGTE applies the GTE predicate on the "fulfilled_quantity" field.
+func FulfilledQuantityGTE(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldFulfilledQuantity, v))
+}
+
+// FulfilledQuantityLT applies the LT predicate on the "fulfilled_quantity" field.
+func FulfilledQuantityLT(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldFulfilledQuantity, v))
+}
+
+// FulfilledQuantityLTE applies the LTE predicate on the "fulfilled_quantity" field.
+func FulfilledQuantityLTE(v int) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldFulfilledQuantity, v))
+}
+
+// UrgencyEQ applies the EQ predicate on the "urgency" field.
+func UrgencyEQ(v Urgency) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldUrgency, v))
+}
+
+// UrgencyNEQ applies the NEQ predicate on the "urgency" field.
+func UrgencyNEQ(v Urgency) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldUrgency, v))
+}
+
+// UrgencyIn applies the In predicate on the "urgency" field.
+func UrgencyIn(vs ...Urgency) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldUrgency, vs...))
+}
+
+// UrgencyNotIn applies the NotIn predicate on the "urgency" field.
+func UrgencyNotIn(vs ...Urgency) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldUrgency, vs...))
+}
+
+// RequiredByEQ applies the EQ predicate on the "required_by" field.
+func RequiredByEQ(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldRequiredBy, v))
+}
+
+// RequiredByNEQ applies the NEQ predicate on the "required_by" field.
+func RequiredByNEQ(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldRequiredBy, v))
+}
+
+// RequiredByIn applies the In predicate on the "required_by" field.
+func RequiredByIn(vs ...int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldRequiredBy, vs...))
+}
+
+// RequiredByNotIn applies the NotIn predicate on the "required_by" field.
+func RequiredByNotIn(vs ...int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldRequiredBy, vs...))
+}
+
+// RequiredByGT applies the GT predicate on the "required_by" field.
+func RequiredByGT(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldRequiredBy, v))
+}
+
+// RequiredByGTE applies the GTE predicate on the "required_by" field.
+func RequiredByGTE(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldRequiredBy, v))
+}
+
+// RequiredByLT applies the LT predicate on the "required_by" field.
+func RequiredByLT(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldRequiredBy, v))
+}
+
+// RequiredByLTE applies the LTE predicate on the "required_by" field.
+func RequiredByLTE(v int64) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldRequiredBy, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldContainsFold(FieldNote, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.FieldContainsFold(FieldReason, v))
+}
+
+// HasHospital applies the HasEdge predicate on the "hospital" edge.
+func HasHospital() predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, HospitalTable, HospitalColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHospitalWith applies the HasEdge predicate on the "hospital" edge with a given conditions (other predicates).
+func HasHospitalWith(preds ...predicate.Hospital) predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := newHospitalStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBloodType applies the HasEdge predicate on the "blood_type" edge.
+func HasBloodType() predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BloodTypeTable, BloodTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBloodTypeWith applies the HasEdge predicate on the "blood_type" edge with a given conditions (other predicates).
+func HasBloodTypeWith(preds ...predicate.BloodType) predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := newBloodTypeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPmiLocation applies the HasEdge predicate on the "pmi_location" edge.
+func HasPmiLocation() predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPmiLocationWith applies the HasEdge predicate on the "pmi_location" edge with a given conditions (other predicates).
+func HasPmiLocationWith(preds ...predicate.PMILocation) predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := newPmiLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRequestedBy applies the HasEdge predicate on the "requested_by" edge.
+func HasRequestedBy() predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RequestedByTable, RequestedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequestedByWith applies the HasEdge predicate on the "requested_by" edge with a given conditions (other predicates).
+func HasRequestedByWith(preds ...predicate.Account) predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := newRequestedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHandledBy applies the HasEdge predicate on the "handled_by" edge.
+func HasHandledBy() predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, HandledByTable, HandledByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHandledByWith applies the HasEdge predicate on the "handled_by" edge with a given conditions (other predicates).
+func HasHandledByWith(preds ...predicate.Account) predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := newHandledByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUnits applies the HasEdge predicate on the "units" edge.
+func HasUnits() predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, UnitsTable, UnitsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUnitsWith applies the HasEdge predicate on the "units" edge with a given conditions (other predicates).
+func HasUnitsWith(preds ...predicate.BloodUnit) predicate.BloodRequest {
+	return predicate.BloodRequest(func(s *sql.Selector) {
+		step := newUnitsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BloodRequest) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BloodRequest) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BloodRequest) predicate.BloodRequest {
+	return predicate.BloodRequest(sql.NotPredicates(p))
+}
@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+)
+
+// BloodRequestCreate is the builder for creating a BloodRequest entity.
+type BloodRequestCreate struct {
+	config
+	mutation *BloodRequestMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BloodRequestCreate) SetCreatedAt(v int64) *BloodRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BloodRequestCreate) SetUpdatedAt(v int64) *BloodRequestCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BloodRequestCreate) SetNillableUpdatedAt(v *int64) *BloodRequestCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *BloodRequestCreate) SetDeletedAt(v int64) *BloodRequestCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *BloodRequestCreate) SetNillableDeletedAt(v *int64) *BloodRequestCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetPatientName sets the "patient_name" field.
+func (_c *BloodRequestCreate) SetPatientName(v string) *BloodRequestCreate {
+	_c.mutation.SetPatientName(v)
+	return _c
+}
+
+// SetPatientRecordNumber sets the "patient_record_number" field.
+func (_c *BloodRequestCreate) SetPatientRecordNumber(v string) *BloodRequestCreate {
+	_c.mutation.SetPatientRecordNumber(v)
+	return _c
+}
+
+// SetNillablePatientRecordNumber sets the "patient_record_number" field if the given value is not nil.
+func (_c *BloodRequestCreate) SetNillablePatientRecordNumber(v *string) *BloodRequestCreate {
+	if v != nil {
+		_c.SetPatientRecordNumber(*v)
+	}
+	return _c
+}
+
+// SetComponent sets the "component" field.
+func (_c *BloodRequestCreate) SetComponent(v bloodrequest.Component) *BloodRequestCreate {
+	_c.mutation.SetComponent(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *BloodRequestCreate) SetQuantity(v int) *BloodRequestCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetFulfilledQuantity sets the "fulfilled_quantity" field.
+func (_c *BloodRequestCreate) SetFulfilledQuantity(v int) *BloodRequestCreate {
+	_c.mutation.SetFulfilledQuantity(v)
+	return _c
+}
+
+// SetNillableFulfilledQuantity sets the "fulfilled_quantity" field if the given value is not nil.
+func (_c *BloodRequestCreate) SetNillableFulfilledQuantity(v *int) *BloodRequestCreate {
+	if v != nil {
+		_c.SetFulfilledQuantity(*v)
+	}
+	return _c
+}
+
+// SetUrgency sets the "urgency" field.
+func (_c *BloodRequestCreate) SetUrgency(v bloodrequest.Urgency) *BloodRequestCreate {
+	_c.mutation.SetUrgency(v)
+	return _c
+}
+
+// SetRequiredBy sets the "required_by" field.
+func (_c *BloodRequestCreate) SetRequiredBy(v int64) *BloodRequestCreate {
+	_c.mutation.SetRequiredBy(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BloodRequestCreate) SetStatus(v bloodrequest.Status) *BloodRequestCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BloodRequestCreate) SetNillableStatus(v *bloodrequest.Status) *BloodRequestCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *BloodRequestCreate) SetNote(v string) *BloodRequestCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *BloodRequestCreate) SetNillableNote(v *string) *BloodRequestCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *BloodRequestCreate) SetReason(v string) *BloodRequestCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *BloodRequestCreate) SetNillableReason(v *string) *BloodRequestCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BloodRequestCreate) SetID(v uuid.UUID) *BloodRequestCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetHospitalID sets the "hospital" edge to the Hospital entity by ID.
+func (_c *BloodRequestCreate) SetHospitalID(id uuid.UUID) *BloodRequestCreate {
+	_c.mutation.SetHospitalID(id)
+	return _c
+}
+
+// SetHospital sets the "hospital" edge to the Hospital entity.
+func (_c *BloodRequestCreate) SetHospital(v *Hospital) *BloodRequestCreate {
+	return _c.SetHospitalID(v.ID)
+}
+
+// SetBloodTypeID sets the "blood_type" edge to the BloodType entity by ID.
+func (_c *BloodRequestCreate) SetBloodTypeID(id uuid.UUID) *BloodRequestCreate {
+	_c.mutation.SetBloodTypeID(id)
+	return _c
+}
+
+// SetBloodType sets the "blood_type" edge to the BloodType entity.
+func (_c *BloodRequestCreate) SetBloodType(v *BloodType) *BloodRequestCreate {
+	return _c.SetBloodTypeID(v.ID)
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_c *BloodRequestCreate) SetPmiLocationID(id uuid.UUID) *BloodRequestCreate {
+	_c.mutation.SetPmiLocationID(id)
+	return _c
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_c *BloodRequestCreate) SetPmiLocation(v *PMILocation) *BloodRequestCreate {
+	return _c.SetPmiLocationID(v.ID)
+}
+
+// SetRequestedByID sets the "requested_by" edge to the Account entity by ID.
+func (_c *BloodRequestCreate) SetRequestedByID(id uuid.UUID) *BloodRequestCreate {
+	_c.mutation.SetRequestedByID(id)
+	return _c
+}
+
+// SetRequestedBy sets the "requested_by" edge to the Account entity.
+func (_c *BloodRequestCreate) SetRequestedBy(v *Account) *BloodRequestCreate {
+	return _c.SetRequestedByID(v.ID)
+}
+
+// SetHandledByID sets the "handled_by" edge to the Account entity by ID.
+func (_c *BloodRequestCreate) SetHandledByID(id uuid.UUID) *BloodRequestCreate {
+	_c.mutation.SetHandledByID(id)
+	return _c
+}
+
+// SetNillableHandledByID sets the "handled_by" edge to the Account entity by ID if the given value is not nil.
+func (_c *BloodRequestCreate) SetNillableHandledByID(id *uuid.UUID) *BloodRequestCreate {
+	if id != nil {
+		_c = _c.SetHandledByID(*id)
+	}
+	return _c
+}
+
+// SetHandledBy sets the "handled_by" edge to the Account entity.
+func (_c *BloodRequestCreate) SetHandledBy(v *Account) *BloodRequestCreate {
+	return _c.SetHandledByID(v.ID)
+}
+
+// AddUnitIDs adds the "units" edge to the BloodUnit entity by IDs.
+func (_c *BloodRequestCreate) AddUnitIDs(ids ...uuid.UUID) *BloodRequestCreate {
+	_c.mutation.AddUnitIDs(ids...)
+	return _c
+}
+
+// AddUnits adds the "units" edges to the BloodUnit entity.
+func (_c *BloodRequestCreate) AddUnits(v ...*BloodUnit) *BloodRequestCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUnitIDs(ids...)
+}
+
+// Mutation returns the BloodRequestMutation object of the builder.
+func (_c *BloodRequestCreate) Mutation() *BloodRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the BloodRequest in the database.
+func (_c *BloodRequestCreate) Save(ctx context.Context) (*BloodRequest, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BloodRequestCreate) SaveX(ctx context.Context) *BloodRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BloodRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BloodRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BloodRequestCreate) defaults() {
+	if _, ok := _c.mutation.FulfilledQuantity(); !ok {
+		v := bloodrequest.DefaultFulfilledQuantity
+		_c.mutation.SetFulfilledQuantity(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := bloodrequest.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BloodRequestCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := bloodrequest.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := bloodrequest.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := bloodrequest.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PatientName(); !ok {
+		return &ValidationError{Name: "patient_name", err: errors.New(`ent: missing required field "BloodRequest.patient_name"`)}
+	}
+	if v, ok := _c.mutation.PatientName(); ok {
+		if err := bloodrequest.PatientNameValidator(v); err != nil {
+			return &ValidationError{Name: "patient_name", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.patient_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PatientRecordNumber(); ok {
+		if err := bloodrequest.PatientRecordNumberValidator(v); err != nil {
+			return &ValidationError{Name: "patient_record_number", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.patient_record_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Component(); !ok {
+		return &ValidationError{Name: "component", err: errors.New(`ent: missing required field "BloodRequest.component"`)}
+	}
+	if v, ok := _c.mutation.Component(); ok {
+		if err := bloodrequest.ComponentValidator(v); err != nil {
+			return &ValidationError{Name: "component", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.component": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "BloodRequest.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := bloodrequest.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FulfilledQuantity(); !ok {
+		return &ValidationError{Name: "fulfilled_quantity", err: errors.New(`ent: missing required field "BloodRequest.fulfilled_quantity"`)}
+	}
+	if v, ok := _c.mutation.FulfilledQuantity(); ok {
+		if err := bloodrequest.FulfilledQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "fulfilled_quantity", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.fulfilled_quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Urgency(); !ok {
+		return &ValidationError{Name: "urgency", err: errors.New(`ent: missing required field "BloodRequest.urgency"`)}
+	}
+	if v, ok := _c.mutation.Urgency(); ok {
+		if err := bloodrequest.UrgencyValidator(v); err != nil {
+			return &ValidationError{Name: "urgency", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.urgency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequiredBy(); !ok {
+		return &ValidationError{Name: "required_by", err: errors.New(`ent: missing required field "BloodRequest.required_by"`)}
+	}
+	if v, ok := _c.mutation.RequiredBy(); ok {
+		if err := bloodrequest.RequiredByValidator(v); err != nil {
+			return &ValidationError{Name: "required_by", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.required_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BloodRequest.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := bloodrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := bloodrequest.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.note": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := bloodrequest.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.reason": %w`, err)}
+		}
+	}
+	if len(_c.mutation.HospitalIDs()) == 0 {
+		return &ValidationError{Name: "hospital", err: errors.New(`ent: missing required edge "BloodRequest.hospital"`)}
+	}
+	if len(_c.mutation.BloodTypeIDs()) == 0 {
+		return &ValidationError{Name: "blood_type", err: errors.New(`ent: missing required edge "BloodRequest.blood_type"`)}
+	}
+	if len(_c.mutation.PmiLocationIDs()) == 0 {
+		return &ValidationError{Name: "pmi_location", err: errors.New(`ent: missing required edge "BloodRequest.pmi_location"`)}
+	}
+	if len(_c.mutation.RequestedByIDs()) == 0 {
+		return &ValidationError{Name: "requested_by", err: errors.New(`ent: missing required edge "BloodRequest.requested_by"`)}
+	}
+	return nil
+}
+
+func (_c *BloodRequestCreate) sqlSave(ctx context.Context) (*BloodRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BloodRequestCreate) createSpec() (*BloodRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BloodRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bloodrequest.Table, sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bloodrequest.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodrequest.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodrequest.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.PatientName(); ok {
+		_spec.SetField(bloodrequest.FieldPatientName, field.TypeString, value)
+		_node.PatientName = value
+	}
+	if value, ok := _c.mutation.PatientRecordNumber(); ok {
+		_spec.SetField(bloodrequest.FieldPatientRecordNumber, field.TypeString, value)
+		_node.PatientRecordNumber = value
+	}
+	if value, ok := _c.mutation.Component(); ok {
+		_spec.SetField(bloodrequest.FieldComponent, field.TypeEnum, value)
+		_node.Component = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(bloodrequest.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.FulfilledQuantity(); ok {
+		_spec.SetField(bloodrequest.FieldFulfilledQuantity, field.TypeInt, value)
+		_node.FulfilledQuantity = value
+	}
+	if value, ok := _c.mutation.Urgency(); ok {
+		_spec.SetField(bloodrequest.FieldUrgency, field.TypeEnum, value)
+		_node.Urgency = value
+	}
+	if value, ok := _c.mutation.RequiredBy(); ok {
+		_spec.SetField(bloodrequest.FieldRequiredBy, field.TypeInt64, value)
+		_node.RequiredBy = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(bloodrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(bloodrequest.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(bloodrequest.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if nodes := _c.mutation.HospitalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodrequest.HospitalTable,
+			Columns: []string{bloodrequest.HospitalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hospital.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.hospital_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BloodTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodrequest.BloodTypeTable,
+			Columns: []string{bloodrequest.BloodTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodtype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blood_type_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodrequest.PmiLocationTable,
+			Columns: []string{bloodrequest.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pmi_location_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RequestedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodrequest.RequestedByTable,
+			Columns: []string{bloodrequest.RequestedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.requested_by_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HandledByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodrequest.HandledByTable,
+			Columns: []string{bloodrequest.HandledByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.handled_by_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UnitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodrequest.UnitsTable,
+			Columns: []string{bloodrequest.UnitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BloodRequestCreateBulk is the builder for creating many BloodRequest entities in bulk.
+type BloodRequestCreateBulk struct {
+	config
+	err      error
+	builders []*BloodRequestCreate
+}
+
+// Save creates the BloodRequest entities in the database.
+func (_c *BloodRequestCreateBulk) Save(ctx context.Context) ([]*BloodRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BloodRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BloodRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BloodRequestCreateBulk) SaveX(ctx context.Context) []*BloodRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BloodRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BloodRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// BloodRequestDelete is the builder for deleting a BloodRequest entity.
+type BloodRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *BloodRequestMutation
+}
+
+// Where appends a list predicates to the BloodRequestDelete builder.
+func (_d *BloodRequestDelete) Where(ps ...predicate.BloodRequest) *BloodRequestDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BloodRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BloodRequestDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BloodRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bloodrequest.Table, sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BloodRequestDeleteOne is the builder for deleting a single BloodRequest entity.
+type BloodRequestDeleteOne struct {
+	_d *BloodRequestDelete
+}
+
+// Where appends a list predicates to the BloodRequestDelete builder.
+func (_d *BloodRequestDeleteOne) Where(ps ...predicate.BloodRequest) *BloodRequestDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BloodRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bloodrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BloodRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,990 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// BloodRequestQuery is the builder for querying BloodRequest entities.
+type BloodRequestQuery struct {
+	config
+	ctx             *QueryContext
+	order           []bloodrequest.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.BloodRequest
+	withHospital    *HospitalQuery
+	withBloodType   *BloodTypeQuery
+	withPmiLocation *PMILocationQuery
+	withRequestedBy *AccountQuery
+	withHandledBy   *AccountQuery
+	withUnits       *BloodUnitQuery
+	withFKs         bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BloodRequestQuery builder.
+func (_q *BloodRequestQuery) Where(ps ...predicate.BloodRequest) *BloodRequestQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BloodRequestQuery) Limit(limit int) *BloodRequestQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BloodRequestQuery) Offset(offset int) *BloodRequestQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BloodRequestQuery) Unique(unique bool) *BloodRequestQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BloodRequestQuery) Order(o ...bloodrequest.OrderOption) *BloodRequestQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryHospital chains the current query on the "hospital" edge.
+func (_q *BloodRequestQuery) QueryHospital() *HospitalQuery {
+	query := (&HospitalClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, selector),
+			sqlgraph.To(hospital.Table, hospital.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodrequest.HospitalTable, bloodrequest.HospitalColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBloodType chains the current query on the "blood_type" edge.
+func (_q *BloodRequestQuery) QueryBloodType() *BloodTypeQuery {
+	query := (&BloodTypeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, selector),
+			sqlgraph.To(bloodtype.Table, bloodtype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodrequest.BloodTypeTable, bloodrequest.BloodTypeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPmiLocation chains the current query on the "pmi_location" edge.
+func (_q *BloodRequestQuery) QueryPmiLocation() *PMILocationQuery {
+	query := (&PMILocationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, selector),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodrequest.PmiLocationTable, bloodrequest.PmiLocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRequestedBy chains the current query on the "requested_by" edge.
+func (_q *BloodRequestQuery) QueryRequestedBy() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodrequest.RequestedByTable, bloodrequest.RequestedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHandledBy chains the current query on the "handled_by" edge.
+func (_q *BloodRequestQuery) QueryHandledBy() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodrequest.HandledByTable, bloodrequest.HandledByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUnits chains the current query on the "units" edge.
+func (_q *BloodRequestQuery) QueryUnits() *BloodUnitQuery {
+	query := (&BloodUnitClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, selector),
+			sqlgraph.To(bloodunit.Table, bloodunit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, bloodrequest.UnitsTable, bloodrequest.UnitsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BloodRequest entity from the query.
+// Returns a *NotFoundError when no BloodRequest was found.
+func (_q *BloodRequestQuery) First(ctx context.Context) (*BloodRequest, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bloodrequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BloodRequestQuery) FirstX(ctx context.Context) *BloodRequest {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BloodRequest ID from the query.
+// Returns a *NotFoundError when no BloodRequest ID was found.
+func (_q *BloodRequestQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bloodrequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BloodRequestQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BloodRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BloodRequest entity is found.
+// Returns a *NotFoundError when no BloodRequest entities are found.
+func (_q *BloodRequestQuery) Only(ctx context.Context) (*BloodRequest, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bloodrequest.Label}
+	default:
+		return nil, &NotSingularError{bloodrequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BloodRequestQuery) OnlyX(ctx context.Context) *BloodRequest {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BloodRequest ID in the query.
+// Returns a *NotSingularError when more than one BloodRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BloodRequestQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bloodrequest.Label}
+	default:
+		err = &NotSingularError{bloodrequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BloodRequestQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BloodRequests.
+func (_q *BloodRequestQuery) All(ctx context.Context) ([]*BloodRequest, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BloodRequest, *BloodRequestQuery]()
+	return withInterceptors[[]*BloodRequest](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BloodRequestQuery) AllX(ctx context.Context) []*BloodRequest {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BloodRequest IDs.
+func (_q *BloodRequestQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bloodrequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BloodRequestQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BloodRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BloodRequestQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BloodRequestQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BloodRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BloodRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BloodRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BloodRequestQuery) Clone() *BloodRequestQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BloodRequestQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]bloodrequest.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.BloodRequest{}, _q.predicates...),
+		withHospital:    _q.withHospital.Clone(),
+		withBloodType:   _q.withBloodType.Clone(),
+		withPmiLocation: _q.withPmiLocation.Clone(),
+		withRequestedBy: _q.withRequestedBy.Clone(),
+		withHandledBy:   _q.withHandledBy.Clone(),
+		withUnits:       _q.withUnits.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithHospital tells the query-builder to eager-load the nodes that are connected to
+// the "hospital" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodRequestQuery) WithHospital(opts ...func(*HospitalQuery)) *BloodRequestQuery {
+	query := (&HospitalClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHospital = query
+	return _q
+}
+
+// WithBloodType tells the query-builder to eager-load the nodes that are connected to
+// the "blood_type" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodRequestQuery) WithBloodType(opts ...func(*BloodTypeQuery)) *BloodRequestQuery {
+	query := (&BloodTypeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBloodType = query
+	return _q
+}
+
+// WithPmiLocation tells the query-builder to eager-load the nodes that are connected to
+// the "pmi_location" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodRequestQuery) WithPmiLocation(opts ...func(*PMILocationQuery)) *BloodRequestQuery {
+	query := (&PMILocationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPmiLocation = query
+	return _q
+}
+
+// WithRequestedBy tells the query-builder to eager-load the nodes that are connected to
+// the "requested_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodRequestQuery) WithRequestedBy(opts ...func(*AccountQuery)) *BloodRequestQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRequestedBy = query
+	return _q
+}
+
+// WithHandledBy tells the query-builder to eager-load the nodes that are connected to
+// the "handled_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodRequestQuery) WithHandledBy(opts ...func(*AccountQuery)) *BloodRequestQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHandledBy = query
+	return _q
+}
+
+// WithUnits tells the query-builder to eager-load the nodes that are connected to
+// the "units" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodRequestQuery) WithUnits(opts ...func(*BloodUnitQuery)) *BloodRequestQuery {
+	query := (&BloodUnitClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUnits = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt int64 `json:"created_at"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BloodRequest.Query().
+//		GroupBy(bloodrequest.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *BloodRequestQuery) GroupBy(field string, fields ...string) *BloodRequestGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BloodRequestGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bloodrequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt int64 `json:"created_at"`
+//	}
+//
+//	client.BloodRequest.Query().
+//		Select(bloodrequest.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *BloodRequestQuery) Select(fields ...string) *BloodRequestSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BloodRequestSelect{BloodRequestQuery: _q}
+	sbuild.label = bloodrequest.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BloodRequestSelect configured with the given aggregations.
+func (_q *BloodRequestQuery) Aggregate(fns ...AggregateFunc) *BloodRequestSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BloodRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bloodrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BloodRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BloodRequest, error) {
+	var (
+		nodes       = []*BloodRequest{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [6]bool{
+			_q.withHospital != nil,
+			_q.withBloodType != nil,
+			_q.withPmiLocation != nil,
+			_q.withRequestedBy != nil,
+			_q.withHandledBy != nil,
+			_q.withUnits != nil,
+		}
+	)
+	if _q.withHospital != nil || _q.withBloodType != nil || _q.withPmiLocation != nil || _q.withRequestedBy != nil || _q.withHandledBy != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, bloodrequest.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BloodRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BloodRequest{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withHospital; query != nil {
+		if err := _q.loadHospital(ctx, query, nodes, nil,
+			func(n *BloodRequest, e *Hospital) { n.Edges.Hospital = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBloodType; query != nil {
+		if err := _q.loadBloodType(ctx, query, nodes, nil,
+			func(n *BloodRequest, e *BloodType) { n.Edges.BloodType = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPmiLocation; query != nil {
+		if err := _q.loadPmiLocation(ctx, query, nodes, nil,
+			func(n *BloodRequest, e *PMILocation) { n.Edges.PmiLocation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRequestedBy; query != nil {
+		if err := _q.loadRequestedBy(ctx, query, nodes, nil,
+			func(n *BloodRequest, e *Account) { n.Edges.RequestedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withHandledBy; query != nil {
+		if err := _q.loadHandledBy(ctx, query, nodes, nil,
+			func(n *BloodRequest, e *Account) { n.Edges.HandledBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUnits; query != nil {
+		if err := _q.loadUnits(ctx, query, nodes,
+			func(n *BloodRequest) { n.Edges.Units = []*BloodUnit{} },
+			func(n *BloodRequest, e *BloodUnit) { n.Edges.Units = append(n.Edges.Units, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *BloodRequestQuery) loadHospital(ctx context.Context, query *HospitalQuery, nodes []*BloodRequest, init func(*BloodRequest), assign func(*BloodRequest, *Hospital)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodRequest)
+	for i := range nodes {
+		if nodes[i].hospital_id == nil {
+			continue
+		}
+		fk := *nodes[i].hospital_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(hospital.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "hospital_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodRequestQuery) loadBloodType(ctx context.Context, query *BloodTypeQuery, nodes []*BloodRequest, init func(*BloodRequest), assign func(*BloodRequest, *BloodType)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodRequest)
+	for i := range nodes {
+		if nodes[i].blood_type_id == nil {
+			continue
+		}
+		fk := *nodes[i].blood_type_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bloodtype.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "blood_type_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodRequestQuery) loadPmiLocation(ctx context.Context, query *PMILocationQuery, nodes []*BloodRequest, init func(*BloodRequest), assign func(*BloodRequest, *PMILocation)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodRequest)
+	for i := range nodes {
+		if nodes[i].pmi_location_id == nil {
+			continue
+		}
+		fk := *nodes[i].pmi_location_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(pmilocation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pmi_location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodRequestQuery) loadRequestedBy(ctx context.Context, query *AccountQuery, nodes []*BloodRequest, init func(*BloodRequest), assign func(*BloodRequest, *Account)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodRequest)
+	for i := range nodes {
+		if nodes[i].requested_by_id == nil {
+			continue
+		}
+		fk := *nodes[i].requested_by_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "requested_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodRequestQuery) loadHandledBy(ctx context.Context, query *AccountQuery, nodes []*BloodRequest, init func(*BloodRequest), assign func(*BloodRequest, *Account)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodRequest)
+	for i := range nodes {
+		if nodes[i].handled_by_id == nil {
+			continue
+		}
+		fk := *nodes[i].handled_by_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "handled_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodRequestQuery) loadUnits(ctx context.Context, query *BloodUnitQuery, nodes []*BloodRequest, init func(*BloodRequest), assign func(*BloodRequest, *BloodUnit)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*BloodRequest)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BloodUnit(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(bloodrequest.UnitsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.blood_request_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "blood_request_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blood_request_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *BloodRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BloodRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bloodrequest.Table, bloodrequest.Columns, sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bloodrequest.FieldID)
+		for i := range fields {
+			if fields[i] != bloodrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BloodRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bloodrequest.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bloodrequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BloodRequestGroupBy is the group-by builder for BloodRequest entities.
+type BloodRequestGroupBy struct {
+	selector
+	build *BloodRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BloodRequestGroupBy) Aggregate(fns ...AggregateFunc) *BloodRequestGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BloodRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BloodRequestQuery, *BloodRequestGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BloodRequestGroupBy) sqlScan(ctx context.Context, root *BloodRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BloodRequestSelect is the builder for selecting fields of BloodRequest entities.
+type BloodRequestSelect struct {
+	*BloodRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BloodRequestSelect) Aggregate(fns ...AggregateFunc) *BloodRequestSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BloodRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BloodRequestQuery, *BloodRequestSelect](ctx, _s.BloodRequestQuery, _s, _s.inters, v)
+}
+
+func (_s *BloodRequestSelect) sqlScan(ctx context.Context, root *BloodRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,892 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// BloodRequestUpdate is the builder for updating BloodRequest entities.
+type BloodRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BloodRequestMutation
+}
+
+// Where appends a list predicates to the BloodRequestUpdate builder.
+func (_u *BloodRequestUpdate) Where(ps ...predicate.BloodRequest) *BloodRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BloodRequestUpdate) SetUpdatedAt(v int64) *BloodRequestUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *BloodRequestUpdate) AddUpdatedAt(v int64) *BloodRequestUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BloodRequestUpdate) ClearUpdatedAt() *BloodRequestUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BloodRequestUpdate) SetDeletedAt(v int64) *BloodRequestUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BloodRequestUpdate) SetNillableDeletedAt(v *int64) *BloodRequestUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *BloodRequestUpdate) AddDeletedAt(v int64) *BloodRequestUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BloodRequestUpdate) ClearDeletedAt() *BloodRequestUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFulfilledQuantity sets the "fulfilled_quantity" field.
+func (_u *BloodRequestUpdate) SetFulfilledQuantity(v int) *BloodRequestUpdate {
+	_u.mutation.ResetFulfilledQuantity()
+	_u.mutation.SetFulfilledQuantity(v)
+	return _u
+}
+
+// SetNillableFulfilledQuantity sets the "fulfilled_quantity" field if the given value is not nil.
+func (_u *BloodRequestUpdate) SetNillableFulfilledQuantity(v *int) *BloodRequestUpdate {
+	if v != nil {
+		_u.SetFulfilledQuantity(*v)
+	}
+	return _u
+}
+
+// AddFulfilledQuantity adds value to the "fulfilled_quantity" field.
+func (_u *BloodRequestUpdate) AddFulfilledQuantity(v int) *BloodRequestUpdate {
+	_u.mutation.AddFulfilledQuantity(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BloodRequestUpdate) SetStatus(v bloodrequest.Status) *BloodRequestUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BloodRequestUpdate) SetNillableStatus(v *bloodrequest.Status) *BloodRequestUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *BloodRequestUpdate) SetNote(v string) *BloodRequestUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *BloodRequestUpdate) SetNillableNote(v *string) *BloodRequestUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *BloodRequestUpdate) ClearNote() *BloodRequestUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *BloodRequestUpdate) SetReason(v string) *BloodRequestUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *BloodRequestUpdate) SetNillableReason(v *string) *BloodRequestUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *BloodRequestUpdate) ClearReason() *BloodRequestUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetHandledByID sets the "handled_by" edge to the Account entity by ID.
+func (_u *BloodRequestUpdate) SetHandledByID(id uuid.UUID) *BloodRequestUpdate {
+	_u.mutation.SetHandledByID(id)
+	return _u
+}
+
+// SetNillableHandledByID sets the "handled_by" edge to the Account entity by ID if the given value is not nil.
+func (_u *BloodRequestUpdate) SetNillableHandledByID(id *uuid.UUID) *BloodRequestUpdate {
+	if id != nil {
+		_u = _u.SetHandledByID(*id)
+	}
+	return _u
+}
+
+// SetHandledBy sets the "handled_by" edge to the Account entity.
+func (_u *BloodRequestUpdate) SetHandledBy(v *Account) *BloodRequestUpdate {
+	return _u.SetHandledByID(v.ID)
+}
+
+// AddUnitIDs adds the "units" edge to the BloodUnit entity by IDs.
+func (_u *BloodRequestUpdate) AddUnitIDs(ids ...uuid.UUID) *BloodRequestUpdate {
+	_u.mutation.AddUnitIDs(ids...)
+	return _u
+}
+
+// AddUnits adds the "units" edges to the BloodUnit entity.
+func (_u *BloodRequestUpdate) AddUnits(v ...*BloodUnit) *BloodRequestUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUnitIDs(ids...)
+}
+
+// Mutation returns the BloodRequestMutation object of the builder.
+func (_u *BloodRequestUpdate) Mutation() *BloodRequestMutation {
+	return _u.mutation
+}
+
+// ClearHandledBy clears the "handled_by" edge to the Account entity.
+func (_u *BloodRequestUpdate) ClearHandledBy() *BloodRequestUpdate {
+	_u.mutation.ClearHandledBy()
+	return _u
+}
+
+// ClearUnits clears all "units" edges to the BloodUnit entity.
+func (_u *BloodRequestUpdate) ClearUnits() *BloodRequestUpdate {
+	_u.mutation.ClearUnits()
+	return _u
+}
+
+// RemoveUnitIDs removes the "units" edge to BloodUnit entities by IDs.
+func (_u *BloodRequestUpdate) RemoveUnitIDs(ids ...uuid.UUID) *BloodRequestUpdate {
+	_u.mutation.RemoveUnitIDs(ids...)
+	return _u
+}
+
+// RemoveUnits removes "units" edges to BloodUnit entities.
+func (_u *BloodRequestUpdate) RemoveUnits(v ...*BloodUnit) *BloodRequestUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUnitIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BloodRequestUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BloodRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BloodRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BloodRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BloodRequestUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := bloodrequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BloodRequestUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := bloodrequest.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := bloodrequest.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FulfilledQuantity(); ok {
+		if err := bloodrequest.FulfilledQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "fulfilled_quantity", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.fulfilled_quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := bloodrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := bloodrequest.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := bloodrequest.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.HospitalCleared() && len(_u.mutation.HospitalIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodRequest.hospital"`)
+	}
+	if _u.mutation.BloodTypeCleared() && len(_u.mutation.BloodTypeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodRequest.blood_type"`)
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodRequest.pmi_location"`)
+	}
+	if _u.mutation.RequestedByCleared() && len(_u.mutation.RequestedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodRequest.requested_by"`)
+	}
+	return nil
+}
+
+func (_u *BloodRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bloodrequest.Table, bloodrequest.Columns, sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodrequest.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(bloodrequest.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(bloodrequest.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodrequest.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(bloodrequest.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(bloodrequest.FieldDeletedAt, field.TypeInt64)
+	}
+	if _u.mutation.PatientRecordNumberCleared() {
+		_spec.ClearField(bloodrequest.FieldPatientRecordNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.FulfilledQuantity(); ok {
+		_spec.SetField(bloodrequest.FieldFulfilledQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFulfilledQuantity(); ok {
+		_spec.AddField(bloodrequest.FieldFulfilledQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bloodrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(bloodrequest.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(bloodrequest.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(bloodrequest.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(bloodrequest.FieldReason, field.TypeString)
+	}
+	if _u.mutation.HandledByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodrequest.HandledByTable,
+			Columns: []string{bloodrequest.HandledByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HandledByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodrequest.HandledByTable,
+			Columns: []string{bloodrequest.HandledByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UnitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodrequest.UnitsTable,
+			Columns: []string{bloodrequest.UnitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUnitsIDs(); len(nodes) > 0 && !_u.mutation.UnitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodrequest.UnitsTable,
+			Columns: []string{bloodrequest.UnitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UnitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodrequest.UnitsTable,
+			Columns: []string{bloodrequest.UnitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bloodrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BloodRequestUpdateOne is the builder for updating a single BloodRequest entity.
+type BloodRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BloodRequestMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BloodRequestUpdateOne) SetUpdatedAt(v int64) *BloodRequestUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *BloodRequestUpdateOne) AddUpdatedAt(v int64) *BloodRequestUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BloodRequestUpdateOne) ClearUpdatedAt() *BloodRequestUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BloodRequestUpdateOne) SetDeletedAt(v int64) *BloodRequestUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BloodRequestUpdateOne) SetNillableDeletedAt(v *int64) *BloodRequestUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *BloodRequestUpdateOne) AddDeletedAt(v int64) *BloodRequestUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BloodRequestUpdateOne) ClearDeletedAt() *BloodRequestUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFulfilledQuantity sets the "fulfilled_quantity" field.
+func (_u *BloodRequestUpdateOne) SetFulfilledQuantity(v int) *BloodRequestUpdateOne {
+	_u.mutation.ResetFulfilledQuantity()
+	_u.mutation.SetFulfilledQuantity(v)
+	return _u
+}
+
+// SetNillableFulfilledQuantity sets the "fulfilled_quantity" field if the given value is not nil.
+func (_u *BloodRequestUpdateOne) SetNillableFulfilledQuantity(v *int) *BloodRequestUpdateOne {
+	if v != nil {
+		_u.SetFulfilledQuantity(*v)
+	}
+	return _u
+}
+
+// AddFulfilledQuantity adds value to the "fulfilled_quantity" field.
+func (_u *BloodRequestUpdateOne) AddFulfilledQuantity(v int) *BloodRequestUpdateOne {
+	_u.mutation.AddFulfilledQuantity(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BloodRequestUpdateOne) SetStatus(v bloodrequest.Status) *BloodRequestUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BloodRequestUpdateOne) SetNillableStatus(v *bloodrequest.Status) *BloodRequestUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *BloodRequestUpdateOne) SetNote(v string) *BloodRequestUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *BloodRequestUpdateOne) SetNillableNote(v *string) *BloodRequestUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *BloodRequestUpdateOne) ClearNote() *BloodRequestUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *BloodRequestUpdateOne) SetReason(v string) *BloodRequestUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *BloodRequestUpdateOne) SetNillableReason(v *string) *BloodRequestUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *BloodRequestUpdateOne) ClearReason() *BloodRequestUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetHandledByID sets the "handled_by" edge to the Account entity by ID.
+func (_u *BloodRequestUpdateOne) SetHandledByID(id uuid.UUID) *BloodRequestUpdateOne {
+	_u.mutation.SetHandledByID(id)
+	return _u
+}
+
+// SetNillableHandledByID sets the "handled_by" edge to the Account entity by ID if the given value is not nil.
+func (_u *BloodRequestUpdateOne) SetNillableHandledByID(id *uuid.UUID) *BloodRequestUpdateOne {
+	if id != nil {
+		_u = _u.SetHandledByID(*id)
+	}
+	return _u
+}
+
+// SetHandledBy sets the "handled_by" edge to the Account entity.
+func (_u *BloodRequestUpdateOne) SetHandledBy(v *Account) *BloodRequestUpdateOne {
+	return _u.SetHandledByID(v.ID)
+}
+
+// AddUnitIDs adds the "units" edge to the BloodUnit entity by IDs.
+func (_u *BloodRequestUpdateOne) AddUnitIDs(ids ...uuid.UUID) *BloodRequestUpdateOne {
+	_u.mutation.AddUnitIDs(ids...)
+	return _u
+}
+
+// AddUnits adds the "units" edges to the BloodUnit entity.
+func (_u *BloodRequestUpdateOne) AddUnits(v ...*BloodUnit) *BloodRequestUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUnitIDs(ids...)
+}
+
+// Mutation returns the BloodRequestMutation object of the builder.
+func (_u *BloodRequestUpdateOne) Mutation() *BloodRequestMutation {
+	return _u.mutation
+}
+
+// ClearHandledBy clears the "handled_by" edge to the Account entity.
+func (_u *BloodRequestUpdateOne) ClearHandledBy() *BloodRequestUpdateOne {
+	_u.mutation.ClearHandledBy()
+	return _u
+}
+
+// ClearUnits clears all "units" edges to the BloodUnit entity.
+func (_u *BloodRequestUpdateOne) ClearUnits() *BloodRequestUpdateOne {
+	_u.mutation.ClearUnits()
+	return _u
+}
+
+// RemoveUnitIDs removes the "units" edge to BloodUnit entities by IDs.
+func (_u *BloodRequestUpdateOne) RemoveUnitIDs(ids ...uuid.UUID) *BloodRequestUpdateOne {
+	_u.mutation.RemoveUnitIDs(ids...)
+	return _u
+}
+
+// RemoveUnits removes "units" edges to BloodUnit entities.
+func (_u *BloodRequestUpdateOne) RemoveUnits(v ...*BloodUnit) *BloodRequestUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUnitIDs(ids...)
+}
+
+// Where appends a list predicates to the BloodRequestUpdate builder.
+func (_u *BloodRequestUpdateOne) Where(ps ...predicate.BloodRequest) *BloodRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BloodRequestUpdateOne) Select(field string, fields ...string) *BloodRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BloodRequest entity.
+func (_u *BloodRequestUpdateOne) Save(ctx context.Context) (*BloodRequest, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BloodRequestUpdateOne) SaveX(ctx context.Context) *BloodRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BloodRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BloodRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BloodRequestUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := bloodrequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BloodRequestUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := bloodrequest.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := bloodrequest.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FulfilledQuantity(); ok {
+		if err := bloodrequest.FulfilledQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "fulfilled_quantity", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.fulfilled_quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := bloodrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := bloodrequest.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := bloodrequest.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "BloodRequest.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.HospitalCleared() && len(_u.mutation.HospitalIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodRequest.hospital"`)
+	}
+	if _u.mutation.BloodTypeCleared() && len(_u.mutation.BloodTypeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodRequest.blood_type"`)
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodRequest.pmi_location"`)
+	}
+	if _u.mutation.RequestedByCleared() && len(_u.mutation.RequestedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodRequest.requested_by"`)
+	}
+	return nil
+}
+
+func (_u *BloodRequestUpdateOne) sqlSave(ctx context.Context) (_node *BloodRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bloodrequest.Table, bloodrequest.Columns, sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BloodRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bloodrequest.FieldID)
+		for _, f := range fields {
+			if !bloodrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bloodrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodrequest.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(bloodrequest.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(bloodrequest.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodrequest.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(bloodrequest.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(bloodrequest.FieldDeletedAt, field.TypeInt64)
+	}
+	if _u.mutation.PatientRecordNumberCleared() {
+		_spec.ClearField(bloodrequest.FieldPatientRecordNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.FulfilledQuantity(); ok {
+		_spec.SetField(bloodrequest.FieldFulfilledQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFulfilledQuantity(); ok {
+		_spec.AddField(bloodrequest.FieldFulfilledQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bloodrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(bloodrequest.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(bloodrequest.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(bloodrequest.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(bloodrequest.FieldReason, field.TypeString)
+	}
+	if _u.mutation.HandledByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodrequest.HandledByTable,
+			Columns: []string{bloodrequest.HandledByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HandledByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodrequest.HandledByTable,
+			Columns: []string{bloodrequest.HandledByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UnitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodrequest.UnitsTable,
+			Columns: []string{bloodrequest.UnitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUnitsIDs(); len(nodes) > 0 && !_u.mutation.UnitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodrequest.UnitsTable,
+			Columns: []string{bloodrequest.UnitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UnitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodrequest.UnitsTable,
+			Columns: []string{bloodrequest.UnitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BloodRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bloodrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
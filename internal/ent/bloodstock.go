@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+)
+
+// BloodStock is the model entity for the BloodStock schema.
+type BloodStock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Blood component: whole blood, packed red cells, thrombocyte concentrate or fresh frozen plasma.
+	Component bloodstock.Component `json:"component"`
+	// Number of bags in stock. Only changed through stock movements.
+	Quantity int `json:"quantity"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BloodStockQuery when eager-loading is set.
+	Edges           BloodStockEdges `json:"edges"`
+	pmi_location_id *uuid.UUID
+	blood_type_id   *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// BloodStockEdges holds the relations/edges for other nodes in the graph.
+type BloodStockEdges struct {
+	// PmiLocation holds the value of the pmi_location edge.
+	PmiLocation *PMILocation `json:"pmi_location,omitempty"`
+	// BloodType holds the value of the blood_type edge.
+	BloodType *BloodType `json:"blood_type,omitempty"`
+	// Movements holds the value of the movements edge.
+	Movements []*StockMovement `json:"movements,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PmiLocationOrErr returns the PmiLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodStockEdges) PmiLocationOrErr() (*PMILocation, error) {
+	if e.PmiLocation != nil {
+		return e.PmiLocation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: pmilocation.Label}
+	}
+	return nil, &NotLoadedError{edge: "pmi_location"}
+}
+
+// BloodTypeOrErr returns the BloodType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodStockEdges) BloodTypeOrErr() (*BloodType, error) {
+	if e.BloodType != nil {
+		return e.BloodType, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: bloodtype.Label}
+	}
+	return nil, &NotLoadedError{edge: "blood_type"}
+}
+
+// MovementsOrErr returns the Movements value or an error if the edge
+// was not loaded in eager-loading.
+func (e BloodStockEdges) MovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[2] {
+		return e.Movements, nil
+	}
+	return nil, &NotLoadedError{edge: "movements"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BloodStock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bloodstock.FieldCreatedAt, bloodstock.FieldUpdatedAt, bloodstock.FieldDeletedAt, bloodstock.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case bloodstock.FieldComponent:
+			values[i] = new(sql.NullString)
+		case bloodstock.FieldID:
+			values[i] = new(uuid.UUID)
+		case bloodstock.ForeignKeys[0]: // pmi_location_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bloodstock.ForeignKeys[1]: // blood_type_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BloodStock fields.
+func (_m *BloodStock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bloodstock.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bloodstock.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case bloodstock.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case bloodstock.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case bloodstock.FieldComponent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field component", values[i])
+			} else if value.Valid {
+				_m.Component = bloodstock.Component(value.String)
+			}
+		case bloodstock.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case bloodstock.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field pmi_location_id", values[i])
+			} else if value.Valid {
+				_m.pmi_location_id = new(uuid.UUID)
+				*_m.pmi_location_id = *value.S.(*uuid.UUID)
+			}
+		case bloodstock.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field blood_type_id", values[i])
+			} else if value.Valid {
+				_m.blood_type_id = new(uuid.UUID)
+				*_m.blood_type_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BloodStock.
+// This includes values selected through modifiers, order, etc.
+func (_m *BloodStock) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPmiLocation queries the "pmi_location" edge of the BloodStock entity.
+func (_m *BloodStock) QueryPmiLocation() *PMILocationQuery {
+	return NewBloodStockClient(_m.config).QueryPmiLocation(_m)
+}
+
+// QueryBloodType queries the "blood_type" edge of the BloodStock entity.
+func (_m *BloodStock) QueryBloodType() *BloodTypeQuery {
+	return NewBloodStockClient(_m.config).QueryBloodType(_m)
+}
+
+// QueryMovements queries the "movements" edge of the BloodStock entity.
+func (_m *BloodStock) QueryMovements() *StockMovementQuery {
+	return NewBloodStockClient(_m.config).QueryMovements(_m)
+}
+
+// Update returns a builder for updating this BloodStock.
+// Note that you need to call BloodStock.Unwrap() before calling this method if this BloodStock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BloodStock) Update() *BloodStockUpdateOne {
+	return NewBloodStockClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BloodStock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BloodStock) Unwrap() *BloodStock {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BloodStock is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BloodStock) String() string {
+	var builder strings.Builder
+	builder.WriteString("BloodStock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("component=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Component))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BloodStocks is a parsable slice of BloodStock.
+type BloodStocks []*BloodStock
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bloodstock
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the bloodstock type in the database.
+	Label = "blood_stock"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldComponent holds the string denoting the component field in the database.
+	FieldComponent = "component"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// EdgePmiLocation holds the string denoting the pmi_location edge name in mutations.
+	EdgePmiLocation = "pmi_location"
+	// EdgeBloodType holds the string denoting the blood_type edge name in mutations.
+	EdgeBloodType = "blood_type"
+	// EdgeMovements holds the string denoting the movements edge name in mutations.
+	EdgeMovements = "movements"
+	// Table holds the table name of the bloodstock in the database.
+	Table = "blood_stocks"
+	// PmiLocationTable is the table that holds the pmi_location relation/edge.
+	PmiLocationTable = "blood_stocks"
+	// PmiLocationInverseTable is the table name for the PMILocation entity.
+	// It exists in this package in order to avoid circular dependency with the "pmilocation" package.
+	PmiLocationInverseTable = "pmi_locations"
+	// PmiLocationColumn is the table column denoting the pmi_location relation/edge.
+	PmiLocationColumn = "pmi_location_id"
+	// BloodTypeTable is the table that holds the blood_type relation/edge.
+	BloodTypeTable = "blood_stocks"
+	// BloodTypeInverseTable is the table name for the BloodType entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodtype" package.
+	BloodTypeInverseTable = "blood_types"
+	// BloodTypeColumn is the table column denoting the blood_type relation/edge.
+	BloodTypeColumn = "blood_type_id"
+	// MovementsTable is the table that holds the movements relation/edge.
+	MovementsTable = "stock_movements"
+	// MovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	MovementsInverseTable = "stock_movements"
+	// MovementsColumn is the table column denoting the movements relation/edge.
+	MovementsColumn = "blood_stock_id"
+)
+
+// Columns holds all SQL columns for bloodstock fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldComponent,
+	FieldQuantity,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "blood_stocks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"pmi_location_id",
+	"blood_type_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// DefaultQuantity holds the default value on creation for the "quantity" field.
+	DefaultQuantity int
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+)
+
+// Component defines the type for the "component" enum field.
+type Component string
+
+// Component values.
+const (
+	ComponentWholeBlood Component = "WHOLE_BLOOD"
+	ComponentPRC        Component = "PRC"
+	ComponentTC         Component = "TC"
+	ComponentFFP        Component = "FFP"
+)
+
+func (c Component) String() string {
+	return string(c)
+}
+
+// ComponentValidator is a validator for the "component" field enum values. It is called by the builders before save.
+func ComponentValidator(c Component) error {
+	switch c {
+	case ComponentWholeBlood, ComponentPRC, ComponentTC, ComponentFFP:
+		return nil
+	default:
+		return fmt.Errorf("bloodstock: invalid enum value for component field: %q", c)
+	}
+}
+
+// OrderOption defines the ordering options for the BloodStock queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByComponent orders the results by the component field.
+func ByComponent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComponent, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByPmiLocationField orders the results by pmi_location field.
+func ByPmiLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPmiLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBloodTypeField orders the results by blood_type field.
+func ByBloodTypeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBloodTypeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMovementsCount orders the results by movements count.
+func ByMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMovementsStep(), opts...)
+	}
+}
+
+// ByMovements orders the results by movements terms.
+func ByMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPmiLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PmiLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+	)
+}
+func newBloodTypeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BloodTypeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, BloodTypeTable, BloodTypeColumn),
+	)
+}
+func newMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MovementsTable, MovementsColumn),
+	)
+}
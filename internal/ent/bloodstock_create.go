@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+// BloodStockCreate is the builder for creating a BloodStock entity.
+type BloodStockCreate struct {
+	config
+	mutation *BloodStockMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BloodStockCreate) SetCreatedAt(v int64) *BloodStockCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BloodStockCreate) SetUpdatedAt(v int64) *BloodStockCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BloodStockCreate) SetNillableUpdatedAt(v *int64) *BloodStockCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *BloodStockCreate) SetDeletedAt(v int64) *BloodStockCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *BloodStockCreate) SetNillableDeletedAt(v *int64) *BloodStockCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetComponent sets the "component" field.
+func (_c *BloodStockCreate) SetComponent(v bloodstock.Component) *BloodStockCreate {
+	_c.mutation.SetComponent(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *BloodStockCreate) SetQuantity(v int) *BloodStockCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_c *BloodStockCreate) SetNillableQuantity(v *int) *BloodStockCreate {
+	if v != nil {
+		_c.SetQuantity(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BloodStockCreate) SetID(v uuid.UUID) *BloodStockCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_c *BloodStockCreate) SetPmiLocationID(id uuid.UUID) *BloodStockCreate {
+	_c.mutation.SetPmiLocationID(id)
+	return _c
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_c *BloodStockCreate) SetPmiLocation(v *PMILocation) *BloodStockCreate {
+	return _c.SetPmiLocationID(v.ID)
+}
+
+// SetBloodTypeID sets the "blood_type" edge to the BloodType entity by ID.
+func (_c *BloodStockCreate) SetBloodTypeID(id uuid.UUID) *BloodStockCreate {
+	_c.mutation.SetBloodTypeID(id)
+	return _c
+}
+
+// SetBloodType sets the "blood_type" edge to the BloodType entity.
+func (_c *BloodStockCreate) SetBloodType(v *BloodType) *BloodStockCreate {
+	return _c.SetBloodTypeID(v.ID)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_c *BloodStockCreate) AddMovementIDs(ids ...uuid.UUID) *BloodStockCreate {
+	_c.mutation.AddMovementIDs(ids...)
+	return _c
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_c *BloodStockCreate) AddMovements(v ...*StockMovement) *BloodStockCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMovementIDs(ids...)
+}
+
+// Mutation returns the BloodStockMutation object of the builder.
+func (_c *BloodStockCreate) Mutation() *BloodStockMutation {
+	return _c.mutation
+}
+
+// Save creates the BloodStock in the database.
+func (_c *BloodStockCreate) Save(ctx context.Context) (*BloodStock, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BloodStockCreate) SaveX(ctx context.Context) *BloodStock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BloodStockCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BloodStockCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BloodStockCreate) defaults() {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		v := bloodstock.DefaultQuantity
+		_c.mutation.SetQuantity(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BloodStockCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := bloodstock.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "BloodStock.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := bloodstock.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodStock.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := bloodstock.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodStock.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Component(); !ok {
+		return &ValidationError{Name: "component", err: errors.New(`ent: missing required field "BloodStock.component"`)}
+	}
+	if v, ok := _c.mutation.Component(); ok {
+		if err := bloodstock.ComponentValidator(v); err != nil {
+			return &ValidationError{Name: "component", err: fmt.Errorf(`ent: validator failed for field "BloodStock.component": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "BloodStock.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := bloodstock.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BloodStock.quantity": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PmiLocationIDs()) == 0 {
+		return &ValidationError{Name: "pmi_location", err: errors.New(`ent: missing required edge "BloodStock.pmi_location"`)}
+	}
+	if len(_c.mutation.BloodTypeIDs()) == 0 {
+		return &ValidationError{Name: "blood_type", err: errors.New(`ent: missing required edge "BloodStock.blood_type"`)}
+	}
+	return nil
+}
+
+func (_c *BloodStockCreate) sqlSave(ctx context.Context) (*BloodStock, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BloodStockCreate) createSpec() (*BloodStock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BloodStock{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bloodstock.Table, sqlgraph.NewFieldSpec(bloodstock.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bloodstock.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodstock.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodstock.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Component(); ok {
+		_spec.SetField(bloodstock.FieldComponent, field.TypeEnum, value)
+		_node.Component = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(bloodstock.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if nodes := _c.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodstock.PmiLocationTable,
+			Columns: []string{bloodstock.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pmi_location_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BloodTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodstock.BloodTypeTable,
+			Columns: []string{bloodstock.BloodTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodtype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blood_type_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodstock.MovementsTable,
+			Columns: []string{bloodstock.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BloodStockCreateBulk is the builder for creating many BloodStock entities in bulk.
+type BloodStockCreateBulk struct {
+	config
+	err      error
+	builders []*BloodStockCreate
+}
+
+// Save creates the BloodStock entities in the database.
+func (_c *BloodStockCreateBulk) Save(ctx context.Context) ([]*BloodStock, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BloodStock, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BloodStockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BloodStockCreateBulk) SaveX(ctx context.Context) []*BloodStock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BloodStockCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BloodStockCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+// BloodStockQuery is the builder for querying BloodStock entities.
+type BloodStockQuery struct {
+	config
+	ctx             *QueryContext
+	order           []bloodstock.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.BloodStock
+	withPmiLocation *PMILocationQuery
+	withBloodType   *BloodTypeQuery
+	withMovements   *StockMovementQuery
+	withFKs         bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BloodStockQuery builder.
+func (_q *BloodStockQuery) Where(ps ...predicate.BloodStock) *BloodStockQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BloodStockQuery) Limit(limit int) *BloodStockQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BloodStockQuery) Offset(offset int) *BloodStockQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BloodStockQuery) Unique(unique bool) *BloodStockQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BloodStockQuery) Order(o ...bloodstock.OrderOption) *BloodStockQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryPmiLocation chains the current query on the "pmi_location" edge.
+func (_q *BloodStockQuery) QueryPmiLocation() *PMILocationQuery {
+	query := (&PMILocationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodstock.Table, bloodstock.FieldID, selector),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodstock.PmiLocationTable, bloodstock.PmiLocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBloodType chains the current query on the "blood_type" edge.
+func (_q *BloodStockQuery) QueryBloodType() *BloodTypeQuery {
+	query := (&BloodTypeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodstock.Table, bloodstock.FieldID, selector),
+			sqlgraph.To(bloodtype.Table, bloodtype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodstock.BloodTypeTable, bloodstock.BloodTypeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMovements chains the current query on the "movements" edge.
+func (_q *BloodStockQuery) QueryMovements() *StockMovementQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodstock.Table, bloodstock.FieldID, selector),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, bloodstock.MovementsTable, bloodstock.MovementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BloodStock entity from the query.
+// Returns a *NotFoundError when no BloodStock was found.
+func (_q *BloodStockQuery) First(ctx context.Context) (*BloodStock, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bloodstock.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BloodStockQuery) FirstX(ctx context.Context) *BloodStock {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BloodStock ID from the query.
+// Returns a *NotFoundError when no BloodStock ID was found.
+func (_q *BloodStockQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bloodstock.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BloodStockQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BloodStock entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BloodStock entity is found.
+// Returns a *NotFoundError when no BloodStock entities are found.
+func (_q *BloodStockQuery) Only(ctx context.Context) (*BloodStock, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bloodstock.Label}
+	default:
+		return nil, &NotSingularError{bloodstock.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BloodStockQuery) OnlyX(ctx context.Context) *BloodStock {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BloodStock ID in the query.
+// Returns a *NotSingularError when more than one BloodStock ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BloodStockQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bloodstock.Label}
+	default:
+		err = &NotSingularError{bloodstock.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BloodStockQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BloodStocks.
+func (_q *BloodStockQuery) All(ctx context.Context) ([]*BloodStock, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BloodStock, *BloodStockQuery]()
+	return withInterceptors[[]*BloodStock](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BloodStockQuery) AllX(ctx context.Context) []*BloodStock {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BloodStock IDs.
+func (_q *BloodStockQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bloodstock.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BloodStockQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BloodStockQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BloodStockQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BloodStockQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BloodStockQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BloodStockQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BloodStockQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BloodStockQuery) Clone() *BloodStockQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BloodStockQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]bloodstock.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.BloodStock{}, _q.predicates...),
+		withPmiLocation: _q.withPmiLocation.Clone(),
+		withBloodType:   _q.withBloodType.Clone(),
+		withMovements:   _q.withMovements.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithPmiLocation tells the query-builder to eager-load the nodes that are connected to
+// the "pmi_location" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodStockQuery) WithPmiLocation(opts ...func(*PMILocationQuery)) *BloodStockQuery {
+	query := (&PMILocationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPmiLocation = query
+	return _q
+}
+
+// WithBloodType tells the query-builder to eager-load the nodes that are connected to
+// the "blood_type" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodStockQuery) WithBloodType(opts ...func(*BloodTypeQuery)) *BloodStockQuery {
+	query := (&BloodTypeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBloodType = query
+	return _q
+}
+
+// WithMovements tells the query-builder to eager-load the nodes that are connected to
+// the "movements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodStockQuery) WithMovements(opts ...func(*StockMovementQuery)) *BloodStockQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMovements = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt int64 `json:"created_at"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BloodStock.Query().
+//		GroupBy(bloodstock.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *BloodStockQuery) GroupBy(field string, fields ...string) *BloodStockGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BloodStockGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bloodstock.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt int64 `json:"created_at"`
+//	}
+//
+//	client.BloodStock.Query().
+//		Select(bloodstock.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *BloodStockQuery) Select(fields ...string) *BloodStockSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BloodStockSelect{BloodStockQuery: _q}
+	sbuild.label = bloodstock.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BloodStockSelect configured with the given aggregations.
+func (_q *BloodStockQuery) Aggregate(fns ...AggregateFunc) *BloodStockSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BloodStockQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bloodstock.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BloodStockQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BloodStock, error) {
+	var (
+		nodes       = []*BloodStock{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withPmiLocation != nil,
+			_q.withBloodType != nil,
+			_q.withMovements != nil,
+		}
+	)
+	if _q.withPmiLocation != nil || _q.withBloodType != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, bloodstock.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BloodStock).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BloodStock{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withPmiLocation; query != nil {
+		if err := _q.loadPmiLocation(ctx, query, nodes, nil,
+			func(n *BloodStock, e *PMILocation) { n.Edges.PmiLocation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBloodType; query != nil {
+		if err := _q.loadBloodType(ctx, query, nodes, nil,
+			func(n *BloodStock, e *BloodType) { n.Edges.BloodType = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMovements; query != nil {
+		if err := _q.loadMovements(ctx, query, nodes,
+			func(n *BloodStock) { n.Edges.Movements = []*StockMovement{} },
+			func(n *BloodStock, e *StockMovement) { n.Edges.Movements = append(n.Edges.Movements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *BloodStockQuery) loadPmiLocation(ctx context.Context, query *PMILocationQuery, nodes []*BloodStock, init func(*BloodStock), assign func(*BloodStock, *PMILocation)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodStock)
+	for i := range nodes {
+		if nodes[i].pmi_location_id == nil {
+			continue
+		}
+		fk := *nodes[i].pmi_location_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(pmilocation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pmi_location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodStockQuery) loadBloodType(ctx context.Context, query *BloodTypeQuery, nodes []*BloodStock, init func(*BloodStock), assign func(*BloodStock, *BloodType)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodStock)
+	for i := range nodes {
+		if nodes[i].blood_type_id == nil {
+			continue
+		}
+		fk := *nodes[i].blood_type_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bloodtype.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "blood_type_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodStockQuery) loadMovements(ctx context.Context, query *StockMovementQuery, nodes []*BloodStock, init func(*BloodStock), assign func(*BloodStock, *StockMovement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*BloodStock)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(bloodstock.MovementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.blood_stock_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "blood_stock_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blood_stock_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *BloodStockQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BloodStockQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bloodstock.Table, bloodstock.Columns, sqlgraph.NewFieldSpec(bloodstock.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bloodstock.FieldID)
+		for i := range fields {
+			if fields[i] != bloodstock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BloodStockQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bloodstock.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bloodstock.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BloodStockGroupBy is the group-by builder for BloodStock entities.
+type BloodStockGroupBy struct {
+	selector
+	build *BloodStockQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BloodStockGroupBy) Aggregate(fns ...AggregateFunc) *BloodStockGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BloodStockGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BloodStockQuery, *BloodStockGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BloodStockGroupBy) sqlScan(ctx context.Context, root *BloodStockQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BloodStockSelect is the builder for selecting fields of BloodStock entities.
+type BloodStockSelect struct {
+	*BloodStockQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BloodStockSelect) Aggregate(fns ...AggregateFunc) *BloodStockSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BloodStockSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BloodStockQuery, *BloodStockSelect](ctx, _s.BloodStockQuery, _s, _s.inters, v)
+}
+
+func (_s *BloodStockSelect) sqlScan(ctx context.Context, root *BloodStockQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
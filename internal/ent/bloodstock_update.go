@@ -0,0 +1,827 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+// BloodStockUpdate is the builder for updating BloodStock entities.
+type BloodStockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BloodStockMutation
+}
+
+// Where appends a list predicates to the BloodStockUpdate builder.
+func (_u *BloodStockUpdate) Where(ps ...predicate.BloodStock) *BloodStockUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BloodStockUpdate) SetUpdatedAt(v int64) *BloodStockUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *BloodStockUpdate) AddUpdatedAt(v int64) *BloodStockUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BloodStockUpdate) ClearUpdatedAt() *BloodStockUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BloodStockUpdate) SetDeletedAt(v int64) *BloodStockUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BloodStockUpdate) SetNillableDeletedAt(v *int64) *BloodStockUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *BloodStockUpdate) AddDeletedAt(v int64) *BloodStockUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BloodStockUpdate) ClearDeletedAt() *BloodStockUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetComponent sets the "component" field.
+func (_u *BloodStockUpdate) SetComponent(v bloodstock.Component) *BloodStockUpdate {
+	_u.mutation.SetComponent(v)
+	return _u
+}
+
+// SetNillableComponent sets the "component" field if the given value is not nil.
+func (_u *BloodStockUpdate) SetNillableComponent(v *bloodstock.Component) *BloodStockUpdate {
+	if v != nil {
+		_u.SetComponent(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *BloodStockUpdate) SetQuantity(v int) *BloodStockUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *BloodStockUpdate) SetNillableQuantity(v *int) *BloodStockUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *BloodStockUpdate) AddQuantity(v int) *BloodStockUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_u *BloodStockUpdate) SetPmiLocationID(id uuid.UUID) *BloodStockUpdate {
+	_u.mutation.SetPmiLocationID(id)
+	return _u
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_u *BloodStockUpdate) SetPmiLocation(v *PMILocation) *BloodStockUpdate {
+	return _u.SetPmiLocationID(v.ID)
+}
+
+// SetBloodTypeID sets the "blood_type" edge to the BloodType entity by ID.
+func (_u *BloodStockUpdate) SetBloodTypeID(id uuid.UUID) *BloodStockUpdate {
+	_u.mutation.SetBloodTypeID(id)
+	return _u
+}
+
+// SetBloodType sets the "blood_type" edge to the BloodType entity.
+func (_u *BloodStockUpdate) SetBloodType(v *BloodType) *BloodStockUpdate {
+	return _u.SetBloodTypeID(v.ID)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_u *BloodStockUpdate) AddMovementIDs(ids ...uuid.UUID) *BloodStockUpdate {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_u *BloodStockUpdate) AddMovements(v ...*StockMovement) *BloodStockUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// Mutation returns the BloodStockMutation object of the builder.
+func (_u *BloodStockUpdate) Mutation() *BloodStockMutation {
+	return _u.mutation
+}
+
+// ClearPmiLocation clears the "pmi_location" edge to the PMILocation entity.
+func (_u *BloodStockUpdate) ClearPmiLocation() *BloodStockUpdate {
+	_u.mutation.ClearPmiLocation()
+	return _u
+}
+
+// ClearBloodType clears the "blood_type" edge to the BloodType entity.
+func (_u *BloodStockUpdate) ClearBloodType() *BloodStockUpdate {
+	_u.mutation.ClearBloodType()
+	return _u
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (_u *BloodStockUpdate) ClearMovements() *BloodStockUpdate {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (_u *BloodStockUpdate) RemoveMovementIDs(ids ...uuid.UUID) *BloodStockUpdate {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (_u *BloodStockUpdate) RemoveMovements(v ...*StockMovement) *BloodStockUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BloodStockUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BloodStockUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BloodStockUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BloodStockUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BloodStockUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := bloodstock.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BloodStockUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := bloodstock.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodStock.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := bloodstock.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodStock.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Component(); ok {
+		if err := bloodstock.ComponentValidator(v); err != nil {
+			return &ValidationError{Name: "component", err: fmt.Errorf(`ent: validator failed for field "BloodStock.component": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := bloodstock.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BloodStock.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodStock.pmi_location"`)
+	}
+	if _u.mutation.BloodTypeCleared() && len(_u.mutation.BloodTypeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodStock.blood_type"`)
+	}
+	return nil
+}
+
+func (_u *BloodStockUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bloodstock.Table, bloodstock.Columns, sqlgraph.NewFieldSpec(bloodstock.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodstock.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(bloodstock.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(bloodstock.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodstock.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(bloodstock.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(bloodstock.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Component(); ok {
+		_spec.SetField(bloodstock.FieldComponent, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(bloodstock.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(bloodstock.FieldQuantity, field.TypeInt, value)
+	}
+	if _u.mutation.PmiLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodstock.PmiLocationTable,
+			Columns: []string{bloodstock.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodstock.PmiLocationTable,
+			Columns: []string{bloodstock.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BloodTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodstock.BloodTypeTable,
+			Columns: []string{bloodstock.BloodTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodtype.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BloodTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodstock.BloodTypeTable,
+			Columns: []string{bloodstock.BloodTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodtype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodstock.MovementsTable,
+			Columns: []string{bloodstock.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodstock.MovementsTable,
+			Columns: []string{bloodstock.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodstock.MovementsTable,
+			Columns: []string{bloodstock.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bloodstock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BloodStockUpdateOne is the builder for updating a single BloodStock entity.
+type BloodStockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BloodStockMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BloodStockUpdateOne) SetUpdatedAt(v int64) *BloodStockUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *BloodStockUpdateOne) AddUpdatedAt(v int64) *BloodStockUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BloodStockUpdateOne) ClearUpdatedAt() *BloodStockUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BloodStockUpdateOne) SetDeletedAt(v int64) *BloodStockUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BloodStockUpdateOne) SetNillableDeletedAt(v *int64) *BloodStockUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *BloodStockUpdateOne) AddDeletedAt(v int64) *BloodStockUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BloodStockUpdateOne) ClearDeletedAt() *BloodStockUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetComponent sets the "component" field.
+func (_u *BloodStockUpdateOne) SetComponent(v bloodstock.Component) *BloodStockUpdateOne {
+	_u.mutation.SetComponent(v)
+	return _u
+}
+
+// SetNillableComponent sets the "component" field if the given value is not nil.
+func (_u *BloodStockUpdateOne) SetNillableComponent(v *bloodstock.Component) *BloodStockUpdateOne {
+	if v != nil {
+		_u.SetComponent(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *BloodStockUpdateOne) SetQuantity(v int) *BloodStockUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *BloodStockUpdateOne) SetNillableQuantity(v *int) *BloodStockUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *BloodStockUpdateOne) AddQuantity(v int) *BloodStockUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_u *BloodStockUpdateOne) SetPmiLocationID(id uuid.UUID) *BloodStockUpdateOne {
+	_u.mutation.SetPmiLocationID(id)
+	return _u
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_u *BloodStockUpdateOne) SetPmiLocation(v *PMILocation) *BloodStockUpdateOne {
+	return _u.SetPmiLocationID(v.ID)
+}
+
+// SetBloodTypeID sets the "blood_type" edge to the BloodType entity by ID.
+func (_u *BloodStockUpdateOne) SetBloodTypeID(id uuid.UUID) *BloodStockUpdateOne {
+	_u.mutation.SetBloodTypeID(id)
+	return _u
+}
+
+// SetBloodType sets the "blood_type" edge to the BloodType entity.
+func (_u *BloodStockUpdateOne) SetBloodType(v *BloodType) *BloodStockUpdateOne {
+	return _u.SetBloodTypeID(v.ID)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_u *BloodStockUpdateOne) AddMovementIDs(ids ...uuid.UUID) *BloodStockUpdateOne {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_u *BloodStockUpdateOne) AddMovements(v ...*StockMovement) *BloodStockUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// Mutation returns the BloodStockMutation object of the builder.
+func (_u *BloodStockUpdateOne) Mutation() *BloodStockMutation {
+	return _u.mutation
+}
+
+// ClearPmiLocation clears the "pmi_location" edge to the PMILocation entity.
+func (_u *BloodStockUpdateOne) ClearPmiLocation() *BloodStockUpdateOne {
+	_u.mutation.ClearPmiLocation()
+	return _u
+}
+
+// ClearBloodType clears the "blood_type" edge to the BloodType entity.
+func (_u *BloodStockUpdateOne) ClearBloodType() *BloodStockUpdateOne {
+	_u.mutation.ClearBloodType()
+	return _u
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (_u *BloodStockUpdateOne) ClearMovements() *BloodStockUpdateOne {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (_u *BloodStockUpdateOne) RemoveMovementIDs(ids ...uuid.UUID) *BloodStockUpdateOne {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (_u *BloodStockUpdateOne) RemoveMovements(v ...*StockMovement) *BloodStockUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// Where appends a list predicates to the BloodStockUpdate builder.
+func (_u *BloodStockUpdateOne) Where(ps ...predicate.BloodStock) *BloodStockUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BloodStockUpdateOne) Select(field string, fields ...string) *BloodStockUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BloodStock entity.
+func (_u *BloodStockUpdateOne) Save(ctx context.Context) (*BloodStock, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BloodStockUpdateOne) SaveX(ctx context.Context) *BloodStock {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BloodStockUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BloodStockUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BloodStockUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := bloodstock.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BloodStockUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := bloodstock.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodStock.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := bloodstock.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodStock.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Component(); ok {
+		if err := bloodstock.ComponentValidator(v); err != nil {
+			return &ValidationError{Name: "component", err: fmt.Errorf(`ent: validator failed for field "BloodStock.component": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := bloodstock.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BloodStock.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodStock.pmi_location"`)
+	}
+	if _u.mutation.BloodTypeCleared() && len(_u.mutation.BloodTypeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodStock.blood_type"`)
+	}
+	return nil
+}
+
+func (_u *BloodStockUpdateOne) sqlSave(ctx context.Context) (_node *BloodStock, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bloodstock.Table, bloodstock.Columns, sqlgraph.NewFieldSpec(bloodstock.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BloodStock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bloodstock.FieldID)
+		for _, f := range fields {
+			if !bloodstock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bloodstock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodstock.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(bloodstock.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(bloodstock.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodstock.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(bloodstock.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(bloodstock.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Component(); ok {
+		_spec.SetField(bloodstock.FieldComponent, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(bloodstock.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(bloodstock.FieldQuantity, field.TypeInt, value)
+	}
+	if _u.mutation.PmiLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodstock.PmiLocationTable,
+			Columns: []string{bloodstock.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodstock.PmiLocationTable,
+			Columns: []string{bloodstock.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BloodTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodstock.BloodTypeTable,
+			Columns: []string{bloodstock.BloodTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodtype.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BloodTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodstock.BloodTypeTable,
+			Columns: []string{bloodstock.BloodTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodtype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodstock.MovementsTable,
+			Columns: []string{bloodstock.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodstock.MovementsTable,
+			Columns: []string{bloodstock.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodstock.MovementsTable,
+			Columns: []string{bloodstock.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BloodStock{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bloodstock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
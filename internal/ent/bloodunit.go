@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+)
+
+// BloodUnit is the model entity for the BloodUnit schema.
+type BloodUnit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Barcode printed on the bag label.
+	Barcode string `json:"barcode"`
+	// Component holds the value of the "component" field.
+	Component bloodunit.Component `json:"component"`
+	// Time the blood was collected in milliseconds.
+	CollectedAt int64 `json:"collected_at"`
+	// End of the shelf life in milliseconds.
+	ExpiresAt int64 `json:"expires_at"`
+	// Only changed through the lifecycle state machine.
+	Status bloodunit.Status `json:"status"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BloodUnitQuery when eager-loading is set.
+	Edges            BloodUnitEdges `json:"edges"`
+	pmi_location_id  *uuid.UUID
+	blood_type_id    *uuid.UUID
+	donation_id      *uuid.UUID
+	blood_request_id *uuid.UUID
+	selectValues     sql.SelectValues
+}
+
+// BloodUnitEdges holds the relations/edges for other nodes in the graph.
+type BloodUnitEdges struct {
+	// PmiLocation holds the value of the pmi_location edge.
+	PmiLocation *PMILocation `json:"pmi_location,omitempty"`
+	// BloodType holds the value of the blood_type edge.
+	BloodType *BloodType `json:"blood_type,omitempty"`
+	// Donation holds the value of the donation edge.
+	Donation *Donation `json:"donation,omitempty"`
+	// Blood request the unit is reserved for or issued to.
+	Request *BloodRequest `json:"request,omitempty"`
+	// Events holds the value of the events edge.
+	Events []*BloodUnitEvent `json:"events,omitempty"`
+	// Movements holds the value of the movements edge.
+	Movements []*StockMovement `json:"movements,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// PmiLocationOrErr returns the PmiLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodUnitEdges) PmiLocationOrErr() (*PMILocation, error) {
+	if e.PmiLocation != nil {
+		return e.PmiLocation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: pmilocation.Label}
+	}
+	return nil, &NotLoadedError{edge: "pmi_location"}
+}
+
+// BloodTypeOrErr returns the BloodType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodUnitEdges) BloodTypeOrErr() (*BloodType, error) {
+	if e.BloodType != nil {
+		return e.BloodType, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: bloodtype.Label}
+	}
+	return nil, &NotLoadedError{edge: "blood_type"}
+}
+
+// DonationOrErr returns the Donation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodUnitEdges) DonationOrErr() (*Donation, error) {
+	if e.Donation != nil {
+		return e.Donation, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: donation.Label}
+	}
+	return nil, &NotLoadedError{edge: "donation"}
+}
+
+// RequestOrErr returns the Request value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodUnitEdges) RequestOrErr() (*BloodRequest, error) {
+	if e.Request != nil {
+		return e.Request, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: bloodrequest.Label}
+	}
+	return nil, &NotLoadedError{edge: "request"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e BloodUnitEdges) EventsOrErr() ([]*BloodUnitEvent, error) {
+	if e.loadedTypes[4] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// MovementsOrErr returns the Movements value or an error if the edge
+// was not loaded in eager-loading.
+func (e BloodUnitEdges) MovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[5] {
+		return e.Movements, nil
+	}
+	return nil, &NotLoadedError{edge: "movements"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BloodUnit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bloodunit.FieldCreatedAt, bloodunit.FieldUpdatedAt, bloodunit.FieldDeletedAt, bloodunit.FieldCollectedAt, bloodunit.FieldExpiresAt:
+			values[i] = new(sql.NullInt64)
+		case bloodunit.FieldBarcode, bloodunit.FieldComponent, bloodunit.FieldStatus:
+			values[i] = new(sql.NullString)
+		case bloodunit.FieldID:
+			values[i] = new(uuid.UUID)
+		case bloodunit.ForeignKeys[0]: // pmi_location_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bloodunit.ForeignKeys[1]: // blood_type_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bloodunit.ForeignKeys[2]: // donation_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bloodunit.ForeignKeys[3]: // blood_request_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BloodUnit fields.
+func (_m *BloodUnit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bloodunit.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bloodunit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case bloodunit.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case bloodunit.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case bloodunit.FieldBarcode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field barcode", values[i])
+			} else if value.Valid {
+				_m.Barcode = value.String
+			}
+		case bloodunit.FieldComponent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field component", values[i])
+			} else if value.Valid {
+				_m.Component = bloodunit.Component(value.String)
+			}
+		case bloodunit.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Int64
+			}
+		case bloodunit.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Int64
+			}
+		case bloodunit.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = bloodunit.Status(value.String)
+			}
+		case bloodunit.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field pmi_location_id", values[i])
+			} else if value.Valid {
+				_m.pmi_location_id = new(uuid.UUID)
+				*_m.pmi_location_id = *value.S.(*uuid.UUID)
+			}
+		case bloodunit.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field blood_type_id", values[i])
+			} else if value.Valid {
+				_m.blood_type_id = new(uuid.UUID)
+				*_m.blood_type_id = *value.S.(*uuid.UUID)
+			}
+		case bloodunit.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field donation_id", values[i])
+			} else if value.Valid {
+				_m.donation_id = new(uuid.UUID)
+				*_m.donation_id = *value.S.(*uuid.UUID)
+			}
+		case bloodunit.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field blood_request_id", values[i])
+			} else if value.Valid {
+				_m.blood_request_id = new(uuid.UUID)
+				*_m.blood_request_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BloodUnit.
+// This includes values selected through modifiers, order, etc.
+func (_m *BloodUnit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPmiLocation queries the "pmi_location" edge of the BloodUnit entity.
+func (_m *BloodUnit) QueryPmiLocation() *PMILocationQuery {
+	return NewBloodUnitClient(_m.config).QueryPmiLocation(_m)
+}
+
+// QueryBloodType queries the "blood_type" edge of the BloodUnit entity.
+func (_m *BloodUnit) QueryBloodType() *BloodTypeQuery {
+	return NewBloodUnitClient(_m.config).QueryBloodType(_m)
+}
+
+// QueryDonation queries the "donation" edge of the BloodUnit entity.
+func (_m *BloodUnit) QueryDonation() *DonationQuery {
+	return NewBloodUnitClient(_m.config).QueryDonation(_m)
+}
+
+// QueryRequest queries the "request" edge of the BloodUnit entity.
+func (_m *BloodUnit) QueryRequest() *BloodRequestQuery {
+	return NewBloodUnitClient(_m.config).QueryRequest(_m)
+}
+
+// QueryEvents queries the "events" edge of the BloodUnit entity.
+func (_m *BloodUnit) QueryEvents() *BloodUnitEventQuery {
+	return NewBloodUnitClient(_m.config).QueryEvents(_m)
+}
+
+// QueryMovements queries the "movements" edge of the BloodUnit entity.
+func (_m *BloodUnit) QueryMovements() *StockMovementQuery {
+	return NewBloodUnitClient(_m.config).QueryMovements(_m)
+}
+
+// Update returns a builder for updating this BloodUnit.
+// Note that you need to call BloodUnit.Unwrap() before calling this method if this BloodUnit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BloodUnit) Update() *BloodUnitUpdateOne {
+	return NewBloodUnitClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BloodUnit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BloodUnit) Unwrap() *BloodUnit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BloodUnit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BloodUnit) String() string {
+	var builder strings.Builder
+	builder.WriteString("BloodUnit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("barcode=")
+	builder.WriteString(_m.Barcode)
+	builder.WriteString(", ")
+	builder.WriteString("component=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Component))
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CollectedAt))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpiresAt))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BloodUnits is a parsable slice of BloodUnit.
+type BloodUnits []*BloodUnit
@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bloodunit
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the bloodunit type in the database.
+	Label = "blood_unit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldBarcode holds the string denoting the barcode field in the database.
+	FieldBarcode = "barcode"
+	// FieldComponent holds the string denoting the component field in the database.
+	FieldComponent = "component"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// EdgePmiLocation holds the string denoting the pmi_location edge name in mutations.
+	EdgePmiLocation = "pmi_location"
+	// EdgeBloodType holds the string denoting the blood_type edge name in mutations.
+	EdgeBloodType = "blood_type"
+	// EdgeDonation holds the string denoting the donation edge name in mutations.
+	EdgeDonation = "donation"
+	// EdgeRequest holds the string denoting the request edge name in mutations.
+	EdgeRequest = "request"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
+	// EdgeMovements holds the string denoting the movements edge name in mutations.
+	EdgeMovements = "movements"
+	// Table holds the table name of the bloodunit in the database.
+	Table = "blood_units"
+	// PmiLocationTable is the table that holds the pmi_location relation/edge.
+	PmiLocationTable = "blood_units"
+	// PmiLocationInverseTable is the table name for the PMILocation entity.
+	// It exists in this package in order to avoid circular dependency with the "pmilocation" package.
+	PmiLocationInverseTable = "pmi_locations"
+	// PmiLocationColumn is the table column denoting the pmi_location relation/edge.
+	PmiLocationColumn = "pmi_location_id"
+	// BloodTypeTable is the table that holds the blood_type relation/edge.
+	BloodTypeTable = "blood_units"
+	// BloodTypeInverseTable is the table name for the BloodType entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodtype" package.
+	BloodTypeInverseTable = "blood_types"
+	// BloodTypeColumn is the table column denoting the blood_type relation/edge.
+	BloodTypeColumn = "blood_type_id"
+	// DonationTable is the table that holds the donation relation/edge.
+	DonationTable = "blood_units"
+	// DonationInverseTable is the table name for the Donation entity.
+	// It exists in this package in order to avoid circular dependency with the "donation" package.
+	DonationInverseTable = "donations"
+	// DonationColumn is the table column denoting the donation relation/edge.
+	DonationColumn = "donation_id"
+	// RequestTable is the table that holds the request relation/edge.
+	RequestTable = "blood_units"
+	// RequestInverseTable is the table name for the BloodRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodrequest" package.
+	RequestInverseTable = "blood_requests"
+	// RequestColumn is the table column denoting the request relation/edge.
+	RequestColumn = "blood_request_id"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "blood_unit_events"
+	// EventsInverseTable is the table name for the BloodUnitEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodunitevent" package.
+	EventsInverseTable = "blood_unit_events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "blood_unit_id"
+	// MovementsTable is the table that holds the movements relation/edge.
+	MovementsTable = "stock_movements"
+	// MovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	MovementsInverseTable = "stock_movements"
+	// MovementsColumn is the table column denoting the movements relation/edge.
+	MovementsColumn = "blood_unit_id"
+)
+
+// Columns holds all SQL columns for bloodunit fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldBarcode,
+	FieldComponent,
+	FieldCollectedAt,
+	FieldExpiresAt,
+	FieldStatus,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "blood_units"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"pmi_location_id",
+	"blood_type_id",
+	"donation_id",
+	"blood_request_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// BarcodeValidator is a validator for the "barcode" field. It is called by the builders before save.
+	BarcodeValidator func(string) error
+	// CollectedAtValidator is a validator for the "collected_at" field. It is called by the builders before save.
+	CollectedAtValidator func(int64) error
+	// ExpiresAtValidator is a validator for the "expires_at" field. It is called by the builders before save.
+	ExpiresAtValidator func(int64) error
+)
+
+// Component defines the type for the "component" enum field.
+type Component string
+
+// Component values.
+const (
+	ComponentWholeBlood Component = "WHOLE_BLOOD"
+	ComponentPRC        Component = "PRC"
+	ComponentTC         Component = "TC"
+	ComponentFFP        Component = "FFP"
+)
+
+func (c Component) String() string {
+	return string(c)
+}
+
+// ComponentValidator is a validator for the "component" field enum values. It is called by the builders before save.
+func ComponentValidator(c Component) error {
+	switch c {
+	case ComponentWholeBlood, ComponentPRC, ComponentTC, ComponentFFP:
+		return nil
+	default:
+		return fmt.Errorf("bloodunit: invalid enum value for component field: %q", c)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusQuarantined is the default value of the Status enum.
+const DefaultStatus = StatusQuarantined
+
+// Status values.
+const (
+	StatusQuarantined Status = "QUARANTINED"
+	StatusTested      Status = "TESTED"
+	StatusAvailable   Status = "AVAILABLE"
+	StatusReserved    Status = "RESERVED"
+	StatusIssued      Status = "ISSUED"
+	StatusExpired     Status = "EXPIRED"
+	StatusDiscarded   Status = "DISCARDED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusQuarantined, StatusTested, StatusAvailable, StatusReserved, StatusIssued, StatusExpired, StatusDiscarded:
+		return nil
+	default:
+		return fmt.Errorf("bloodunit: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the BloodUnit queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByBarcode orders the results by the barcode field.
+func ByBarcode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBarcode, opts...).ToFunc()
+}
+
+// ByComponent orders the results by the component field.
+func ByComponent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComponent, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPmiLocationField orders the results by pmi_location field.
+func ByPmiLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPmiLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBloodTypeField orders the results by blood_type field.
+func ByBloodTypeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBloodTypeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDonationField orders the results by donation field.
+func ByDonationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDonationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRequestField orders the results by request field.
+func ByRequestField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequestStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEventsCount orders the results by events count.
+func ByEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventsStep(), opts...)
+	}
+}
+
+// ByEvents orders the results by events terms.
+func ByEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMovementsCount orders the results by movements count.
+func ByMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMovementsStep(), opts...)
+	}
+}
+
+// ByMovements orders the results by movements terms.
+func ByMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPmiLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PmiLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+	)
+}
+func newBloodTypeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BloodTypeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, BloodTypeTable, BloodTypeColumn),
+	)
+}
+func newDonationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DonationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, DonationTable, DonationColumn),
+	)
+}
+func newRequestStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RequestInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RequestTable, RequestColumn),
+	)
+}
+func newEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, EventsTable, EventsColumn),
+	)
+}
+func newMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MovementsTable, MovementsColumn),
+	)
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bloodunit
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Barcode applies equality check predicate on the "barcode" field. It's identical to BarcodeEQ.
+func Barcode(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldBarcode, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// BarcodeEQ applies the EQ predicate on the "barcode" field.
+func BarcodeEQ(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldBarcode, v))
+}
+
+// BarcodeNEQ applies the NEQ predicate on the "barcode" field.
+func BarcodeNEQ(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNEQ(FieldBarcode, v))
+}
+
+// BarcodeIn applies the In predicate on the "barcode" field.
+func BarcodeIn(vs ...string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIn(FieldBarcode, vs...))
+}
+
+// BarcodeNotIn applies the NotIn predicate on the "barcode" field.
+func BarcodeNotIn(vs ...string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotIn(FieldBarcode, vs...))
+}
+
+// BarcodeGT applies the GT predicate on the "barcode" field.
+func BarcodeGT(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGT(FieldBarcode, v))
+}
+
+// BarcodeGTE applies the GTE predicate on the "barcode" field.
+func BarcodeGTE(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGTE(FieldBarcode, v))
+}
+
+// BarcodeLT applies the LT predicate on the "barcode" field.
+func BarcodeLT(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLT(FieldBarcode, v))
+}
+
+// BarcodeLTE applies the LTE predicate on the "barcode" field.
+func BarcodeLTE(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLTE(FieldBarcode, v))
+}
+
+// BarcodeContains applies the Contains predicate on the "barcode" field.
+func BarcodeContains(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldContains(FieldBarcode, v))
+}
+
+// BarcodeHasPrefix applies the HasPrefix predicate on the "barcode" field.
+func BarcodeHasPrefix(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldHasPrefix(FieldBarcode, v))
+}
+
+// BarcodeHasSuffix applies the HasSuffix predicate on the "barcode" field.
+func BarcodeHasSuffix(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldHasSuffix(FieldBarcode, v))
+}
+
+// BarcodeEqualFold applies the EqualFold predicate on the "barcode" field.
+func BarcodeEqualFold(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEqualFold(FieldBarcode, v))
+}
+
+// BarcodeContainsFold applies the ContainsFold predicate on the "barcode" field.
+func BarcodeContainsFold(v string) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldContainsFold(FieldBarcode, v))
+}
+
+// ComponentEQ applies the EQ predicate on the "component" field.
+func ComponentEQ(v Component) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldComponent, v))
+}
+
+// ComponentNEQ applies the NEQ predicate on the "component" field.
+func ComponentNEQ(v Component) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNEQ(FieldComponent, v))
+}
+
+// ComponentIn applies the In predicate on the "component" field.
+func ComponentIn(vs ...Component) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIn(FieldComponent, vs...))
+}
+
+// ComponentNotIn applies the NotIn predicate on the "component" field.
+func ComponentNotIn(vs ...Component) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotIn(FieldComponent, vs...))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v int64) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HasPmiLocation applies the HasEdge predicate on the "pmi_location" edge.
+func HasPmiLocation() predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPmiLocationWith applies the HasEdge predicate on the "pmi_location" edge with a given conditions (other predicates).
+func HasPmiLocationWith(preds ...predicate.PMILocation) predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := newPmiLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBloodType applies the HasEdge predicate on the "blood_type" edge.
+func HasBloodType() predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BloodTypeTable, BloodTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBloodTypeWith applies the HasEdge predicate on the "blood_type" edge with a given conditions (other predicates).
+func HasBloodTypeWith(preds ...predicate.BloodType) predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := newBloodTypeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDonation applies the HasEdge predicate on the "donation" edge.
+func HasDonation() predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, DonationTable, DonationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDonationWith applies the HasEdge predicate on the "donation" edge with a given conditions (other predicates).
+func HasDonationWith(preds ...predicate.Donation) predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := newDonationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRequest applies the HasEdge predicate on the "request" edge.
+func HasRequest() predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RequestTable, RequestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequestWith applies the HasEdge predicate on the "request" edge with a given conditions (other predicates).
+func HasRequestWith(preds ...predicate.BloodRequest) predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := newRequestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.BloodUnitEvent) predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := newEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMovements applies the HasEdge predicate on the "movements" edge.
+func HasMovements() predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MovementsTable, MovementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMovementsWith applies the HasEdge predicate on the "movements" edge with a given conditions (other predicates).
+func HasMovementsWith(preds ...predicate.StockMovement) predicate.BloodUnit {
+	return predicate.BloodUnit(func(s *sql.Selector) {
+		step := newMovementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BloodUnit) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BloodUnit) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BloodUnit) predicate.BloodUnit {
+	return predicate.BloodUnit(sql.NotPredicates(p))
+}
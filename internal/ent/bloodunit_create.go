@@ -0,0 +1,553 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+// BloodUnitCreate is the builder for creating a BloodUnit entity.
+type BloodUnitCreate struct {
+	config
+	mutation *BloodUnitMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BloodUnitCreate) SetCreatedAt(v int64) *BloodUnitCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BloodUnitCreate) SetUpdatedAt(v int64) *BloodUnitCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BloodUnitCreate) SetNillableUpdatedAt(v *int64) *BloodUnitCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *BloodUnitCreate) SetDeletedAt(v int64) *BloodUnitCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *BloodUnitCreate) SetNillableDeletedAt(v *int64) *BloodUnitCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetBarcode sets the "barcode" field.
+func (_c *BloodUnitCreate) SetBarcode(v string) *BloodUnitCreate {
+	_c.mutation.SetBarcode(v)
+	return _c
+}
+
+// SetComponent sets the "component" field.
+func (_c *BloodUnitCreate) SetComponent(v bloodunit.Component) *BloodUnitCreate {
+	_c.mutation.SetComponent(v)
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BloodUnitCreate) SetCollectedAt(v int64) *BloodUnitCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *BloodUnitCreate) SetExpiresAt(v int64) *BloodUnitCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BloodUnitCreate) SetStatus(v bloodunit.Status) *BloodUnitCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BloodUnitCreate) SetNillableStatus(v *bloodunit.Status) *BloodUnitCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BloodUnitCreate) SetID(v uuid.UUID) *BloodUnitCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_c *BloodUnitCreate) SetPmiLocationID(id uuid.UUID) *BloodUnitCreate {
+	_c.mutation.SetPmiLocationID(id)
+	return _c
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_c *BloodUnitCreate) SetPmiLocation(v *PMILocation) *BloodUnitCreate {
+	return _c.SetPmiLocationID(v.ID)
+}
+
+// SetBloodTypeID sets the "blood_type" edge to the BloodType entity by ID.
+func (_c *BloodUnitCreate) SetBloodTypeID(id uuid.UUID) *BloodUnitCreate {
+	_c.mutation.SetBloodTypeID(id)
+	return _c
+}
+
+// SetBloodType sets the "blood_type" edge to the BloodType entity.
+func (_c *BloodUnitCreate) SetBloodType(v *BloodType) *BloodUnitCreate {
+	return _c.SetBloodTypeID(v.ID)
+}
+
+// SetDonationID sets the "donation" edge to the Donation entity by ID.
+func (_c *BloodUnitCreate) SetDonationID(id uuid.UUID) *BloodUnitCreate {
+	_c.mutation.SetDonationID(id)
+	return _c
+}
+
+// SetNillableDonationID sets the "donation" edge to the Donation entity by ID if the given value is not nil.
+func (_c *BloodUnitCreate) SetNillableDonationID(id *uuid.UUID) *BloodUnitCreate {
+	if id != nil {
+		_c = _c.SetDonationID(*id)
+	}
+	return _c
+}
+
+// SetDonation sets the "donation" edge to the Donation entity.
+func (_c *BloodUnitCreate) SetDonation(v *Donation) *BloodUnitCreate {
+	return _c.SetDonationID(v.ID)
+}
+
+// SetRequestID sets the "request" edge to the BloodRequest entity by ID.
+func (_c *BloodUnitCreate) SetRequestID(id uuid.UUID) *BloodUnitCreate {
+	_c.mutation.SetRequestID(id)
+	return _c
+}
+
+// SetNillableRequestID sets the "request" edge to the BloodRequest entity by ID if the given value is not nil.
+func (_c *BloodUnitCreate) SetNillableRequestID(id *uuid.UUID) *BloodUnitCreate {
+	if id != nil {
+		_c = _c.SetRequestID(*id)
+	}
+	return _c
+}
+
+// SetRequest sets the "request" edge to the BloodRequest entity.
+func (_c *BloodUnitCreate) SetRequest(v *BloodRequest) *BloodUnitCreate {
+	return _c.SetRequestID(v.ID)
+}
+
+// AddEventIDs adds the "events" edge to the BloodUnitEvent entity by IDs.
+func (_c *BloodUnitCreate) AddEventIDs(ids ...uuid.UUID) *BloodUnitCreate {
+	_c.mutation.AddEventIDs(ids...)
+	return _c
+}
+
+// AddEvents adds the "events" edges to the BloodUnitEvent entity.
+func (_c *BloodUnitCreate) AddEvents(v ...*BloodUnitEvent) *BloodUnitCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_c *BloodUnitCreate) AddMovementIDs(ids ...uuid.UUID) *BloodUnitCreate {
+	_c.mutation.AddMovementIDs(ids...)
+	return _c
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_c *BloodUnitCreate) AddMovements(v ...*StockMovement) *BloodUnitCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMovementIDs(ids...)
+}
+
+// Mutation returns the BloodUnitMutation object of the builder.
+func (_c *BloodUnitCreate) Mutation() *BloodUnitMutation {
+	return _c.mutation
+}
+
+// Save creates the BloodUnit in the database.
+func (_c *BloodUnitCreate) Save(ctx context.Context) (*BloodUnit, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BloodUnitCreate) SaveX(ctx context.Context) *BloodUnit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BloodUnitCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BloodUnitCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BloodUnitCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := bloodunit.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BloodUnitCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := bloodunit.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := bloodunit.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := bloodunit.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Barcode(); !ok {
+		return &ValidationError{Name: "barcode", err: errors.New(`ent: missing required field "BloodUnit.barcode"`)}
+	}
+	if v, ok := _c.mutation.Barcode(); ok {
+		if err := bloodunit.BarcodeValidator(v); err != nil {
+			return &ValidationError{Name: "barcode", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.barcode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Component(); !ok {
+		return &ValidationError{Name: "component", err: errors.New(`ent: missing required field "BloodUnit.component"`)}
+	}
+	if v, ok := _c.mutation.Component(); ok {
+		if err := bloodunit.ComponentValidator(v); err != nil {
+			return &ValidationError{Name: "component", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.component": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ent: missing required field "BloodUnit.collected_at"`)}
+	}
+	if v, ok := _c.mutation.CollectedAt(); ok {
+		if err := bloodunit.CollectedAtValidator(v); err != nil {
+			return &ValidationError{Name: "collected_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.collected_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "BloodUnit.expires_at"`)}
+	}
+	if v, ok := _c.mutation.ExpiresAt(); ok {
+		if err := bloodunit.ExpiresAtValidator(v); err != nil {
+			return &ValidationError{Name: "expires_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.expires_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BloodUnit.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := bloodunit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PmiLocationIDs()) == 0 {
+		return &ValidationError{Name: "pmi_location", err: errors.New(`ent: missing required edge "BloodUnit.pmi_location"`)}
+	}
+	if len(_c.mutation.BloodTypeIDs()) == 0 {
+		return &ValidationError{Name: "blood_type", err: errors.New(`ent: missing required edge "BloodUnit.blood_type"`)}
+	}
+	return nil
+}
+
+func (_c *BloodUnitCreate) sqlSave(ctx context.Context) (*BloodUnit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BloodUnitCreate) createSpec() (*BloodUnit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BloodUnit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bloodunit.Table, sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bloodunit.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodunit.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodunit.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Barcode(); ok {
+		_spec.SetField(bloodunit.FieldBarcode, field.TypeString, value)
+		_node.Barcode = value
+	}
+	if value, ok := _c.mutation.Component(); ok {
+		_spec.SetField(bloodunit.FieldComponent, field.TypeEnum, value)
+		_node.Component = value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bloodunit.FieldCollectedAt, field.TypeInt64, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(bloodunit.FieldExpiresAt, field.TypeInt64, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(bloodunit.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if nodes := _c.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.PmiLocationTable,
+			Columns: []string{bloodunit.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pmi_location_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BloodTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.BloodTypeTable,
+			Columns: []string{bloodunit.BloodTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodtype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blood_type_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DonationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.DonationTable,
+			Columns: []string{bloodunit.DonationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.donation_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.RequestTable,
+			Columns: []string{bloodunit.RequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blood_request_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.EventsTable,
+			Columns: []string{bloodunit.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.MovementsTable,
+			Columns: []string{bloodunit.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BloodUnitCreateBulk is the builder for creating many BloodUnit entities in bulk.
+type BloodUnitCreateBulk struct {
+	config
+	err      error
+	builders []*BloodUnitCreate
+}
+
+// Save creates the BloodUnit entities in the database.
+func (_c *BloodUnitCreateBulk) Save(ctx context.Context) ([]*BloodUnit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BloodUnit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BloodUnitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BloodUnitCreateBulk) SaveX(ctx context.Context) []*BloodUnit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BloodUnitCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BloodUnitCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
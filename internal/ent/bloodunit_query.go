@@ -0,0 +1,991 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+// BloodUnitQuery is the builder for querying BloodUnit entities.
+type BloodUnitQuery struct {
+	config
+	ctx             *QueryContext
+	order           []bloodunit.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.BloodUnit
+	withPmiLocation *PMILocationQuery
+	withBloodType   *BloodTypeQuery
+	withDonation    *DonationQuery
+	withRequest     *BloodRequestQuery
+	withEvents      *BloodUnitEventQuery
+	withMovements   *StockMovementQuery
+	withFKs         bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BloodUnitQuery builder.
+func (_q *BloodUnitQuery) Where(ps ...predicate.BloodUnit) *BloodUnitQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BloodUnitQuery) Limit(limit int) *BloodUnitQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BloodUnitQuery) Offset(offset int) *BloodUnitQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BloodUnitQuery) Unique(unique bool) *BloodUnitQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BloodUnitQuery) Order(o ...bloodunit.OrderOption) *BloodUnitQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryPmiLocation chains the current query on the "pmi_location" edge.
+func (_q *BloodUnitQuery) QueryPmiLocation() *PMILocationQuery {
+	query := (&PMILocationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, selector),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodunit.PmiLocationTable, bloodunit.PmiLocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBloodType chains the current query on the "blood_type" edge.
+func (_q *BloodUnitQuery) QueryBloodType() *BloodTypeQuery {
+	query := (&BloodTypeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, selector),
+			sqlgraph.To(bloodtype.Table, bloodtype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodunit.BloodTypeTable, bloodunit.BloodTypeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDonation chains the current query on the "donation" edge.
+func (_q *BloodUnitQuery) QueryDonation() *DonationQuery {
+	query := (&DonationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, selector),
+			sqlgraph.To(donation.Table, donation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodunit.DonationTable, bloodunit.DonationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRequest chains the current query on the "request" edge.
+func (_q *BloodUnitQuery) QueryRequest() *BloodRequestQuery {
+	query := (&BloodRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, selector),
+			sqlgraph.To(bloodrequest.Table, bloodrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodunit.RequestTable, bloodunit.RequestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEvents chains the current query on the "events" edge.
+func (_q *BloodUnitQuery) QueryEvents() *BloodUnitEventQuery {
+	query := (&BloodUnitEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, selector),
+			sqlgraph.To(bloodunitevent.Table, bloodunitevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, bloodunit.EventsTable, bloodunit.EventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMovements chains the current query on the "movements" edge.
+func (_q *BloodUnitQuery) QueryMovements() *StockMovementQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, selector),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, bloodunit.MovementsTable, bloodunit.MovementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BloodUnit entity from the query.
+// Returns a *NotFoundError when no BloodUnit was found.
+func (_q *BloodUnitQuery) First(ctx context.Context) (*BloodUnit, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bloodunit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BloodUnitQuery) FirstX(ctx context.Context) *BloodUnit {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BloodUnit ID from the query.
+// Returns a *NotFoundError when no BloodUnit ID was found.
+func (_q *BloodUnitQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bloodunit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BloodUnitQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BloodUnit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BloodUnit entity is found.
+// Returns a *NotFoundError when no BloodUnit entities are found.
+func (_q *BloodUnitQuery) Only(ctx context.Context) (*BloodUnit, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bloodunit.Label}
+	default:
+		return nil, &NotSingularError{bloodunit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BloodUnitQuery) OnlyX(ctx context.Context) *BloodUnit {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BloodUnit ID in the query.
+// Returns a *NotSingularError when more than one BloodUnit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BloodUnitQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bloodunit.Label}
+	default:
+		err = &NotSingularError{bloodunit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BloodUnitQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BloodUnits.
+func (_q *BloodUnitQuery) All(ctx context.Context) ([]*BloodUnit, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BloodUnit, *BloodUnitQuery]()
+	return withInterceptors[[]*BloodUnit](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BloodUnitQuery) AllX(ctx context.Context) []*BloodUnit {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BloodUnit IDs.
+func (_q *BloodUnitQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bloodunit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BloodUnitQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BloodUnitQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BloodUnitQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BloodUnitQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BloodUnitQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BloodUnitQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BloodUnitQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BloodUnitQuery) Clone() *BloodUnitQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BloodUnitQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]bloodunit.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.BloodUnit{}, _q.predicates...),
+		withPmiLocation: _q.withPmiLocation.Clone(),
+		withBloodType:   _q.withBloodType.Clone(),
+		withDonation:    _q.withDonation.Clone(),
+		withRequest:     _q.withRequest.Clone(),
+		withEvents:      _q.withEvents.Clone(),
+		withMovements:   _q.withMovements.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithPmiLocation tells the query-builder to eager-load the nodes that are connected to
+// the "pmi_location" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodUnitQuery) WithPmiLocation(opts ...func(*PMILocationQuery)) *BloodUnitQuery {
+	query := (&PMILocationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPmiLocation = query
+	return _q
+}
+
+// WithBloodType tells the query-builder to eager-load the nodes that are connected to
+// the "blood_type" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodUnitQuery) WithBloodType(opts ...func(*BloodTypeQuery)) *BloodUnitQuery {
+	query := (&BloodTypeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBloodType = query
+	return _q
+}
+
+// WithDonation tells the query-builder to eager-load the nodes that are connected to
+// the "donation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodUnitQuery) WithDonation(opts ...func(*DonationQuery)) *BloodUnitQuery {
+	query := (&DonationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDonation = query
+	return _q
+}
+
+// WithRequest tells the query-builder to eager-load the nodes that are connected to
+// the "request" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodUnitQuery) WithRequest(opts ...func(*BloodRequestQuery)) *BloodUnitQuery {
+	query := (&BloodRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRequest = query
+	return _q
+}
+
+// WithEvents tells the query-builder to eager-load the nodes that are connected to
+// the "events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodUnitQuery) WithEvents(opts ...func(*BloodUnitEventQuery)) *BloodUnitQuery {
+	query := (&BloodUnitEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEvents = query
+	return _q
+}
+
+// WithMovements tells the query-builder to eager-load the nodes that are connected to
+// the "movements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BloodUnitQuery) WithMovements(opts ...func(*StockMovementQuery)) *BloodUnitQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMovements = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt int64 `json:"created_at"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BloodUnit.Query().
+//		GroupBy(bloodunit.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *BloodUnitQuery) GroupBy(field string, fields ...string) *BloodUnitGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BloodUnitGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bloodunit.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt int64 `json:"created_at"`
+//	}
+//
+//	client.BloodUnit.Query().
+//		Select(bloodunit.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *BloodUnitQuery) Select(fields ...string) *BloodUnitSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BloodUnitSelect{BloodUnitQuery: _q}
+	sbuild.label = bloodunit.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BloodUnitSelect configured with the given aggregations.
+func (_q *BloodUnitQuery) Aggregate(fns ...AggregateFunc) *BloodUnitSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BloodUnitQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bloodunit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BloodUnitQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BloodUnit, error) {
+	var (
+		nodes       = []*BloodUnit{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [6]bool{
+			_q.withPmiLocation != nil,
+			_q.withBloodType != nil,
+			_q.withDonation != nil,
+			_q.withRequest != nil,
+			_q.withEvents != nil,
+			_q.withMovements != nil,
+		}
+	)
+	if _q.withPmiLocation != nil || _q.withBloodType != nil || _q.withDonation != nil || _q.withRequest != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, bloodunit.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BloodUnit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BloodUnit{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withPmiLocation; query != nil {
+		if err := _q.loadPmiLocation(ctx, query, nodes, nil,
+			func(n *BloodUnit, e *PMILocation) { n.Edges.PmiLocation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBloodType; query != nil {
+		if err := _q.loadBloodType(ctx, query, nodes, nil,
+			func(n *BloodUnit, e *BloodType) { n.Edges.BloodType = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDonation; query != nil {
+		if err := _q.loadDonation(ctx, query, nodes, nil,
+			func(n *BloodUnit, e *Donation) { n.Edges.Donation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRequest; query != nil {
+		if err := _q.loadRequest(ctx, query, nodes, nil,
+			func(n *BloodUnit, e *BloodRequest) { n.Edges.Request = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEvents; query != nil {
+		if err := _q.loadEvents(ctx, query, nodes,
+			func(n *BloodUnit) { n.Edges.Events = []*BloodUnitEvent{} },
+			func(n *BloodUnit, e *BloodUnitEvent) { n.Edges.Events = append(n.Edges.Events, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMovements; query != nil {
+		if err := _q.loadMovements(ctx, query, nodes,
+			func(n *BloodUnit) { n.Edges.Movements = []*StockMovement{} },
+			func(n *BloodUnit, e *StockMovement) { n.Edges.Movements = append(n.Edges.Movements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *BloodUnitQuery) loadPmiLocation(ctx context.Context, query *PMILocationQuery, nodes []*BloodUnit, init func(*BloodUnit), assign func(*BloodUnit, *PMILocation)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodUnit)
+	for i := range nodes {
+		if nodes[i].pmi_location_id == nil {
+			continue
+		}
+		fk := *nodes[i].pmi_location_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(pmilocation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pmi_location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodUnitQuery) loadBloodType(ctx context.Context, query *BloodTypeQuery, nodes []*BloodUnit, init func(*BloodUnit), assign func(*BloodUnit, *BloodType)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodUnit)
+	for i := range nodes {
+		if nodes[i].blood_type_id == nil {
+			continue
+		}
+		fk := *nodes[i].blood_type_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bloodtype.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "blood_type_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodUnitQuery) loadDonation(ctx context.Context, query *DonationQuery, nodes []*BloodUnit, init func(*BloodUnit), assign func(*BloodUnit, *Donation)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodUnit)
+	for i := range nodes {
+		if nodes[i].donation_id == nil {
+			continue
+		}
+		fk := *nodes[i].donation_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(donation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "donation_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodUnitQuery) loadRequest(ctx context.Context, query *BloodRequestQuery, nodes []*BloodUnit, init func(*BloodUnit), assign func(*BloodUnit, *BloodRequest)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BloodUnit)
+	for i := range nodes {
+		if nodes[i].blood_request_id == nil {
+			continue
+		}
+		fk := *nodes[i].blood_request_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bloodrequest.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "blood_request_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BloodUnitQuery) loadEvents(ctx context.Context, query *BloodUnitEventQuery, nodes []*BloodUnit, init func(*BloodUnit), assign func(*BloodUnit, *BloodUnitEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*BloodUnit)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BloodUnitEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(bloodunit.EventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.blood_unit_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "blood_unit_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blood_unit_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *BloodUnitQuery) loadMovements(ctx context.Context, query *StockMovementQuery, nodes []*BloodUnit, init func(*BloodUnit), assign func(*BloodUnit, *StockMovement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*BloodUnit)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(bloodunit.MovementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.blood_unit_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "blood_unit_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blood_unit_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *BloodUnitQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BloodUnitQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bloodunit.Table, bloodunit.Columns, sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bloodunit.FieldID)
+		for i := range fields {
+			if fields[i] != bloodunit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BloodUnitQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bloodunit.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bloodunit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BloodUnitGroupBy is the group-by builder for BloodUnit entities.
+type BloodUnitGroupBy struct {
+	selector
+	build *BloodUnitQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BloodUnitGroupBy) Aggregate(fns ...AggregateFunc) *BloodUnitGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BloodUnitGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BloodUnitQuery, *BloodUnitGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BloodUnitGroupBy) sqlScan(ctx context.Context, root *BloodUnitQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BloodUnitSelect is the builder for selecting fields of BloodUnit entities.
+type BloodUnitSelect struct {
+	*BloodUnitQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BloodUnitSelect) Aggregate(fns ...AggregateFunc) *BloodUnitSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BloodUnitSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BloodUnitQuery, *BloodUnitSelect](ctx, _s.BloodUnitQuery, _s, _s.inters, v)
+}
+
+func (_s *BloodUnitSelect) sqlScan(ctx context.Context, root *BloodUnitQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
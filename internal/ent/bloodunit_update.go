@@ -0,0 +1,1006 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+// BloodUnitUpdate is the builder for updating BloodUnit entities.
+type BloodUnitUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BloodUnitMutation
+}
+
+// Where appends a list predicates to the BloodUnitUpdate builder.
+func (_u *BloodUnitUpdate) Where(ps ...predicate.BloodUnit) *BloodUnitUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BloodUnitUpdate) SetUpdatedAt(v int64) *BloodUnitUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *BloodUnitUpdate) AddUpdatedAt(v int64) *BloodUnitUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BloodUnitUpdate) ClearUpdatedAt() *BloodUnitUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BloodUnitUpdate) SetDeletedAt(v int64) *BloodUnitUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BloodUnitUpdate) SetNillableDeletedAt(v *int64) *BloodUnitUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *BloodUnitUpdate) AddDeletedAt(v int64) *BloodUnitUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BloodUnitUpdate) ClearDeletedAt() *BloodUnitUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *BloodUnitUpdate) SetExpiresAt(v int64) *BloodUnitUpdate {
+	_u.mutation.ResetExpiresAt()
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *BloodUnitUpdate) SetNillableExpiresAt(v *int64) *BloodUnitUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// AddExpiresAt adds value to the "expires_at" field.
+func (_u *BloodUnitUpdate) AddExpiresAt(v int64) *BloodUnitUpdate {
+	_u.mutation.AddExpiresAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BloodUnitUpdate) SetStatus(v bloodunit.Status) *BloodUnitUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BloodUnitUpdate) SetNillableStatus(v *bloodunit.Status) *BloodUnitUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_u *BloodUnitUpdate) SetPmiLocationID(id uuid.UUID) *BloodUnitUpdate {
+	_u.mutation.SetPmiLocationID(id)
+	return _u
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_u *BloodUnitUpdate) SetPmiLocation(v *PMILocation) *BloodUnitUpdate {
+	return _u.SetPmiLocationID(v.ID)
+}
+
+// SetRequestID sets the "request" edge to the BloodRequest entity by ID.
+func (_u *BloodUnitUpdate) SetRequestID(id uuid.UUID) *BloodUnitUpdate {
+	_u.mutation.SetRequestID(id)
+	return _u
+}
+
+// SetNillableRequestID sets the "request" edge to the BloodRequest entity by ID if the given value is not nil.
+func (_u *BloodUnitUpdate) SetNillableRequestID(id *uuid.UUID) *BloodUnitUpdate {
+	if id != nil {
+		_u = _u.SetRequestID(*id)
+	}
+	return _u
+}
+
+// SetRequest sets the "request" edge to the BloodRequest entity.
+func (_u *BloodUnitUpdate) SetRequest(v *BloodRequest) *BloodUnitUpdate {
+	return _u.SetRequestID(v.ID)
+}
+
+// AddEventIDs adds the "events" edge to the BloodUnitEvent entity by IDs.
+func (_u *BloodUnitUpdate) AddEventIDs(ids ...uuid.UUID) *BloodUnitUpdate {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the BloodUnitEvent entity.
+func (_u *BloodUnitUpdate) AddEvents(v ...*BloodUnitEvent) *BloodUnitUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_u *BloodUnitUpdate) AddMovementIDs(ids ...uuid.UUID) *BloodUnitUpdate {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_u *BloodUnitUpdate) AddMovements(v ...*StockMovement) *BloodUnitUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// Mutation returns the BloodUnitMutation object of the builder.
+func (_u *BloodUnitUpdate) Mutation() *BloodUnitMutation {
+	return _u.mutation
+}
+
+// ClearPmiLocation clears the "pmi_location" edge to the PMILocation entity.
+func (_u *BloodUnitUpdate) ClearPmiLocation() *BloodUnitUpdate {
+	_u.mutation.ClearPmiLocation()
+	return _u
+}
+
+// ClearRequest clears the "request" edge to the BloodRequest entity.
+func (_u *BloodUnitUpdate) ClearRequest() *BloodUnitUpdate {
+	_u.mutation.ClearRequest()
+	return _u
+}
+
+// ClearEvents clears all "events" edges to the BloodUnitEvent entity.
+func (_u *BloodUnitUpdate) ClearEvents() *BloodUnitUpdate {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to BloodUnitEvent entities by IDs.
+func (_u *BloodUnitUpdate) RemoveEventIDs(ids ...uuid.UUID) *BloodUnitUpdate {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to BloodUnitEvent entities.
+func (_u *BloodUnitUpdate) RemoveEvents(v ...*BloodUnitEvent) *BloodUnitUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (_u *BloodUnitUpdate) ClearMovements() *BloodUnitUpdate {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (_u *BloodUnitUpdate) RemoveMovementIDs(ids ...uuid.UUID) *BloodUnitUpdate {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (_u *BloodUnitUpdate) RemoveMovements(v ...*StockMovement) *BloodUnitUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BloodUnitUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BloodUnitUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BloodUnitUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BloodUnitUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BloodUnitUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := bloodunit.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BloodUnitUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := bloodunit.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := bloodunit.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExpiresAt(); ok {
+		if err := bloodunit.ExpiresAtValidator(v); err != nil {
+			return &ValidationError{Name: "expires_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.expires_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := bloodunit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodUnit.pmi_location"`)
+	}
+	if _u.mutation.BloodTypeCleared() && len(_u.mutation.BloodTypeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodUnit.blood_type"`)
+	}
+	return nil
+}
+
+func (_u *BloodUnitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bloodunit.Table, bloodunit.Columns, sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodunit.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(bloodunit.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(bloodunit.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodunit.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(bloodunit.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(bloodunit.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(bloodunit.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(bloodunit.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bloodunit.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.PmiLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.PmiLocationTable,
+			Columns: []string{bloodunit.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.PmiLocationTable,
+			Columns: []string{bloodunit.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RequestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.RequestTable,
+			Columns: []string{bloodunit.RequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.RequestTable,
+			Columns: []string{bloodunit.RequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.EventsTable,
+			Columns: []string{bloodunit.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.EventsTable,
+			Columns: []string{bloodunit.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.EventsTable,
+			Columns: []string{bloodunit.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.MovementsTable,
+			Columns: []string{bloodunit.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.MovementsTable,
+			Columns: []string{bloodunit.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.MovementsTable,
+			Columns: []string{bloodunit.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bloodunit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BloodUnitUpdateOne is the builder for updating a single BloodUnit entity.
+type BloodUnitUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BloodUnitMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BloodUnitUpdateOne) SetUpdatedAt(v int64) *BloodUnitUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *BloodUnitUpdateOne) AddUpdatedAt(v int64) *BloodUnitUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BloodUnitUpdateOne) ClearUpdatedAt() *BloodUnitUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BloodUnitUpdateOne) SetDeletedAt(v int64) *BloodUnitUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BloodUnitUpdateOne) SetNillableDeletedAt(v *int64) *BloodUnitUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *BloodUnitUpdateOne) AddDeletedAt(v int64) *BloodUnitUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BloodUnitUpdateOne) ClearDeletedAt() *BloodUnitUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *BloodUnitUpdateOne) SetExpiresAt(v int64) *BloodUnitUpdateOne {
+	_u.mutation.ResetExpiresAt()
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *BloodUnitUpdateOne) SetNillableExpiresAt(v *int64) *BloodUnitUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// AddExpiresAt adds value to the "expires_at" field.
+func (_u *BloodUnitUpdateOne) AddExpiresAt(v int64) *BloodUnitUpdateOne {
+	_u.mutation.AddExpiresAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BloodUnitUpdateOne) SetStatus(v bloodunit.Status) *BloodUnitUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BloodUnitUpdateOne) SetNillableStatus(v *bloodunit.Status) *BloodUnitUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_u *BloodUnitUpdateOne) SetPmiLocationID(id uuid.UUID) *BloodUnitUpdateOne {
+	_u.mutation.SetPmiLocationID(id)
+	return _u
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_u *BloodUnitUpdateOne) SetPmiLocation(v *PMILocation) *BloodUnitUpdateOne {
+	return _u.SetPmiLocationID(v.ID)
+}
+
+// SetRequestID sets the "request" edge to the BloodRequest entity by ID.
+func (_u *BloodUnitUpdateOne) SetRequestID(id uuid.UUID) *BloodUnitUpdateOne {
+	_u.mutation.SetRequestID(id)
+	return _u
+}
+
+// SetNillableRequestID sets the "request" edge to the BloodRequest entity by ID if the given value is not nil.
+func (_u *BloodUnitUpdateOne) SetNillableRequestID(id *uuid.UUID) *BloodUnitUpdateOne {
+	if id != nil {
+		_u = _u.SetRequestID(*id)
+	}
+	return _u
+}
+
+// SetRequest sets the "request" edge to the BloodRequest entity.
+func (_u *BloodUnitUpdateOne) SetRequest(v *BloodRequest) *BloodUnitUpdateOne {
+	return _u.SetRequestID(v.ID)
+}
+
+// AddEventIDs adds the "events" edge to the BloodUnitEvent entity by IDs.
+func (_u *BloodUnitUpdateOne) AddEventIDs(ids ...uuid.UUID) *BloodUnitUpdateOne {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the BloodUnitEvent entity.
+func (_u *BloodUnitUpdateOne) AddEvents(v ...*BloodUnitEvent) *BloodUnitUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_u *BloodUnitUpdateOne) AddMovementIDs(ids ...uuid.UUID) *BloodUnitUpdateOne {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_u *BloodUnitUpdateOne) AddMovements(v ...*StockMovement) *BloodUnitUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// Mutation returns the BloodUnitMutation object of the builder.
+func (_u *BloodUnitUpdateOne) Mutation() *BloodUnitMutation {
+	return _u.mutation
+}
+
+// ClearPmiLocation clears the "pmi_location" edge to the PMILocation entity.
+func (_u *BloodUnitUpdateOne) ClearPmiLocation() *BloodUnitUpdateOne {
+	_u.mutation.ClearPmiLocation()
+	return _u
+}
+
+// ClearRequest clears the "request" edge to the BloodRequest entity.
+func (_u *BloodUnitUpdateOne) ClearRequest() *BloodUnitUpdateOne {
+	_u.mutation.ClearRequest()
+	return _u
+}
+
+// ClearEvents clears all "events" edges to the BloodUnitEvent entity.
+func (_u *BloodUnitUpdateOne) ClearEvents() *BloodUnitUpdateOne {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to BloodUnitEvent entities by IDs.
+func (_u *BloodUnitUpdateOne) RemoveEventIDs(ids ...uuid.UUID) *BloodUnitUpdateOne {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to BloodUnitEvent entities.
+func (_u *BloodUnitUpdateOne) RemoveEvents(v ...*BloodUnitEvent) *BloodUnitUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (_u *BloodUnitUpdateOne) ClearMovements() *BloodUnitUpdateOne {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (_u *BloodUnitUpdateOne) RemoveMovementIDs(ids ...uuid.UUID) *BloodUnitUpdateOne {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (_u *BloodUnitUpdateOne) RemoveMovements(v ...*StockMovement) *BloodUnitUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// Where appends a list predicates to the BloodUnitUpdate builder.
+func (_u *BloodUnitUpdateOne) Where(ps ...predicate.BloodUnit) *BloodUnitUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BloodUnitUpdateOne) Select(field string, fields ...string) *BloodUnitUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BloodUnit entity.
+func (_u *BloodUnitUpdateOne) Save(ctx context.Context) (*BloodUnit, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BloodUnitUpdateOne) SaveX(ctx context.Context) *BloodUnit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BloodUnitUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BloodUnitUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BloodUnitUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := bloodunit.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BloodUnitUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := bloodunit.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := bloodunit.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExpiresAt(); ok {
+		if err := bloodunit.ExpiresAtValidator(v); err != nil {
+			return &ValidationError{Name: "expires_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.expires_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := bloodunit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BloodUnit.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodUnit.pmi_location"`)
+	}
+	if _u.mutation.BloodTypeCleared() && len(_u.mutation.BloodTypeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodUnit.blood_type"`)
+	}
+	return nil
+}
+
+func (_u *BloodUnitUpdateOne) sqlSave(ctx context.Context) (_node *BloodUnit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bloodunit.Table, bloodunit.Columns, sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BloodUnit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bloodunit.FieldID)
+		for _, f := range fields {
+			if !bloodunit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bloodunit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodunit.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(bloodunit.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(bloodunit.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodunit.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(bloodunit.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(bloodunit.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(bloodunit.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(bloodunit.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bloodunit.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.PmiLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.PmiLocationTable,
+			Columns: []string{bloodunit.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.PmiLocationTable,
+			Columns: []string{bloodunit.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RequestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.RequestTable,
+			Columns: []string{bloodunit.RequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunit.RequestTable,
+			Columns: []string{bloodunit.RequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.EventsTable,
+			Columns: []string{bloodunit.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.EventsTable,
+			Columns: []string{bloodunit.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.EventsTable,
+			Columns: []string{bloodunit.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.MovementsTable,
+			Columns: []string{bloodunit.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.MovementsTable,
+			Columns: []string{bloodunit.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   bloodunit.MovementsTable,
+			Columns: []string{bloodunit.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BloodUnit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bloodunit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
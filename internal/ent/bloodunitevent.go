@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
+)
+
+// BloodUnitEvent is the model entity for the BloodUnitEvent schema.
+type BloodUnitEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Status before the transition. Empty for the registration.
+	FromStatus string `json:"from_status"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BloodUnitEventQuery when eager-loading is set.
+	Edges           BloodUnitEventEdges `json:"edges"`
+	blood_unit_id   *uuid.UUID
+	performed_by_id *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// BloodUnitEventEdges holds the relations/edges for other nodes in the graph.
+type BloodUnitEventEdges struct {
+	// Unit holds the value of the unit edge.
+	Unit *BloodUnit `json:"unit,omitempty"`
+	// Staff who changed the status. Empty for system jobs.
+	PerformedBy *Account `json:"performed_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UnitOrErr returns the Unit value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodUnitEventEdges) UnitOrErr() (*BloodUnit, error) {
+	if e.Unit != nil {
+		return e.Unit, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: bloodunit.Label}
+	}
+	return nil, &NotLoadedError{edge: "unit"}
+}
+
+// PerformedByOrErr returns the PerformedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BloodUnitEventEdges) PerformedByOrErr() (*Account, error) {
+	if e.PerformedBy != nil {
+		return e.PerformedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "performed_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BloodUnitEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bloodunitevent.FieldCreatedAt, bloodunitevent.FieldUpdatedAt, bloodunitevent.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case bloodunitevent.FieldFromStatus, bloodunitevent.FieldToStatus, bloodunitevent.FieldNote:
+			values[i] = new(sql.NullString)
+		case bloodunitevent.FieldID:
+			values[i] = new(uuid.UUID)
+		case bloodunitevent.ForeignKeys[0]: // blood_unit_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bloodunitevent.ForeignKeys[1]: // performed_by_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BloodUnitEvent fields.
+func (_m *BloodUnitEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bloodunitevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bloodunitevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case bloodunitevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case bloodunitevent.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case bloodunitevent.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				_m.FromStatus = value.String
+			}
+		case bloodunitevent.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				_m.ToStatus = value.String
+			}
+		case bloodunitevent.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case bloodunitevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field blood_unit_id", values[i])
+			} else if value.Valid {
+				_m.blood_unit_id = new(uuid.UUID)
+				*_m.blood_unit_id = *value.S.(*uuid.UUID)
+			}
+		case bloodunitevent.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field performed_by_id", values[i])
+			} else if value.Valid {
+				_m.performed_by_id = new(uuid.UUID)
+				*_m.performed_by_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BloodUnitEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *BloodUnitEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUnit queries the "unit" edge of the BloodUnitEvent entity.
+func (_m *BloodUnitEvent) QueryUnit() *BloodUnitQuery {
+	return NewBloodUnitEventClient(_m.config).QueryUnit(_m)
+}
+
+// QueryPerformedBy queries the "performed_by" edge of the BloodUnitEvent entity.
+func (_m *BloodUnitEvent) QueryPerformedBy() *AccountQuery {
+	return NewBloodUnitEventClient(_m.config).QueryPerformedBy(_m)
+}
+
+// Update returns a builder for updating this BloodUnitEvent.
+// Note that you need to call BloodUnitEvent.Unwrap() before calling this method if this BloodUnitEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BloodUnitEvent) Update() *BloodUnitEventUpdateOne {
+	return NewBloodUnitEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BloodUnitEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BloodUnitEvent) Unwrap() *BloodUnitEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BloodUnitEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BloodUnitEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("BloodUnitEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(_m.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(_m.ToStatus)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BloodUnitEvents is a parsable slice of BloodUnitEvent.
+type BloodUnitEvents []*BloodUnitEvent
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bloodunitevent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the bloodunitevent type in the database.
+	Label = "blood_unit_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// EdgeUnit holds the string denoting the unit edge name in mutations.
+	EdgeUnit = "unit"
+	// EdgePerformedBy holds the string denoting the performed_by edge name in mutations.
+	EdgePerformedBy = "performed_by"
+	// Table holds the table name of the bloodunitevent in the database.
+	Table = "blood_unit_events"
+	// UnitTable is the table that holds the unit relation/edge.
+	UnitTable = "blood_unit_events"
+	// UnitInverseTable is the table name for the BloodUnit entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodunit" package.
+	UnitInverseTable = "blood_units"
+	// UnitColumn is the table column denoting the unit relation/edge.
+	UnitColumn = "blood_unit_id"
+	// PerformedByTable is the table that holds the performed_by relation/edge.
+	PerformedByTable = "blood_unit_events"
+	// PerformedByInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	PerformedByInverseTable = "accounts"
+	// PerformedByColumn is the table column denoting the performed_by relation/edge.
+	PerformedByColumn = "performed_by_id"
+)
+
+// Columns holds all SQL columns for bloodunitevent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldNote,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "blood_unit_events"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"blood_unit_id",
+	"performed_by_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// FromStatusValidator is a validator for the "from_status" field. It is called by the builders before save.
+	FromStatusValidator func(string) error
+	// ToStatusValidator is a validator for the "to_status" field. It is called by the builders before save.
+	ToStatusValidator func(string) error
+	// NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	NoteValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BloodUnitEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByFromStatus orders the results by the from_status field.
+func ByFromStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStatus, opts...).ToFunc()
+}
+
+// ByToStatus orders the results by the to_status field.
+func ByToStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStatus, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByUnitField orders the results by unit field.
+func ByUnitField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUnitStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPerformedByField orders the results by performed_by field.
+func ByPerformedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPerformedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUnitStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UnitInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UnitTable, UnitColumn),
+	)
+}
+func newPerformedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PerformedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PerformedByTable, PerformedByColumn),
+	)
+}
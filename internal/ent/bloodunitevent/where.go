@@ -0,0 +1,501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bloodunitevent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// FromStatus applies equality check predicate on the "from_status" field. It's identical to FromStatusEQ.
+func FromStatus(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// ToStatus applies equality check predicate on the "to_status" field. It's identical to ToStatusEQ.
+func ToStatus(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldToStatus, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNEQ(FieldFromStatus, v))
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIn(FieldFromStatus, vs...))
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotIn(FieldFromStatus, vs...))
+}
+
+// FromStatusGT applies the GT predicate on the "from_status" field.
+func FromStatusGT(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGT(FieldFromStatus, v))
+}
+
+// FromStatusGTE applies the GTE predicate on the "from_status" field.
+func FromStatusGTE(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGTE(FieldFromStatus, v))
+}
+
+// FromStatusLT applies the LT predicate on the "from_status" field.
+func FromStatusLT(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLT(FieldFromStatus, v))
+}
+
+// FromStatusLTE applies the LTE predicate on the "from_status" field.
+func FromStatusLTE(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLTE(FieldFromStatus, v))
+}
+
+// FromStatusContains applies the Contains predicate on the "from_status" field.
+func FromStatusContains(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldContains(FieldFromStatus, v))
+}
+
+// FromStatusHasPrefix applies the HasPrefix predicate on the "from_status" field.
+func FromStatusHasPrefix(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldHasPrefix(FieldFromStatus, v))
+}
+
+// FromStatusHasSuffix applies the HasSuffix predicate on the "from_status" field.
+func FromStatusHasSuffix(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldHasSuffix(FieldFromStatus, v))
+}
+
+// FromStatusIsNil applies the IsNil predicate on the "from_status" field.
+func FromStatusIsNil() predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIsNull(FieldFromStatus))
+}
+
+// FromStatusNotNil applies the NotNil predicate on the "from_status" field.
+func FromStatusNotNil() predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotNull(FieldFromStatus))
+}
+
+// FromStatusEqualFold applies the EqualFold predicate on the "from_status" field.
+func FromStatusEqualFold(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEqualFold(FieldFromStatus, v))
+}
+
+// FromStatusContainsFold applies the ContainsFold predicate on the "from_status" field.
+func FromStatusContainsFold(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldContainsFold(FieldFromStatus, v))
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNEQ(FieldToStatus, v))
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIn(FieldToStatus, vs...))
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotIn(FieldToStatus, vs...))
+}
+
+// ToStatusGT applies the GT predicate on the "to_status" field.
+func ToStatusGT(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGT(FieldToStatus, v))
+}
+
+// ToStatusGTE applies the GTE predicate on the "to_status" field.
+func ToStatusGTE(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGTE(FieldToStatus, v))
+}
+
+// ToStatusLT applies the LT predicate on the "to_status" field.
+func ToStatusLT(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLT(FieldToStatus, v))
+}
+
+// ToStatusLTE applies the LTE predicate on the "to_status" field.
+func ToStatusLTE(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLTE(FieldToStatus, v))
+}
+
+// ToStatusContains applies the Contains predicate on the "to_status" field.
+func ToStatusContains(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldContains(FieldToStatus, v))
+}
+
+// ToStatusHasPrefix applies the HasPrefix predicate on the "to_status" field.
+func ToStatusHasPrefix(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldHasPrefix(FieldToStatus, v))
+}
+
+// ToStatusHasSuffix applies the HasSuffix predicate on the "to_status" field.
+func ToStatusHasSuffix(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldHasSuffix(FieldToStatus, v))
+}
+
+// ToStatusEqualFold applies the EqualFold predicate on the "to_status" field.
+func ToStatusEqualFold(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEqualFold(FieldToStatus, v))
+}
+
+// ToStatusContainsFold applies the ContainsFold predicate on the "to_status" field.
+func ToStatusContainsFold(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldContainsFold(FieldToStatus, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.FieldContainsFold(FieldNote, v))
+}
+
+// HasUnit applies the HasEdge predicate on the "unit" edge.
+func HasUnit() predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UnitTable, UnitColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUnitWith applies the HasEdge predicate on the "unit" edge with a given conditions (other predicates).
+func HasUnitWith(preds ...predicate.BloodUnit) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(func(s *sql.Selector) {
+		step := newUnitStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPerformedBy applies the HasEdge predicate on the "performed_by" edge.
+func HasPerformedBy() predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PerformedByTable, PerformedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPerformedByWith applies the HasEdge predicate on the "performed_by" edge with a given conditions (other predicates).
+func HasPerformedByWith(preds ...predicate.Account) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(func(s *sql.Selector) {
+		step := newPerformedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BloodUnitEvent) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BloodUnitEvent) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BloodUnitEvent) predicate.BloodUnitEvent {
+	return predicate.BloodUnitEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
+)
+
+// BloodUnitEventCreate is the builder for creating a BloodUnitEvent entity.
+type BloodUnitEventCreate struct {
+	config
+	mutation *BloodUnitEventMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BloodUnitEventCreate) SetCreatedAt(v int64) *BloodUnitEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BloodUnitEventCreate) SetUpdatedAt(v int64) *BloodUnitEventCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BloodUnitEventCreate) SetNillableUpdatedAt(v *int64) *BloodUnitEventCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *BloodUnitEventCreate) SetDeletedAt(v int64) *BloodUnitEventCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *BloodUnitEventCreate) SetNillableDeletedAt(v *int64) *BloodUnitEventCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_c *BloodUnitEventCreate) SetFromStatus(v string) *BloodUnitEventCreate {
+	_c.mutation.SetFromStatus(v)
+	return _c
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_c *BloodUnitEventCreate) SetNillableFromStatus(v *string) *BloodUnitEventCreate {
+	if v != nil {
+		_c.SetFromStatus(*v)
+	}
+	return _c
+}
+
+// SetToStatus sets the "to_status" field.
+func (_c *BloodUnitEventCreate) SetToStatus(v string) *BloodUnitEventCreate {
+	_c.mutation.SetToStatus(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *BloodUnitEventCreate) SetNote(v string) *BloodUnitEventCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *BloodUnitEventCreate) SetNillableNote(v *string) *BloodUnitEventCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BloodUnitEventCreate) SetID(v uuid.UUID) *BloodUnitEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUnitID sets the "unit" edge to the BloodUnit entity by ID.
+func (_c *BloodUnitEventCreate) SetUnitID(id uuid.UUID) *BloodUnitEventCreate {
+	_c.mutation.SetUnitID(id)
+	return _c
+}
+
+// SetUnit sets the "unit" edge to the BloodUnit entity.
+func (_c *BloodUnitEventCreate) SetUnit(v *BloodUnit) *BloodUnitEventCreate {
+	return _c.SetUnitID(v.ID)
+}
+
+// SetPerformedByID sets the "performed_by" edge to the Account entity by ID.
+func (_c *BloodUnitEventCreate) SetPerformedByID(id uuid.UUID) *BloodUnitEventCreate {
+	_c.mutation.SetPerformedByID(id)
+	return _c
+}
+
+// SetNillablePerformedByID sets the "performed_by" edge to the Account entity by ID if the given value is not nil.
+func (_c *BloodUnitEventCreate) SetNillablePerformedByID(id *uuid.UUID) *BloodUnitEventCreate {
+	if id != nil {
+		_c = _c.SetPerformedByID(*id)
+	}
+	return _c
+}
+
+// SetPerformedBy sets the "performed_by" edge to the Account entity.
+func (_c *BloodUnitEventCreate) SetPerformedBy(v *Account) *BloodUnitEventCreate {
+	return _c.SetPerformedByID(v.ID)
+}
+
+// Mutation returns the BloodUnitEventMutation object of the builder.
+func (_c *BloodUnitEventCreate) Mutation() *BloodUnitEventMutation {
+	return _c.mutation
+}
+
+// Save creates the BloodUnitEvent in the database.
+func (_c *BloodUnitEventCreate) Save(ctx context.Context) (*BloodUnitEvent, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BloodUnitEventCreate) SaveX(ctx context.Context) *BloodUnitEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BloodUnitEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BloodUnitEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BloodUnitEventCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := bloodunitevent.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnitEvent.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := bloodunitevent.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnitEvent.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := bloodunitevent.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnitEvent.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.FromStatus(); ok {
+		if err := bloodunitevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "BloodUnitEvent.from_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "BloodUnitEvent.to_status"`)}
+	}
+	if v, ok := _c.mutation.ToStatus(); ok {
+		if err := bloodunitevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "BloodUnitEvent.to_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := bloodunitevent.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "BloodUnitEvent.note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UnitIDs()) == 0 {
+		return &ValidationError{Name: "unit", err: errors.New(`ent: missing required edge "BloodUnitEvent.unit"`)}
+	}
+	return nil
+}
+
+func (_c *BloodUnitEventCreate) sqlSave(ctx context.Context) (*BloodUnitEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BloodUnitEventCreate) createSpec() (*BloodUnitEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BloodUnitEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bloodunitevent.Table, sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bloodunitevent.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodunitevent.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodunitevent.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.FromStatus(); ok {
+		_spec.SetField(bloodunitevent.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := _c.mutation.ToStatus(); ok {
+		_spec.SetField(bloodunitevent.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(bloodunitevent.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if nodes := _c.mutation.UnitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunitevent.UnitTable,
+			Columns: []string{bloodunitevent.UnitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blood_unit_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PerformedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bloodunitevent.PerformedByTable,
+			Columns: []string{bloodunitevent.PerformedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.performed_by_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BloodUnitEventCreateBulk is the builder for creating many BloodUnitEvent entities in bulk.
+type BloodUnitEventCreateBulk struct {
+	config
+	err      error
+	builders []*BloodUnitEventCreate
+}
+
+// Save creates the BloodUnitEvent entities in the database.
+func (_c *BloodUnitEventCreateBulk) Save(ctx context.Context) ([]*BloodUnitEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BloodUnitEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BloodUnitEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BloodUnitEventCreateBulk) SaveX(ctx context.Context) []*BloodUnitEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BloodUnitEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BloodUnitEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// BloodUnitEventDelete is the builder for deleting a BloodUnitEvent entity.
+type BloodUnitEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *BloodUnitEventMutation
+}
+
+// Where appends a list predicates to the BloodUnitEventDelete builder.
+func (_d *BloodUnitEventDelete) Where(ps ...predicate.BloodUnitEvent) *BloodUnitEventDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BloodUnitEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BloodUnitEventDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BloodUnitEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bloodunitevent.Table, sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BloodUnitEventDeleteOne is the builder for deleting a single BloodUnitEvent entity.
+type BloodUnitEventDeleteOne struct {
+	_d *BloodUnitEventDelete
+}
+
+// Where appends a list predicates to the BloodUnitEventDelete builder.
+func (_d *BloodUnitEventDeleteOne) Where(ps ...predicate.BloodUnitEvent) *BloodUnitEventDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BloodUnitEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bloodunitevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BloodUnitEventDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
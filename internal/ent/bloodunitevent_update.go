@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// BloodUnitEventUpdate is the builder for updating BloodUnitEvent entities.
+type BloodUnitEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BloodUnitEventMutation
+}
+
+// Where appends a list predicates to the BloodUnitEventUpdate builder.
+func (_u *BloodUnitEventUpdate) Where(ps ...predicate.BloodUnitEvent) *BloodUnitEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BloodUnitEventUpdate) SetUpdatedAt(v int64) *BloodUnitEventUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *BloodUnitEventUpdate) AddUpdatedAt(v int64) *BloodUnitEventUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BloodUnitEventUpdate) ClearUpdatedAt() *BloodUnitEventUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BloodUnitEventUpdate) SetDeletedAt(v int64) *BloodUnitEventUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BloodUnitEventUpdate) SetNillableDeletedAt(v *int64) *BloodUnitEventUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *BloodUnitEventUpdate) AddDeletedAt(v int64) *BloodUnitEventUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BloodUnitEventUpdate) ClearDeletedAt() *BloodUnitEventUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the BloodUnitEventMutation object of the builder.
+func (_u *BloodUnitEventUpdate) Mutation() *BloodUnitEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BloodUnitEventUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BloodUnitEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BloodUnitEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BloodUnitEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BloodUnitEventUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := bloodunitevent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BloodUnitEventUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := bloodunitevent.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnitEvent.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := bloodunitevent.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnitEvent.deleted_at": %w`, err)}
+		}
+	}
+	if _u.mutation.UnitCleared() && len(_u.mutation.UnitIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodUnitEvent.unit"`)
+	}
+	return nil
+}
+
+func (_u *BloodUnitEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bloodunitevent.Table, bloodunitevent.Columns, sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodunitevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(bloodunitevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(bloodunitevent.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodunitevent.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(bloodunitevent.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(bloodunitevent.FieldDeletedAt, field.TypeInt64)
+	}
+	if _u.mutation.FromStatusCleared() {
+		_spec.ClearField(bloodunitevent.FieldFromStatus, field.TypeString)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(bloodunitevent.FieldNote, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bloodunitevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BloodUnitEventUpdateOne is the builder for updating a single BloodUnitEvent entity.
+type BloodUnitEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BloodUnitEventMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BloodUnitEventUpdateOne) SetUpdatedAt(v int64) *BloodUnitEventUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *BloodUnitEventUpdateOne) AddUpdatedAt(v int64) *BloodUnitEventUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BloodUnitEventUpdateOne) ClearUpdatedAt() *BloodUnitEventUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BloodUnitEventUpdateOne) SetDeletedAt(v int64) *BloodUnitEventUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BloodUnitEventUpdateOne) SetNillableDeletedAt(v *int64) *BloodUnitEventUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *BloodUnitEventUpdateOne) AddDeletedAt(v int64) *BloodUnitEventUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BloodUnitEventUpdateOne) ClearDeletedAt() *BloodUnitEventUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the BloodUnitEventMutation object of the builder.
+func (_u *BloodUnitEventUpdateOne) Mutation() *BloodUnitEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BloodUnitEventUpdate builder.
+func (_u *BloodUnitEventUpdateOne) Where(ps ...predicate.BloodUnitEvent) *BloodUnitEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BloodUnitEventUpdateOne) Select(field string, fields ...string) *BloodUnitEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BloodUnitEvent entity.
+func (_u *BloodUnitEventUpdateOne) Save(ctx context.Context) (*BloodUnitEvent, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BloodUnitEventUpdateOne) SaveX(ctx context.Context) *BloodUnitEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BloodUnitEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BloodUnitEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BloodUnitEventUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := bloodunitevent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BloodUnitEventUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := bloodunitevent.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnitEvent.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := bloodunitevent.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "BloodUnitEvent.deleted_at": %w`, err)}
+		}
+	}
+	if _u.mutation.UnitCleared() && len(_u.mutation.UnitIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BloodUnitEvent.unit"`)
+	}
+	return nil
+}
+
+func (_u *BloodUnitEventUpdateOne) sqlSave(ctx context.Context) (_node *BloodUnitEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bloodunitevent.Table, bloodunitevent.Columns, sqlgraph.NewFieldSpec(bloodunitevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BloodUnitEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bloodunitevent.FieldID)
+		for _, f := range fields {
+			if !bloodunitevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bloodunitevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bloodunitevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(bloodunitevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(bloodunitevent.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(bloodunitevent.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(bloodunitevent.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(bloodunitevent.FieldDeletedAt, field.TypeInt64)
+	}
+	if _u.mutation.FromStatusCleared() {
+		_spec.ClearField(bloodunitevent.FieldFromStatus, field.TypeString)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(bloodunitevent.FieldNote, field.TypeString)
+	}
+	_node = &BloodUnitEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bloodunitevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/certificate"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+)
+
+// Certificate is the model entity for the Certificate schema.
+type Certificate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Number of donations the certificate recognizes.
+	Milestone int `json:"milestone"`
+	// Donor name at the time the certificate was awarded.
+	HolderName string `json:"holder_name"`
+	// Time of the donation that reached the milestone in milliseconds.
+	AwardedAt int64 `json:"awarded_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CertificateQuery when eager-loading is set.
+	Edges        CertificateEdges `json:"edges"`
+	account_id   *uuid.UUID
+	donation_id  *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// CertificateEdges holds the relations/edges for other nodes in the graph.
+type CertificateEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Donation holds the value of the donation edge.
+	Donation *Donation `json:"donation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertificateEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// DonationOrErr returns the Donation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertificateEdges) DonationOrErr() (*Donation, error) {
+	if e.Donation != nil {
+		return e.Donation, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: donation.Label}
+	}
+	return nil, &NotLoadedError{edge: "donation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Certificate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case certificate.FieldCreatedAt, certificate.FieldUpdatedAt, certificate.FieldDeletedAt, certificate.FieldMilestone, certificate.FieldAwardedAt:
+			values[i] = new(sql.NullInt64)
+		case certificate.FieldHolderName:
+			values[i] = new(sql.NullString)
+		case certificate.FieldID:
+			values[i] = new(uuid.UUID)
+		case certificate.ForeignKeys[0]: // account_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case certificate.ForeignKeys[1]: // donation_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Certificate fields.
+func (_m *Certificate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case certificate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case certificate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case certificate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case certificate.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case certificate.FieldMilestone:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field milestone", values[i])
+			} else if value.Valid {
+				_m.Milestone = int(value.Int64)
+			}
+		case certificate.FieldHolderName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holder_name", values[i])
+			} else if value.Valid {
+				_m.HolderName = value.String
+			}
+		case certificate.FieldAwardedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field awarded_at", values[i])
+			} else if value.Valid {
+				_m.AwardedAt = value.Int64
+			}
+		case certificate.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.account_id = new(uuid.UUID)
+				*_m.account_id = *value.S.(*uuid.UUID)
+			}
+		case certificate.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field donation_id", values[i])
+			} else if value.Valid {
+				_m.donation_id = new(uuid.UUID)
+				*_m.donation_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Certificate.
+// This includes values selected through modifiers, order, etc.
+func (_m *Certificate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Certificate entity.
+func (_m *Certificate) QueryAccount() *AccountQuery {
+	return NewCertificateClient(_m.config).QueryAccount(_m)
+}
+
+// QueryDonation queries the "donation" edge of the Certificate entity.
+func (_m *Certificate) QueryDonation() *DonationQuery {
+	return NewCertificateClient(_m.config).QueryDonation(_m)
+}
+
+// Update returns a builder for updating this Certificate.
+// Note that you need to call Certificate.Unwrap() before calling this method if this Certificate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Certificate) Update() *CertificateUpdateOne {
+	return NewCertificateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Certificate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Certificate) Unwrap() *Certificate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Certificate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Certificate) String() string {
+	var builder strings.Builder
+	builder.WriteString("Certificate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("milestone=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Milestone))
+	builder.WriteString(", ")
+	builder.WriteString("holder_name=")
+	builder.WriteString(_m.HolderName)
+	builder.WriteString(", ")
+	builder.WriteString("awarded_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AwardedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Certificates is a parsable slice of Certificate.
+type Certificates []*Certificate
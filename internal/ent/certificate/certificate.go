@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certificate
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the certificate type in the database.
+	Label = "certificate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldMilestone holds the string denoting the milestone field in the database.
+	FieldMilestone = "milestone"
+	// FieldHolderName holds the string denoting the holder_name field in the database.
+	FieldHolderName = "holder_name"
+	// FieldAwardedAt holds the string denoting the awarded_at field in the database.
+	FieldAwardedAt = "awarded_at"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeDonation holds the string denoting the donation edge name in mutations.
+	EdgeDonation = "donation"
+	// Table holds the table name of the certificate in the database.
+	Table = "certificates"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "certificates"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// DonationTable is the table that holds the donation relation/edge.
+	DonationTable = "certificates"
+	// DonationInverseTable is the table name for the Donation entity.
+	// It exists in this package in order to avoid circular dependency with the "donation" package.
+	DonationInverseTable = "donations"
+	// DonationColumn is the table column denoting the donation relation/edge.
+	DonationColumn = "donation_id"
+)
+
+// Columns holds all SQL columns for certificate fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldMilestone,
+	FieldHolderName,
+	FieldAwardedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "certificates"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"account_id",
+	"donation_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// MilestoneValidator is a validator for the "milestone" field. It is called by the builders before save.
+	MilestoneValidator func(int) error
+	// HolderNameValidator is a validator for the "holder_name" field. It is called by the builders before save.
+	HolderNameValidator func(string) error
+	// AwardedAtValidator is a validator for the "awarded_at" field. It is called by the builders before save.
+	AwardedAtValidator func(int64) error
+)
+
+// OrderOption defines the ordering options for the Certificate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByMilestone orders the results by the milestone field.
+func ByMilestone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMilestone, opts...).ToFunc()
+}
+
+// ByHolderName orders the results by the holder_name field.
+func ByHolderName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHolderName, opts...).ToFunc()
+}
+
+// ByAwardedAt orders the results by the awarded_at field.
+func ByAwardedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAwardedAt, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDonationField orders the results by donation field.
+func ByDonationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDonationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+	)
+}
+func newDonationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DonationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, DonationTable, DonationColumn),
+	)
+}
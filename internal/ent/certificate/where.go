@@ -0,0 +1,431 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certificate
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Milestone applies equality check predicate on the "milestone" field. It's identical to MilestoneEQ.
+func Milestone(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldMilestone, v))
+}
+
+// HolderName applies equality check predicate on the "holder_name" field. It's identical to HolderNameEQ.
+func HolderName(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldHolderName, v))
+}
+
+// AwardedAt applies equality check predicate on the "awarded_at" field. It's identical to AwardedAtEQ.
+func AwardedAt(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldAwardedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// MilestoneEQ applies the EQ predicate on the "milestone" field.
+func MilestoneEQ(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldMilestone, v))
+}
+
+// MilestoneNEQ applies the NEQ predicate on the "milestone" field.
+func MilestoneNEQ(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldMilestone, v))
+}
+
+// MilestoneIn applies the In predicate on the "milestone" field.
+func MilestoneIn(vs ...int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldMilestone, vs...))
+}
+
+// MilestoneNotIn applies the NotIn predicate on the "milestone" field.
+func MilestoneNotIn(vs ...int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldMilestone, vs...))
+}
+
+// MilestoneGT applies the GT predicate on the "milestone" field.
+func MilestoneGT(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldMilestone, v))
+}
+
+// MilestoneGTE applies the GTE predicate on the "milestone" field.
+func MilestoneGTE(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldMilestone, v))
+}
+
+// MilestoneLT applies the LT predicate on the "milestone" field.
+func MilestoneLT(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldMilestone, v))
+}
+
+// MilestoneLTE applies the LTE predicate on the "milestone" field.
+func MilestoneLTE(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldMilestone, v))
+}
+
+// HolderNameEQ applies the EQ predicate on the "holder_name" field.
+func HolderNameEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldHolderName, v))
+}
+
+// HolderNameNEQ applies the NEQ predicate on the "holder_name" field.
+func HolderNameNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldHolderName, v))
+}
+
+// HolderNameIn applies the In predicate on the "holder_name" field.
+func HolderNameIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldHolderName, vs...))
+}
+
+// HolderNameNotIn applies the NotIn predicate on the "holder_name" field.
+func HolderNameNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldHolderName, vs...))
+}
+
+// HolderNameGT applies the GT predicate on the "holder_name" field.
+func HolderNameGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldHolderName, v))
+}
+
+// HolderNameGTE applies the GTE predicate on the "holder_name" field.
+func HolderNameGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldHolderName, v))
+}
+
+// HolderNameLT applies the LT predicate on the "holder_name" field.
+func HolderNameLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldHolderName, v))
+}
+
+// HolderNameLTE applies the LTE predicate on the "holder_name" field.
+func HolderNameLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldHolderName, v))
+}
+
+// HolderNameContains applies the Contains predicate on the "holder_name" field.
+func HolderNameContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldHolderName, v))
+}
+
+// HolderNameHasPrefix applies the HasPrefix predicate on the "holder_name" field.
+func HolderNameHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldHolderName, v))
+}
+
+// HolderNameHasSuffix applies the HasSuffix predicate on the "holder_name" field.
+func HolderNameHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldHolderName, v))
+}
+
+// HolderNameEqualFold applies the EqualFold predicate on the "holder_name" field.
+func HolderNameEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldHolderName, v))
+}
+
+// HolderNameContainsFold applies the ContainsFold predicate on the "holder_name" field.
+func HolderNameContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldHolderName, v))
+}
+
+// AwardedAtEQ applies the EQ predicate on the "awarded_at" field.
+func AwardedAtEQ(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldAwardedAt, v))
+}
+
+// AwardedAtNEQ applies the NEQ predicate on the "awarded_at" field.
+func AwardedAtNEQ(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldAwardedAt, v))
+}
+
+// AwardedAtIn applies the In predicate on the "awarded_at" field.
+func AwardedAtIn(vs ...int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldAwardedAt, vs...))
+}
+
+// AwardedAtNotIn applies the NotIn predicate on the "awarded_at" field.
+func AwardedAtNotIn(vs ...int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldAwardedAt, vs...))
+}
+
+// AwardedAtGT applies the GT predicate on the "awarded_at" field.
+func AwardedAtGT(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldAwardedAt, v))
+}
+
+// AwardedAtGTE applies the GTE predicate on the "awarded_at" field.
+func AwardedAtGTE(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldAwardedAt, v))
+}
+
+// AwardedAtLT applies the LT predicate on the "awarded_at" field.
+func AwardedAtLT(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldAwardedAt, v))
+}
+
+// AwardedAtLTE applies the LTE predicate on the "awarded_at" field.
+func AwardedAtLTE(v int64) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldAwardedAt, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDonation applies the HasEdge predicate on the "donation" edge.
+func HasDonation() predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, DonationTable, DonationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDonationWith applies the HasEdge predicate on the "donation" edge with a given conditions (other predicates).
+func HasDonationWith(preds ...predicate.Donation) predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := newDonationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Certificate) predicate.Certificate {
+	return predicate.Certificate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Certificate) predicate.Certificate {
+	return predicate.Certificate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Certificate) predicate.Certificate {
+	return predicate.Certificate(sql.NotPredicates(p))
+}
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/certificate"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+)
+
+// CertificateCreate is the builder for creating a Certificate entity.
+type CertificateCreate struct {
+	config
+	mutation *CertificateMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CertificateCreate) SetCreatedAt(v int64) *CertificateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CertificateCreate) SetUpdatedAt(v int64) *CertificateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableUpdatedAt(v *int64) *CertificateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CertificateCreate) SetDeletedAt(v int64) *CertificateCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableDeletedAt(v *int64) *CertificateCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetMilestone sets the "milestone" field.
+func (_c *CertificateCreate) SetMilestone(v int) *CertificateCreate {
+	_c.mutation.SetMilestone(v)
+	return _c
+}
+
+// SetHolderName sets the "holder_name" field.
+func (_c *CertificateCreate) SetHolderName(v string) *CertificateCreate {
+	_c.mutation.SetHolderName(v)
+	return _c
+}
+
+// SetAwardedAt sets the "awarded_at" field.
+func (_c *CertificateCreate) SetAwardedAt(v int64) *CertificateCreate {
+	_c.mutation.SetAwardedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CertificateCreate) SetID(v uuid.UUID) *CertificateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *CertificateCreate) SetAccountID(id uuid.UUID) *CertificateCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *CertificateCreate) SetAccount(v *Account) *CertificateCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetDonationID sets the "donation" edge to the Donation entity by ID.
+func (_c *CertificateCreate) SetDonationID(id uuid.UUID) *CertificateCreate {
+	_c.mutation.SetDonationID(id)
+	return _c
+}
+
+// SetDonation sets the "donation" edge to the Donation entity.
+func (_c *CertificateCreate) SetDonation(v *Donation) *CertificateCreate {
+	return _c.SetDonationID(v.ID)
+}
+
+// Mutation returns the CertificateMutation object of the builder.
+func (_c *CertificateCreate) Mutation() *CertificateMutation {
+	return _c.mutation
+}
+
+// Save creates the Certificate in the database.
+func (_c *CertificateCreate) Save(ctx context.Context) (*Certificate, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CertificateCreate) SaveX(ctx context.Context) *Certificate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CertificateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CertificateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CertificateCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := certificate.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "Certificate.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := certificate.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Certificate.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := certificate.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Certificate.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Milestone(); !ok {
+		return &ValidationError{Name: "milestone", err: errors.New(`ent: missing required field "Certificate.milestone"`)}
+	}
+	if v, ok := _c.mutation.Milestone(); ok {
+		if err := certificate.MilestoneValidator(v); err != nil {
+			return &ValidationError{Name: "milestone", err: fmt.Errorf(`ent: validator failed for field "Certificate.milestone": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HolderName(); !ok {
+		return &ValidationError{Name: "holder_name", err: errors.New(`ent: missing required field "Certificate.holder_name"`)}
+	}
+	if v, ok := _c.mutation.HolderName(); ok {
+		if err := certificate.HolderNameValidator(v); err != nil {
+			return &ValidationError{Name: "holder_name", err: fmt.Errorf(`ent: validator failed for field "Certificate.holder_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AwardedAt(); !ok {
+		return &ValidationError{Name: "awarded_at", err: errors.New(`ent: missing required field "Certificate.awarded_at"`)}
+	}
+	if v, ok := _c.mutation.AwardedAt(); ok {
+		if err := certificate.AwardedAtValidator(v); err != nil {
+			return &ValidationError{Name: "awarded_at", err: fmt.Errorf(`ent: validator failed for field "Certificate.awarded_at": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Certificate.account"`)}
+	}
+	if len(_c.mutation.DonationIDs()) == 0 {
+		return &ValidationError{Name: "donation", err: errors.New(`ent: missing required edge "Certificate.donation"`)}
+	}
+	return nil
+}
+
+func (_c *CertificateCreate) sqlSave(ctx context.Context) (*Certificate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CertificateCreate) createSpec() (*Certificate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Certificate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(certificate.Table, sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(certificate.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(certificate.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(certificate.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Milestone(); ok {
+		_spec.SetField(certificate.FieldMilestone, field.TypeInt, value)
+		_node.Milestone = value
+	}
+	if value, ok := _c.mutation.HolderName(); ok {
+		_spec.SetField(certificate.FieldHolderName, field.TypeString, value)
+		_node.HolderName = value
+	}
+	if value, ok := _c.mutation.AwardedAt(); ok {
+		_spec.SetField(certificate.FieldAwardedAt, field.TypeInt64, value)
+		_node.AwardedAt = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certificate.AccountTable,
+			Columns: []string{certificate.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DonationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certificate.DonationTable,
+			Columns: []string{certificate.DonationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.donation_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CertificateCreateBulk is the builder for creating many Certificate entities in bulk.
+type CertificateCreateBulk struct {
+	config
+	err      error
+	builders []*CertificateCreate
+}
+
+// Save creates the Certificate entities in the database.
+func (_c *CertificateCreateBulk) Save(ctx context.Context) ([]*Certificate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Certificate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CertificateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CertificateCreateBulk) SaveX(ctx context.Context) []*Certificate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CertificateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CertificateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -17,16 +17,37 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/apikey"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/auditlog"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
 	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
 	"github.com/sembraniteam/setetes/internal/ent/casbinrule"
+	"github.com/sembraniteam/setetes/internal/ent/certificate"
 	"github.com/sembraniteam/setetes/internal/ent/city"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
 	"github.com/sembraniteam/setetes/internal/ent/district"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+	"github.com/sembraniteam/setetes/internal/ent/donationreminder"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/notificationpreference"
 	"github.com/sembraniteam/setetes/internal/ent/otp"
 	"github.com/sembraniteam/setetes/internal/ent/password"
 	"github.com/sembraniteam/setetes/internal/ent/permission"
 	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
 	"github.com/sembraniteam/setetes/internal/ent/province"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
 	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/roleassignment"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
 	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
 )
 
@@ -35,16 +56,48 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// Appointment is the client for interacting with the Appointment builders.
+	Appointment *AppointmentClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// BloodRequest is the client for interacting with the BloodRequest builders.
+	BloodRequest *BloodRequestClient
+	// BloodStock is the client for interacting with the BloodStock builders.
+	BloodStock *BloodStockClient
 	// BloodType is the client for interacting with the BloodType builders.
 	BloodType *BloodTypeClient
+	// BloodUnit is the client for interacting with the BloodUnit builders.
+	BloodUnit *BloodUnitClient
+	// BloodUnitEvent is the client for interacting with the BloodUnitEvent builders.
+	BloodUnitEvent *BloodUnitEventClient
 	// CasbinRule is the client for interacting with the CasbinRule builders.
 	CasbinRule *CasbinRuleClient
+	// Certificate is the client for interacting with the Certificate builders.
+	Certificate *CertificateClient
 	// City is the client for interacting with the City builders.
 	City *CityClient
+	// Deferral is the client for interacting with the Deferral builders.
+	Deferral *DeferralClient
 	// District is the client for interacting with the District builders.
 	District *DistrictClient
+	// Donation is the client for interacting with the Donation builders.
+	Donation *DonationClient
+	// DonationEvent is the client for interacting with the DonationEvent builders.
+	DonationEvent *DonationEventClient
+	// DonationReminder is the client for interacting with the DonationReminder builders.
+	DonationReminder *DonationReminderClient
+	// EmergencyCampaign is the client for interacting with the EmergencyCampaign builders.
+	EmergencyCampaign *EmergencyCampaignClient
+	// EmergencyContact is the client for interacting with the EmergencyContact builders.
+	EmergencyContact *EmergencyContactClient
+	// Hospital is the client for interacting with the Hospital builders.
+	Hospital *HospitalClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
 	// OTP is the client for interacting with the OTP builders.
 	OTP *OTPClient
 	// PMILocation is the client for interacting with the PMILocation builders.
@@ -55,8 +108,18 @@ type Client struct {
 	Permission *PermissionClient
 	// Province is the client for interacting with the Province builders.
 	Province *ProvinceClient
+	// Questionnaire is the client for interacting with the Questionnaire builders.
+	Questionnaire *QuestionnaireClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// RoleAssignment is the client for interacting with the RoleAssignment builders.
+	RoleAssignment *RoleAssignmentClient
+	// ScreeningQuestion is the client for interacting with the ScreeningQuestion builders.
+	ScreeningQuestion *ScreeningQuestionClient
+	// ScreeningSubmission is the client for interacting with the ScreeningSubmission builders.
+	ScreeningSubmission *ScreeningSubmissionClient
+	// StockMovement is the client for interacting with the StockMovement builders.
+	StockMovement *StockMovementClient
 	// Subdistrict is the client for interacting with the Subdistrict builders.
 	Subdistrict *SubdistrictClient
 }
@@ -70,17 +133,38 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
 	c.Account = NewAccountClient(c.config)
+	c.Appointment = NewAppointmentClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.BloodRequest = NewBloodRequestClient(c.config)
+	c.BloodStock = NewBloodStockClient(c.config)
 	c.BloodType = NewBloodTypeClient(c.config)
+	c.BloodUnit = NewBloodUnitClient(c.config)
+	c.BloodUnitEvent = NewBloodUnitEventClient(c.config)
 	c.CasbinRule = NewCasbinRuleClient(c.config)
+	c.Certificate = NewCertificateClient(c.config)
 	c.City = NewCityClient(c.config)
+	c.Deferral = NewDeferralClient(c.config)
 	c.District = NewDistrictClient(c.config)
+	c.Donation = NewDonationClient(c.config)
+	c.DonationEvent = NewDonationEventClient(c.config)
+	c.DonationReminder = NewDonationReminderClient(c.config)
+	c.EmergencyCampaign = NewEmergencyCampaignClient(c.config)
+	c.EmergencyContact = NewEmergencyContactClient(c.config)
+	c.Hospital = NewHospitalClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
 	c.OTP = NewOTPClient(c.config)
 	c.PMILocation = NewPMILocationClient(c.config)
 	c.Password = NewPasswordClient(c.config)
 	c.Permission = NewPermissionClient(c.config)
 	c.Province = NewProvinceClient(c.config)
+	c.Questionnaire = NewQuestionnaireClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.RoleAssignment = NewRoleAssignmentClient(c.config)
+	c.ScreeningQuestion = NewScreeningQuestionClient(c.config)
+	c.ScreeningSubmission = NewScreeningSubmissionClient(c.config)
+	c.StockMovement = NewStockMovementClient(c.config)
 	c.Subdistrict = NewSubdistrictClient(c.config)
 }
 
@@ -172,20 +256,41 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Account:     NewAccountClient(cfg),
-		BloodType:   NewBloodTypeClient(cfg),
-		CasbinRule:  NewCasbinRuleClient(cfg),
-		City:        NewCityClient(cfg),
-		District:    NewDistrictClient(cfg),
-		OTP:         NewOTPClient(cfg),
-		PMILocation: NewPMILocationClient(cfg),
-		Password:    NewPasswordClient(cfg),
-		Permission:  NewPermissionClient(cfg),
-		Province:    NewProvinceClient(cfg),
-		Role:        NewRoleClient(cfg),
-		Subdistrict: NewSubdistrictClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		APIKey:                 NewAPIKeyClient(cfg),
+		Account:                NewAccountClient(cfg),
+		Appointment:            NewAppointmentClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		BloodRequest:           NewBloodRequestClient(cfg),
+		BloodStock:             NewBloodStockClient(cfg),
+		BloodType:              NewBloodTypeClient(cfg),
+		BloodUnit:              NewBloodUnitClient(cfg),
+		BloodUnitEvent:         NewBloodUnitEventClient(cfg),
+		CasbinRule:             NewCasbinRuleClient(cfg),
+		Certificate:            NewCertificateClient(cfg),
+		City:                   NewCityClient(cfg),
+		Deferral:               NewDeferralClient(cfg),
+		District:               NewDistrictClient(cfg),
+		Donation:               NewDonationClient(cfg),
+		DonationEvent:          NewDonationEventClient(cfg),
+		DonationReminder:       NewDonationReminderClient(cfg),
+		EmergencyCampaign:      NewEmergencyCampaignClient(cfg),
+		EmergencyContact:       NewEmergencyContactClient(cfg),
+		Hospital:               NewHospitalClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		OTP:                    NewOTPClient(cfg),
+		PMILocation:            NewPMILocationClient(cfg),
+		Password:               NewPasswordClient(cfg),
+		Permission:             NewPermissionClient(cfg),
+		Province:               NewProvinceClient(cfg),
+		Questionnaire:          NewQuestionnaireClient(cfg),
+		Role:                   NewRoleClient(cfg),
+		RoleAssignment:         NewRoleAssignmentClient(cfg),
+		ScreeningQuestion:      NewScreeningQuestionClient(cfg),
+		ScreeningSubmission:    NewScreeningSubmissionClient(cfg),
+		StockMovement:          NewStockMovementClient(cfg),
+		Subdistrict:            NewSubdistrictClient(cfg),
 	}, nil
 }
 
@@ -203,27 +308,48 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Account:     NewAccountClient(cfg),
-		BloodType:   NewBloodTypeClient(cfg),
-		CasbinRule:  NewCasbinRuleClient(cfg),
-		City:        NewCityClient(cfg),
-		District:    NewDistrictClient(cfg),
-		OTP:         NewOTPClient(cfg),
-		PMILocation: NewPMILocationClient(cfg),
-		Password:    NewPasswordClient(cfg),
-		Permission:  NewPermissionClient(cfg),
-		Province:    NewProvinceClient(cfg),
-		Role:        NewRoleClient(cfg),
-		Subdistrict: NewSubdistrictClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		APIKey:                 NewAPIKeyClient(cfg),
+		Account:                NewAccountClient(cfg),
+		Appointment:            NewAppointmentClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		BloodRequest:           NewBloodRequestClient(cfg),
+		BloodStock:             NewBloodStockClient(cfg),
+		BloodType:              NewBloodTypeClient(cfg),
+		BloodUnit:              NewBloodUnitClient(cfg),
+		BloodUnitEvent:         NewBloodUnitEventClient(cfg),
+		CasbinRule:             NewCasbinRuleClient(cfg),
+		Certificate:            NewCertificateClient(cfg),
+		City:                   NewCityClient(cfg),
+		Deferral:               NewDeferralClient(cfg),
+		District:               NewDistrictClient(cfg),
+		Donation:               NewDonationClient(cfg),
+		DonationEvent:          NewDonationEventClient(cfg),
+		DonationReminder:       NewDonationReminderClient(cfg),
+		EmergencyCampaign:      NewEmergencyCampaignClient(cfg),
+		EmergencyContact:       NewEmergencyContactClient(cfg),
+		Hospital:               NewHospitalClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		OTP:                    NewOTPClient(cfg),
+		PMILocation:            NewPMILocationClient(cfg),
+		Password:               NewPasswordClient(cfg),
+		Permission:             NewPermissionClient(cfg),
+		Province:               NewProvinceClient(cfg),
+		Questionnaire:          NewQuestionnaireClient(cfg),
+		Role:                   NewRoleClient(cfg),
+		RoleAssignment:         NewRoleAssignmentClient(cfg),
+		ScreeningQuestion:      NewScreeningQuestionClient(cfg),
+		ScreeningSubmission:    NewScreeningSubmissionClient(cfg),
+		StockMovement:          NewStockMovementClient(cfg),
+		Subdistrict:            NewSubdistrictClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Account.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -246,8 +372,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Account, c.BloodType, c.CasbinRule, c.City, c.District, c.OTP, c.PMILocation,
-		c.Password, c.Permission, c.Province, c.Role, c.Subdistrict,
+		c.APIKey, c.Account, c.Appointment, c.AuditLog, c.BloodRequest, c.BloodStock,
+		c.BloodType, c.BloodUnit, c.BloodUnitEvent, c.CasbinRule, c.Certificate,
+		c.City, c.Deferral, c.District, c.Donation, c.DonationEvent,
+		c.DonationReminder, c.EmergencyCampaign, c.EmergencyContact, c.Hospital,
+		c.NotificationPreference, c.OTP, c.PMILocation, c.Password, c.Permission,
+		c.Province, c.Questionnaire, c.Role, c.RoleAssignment, c.ScreeningQuestion,
+		c.ScreeningSubmission, c.StockMovement, c.Subdistrict,
 	} {
 		n.Use(hooks...)
 	}
@@ -257,8 +388,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Account, c.BloodType, c.CasbinRule, c.City, c.District, c.OTP, c.PMILocation,
-		c.Password, c.Permission, c.Province, c.Role, c.Subdistrict,
+		c.APIKey, c.Account, c.Appointment, c.AuditLog, c.BloodRequest, c.BloodStock,
+		c.BloodType, c.BloodUnit, c.BloodUnitEvent, c.CasbinRule, c.Certificate,
+		c.City, c.Deferral, c.District, c.Donation, c.DonationEvent,
+		c.DonationReminder, c.EmergencyCampaign, c.EmergencyContact, c.Hospital,
+		c.NotificationPreference, c.OTP, c.PMILocation, c.Password, c.Permission,
+		c.Province, c.Questionnaire, c.Role, c.RoleAssignment, c.ScreeningQuestion,
+		c.ScreeningSubmission, c.StockMovement, c.Subdistrict,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -267,16 +403,48 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
 	case *AccountMutation:
 		return c.Account.mutate(ctx, m)
+	case *AppointmentMutation:
+		return c.Appointment.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *BloodRequestMutation:
+		return c.BloodRequest.mutate(ctx, m)
+	case *BloodStockMutation:
+		return c.BloodStock.mutate(ctx, m)
 	case *BloodTypeMutation:
 		return c.BloodType.mutate(ctx, m)
+	case *BloodUnitMutation:
+		return c.BloodUnit.mutate(ctx, m)
+	case *BloodUnitEventMutation:
+		return c.BloodUnitEvent.mutate(ctx, m)
 	case *CasbinRuleMutation:
 		return c.CasbinRule.mutate(ctx, m)
+	case *CertificateMutation:
+		return c.Certificate.mutate(ctx, m)
 	case *CityMutation:
 		return c.City.mutate(ctx, m)
+	case *DeferralMutation:
+		return c.Deferral.mutate(ctx, m)
 	case *DistrictMutation:
 		return c.District.mutate(ctx, m)
+	case *DonationMutation:
+		return c.Donation.mutate(ctx, m)
+	case *DonationEventMutation:
+		return c.DonationEvent.mutate(ctx, m)
+	case *DonationReminderMutation:
+		return c.DonationReminder.mutate(ctx, m)
+	case *EmergencyCampaignMutation:
+		return c.EmergencyCampaign.mutate(ctx, m)
+	case *EmergencyContactMutation:
+		return c.EmergencyContact.mutate(ctx, m)
+	case *HospitalMutation:
+		return c.Hospital.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
 	case *OTPMutation:
 		return c.OTP.mutate(ctx, m)
 	case *PMILocationMutation:
@@ -287,8 +455,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Permission.mutate(ctx, m)
 	case *ProvinceMutation:
 		return c.Province.mutate(ctx, m)
+	case *QuestionnaireMutation:
+		return c.Questionnaire.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *RoleAssignmentMutation:
+		return c.RoleAssignment.mutate(ctx, m)
+	case *ScreeningQuestionMutation:
+		return c.ScreeningQuestion.mutate(ctx, m)
+	case *ScreeningSubmissionMutation:
+		return c.ScreeningSubmission.mutate(ctx, m)
+	case *StockMovementMutation:
+		return c.StockMovement.mutate(ctx, m)
 	case *SubdistrictMutation:
 		return c.Subdistrict.mutate(ctx, m)
 	default:
@@ -296,6 +474,171 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
+	config
+}
+
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
+}
+
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeyClient) UpdateOne(_m *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(_m))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeyClient) UpdateOneID(id uuid.UUID) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeyClient) DeleteOne(_m *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeyClient) DeleteOneID(id uuid.UUID) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id uuid.UUID) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeyClient) GetX(ctx context.Context, id uuid.UUID) *APIKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a APIKey.
+func (c *APIKeyClient) QueryAccount(_m *APIKey) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, apikey.AccountTable, apikey.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHospital queries the hospital edge of a APIKey.
+func (c *APIKeyClient) QueryHospital(_m *APIKey) *HospitalQuery {
+	query := (&HospitalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(hospital.Table, hospital.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, apikey.HospitalTable, apikey.HospitalColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeyClient) Hooks() []Hook {
+	return c.hooks.APIKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
+}
+
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIKey mutation op: %q", m.Op())
+	}
+}
+
 // AccountClient is a client for the Account schema.
 type AccountClient struct {
 	config
@@ -468,6 +811,134 @@ func (c *AccountClient) QueryRole(_m *Account) *RoleQuery {
 	return query
 }
 
+// QueryDonations queries the donations edge of a Account.
+func (c *AccountClient) QueryDonations(_m *Account) *DonationQuery {
+	query := (&DonationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(donation.Table, donation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.DonationsTable, account.DonationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAppointments queries the appointments edge of a Account.
+func (c *AccountClient) QueryAppointments(_m *Account) *AppointmentQuery {
+	query := (&AppointmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(appointment.Table, appointment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.AppointmentsTable, account.AppointmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeferrals queries the deferrals edge of a Account.
+func (c *AccountClient) QueryDeferrals(_m *Account) *DeferralQuery {
+	query := (&DeferralClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(deferral.Table, deferral.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.DeferralsTable, account.DeferralsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmergencyContacts queries the emergency_contacts edge of a Account.
+func (c *AccountClient) QueryEmergencyContacts(_m *Account) *EmergencyContactQuery {
+	query := (&EmergencyContactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(emergencycontact.Table, emergencycontact.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.EmergencyContactsTable, account.EmergencyContactsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotificationPreference queries the notification_preference edge of a Account.
+func (c *AccountClient) QueryNotificationPreference(_m *Account) *NotificationPreferenceQuery {
+	query := (&NotificationPreferenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(notificationpreference.Table, notificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, account.NotificationPreferenceTable, account.NotificationPreferenceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCertificates queries the certificates edge of a Account.
+func (c *AccountClient) QueryCertificates(_m *Account) *CertificateQuery {
+	query := (&CertificateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(certificate.Table, certificate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.CertificatesTable, account.CertificatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoleAssignments queries the role_assignments edge of a Account.
+func (c *AccountClient) QueryRoleAssignments(_m *Account) *RoleAssignmentQuery {
+	query := (&RoleAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(roleassignment.Table, roleassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.RoleAssignmentsTable, account.RoleAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHospital queries the hospital edge of a Account.
+func (c *AccountClient) QueryHospital(_m *Account) *HospitalQuery {
+	query := (&HospitalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(hospital.Table, hospital.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, account.HospitalTable, account.HospitalColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AccountClient) Hooks() []Hook {
 	return c.hooks.Account
@@ -493,107 +964,107 @@ func (c *AccountClient) mutate(ctx context.Context, m *AccountMutation) (Value,
 	}
 }
 
-// BloodTypeClient is a client for the BloodType schema.
-type BloodTypeClient struct {
+// AppointmentClient is a client for the Appointment schema.
+type AppointmentClient struct {
 	config
 }
 
-// NewBloodTypeClient returns a client for the BloodType from the given config.
-func NewBloodTypeClient(c config) *BloodTypeClient {
-	return &BloodTypeClient{config: c}
+// NewAppointmentClient returns a client for the Appointment from the given config.
+func NewAppointmentClient(c config) *AppointmentClient {
+	return &AppointmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `bloodtype.Hooks(f(g(h())))`.
-func (c *BloodTypeClient) Use(hooks ...Hook) {
-	c.hooks.BloodType = append(c.hooks.BloodType, hooks...)
+// A call to `Use(f, g, h)` equals to `appointment.Hooks(f(g(h())))`.
+func (c *AppointmentClient) Use(hooks ...Hook) {
+	c.hooks.Appointment = append(c.hooks.Appointment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `bloodtype.Intercept(f(g(h())))`.
-func (c *BloodTypeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.BloodType = append(c.inters.BloodType, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `appointment.Intercept(f(g(h())))`.
+func (c *AppointmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Appointment = append(c.inters.Appointment, interceptors...)
 }
 
-// Create returns a builder for creating a BloodType entity.
-func (c *BloodTypeClient) Create() *BloodTypeCreate {
-	mutation := newBloodTypeMutation(c.config, OpCreate)
-	return &BloodTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Appointment entity.
+func (c *AppointmentClient) Create() *AppointmentCreate {
+	mutation := newAppointmentMutation(c.config, OpCreate)
+	return &AppointmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of BloodType entities.
-func (c *BloodTypeClient) CreateBulk(builders ...*BloodTypeCreate) *BloodTypeCreateBulk {
-	return &BloodTypeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Appointment entities.
+func (c *AppointmentClient) CreateBulk(builders ...*AppointmentCreate) *AppointmentCreateBulk {
+	return &AppointmentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BloodTypeClient) MapCreateBulk(slice any, setFunc func(*BloodTypeCreate, int)) *BloodTypeCreateBulk {
+func (c *AppointmentClient) MapCreateBulk(slice any, setFunc func(*AppointmentCreate, int)) *AppointmentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BloodTypeCreateBulk{err: fmt.Errorf("calling to BloodTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AppointmentCreateBulk{err: fmt.Errorf("calling to AppointmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BloodTypeCreate, rv.Len())
+	builders := make([]*AppointmentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BloodTypeCreateBulk{config: c.config, builders: builders}
+	return &AppointmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for BloodType.
-func (c *BloodTypeClient) Update() *BloodTypeUpdate {
-	mutation := newBloodTypeMutation(c.config, OpUpdate)
-	return &BloodTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Appointment.
+func (c *AppointmentClient) Update() *AppointmentUpdate {
+	mutation := newAppointmentMutation(c.config, OpUpdate)
+	return &AppointmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BloodTypeClient) UpdateOne(_m *BloodType) *BloodTypeUpdateOne {
-	mutation := newBloodTypeMutation(c.config, OpUpdateOne, withBloodType(_m))
-	return &BloodTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AppointmentClient) UpdateOne(_m *Appointment) *AppointmentUpdateOne {
+	mutation := newAppointmentMutation(c.config, OpUpdateOne, withAppointment(_m))
+	return &AppointmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BloodTypeClient) UpdateOneID(id uuid.UUID) *BloodTypeUpdateOne {
-	mutation := newBloodTypeMutation(c.config, OpUpdateOne, withBloodTypeID(id))
-	return &BloodTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AppointmentClient) UpdateOneID(id uuid.UUID) *AppointmentUpdateOne {
+	mutation := newAppointmentMutation(c.config, OpUpdateOne, withAppointmentID(id))
+	return &AppointmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for BloodType.
-func (c *BloodTypeClient) Delete() *BloodTypeDelete {
-	mutation := newBloodTypeMutation(c.config, OpDelete)
-	return &BloodTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Appointment.
+func (c *AppointmentClient) Delete() *AppointmentDelete {
+	mutation := newAppointmentMutation(c.config, OpDelete)
+	return &AppointmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BloodTypeClient) DeleteOne(_m *BloodType) *BloodTypeDeleteOne {
+func (c *AppointmentClient) DeleteOne(_m *Appointment) *AppointmentDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BloodTypeClient) DeleteOneID(id uuid.UUID) *BloodTypeDeleteOne {
-	builder := c.Delete().Where(bloodtype.ID(id))
+func (c *AppointmentClient) DeleteOneID(id uuid.UUID) *AppointmentDeleteOne {
+	builder := c.Delete().Where(appointment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BloodTypeDeleteOne{builder}
+	return &AppointmentDeleteOne{builder}
 }
 
-// Query returns a query builder for BloodType.
-func (c *BloodTypeClient) Query() *BloodTypeQuery {
-	return &BloodTypeQuery{
+// Query returns a query builder for Appointment.
+func (c *AppointmentClient) Query() *AppointmentQuery {
+	return &AppointmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBloodType},
+		ctx:    &QueryContext{Type: TypeAppointment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a BloodType entity by its id.
-func (c *BloodTypeClient) Get(ctx context.Context, id uuid.UUID) (*BloodType, error) {
-	return c.Query().Where(bloodtype.ID(id)).Only(ctx)
+// Get returns a Appointment entity by its id.
+func (c *AppointmentClient) Get(ctx context.Context, id uuid.UUID) (*Appointment, error) {
+	return c.Query().Where(appointment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BloodTypeClient) GetX(ctx context.Context, id uuid.UUID) *BloodType {
+func (c *AppointmentClient) GetX(ctx context.Context, id uuid.UUID) *Appointment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -601,15 +1072,15 @@ func (c *BloodTypeClient) GetX(ctx context.Context, id uuid.UUID) *BloodType {
 	return obj
 }
 
-// QueryAccounts queries the accounts edge of a BloodType.
-func (c *BloodTypeClient) QueryAccounts(_m *BloodType) *AccountQuery {
+// QueryAccount queries the account edge of a Appointment.
+func (c *AppointmentClient) QueryAccount(_m *Appointment) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(bloodtype.Table, bloodtype.FieldID, id),
+			sqlgraph.From(appointment.Table, appointment.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, bloodtype.AccountsTable, bloodtype.AccountsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, appointment.AccountTable, appointment.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -617,132 +1088,164 @@ func (c *BloodTypeClient) QueryAccounts(_m *BloodType) *AccountQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *BloodTypeClient) Hooks() []Hook {
-	return c.hooks.BloodType
-}
-
-// Interceptors returns the client interceptors.
-func (c *BloodTypeClient) Interceptors() []Interceptor {
-	return c.inters.BloodType
+// QueryPmiLocation queries the pmi_location edge of a Appointment.
+func (c *AppointmentClient) QueryPmiLocation(_m *Appointment) *PMILocationQuery {
+	query := (&PMILocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointment.Table, appointment.FieldID, id),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, appointment.PmiLocationTable, appointment.PmiLocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *BloodTypeClient) mutate(ctx context.Context, m *BloodTypeMutation) (Value, error) {
+// QueryDonation queries the donation edge of a Appointment.
+func (c *AppointmentClient) QueryDonation(_m *Appointment) *DonationQuery {
+	query := (&DonationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointment.Table, appointment.FieldID, id),
+			sqlgraph.To(donation.Table, donation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, appointment.DonationTable, appointment.DonationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AppointmentClient) Hooks() []Hook {
+	return c.hooks.Appointment
+}
+
+// Interceptors returns the client interceptors.
+func (c *AppointmentClient) Interceptors() []Interceptor {
+	return c.inters.Appointment
+}
+
+func (c *AppointmentClient) mutate(ctx context.Context, m *AppointmentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BloodTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AppointmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BloodTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AppointmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BloodTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AppointmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BloodTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AppointmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown BloodType mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Appointment mutation op: %q", m.Op())
 	}
 }
 
-// CasbinRuleClient is a client for the CasbinRule schema.
-type CasbinRuleClient struct {
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
 	config
 }
 
-// NewCasbinRuleClient returns a client for the CasbinRule from the given config.
-func NewCasbinRuleClient(c config) *CasbinRuleClient {
-	return &CasbinRuleClient{config: c}
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `casbinrule.Hooks(f(g(h())))`.
-func (c *CasbinRuleClient) Use(hooks ...Hook) {
-	c.hooks.CasbinRule = append(c.hooks.CasbinRule, hooks...)
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `casbinrule.Intercept(f(g(h())))`.
-func (c *CasbinRuleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CasbinRule = append(c.inters.CasbinRule, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a CasbinRule entity.
-func (c *CasbinRuleClient) Create() *CasbinRuleCreate {
-	mutation := newCasbinRuleMutation(c.config, OpCreate)
-	return &CasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CasbinRule entities.
-func (c *CasbinRuleClient) CreateBulk(builders ...*CasbinRuleCreate) *CasbinRuleCreateBulk {
-	return &CasbinRuleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CasbinRuleClient) MapCreateBulk(slice any, setFunc func(*CasbinRuleCreate, int)) *CasbinRuleCreateBulk {
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CasbinRuleCreateBulk{err: fmt.Errorf("calling to CasbinRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CasbinRuleCreate, rv.Len())
+	builders := make([]*AuditLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CasbinRuleCreateBulk{config: c.config, builders: builders}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CasbinRule.
-func (c *CasbinRuleClient) Update() *CasbinRuleUpdate {
-	mutation := newCasbinRuleMutation(c.config, OpUpdate)
-	return &CasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CasbinRuleClient) UpdateOne(_m *CasbinRule) *CasbinRuleUpdateOne {
-	mutation := newCasbinRuleMutation(c.config, OpUpdateOne, withCasbinRule(_m))
-	return &CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOne(_m *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(_m))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CasbinRuleClient) UpdateOneID(id int) *CasbinRuleUpdateOne {
-	mutation := newCasbinRuleMutation(c.config, OpUpdateOne, withCasbinRuleID(id))
-	return &CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOneID(id uuid.UUID) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CasbinRule.
-func (c *CasbinRuleClient) Delete() *CasbinRuleDelete {
-	mutation := newCasbinRuleMutation(c.config, OpDelete)
-	return &CasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CasbinRuleClient) DeleteOne(_m *CasbinRule) *CasbinRuleDeleteOne {
+func (c *AuditLogClient) DeleteOne(_m *AuditLog) *AuditLogDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CasbinRuleClient) DeleteOneID(id int) *CasbinRuleDeleteOne {
-	builder := c.Delete().Where(casbinrule.ID(id))
+func (c *AuditLogClient) DeleteOneID(id uuid.UUID) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CasbinRuleDeleteOne{builder}
+	return &AuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for CasbinRule.
-func (c *CasbinRuleClient) Query() *CasbinRuleQuery {
-	return &CasbinRuleQuery{
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCasbinRule},
+		ctx:    &QueryContext{Type: TypeAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a CasbinRule entity by its id.
-func (c *CasbinRuleClient) Get(ctx context.Context, id int) (*CasbinRule, error) {
-	return c.Query().Where(casbinrule.ID(id)).Only(ctx)
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id uuid.UUID) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CasbinRuleClient) GetX(ctx context.Context, id int) *CasbinRule {
+func (c *AuditLogClient) GetX(ctx context.Context, id uuid.UUID) *AuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -751,131 +1254,131 @@ func (c *CasbinRuleClient) GetX(ctx context.Context, id int) *CasbinRule {
 }
 
 // Hooks returns the client hooks.
-func (c *CasbinRuleClient) Hooks() []Hook {
-	return c.hooks.CasbinRule
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *CasbinRuleClient) Interceptors() []Interceptor {
-	return c.inters.CasbinRule
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
 }
 
-func (c *CasbinRuleClient) mutate(ctx context.Context, m *CasbinRuleMutation) (Value, error) {
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown CasbinRule mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
 	}
 }
 
-// CityClient is a client for the City schema.
-type CityClient struct {
+// BloodRequestClient is a client for the BloodRequest schema.
+type BloodRequestClient struct {
 	config
 }
 
-// NewCityClient returns a client for the City from the given config.
-func NewCityClient(c config) *CityClient {
-	return &CityClient{config: c}
+// NewBloodRequestClient returns a client for the BloodRequest from the given config.
+func NewBloodRequestClient(c config) *BloodRequestClient {
+	return &BloodRequestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `city.Hooks(f(g(h())))`.
-func (c *CityClient) Use(hooks ...Hook) {
-	c.hooks.City = append(c.hooks.City, hooks...)
+// A call to `Use(f, g, h)` equals to `bloodrequest.Hooks(f(g(h())))`.
+func (c *BloodRequestClient) Use(hooks ...Hook) {
+	c.hooks.BloodRequest = append(c.hooks.BloodRequest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `city.Intercept(f(g(h())))`.
-func (c *CityClient) Intercept(interceptors ...Interceptor) {
-	c.inters.City = append(c.inters.City, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bloodrequest.Intercept(f(g(h())))`.
+func (c *BloodRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BloodRequest = append(c.inters.BloodRequest, interceptors...)
 }
 
-// Create returns a builder for creating a City entity.
-func (c *CityClient) Create() *CityCreate {
-	mutation := newCityMutation(c.config, OpCreate)
-	return &CityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BloodRequest entity.
+func (c *BloodRequestClient) Create() *BloodRequestCreate {
+	mutation := newBloodRequestMutation(c.config, OpCreate)
+	return &BloodRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of City entities.
-func (c *CityClient) CreateBulk(builders ...*CityCreate) *CityCreateBulk {
-	return &CityCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BloodRequest entities.
+func (c *BloodRequestClient) CreateBulk(builders ...*BloodRequestCreate) *BloodRequestCreateBulk {
+	return &BloodRequestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CityClient) MapCreateBulk(slice any, setFunc func(*CityCreate, int)) *CityCreateBulk {
+func (c *BloodRequestClient) MapCreateBulk(slice any, setFunc func(*BloodRequestCreate, int)) *BloodRequestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CityCreateBulk{err: fmt.Errorf("calling to CityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BloodRequestCreateBulk{err: fmt.Errorf("calling to BloodRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CityCreate, rv.Len())
+	builders := make([]*BloodRequestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CityCreateBulk{config: c.config, builders: builders}
+	return &BloodRequestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for City.
-func (c *CityClient) Update() *CityUpdate {
-	mutation := newCityMutation(c.config, OpUpdate)
-	return &CityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BloodRequest.
+func (c *BloodRequestClient) Update() *BloodRequestUpdate {
+	mutation := newBloodRequestMutation(c.config, OpUpdate)
+	return &BloodRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CityClient) UpdateOne(_m *City) *CityUpdateOne {
-	mutation := newCityMutation(c.config, OpUpdateOne, withCity(_m))
-	return &CityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BloodRequestClient) UpdateOne(_m *BloodRequest) *BloodRequestUpdateOne {
+	mutation := newBloodRequestMutation(c.config, OpUpdateOne, withBloodRequest(_m))
+	return &BloodRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CityClient) UpdateOneID(id uuid.UUID) *CityUpdateOne {
-	mutation := newCityMutation(c.config, OpUpdateOne, withCityID(id))
-	return &CityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BloodRequestClient) UpdateOneID(id uuid.UUID) *BloodRequestUpdateOne {
+	mutation := newBloodRequestMutation(c.config, OpUpdateOne, withBloodRequestID(id))
+	return &BloodRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for City.
-func (c *CityClient) Delete() *CityDelete {
-	mutation := newCityMutation(c.config, OpDelete)
-	return &CityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BloodRequest.
+func (c *BloodRequestClient) Delete() *BloodRequestDelete {
+	mutation := newBloodRequestMutation(c.config, OpDelete)
+	return &BloodRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CityClient) DeleteOne(_m *City) *CityDeleteOne {
+func (c *BloodRequestClient) DeleteOne(_m *BloodRequest) *BloodRequestDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CityClient) DeleteOneID(id uuid.UUID) *CityDeleteOne {
-	builder := c.Delete().Where(city.ID(id))
+func (c *BloodRequestClient) DeleteOneID(id uuid.UUID) *BloodRequestDeleteOne {
+	builder := c.Delete().Where(bloodrequest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CityDeleteOne{builder}
+	return &BloodRequestDeleteOne{builder}
 }
 
-// Query returns a query builder for City.
-func (c *CityClient) Query() *CityQuery {
-	return &CityQuery{
+// Query returns a query builder for BloodRequest.
+func (c *BloodRequestClient) Query() *BloodRequestQuery {
+	return &BloodRequestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCity},
+		ctx:    &QueryContext{Type: TypeBloodRequest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a City entity by its id.
-func (c *CityClient) Get(ctx context.Context, id uuid.UUID) (*City, error) {
-	return c.Query().Where(city.ID(id)).Only(ctx)
+// Get returns a BloodRequest entity by its id.
+func (c *BloodRequestClient) Get(ctx context.Context, id uuid.UUID) (*BloodRequest, error) {
+	return c.Query().Where(bloodrequest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CityClient) GetX(ctx context.Context, id uuid.UUID) *City {
+func (c *BloodRequestClient) GetX(ctx context.Context, id uuid.UUID) *BloodRequest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -883,15 +1386,15 @@ func (c *CityClient) GetX(ctx context.Context, id uuid.UUID) *City {
 	return obj
 }
 
-// QueryProvince queries the province edge of a City.
-func (c *CityClient) QueryProvince(_m *City) *ProvinceQuery {
-	query := (&ProvinceClient{config: c.config}).Query()
+// QueryHospital queries the hospital edge of a BloodRequest.
+func (c *BloodRequestClient) QueryHospital(_m *BloodRequest) *HospitalQuery {
+	query := (&HospitalClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(city.Table, city.FieldID, id),
-			sqlgraph.To(province.Table, province.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, city.ProvinceTable, city.ProvinceColumn),
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, id),
+			sqlgraph.To(hospital.Table, hospital.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodrequest.HospitalTable, bloodrequest.HospitalColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -899,15 +1402,79 @@ func (c *CityClient) QueryProvince(_m *City) *ProvinceQuery {
 	return query
 }
 
-// QueryDistrict queries the district edge of a City.
-func (c *CityClient) QueryDistrict(_m *City) *DistrictQuery {
-	query := (&DistrictClient{config: c.config}).Query()
+// QueryBloodType queries the blood_type edge of a BloodRequest.
+func (c *BloodRequestClient) QueryBloodType(_m *BloodRequest) *BloodTypeQuery {
+	query := (&BloodTypeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(city.Table, city.FieldID, id),
-			sqlgraph.To(district.Table, district.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, city.DistrictTable, city.DistrictColumn),
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, id),
+			sqlgraph.To(bloodtype.Table, bloodtype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodrequest.BloodTypeTable, bloodrequest.BloodTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPmiLocation queries the pmi_location edge of a BloodRequest.
+func (c *BloodRequestClient) QueryPmiLocation(_m *BloodRequest) *PMILocationQuery {
+	query := (&PMILocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, id),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodrequest.PmiLocationTable, bloodrequest.PmiLocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRequestedBy queries the requested_by edge of a BloodRequest.
+func (c *BloodRequestClient) QueryRequestedBy(_m *BloodRequest) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodrequest.RequestedByTable, bloodrequest.RequestedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHandledBy queries the handled_by edge of a BloodRequest.
+func (c *BloodRequestClient) QueryHandledBy(_m *BloodRequest) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodrequest.HandledByTable, bloodrequest.HandledByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUnits queries the units edge of a BloodRequest.
+func (c *BloodRequestClient) QueryUnits(_m *BloodRequest) *BloodUnitQuery {
+	query := (&BloodUnitClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodrequest.Table, bloodrequest.FieldID, id),
+			sqlgraph.To(bloodunit.Table, bloodunit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, bloodrequest.UnitsTable, bloodrequest.UnitsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -916,131 +1483,131 @@ func (c *CityClient) QueryDistrict(_m *City) *DistrictQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *CityClient) Hooks() []Hook {
-	return c.hooks.City
+func (c *BloodRequestClient) Hooks() []Hook {
+	return c.hooks.BloodRequest
 }
 
 // Interceptors returns the client interceptors.
-func (c *CityClient) Interceptors() []Interceptor {
-	return c.inters.City
+func (c *BloodRequestClient) Interceptors() []Interceptor {
+	return c.inters.BloodRequest
 }
 
-func (c *CityClient) mutate(ctx context.Context, m *CityMutation) (Value, error) {
+func (c *BloodRequestClient) mutate(ctx context.Context, m *BloodRequestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BloodRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BloodRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BloodRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BloodRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown City mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BloodRequest mutation op: %q", m.Op())
 	}
 }
 
-// DistrictClient is a client for the District schema.
-type DistrictClient struct {
+// BloodStockClient is a client for the BloodStock schema.
+type BloodStockClient struct {
 	config
 }
 
-// NewDistrictClient returns a client for the District from the given config.
-func NewDistrictClient(c config) *DistrictClient {
-	return &DistrictClient{config: c}
+// NewBloodStockClient returns a client for the BloodStock from the given config.
+func NewBloodStockClient(c config) *BloodStockClient {
+	return &BloodStockClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `district.Hooks(f(g(h())))`.
-func (c *DistrictClient) Use(hooks ...Hook) {
-	c.hooks.District = append(c.hooks.District, hooks...)
+// A call to `Use(f, g, h)` equals to `bloodstock.Hooks(f(g(h())))`.
+func (c *BloodStockClient) Use(hooks ...Hook) {
+	c.hooks.BloodStock = append(c.hooks.BloodStock, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `district.Intercept(f(g(h())))`.
-func (c *DistrictClient) Intercept(interceptors ...Interceptor) {
-	c.inters.District = append(c.inters.District, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bloodstock.Intercept(f(g(h())))`.
+func (c *BloodStockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BloodStock = append(c.inters.BloodStock, interceptors...)
 }
 
-// Create returns a builder for creating a District entity.
-func (c *DistrictClient) Create() *DistrictCreate {
-	mutation := newDistrictMutation(c.config, OpCreate)
-	return &DistrictCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BloodStock entity.
+func (c *BloodStockClient) Create() *BloodStockCreate {
+	mutation := newBloodStockMutation(c.config, OpCreate)
+	return &BloodStockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of District entities.
-func (c *DistrictClient) CreateBulk(builders ...*DistrictCreate) *DistrictCreateBulk {
-	return &DistrictCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BloodStock entities.
+func (c *BloodStockClient) CreateBulk(builders ...*BloodStockCreate) *BloodStockCreateBulk {
+	return &BloodStockCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DistrictClient) MapCreateBulk(slice any, setFunc func(*DistrictCreate, int)) *DistrictCreateBulk {
+func (c *BloodStockClient) MapCreateBulk(slice any, setFunc func(*BloodStockCreate, int)) *BloodStockCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DistrictCreateBulk{err: fmt.Errorf("calling to DistrictClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BloodStockCreateBulk{err: fmt.Errorf("calling to BloodStockClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DistrictCreate, rv.Len())
+	builders := make([]*BloodStockCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DistrictCreateBulk{config: c.config, builders: builders}
+	return &BloodStockCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for District.
-func (c *DistrictClient) Update() *DistrictUpdate {
-	mutation := newDistrictMutation(c.config, OpUpdate)
-	return &DistrictUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BloodStock.
+func (c *BloodStockClient) Update() *BloodStockUpdate {
+	mutation := newBloodStockMutation(c.config, OpUpdate)
+	return &BloodStockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DistrictClient) UpdateOne(_m *District) *DistrictUpdateOne {
-	mutation := newDistrictMutation(c.config, OpUpdateOne, withDistrict(_m))
-	return &DistrictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BloodStockClient) UpdateOne(_m *BloodStock) *BloodStockUpdateOne {
+	mutation := newBloodStockMutation(c.config, OpUpdateOne, withBloodStock(_m))
+	return &BloodStockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DistrictClient) UpdateOneID(id uuid.UUID) *DistrictUpdateOne {
-	mutation := newDistrictMutation(c.config, OpUpdateOne, withDistrictID(id))
-	return &DistrictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BloodStockClient) UpdateOneID(id uuid.UUID) *BloodStockUpdateOne {
+	mutation := newBloodStockMutation(c.config, OpUpdateOne, withBloodStockID(id))
+	return &BloodStockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for District.
-func (c *DistrictClient) Delete() *DistrictDelete {
-	mutation := newDistrictMutation(c.config, OpDelete)
-	return &DistrictDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BloodStock.
+func (c *BloodStockClient) Delete() *BloodStockDelete {
+	mutation := newBloodStockMutation(c.config, OpDelete)
+	return &BloodStockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DistrictClient) DeleteOne(_m *District) *DistrictDeleteOne {
+func (c *BloodStockClient) DeleteOne(_m *BloodStock) *BloodStockDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DistrictClient) DeleteOneID(id uuid.UUID) *DistrictDeleteOne {
-	builder := c.Delete().Where(district.ID(id))
+func (c *BloodStockClient) DeleteOneID(id uuid.UUID) *BloodStockDeleteOne {
+	builder := c.Delete().Where(bloodstock.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DistrictDeleteOne{builder}
+	return &BloodStockDeleteOne{builder}
 }
 
-// Query returns a query builder for District.
-func (c *DistrictClient) Query() *DistrictQuery {
-	return &DistrictQuery{
+// Query returns a query builder for BloodStock.
+func (c *BloodStockClient) Query() *BloodStockQuery {
+	return &BloodStockQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDistrict},
+		ctx:    &QueryContext{Type: TypeBloodStock},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a District entity by its id.
-func (c *DistrictClient) Get(ctx context.Context, id uuid.UUID) (*District, error) {
-	return c.Query().Where(district.ID(id)).Only(ctx)
+// Get returns a BloodStock entity by its id.
+func (c *BloodStockClient) Get(ctx context.Context, id uuid.UUID) (*BloodStock, error) {
+	return c.Query().Where(bloodstock.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DistrictClient) GetX(ctx context.Context, id uuid.UUID) *District {
+func (c *BloodStockClient) GetX(ctx context.Context, id uuid.UUID) *BloodStock {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1048,15 +1615,15 @@ func (c *DistrictClient) GetX(ctx context.Context, id uuid.UUID) *District {
 	return obj
 }
 
-// QueryCity queries the city edge of a District.
-func (c *DistrictClient) QueryCity(_m *District) *CityQuery {
-	query := (&CityClient{config: c.config}).Query()
+// QueryPmiLocation queries the pmi_location edge of a BloodStock.
+func (c *BloodStockClient) QueryPmiLocation(_m *BloodStock) *PMILocationQuery {
+	query := (&PMILocationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(district.Table, district.FieldID, id),
-			sqlgraph.To(city.Table, city.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, district.CityTable, district.CityColumn),
+			sqlgraph.From(bloodstock.Table, bloodstock.FieldID, id),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodstock.PmiLocationTable, bloodstock.PmiLocationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1064,15 +1631,31 @@ func (c *DistrictClient) QueryCity(_m *District) *CityQuery {
 	return query
 }
 
-// QuerySubdistrict queries the subdistrict edge of a District.
-func (c *DistrictClient) QuerySubdistrict(_m *District) *SubdistrictQuery {
-	query := (&SubdistrictClient{config: c.config}).Query()
+// QueryBloodType queries the blood_type edge of a BloodStock.
+func (c *BloodStockClient) QueryBloodType(_m *BloodStock) *BloodTypeQuery {
+	query := (&BloodTypeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(district.Table, district.FieldID, id),
-			sqlgraph.To(subdistrict.Table, subdistrict.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, district.SubdistrictTable, district.SubdistrictColumn),
+			sqlgraph.From(bloodstock.Table, bloodstock.FieldID, id),
+			sqlgraph.To(bloodtype.Table, bloodtype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodstock.BloodTypeTable, bloodstock.BloodTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMovements queries the movements edge of a BloodStock.
+func (c *BloodStockClient) QueryMovements(_m *BloodStock) *StockMovementQuery {
+	query := (&StockMovementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodstock.Table, bloodstock.FieldID, id),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, bloodstock.MovementsTable, bloodstock.MovementsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1081,131 +1664,131 @@ func (c *DistrictClient) QuerySubdistrict(_m *District) *SubdistrictQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *DistrictClient) Hooks() []Hook {
-	return c.hooks.District
+func (c *BloodStockClient) Hooks() []Hook {
+	return c.hooks.BloodStock
 }
 
 // Interceptors returns the client interceptors.
-func (c *DistrictClient) Interceptors() []Interceptor {
-	return c.inters.District
+func (c *BloodStockClient) Interceptors() []Interceptor {
+	return c.inters.BloodStock
 }
 
-func (c *DistrictClient) mutate(ctx context.Context, m *DistrictMutation) (Value, error) {
+func (c *BloodStockClient) mutate(ctx context.Context, m *BloodStockMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DistrictCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BloodStockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DistrictUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BloodStockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DistrictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BloodStockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DistrictDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BloodStockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown District mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BloodStock mutation op: %q", m.Op())
 	}
 }
 
-// OTPClient is a client for the OTP schema.
-type OTPClient struct {
+// BloodTypeClient is a client for the BloodType schema.
+type BloodTypeClient struct {
 	config
 }
 
-// NewOTPClient returns a client for the OTP from the given config.
-func NewOTPClient(c config) *OTPClient {
-	return &OTPClient{config: c}
+// NewBloodTypeClient returns a client for the BloodType from the given config.
+func NewBloodTypeClient(c config) *BloodTypeClient {
+	return &BloodTypeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `otp.Hooks(f(g(h())))`.
-func (c *OTPClient) Use(hooks ...Hook) {
-	c.hooks.OTP = append(c.hooks.OTP, hooks...)
+// A call to `Use(f, g, h)` equals to `bloodtype.Hooks(f(g(h())))`.
+func (c *BloodTypeClient) Use(hooks ...Hook) {
+	c.hooks.BloodType = append(c.hooks.BloodType, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `otp.Intercept(f(g(h())))`.
-func (c *OTPClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OTP = append(c.inters.OTP, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bloodtype.Intercept(f(g(h())))`.
+func (c *BloodTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BloodType = append(c.inters.BloodType, interceptors...)
 }
 
-// Create returns a builder for creating a OTP entity.
-func (c *OTPClient) Create() *OTPCreate {
-	mutation := newOTPMutation(c.config, OpCreate)
-	return &OTPCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BloodType entity.
+func (c *BloodTypeClient) Create() *BloodTypeCreate {
+	mutation := newBloodTypeMutation(c.config, OpCreate)
+	return &BloodTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OTP entities.
-func (c *OTPClient) CreateBulk(builders ...*OTPCreate) *OTPCreateBulk {
-	return &OTPCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BloodType entities.
+func (c *BloodTypeClient) CreateBulk(builders ...*BloodTypeCreate) *BloodTypeCreateBulk {
+	return &BloodTypeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OTPClient) MapCreateBulk(slice any, setFunc func(*OTPCreate, int)) *OTPCreateBulk {
+func (c *BloodTypeClient) MapCreateBulk(slice any, setFunc func(*BloodTypeCreate, int)) *BloodTypeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OTPCreateBulk{err: fmt.Errorf("calling to OTPClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BloodTypeCreateBulk{err: fmt.Errorf("calling to BloodTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OTPCreate, rv.Len())
+	builders := make([]*BloodTypeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OTPCreateBulk{config: c.config, builders: builders}
+	return &BloodTypeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OTP.
-func (c *OTPClient) Update() *OTPUpdate {
-	mutation := newOTPMutation(c.config, OpUpdate)
-	return &OTPUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BloodType.
+func (c *BloodTypeClient) Update() *BloodTypeUpdate {
+	mutation := newBloodTypeMutation(c.config, OpUpdate)
+	return &BloodTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OTPClient) UpdateOne(_m *OTP) *OTPUpdateOne {
-	mutation := newOTPMutation(c.config, OpUpdateOne, withOTP(_m))
-	return &OTPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BloodTypeClient) UpdateOne(_m *BloodType) *BloodTypeUpdateOne {
+	mutation := newBloodTypeMutation(c.config, OpUpdateOne, withBloodType(_m))
+	return &BloodTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OTPClient) UpdateOneID(id uuid.UUID) *OTPUpdateOne {
-	mutation := newOTPMutation(c.config, OpUpdateOne, withOTPID(id))
-	return &OTPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BloodTypeClient) UpdateOneID(id uuid.UUID) *BloodTypeUpdateOne {
+	mutation := newBloodTypeMutation(c.config, OpUpdateOne, withBloodTypeID(id))
+	return &BloodTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OTP.
-func (c *OTPClient) Delete() *OTPDelete {
-	mutation := newOTPMutation(c.config, OpDelete)
-	return &OTPDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BloodType.
+func (c *BloodTypeClient) Delete() *BloodTypeDelete {
+	mutation := newBloodTypeMutation(c.config, OpDelete)
+	return &BloodTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OTPClient) DeleteOne(_m *OTP) *OTPDeleteOne {
+func (c *BloodTypeClient) DeleteOne(_m *BloodType) *BloodTypeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OTPClient) DeleteOneID(id uuid.UUID) *OTPDeleteOne {
-	builder := c.Delete().Where(otp.ID(id))
+func (c *BloodTypeClient) DeleteOneID(id uuid.UUID) *BloodTypeDeleteOne {
+	builder := c.Delete().Where(bloodtype.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OTPDeleteOne{builder}
+	return &BloodTypeDeleteOne{builder}
 }
 
-// Query returns a query builder for OTP.
-func (c *OTPClient) Query() *OTPQuery {
-	return &OTPQuery{
+// Query returns a query builder for BloodType.
+func (c *BloodTypeClient) Query() *BloodTypeQuery {
+	return &BloodTypeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOTP},
+		ctx:    &QueryContext{Type: TypeBloodType},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OTP entity by its id.
-func (c *OTPClient) Get(ctx context.Context, id uuid.UUID) (*OTP, error) {
-	return c.Query().Where(otp.ID(id)).Only(ctx)
+// Get returns a BloodType entity by its id.
+func (c *BloodTypeClient) Get(ctx context.Context, id uuid.UUID) (*BloodType, error) {
+	return c.Query().Where(bloodtype.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OTPClient) GetX(ctx context.Context, id uuid.UUID) *OTP {
+func (c *BloodTypeClient) GetX(ctx context.Context, id uuid.UUID) *BloodType {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1213,15 +1796,15 @@ func (c *OTPClient) GetX(ctx context.Context, id uuid.UUID) *OTP {
 	return obj
 }
 
-// QueryAccount queries the account edge of a OTP.
-func (c *OTPClient) QueryAccount(_m *OTP) *AccountQuery {
+// QueryAccounts queries the accounts edge of a BloodType.
+func (c *BloodTypeClient) QueryAccounts(_m *BloodType) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(otp.Table, otp.FieldID, id),
+			sqlgraph.From(bloodtype.Table, bloodtype.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, otp.AccountTable, otp.AccountColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, bloodtype.AccountsTable, bloodtype.AccountsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1230,131 +1813,131 @@ func (c *OTPClient) QueryAccount(_m *OTP) *AccountQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *OTPClient) Hooks() []Hook {
-	return c.hooks.OTP
+func (c *BloodTypeClient) Hooks() []Hook {
+	return c.hooks.BloodType
 }
 
 // Interceptors returns the client interceptors.
-func (c *OTPClient) Interceptors() []Interceptor {
-	return c.inters.OTP
+func (c *BloodTypeClient) Interceptors() []Interceptor {
+	return c.inters.BloodType
 }
 
-func (c *OTPClient) mutate(ctx context.Context, m *OTPMutation) (Value, error) {
+func (c *BloodTypeClient) mutate(ctx context.Context, m *BloodTypeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OTPCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BloodTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OTPUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BloodTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OTPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BloodTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OTPDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BloodTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OTP mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BloodType mutation op: %q", m.Op())
 	}
 }
 
-// PMILocationClient is a client for the PMILocation schema.
-type PMILocationClient struct {
+// BloodUnitClient is a client for the BloodUnit schema.
+type BloodUnitClient struct {
 	config
 }
 
-// NewPMILocationClient returns a client for the PMILocation from the given config.
-func NewPMILocationClient(c config) *PMILocationClient {
-	return &PMILocationClient{config: c}
+// NewBloodUnitClient returns a client for the BloodUnit from the given config.
+func NewBloodUnitClient(c config) *BloodUnitClient {
+	return &BloodUnitClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `pmilocation.Hooks(f(g(h())))`.
-func (c *PMILocationClient) Use(hooks ...Hook) {
-	c.hooks.PMILocation = append(c.hooks.PMILocation, hooks...)
+// A call to `Use(f, g, h)` equals to `bloodunit.Hooks(f(g(h())))`.
+func (c *BloodUnitClient) Use(hooks ...Hook) {
+	c.hooks.BloodUnit = append(c.hooks.BloodUnit, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `pmilocation.Intercept(f(g(h())))`.
-func (c *PMILocationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PMILocation = append(c.inters.PMILocation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bloodunit.Intercept(f(g(h())))`.
+func (c *BloodUnitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BloodUnit = append(c.inters.BloodUnit, interceptors...)
 }
 
-// Create returns a builder for creating a PMILocation entity.
-func (c *PMILocationClient) Create() *PMILocationCreate {
-	mutation := newPMILocationMutation(c.config, OpCreate)
-	return &PMILocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BloodUnit entity.
+func (c *BloodUnitClient) Create() *BloodUnitCreate {
+	mutation := newBloodUnitMutation(c.config, OpCreate)
+	return &BloodUnitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PMILocation entities.
-func (c *PMILocationClient) CreateBulk(builders ...*PMILocationCreate) *PMILocationCreateBulk {
-	return &PMILocationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BloodUnit entities.
+func (c *BloodUnitClient) CreateBulk(builders ...*BloodUnitCreate) *BloodUnitCreateBulk {
+	return &BloodUnitCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PMILocationClient) MapCreateBulk(slice any, setFunc func(*PMILocationCreate, int)) *PMILocationCreateBulk {
+func (c *BloodUnitClient) MapCreateBulk(slice any, setFunc func(*BloodUnitCreate, int)) *BloodUnitCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PMILocationCreateBulk{err: fmt.Errorf("calling to PMILocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BloodUnitCreateBulk{err: fmt.Errorf("calling to BloodUnitClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PMILocationCreate, rv.Len())
+	builders := make([]*BloodUnitCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PMILocationCreateBulk{config: c.config, builders: builders}
+	return &BloodUnitCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PMILocation.
-func (c *PMILocationClient) Update() *PMILocationUpdate {
-	mutation := newPMILocationMutation(c.config, OpUpdate)
-	return &PMILocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BloodUnit.
+func (c *BloodUnitClient) Update() *BloodUnitUpdate {
+	mutation := newBloodUnitMutation(c.config, OpUpdate)
+	return &BloodUnitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PMILocationClient) UpdateOne(_m *PMILocation) *PMILocationUpdateOne {
-	mutation := newPMILocationMutation(c.config, OpUpdateOne, withPMILocation(_m))
-	return &PMILocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BloodUnitClient) UpdateOne(_m *BloodUnit) *BloodUnitUpdateOne {
+	mutation := newBloodUnitMutation(c.config, OpUpdateOne, withBloodUnit(_m))
+	return &BloodUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PMILocationClient) UpdateOneID(id uuid.UUID) *PMILocationUpdateOne {
-	mutation := newPMILocationMutation(c.config, OpUpdateOne, withPMILocationID(id))
-	return &PMILocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BloodUnitClient) UpdateOneID(id uuid.UUID) *BloodUnitUpdateOne {
+	mutation := newBloodUnitMutation(c.config, OpUpdateOne, withBloodUnitID(id))
+	return &BloodUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PMILocation.
-func (c *PMILocationClient) Delete() *PMILocationDelete {
-	mutation := newPMILocationMutation(c.config, OpDelete)
-	return &PMILocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BloodUnit.
+func (c *BloodUnitClient) Delete() *BloodUnitDelete {
+	mutation := newBloodUnitMutation(c.config, OpDelete)
+	return &BloodUnitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PMILocationClient) DeleteOne(_m *PMILocation) *PMILocationDeleteOne {
+func (c *BloodUnitClient) DeleteOne(_m *BloodUnit) *BloodUnitDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PMILocationClient) DeleteOneID(id uuid.UUID) *PMILocationDeleteOne {
-	builder := c.Delete().Where(pmilocation.ID(id))
+func (c *BloodUnitClient) DeleteOneID(id uuid.UUID) *BloodUnitDeleteOne {
+	builder := c.Delete().Where(bloodunit.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PMILocationDeleteOne{builder}
+	return &BloodUnitDeleteOne{builder}
 }
 
-// Query returns a query builder for PMILocation.
-func (c *PMILocationClient) Query() *PMILocationQuery {
-	return &PMILocationQuery{
+// Query returns a query builder for BloodUnit.
+func (c *BloodUnitClient) Query() *BloodUnitQuery {
+	return &BloodUnitQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePMILocation},
+		ctx:    &QueryContext{Type: TypeBloodUnit},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PMILocation entity by its id.
-func (c *PMILocationClient) Get(ctx context.Context, id uuid.UUID) (*PMILocation, error) {
-	return c.Query().Where(pmilocation.ID(id)).Only(ctx)
+// Get returns a BloodUnit entity by its id.
+func (c *BloodUnitClient) Get(ctx context.Context, id uuid.UUID) (*BloodUnit, error) {
+	return c.Query().Where(bloodunit.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PMILocationClient) GetX(ctx context.Context, id uuid.UUID) *PMILocation {
+func (c *BloodUnitClient) GetX(ctx context.Context, id uuid.UUID) *BloodUnit {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1362,15 +1945,15 @@ func (c *PMILocationClient) GetX(ctx context.Context, id uuid.UUID) *PMILocation
 	return obj
 }
 
-// QuerySubdistrict queries the subdistrict edge of a PMILocation.
-func (c *PMILocationClient) QuerySubdistrict(_m *PMILocation) *SubdistrictQuery {
-	query := (&SubdistrictClient{config: c.config}).Query()
+// QueryPmiLocation queries the pmi_location edge of a BloodUnit.
+func (c *BloodUnitClient) QueryPmiLocation(_m *BloodUnit) *PMILocationQuery {
+	query := (&PMILocationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(pmilocation.Table, pmilocation.FieldID, id),
-			sqlgraph.To(subdistrict.Table, subdistrict.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, pmilocation.SubdistrictTable, pmilocation.SubdistrictColumn),
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, id),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodunit.PmiLocationTable, bloodunit.PmiLocationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1378,132 +1961,3576 @@ func (c *PMILocationClient) QuerySubdistrict(_m *PMILocation) *SubdistrictQuery
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *PMILocationClient) Hooks() []Hook {
-	return c.hooks.PMILocation
-}
-
-// Interceptors returns the client interceptors.
-func (c *PMILocationClient) Interceptors() []Interceptor {
-	return c.inters.PMILocation
+// QueryBloodType queries the blood_type edge of a BloodUnit.
+func (c *BloodUnitClient) QueryBloodType(_m *BloodUnit) *BloodTypeQuery {
+	query := (&BloodTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, id),
+			sqlgraph.To(bloodtype.Table, bloodtype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodunit.BloodTypeTable, bloodunit.BloodTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *PMILocationClient) mutate(ctx context.Context, m *PMILocationMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&PMILocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&PMILocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&PMILocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&PMILocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("ent: unknown PMILocation mutation op: %q", m.Op())
+// QueryDonation queries the donation edge of a BloodUnit.
+func (c *BloodUnitClient) QueryDonation(_m *BloodUnit) *DonationQuery {
+	query := (&DonationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, id),
+			sqlgraph.To(donation.Table, donation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodunit.DonationTable, bloodunit.DonationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
+	return query
 }
 
-// PasswordClient is a client for the Password schema.
-type PasswordClient struct {
-	config
+// QueryRequest queries the request edge of a BloodUnit.
+func (c *BloodUnitClient) QueryRequest(_m *BloodUnit) *BloodRequestQuery {
+	query := (&BloodRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, id),
+			sqlgraph.To(bloodrequest.Table, bloodrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodunit.RequestTable, bloodunit.RequestColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// NewPasswordClient returns a client for the Password from the given config.
-func NewPasswordClient(c config) *PasswordClient {
-	return &PasswordClient{config: c}
+// QueryEvents queries the events edge of a BloodUnit.
+func (c *BloodUnitClient) QueryEvents(_m *BloodUnit) *BloodUnitEventQuery {
+	query := (&BloodUnitEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, id),
+			sqlgraph.To(bloodunitevent.Table, bloodunitevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, bloodunit.EventsTable, bloodunit.EventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMovements queries the movements edge of a BloodUnit.
+func (c *BloodUnitClient) QueryMovements(_m *BloodUnit) *StockMovementQuery {
+	query := (&StockMovementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunit.Table, bloodunit.FieldID, id),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, bloodunit.MovementsTable, bloodunit.MovementsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BloodUnitClient) Hooks() []Hook {
+	return c.hooks.BloodUnit
+}
+
+// Interceptors returns the client interceptors.
+func (c *BloodUnitClient) Interceptors() []Interceptor {
+	return c.inters.BloodUnit
+}
+
+func (c *BloodUnitClient) mutate(ctx context.Context, m *BloodUnitMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BloodUnitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BloodUnitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BloodUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BloodUnitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BloodUnit mutation op: %q", m.Op())
+	}
+}
+
+// BloodUnitEventClient is a client for the BloodUnitEvent schema.
+type BloodUnitEventClient struct {
+	config
+}
+
+// NewBloodUnitEventClient returns a client for the BloodUnitEvent from the given config.
+func NewBloodUnitEventClient(c config) *BloodUnitEventClient {
+	return &BloodUnitEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bloodunitevent.Hooks(f(g(h())))`.
+func (c *BloodUnitEventClient) Use(hooks ...Hook) {
+	c.hooks.BloodUnitEvent = append(c.hooks.BloodUnitEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bloodunitevent.Intercept(f(g(h())))`.
+func (c *BloodUnitEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BloodUnitEvent = append(c.inters.BloodUnitEvent, interceptors...)
+}
+
+// Create returns a builder for creating a BloodUnitEvent entity.
+func (c *BloodUnitEventClient) Create() *BloodUnitEventCreate {
+	mutation := newBloodUnitEventMutation(c.config, OpCreate)
+	return &BloodUnitEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BloodUnitEvent entities.
+func (c *BloodUnitEventClient) CreateBulk(builders ...*BloodUnitEventCreate) *BloodUnitEventCreateBulk {
+	return &BloodUnitEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BloodUnitEventClient) MapCreateBulk(slice any, setFunc func(*BloodUnitEventCreate, int)) *BloodUnitEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BloodUnitEventCreateBulk{err: fmt.Errorf("calling to BloodUnitEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BloodUnitEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BloodUnitEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BloodUnitEvent.
+func (c *BloodUnitEventClient) Update() *BloodUnitEventUpdate {
+	mutation := newBloodUnitEventMutation(c.config, OpUpdate)
+	return &BloodUnitEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BloodUnitEventClient) UpdateOne(_m *BloodUnitEvent) *BloodUnitEventUpdateOne {
+	mutation := newBloodUnitEventMutation(c.config, OpUpdateOne, withBloodUnitEvent(_m))
+	return &BloodUnitEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BloodUnitEventClient) UpdateOneID(id uuid.UUID) *BloodUnitEventUpdateOne {
+	mutation := newBloodUnitEventMutation(c.config, OpUpdateOne, withBloodUnitEventID(id))
+	return &BloodUnitEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BloodUnitEvent.
+func (c *BloodUnitEventClient) Delete() *BloodUnitEventDelete {
+	mutation := newBloodUnitEventMutation(c.config, OpDelete)
+	return &BloodUnitEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BloodUnitEventClient) DeleteOne(_m *BloodUnitEvent) *BloodUnitEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BloodUnitEventClient) DeleteOneID(id uuid.UUID) *BloodUnitEventDeleteOne {
+	builder := c.Delete().Where(bloodunitevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BloodUnitEventDeleteOne{builder}
+}
+
+// Query returns a query builder for BloodUnitEvent.
+func (c *BloodUnitEventClient) Query() *BloodUnitEventQuery {
+	return &BloodUnitEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBloodUnitEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BloodUnitEvent entity by its id.
+func (c *BloodUnitEventClient) Get(ctx context.Context, id uuid.UUID) (*BloodUnitEvent, error) {
+	return c.Query().Where(bloodunitevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BloodUnitEventClient) GetX(ctx context.Context, id uuid.UUID) *BloodUnitEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUnit queries the unit edge of a BloodUnitEvent.
+func (c *BloodUnitEventClient) QueryUnit(_m *BloodUnitEvent) *BloodUnitQuery {
+	query := (&BloodUnitClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunitevent.Table, bloodunitevent.FieldID, id),
+			sqlgraph.To(bloodunit.Table, bloodunit.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodunitevent.UnitTable, bloodunitevent.UnitColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPerformedBy queries the performed_by edge of a BloodUnitEvent.
+func (c *BloodUnitEventClient) QueryPerformedBy(_m *BloodUnitEvent) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bloodunitevent.Table, bloodunitevent.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bloodunitevent.PerformedByTable, bloodunitevent.PerformedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BloodUnitEventClient) Hooks() []Hook {
+	return c.hooks.BloodUnitEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *BloodUnitEventClient) Interceptors() []Interceptor {
+	return c.inters.BloodUnitEvent
+}
+
+func (c *BloodUnitEventClient) mutate(ctx context.Context, m *BloodUnitEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BloodUnitEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BloodUnitEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BloodUnitEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BloodUnitEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BloodUnitEvent mutation op: %q", m.Op())
+	}
+}
+
+// CasbinRuleClient is a client for the CasbinRule schema.
+type CasbinRuleClient struct {
+	config
+}
+
+// NewCasbinRuleClient returns a client for the CasbinRule from the given config.
+func NewCasbinRuleClient(c config) *CasbinRuleClient {
+	return &CasbinRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `casbinrule.Hooks(f(g(h())))`.
+func (c *CasbinRuleClient) Use(hooks ...Hook) {
+	c.hooks.CasbinRule = append(c.hooks.CasbinRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `casbinrule.Intercept(f(g(h())))`.
+func (c *CasbinRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CasbinRule = append(c.inters.CasbinRule, interceptors...)
+}
+
+// Create returns a builder for creating a CasbinRule entity.
+func (c *CasbinRuleClient) Create() *CasbinRuleCreate {
+	mutation := newCasbinRuleMutation(c.config, OpCreate)
+	return &CasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CasbinRule entities.
+func (c *CasbinRuleClient) CreateBulk(builders ...*CasbinRuleCreate) *CasbinRuleCreateBulk {
+	return &CasbinRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CasbinRuleClient) MapCreateBulk(slice any, setFunc func(*CasbinRuleCreate, int)) *CasbinRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CasbinRuleCreateBulk{err: fmt.Errorf("calling to CasbinRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CasbinRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CasbinRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CasbinRule.
+func (c *CasbinRuleClient) Update() *CasbinRuleUpdate {
+	mutation := newCasbinRuleMutation(c.config, OpUpdate)
+	return &CasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CasbinRuleClient) UpdateOne(_m *CasbinRule) *CasbinRuleUpdateOne {
+	mutation := newCasbinRuleMutation(c.config, OpUpdateOne, withCasbinRule(_m))
+	return &CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CasbinRuleClient) UpdateOneID(id int) *CasbinRuleUpdateOne {
+	mutation := newCasbinRuleMutation(c.config, OpUpdateOne, withCasbinRuleID(id))
+	return &CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CasbinRule.
+func (c *CasbinRuleClient) Delete() *CasbinRuleDelete {
+	mutation := newCasbinRuleMutation(c.config, OpDelete)
+	return &CasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CasbinRuleClient) DeleteOne(_m *CasbinRule) *CasbinRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CasbinRuleClient) DeleteOneID(id int) *CasbinRuleDeleteOne {
+	builder := c.Delete().Where(casbinrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CasbinRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for CasbinRule.
+func (c *CasbinRuleClient) Query() *CasbinRuleQuery {
+	return &CasbinRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCasbinRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CasbinRule entity by its id.
+func (c *CasbinRuleClient) Get(ctx context.Context, id int) (*CasbinRule, error) {
+	return c.Query().Where(casbinrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CasbinRuleClient) GetX(ctx context.Context, id int) *CasbinRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CasbinRuleClient) Hooks() []Hook {
+	return c.hooks.CasbinRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *CasbinRuleClient) Interceptors() []Interceptor {
+	return c.inters.CasbinRule
+}
+
+func (c *CasbinRuleClient) mutate(ctx context.Context, m *CasbinRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CasbinRule mutation op: %q", m.Op())
+	}
+}
+
+// CertificateClient is a client for the Certificate schema.
+type CertificateClient struct {
+	config
+}
+
+// NewCertificateClient returns a client for the Certificate from the given config.
+func NewCertificateClient(c config) *CertificateClient {
+	return &CertificateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `certificate.Hooks(f(g(h())))`.
+func (c *CertificateClient) Use(hooks ...Hook) {
+	c.hooks.Certificate = append(c.hooks.Certificate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `certificate.Intercept(f(g(h())))`.
+func (c *CertificateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Certificate = append(c.inters.Certificate, interceptors...)
+}
+
+// Create returns a builder for creating a Certificate entity.
+func (c *CertificateClient) Create() *CertificateCreate {
+	mutation := newCertificateMutation(c.config, OpCreate)
+	return &CertificateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Certificate entities.
+func (c *CertificateClient) CreateBulk(builders ...*CertificateCreate) *CertificateCreateBulk {
+	return &CertificateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CertificateClient) MapCreateBulk(slice any, setFunc func(*CertificateCreate, int)) *CertificateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CertificateCreateBulk{err: fmt.Errorf("calling to CertificateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CertificateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CertificateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Certificate.
+func (c *CertificateClient) Update() *CertificateUpdate {
+	mutation := newCertificateMutation(c.config, OpUpdate)
+	return &CertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CertificateClient) UpdateOne(_m *Certificate) *CertificateUpdateOne {
+	mutation := newCertificateMutation(c.config, OpUpdateOne, withCertificate(_m))
+	return &CertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CertificateClient) UpdateOneID(id uuid.UUID) *CertificateUpdateOne {
+	mutation := newCertificateMutation(c.config, OpUpdateOne, withCertificateID(id))
+	return &CertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Certificate.
+func (c *CertificateClient) Delete() *CertificateDelete {
+	mutation := newCertificateMutation(c.config, OpDelete)
+	return &CertificateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CertificateClient) DeleteOne(_m *Certificate) *CertificateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CertificateClient) DeleteOneID(id uuid.UUID) *CertificateDeleteOne {
+	builder := c.Delete().Where(certificate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CertificateDeleteOne{builder}
+}
+
+// Query returns a query builder for Certificate.
+func (c *CertificateClient) Query() *CertificateQuery {
+	return &CertificateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCertificate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Certificate entity by its id.
+func (c *CertificateClient) Get(ctx context.Context, id uuid.UUID) (*Certificate, error) {
+	return c.Query().Where(certificate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CertificateClient) GetX(ctx context.Context, id uuid.UUID) *Certificate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a Certificate.
+func (c *CertificateClient) QueryAccount(_m *Certificate) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certificate.Table, certificate.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certificate.AccountTable, certificate.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDonation queries the donation edge of a Certificate.
+func (c *CertificateClient) QueryDonation(_m *Certificate) *DonationQuery {
+	query := (&DonationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certificate.Table, certificate.FieldID, id),
+			sqlgraph.To(donation.Table, donation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certificate.DonationTable, certificate.DonationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CertificateClient) Hooks() []Hook {
+	return c.hooks.Certificate
+}
+
+// Interceptors returns the client interceptors.
+func (c *CertificateClient) Interceptors() []Interceptor {
+	return c.inters.Certificate
+}
+
+func (c *CertificateClient) mutate(ctx context.Context, m *CertificateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CertificateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CertificateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Certificate mutation op: %q", m.Op())
+	}
+}
+
+// CityClient is a client for the City schema.
+type CityClient struct {
+	config
+}
+
+// NewCityClient returns a client for the City from the given config.
+func NewCityClient(c config) *CityClient {
+	return &CityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `city.Hooks(f(g(h())))`.
+func (c *CityClient) Use(hooks ...Hook) {
+	c.hooks.City = append(c.hooks.City, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `city.Intercept(f(g(h())))`.
+func (c *CityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.City = append(c.inters.City, interceptors...)
+}
+
+// Create returns a builder for creating a City entity.
+func (c *CityClient) Create() *CityCreate {
+	mutation := newCityMutation(c.config, OpCreate)
+	return &CityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of City entities.
+func (c *CityClient) CreateBulk(builders ...*CityCreate) *CityCreateBulk {
+	return &CityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CityClient) MapCreateBulk(slice any, setFunc func(*CityCreate, int)) *CityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CityCreateBulk{err: fmt.Errorf("calling to CityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for City.
+func (c *CityClient) Update() *CityUpdate {
+	mutation := newCityMutation(c.config, OpUpdate)
+	return &CityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CityClient) UpdateOne(_m *City) *CityUpdateOne {
+	mutation := newCityMutation(c.config, OpUpdateOne, withCity(_m))
+	return &CityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CityClient) UpdateOneID(id uuid.UUID) *CityUpdateOne {
+	mutation := newCityMutation(c.config, OpUpdateOne, withCityID(id))
+	return &CityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for City.
+func (c *CityClient) Delete() *CityDelete {
+	mutation := newCityMutation(c.config, OpDelete)
+	return &CityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CityClient) DeleteOne(_m *City) *CityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CityClient) DeleteOneID(id uuid.UUID) *CityDeleteOne {
+	builder := c.Delete().Where(city.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CityDeleteOne{builder}
+}
+
+// Query returns a query builder for City.
+func (c *CityClient) Query() *CityQuery {
+	return &CityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a City entity by its id.
+func (c *CityClient) Get(ctx context.Context, id uuid.UUID) (*City, error) {
+	return c.Query().Where(city.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CityClient) GetX(ctx context.Context, id uuid.UUID) *City {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvince queries the province edge of a City.
+func (c *CityClient) QueryProvince(_m *City) *ProvinceQuery {
+	query := (&ProvinceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(city.Table, city.FieldID, id),
+			sqlgraph.To(province.Table, province.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, city.ProvinceTable, city.ProvinceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDistrict queries the district edge of a City.
+func (c *CityClient) QueryDistrict(_m *City) *DistrictQuery {
+	query := (&DistrictClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(city.Table, city.FieldID, id),
+			sqlgraph.To(district.Table, district.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, city.DistrictTable, city.DistrictColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CityClient) Hooks() []Hook {
+	return c.hooks.City
+}
+
+// Interceptors returns the client interceptors.
+func (c *CityClient) Interceptors() []Interceptor {
+	return c.inters.City
+}
+
+func (c *CityClient) mutate(ctx context.Context, m *CityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown City mutation op: %q", m.Op())
+	}
+}
+
+// DeferralClient is a client for the Deferral schema.
+type DeferralClient struct {
+	config
+}
+
+// NewDeferralClient returns a client for the Deferral from the given config.
+func NewDeferralClient(c config) *DeferralClient {
+	return &DeferralClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deferral.Hooks(f(g(h())))`.
+func (c *DeferralClient) Use(hooks ...Hook) {
+	c.hooks.Deferral = append(c.hooks.Deferral, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deferral.Intercept(f(g(h())))`.
+func (c *DeferralClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Deferral = append(c.inters.Deferral, interceptors...)
+}
+
+// Create returns a builder for creating a Deferral entity.
+func (c *DeferralClient) Create() *DeferralCreate {
+	mutation := newDeferralMutation(c.config, OpCreate)
+	return &DeferralCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Deferral entities.
+func (c *DeferralClient) CreateBulk(builders ...*DeferralCreate) *DeferralCreateBulk {
+	return &DeferralCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeferralClient) MapCreateBulk(slice any, setFunc func(*DeferralCreate, int)) *DeferralCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeferralCreateBulk{err: fmt.Errorf("calling to DeferralClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeferralCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeferralCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Deferral.
+func (c *DeferralClient) Update() *DeferralUpdate {
+	mutation := newDeferralMutation(c.config, OpUpdate)
+	return &DeferralUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeferralClient) UpdateOne(_m *Deferral) *DeferralUpdateOne {
+	mutation := newDeferralMutation(c.config, OpUpdateOne, withDeferral(_m))
+	return &DeferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeferralClient) UpdateOneID(id uuid.UUID) *DeferralUpdateOne {
+	mutation := newDeferralMutation(c.config, OpUpdateOne, withDeferralID(id))
+	return &DeferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Deferral.
+func (c *DeferralClient) Delete() *DeferralDelete {
+	mutation := newDeferralMutation(c.config, OpDelete)
+	return &DeferralDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeferralClient) DeleteOne(_m *Deferral) *DeferralDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeferralClient) DeleteOneID(id uuid.UUID) *DeferralDeleteOne {
+	builder := c.Delete().Where(deferral.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeferralDeleteOne{builder}
+}
+
+// Query returns a query builder for Deferral.
+func (c *DeferralClient) Query() *DeferralQuery {
+	return &DeferralQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeferral},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Deferral entity by its id.
+func (c *DeferralClient) Get(ctx context.Context, id uuid.UUID) (*Deferral, error) {
+	return c.Query().Where(deferral.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeferralClient) GetX(ctx context.Context, id uuid.UUID) *Deferral {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a Deferral.
+func (c *DeferralClient) QueryAccount(_m *Deferral) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deferral.Table, deferral.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, deferral.AccountTable, deferral.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedBy queries the created_by edge of a Deferral.
+func (c *DeferralClient) QueryCreatedBy(_m *Deferral) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deferral.Table, deferral.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, deferral.CreatedByTable, deferral.CreatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScreening queries the screening edge of a Deferral.
+func (c *DeferralClient) QueryScreening(_m *Deferral) *ScreeningSubmissionQuery {
+	query := (&ScreeningSubmissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deferral.Table, deferral.FieldID, id),
+			sqlgraph.To(screeningsubmission.Table, screeningsubmission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deferral.ScreeningTable, deferral.ScreeningColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeferralClient) Hooks() []Hook {
+	return c.hooks.Deferral
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeferralClient) Interceptors() []Interceptor {
+	return c.inters.Deferral
+}
+
+func (c *DeferralClient) mutate(ctx context.Context, m *DeferralMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeferralCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeferralUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeferralDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Deferral mutation op: %q", m.Op())
+	}
+}
+
+// DistrictClient is a client for the District schema.
+type DistrictClient struct {
+	config
+}
+
+// NewDistrictClient returns a client for the District from the given config.
+func NewDistrictClient(c config) *DistrictClient {
+	return &DistrictClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `district.Hooks(f(g(h())))`.
+func (c *DistrictClient) Use(hooks ...Hook) {
+	c.hooks.District = append(c.hooks.District, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `district.Intercept(f(g(h())))`.
+func (c *DistrictClient) Intercept(interceptors ...Interceptor) {
+	c.inters.District = append(c.inters.District, interceptors...)
+}
+
+// Create returns a builder for creating a District entity.
+func (c *DistrictClient) Create() *DistrictCreate {
+	mutation := newDistrictMutation(c.config, OpCreate)
+	return &DistrictCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of District entities.
+func (c *DistrictClient) CreateBulk(builders ...*DistrictCreate) *DistrictCreateBulk {
+	return &DistrictCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DistrictClient) MapCreateBulk(slice any, setFunc func(*DistrictCreate, int)) *DistrictCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DistrictCreateBulk{err: fmt.Errorf("calling to DistrictClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DistrictCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DistrictCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for District.
+func (c *DistrictClient) Update() *DistrictUpdate {
+	mutation := newDistrictMutation(c.config, OpUpdate)
+	return &DistrictUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DistrictClient) UpdateOne(_m *District) *DistrictUpdateOne {
+	mutation := newDistrictMutation(c.config, OpUpdateOne, withDistrict(_m))
+	return &DistrictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DistrictClient) UpdateOneID(id uuid.UUID) *DistrictUpdateOne {
+	mutation := newDistrictMutation(c.config, OpUpdateOne, withDistrictID(id))
+	return &DistrictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for District.
+func (c *DistrictClient) Delete() *DistrictDelete {
+	mutation := newDistrictMutation(c.config, OpDelete)
+	return &DistrictDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DistrictClient) DeleteOne(_m *District) *DistrictDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DistrictClient) DeleteOneID(id uuid.UUID) *DistrictDeleteOne {
+	builder := c.Delete().Where(district.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DistrictDeleteOne{builder}
+}
+
+// Query returns a query builder for District.
+func (c *DistrictClient) Query() *DistrictQuery {
+	return &DistrictQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDistrict},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a District entity by its id.
+func (c *DistrictClient) Get(ctx context.Context, id uuid.UUID) (*District, error) {
+	return c.Query().Where(district.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DistrictClient) GetX(ctx context.Context, id uuid.UUID) *District {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCity queries the city edge of a District.
+func (c *DistrictClient) QueryCity(_m *District) *CityQuery {
+	query := (&CityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(district.Table, district.FieldID, id),
+			sqlgraph.To(city.Table, city.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, district.CityTable, district.CityColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubdistrict queries the subdistrict edge of a District.
+func (c *DistrictClient) QuerySubdistrict(_m *District) *SubdistrictQuery {
+	query := (&SubdistrictClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(district.Table, district.FieldID, id),
+			sqlgraph.To(subdistrict.Table, subdistrict.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, district.SubdistrictTable, district.SubdistrictColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DistrictClient) Hooks() []Hook {
+	return c.hooks.District
+}
+
+// Interceptors returns the client interceptors.
+func (c *DistrictClient) Interceptors() []Interceptor {
+	return c.inters.District
+}
+
+func (c *DistrictClient) mutate(ctx context.Context, m *DistrictMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DistrictCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DistrictUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DistrictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DistrictDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown District mutation op: %q", m.Op())
+	}
+}
+
+// DonationClient is a client for the Donation schema.
+type DonationClient struct {
+	config
+}
+
+// NewDonationClient returns a client for the Donation from the given config.
+func NewDonationClient(c config) *DonationClient {
+	return &DonationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `donation.Hooks(f(g(h())))`.
+func (c *DonationClient) Use(hooks ...Hook) {
+	c.hooks.Donation = append(c.hooks.Donation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `donation.Intercept(f(g(h())))`.
+func (c *DonationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Donation = append(c.inters.Donation, interceptors...)
+}
+
+// Create returns a builder for creating a Donation entity.
+func (c *DonationClient) Create() *DonationCreate {
+	mutation := newDonationMutation(c.config, OpCreate)
+	return &DonationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Donation entities.
+func (c *DonationClient) CreateBulk(builders ...*DonationCreate) *DonationCreateBulk {
+	return &DonationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DonationClient) MapCreateBulk(slice any, setFunc func(*DonationCreate, int)) *DonationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DonationCreateBulk{err: fmt.Errorf("calling to DonationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DonationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DonationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Donation.
+func (c *DonationClient) Update() *DonationUpdate {
+	mutation := newDonationMutation(c.config, OpUpdate)
+	return &DonationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DonationClient) UpdateOne(_m *Donation) *DonationUpdateOne {
+	mutation := newDonationMutation(c.config, OpUpdateOne, withDonation(_m))
+	return &DonationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DonationClient) UpdateOneID(id uuid.UUID) *DonationUpdateOne {
+	mutation := newDonationMutation(c.config, OpUpdateOne, withDonationID(id))
+	return &DonationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Donation.
+func (c *DonationClient) Delete() *DonationDelete {
+	mutation := newDonationMutation(c.config, OpDelete)
+	return &DonationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DonationClient) DeleteOne(_m *Donation) *DonationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DonationClient) DeleteOneID(id uuid.UUID) *DonationDeleteOne {
+	builder := c.Delete().Where(donation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DonationDeleteOne{builder}
+}
+
+// Query returns a query builder for Donation.
+func (c *DonationClient) Query() *DonationQuery {
+	return &DonationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDonation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Donation entity by its id.
+func (c *DonationClient) Get(ctx context.Context, id uuid.UUID) (*Donation, error) {
+	return c.Query().Where(donation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DonationClient) GetX(ctx context.Context, id uuid.UUID) *Donation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a Donation.
+func (c *DonationClient) QueryAccount(_m *Donation) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donation.Table, donation.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, donation.AccountTable, donation.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPmiLocation queries the pmi_location edge of a Donation.
+func (c *DonationClient) QueryPmiLocation(_m *Donation) *PMILocationQuery {
+	query := (&PMILocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donation.Table, donation.FieldID, id),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, donation.PmiLocationTable, donation.PmiLocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAppointment queries the appointment edge of a Donation.
+func (c *DonationClient) QueryAppointment(_m *Donation) *AppointmentQuery {
+	query := (&AppointmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donation.Table, donation.FieldID, id),
+			sqlgraph.To(appointment.Table, appointment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, donation.AppointmentTable, donation.AppointmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecordedBy queries the recorded_by edge of a Donation.
+func (c *DonationClient) QueryRecordedBy(_m *Donation) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donation.Table, donation.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, donation.RecordedByTable, donation.RecordedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReminder queries the reminder edge of a Donation.
+func (c *DonationClient) QueryReminder(_m *Donation) *DonationReminderQuery {
+	query := (&DonationReminderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donation.Table, donation.FieldID, id),
+			sqlgraph.To(donationreminder.Table, donationreminder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, donation.ReminderTable, donation.ReminderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DonationClient) Hooks() []Hook {
+	return c.hooks.Donation
+}
+
+// Interceptors returns the client interceptors.
+func (c *DonationClient) Interceptors() []Interceptor {
+	return c.inters.Donation
+}
+
+func (c *DonationClient) mutate(ctx context.Context, m *DonationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DonationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DonationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DonationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DonationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Donation mutation op: %q", m.Op())
+	}
+}
+
+// DonationEventClient is a client for the DonationEvent schema.
+type DonationEventClient struct {
+	config
+}
+
+// NewDonationEventClient returns a client for the DonationEvent from the given config.
+func NewDonationEventClient(c config) *DonationEventClient {
+	return &DonationEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `donationevent.Hooks(f(g(h())))`.
+func (c *DonationEventClient) Use(hooks ...Hook) {
+	c.hooks.DonationEvent = append(c.hooks.DonationEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `donationevent.Intercept(f(g(h())))`.
+func (c *DonationEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DonationEvent = append(c.inters.DonationEvent, interceptors...)
+}
+
+// Create returns a builder for creating a DonationEvent entity.
+func (c *DonationEventClient) Create() *DonationEventCreate {
+	mutation := newDonationEventMutation(c.config, OpCreate)
+	return &DonationEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DonationEvent entities.
+func (c *DonationEventClient) CreateBulk(builders ...*DonationEventCreate) *DonationEventCreateBulk {
+	return &DonationEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DonationEventClient) MapCreateBulk(slice any, setFunc func(*DonationEventCreate, int)) *DonationEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DonationEventCreateBulk{err: fmt.Errorf("calling to DonationEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DonationEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DonationEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DonationEvent.
+func (c *DonationEventClient) Update() *DonationEventUpdate {
+	mutation := newDonationEventMutation(c.config, OpUpdate)
+	return &DonationEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DonationEventClient) UpdateOne(_m *DonationEvent) *DonationEventUpdateOne {
+	mutation := newDonationEventMutation(c.config, OpUpdateOne, withDonationEvent(_m))
+	return &DonationEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DonationEventClient) UpdateOneID(id uuid.UUID) *DonationEventUpdateOne {
+	mutation := newDonationEventMutation(c.config, OpUpdateOne, withDonationEventID(id))
+	return &DonationEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DonationEvent.
+func (c *DonationEventClient) Delete() *DonationEventDelete {
+	mutation := newDonationEventMutation(c.config, OpDelete)
+	return &DonationEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DonationEventClient) DeleteOne(_m *DonationEvent) *DonationEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DonationEventClient) DeleteOneID(id uuid.UUID) *DonationEventDeleteOne {
+	builder := c.Delete().Where(donationevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DonationEventDeleteOne{builder}
+}
+
+// Query returns a query builder for DonationEvent.
+func (c *DonationEventClient) Query() *DonationEventQuery {
+	return &DonationEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDonationEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DonationEvent entity by its id.
+func (c *DonationEventClient) Get(ctx context.Context, id uuid.UUID) (*DonationEvent, error) {
+	return c.Query().Where(donationevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DonationEventClient) GetX(ctx context.Context, id uuid.UUID) *DonationEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubdistrict queries the subdistrict edge of a DonationEvent.
+func (c *DonationEventClient) QuerySubdistrict(_m *DonationEvent) *SubdistrictQuery {
+	query := (&SubdistrictClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donationevent.Table, donationevent.FieldID, id),
+			sqlgraph.To(subdistrict.Table, subdistrict.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, donationevent.SubdistrictTable, donationevent.SubdistrictColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPmiLocation queries the pmi_location edge of a DonationEvent.
+func (c *DonationEventClient) QueryPmiLocation(_m *DonationEvent) *PMILocationQuery {
+	query := (&PMILocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donationevent.Table, donationevent.FieldID, id),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, donationevent.PmiLocationTable, donationevent.PmiLocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganizer queries the organizer edge of a DonationEvent.
+func (c *DonationEventClient) QueryOrganizer(_m *DonationEvent) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donationevent.Table, donationevent.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, donationevent.OrganizerTable, donationevent.OrganizerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewedBy queries the reviewed_by edge of a DonationEvent.
+func (c *DonationEventClient) QueryReviewedBy(_m *DonationEvent) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donationevent.Table, donationevent.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, donationevent.ReviewedByTable, donationevent.ReviewedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DonationEventClient) Hooks() []Hook {
+	return c.hooks.DonationEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *DonationEventClient) Interceptors() []Interceptor {
+	return c.inters.DonationEvent
+}
+
+func (c *DonationEventClient) mutate(ctx context.Context, m *DonationEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DonationEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DonationEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DonationEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DonationEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DonationEvent mutation op: %q", m.Op())
+	}
+}
+
+// DonationReminderClient is a client for the DonationReminder schema.
+type DonationReminderClient struct {
+	config
+}
+
+// NewDonationReminderClient returns a client for the DonationReminder from the given config.
+func NewDonationReminderClient(c config) *DonationReminderClient {
+	return &DonationReminderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `donationreminder.Hooks(f(g(h())))`.
+func (c *DonationReminderClient) Use(hooks ...Hook) {
+	c.hooks.DonationReminder = append(c.hooks.DonationReminder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `donationreminder.Intercept(f(g(h())))`.
+func (c *DonationReminderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DonationReminder = append(c.inters.DonationReminder, interceptors...)
+}
+
+// Create returns a builder for creating a DonationReminder entity.
+func (c *DonationReminderClient) Create() *DonationReminderCreate {
+	mutation := newDonationReminderMutation(c.config, OpCreate)
+	return &DonationReminderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DonationReminder entities.
+func (c *DonationReminderClient) CreateBulk(builders ...*DonationReminderCreate) *DonationReminderCreateBulk {
+	return &DonationReminderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DonationReminderClient) MapCreateBulk(slice any, setFunc func(*DonationReminderCreate, int)) *DonationReminderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DonationReminderCreateBulk{err: fmt.Errorf("calling to DonationReminderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DonationReminderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DonationReminderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DonationReminder.
+func (c *DonationReminderClient) Update() *DonationReminderUpdate {
+	mutation := newDonationReminderMutation(c.config, OpUpdate)
+	return &DonationReminderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DonationReminderClient) UpdateOne(_m *DonationReminder) *DonationReminderUpdateOne {
+	mutation := newDonationReminderMutation(c.config, OpUpdateOne, withDonationReminder(_m))
+	return &DonationReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DonationReminderClient) UpdateOneID(id uuid.UUID) *DonationReminderUpdateOne {
+	mutation := newDonationReminderMutation(c.config, OpUpdateOne, withDonationReminderID(id))
+	return &DonationReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DonationReminder.
+func (c *DonationReminderClient) Delete() *DonationReminderDelete {
+	mutation := newDonationReminderMutation(c.config, OpDelete)
+	return &DonationReminderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DonationReminderClient) DeleteOne(_m *DonationReminder) *DonationReminderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DonationReminderClient) DeleteOneID(id uuid.UUID) *DonationReminderDeleteOne {
+	builder := c.Delete().Where(donationreminder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DonationReminderDeleteOne{builder}
+}
+
+// Query returns a query builder for DonationReminder.
+func (c *DonationReminderClient) Query() *DonationReminderQuery {
+	return &DonationReminderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDonationReminder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DonationReminder entity by its id.
+func (c *DonationReminderClient) Get(ctx context.Context, id uuid.UUID) (*DonationReminder, error) {
+	return c.Query().Where(donationreminder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DonationReminderClient) GetX(ctx context.Context, id uuid.UUID) *DonationReminder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a DonationReminder.
+func (c *DonationReminderClient) QueryAccount(_m *DonationReminder) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donationreminder.Table, donationreminder.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, donationreminder.AccountTable, donationreminder.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDonation queries the donation edge of a DonationReminder.
+func (c *DonationReminderClient) QueryDonation(_m *DonationReminder) *DonationQuery {
+	query := (&DonationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(donationreminder.Table, donationreminder.FieldID, id),
+			sqlgraph.To(donation.Table, donation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, donationreminder.DonationTable, donationreminder.DonationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DonationReminderClient) Hooks() []Hook {
+	return c.hooks.DonationReminder
+}
+
+// Interceptors returns the client interceptors.
+func (c *DonationReminderClient) Interceptors() []Interceptor {
+	return c.inters.DonationReminder
+}
+
+func (c *DonationReminderClient) mutate(ctx context.Context, m *DonationReminderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DonationReminderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DonationReminderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DonationReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DonationReminderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DonationReminder mutation op: %q", m.Op())
+	}
+}
+
+// EmergencyCampaignClient is a client for the EmergencyCampaign schema.
+type EmergencyCampaignClient struct {
+	config
+}
+
+// NewEmergencyCampaignClient returns a client for the EmergencyCampaign from the given config.
+func NewEmergencyCampaignClient(c config) *EmergencyCampaignClient {
+	return &EmergencyCampaignClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emergencycampaign.Hooks(f(g(h())))`.
+func (c *EmergencyCampaignClient) Use(hooks ...Hook) {
+	c.hooks.EmergencyCampaign = append(c.hooks.EmergencyCampaign, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emergencycampaign.Intercept(f(g(h())))`.
+func (c *EmergencyCampaignClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmergencyCampaign = append(c.inters.EmergencyCampaign, interceptors...)
+}
+
+// Create returns a builder for creating a EmergencyCampaign entity.
+func (c *EmergencyCampaignClient) Create() *EmergencyCampaignCreate {
+	mutation := newEmergencyCampaignMutation(c.config, OpCreate)
+	return &EmergencyCampaignCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmergencyCampaign entities.
+func (c *EmergencyCampaignClient) CreateBulk(builders ...*EmergencyCampaignCreate) *EmergencyCampaignCreateBulk {
+	return &EmergencyCampaignCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmergencyCampaignClient) MapCreateBulk(slice any, setFunc func(*EmergencyCampaignCreate, int)) *EmergencyCampaignCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmergencyCampaignCreateBulk{err: fmt.Errorf("calling to EmergencyCampaignClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmergencyCampaignCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmergencyCampaignCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmergencyCampaign.
+func (c *EmergencyCampaignClient) Update() *EmergencyCampaignUpdate {
+	mutation := newEmergencyCampaignMutation(c.config, OpUpdate)
+	return &EmergencyCampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmergencyCampaignClient) UpdateOne(_m *EmergencyCampaign) *EmergencyCampaignUpdateOne {
+	mutation := newEmergencyCampaignMutation(c.config, OpUpdateOne, withEmergencyCampaign(_m))
+	return &EmergencyCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmergencyCampaignClient) UpdateOneID(id uuid.UUID) *EmergencyCampaignUpdateOne {
+	mutation := newEmergencyCampaignMutation(c.config, OpUpdateOne, withEmergencyCampaignID(id))
+	return &EmergencyCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmergencyCampaign.
+func (c *EmergencyCampaignClient) Delete() *EmergencyCampaignDelete {
+	mutation := newEmergencyCampaignMutation(c.config, OpDelete)
+	return &EmergencyCampaignDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmergencyCampaignClient) DeleteOne(_m *EmergencyCampaign) *EmergencyCampaignDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmergencyCampaignClient) DeleteOneID(id uuid.UUID) *EmergencyCampaignDeleteOne {
+	builder := c.Delete().Where(emergencycampaign.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmergencyCampaignDeleteOne{builder}
+}
+
+// Query returns a query builder for EmergencyCampaign.
+func (c *EmergencyCampaignClient) Query() *EmergencyCampaignQuery {
+	return &EmergencyCampaignQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmergencyCampaign},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmergencyCampaign entity by its id.
+func (c *EmergencyCampaignClient) Get(ctx context.Context, id uuid.UUID) (*EmergencyCampaign, error) {
+	return c.Query().Where(emergencycampaign.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmergencyCampaignClient) GetX(ctx context.Context, id uuid.UUID) *EmergencyCampaign {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPmiLocation queries the pmi_location edge of a EmergencyCampaign.
+func (c *EmergencyCampaignClient) QueryPmiLocation(_m *EmergencyCampaign) *PMILocationQuery {
+	query := (&PMILocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emergencycampaign.Table, emergencycampaign.FieldID, id),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, emergencycampaign.PmiLocationTable, emergencycampaign.PmiLocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBloodType queries the blood_type edge of a EmergencyCampaign.
+func (c *EmergencyCampaignClient) QueryBloodType(_m *EmergencyCampaign) *BloodTypeQuery {
+	query := (&BloodTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emergencycampaign.Table, emergencycampaign.FieldID, id),
+			sqlgraph.To(bloodtype.Table, bloodtype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, emergencycampaign.BloodTypeTable, emergencycampaign.BloodTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedBy queries the created_by edge of a EmergencyCampaign.
+func (c *EmergencyCampaignClient) QueryCreatedBy(_m *EmergencyCampaign) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emergencycampaign.Table, emergencycampaign.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, emergencycampaign.CreatedByTable, emergencycampaign.CreatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContacts queries the contacts edge of a EmergencyCampaign.
+func (c *EmergencyCampaignClient) QueryContacts(_m *EmergencyCampaign) *EmergencyContactQuery {
+	query := (&EmergencyContactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emergencycampaign.Table, emergencycampaign.FieldID, id),
+			sqlgraph.To(emergencycontact.Table, emergencycontact.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, emergencycampaign.ContactsTable, emergencycampaign.ContactsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmergencyCampaignClient) Hooks() []Hook {
+	return c.hooks.EmergencyCampaign
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmergencyCampaignClient) Interceptors() []Interceptor {
+	return c.inters.EmergencyCampaign
+}
+
+func (c *EmergencyCampaignClient) mutate(ctx context.Context, m *EmergencyCampaignMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmergencyCampaignCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmergencyCampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmergencyCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmergencyCampaignDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmergencyCampaign mutation op: %q", m.Op())
+	}
+}
+
+// EmergencyContactClient is a client for the EmergencyContact schema.
+type EmergencyContactClient struct {
+	config
+}
+
+// NewEmergencyContactClient returns a client for the EmergencyContact from the given config.
+func NewEmergencyContactClient(c config) *EmergencyContactClient {
+	return &EmergencyContactClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emergencycontact.Hooks(f(g(h())))`.
+func (c *EmergencyContactClient) Use(hooks ...Hook) {
+	c.hooks.EmergencyContact = append(c.hooks.EmergencyContact, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emergencycontact.Intercept(f(g(h())))`.
+func (c *EmergencyContactClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmergencyContact = append(c.inters.EmergencyContact, interceptors...)
+}
+
+// Create returns a builder for creating a EmergencyContact entity.
+func (c *EmergencyContactClient) Create() *EmergencyContactCreate {
+	mutation := newEmergencyContactMutation(c.config, OpCreate)
+	return &EmergencyContactCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmergencyContact entities.
+func (c *EmergencyContactClient) CreateBulk(builders ...*EmergencyContactCreate) *EmergencyContactCreateBulk {
+	return &EmergencyContactCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmergencyContactClient) MapCreateBulk(slice any, setFunc func(*EmergencyContactCreate, int)) *EmergencyContactCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmergencyContactCreateBulk{err: fmt.Errorf("calling to EmergencyContactClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmergencyContactCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmergencyContactCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmergencyContact.
+func (c *EmergencyContactClient) Update() *EmergencyContactUpdate {
+	mutation := newEmergencyContactMutation(c.config, OpUpdate)
+	return &EmergencyContactUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmergencyContactClient) UpdateOne(_m *EmergencyContact) *EmergencyContactUpdateOne {
+	mutation := newEmergencyContactMutation(c.config, OpUpdateOne, withEmergencyContact(_m))
+	return &EmergencyContactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmergencyContactClient) UpdateOneID(id uuid.UUID) *EmergencyContactUpdateOne {
+	mutation := newEmergencyContactMutation(c.config, OpUpdateOne, withEmergencyContactID(id))
+	return &EmergencyContactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmergencyContact.
+func (c *EmergencyContactClient) Delete() *EmergencyContactDelete {
+	mutation := newEmergencyContactMutation(c.config, OpDelete)
+	return &EmergencyContactDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmergencyContactClient) DeleteOne(_m *EmergencyContact) *EmergencyContactDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmergencyContactClient) DeleteOneID(id uuid.UUID) *EmergencyContactDeleteOne {
+	builder := c.Delete().Where(emergencycontact.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmergencyContactDeleteOne{builder}
+}
+
+// Query returns a query builder for EmergencyContact.
+func (c *EmergencyContactClient) Query() *EmergencyContactQuery {
+	return &EmergencyContactQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmergencyContact},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmergencyContact entity by its id.
+func (c *EmergencyContactClient) Get(ctx context.Context, id uuid.UUID) (*EmergencyContact, error) {
+	return c.Query().Where(emergencycontact.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmergencyContactClient) GetX(ctx context.Context, id uuid.UUID) *EmergencyContact {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCampaign queries the campaign edge of a EmergencyContact.
+func (c *EmergencyContactClient) QueryCampaign(_m *EmergencyContact) *EmergencyCampaignQuery {
+	query := (&EmergencyCampaignClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emergencycontact.Table, emergencycontact.FieldID, id),
+			sqlgraph.To(emergencycampaign.Table, emergencycampaign.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, emergencycontact.CampaignTable, emergencycontact.CampaignColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccount queries the account edge of a EmergencyContact.
+func (c *EmergencyContactClient) QueryAccount(_m *EmergencyContact) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emergencycontact.Table, emergencycontact.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, emergencycontact.AccountTable, emergencycontact.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmergencyContactClient) Hooks() []Hook {
+	return c.hooks.EmergencyContact
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmergencyContactClient) Interceptors() []Interceptor {
+	return c.inters.EmergencyContact
+}
+
+func (c *EmergencyContactClient) mutate(ctx context.Context, m *EmergencyContactMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmergencyContactCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmergencyContactUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmergencyContactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmergencyContactDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmergencyContact mutation op: %q", m.Op())
+	}
+}
+
+// HospitalClient is a client for the Hospital schema.
+type HospitalClient struct {
+	config
+}
+
+// NewHospitalClient returns a client for the Hospital from the given config.
+func NewHospitalClient(c config) *HospitalClient {
+	return &HospitalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hospital.Hooks(f(g(h())))`.
+func (c *HospitalClient) Use(hooks ...Hook) {
+	c.hooks.Hospital = append(c.hooks.Hospital, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hospital.Intercept(f(g(h())))`.
+func (c *HospitalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Hospital = append(c.inters.Hospital, interceptors...)
+}
+
+// Create returns a builder for creating a Hospital entity.
+func (c *HospitalClient) Create() *HospitalCreate {
+	mutation := newHospitalMutation(c.config, OpCreate)
+	return &HospitalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Hospital entities.
+func (c *HospitalClient) CreateBulk(builders ...*HospitalCreate) *HospitalCreateBulk {
+	return &HospitalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HospitalClient) MapCreateBulk(slice any, setFunc func(*HospitalCreate, int)) *HospitalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HospitalCreateBulk{err: fmt.Errorf("calling to HospitalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HospitalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HospitalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Hospital.
+func (c *HospitalClient) Update() *HospitalUpdate {
+	mutation := newHospitalMutation(c.config, OpUpdate)
+	return &HospitalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HospitalClient) UpdateOne(_m *Hospital) *HospitalUpdateOne {
+	mutation := newHospitalMutation(c.config, OpUpdateOne, withHospital(_m))
+	return &HospitalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HospitalClient) UpdateOneID(id uuid.UUID) *HospitalUpdateOne {
+	mutation := newHospitalMutation(c.config, OpUpdateOne, withHospitalID(id))
+	return &HospitalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Hospital.
+func (c *HospitalClient) Delete() *HospitalDelete {
+	mutation := newHospitalMutation(c.config, OpDelete)
+	return &HospitalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HospitalClient) DeleteOne(_m *Hospital) *HospitalDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HospitalClient) DeleteOneID(id uuid.UUID) *HospitalDeleteOne {
+	builder := c.Delete().Where(hospital.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HospitalDeleteOne{builder}
+}
+
+// Query returns a query builder for Hospital.
+func (c *HospitalClient) Query() *HospitalQuery {
+	return &HospitalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHospital},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Hospital entity by its id.
+func (c *HospitalClient) Get(ctx context.Context, id uuid.UUID) (*Hospital, error) {
+	return c.Query().Where(hospital.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HospitalClient) GetX(ctx context.Context, id uuid.UUID) *Hospital {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubdistrict queries the subdistrict edge of a Hospital.
+func (c *HospitalClient) QuerySubdistrict(_m *Hospital) *SubdistrictQuery {
+	query := (&SubdistrictClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hospital.Table, hospital.FieldID, id),
+			sqlgraph.To(subdistrict.Table, subdistrict.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hospital.SubdistrictTable, hospital.SubdistrictColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Hospital.
+func (c *HospitalClient) QueryMembers(_m *Hospital) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hospital.Table, hospital.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, hospital.MembersTable, hospital.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBloodRequests queries the blood_requests edge of a Hospital.
+func (c *HospitalClient) QueryBloodRequests(_m *Hospital) *BloodRequestQuery {
+	query := (&BloodRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hospital.Table, hospital.FieldID, id),
+			sqlgraph.To(bloodrequest.Table, bloodrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, hospital.BloodRequestsTable, hospital.BloodRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HospitalClient) Hooks() []Hook {
+	return c.hooks.Hospital
+}
+
+// Interceptors returns the client interceptors.
+func (c *HospitalClient) Interceptors() []Interceptor {
+	return c.inters.Hospital
+}
+
+func (c *HospitalClient) mutate(ctx context.Context, m *HospitalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HospitalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HospitalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HospitalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HospitalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Hospital mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(_m *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(_m))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id uuid.UUID) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(_m *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id uuid.UUID) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id uuid.UUID) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id uuid.UUID) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a NotificationPreference.
+func (c *NotificationPreferenceClient) QueryAccount(_m *NotificationPreference) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationpreference.Table, notificationpreference.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, notificationpreference.AccountTable, notificationpreference.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.NotificationPreference
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// OTPClient is a client for the OTP schema.
+type OTPClient struct {
+	config
+}
+
+// NewOTPClient returns a client for the OTP from the given config.
+func NewOTPClient(c config) *OTPClient {
+	return &OTPClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `otp.Hooks(f(g(h())))`.
+func (c *OTPClient) Use(hooks ...Hook) {
+	c.hooks.OTP = append(c.hooks.OTP, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `otp.Intercept(f(g(h())))`.
+func (c *OTPClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OTP = append(c.inters.OTP, interceptors...)
+}
+
+// Create returns a builder for creating a OTP entity.
+func (c *OTPClient) Create() *OTPCreate {
+	mutation := newOTPMutation(c.config, OpCreate)
+	return &OTPCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OTP entities.
+func (c *OTPClient) CreateBulk(builders ...*OTPCreate) *OTPCreateBulk {
+	return &OTPCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OTPClient) MapCreateBulk(slice any, setFunc func(*OTPCreate, int)) *OTPCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OTPCreateBulk{err: fmt.Errorf("calling to OTPClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OTPCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OTPCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OTP.
+func (c *OTPClient) Update() *OTPUpdate {
+	mutation := newOTPMutation(c.config, OpUpdate)
+	return &OTPUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OTPClient) UpdateOne(_m *OTP) *OTPUpdateOne {
+	mutation := newOTPMutation(c.config, OpUpdateOne, withOTP(_m))
+	return &OTPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OTPClient) UpdateOneID(id uuid.UUID) *OTPUpdateOne {
+	mutation := newOTPMutation(c.config, OpUpdateOne, withOTPID(id))
+	return &OTPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OTP.
+func (c *OTPClient) Delete() *OTPDelete {
+	mutation := newOTPMutation(c.config, OpDelete)
+	return &OTPDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OTPClient) DeleteOne(_m *OTP) *OTPDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OTPClient) DeleteOneID(id uuid.UUID) *OTPDeleteOne {
+	builder := c.Delete().Where(otp.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OTPDeleteOne{builder}
+}
+
+// Query returns a query builder for OTP.
+func (c *OTPClient) Query() *OTPQuery {
+	return &OTPQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOTP},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OTP entity by its id.
+func (c *OTPClient) Get(ctx context.Context, id uuid.UUID) (*OTP, error) {
+	return c.Query().Where(otp.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OTPClient) GetX(ctx context.Context, id uuid.UUID) *OTP {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a OTP.
+func (c *OTPClient) QueryAccount(_m *OTP) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(otp.Table, otp.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, otp.AccountTable, otp.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OTPClient) Hooks() []Hook {
+	return c.hooks.OTP
+}
+
+// Interceptors returns the client interceptors.
+func (c *OTPClient) Interceptors() []Interceptor {
+	return c.inters.OTP
+}
+
+func (c *OTPClient) mutate(ctx context.Context, m *OTPMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OTPCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OTPUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OTPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OTPDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OTP mutation op: %q", m.Op())
+	}
+}
+
+// PMILocationClient is a client for the PMILocation schema.
+type PMILocationClient struct {
+	config
+}
+
+// NewPMILocationClient returns a client for the PMILocation from the given config.
+func NewPMILocationClient(c config) *PMILocationClient {
+	return &PMILocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pmilocation.Hooks(f(g(h())))`.
+func (c *PMILocationClient) Use(hooks ...Hook) {
+	c.hooks.PMILocation = append(c.hooks.PMILocation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pmilocation.Intercept(f(g(h())))`.
+func (c *PMILocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PMILocation = append(c.inters.PMILocation, interceptors...)
+}
+
+// Create returns a builder for creating a PMILocation entity.
+func (c *PMILocationClient) Create() *PMILocationCreate {
+	mutation := newPMILocationMutation(c.config, OpCreate)
+	return &PMILocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PMILocation entities.
+func (c *PMILocationClient) CreateBulk(builders ...*PMILocationCreate) *PMILocationCreateBulk {
+	return &PMILocationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PMILocationClient) MapCreateBulk(slice any, setFunc func(*PMILocationCreate, int)) *PMILocationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PMILocationCreateBulk{err: fmt.Errorf("calling to PMILocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PMILocationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PMILocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PMILocation.
+func (c *PMILocationClient) Update() *PMILocationUpdate {
+	mutation := newPMILocationMutation(c.config, OpUpdate)
+	return &PMILocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PMILocationClient) UpdateOne(_m *PMILocation) *PMILocationUpdateOne {
+	mutation := newPMILocationMutation(c.config, OpUpdateOne, withPMILocation(_m))
+	return &PMILocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PMILocationClient) UpdateOneID(id uuid.UUID) *PMILocationUpdateOne {
+	mutation := newPMILocationMutation(c.config, OpUpdateOne, withPMILocationID(id))
+	return &PMILocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PMILocation.
+func (c *PMILocationClient) Delete() *PMILocationDelete {
+	mutation := newPMILocationMutation(c.config, OpDelete)
+	return &PMILocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PMILocationClient) DeleteOne(_m *PMILocation) *PMILocationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PMILocationClient) DeleteOneID(id uuid.UUID) *PMILocationDeleteOne {
+	builder := c.Delete().Where(pmilocation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PMILocationDeleteOne{builder}
+}
+
+// Query returns a query builder for PMILocation.
+func (c *PMILocationClient) Query() *PMILocationQuery {
+	return &PMILocationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePMILocation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PMILocation entity by its id.
+func (c *PMILocationClient) Get(ctx context.Context, id uuid.UUID) (*PMILocation, error) {
+	return c.Query().Where(pmilocation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PMILocationClient) GetX(ctx context.Context, id uuid.UUID) *PMILocation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubdistrict queries the subdistrict edge of a PMILocation.
+func (c *PMILocationClient) QuerySubdistrict(_m *PMILocation) *SubdistrictQuery {
+	query := (&SubdistrictClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pmilocation.Table, pmilocation.FieldID, id),
+			sqlgraph.To(subdistrict.Table, subdistrict.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pmilocation.SubdistrictTable, pmilocation.SubdistrictColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PMILocationClient) Hooks() []Hook {
+	return c.hooks.PMILocation
+}
+
+// Interceptors returns the client interceptors.
+func (c *PMILocationClient) Interceptors() []Interceptor {
+	return c.inters.PMILocation
+}
+
+func (c *PMILocationClient) mutate(ctx context.Context, m *PMILocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PMILocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PMILocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PMILocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PMILocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PMILocation mutation op: %q", m.Op())
+	}
+}
+
+// PasswordClient is a client for the Password schema.
+type PasswordClient struct {
+	config
+}
+
+// NewPasswordClient returns a client for the Password from the given config.
+func NewPasswordClient(c config) *PasswordClient {
+	return &PasswordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `password.Hooks(f(g(h())))`.
+func (c *PasswordClient) Use(hooks ...Hook) {
+	c.hooks.Password = append(c.hooks.Password, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `password.Intercept(f(g(h())))`.
+func (c *PasswordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Password = append(c.inters.Password, interceptors...)
+}
+
+// Create returns a builder for creating a Password entity.
+func (c *PasswordClient) Create() *PasswordCreate {
+	mutation := newPasswordMutation(c.config, OpCreate)
+	return &PasswordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Password entities.
+func (c *PasswordClient) CreateBulk(builders ...*PasswordCreate) *PasswordCreateBulk {
+	return &PasswordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordClient) MapCreateBulk(slice any, setFunc func(*PasswordCreate, int)) *PasswordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordCreateBulk{err: fmt.Errorf("calling to PasswordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Password.
+func (c *PasswordClient) Update() *PasswordUpdate {
+	mutation := newPasswordMutation(c.config, OpUpdate)
+	return &PasswordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordClient) UpdateOne(_m *Password) *PasswordUpdateOne {
+	mutation := newPasswordMutation(c.config, OpUpdateOne, withPassword(_m))
+	return &PasswordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordClient) UpdateOneID(id uuid.UUID) *PasswordUpdateOne {
+	mutation := newPasswordMutation(c.config, OpUpdateOne, withPasswordID(id))
+	return &PasswordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Password.
+func (c *PasswordClient) Delete() *PasswordDelete {
+	mutation := newPasswordMutation(c.config, OpDelete)
+	return &PasswordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordClient) DeleteOne(_m *Password) *PasswordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordClient) DeleteOneID(id uuid.UUID) *PasswordDeleteOne {
+	builder := c.Delete().Where(password.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordDeleteOne{builder}
+}
+
+// Query returns a query builder for Password.
+func (c *PasswordClient) Query() *PasswordQuery {
+	return &PasswordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePassword},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Password entity by its id.
+func (c *PasswordClient) Get(ctx context.Context, id uuid.UUID) (*Password, error) {
+	return c.Query().Where(password.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordClient) GetX(ctx context.Context, id uuid.UUID) *Password {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a Password.
+func (c *PasswordClient) QueryAccount(_m *Password) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(password.Table, password.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, password.AccountTable, password.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordClient) Hooks() []Hook {
+	return c.hooks.Password
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordClient) Interceptors() []Interceptor {
+	return c.inters.Password
+}
+
+func (c *PasswordClient) mutate(ctx context.Context, m *PasswordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Password mutation op: %q", m.Op())
+	}
+}
+
+// PermissionClient is a client for the Permission schema.
+type PermissionClient struct {
+	config
+}
+
+// NewPermissionClient returns a client for the Permission from the given config.
+func NewPermissionClient(c config) *PermissionClient {
+	return &PermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `permission.Hooks(f(g(h())))`.
+func (c *PermissionClient) Use(hooks ...Hook) {
+	c.hooks.Permission = append(c.hooks.Permission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `permission.Intercept(f(g(h())))`.
+func (c *PermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Permission = append(c.inters.Permission, interceptors...)
+}
+
+// Create returns a builder for creating a Permission entity.
+func (c *PermissionClient) Create() *PermissionCreate {
+	mutation := newPermissionMutation(c.config, OpCreate)
+	return &PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Permission entities.
+func (c *PermissionClient) CreateBulk(builders ...*PermissionCreate) *PermissionCreateBulk {
+	return &PermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PermissionClient) MapCreateBulk(slice any, setFunc func(*PermissionCreate, int)) *PermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PermissionCreateBulk{err: fmt.Errorf("calling to PermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Permission.
+func (c *PermissionClient) Update() *PermissionUpdate {
+	mutation := newPermissionMutation(c.config, OpUpdate)
+	return &PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PermissionClient) UpdateOne(_m *Permission) *PermissionUpdateOne {
+	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermission(_m))
+	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PermissionClient) UpdateOneID(id uuid.UUID) *PermissionUpdateOne {
+	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermissionID(id))
+	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Permission.
+func (c *PermissionClient) Delete() *PermissionDelete {
+	mutation := newPermissionMutation(c.config, OpDelete)
+	return &PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PermissionClient) DeleteOne(_m *Permission) *PermissionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PermissionClient) DeleteOneID(id uuid.UUID) *PermissionDeleteOne {
+	builder := c.Delete().Where(permission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for Permission.
+func (c *PermissionClient) Query() *PermissionQuery {
+	return &PermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Permission entity by its id.
+func (c *PermissionClient) Get(ctx context.Context, id uuid.UUID) (*Permission, error) {
+	return c.Query().Where(permission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PermissionClient) GetX(ctx context.Context, id uuid.UUID) *Permission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoles queries the roles edge of a Permission.
+func (c *PermissionClient) QueryRoles(_m *Permission) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permission.Table, permission.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, permission.RolesTable, permission.RolesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PermissionClient) Hooks() []Hook {
+	return c.hooks.Permission
+}
+
+// Interceptors returns the client interceptors.
+func (c *PermissionClient) Interceptors() []Interceptor {
+	return c.inters.Permission
+}
+
+func (c *PermissionClient) mutate(ctx context.Context, m *PermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Permission mutation op: %q", m.Op())
+	}
+}
+
+// ProvinceClient is a client for the Province schema.
+type ProvinceClient struct {
+	config
+}
+
+// NewProvinceClient returns a client for the Province from the given config.
+func NewProvinceClient(c config) *ProvinceClient {
+	return &ProvinceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `province.Hooks(f(g(h())))`.
+func (c *ProvinceClient) Use(hooks ...Hook) {
+	c.hooks.Province = append(c.hooks.Province, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `province.Intercept(f(g(h())))`.
+func (c *ProvinceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Province = append(c.inters.Province, interceptors...)
+}
+
+// Create returns a builder for creating a Province entity.
+func (c *ProvinceClient) Create() *ProvinceCreate {
+	mutation := newProvinceMutation(c.config, OpCreate)
+	return &ProvinceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Province entities.
+func (c *ProvinceClient) CreateBulk(builders ...*ProvinceCreate) *ProvinceCreateBulk {
+	return &ProvinceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProvinceClient) MapCreateBulk(slice any, setFunc func(*ProvinceCreate, int)) *ProvinceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProvinceCreateBulk{err: fmt.Errorf("calling to ProvinceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProvinceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProvinceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Province.
+func (c *ProvinceClient) Update() *ProvinceUpdate {
+	mutation := newProvinceMutation(c.config, OpUpdate)
+	return &ProvinceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProvinceClient) UpdateOne(_m *Province) *ProvinceUpdateOne {
+	mutation := newProvinceMutation(c.config, OpUpdateOne, withProvince(_m))
+	return &ProvinceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProvinceClient) UpdateOneID(id uuid.UUID) *ProvinceUpdateOne {
+	mutation := newProvinceMutation(c.config, OpUpdateOne, withProvinceID(id))
+	return &ProvinceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Province.
+func (c *ProvinceClient) Delete() *ProvinceDelete {
+	mutation := newProvinceMutation(c.config, OpDelete)
+	return &ProvinceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProvinceClient) DeleteOne(_m *Province) *ProvinceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProvinceClient) DeleteOneID(id uuid.UUID) *ProvinceDeleteOne {
+	builder := c.Delete().Where(province.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProvinceDeleteOne{builder}
+}
+
+// Query returns a query builder for Province.
+func (c *ProvinceClient) Query() *ProvinceQuery {
+	return &ProvinceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProvince},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Province entity by its id.
+func (c *ProvinceClient) Get(ctx context.Context, id uuid.UUID) (*Province, error) {
+	return c.Query().Where(province.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProvinceClient) GetX(ctx context.Context, id uuid.UUID) *Province {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCity queries the city edge of a Province.
+func (c *ProvinceClient) QueryCity(_m *Province) *CityQuery {
+	query := (&CityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(province.Table, province.FieldID, id),
+			sqlgraph.To(city.Table, city.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, province.CityTable, province.CityColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProvinceClient) Hooks() []Hook {
+	return c.hooks.Province
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProvinceClient) Interceptors() []Interceptor {
+	return c.inters.Province
+}
+
+func (c *ProvinceClient) mutate(ctx context.Context, m *ProvinceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProvinceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProvinceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProvinceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProvinceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Province mutation op: %q", m.Op())
+	}
+}
+
+// QuestionnaireClient is a client for the Questionnaire schema.
+type QuestionnaireClient struct {
+	config
+}
+
+// NewQuestionnaireClient returns a client for the Questionnaire from the given config.
+func NewQuestionnaireClient(c config) *QuestionnaireClient {
+	return &QuestionnaireClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `questionnaire.Hooks(f(g(h())))`.
+func (c *QuestionnaireClient) Use(hooks ...Hook) {
+	c.hooks.Questionnaire = append(c.hooks.Questionnaire, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `questionnaire.Intercept(f(g(h())))`.
+func (c *QuestionnaireClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Questionnaire = append(c.inters.Questionnaire, interceptors...)
+}
+
+// Create returns a builder for creating a Questionnaire entity.
+func (c *QuestionnaireClient) Create() *QuestionnaireCreate {
+	mutation := newQuestionnaireMutation(c.config, OpCreate)
+	return &QuestionnaireCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Questionnaire entities.
+func (c *QuestionnaireClient) CreateBulk(builders ...*QuestionnaireCreate) *QuestionnaireCreateBulk {
+	return &QuestionnaireCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuestionnaireClient) MapCreateBulk(slice any, setFunc func(*QuestionnaireCreate, int)) *QuestionnaireCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuestionnaireCreateBulk{err: fmt.Errorf("calling to QuestionnaireClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuestionnaireCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuestionnaireCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Questionnaire.
+func (c *QuestionnaireClient) Update() *QuestionnaireUpdate {
+	mutation := newQuestionnaireMutation(c.config, OpUpdate)
+	return &QuestionnaireUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuestionnaireClient) UpdateOne(_m *Questionnaire) *QuestionnaireUpdateOne {
+	mutation := newQuestionnaireMutation(c.config, OpUpdateOne, withQuestionnaire(_m))
+	return &QuestionnaireUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuestionnaireClient) UpdateOneID(id uuid.UUID) *QuestionnaireUpdateOne {
+	mutation := newQuestionnaireMutation(c.config, OpUpdateOne, withQuestionnaireID(id))
+	return &QuestionnaireUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Questionnaire.
+func (c *QuestionnaireClient) Delete() *QuestionnaireDelete {
+	mutation := newQuestionnaireMutation(c.config, OpDelete)
+	return &QuestionnaireDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuestionnaireClient) DeleteOne(_m *Questionnaire) *QuestionnaireDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuestionnaireClient) DeleteOneID(id uuid.UUID) *QuestionnaireDeleteOne {
+	builder := c.Delete().Where(questionnaire.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestionnaireDeleteOne{builder}
+}
+
+// Query returns a query builder for Questionnaire.
+func (c *QuestionnaireClient) Query() *QuestionnaireQuery {
+	return &QuestionnaireQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuestionnaire},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Questionnaire entity by its id.
+func (c *QuestionnaireClient) Get(ctx context.Context, id uuid.UUID) (*Questionnaire, error) {
+	return c.Query().Where(questionnaire.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestionnaireClient) GetX(ctx context.Context, id uuid.UUID) *Questionnaire {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQuestions queries the questions edge of a Questionnaire.
+func (c *QuestionnaireClient) QueryQuestions(_m *Questionnaire) *ScreeningQuestionQuery {
+	query := (&ScreeningQuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaire.Table, questionnaire.FieldID, id),
+			sqlgraph.To(screeningquestion.Table, screeningquestion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionnaire.QuestionsTable, questionnaire.QuestionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubmissions queries the submissions edge of a Questionnaire.
+func (c *QuestionnaireClient) QuerySubmissions(_m *Questionnaire) *ScreeningSubmissionQuery {
+	query := (&ScreeningSubmissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaire.Table, questionnaire.FieldID, id),
+			sqlgraph.To(screeningsubmission.Table, screeningsubmission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, questionnaire.SubmissionsTable, questionnaire.SubmissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuestionnaireClient) Hooks() []Hook {
+	return c.hooks.Questionnaire
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionnaireClient) Interceptors() []Interceptor {
+	return c.inters.Questionnaire
+}
+
+func (c *QuestionnaireClient) mutate(ctx context.Context, m *QuestionnaireMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionnaireCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionnaireUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionnaireUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionnaireDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Questionnaire mutation op: %q", m.Op())
+	}
+}
+
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
+	config
+}
+
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleClient) UpdateOneID(id uuid.UUID) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleClient) DeleteOneID(id uuid.UUID) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleDeleteOne{builder}
+}
+
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id uuid.UUID) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleClient) GetX(ctx context.Context, id uuid.UUID) *Role {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccounts queries the accounts edge of a Role.
+func (c *RoleClient) QueryAccounts(_m *Role) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, role.AccountsTable, role.AccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Role.
+func (c *RoleClient) QueryChildren(_m *Role) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, role.ChildrenTable, role.ChildrenPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Role.
+func (c *RoleClient) QueryParent(_m *Role) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, role.ParentTable, role.ParentPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermissions queries the permissions edge of a Role.
+func (c *RoleClient) QueryPermissions(_m *Role) *PermissionQuery {
+	query := (&PermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(permission.Table, permission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, role.PermissionsTable, role.PermissionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignments queries the assignments edge of a Role.
+func (c *RoleClient) QueryAssignments(_m *Role) *RoleAssignmentQuery {
+	query := (&RoleAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(roleassignment.Table, roleassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, role.AssignmentsTable, role.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
+}
+
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+	}
+}
+
+// RoleAssignmentClient is a client for the RoleAssignment schema.
+type RoleAssignmentClient struct {
+	config
+}
+
+// NewRoleAssignmentClient returns a client for the RoleAssignment from the given config.
+func NewRoleAssignmentClient(c config) *RoleAssignmentClient {
+	return &RoleAssignmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `password.Hooks(f(g(h())))`.
-func (c *PasswordClient) Use(hooks ...Hook) {
-	c.hooks.Password = append(c.hooks.Password, hooks...)
+// A call to `Use(f, g, h)` equals to `roleassignment.Hooks(f(g(h())))`.
+func (c *RoleAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.RoleAssignment = append(c.hooks.RoleAssignment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `password.Intercept(f(g(h())))`.
-func (c *PasswordClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Password = append(c.inters.Password, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `roleassignment.Intercept(f(g(h())))`.
+func (c *RoleAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleAssignment = append(c.inters.RoleAssignment, interceptors...)
 }
 
-// Create returns a builder for creating a Password entity.
-func (c *PasswordClient) Create() *PasswordCreate {
-	mutation := newPasswordMutation(c.config, OpCreate)
-	return &PasswordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RoleAssignment entity.
+func (c *RoleAssignmentClient) Create() *RoleAssignmentCreate {
+	mutation := newRoleAssignmentMutation(c.config, OpCreate)
+	return &RoleAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Password entities.
-func (c *PasswordClient) CreateBulk(builders ...*PasswordCreate) *PasswordCreateBulk {
-	return &PasswordCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RoleAssignment entities.
+func (c *RoleAssignmentClient) CreateBulk(builders ...*RoleAssignmentCreate) *RoleAssignmentCreateBulk {
+	return &RoleAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PasswordClient) MapCreateBulk(slice any, setFunc func(*PasswordCreate, int)) *PasswordCreateBulk {
+func (c *RoleAssignmentClient) MapCreateBulk(slice any, setFunc func(*RoleAssignmentCreate, int)) *RoleAssignmentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PasswordCreateBulk{err: fmt.Errorf("calling to PasswordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RoleAssignmentCreateBulk{err: fmt.Errorf("calling to RoleAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PasswordCreate, rv.Len())
+	builders := make([]*RoleAssignmentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PasswordCreateBulk{config: c.config, builders: builders}
+	return &RoleAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Password.
-func (c *PasswordClient) Update() *PasswordUpdate {
-	mutation := newPasswordMutation(c.config, OpUpdate)
-	return &PasswordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RoleAssignment.
+func (c *RoleAssignmentClient) Update() *RoleAssignmentUpdate {
+	mutation := newRoleAssignmentMutation(c.config, OpUpdate)
+	return &RoleAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PasswordClient) UpdateOne(_m *Password) *PasswordUpdateOne {
-	mutation := newPasswordMutation(c.config, OpUpdateOne, withPassword(_m))
-	return &PasswordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleAssignmentClient) UpdateOne(_m *RoleAssignment) *RoleAssignmentUpdateOne {
+	mutation := newRoleAssignmentMutation(c.config, OpUpdateOne, withRoleAssignment(_m))
+	return &RoleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PasswordClient) UpdateOneID(id uuid.UUID) *PasswordUpdateOne {
-	mutation := newPasswordMutation(c.config, OpUpdateOne, withPasswordID(id))
-	return &PasswordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleAssignmentClient) UpdateOneID(id uuid.UUID) *RoleAssignmentUpdateOne {
+	mutation := newRoleAssignmentMutation(c.config, OpUpdateOne, withRoleAssignmentID(id))
+	return &RoleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Password.
-func (c *PasswordClient) Delete() *PasswordDelete {
-	mutation := newPasswordMutation(c.config, OpDelete)
-	return &PasswordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RoleAssignment.
+func (c *RoleAssignmentClient) Delete() *RoleAssignmentDelete {
+	mutation := newRoleAssignmentMutation(c.config, OpDelete)
+	return &RoleAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PasswordClient) DeleteOne(_m *Password) *PasswordDeleteOne {
+func (c *RoleAssignmentClient) DeleteOne(_m *RoleAssignment) *RoleAssignmentDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PasswordClient) DeleteOneID(id uuid.UUID) *PasswordDeleteOne {
-	builder := c.Delete().Where(password.ID(id))
+func (c *RoleAssignmentClient) DeleteOneID(id uuid.UUID) *RoleAssignmentDeleteOne {
+	builder := c.Delete().Where(roleassignment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PasswordDeleteOne{builder}
+	return &RoleAssignmentDeleteOne{builder}
 }
 
-// Query returns a query builder for Password.
-func (c *PasswordClient) Query() *PasswordQuery {
-	return &PasswordQuery{
+// Query returns a query builder for RoleAssignment.
+func (c *RoleAssignmentClient) Query() *RoleAssignmentQuery {
+	return &RoleAssignmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePassword},
+		ctx:    &QueryContext{Type: TypeRoleAssignment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Password entity by its id.
-func (c *PasswordClient) Get(ctx context.Context, id uuid.UUID) (*Password, error) {
-	return c.Query().Where(password.ID(id)).Only(ctx)
+// Get returns a RoleAssignment entity by its id.
+func (c *RoleAssignmentClient) Get(ctx context.Context, id uuid.UUID) (*RoleAssignment, error) {
+	return c.Query().Where(roleassignment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PasswordClient) GetX(ctx context.Context, id uuid.UUID) *Password {
+func (c *RoleAssignmentClient) GetX(ctx context.Context, id uuid.UUID) *RoleAssignment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1511,15 +5538,47 @@ func (c *PasswordClient) GetX(ctx context.Context, id uuid.UUID) *Password {
 	return obj
 }
 
-// QueryAccount queries the account edge of a Password.
-func (c *PasswordClient) QueryAccount(_m *Password) *AccountQuery {
+// QueryAccount queries the account edge of a RoleAssignment.
+func (c *RoleAssignmentClient) QueryAccount(_m *RoleAssignment) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roleassignment.Table, roleassignment.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleassignment.AccountTable, roleassignment.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRole queries the role edge of a RoleAssignment.
+func (c *RoleAssignmentClient) QueryRole(_m *RoleAssignment) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roleassignment.Table, roleassignment.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleassignment.RoleTable, roleassignment.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignedBy queries the assigned_by edge of a RoleAssignment.
+func (c *RoleAssignmentClient) QueryAssignedBy(_m *RoleAssignment) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(password.Table, password.FieldID, id),
+			sqlgraph.From(roleassignment.Table, roleassignment.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, password.AccountTable, password.AccountColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleassignment.AssignedByTable, roleassignment.AssignedByColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1528,131 +5587,131 @@ func (c *PasswordClient) QueryAccount(_m *Password) *AccountQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PasswordClient) Hooks() []Hook {
-	return c.hooks.Password
+func (c *RoleAssignmentClient) Hooks() []Hook {
+	return c.hooks.RoleAssignment
 }
 
 // Interceptors returns the client interceptors.
-func (c *PasswordClient) Interceptors() []Interceptor {
-	return c.inters.Password
+func (c *RoleAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.RoleAssignment
 }
 
-func (c *PasswordClient) mutate(ctx context.Context, m *PasswordMutation) (Value, error) {
+func (c *RoleAssignmentClient) mutate(ctx context.Context, m *RoleAssignmentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PasswordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PasswordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PasswordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PasswordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RoleAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Password mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RoleAssignment mutation op: %q", m.Op())
 	}
 }
 
-// PermissionClient is a client for the Permission schema.
-type PermissionClient struct {
+// ScreeningQuestionClient is a client for the ScreeningQuestion schema.
+type ScreeningQuestionClient struct {
 	config
 }
 
-// NewPermissionClient returns a client for the Permission from the given config.
-func NewPermissionClient(c config) *PermissionClient {
-	return &PermissionClient{config: c}
+// NewScreeningQuestionClient returns a client for the ScreeningQuestion from the given config.
+func NewScreeningQuestionClient(c config) *ScreeningQuestionClient {
+	return &ScreeningQuestionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `permission.Hooks(f(g(h())))`.
-func (c *PermissionClient) Use(hooks ...Hook) {
-	c.hooks.Permission = append(c.hooks.Permission, hooks...)
+// A call to `Use(f, g, h)` equals to `screeningquestion.Hooks(f(g(h())))`.
+func (c *ScreeningQuestionClient) Use(hooks ...Hook) {
+	c.hooks.ScreeningQuestion = append(c.hooks.ScreeningQuestion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `permission.Intercept(f(g(h())))`.
-func (c *PermissionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Permission = append(c.inters.Permission, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `screeningquestion.Intercept(f(g(h())))`.
+func (c *ScreeningQuestionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScreeningQuestion = append(c.inters.ScreeningQuestion, interceptors...)
 }
 
-// Create returns a builder for creating a Permission entity.
-func (c *PermissionClient) Create() *PermissionCreate {
-	mutation := newPermissionMutation(c.config, OpCreate)
-	return &PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScreeningQuestion entity.
+func (c *ScreeningQuestionClient) Create() *ScreeningQuestionCreate {
+	mutation := newScreeningQuestionMutation(c.config, OpCreate)
+	return &ScreeningQuestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Permission entities.
-func (c *PermissionClient) CreateBulk(builders ...*PermissionCreate) *PermissionCreateBulk {
-	return &PermissionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScreeningQuestion entities.
+func (c *ScreeningQuestionClient) CreateBulk(builders ...*ScreeningQuestionCreate) *ScreeningQuestionCreateBulk {
+	return &ScreeningQuestionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PermissionClient) MapCreateBulk(slice any, setFunc func(*PermissionCreate, int)) *PermissionCreateBulk {
+func (c *ScreeningQuestionClient) MapCreateBulk(slice any, setFunc func(*ScreeningQuestionCreate, int)) *ScreeningQuestionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PermissionCreateBulk{err: fmt.Errorf("calling to PermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScreeningQuestionCreateBulk{err: fmt.Errorf("calling to ScreeningQuestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PermissionCreate, rv.Len())
+	builders := make([]*ScreeningQuestionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PermissionCreateBulk{config: c.config, builders: builders}
+	return &ScreeningQuestionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Permission.
-func (c *PermissionClient) Update() *PermissionUpdate {
-	mutation := newPermissionMutation(c.config, OpUpdate)
-	return &PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScreeningQuestion.
+func (c *ScreeningQuestionClient) Update() *ScreeningQuestionUpdate {
+	mutation := newScreeningQuestionMutation(c.config, OpUpdate)
+	return &ScreeningQuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PermissionClient) UpdateOne(_m *Permission) *PermissionUpdateOne {
-	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermission(_m))
-	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScreeningQuestionClient) UpdateOne(_m *ScreeningQuestion) *ScreeningQuestionUpdateOne {
+	mutation := newScreeningQuestionMutation(c.config, OpUpdateOne, withScreeningQuestion(_m))
+	return &ScreeningQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PermissionClient) UpdateOneID(id uuid.UUID) *PermissionUpdateOne {
-	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermissionID(id))
-	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScreeningQuestionClient) UpdateOneID(id uuid.UUID) *ScreeningQuestionUpdateOne {
+	mutation := newScreeningQuestionMutation(c.config, OpUpdateOne, withScreeningQuestionID(id))
+	return &ScreeningQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Permission.
-func (c *PermissionClient) Delete() *PermissionDelete {
-	mutation := newPermissionMutation(c.config, OpDelete)
-	return &PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ScreeningQuestion.
+func (c *ScreeningQuestionClient) Delete() *ScreeningQuestionDelete {
+	mutation := newScreeningQuestionMutation(c.config, OpDelete)
+	return &ScreeningQuestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PermissionClient) DeleteOne(_m *Permission) *PermissionDeleteOne {
+func (c *ScreeningQuestionClient) DeleteOne(_m *ScreeningQuestion) *ScreeningQuestionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PermissionClient) DeleteOneID(id uuid.UUID) *PermissionDeleteOne {
-	builder := c.Delete().Where(permission.ID(id))
+func (c *ScreeningQuestionClient) DeleteOneID(id uuid.UUID) *ScreeningQuestionDeleteOne {
+	builder := c.Delete().Where(screeningquestion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PermissionDeleteOne{builder}
+	return &ScreeningQuestionDeleteOne{builder}
 }
 
-// Query returns a query builder for Permission.
-func (c *PermissionClient) Query() *PermissionQuery {
-	return &PermissionQuery{
+// Query returns a query builder for ScreeningQuestion.
+func (c *ScreeningQuestionClient) Query() *ScreeningQuestionQuery {
+	return &ScreeningQuestionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePermission},
+		ctx:    &QueryContext{Type: TypeScreeningQuestion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Permission entity by its id.
-func (c *PermissionClient) Get(ctx context.Context, id uuid.UUID) (*Permission, error) {
-	return c.Query().Where(permission.ID(id)).Only(ctx)
+// Get returns a ScreeningQuestion entity by its id.
+func (c *ScreeningQuestionClient) Get(ctx context.Context, id uuid.UUID) (*ScreeningQuestion, error) {
+	return c.Query().Where(screeningquestion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PermissionClient) GetX(ctx context.Context, id uuid.UUID) *Permission {
+func (c *ScreeningQuestionClient) GetX(ctx context.Context, id uuid.UUID) *ScreeningQuestion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1660,132 +5719,148 @@ func (c *PermissionClient) GetX(ctx context.Context, id uuid.UUID) *Permission {
 	return obj
 }
 
+// QueryQuestionnaire queries the questionnaire edge of a ScreeningQuestion.
+func (c *ScreeningQuestionClient) QueryQuestionnaire(_m *ScreeningQuestion) *QuestionnaireQuery {
+	query := (&QuestionnaireClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningquestion.Table, screeningquestion.FieldID, id),
+			sqlgraph.To(questionnaire.Table, questionnaire.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, screeningquestion.QuestionnaireTable, screeningquestion.QuestionnaireColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *PermissionClient) Hooks() []Hook {
-	return c.hooks.Permission
+func (c *ScreeningQuestionClient) Hooks() []Hook {
+	return c.hooks.ScreeningQuestion
 }
 
 // Interceptors returns the client interceptors.
-func (c *PermissionClient) Interceptors() []Interceptor {
-	return c.inters.Permission
+func (c *ScreeningQuestionClient) Interceptors() []Interceptor {
+	return c.inters.ScreeningQuestion
 }
 
-func (c *PermissionClient) mutate(ctx context.Context, m *PermissionMutation) (Value, error) {
+func (c *ScreeningQuestionClient) mutate(ctx context.Context, m *ScreeningQuestionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScreeningQuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScreeningQuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScreeningQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ScreeningQuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Permission mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ScreeningQuestion mutation op: %q", m.Op())
 	}
 }
 
-// ProvinceClient is a client for the Province schema.
-type ProvinceClient struct {
+// ScreeningSubmissionClient is a client for the ScreeningSubmission schema.
+type ScreeningSubmissionClient struct {
 	config
 }
 
-// NewProvinceClient returns a client for the Province from the given config.
-func NewProvinceClient(c config) *ProvinceClient {
-	return &ProvinceClient{config: c}
+// NewScreeningSubmissionClient returns a client for the ScreeningSubmission from the given config.
+func NewScreeningSubmissionClient(c config) *ScreeningSubmissionClient {
+	return &ScreeningSubmissionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `province.Hooks(f(g(h())))`.
-func (c *ProvinceClient) Use(hooks ...Hook) {
-	c.hooks.Province = append(c.hooks.Province, hooks...)
+// A call to `Use(f, g, h)` equals to `screeningsubmission.Hooks(f(g(h())))`.
+func (c *ScreeningSubmissionClient) Use(hooks ...Hook) {
+	c.hooks.ScreeningSubmission = append(c.hooks.ScreeningSubmission, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `province.Intercept(f(g(h())))`.
-func (c *ProvinceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Province = append(c.inters.Province, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `screeningsubmission.Intercept(f(g(h())))`.
+func (c *ScreeningSubmissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScreeningSubmission = append(c.inters.ScreeningSubmission, interceptors...)
 }
 
-// Create returns a builder for creating a Province entity.
-func (c *ProvinceClient) Create() *ProvinceCreate {
-	mutation := newProvinceMutation(c.config, OpCreate)
-	return &ProvinceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScreeningSubmission entity.
+func (c *ScreeningSubmissionClient) Create() *ScreeningSubmissionCreate {
+	mutation := newScreeningSubmissionMutation(c.config, OpCreate)
+	return &ScreeningSubmissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Province entities.
-func (c *ProvinceClient) CreateBulk(builders ...*ProvinceCreate) *ProvinceCreateBulk {
-	return &ProvinceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScreeningSubmission entities.
+func (c *ScreeningSubmissionClient) CreateBulk(builders ...*ScreeningSubmissionCreate) *ScreeningSubmissionCreateBulk {
+	return &ScreeningSubmissionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProvinceClient) MapCreateBulk(slice any, setFunc func(*ProvinceCreate, int)) *ProvinceCreateBulk {
+func (c *ScreeningSubmissionClient) MapCreateBulk(slice any, setFunc func(*ScreeningSubmissionCreate, int)) *ScreeningSubmissionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProvinceCreateBulk{err: fmt.Errorf("calling to ProvinceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScreeningSubmissionCreateBulk{err: fmt.Errorf("calling to ScreeningSubmissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProvinceCreate, rv.Len())
+	builders := make([]*ScreeningSubmissionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProvinceCreateBulk{config: c.config, builders: builders}
+	return &ScreeningSubmissionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Province.
-func (c *ProvinceClient) Update() *ProvinceUpdate {
-	mutation := newProvinceMutation(c.config, OpUpdate)
-	return &ProvinceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScreeningSubmission.
+func (c *ScreeningSubmissionClient) Update() *ScreeningSubmissionUpdate {
+	mutation := newScreeningSubmissionMutation(c.config, OpUpdate)
+	return &ScreeningSubmissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProvinceClient) UpdateOne(_m *Province) *ProvinceUpdateOne {
-	mutation := newProvinceMutation(c.config, OpUpdateOne, withProvince(_m))
-	return &ProvinceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScreeningSubmissionClient) UpdateOne(_m *ScreeningSubmission) *ScreeningSubmissionUpdateOne {
+	mutation := newScreeningSubmissionMutation(c.config, OpUpdateOne, withScreeningSubmission(_m))
+	return &ScreeningSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProvinceClient) UpdateOneID(id uuid.UUID) *ProvinceUpdateOne {
-	mutation := newProvinceMutation(c.config, OpUpdateOne, withProvinceID(id))
-	return &ProvinceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScreeningSubmissionClient) UpdateOneID(id uuid.UUID) *ScreeningSubmissionUpdateOne {
+	mutation := newScreeningSubmissionMutation(c.config, OpUpdateOne, withScreeningSubmissionID(id))
+	return &ScreeningSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Province.
-func (c *ProvinceClient) Delete() *ProvinceDelete {
-	mutation := newProvinceMutation(c.config, OpDelete)
-	return &ProvinceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ScreeningSubmission.
+func (c *ScreeningSubmissionClient) Delete() *ScreeningSubmissionDelete {
+	mutation := newScreeningSubmissionMutation(c.config, OpDelete)
+	return &ScreeningSubmissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProvinceClient) DeleteOne(_m *Province) *ProvinceDeleteOne {
+func (c *ScreeningSubmissionClient) DeleteOne(_m *ScreeningSubmission) *ScreeningSubmissionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProvinceClient) DeleteOneID(id uuid.UUID) *ProvinceDeleteOne {
-	builder := c.Delete().Where(province.ID(id))
+func (c *ScreeningSubmissionClient) DeleteOneID(id uuid.UUID) *ScreeningSubmissionDeleteOne {
+	builder := c.Delete().Where(screeningsubmission.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProvinceDeleteOne{builder}
+	return &ScreeningSubmissionDeleteOne{builder}
 }
 
-// Query returns a query builder for Province.
-func (c *ProvinceClient) Query() *ProvinceQuery {
-	return &ProvinceQuery{
+// Query returns a query builder for ScreeningSubmission.
+func (c *ScreeningSubmissionClient) Query() *ScreeningSubmissionQuery {
+	return &ScreeningSubmissionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProvince},
+		ctx:    &QueryContext{Type: TypeScreeningSubmission},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Province entity by its id.
-func (c *ProvinceClient) Get(ctx context.Context, id uuid.UUID) (*Province, error) {
-	return c.Query().Where(province.ID(id)).Only(ctx)
+// Get returns a ScreeningSubmission entity by its id.
+func (c *ScreeningSubmissionClient) Get(ctx context.Context, id uuid.UUID) (*ScreeningSubmission, error) {
+	return c.Query().Where(screeningsubmission.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProvinceClient) GetX(ctx context.Context, id uuid.UUID) *Province {
+func (c *ScreeningSubmissionClient) GetX(ctx context.Context, id uuid.UUID) *ScreeningSubmission {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1793,15 +5868,63 @@ func (c *ProvinceClient) GetX(ctx context.Context, id uuid.UUID) *Province {
 	return obj
 }
 
-// QueryCity queries the city edge of a Province.
-func (c *ProvinceClient) QueryCity(_m *Province) *CityQuery {
-	query := (&CityClient{config: c.config}).Query()
+// QueryAccount queries the account edge of a ScreeningSubmission.
+func (c *ScreeningSubmissionClient) QueryAccount(_m *ScreeningSubmission) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(province.Table, province.FieldID, id),
-			sqlgraph.To(city.Table, city.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, province.CityTable, province.CityColumn),
+			sqlgraph.From(screeningsubmission.Table, screeningsubmission.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, screeningsubmission.AccountTable, screeningsubmission.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionnaire queries the questionnaire edge of a ScreeningSubmission.
+func (c *ScreeningSubmissionClient) QueryQuestionnaire(_m *ScreeningSubmission) *QuestionnaireQuery {
+	query := (&QuestionnaireClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningsubmission.Table, screeningsubmission.FieldID, id),
+			sqlgraph.To(questionnaire.Table, questionnaire.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, screeningsubmission.QuestionnaireTable, screeningsubmission.QuestionnaireColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAppointment queries the appointment edge of a ScreeningSubmission.
+func (c *ScreeningSubmissionClient) QueryAppointment(_m *ScreeningSubmission) *AppointmentQuery {
+	query := (&AppointmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningsubmission.Table, screeningsubmission.FieldID, id),
+			sqlgraph.To(appointment.Table, appointment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, screeningsubmission.AppointmentTable, screeningsubmission.AppointmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeferrals queries the deferrals edge of a ScreeningSubmission.
+func (c *ScreeningSubmissionClient) QueryDeferrals(_m *ScreeningSubmission) *DeferralQuery {
+	query := (&DeferralClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningsubmission.Table, screeningsubmission.FieldID, id),
+			sqlgraph.To(deferral.Table, deferral.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, screeningsubmission.DeferralsTable, screeningsubmission.DeferralsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1810,131 +5933,131 @@ func (c *ProvinceClient) QueryCity(_m *Province) *CityQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ProvinceClient) Hooks() []Hook {
-	return c.hooks.Province
+func (c *ScreeningSubmissionClient) Hooks() []Hook {
+	return c.hooks.ScreeningSubmission
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProvinceClient) Interceptors() []Interceptor {
-	return c.inters.Province
+func (c *ScreeningSubmissionClient) Interceptors() []Interceptor {
+	return c.inters.ScreeningSubmission
 }
 
-func (c *ProvinceClient) mutate(ctx context.Context, m *ProvinceMutation) (Value, error) {
+func (c *ScreeningSubmissionClient) mutate(ctx context.Context, m *ScreeningSubmissionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProvinceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScreeningSubmissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProvinceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScreeningSubmissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProvinceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScreeningSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProvinceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ScreeningSubmissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Province mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ScreeningSubmission mutation op: %q", m.Op())
 	}
 }
 
-// RoleClient is a client for the Role schema.
-type RoleClient struct {
+// StockMovementClient is a client for the StockMovement schema.
+type StockMovementClient struct {
 	config
 }
 
-// NewRoleClient returns a client for the Role from the given config.
-func NewRoleClient(c config) *RoleClient {
-	return &RoleClient{config: c}
+// NewStockMovementClient returns a client for the StockMovement from the given config.
+func NewStockMovementClient(c config) *StockMovementClient {
+	return &StockMovementClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
-func (c *RoleClient) Use(hooks ...Hook) {
-	c.hooks.Role = append(c.hooks.Role, hooks...)
+// A call to `Use(f, g, h)` equals to `stockmovement.Hooks(f(g(h())))`.
+func (c *StockMovementClient) Use(hooks ...Hook) {
+	c.hooks.StockMovement = append(c.hooks.StockMovement, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
-func (c *RoleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Role = append(c.inters.Role, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `stockmovement.Intercept(f(g(h())))`.
+func (c *StockMovementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StockMovement = append(c.inters.StockMovement, interceptors...)
 }
 
-// Create returns a builder for creating a Role entity.
-func (c *RoleClient) Create() *RoleCreate {
-	mutation := newRoleMutation(c.config, OpCreate)
-	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a StockMovement entity.
+func (c *StockMovementClient) Create() *StockMovementCreate {
+	mutation := newStockMovementMutation(c.config, OpCreate)
+	return &StockMovementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Role entities.
-func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
-	return &RoleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of StockMovement entities.
+func (c *StockMovementClient) CreateBulk(builders ...*StockMovementCreate) *StockMovementCreateBulk {
+	return &StockMovementCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+func (c *StockMovementClient) MapCreateBulk(slice any, setFunc func(*StockMovementCreate, int)) *StockMovementCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &StockMovementCreateBulk{err: fmt.Errorf("calling to StockMovementClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoleCreate, rv.Len())
+	builders := make([]*StockMovementCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoleCreateBulk{config: c.config, builders: builders}
+	return &StockMovementCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Role.
-func (c *RoleClient) Update() *RoleUpdate {
-	mutation := newRoleMutation(c.config, OpUpdate)
-	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for StockMovement.
+func (c *StockMovementClient) Update() *StockMovementUpdate {
+	mutation := newStockMovementMutation(c.config, OpUpdate)
+	return &StockMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StockMovementClient) UpdateOne(_m *StockMovement) *StockMovementUpdateOne {
+	mutation := newStockMovementMutation(c.config, OpUpdateOne, withStockMovement(_m))
+	return &StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoleClient) UpdateOneID(id uuid.UUID) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StockMovementClient) UpdateOneID(id uuid.UUID) *StockMovementUpdateOne {
+	mutation := newStockMovementMutation(c.config, OpUpdateOne, withStockMovementID(id))
+	return &StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Role.
-func (c *RoleClient) Delete() *RoleDelete {
-	mutation := newRoleMutation(c.config, OpDelete)
-	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for StockMovement.
+func (c *StockMovementClient) Delete() *StockMovementDelete {
+	mutation := newStockMovementMutation(c.config, OpDelete)
+	return &StockMovementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
+func (c *StockMovementClient) DeleteOne(_m *StockMovement) *StockMovementDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoleClient) DeleteOneID(id uuid.UUID) *RoleDeleteOne {
-	builder := c.Delete().Where(role.ID(id))
+func (c *StockMovementClient) DeleteOneID(id uuid.UUID) *StockMovementDeleteOne {
+	builder := c.Delete().Where(stockmovement.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoleDeleteOne{builder}
+	return &StockMovementDeleteOne{builder}
 }
 
-// Query returns a query builder for Role.
-func (c *RoleClient) Query() *RoleQuery {
-	return &RoleQuery{
+// Query returns a query builder for StockMovement.
+func (c *StockMovementClient) Query() *StockMovementQuery {
+	return &StockMovementQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRole},
+		ctx:    &QueryContext{Type: TypeStockMovement},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Role entity by its id.
-func (c *RoleClient) Get(ctx context.Context, id uuid.UUID) (*Role, error) {
-	return c.Query().Where(role.ID(id)).Only(ctx)
+// Get returns a StockMovement entity by its id.
+func (c *StockMovementClient) Get(ctx context.Context, id uuid.UUID) (*StockMovement, error) {
+	return c.Query().Where(stockmovement.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoleClient) GetX(ctx context.Context, id uuid.UUID) *Role {
+func (c *StockMovementClient) GetX(ctx context.Context, id uuid.UUID) *StockMovement {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1942,15 +6065,15 @@ func (c *RoleClient) GetX(ctx context.Context, id uuid.UUID) *Role {
 	return obj
 }
 
-// QueryAccounts queries the accounts edge of a Role.
-func (c *RoleClient) QueryAccounts(_m *Role) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
+// QueryStock queries the stock edge of a StockMovement.
+func (c *StockMovementClient) QueryStock(_m *StockMovement) *BloodStockQuery {
+	query := (&BloodStockClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, role.AccountsTable, role.AccountsColumn),
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
+			sqlgraph.To(bloodstock.Table, bloodstock.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, stockmovement.StockTable, stockmovement.StockColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1958,15 +6081,15 @@ func (c *RoleClient) QueryAccounts(_m *Role) *AccountQuery {
 	return query
 }
 
-// QueryChildren queries the children edge of a Role.
-func (c *RoleClient) QueryChildren(_m *Role) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
+// QueryPerformedBy queries the performed_by edge of a StockMovement.
+func (c *StockMovementClient) QueryPerformedBy(_m *StockMovement) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, role.ChildrenTable, role.ChildrenPrimaryKey...),
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, stockmovement.PerformedByTable, stockmovement.PerformedByColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1974,15 +6097,15 @@ func (c *RoleClient) QueryChildren(_m *Role) *RoleQuery {
 	return query
 }
 
-// QueryParent queries the parent edge of a Role.
-func (c *RoleClient) QueryParent(_m *Role) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
+// QueryUnit queries the unit edge of a StockMovement.
+func (c *StockMovementClient) QueryUnit(_m *StockMovement) *BloodUnitQuery {
+	query := (&BloodUnitClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, role.ParentTable, role.ParentPrimaryKey...),
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
+			sqlgraph.To(bloodunit.Table, bloodunit.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, stockmovement.UnitTable, stockmovement.UnitColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1991,27 +6114,27 @@ func (c *RoleClient) QueryParent(_m *Role) *RoleQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *RoleClient) Hooks() []Hook {
-	return c.hooks.Role
+func (c *StockMovementClient) Hooks() []Hook {
+	return c.hooks.StockMovement
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoleClient) Interceptors() []Interceptor {
-	return c.inters.Role
+func (c *StockMovementClient) Interceptors() []Interceptor {
+	return c.inters.StockMovement
 }
 
-func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+func (c *StockMovementClient) mutate(ctx context.Context, m *StockMovementMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StockMovementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StockMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StockMovementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StockMovement mutation op: %q", m.Op())
 	}
 }
 
@@ -2183,11 +6306,19 @@ func (c *SubdistrictClient) mutate(ctx context.Context, m *SubdistrictMutation)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Account, BloodType, CasbinRule, City, District, OTP, PMILocation, Password,
-		Permission, Province, Role, Subdistrict []ent.Hook
+		APIKey, Account, Appointment, AuditLog, BloodRequest, BloodStock, BloodType,
+		BloodUnit, BloodUnitEvent, CasbinRule, Certificate, City, Deferral, District,
+		Donation, DonationEvent, DonationReminder, EmergencyCampaign, EmergencyContact,
+		Hospital, NotificationPreference, OTP, PMILocation, Password, Permission,
+		Province, Questionnaire, Role, RoleAssignment, ScreeningQuestion,
+		ScreeningSubmission, StockMovement, Subdistrict []ent.Hook
 	}
 	inters struct {
-		Account, BloodType, CasbinRule, City, District, OTP, PMILocation, Password,
-		Permission, Province, Role, Subdistrict []ent.Interceptor
+		APIKey, Account, Appointment, AuditLog, BloodRequest, BloodStock, BloodType,
+		BloodUnit, BloodUnitEvent, CasbinRule, Certificate, City, Deferral, District,
+		Donation, DonationEvent, DonationReminder, EmergencyCampaign, EmergencyContact,
+		Hospital, NotificationPreference, OTP, PMILocation, Password, Permission,
+		Province, Questionnaire, Role, RoleAssignment, ScreeningQuestion,
+		ScreeningSubmission, StockMovement, Subdistrict []ent.Interceptor
 	}
 )
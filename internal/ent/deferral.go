@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+)
+
+// Deferral is the model entity for the Deferral schema.
+type Deferral struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Type holds the value of the "type" field.
+	Type deferral.Type `json:"type"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason"`
+	// Start of the deferral in milliseconds.
+	StartsAt int64 `json:"starts_at"`
+	// End of a temporary deferral in milliseconds. Empty for permanent deferrals.
+	EndsAt int64 `json:"ends_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeferralQuery when eager-loading is set.
+	Edges                   DeferralEdges `json:"edges"`
+	account_id              *uuid.UUID
+	created_by_id           *uuid.UUID
+	screening_submission_id *uuid.UUID
+	selectValues            sql.SelectValues
+}
+
+// DeferralEdges holds the relations/edges for other nodes in the graph.
+type DeferralEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *Account `json:"created_by,omitempty"`
+	// Screening holds the value of the screening edge.
+	Screening *ScreeningSubmission `json:"screening,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeferralEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeferralEdges) CreatedByOrErr() (*Account, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
+// ScreeningOrErr returns the Screening value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeferralEdges) ScreeningOrErr() (*ScreeningSubmission, error) {
+	if e.Screening != nil {
+		return e.Screening, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: screeningsubmission.Label}
+	}
+	return nil, &NotLoadedError{edge: "screening"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Deferral) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deferral.FieldCreatedAt, deferral.FieldUpdatedAt, deferral.FieldDeletedAt, deferral.FieldStartsAt, deferral.FieldEndsAt:
+			values[i] = new(sql.NullInt64)
+		case deferral.FieldType, deferral.FieldReason:
+			values[i] = new(sql.NullString)
+		case deferral.FieldID:
+			values[i] = new(uuid.UUID)
+		case deferral.ForeignKeys[0]: // account_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case deferral.ForeignKeys[1]: // created_by_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case deferral.ForeignKeys[2]: // screening_submission_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Deferral fields.
+func (_m *Deferral) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deferral.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case deferral.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case deferral.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case deferral.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case deferral.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = deferral.Type(value.String)
+			}
+		case deferral.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case deferral.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				_m.StartsAt = value.Int64
+			}
+		case deferral.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				_m.EndsAt = value.Int64
+			}
+		case deferral.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.account_id = new(uuid.UUID)
+				*_m.account_id = *value.S.(*uuid.UUID)
+			}
+		case deferral.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				_m.created_by_id = new(uuid.UUID)
+				*_m.created_by_id = *value.S.(*uuid.UUID)
+			}
+		case deferral.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field screening_submission_id", values[i])
+			} else if value.Valid {
+				_m.screening_submission_id = new(uuid.UUID)
+				*_m.screening_submission_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Deferral.
+// This includes values selected through modifiers, order, etc.
+func (_m *Deferral) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Deferral entity.
+func (_m *Deferral) QueryAccount() *AccountQuery {
+	return NewDeferralClient(_m.config).QueryAccount(_m)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the Deferral entity.
+func (_m *Deferral) QueryCreatedBy() *AccountQuery {
+	return NewDeferralClient(_m.config).QueryCreatedBy(_m)
+}
+
+// QueryScreening queries the "screening" edge of the Deferral entity.
+func (_m *Deferral) QueryScreening() *ScreeningSubmissionQuery {
+	return NewDeferralClient(_m.config).QueryScreening(_m)
+}
+
+// Update returns a builder for updating this Deferral.
+// Note that you need to call Deferral.Unwrap() before calling this method if this Deferral
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Deferral) Update() *DeferralUpdateOne {
+	return NewDeferralClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Deferral entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Deferral) Unwrap() *Deferral {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Deferral is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Deferral) String() string {
+	var builder strings.Builder
+	builder.WriteString("Deferral(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartsAt))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndsAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Deferrals is a parsable slice of Deferral.
+type Deferrals []*Deferral
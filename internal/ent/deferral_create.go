@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+)
+
+// DeferralCreate is the builder for creating a Deferral entity.
+type DeferralCreate struct {
+	config
+	mutation *DeferralMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DeferralCreate) SetCreatedAt(v int64) *DeferralCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DeferralCreate) SetUpdatedAt(v int64) *DeferralCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DeferralCreate) SetNillableUpdatedAt(v *int64) *DeferralCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *DeferralCreate) SetDeletedAt(v int64) *DeferralCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *DeferralCreate) SetNillableDeletedAt(v *int64) *DeferralCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *DeferralCreate) SetType(v deferral.Type) *DeferralCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *DeferralCreate) SetReason(v string) *DeferralCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_c *DeferralCreate) SetStartsAt(v int64) *DeferralCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_c *DeferralCreate) SetEndsAt(v int64) *DeferralCreate {
+	_c.mutation.SetEndsAt(v)
+	return _c
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_c *DeferralCreate) SetNillableEndsAt(v *int64) *DeferralCreate {
+	if v != nil {
+		_c.SetEndsAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DeferralCreate) SetID(v uuid.UUID) *DeferralCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *DeferralCreate) SetAccountID(id uuid.UUID) *DeferralCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *DeferralCreate) SetAccount(v *Account) *DeferralCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetCreatedByID sets the "created_by" edge to the Account entity by ID.
+func (_c *DeferralCreate) SetCreatedByID(id uuid.UUID) *DeferralCreate {
+	_c.mutation.SetCreatedByID(id)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the Account entity by ID if the given value is not nil.
+func (_c *DeferralCreate) SetNillableCreatedByID(id *uuid.UUID) *DeferralCreate {
+	if id != nil {
+		_c = _c.SetCreatedByID(*id)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" edge to the Account entity.
+func (_c *DeferralCreate) SetCreatedBy(v *Account) *DeferralCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// SetScreeningID sets the "screening" edge to the ScreeningSubmission entity by ID.
+func (_c *DeferralCreate) SetScreeningID(id uuid.UUID) *DeferralCreate {
+	_c.mutation.SetScreeningID(id)
+	return _c
+}
+
+// SetNillableScreeningID sets the "screening" edge to the ScreeningSubmission entity by ID if the given value is not nil.
+func (_c *DeferralCreate) SetNillableScreeningID(id *uuid.UUID) *DeferralCreate {
+	if id != nil {
+		_c = _c.SetScreeningID(*id)
+	}
+	return _c
+}
+
+// SetScreening sets the "screening" edge to the ScreeningSubmission entity.
+func (_c *DeferralCreate) SetScreening(v *ScreeningSubmission) *DeferralCreate {
+	return _c.SetScreeningID(v.ID)
+}
+
+// Mutation returns the DeferralMutation object of the builder.
+func (_c *DeferralCreate) Mutation() *DeferralMutation {
+	return _c.mutation
+}
+
+// Save creates the Deferral in the database.
+func (_c *DeferralCreate) Save(ctx context.Context) (*Deferral, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeferralCreate) SaveX(ctx context.Context) *Deferral {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeferralCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeferralCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeferralCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := deferral.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := deferral.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := deferral.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Deferral.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := deferral.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Deferral.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Deferral.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := deferral.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Deferral.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "Deferral.starts_at"`)}
+	}
+	if v, ok := _c.mutation.StartsAt(); ok {
+		if err := deferral.StartsAtValidator(v); err != nil {
+			return &ValidationError{Name: "starts_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.starts_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EndsAt(); ok {
+		if err := deferral.EndsAtValidator(v); err != nil {
+			return &ValidationError{Name: "ends_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.ends_at": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Deferral.account"`)}
+	}
+	return nil
+}
+
+func (_c *DeferralCreate) sqlSave(ctx context.Context) (*Deferral, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeferralCreate) createSpec() (*Deferral, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Deferral{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deferral.Table, sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(deferral.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(deferral.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(deferral.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(deferral.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(deferral.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(deferral.FieldStartsAt, field.TypeInt64, value)
+		_node.StartsAt = value
+	}
+	if value, ok := _c.mutation.EndsAt(); ok {
+		_spec.SetField(deferral.FieldEndsAt, field.TypeInt64, value)
+		_node.EndsAt = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deferral.AccountTable,
+			Columns: []string{deferral.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deferral.CreatedByTable,
+			Columns: []string{deferral.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.created_by_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScreeningIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deferral.ScreeningTable,
+			Columns: []string{deferral.ScreeningColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.screening_submission_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DeferralCreateBulk is the builder for creating many Deferral entities in bulk.
+type DeferralCreateBulk struct {
+	config
+	err      error
+	builders []*DeferralCreate
+}
+
+// Save creates the Deferral entities in the database.
+func (_c *DeferralCreateBulk) Save(ctx context.Context) ([]*Deferral, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Deferral, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeferralMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeferralCreateBulk) SaveX(ctx context.Context) []*Deferral {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeferralCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeferralCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
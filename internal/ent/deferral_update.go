@@ -0,0 +1,908 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+)
+
+// DeferralUpdate is the builder for updating Deferral entities.
+type DeferralUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeferralMutation
+}
+
+// Where appends a list predicates to the DeferralUpdate builder.
+func (_u *DeferralUpdate) Where(ps ...predicate.Deferral) *DeferralUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeferralUpdate) SetUpdatedAt(v int64) *DeferralUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *DeferralUpdate) AddUpdatedAt(v int64) *DeferralUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *DeferralUpdate) ClearUpdatedAt() *DeferralUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *DeferralUpdate) SetDeletedAt(v int64) *DeferralUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *DeferralUpdate) SetNillableDeletedAt(v *int64) *DeferralUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *DeferralUpdate) AddDeletedAt(v int64) *DeferralUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *DeferralUpdate) ClearDeletedAt() *DeferralUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *DeferralUpdate) SetType(v deferral.Type) *DeferralUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *DeferralUpdate) SetNillableType(v *deferral.Type) *DeferralUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *DeferralUpdate) SetReason(v string) *DeferralUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *DeferralUpdate) SetNillableReason(v *string) *DeferralUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *DeferralUpdate) SetStartsAt(v int64) *DeferralUpdate {
+	_u.mutation.ResetStartsAt()
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *DeferralUpdate) SetNillableStartsAt(v *int64) *DeferralUpdate {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// AddStartsAt adds value to the "starts_at" field.
+func (_u *DeferralUpdate) AddStartsAt(v int64) *DeferralUpdate {
+	_u.mutation.AddStartsAt(v)
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *DeferralUpdate) SetEndsAt(v int64) *DeferralUpdate {
+	_u.mutation.ResetEndsAt()
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *DeferralUpdate) SetNillableEndsAt(v *int64) *DeferralUpdate {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// AddEndsAt adds value to the "ends_at" field.
+func (_u *DeferralUpdate) AddEndsAt(v int64) *DeferralUpdate {
+	_u.mutation.AddEndsAt(v)
+	return _u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (_u *DeferralUpdate) ClearEndsAt() *DeferralUpdate {
+	_u.mutation.ClearEndsAt()
+	return _u
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *DeferralUpdate) SetAccountID(id uuid.UUID) *DeferralUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *DeferralUpdate) SetAccount(v *Account) *DeferralUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetCreatedByID sets the "created_by" edge to the Account entity by ID.
+func (_u *DeferralUpdate) SetCreatedByID(id uuid.UUID) *DeferralUpdate {
+	_u.mutation.SetCreatedByID(id)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the Account entity by ID if the given value is not nil.
+func (_u *DeferralUpdate) SetNillableCreatedByID(id *uuid.UUID) *DeferralUpdate {
+	if id != nil {
+		_u = _u.SetCreatedByID(*id)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" edge to the Account entity.
+func (_u *DeferralUpdate) SetCreatedBy(v *Account) *DeferralUpdate {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// SetScreeningID sets the "screening" edge to the ScreeningSubmission entity by ID.
+func (_u *DeferralUpdate) SetScreeningID(id uuid.UUID) *DeferralUpdate {
+	_u.mutation.SetScreeningID(id)
+	return _u
+}
+
+// SetNillableScreeningID sets the "screening" edge to the ScreeningSubmission entity by ID if the given value is not nil.
+func (_u *DeferralUpdate) SetNillableScreeningID(id *uuid.UUID) *DeferralUpdate {
+	if id != nil {
+		_u = _u.SetScreeningID(*id)
+	}
+	return _u
+}
+
+// SetScreening sets the "screening" edge to the ScreeningSubmission entity.
+func (_u *DeferralUpdate) SetScreening(v *ScreeningSubmission) *DeferralUpdate {
+	return _u.SetScreeningID(v.ID)
+}
+
+// Mutation returns the DeferralMutation object of the builder.
+func (_u *DeferralUpdate) Mutation() *DeferralMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *DeferralUpdate) ClearAccount() *DeferralUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearCreatedBy clears the "created_by" edge to the Account entity.
+func (_u *DeferralUpdate) ClearCreatedBy() *DeferralUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// ClearScreening clears the "screening" edge to the ScreeningSubmission entity.
+func (_u *DeferralUpdate) ClearScreening() *DeferralUpdate {
+	_u.mutation.ClearScreening()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeferralUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeferralUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeferralUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeferralUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeferralUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := deferral.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeferralUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := deferral.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := deferral.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := deferral.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Deferral.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := deferral.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Deferral.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StartsAt(); ok {
+		if err := deferral.StartsAtValidator(v); err != nil {
+			return &ValidationError{Name: "starts_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.starts_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndsAt(); ok {
+		if err := deferral.EndsAtValidator(v); err != nil {
+			return &ValidationError{Name: "ends_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.ends_at": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Deferral.account"`)
+	}
+	return nil
+}
+
+func (_u *DeferralUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deferral.Table, deferral.Columns, sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deferral.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(deferral.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(deferral.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(deferral.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(deferral.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(deferral.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(deferral.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(deferral.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(deferral.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStartsAt(); ok {
+		_spec.AddField(deferral.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(deferral.FieldEndsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEndsAt(); ok {
+		_spec.AddField(deferral.FieldEndsAt, field.TypeInt64, value)
+	}
+	if _u.mutation.EndsAtCleared() {
+		_spec.ClearField(deferral.FieldEndsAt, field.TypeInt64)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deferral.AccountTable,
+			Columns: []string{deferral.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deferral.AccountTable,
+			Columns: []string{deferral.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deferral.CreatedByTable,
+			Columns: []string{deferral.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deferral.CreatedByTable,
+			Columns: []string{deferral.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScreeningCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deferral.ScreeningTable,
+			Columns: []string{deferral.ScreeningColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScreeningIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deferral.ScreeningTable,
+			Columns: []string{deferral.ScreeningColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deferral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeferralUpdateOne is the builder for updating a single Deferral entity.
+type DeferralUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeferralMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeferralUpdateOne) SetUpdatedAt(v int64) *DeferralUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *DeferralUpdateOne) AddUpdatedAt(v int64) *DeferralUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *DeferralUpdateOne) ClearUpdatedAt() *DeferralUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *DeferralUpdateOne) SetDeletedAt(v int64) *DeferralUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *DeferralUpdateOne) SetNillableDeletedAt(v *int64) *DeferralUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *DeferralUpdateOne) AddDeletedAt(v int64) *DeferralUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *DeferralUpdateOne) ClearDeletedAt() *DeferralUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *DeferralUpdateOne) SetType(v deferral.Type) *DeferralUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *DeferralUpdateOne) SetNillableType(v *deferral.Type) *DeferralUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *DeferralUpdateOne) SetReason(v string) *DeferralUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *DeferralUpdateOne) SetNillableReason(v *string) *DeferralUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *DeferralUpdateOne) SetStartsAt(v int64) *DeferralUpdateOne {
+	_u.mutation.ResetStartsAt()
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *DeferralUpdateOne) SetNillableStartsAt(v *int64) *DeferralUpdateOne {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// AddStartsAt adds value to the "starts_at" field.
+func (_u *DeferralUpdateOne) AddStartsAt(v int64) *DeferralUpdateOne {
+	_u.mutation.AddStartsAt(v)
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *DeferralUpdateOne) SetEndsAt(v int64) *DeferralUpdateOne {
+	_u.mutation.ResetEndsAt()
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *DeferralUpdateOne) SetNillableEndsAt(v *int64) *DeferralUpdateOne {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// AddEndsAt adds value to the "ends_at" field.
+func (_u *DeferralUpdateOne) AddEndsAt(v int64) *DeferralUpdateOne {
+	_u.mutation.AddEndsAt(v)
+	return _u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (_u *DeferralUpdateOne) ClearEndsAt() *DeferralUpdateOne {
+	_u.mutation.ClearEndsAt()
+	return _u
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *DeferralUpdateOne) SetAccountID(id uuid.UUID) *DeferralUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *DeferralUpdateOne) SetAccount(v *Account) *DeferralUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetCreatedByID sets the "created_by" edge to the Account entity by ID.
+func (_u *DeferralUpdateOne) SetCreatedByID(id uuid.UUID) *DeferralUpdateOne {
+	_u.mutation.SetCreatedByID(id)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the Account entity by ID if the given value is not nil.
+func (_u *DeferralUpdateOne) SetNillableCreatedByID(id *uuid.UUID) *DeferralUpdateOne {
+	if id != nil {
+		_u = _u.SetCreatedByID(*id)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" edge to the Account entity.
+func (_u *DeferralUpdateOne) SetCreatedBy(v *Account) *DeferralUpdateOne {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// SetScreeningID sets the "screening" edge to the ScreeningSubmission entity by ID.
+func (_u *DeferralUpdateOne) SetScreeningID(id uuid.UUID) *DeferralUpdateOne {
+	_u.mutation.SetScreeningID(id)
+	return _u
+}
+
+// SetNillableScreeningID sets the "screening" edge to the ScreeningSubmission entity by ID if the given value is not nil.
+func (_u *DeferralUpdateOne) SetNillableScreeningID(id *uuid.UUID) *DeferralUpdateOne {
+	if id != nil {
+		_u = _u.SetScreeningID(*id)
+	}
+	return _u
+}
+
+// SetScreening sets the "screening" edge to the ScreeningSubmission entity.
+func (_u *DeferralUpdateOne) SetScreening(v *ScreeningSubmission) *DeferralUpdateOne {
+	return _u.SetScreeningID(v.ID)
+}
+
+// Mutation returns the DeferralMutation object of the builder.
+func (_u *DeferralUpdateOne) Mutation() *DeferralMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *DeferralUpdateOne) ClearAccount() *DeferralUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearCreatedBy clears the "created_by" edge to the Account entity.
+func (_u *DeferralUpdateOne) ClearCreatedBy() *DeferralUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// ClearScreening clears the "screening" edge to the ScreeningSubmission entity.
+func (_u *DeferralUpdateOne) ClearScreening() *DeferralUpdateOne {
+	_u.mutation.ClearScreening()
+	return _u
+}
+
+// Where appends a list predicates to the DeferralUpdate builder.
+func (_u *DeferralUpdateOne) Where(ps ...predicate.Deferral) *DeferralUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeferralUpdateOne) Select(field string, fields ...string) *DeferralUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Deferral entity.
+func (_u *DeferralUpdateOne) Save(ctx context.Context) (*Deferral, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeferralUpdateOne) SaveX(ctx context.Context) *Deferral {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeferralUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeferralUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeferralUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := deferral.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeferralUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := deferral.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := deferral.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := deferral.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Deferral.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := deferral.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Deferral.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StartsAt(); ok {
+		if err := deferral.StartsAtValidator(v); err != nil {
+			return &ValidationError{Name: "starts_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.starts_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndsAt(); ok {
+		if err := deferral.EndsAtValidator(v); err != nil {
+			return &ValidationError{Name: "ends_at", err: fmt.Errorf(`ent: validator failed for field "Deferral.ends_at": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Deferral.account"`)
+	}
+	return nil
+}
+
+func (_u *DeferralUpdateOne) sqlSave(ctx context.Context) (_node *Deferral, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deferral.Table, deferral.Columns, sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Deferral.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deferral.FieldID)
+		for _, f := range fields {
+			if !deferral.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deferral.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deferral.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(deferral.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(deferral.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(deferral.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(deferral.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(deferral.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(deferral.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(deferral.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(deferral.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStartsAt(); ok {
+		_spec.AddField(deferral.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(deferral.FieldEndsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEndsAt(); ok {
+		_spec.AddField(deferral.FieldEndsAt, field.TypeInt64, value)
+	}
+	if _u.mutation.EndsAtCleared() {
+		_spec.ClearField(deferral.FieldEndsAt, field.TypeInt64)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deferral.AccountTable,
+			Columns: []string{deferral.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deferral.AccountTable,
+			Columns: []string{deferral.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deferral.CreatedByTable,
+			Columns: []string{deferral.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deferral.CreatedByTable,
+			Columns: []string{deferral.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScreeningCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deferral.ScreeningTable,
+			Columns: []string{deferral.ScreeningColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScreeningIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deferral.ScreeningTable,
+			Columns: []string{deferral.ScreeningColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Deferral{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deferral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/donationreminder"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+)
+
+// Donation is the model entity for the Donation schema.
+type Donation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Time the blood was collected in milliseconds.
+	DonatedAt int64 `json:"donated_at"`
+	// Type holds the value of the "type" field.
+	Type donation.Type `json:"type"`
+	// Collected volume in milliliters.
+	VolumeMl int16 `json:"volume_ml"`
+	// Number printed on the blood bag label.
+	BagNumber string `json:"bag_number"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DonationQuery when eager-loading is set.
+	Edges           DonationEdges `json:"edges"`
+	account_id      *uuid.UUID
+	pmi_location_id *uuid.UUID
+	recorded_by_id  *uuid.UUID
+	donation_id     *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// DonationEdges holds the relations/edges for other nodes in the graph.
+type DonationEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// PmiLocation holds the value of the pmi_location edge.
+	PmiLocation *PMILocation `json:"pmi_location,omitempty"`
+	// Appointment holds the value of the appointment edge.
+	Appointment *Appointment `json:"appointment,omitempty"`
+	// RecordedBy holds the value of the recorded_by edge.
+	RecordedBy *Account `json:"recorded_by,omitempty"`
+	// Reminder holds the value of the reminder edge.
+	Reminder *DonationReminder `json:"reminder,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// PmiLocationOrErr returns the PmiLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationEdges) PmiLocationOrErr() (*PMILocation, error) {
+	if e.PmiLocation != nil {
+		return e.PmiLocation, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: pmilocation.Label}
+	}
+	return nil, &NotLoadedError{edge: "pmi_location"}
+}
+
+// AppointmentOrErr returns the Appointment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationEdges) AppointmentOrErr() (*Appointment, error) {
+	if e.Appointment != nil {
+		return e.Appointment, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: appointment.Label}
+	}
+	return nil, &NotLoadedError{edge: "appointment"}
+}
+
+// RecordedByOrErr returns the RecordedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationEdges) RecordedByOrErr() (*Account, error) {
+	if e.RecordedBy != nil {
+		return e.RecordedBy, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "recorded_by"}
+}
+
+// ReminderOrErr returns the Reminder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationEdges) ReminderOrErr() (*DonationReminder, error) {
+	if e.Reminder != nil {
+		return e.Reminder, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: donationreminder.Label}
+	}
+	return nil, &NotLoadedError{edge: "reminder"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Donation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case donation.FieldCreatedAt, donation.FieldUpdatedAt, donation.FieldDeletedAt, donation.FieldDonatedAt, donation.FieldVolumeMl:
+			values[i] = new(sql.NullInt64)
+		case donation.FieldType, donation.FieldBagNumber:
+			values[i] = new(sql.NullString)
+		case donation.FieldID:
+			values[i] = new(uuid.UUID)
+		case donation.ForeignKeys[0]: // account_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case donation.ForeignKeys[1]: // pmi_location_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case donation.ForeignKeys[2]: // recorded_by_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case donation.ForeignKeys[3]: // donation_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Donation fields.
+func (_m *Donation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case donation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case donation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case donation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case donation.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case donation.FieldDonatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field donated_at", values[i])
+			} else if value.Valid {
+				_m.DonatedAt = value.Int64
+			}
+		case donation.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = donation.Type(value.String)
+			}
+		case donation.FieldVolumeMl:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field volume_ml", values[i])
+			} else if value.Valid {
+				_m.VolumeMl = int16(value.Int64)
+			}
+		case donation.FieldBagNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bag_number", values[i])
+			} else if value.Valid {
+				_m.BagNumber = value.String
+			}
+		case donation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.account_id = new(uuid.UUID)
+				*_m.account_id = *value.S.(*uuid.UUID)
+			}
+		case donation.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field pmi_location_id", values[i])
+			} else if value.Valid {
+				_m.pmi_location_id = new(uuid.UUID)
+				*_m.pmi_location_id = *value.S.(*uuid.UUID)
+			}
+		case donation.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field recorded_by_id", values[i])
+			} else if value.Valid {
+				_m.recorded_by_id = new(uuid.UUID)
+				*_m.recorded_by_id = *value.S.(*uuid.UUID)
+			}
+		case donation.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field donation_id", values[i])
+			} else if value.Valid {
+				_m.donation_id = new(uuid.UUID)
+				*_m.donation_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Donation.
+// This includes values selected through modifiers, order, etc.
+func (_m *Donation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Donation entity.
+func (_m *Donation) QueryAccount() *AccountQuery {
+	return NewDonationClient(_m.config).QueryAccount(_m)
+}
+
+// QueryPmiLocation queries the "pmi_location" edge of the Donation entity.
+func (_m *Donation) QueryPmiLocation() *PMILocationQuery {
+	return NewDonationClient(_m.config).QueryPmiLocation(_m)
+}
+
+// QueryAppointment queries the "appointment" edge of the Donation entity.
+func (_m *Donation) QueryAppointment() *AppointmentQuery {
+	return NewDonationClient(_m.config).QueryAppointment(_m)
+}
+
+// QueryRecordedBy queries the "recorded_by" edge of the Donation entity.
+func (_m *Donation) QueryRecordedBy() *AccountQuery {
+	return NewDonationClient(_m.config).QueryRecordedBy(_m)
+}
+
+// QueryReminder queries the "reminder" edge of the Donation entity.
+func (_m *Donation) QueryReminder() *DonationReminderQuery {
+	return NewDonationClient(_m.config).QueryReminder(_m)
+}
+
+// Update returns a builder for updating this Donation.
+// Note that you need to call Donation.Unwrap() before calling this method if this Donation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Donation) Update() *DonationUpdateOne {
+	return NewDonationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Donation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Donation) Unwrap() *Donation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Donation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Donation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Donation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("donated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DonatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("volume_ml=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VolumeMl))
+	builder.WriteString(", ")
+	builder.WriteString("bag_number=")
+	builder.WriteString(_m.BagNumber)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Donations is a parsable slice of Donation.
+type Donations []*Donation
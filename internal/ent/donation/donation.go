@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package donation
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the donation type in the database.
+	Label = "donation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDonatedAt holds the string denoting the donated_at field in the database.
+	FieldDonatedAt = "donated_at"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldVolumeMl holds the string denoting the volume_ml field in the database.
+	FieldVolumeMl = "volume_ml"
+	// FieldBagNumber holds the string denoting the bag_number field in the database.
+	FieldBagNumber = "bag_number"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgePmiLocation holds the string denoting the pmi_location edge name in mutations.
+	EdgePmiLocation = "pmi_location"
+	// EdgeAppointment holds the string denoting the appointment edge name in mutations.
+	EdgeAppointment = "appointment"
+	// EdgeRecordedBy holds the string denoting the recorded_by edge name in mutations.
+	EdgeRecordedBy = "recorded_by"
+	// EdgeReminder holds the string denoting the reminder edge name in mutations.
+	EdgeReminder = "reminder"
+	// Table holds the table name of the donation in the database.
+	Table = "donations"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "donations"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// PmiLocationTable is the table that holds the pmi_location relation/edge.
+	PmiLocationTable = "donations"
+	// PmiLocationInverseTable is the table name for the PMILocation entity.
+	// It exists in this package in order to avoid circular dependency with the "pmilocation" package.
+	PmiLocationInverseTable = "pmi_locations"
+	// PmiLocationColumn is the table column denoting the pmi_location relation/edge.
+	PmiLocationColumn = "pmi_location_id"
+	// AppointmentTable is the table that holds the appointment relation/edge.
+	AppointmentTable = "appointments"
+	// AppointmentInverseTable is the table name for the Appointment entity.
+	// It exists in this package in order to avoid circular dependency with the "appointment" package.
+	AppointmentInverseTable = "appointments"
+	// AppointmentColumn is the table column denoting the appointment relation/edge.
+	AppointmentColumn = "appointment_id"
+	// RecordedByTable is the table that holds the recorded_by relation/edge.
+	RecordedByTable = "donations"
+	// RecordedByInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	RecordedByInverseTable = "accounts"
+	// RecordedByColumn is the table column denoting the recorded_by relation/edge.
+	RecordedByColumn = "recorded_by_id"
+	// ReminderTable is the table that holds the reminder relation/edge.
+	ReminderTable = "donations"
+	// ReminderInverseTable is the table name for the DonationReminder entity.
+	// It exists in this package in order to avoid circular dependency with the "donationreminder" package.
+	ReminderInverseTable = "donation_reminders"
+	// ReminderColumn is the table column denoting the reminder relation/edge.
+	ReminderColumn = "donation_id"
+)
+
+// Columns holds all SQL columns for donation fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldDonatedAt,
+	FieldType,
+	FieldVolumeMl,
+	FieldBagNumber,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "donations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"account_id",
+	"pmi_location_id",
+	"recorded_by_id",
+	"donation_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// DonatedAtValidator is a validator for the "donated_at" field. It is called by the builders before save.
+	DonatedAtValidator func(int64) error
+	// VolumeMlValidator is a validator for the "volume_ml" field. It is called by the builders before save.
+	VolumeMlValidator func(int16) error
+	// BagNumberValidator is a validator for the "bag_number" field. It is called by the builders before save.
+	BagNumberValidator func(string) error
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeWholeBlood         Type = "WHOLE_BLOOD"
+	TypeApheresisPlatelets Type = "APHERESIS_PLATELETS"
+	TypeApheresisPlasma    Type = "APHERESIS_PLASMA"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeWholeBlood, TypeApheresisPlatelets, TypeApheresisPlasma:
+		return nil
+	default:
+		return fmt.Errorf("donation: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the Donation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDonatedAt orders the results by the donated_at field.
+func ByDonatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDonatedAt, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByVolumeMl orders the results by the volume_ml field.
+func ByVolumeMl(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolumeMl, opts...).ToFunc()
+}
+
+// ByBagNumber orders the results by the bag_number field.
+func ByBagNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBagNumber, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPmiLocationField orders the results by pmi_location field.
+func ByPmiLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPmiLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAppointmentField orders the results by appointment field.
+func ByAppointmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAppointmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecordedByField orders the results by recorded_by field.
+func ByRecordedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecordedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReminderField orders the results by reminder field.
+func ByReminderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReminderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+	)
+}
+func newPmiLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PmiLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+	)
+}
+func newAppointmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AppointmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, AppointmentTable, AppointmentColumn),
+	)
+}
+func newRecordedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecordedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RecordedByTable, RecordedByColumn),
+	)
+}
+func newReminderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReminderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, ReminderTable, ReminderColumn),
+	)
+}
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package donation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Donation {
+	return predicate.Donation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Donation {
+	return predicate.Donation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Donation {
+	return predicate.Donation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Donation {
+	return predicate.Donation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Donation {
+	return predicate.Donation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Donation {
+	return predicate.Donation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Donation {
+	return predicate.Donation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DonatedAt applies equality check predicate on the "donated_at" field. It's identical to DonatedAtEQ.
+func DonatedAt(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldDonatedAt, v))
+}
+
+// VolumeMl applies equality check predicate on the "volume_ml" field. It's identical to VolumeMlEQ.
+func VolumeMl(v int16) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldVolumeMl, v))
+}
+
+// BagNumber applies equality check predicate on the "bag_number" field. It's identical to BagNumberEQ.
+func BagNumber(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldBagNumber, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Donation {
+	return predicate.Donation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Donation {
+	return predicate.Donation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.Donation {
+	return predicate.Donation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.Donation {
+	return predicate.Donation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Donation {
+	return predicate.Donation(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Donation {
+	return predicate.Donation(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.Donation {
+	return predicate.Donation(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.Donation {
+	return predicate.Donation(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Donation {
+	return predicate.Donation(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Donation {
+	return predicate.Donation(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DonatedAtEQ applies the EQ predicate on the "donated_at" field.
+func DonatedAtEQ(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldDonatedAt, v))
+}
+
+// DonatedAtNEQ applies the NEQ predicate on the "donated_at" field.
+func DonatedAtNEQ(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldNEQ(FieldDonatedAt, v))
+}
+
+// DonatedAtIn applies the In predicate on the "donated_at" field.
+func DonatedAtIn(vs ...int64) predicate.Donation {
+	return predicate.Donation(sql.FieldIn(FieldDonatedAt, vs...))
+}
+
+// DonatedAtNotIn applies the NotIn predicate on the "donated_at" field.
+func DonatedAtNotIn(vs ...int64) predicate.Donation {
+	return predicate.Donation(sql.FieldNotIn(FieldDonatedAt, vs...))
+}
+
+// DonatedAtGT applies the GT predicate on the "donated_at" field.
+func DonatedAtGT(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldGT(FieldDonatedAt, v))
+}
+
+// DonatedAtGTE applies the GTE predicate on the "donated_at" field.
+func DonatedAtGTE(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldGTE(FieldDonatedAt, v))
+}
+
+// DonatedAtLT applies the LT predicate on the "donated_at" field.
+func DonatedAtLT(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldLT(FieldDonatedAt, v))
+}
+
+// DonatedAtLTE applies the LTE predicate on the "donated_at" field.
+func DonatedAtLTE(v int64) predicate.Donation {
+	return predicate.Donation(sql.FieldLTE(FieldDonatedAt, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.Donation {
+	return predicate.Donation(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.Donation {
+	return predicate.Donation(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.Donation {
+	return predicate.Donation(sql.FieldNotIn(FieldType, vs...))
+}
+
+// VolumeMlEQ applies the EQ predicate on the "volume_ml" field.
+func VolumeMlEQ(v int16) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldVolumeMl, v))
+}
+
+// VolumeMlNEQ applies the NEQ predicate on the "volume_ml" field.
+func VolumeMlNEQ(v int16) predicate.Donation {
+	return predicate.Donation(sql.FieldNEQ(FieldVolumeMl, v))
+}
+
+// VolumeMlIn applies the In predicate on the "volume_ml" field.
+func VolumeMlIn(vs ...int16) predicate.Donation {
+	return predicate.Donation(sql.FieldIn(FieldVolumeMl, vs...))
+}
+
+// VolumeMlNotIn applies the NotIn predicate on the "volume_ml" field.
+func VolumeMlNotIn(vs ...int16) predicate.Donation {
+	return predicate.Donation(sql.FieldNotIn(FieldVolumeMl, vs...))
+}
+
+// VolumeMlGT applies the GT predicate on the "volume_ml" field.
+func VolumeMlGT(v int16) predicate.Donation {
+	return predicate.Donation(sql.FieldGT(FieldVolumeMl, v))
+}
+
+// VolumeMlGTE applies the GTE predicate on the "volume_ml" field.
+func VolumeMlGTE(v int16) predicate.Donation {
+	return predicate.Donation(sql.FieldGTE(FieldVolumeMl, v))
+}
+
+// VolumeMlLT applies the LT predicate on the "volume_ml" field.
+func VolumeMlLT(v int16) predicate.Donation {
+	return predicate.Donation(sql.FieldLT(FieldVolumeMl, v))
+}
+
+// VolumeMlLTE applies the LTE predicate on the "volume_ml" field.
+func VolumeMlLTE(v int16) predicate.Donation {
+	return predicate.Donation(sql.FieldLTE(FieldVolumeMl, v))
+}
+
+// BagNumberEQ applies the EQ predicate on the "bag_number" field.
+func BagNumberEQ(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldEQ(FieldBagNumber, v))
+}
+
+// BagNumberNEQ applies the NEQ predicate on the "bag_number" field.
+func BagNumberNEQ(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldNEQ(FieldBagNumber, v))
+}
+
+// BagNumberIn applies the In predicate on the "bag_number" field.
+func BagNumberIn(vs ...string) predicate.Donation {
+	return predicate.Donation(sql.FieldIn(FieldBagNumber, vs...))
+}
+
+// BagNumberNotIn applies the NotIn predicate on the "bag_number" field.
+func BagNumberNotIn(vs ...string) predicate.Donation {
+	return predicate.Donation(sql.FieldNotIn(FieldBagNumber, vs...))
+}
+
+// BagNumberGT applies the GT predicate on the "bag_number" field.
+func BagNumberGT(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldGT(FieldBagNumber, v))
+}
+
+// BagNumberGTE applies the GTE predicate on the "bag_number" field.
+func BagNumberGTE(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldGTE(FieldBagNumber, v))
+}
+
+// BagNumberLT applies the LT predicate on the "bag_number" field.
+func BagNumberLT(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldLT(FieldBagNumber, v))
+}
+
+// BagNumberLTE applies the LTE predicate on the "bag_number" field.
+func BagNumberLTE(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldLTE(FieldBagNumber, v))
+}
+
+// BagNumberContains applies the Contains predicate on the "bag_number" field.
+func BagNumberContains(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldContains(FieldBagNumber, v))
+}
+
+// BagNumberHasPrefix applies the HasPrefix predicate on the "bag_number" field.
+func BagNumberHasPrefix(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldHasPrefix(FieldBagNumber, v))
+}
+
+// BagNumberHasSuffix applies the HasSuffix predicate on the "bag_number" field.
+func BagNumberHasSuffix(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldHasSuffix(FieldBagNumber, v))
+}
+
+// BagNumberEqualFold applies the EqualFold predicate on the "bag_number" field.
+func BagNumberEqualFold(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldEqualFold(FieldBagNumber, v))
+}
+
+// BagNumberContainsFold applies the ContainsFold predicate on the "bag_number" field.
+func BagNumberContainsFold(v string) predicate.Donation {
+	return predicate.Donation(sql.FieldContainsFold(FieldBagNumber, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Donation {
+	return predicate.Donation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Donation {
+	return predicate.Donation(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPmiLocation applies the HasEdge predicate on the "pmi_location" edge.
+func HasPmiLocation() predicate.Donation {
+	return predicate.Donation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPmiLocationWith applies the HasEdge predicate on the "pmi_location" edge with a given conditions (other predicates).
+func HasPmiLocationWith(preds ...predicate.PMILocation) predicate.Donation {
+	return predicate.Donation(func(s *sql.Selector) {
+		step := newPmiLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAppointment applies the HasEdge predicate on the "appointment" edge.
+func HasAppointment() predicate.Donation {
+	return predicate.Donation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, AppointmentTable, AppointmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppointmentWith applies the HasEdge predicate on the "appointment" edge with a given conditions (other predicates).
+func HasAppointmentWith(preds ...predicate.Appointment) predicate.Donation {
+	return predicate.Donation(func(s *sql.Selector) {
+		step := newAppointmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecordedBy applies the HasEdge predicate on the "recorded_by" edge.
+func HasRecordedBy() predicate.Donation {
+	return predicate.Donation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RecordedByTable, RecordedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecordedByWith applies the HasEdge predicate on the "recorded_by" edge with a given conditions (other predicates).
+func HasRecordedByWith(preds ...predicate.Account) predicate.Donation {
+	return predicate.Donation(func(s *sql.Selector) {
+		step := newRecordedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReminder applies the HasEdge predicate on the "reminder" edge.
+func HasReminder() predicate.Donation {
+	return predicate.Donation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ReminderTable, ReminderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReminderWith applies the HasEdge predicate on the "reminder" edge with a given conditions (other predicates).
+func HasReminderWith(preds ...predicate.DonationReminder) predicate.Donation {
+	return predicate.Donation(func(s *sql.Selector) {
+		step := newReminderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Donation) predicate.Donation {
+	return predicate.Donation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Donation) predicate.Donation {
+	return predicate.Donation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Donation) predicate.Donation {
+	return predicate.Donation(sql.NotPredicates(p))
+}
@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/donationreminder"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+)
+
+// DonationCreate is the builder for creating a Donation entity.
+type DonationCreate struct {
+	config
+	mutation *DonationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DonationCreate) SetCreatedAt(v int64) *DonationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DonationCreate) SetUpdatedAt(v int64) *DonationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DonationCreate) SetNillableUpdatedAt(v *int64) *DonationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *DonationCreate) SetDeletedAt(v int64) *DonationCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *DonationCreate) SetNillableDeletedAt(v *int64) *DonationCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDonatedAt sets the "donated_at" field.
+func (_c *DonationCreate) SetDonatedAt(v int64) *DonationCreate {
+	_c.mutation.SetDonatedAt(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *DonationCreate) SetType(v donation.Type) *DonationCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetVolumeMl sets the "volume_ml" field.
+func (_c *DonationCreate) SetVolumeMl(v int16) *DonationCreate {
+	_c.mutation.SetVolumeMl(v)
+	return _c
+}
+
+// SetBagNumber sets the "bag_number" field.
+func (_c *DonationCreate) SetBagNumber(v string) *DonationCreate {
+	_c.mutation.SetBagNumber(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DonationCreate) SetID(v uuid.UUID) *DonationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *DonationCreate) SetAccountID(id uuid.UUID) *DonationCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *DonationCreate) SetAccount(v *Account) *DonationCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_c *DonationCreate) SetPmiLocationID(id uuid.UUID) *DonationCreate {
+	_c.mutation.SetPmiLocationID(id)
+	return _c
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_c *DonationCreate) SetPmiLocation(v *PMILocation) *DonationCreate {
+	return _c.SetPmiLocationID(v.ID)
+}
+
+// SetAppointmentID sets the "appointment" edge to the Appointment entity by ID.
+func (_c *DonationCreate) SetAppointmentID(id uuid.UUID) *DonationCreate {
+	_c.mutation.SetAppointmentID(id)
+	return _c
+}
+
+// SetNillableAppointmentID sets the "appointment" edge to the Appointment entity by ID if the given value is not nil.
+func (_c *DonationCreate) SetNillableAppointmentID(id *uuid.UUID) *DonationCreate {
+	if id != nil {
+		_c = _c.SetAppointmentID(*id)
+	}
+	return _c
+}
+
+// SetAppointment sets the "appointment" edge to the Appointment entity.
+func (_c *DonationCreate) SetAppointment(v *Appointment) *DonationCreate {
+	return _c.SetAppointmentID(v.ID)
+}
+
+// SetRecordedByID sets the "recorded_by" edge to the Account entity by ID.
+func (_c *DonationCreate) SetRecordedByID(id uuid.UUID) *DonationCreate {
+	_c.mutation.SetRecordedByID(id)
+	return _c
+}
+
+// SetRecordedBy sets the "recorded_by" edge to the Account entity.
+func (_c *DonationCreate) SetRecordedBy(v *Account) *DonationCreate {
+	return _c.SetRecordedByID(v.ID)
+}
+
+// SetReminderID sets the "reminder" edge to the DonationReminder entity by ID.
+func (_c *DonationCreate) SetReminderID(id uuid.UUID) *DonationCreate {
+	_c.mutation.SetReminderID(id)
+	return _c
+}
+
+// SetNillableReminderID sets the "reminder" edge to the DonationReminder entity by ID if the given value is not nil.
+func (_c *DonationCreate) SetNillableReminderID(id *uuid.UUID) *DonationCreate {
+	if id != nil {
+		_c = _c.SetReminderID(*id)
+	}
+	return _c
+}
+
+// SetReminder sets the "reminder" edge to the DonationReminder entity.
+func (_c *DonationCreate) SetReminder(v *DonationReminder) *DonationCreate {
+	return _c.SetReminderID(v.ID)
+}
+
+// Mutation returns the DonationMutation object of the builder.
+func (_c *DonationCreate) Mutation() *DonationMutation {
+	return _c.mutation
+}
+
+// Save creates the Donation in the database.
+func (_c *DonationCreate) Save(ctx context.Context) (*Donation, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DonationCreate) SaveX(ctx context.Context) *Donation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DonationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DonationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DonationCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := donation.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "Donation.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := donation.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Donation.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := donation.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Donation.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DonatedAt(); !ok {
+		return &ValidationError{Name: "donated_at", err: errors.New(`ent: missing required field "Donation.donated_at"`)}
+	}
+	if v, ok := _c.mutation.DonatedAt(); ok {
+		if err := donation.DonatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "donated_at", err: fmt.Errorf(`ent: validator failed for field "Donation.donated_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Donation.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := donation.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Donation.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VolumeMl(); !ok {
+		return &ValidationError{Name: "volume_ml", err: errors.New(`ent: missing required field "Donation.volume_ml"`)}
+	}
+	if v, ok := _c.mutation.VolumeMl(); ok {
+		if err := donation.VolumeMlValidator(v); err != nil {
+			return &ValidationError{Name: "volume_ml", err: fmt.Errorf(`ent: validator failed for field "Donation.volume_ml": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BagNumber(); !ok {
+		return &ValidationError{Name: "bag_number", err: errors.New(`ent: missing required field "Donation.bag_number"`)}
+	}
+	if v, ok := _c.mutation.BagNumber(); ok {
+		if err := donation.BagNumberValidator(v); err != nil {
+			return &ValidationError{Name: "bag_number", err: fmt.Errorf(`ent: validator failed for field "Donation.bag_number": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Donation.account"`)}
+	}
+	if len(_c.mutation.PmiLocationIDs()) == 0 {
+		return &ValidationError{Name: "pmi_location", err: errors.New(`ent: missing required edge "Donation.pmi_location"`)}
+	}
+	if len(_c.mutation.RecordedByIDs()) == 0 {
+		return &ValidationError{Name: "recorded_by", err: errors.New(`ent: missing required edge "Donation.recorded_by"`)}
+	}
+	return nil
+}
+
+func (_c *DonationCreate) sqlSave(ctx context.Context) (*Donation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DonationCreate) createSpec() (*Donation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Donation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(donation.Table, sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(donation.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(donation.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(donation.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.DonatedAt(); ok {
+		_spec.SetField(donation.FieldDonatedAt, field.TypeInt64, value)
+		_node.DonatedAt = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(donation.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.VolumeMl(); ok {
+		_spec.SetField(donation.FieldVolumeMl, field.TypeInt16, value)
+		_node.VolumeMl = value
+	}
+	if value, ok := _c.mutation.BagNumber(); ok {
+		_spec.SetField(donation.FieldBagNumber, field.TypeString, value)
+		_node.BagNumber = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.AccountTable,
+			Columns: []string{donation.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.PmiLocationTable,
+			Columns: []string{donation.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pmi_location_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AppointmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   donation.AppointmentTable,
+			Columns: []string{donation.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecordedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.RecordedByTable,
+			Columns: []string{donation.RecordedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.recorded_by_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReminderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   donation.ReminderTable,
+			Columns: []string{donation.ReminderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.donation_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DonationCreateBulk is the builder for creating many Donation entities in bulk.
+type DonationCreateBulk struct {
+	config
+	err      error
+	builders []*DonationCreate
+}
+
+// Save creates the Donation entities in the database.
+func (_c *DonationCreateBulk) Save(ctx context.Context) ([]*Donation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Donation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DonationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DonationCreateBulk) SaveX(ctx context.Context) []*Donation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DonationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DonationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
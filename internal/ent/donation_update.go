@@ -0,0 +1,1088 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/donationreminder"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// DonationUpdate is the builder for updating Donation entities.
+type DonationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DonationMutation
+}
+
+// Where appends a list predicates to the DonationUpdate builder.
+func (_u *DonationUpdate) Where(ps ...predicate.Donation) *DonationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DonationUpdate) SetUpdatedAt(v int64) *DonationUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *DonationUpdate) AddUpdatedAt(v int64) *DonationUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *DonationUpdate) ClearUpdatedAt() *DonationUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *DonationUpdate) SetDeletedAt(v int64) *DonationUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *DonationUpdate) SetNillableDeletedAt(v *int64) *DonationUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *DonationUpdate) AddDeletedAt(v int64) *DonationUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *DonationUpdate) ClearDeletedAt() *DonationUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDonatedAt sets the "donated_at" field.
+func (_u *DonationUpdate) SetDonatedAt(v int64) *DonationUpdate {
+	_u.mutation.ResetDonatedAt()
+	_u.mutation.SetDonatedAt(v)
+	return _u
+}
+
+// SetNillableDonatedAt sets the "donated_at" field if the given value is not nil.
+func (_u *DonationUpdate) SetNillableDonatedAt(v *int64) *DonationUpdate {
+	if v != nil {
+		_u.SetDonatedAt(*v)
+	}
+	return _u
+}
+
+// AddDonatedAt adds value to the "donated_at" field.
+func (_u *DonationUpdate) AddDonatedAt(v int64) *DonationUpdate {
+	_u.mutation.AddDonatedAt(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *DonationUpdate) SetType(v donation.Type) *DonationUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *DonationUpdate) SetNillableType(v *donation.Type) *DonationUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetVolumeMl sets the "volume_ml" field.
+func (_u *DonationUpdate) SetVolumeMl(v int16) *DonationUpdate {
+	_u.mutation.ResetVolumeMl()
+	_u.mutation.SetVolumeMl(v)
+	return _u
+}
+
+// SetNillableVolumeMl sets the "volume_ml" field if the given value is not nil.
+func (_u *DonationUpdate) SetNillableVolumeMl(v *int16) *DonationUpdate {
+	if v != nil {
+		_u.SetVolumeMl(*v)
+	}
+	return _u
+}
+
+// AddVolumeMl adds value to the "volume_ml" field.
+func (_u *DonationUpdate) AddVolumeMl(v int16) *DonationUpdate {
+	_u.mutation.AddVolumeMl(v)
+	return _u
+}
+
+// SetBagNumber sets the "bag_number" field.
+func (_u *DonationUpdate) SetBagNumber(v string) *DonationUpdate {
+	_u.mutation.SetBagNumber(v)
+	return _u
+}
+
+// SetNillableBagNumber sets the "bag_number" field if the given value is not nil.
+func (_u *DonationUpdate) SetNillableBagNumber(v *string) *DonationUpdate {
+	if v != nil {
+		_u.SetBagNumber(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *DonationUpdate) SetAccountID(id uuid.UUID) *DonationUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *DonationUpdate) SetAccount(v *Account) *DonationUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_u *DonationUpdate) SetPmiLocationID(id uuid.UUID) *DonationUpdate {
+	_u.mutation.SetPmiLocationID(id)
+	return _u
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_u *DonationUpdate) SetPmiLocation(v *PMILocation) *DonationUpdate {
+	return _u.SetPmiLocationID(v.ID)
+}
+
+// SetAppointmentID sets the "appointment" edge to the Appointment entity by ID.
+func (_u *DonationUpdate) SetAppointmentID(id uuid.UUID) *DonationUpdate {
+	_u.mutation.SetAppointmentID(id)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment" edge to the Appointment entity by ID if the given value is not nil.
+func (_u *DonationUpdate) SetNillableAppointmentID(id *uuid.UUID) *DonationUpdate {
+	if id != nil {
+		_u = _u.SetAppointmentID(*id)
+	}
+	return _u
+}
+
+// SetAppointment sets the "appointment" edge to the Appointment entity.
+func (_u *DonationUpdate) SetAppointment(v *Appointment) *DonationUpdate {
+	return _u.SetAppointmentID(v.ID)
+}
+
+// SetRecordedByID sets the "recorded_by" edge to the Account entity by ID.
+func (_u *DonationUpdate) SetRecordedByID(id uuid.UUID) *DonationUpdate {
+	_u.mutation.SetRecordedByID(id)
+	return _u
+}
+
+// SetRecordedBy sets the "recorded_by" edge to the Account entity.
+func (_u *DonationUpdate) SetRecordedBy(v *Account) *DonationUpdate {
+	return _u.SetRecordedByID(v.ID)
+}
+
+// SetReminderID sets the "reminder" edge to the DonationReminder entity by ID.
+func (_u *DonationUpdate) SetReminderID(id uuid.UUID) *DonationUpdate {
+	_u.mutation.SetReminderID(id)
+	return _u
+}
+
+// SetNillableReminderID sets the "reminder" edge to the DonationReminder entity by ID if the given value is not nil.
+func (_u *DonationUpdate) SetNillableReminderID(id *uuid.UUID) *DonationUpdate {
+	if id != nil {
+		_u = _u.SetReminderID(*id)
+	}
+	return _u
+}
+
+// SetReminder sets the "reminder" edge to the DonationReminder entity.
+func (_u *DonationUpdate) SetReminder(v *DonationReminder) *DonationUpdate {
+	return _u.SetReminderID(v.ID)
+}
+
+// Mutation returns the DonationMutation object of the builder.
+func (_u *DonationUpdate) Mutation() *DonationMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *DonationUpdate) ClearAccount() *DonationUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearPmiLocation clears the "pmi_location" edge to the PMILocation entity.
+func (_u *DonationUpdate) ClearPmiLocation() *DonationUpdate {
+	_u.mutation.ClearPmiLocation()
+	return _u
+}
+
+// ClearAppointment clears the "appointment" edge to the Appointment entity.
+func (_u *DonationUpdate) ClearAppointment() *DonationUpdate {
+	_u.mutation.ClearAppointment()
+	return _u
+}
+
+// ClearRecordedBy clears the "recorded_by" edge to the Account entity.
+func (_u *DonationUpdate) ClearRecordedBy() *DonationUpdate {
+	_u.mutation.ClearRecordedBy()
+	return _u
+}
+
+// ClearReminder clears the "reminder" edge to the DonationReminder entity.
+func (_u *DonationUpdate) ClearReminder() *DonationUpdate {
+	_u.mutation.ClearReminder()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DonationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DonationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DonationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DonationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DonationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := donation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DonationUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := donation.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Donation.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := donation.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Donation.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DonatedAt(); ok {
+		if err := donation.DonatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "donated_at", err: fmt.Errorf(`ent: validator failed for field "Donation.donated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := donation.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Donation.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VolumeMl(); ok {
+		if err := donation.VolumeMlValidator(v); err != nil {
+			return &ValidationError{Name: "volume_ml", err: fmt.Errorf(`ent: validator failed for field "Donation.volume_ml": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BagNumber(); ok {
+		if err := donation.BagNumberValidator(v); err != nil {
+			return &ValidationError{Name: "bag_number", err: fmt.Errorf(`ent: validator failed for field "Donation.bag_number": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Donation.account"`)
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Donation.pmi_location"`)
+	}
+	if _u.mutation.RecordedByCleared() && len(_u.mutation.RecordedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Donation.recorded_by"`)
+	}
+	return nil
+}
+
+func (_u *DonationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(donation.Table, donation.Columns, sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(donation.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(donation.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(donation.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(donation.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(donation.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(donation.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DonatedAt(); ok {
+		_spec.SetField(donation.FieldDonatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDonatedAt(); ok {
+		_spec.AddField(donation.FieldDonatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(donation.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VolumeMl(); ok {
+		_spec.SetField(donation.FieldVolumeMl, field.TypeInt16, value)
+	}
+	if value, ok := _u.mutation.AddedVolumeMl(); ok {
+		_spec.AddField(donation.FieldVolumeMl, field.TypeInt16, value)
+	}
+	if value, ok := _u.mutation.BagNumber(); ok {
+		_spec.SetField(donation.FieldBagNumber, field.TypeString, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.AccountTable,
+			Columns: []string{donation.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.AccountTable,
+			Columns: []string{donation.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PmiLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.PmiLocationTable,
+			Columns: []string{donation.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.PmiLocationTable,
+			Columns: []string{donation.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AppointmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   donation.AppointmentTable,
+			Columns: []string{donation.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppointmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   donation.AppointmentTable,
+			Columns: []string{donation.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecordedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.RecordedByTable,
+			Columns: []string{donation.RecordedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecordedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.RecordedByTable,
+			Columns: []string{donation.RecordedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReminderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   donation.ReminderTable,
+			Columns: []string{donation.ReminderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReminderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   donation.ReminderTable,
+			Columns: []string{donation.ReminderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{donation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DonationUpdateOne is the builder for updating a single Donation entity.
+type DonationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DonationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DonationUpdateOne) SetUpdatedAt(v int64) *DonationUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *DonationUpdateOne) AddUpdatedAt(v int64) *DonationUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *DonationUpdateOne) ClearUpdatedAt() *DonationUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *DonationUpdateOne) SetDeletedAt(v int64) *DonationUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *DonationUpdateOne) SetNillableDeletedAt(v *int64) *DonationUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *DonationUpdateOne) AddDeletedAt(v int64) *DonationUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *DonationUpdateOne) ClearDeletedAt() *DonationUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDonatedAt sets the "donated_at" field.
+func (_u *DonationUpdateOne) SetDonatedAt(v int64) *DonationUpdateOne {
+	_u.mutation.ResetDonatedAt()
+	_u.mutation.SetDonatedAt(v)
+	return _u
+}
+
+// SetNillableDonatedAt sets the "donated_at" field if the given value is not nil.
+func (_u *DonationUpdateOne) SetNillableDonatedAt(v *int64) *DonationUpdateOne {
+	if v != nil {
+		_u.SetDonatedAt(*v)
+	}
+	return _u
+}
+
+// AddDonatedAt adds value to the "donated_at" field.
+func (_u *DonationUpdateOne) AddDonatedAt(v int64) *DonationUpdateOne {
+	_u.mutation.AddDonatedAt(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *DonationUpdateOne) SetType(v donation.Type) *DonationUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *DonationUpdateOne) SetNillableType(v *donation.Type) *DonationUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetVolumeMl sets the "volume_ml" field.
+func (_u *DonationUpdateOne) SetVolumeMl(v int16) *DonationUpdateOne {
+	_u.mutation.ResetVolumeMl()
+	_u.mutation.SetVolumeMl(v)
+	return _u
+}
+
+// SetNillableVolumeMl sets the "volume_ml" field if the given value is not nil.
+func (_u *DonationUpdateOne) SetNillableVolumeMl(v *int16) *DonationUpdateOne {
+	if v != nil {
+		_u.SetVolumeMl(*v)
+	}
+	return _u
+}
+
+// AddVolumeMl adds value to the "volume_ml" field.
+func (_u *DonationUpdateOne) AddVolumeMl(v int16) *DonationUpdateOne {
+	_u.mutation.AddVolumeMl(v)
+	return _u
+}
+
+// SetBagNumber sets the "bag_number" field.
+func (_u *DonationUpdateOne) SetBagNumber(v string) *DonationUpdateOne {
+	_u.mutation.SetBagNumber(v)
+	return _u
+}
+
+// SetNillableBagNumber sets the "bag_number" field if the given value is not nil.
+func (_u *DonationUpdateOne) SetNillableBagNumber(v *string) *DonationUpdateOne {
+	if v != nil {
+		_u.SetBagNumber(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *DonationUpdateOne) SetAccountID(id uuid.UUID) *DonationUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *DonationUpdateOne) SetAccount(v *Account) *DonationUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_u *DonationUpdateOne) SetPmiLocationID(id uuid.UUID) *DonationUpdateOne {
+	_u.mutation.SetPmiLocationID(id)
+	return _u
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_u *DonationUpdateOne) SetPmiLocation(v *PMILocation) *DonationUpdateOne {
+	return _u.SetPmiLocationID(v.ID)
+}
+
+// SetAppointmentID sets the "appointment" edge to the Appointment entity by ID.
+func (_u *DonationUpdateOne) SetAppointmentID(id uuid.UUID) *DonationUpdateOne {
+	_u.mutation.SetAppointmentID(id)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment" edge to the Appointment entity by ID if the given value is not nil.
+func (_u *DonationUpdateOne) SetNillableAppointmentID(id *uuid.UUID) *DonationUpdateOne {
+	if id != nil {
+		_u = _u.SetAppointmentID(*id)
+	}
+	return _u
+}
+
+// SetAppointment sets the "appointment" edge to the Appointment entity.
+func (_u *DonationUpdateOne) SetAppointment(v *Appointment) *DonationUpdateOne {
+	return _u.SetAppointmentID(v.ID)
+}
+
+// SetRecordedByID sets the "recorded_by" edge to the Account entity by ID.
+func (_u *DonationUpdateOne) SetRecordedByID(id uuid.UUID) *DonationUpdateOne {
+	_u.mutation.SetRecordedByID(id)
+	return _u
+}
+
+// SetRecordedBy sets the "recorded_by" edge to the Account entity.
+func (_u *DonationUpdateOne) SetRecordedBy(v *Account) *DonationUpdateOne {
+	return _u.SetRecordedByID(v.ID)
+}
+
+// SetReminderID sets the "reminder" edge to the DonationReminder entity by ID.
+func (_u *DonationUpdateOne) SetReminderID(id uuid.UUID) *DonationUpdateOne {
+	_u.mutation.SetReminderID(id)
+	return _u
+}
+
+// SetNillableReminderID sets the "reminder" edge to the DonationReminder entity by ID if the given value is not nil.
+func (_u *DonationUpdateOne) SetNillableReminderID(id *uuid.UUID) *DonationUpdateOne {
+	if id != nil {
+		_u = _u.SetReminderID(*id)
+	}
+	return _u
+}
+
+// SetReminder sets the "reminder" edge to the DonationReminder entity.
+func (_u *DonationUpdateOne) SetReminder(v *DonationReminder) *DonationUpdateOne {
+	return _u.SetReminderID(v.ID)
+}
+
+// Mutation returns the DonationMutation object of the builder.
+func (_u *DonationUpdateOne) Mutation() *DonationMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *DonationUpdateOne) ClearAccount() *DonationUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearPmiLocation clears the "pmi_location" edge to the PMILocation entity.
+func (_u *DonationUpdateOne) ClearPmiLocation() *DonationUpdateOne {
+	_u.mutation.ClearPmiLocation()
+	return _u
+}
+
+// ClearAppointment clears the "appointment" edge to the Appointment entity.
+func (_u *DonationUpdateOne) ClearAppointment() *DonationUpdateOne {
+	_u.mutation.ClearAppointment()
+	return _u
+}
+
+// ClearRecordedBy clears the "recorded_by" edge to the Account entity.
+func (_u *DonationUpdateOne) ClearRecordedBy() *DonationUpdateOne {
+	_u.mutation.ClearRecordedBy()
+	return _u
+}
+
+// ClearReminder clears the "reminder" edge to the DonationReminder entity.
+func (_u *DonationUpdateOne) ClearReminder() *DonationUpdateOne {
+	_u.mutation.ClearReminder()
+	return _u
+}
+
+// Where appends a list predicates to the DonationUpdate builder.
+func (_u *DonationUpdateOne) Where(ps ...predicate.Donation) *DonationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DonationUpdateOne) Select(field string, fields ...string) *DonationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Donation entity.
+func (_u *DonationUpdateOne) Save(ctx context.Context) (*Donation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DonationUpdateOne) SaveX(ctx context.Context) *Donation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DonationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DonationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DonationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := donation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DonationUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := donation.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Donation.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := donation.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Donation.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DonatedAt(); ok {
+		if err := donation.DonatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "donated_at", err: fmt.Errorf(`ent: validator failed for field "Donation.donated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := donation.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Donation.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VolumeMl(); ok {
+		if err := donation.VolumeMlValidator(v); err != nil {
+			return &ValidationError{Name: "volume_ml", err: fmt.Errorf(`ent: validator failed for field "Donation.volume_ml": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BagNumber(); ok {
+		if err := donation.BagNumberValidator(v); err != nil {
+			return &ValidationError{Name: "bag_number", err: fmt.Errorf(`ent: validator failed for field "Donation.bag_number": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Donation.account"`)
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Donation.pmi_location"`)
+	}
+	if _u.mutation.RecordedByCleared() && len(_u.mutation.RecordedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Donation.recorded_by"`)
+	}
+	return nil
+}
+
+func (_u *DonationUpdateOne) sqlSave(ctx context.Context) (_node *Donation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(donation.Table, donation.Columns, sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Donation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, donation.FieldID)
+		for _, f := range fields {
+			if !donation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != donation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(donation.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(donation.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(donation.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(donation.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(donation.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(donation.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DonatedAt(); ok {
+		_spec.SetField(donation.FieldDonatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDonatedAt(); ok {
+		_spec.AddField(donation.FieldDonatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(donation.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VolumeMl(); ok {
+		_spec.SetField(donation.FieldVolumeMl, field.TypeInt16, value)
+	}
+	if value, ok := _u.mutation.AddedVolumeMl(); ok {
+		_spec.AddField(donation.FieldVolumeMl, field.TypeInt16, value)
+	}
+	if value, ok := _u.mutation.BagNumber(); ok {
+		_spec.SetField(donation.FieldBagNumber, field.TypeString, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.AccountTable,
+			Columns: []string{donation.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.AccountTable,
+			Columns: []string{donation.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PmiLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.PmiLocationTable,
+			Columns: []string{donation.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.PmiLocationTable,
+			Columns: []string{donation.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AppointmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   donation.AppointmentTable,
+			Columns: []string{donation.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppointmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   donation.AppointmentTable,
+			Columns: []string{donation.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecordedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.RecordedByTable,
+			Columns: []string{donation.RecordedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecordedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donation.RecordedByTable,
+			Columns: []string{donation.RecordedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReminderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   donation.ReminderTable,
+			Columns: []string{donation.ReminderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReminderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   donation.ReminderTable,
+			Columns: []string{donation.ReminderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Donation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{donation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/schema"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+)
+
+// DonationEvent is the model entity for the DonationEvent schema.
+type DonationEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description"`
+	// Organization hosting the event, e.g. a company, campus or mosque.
+	HostName string `json:"host_name"`
+	// HostType holds the value of the "host_type" field.
+	HostType donationevent.HostType `json:"host_type"`
+	// Venue holds the value of the "venue" field.
+	Venue string `json:"venue"`
+	// Street holds the value of the "street" field.
+	Street string `json:"street"`
+	// LatLng holds the value of the "lat_lng" field.
+	LatLng *schema.GeoPoint `json:"lat_lng"`
+	// Start of the event in milliseconds.
+	StartsAt int64 `json:"starts_at"`
+	// End of the event in milliseconds.
+	EndsAt int64 `json:"ends_at"`
+	// Maximum number of donors the mobile unit can serve.
+	Capacity int `json:"capacity"`
+	// Only APPROVED events are listed publicly.
+	Status donationevent.Status `json:"status"`
+	// ReviewNote holds the value of the "review_note" field.
+	ReviewNote string `json:"review_note"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DonationEventQuery when eager-loading is set.
+	Edges           DonationEventEdges `json:"edges"`
+	subdistrict_id  *uuid.UUID
+	pmi_location_id *uuid.UUID
+	organizer_id    *uuid.UUID
+	reviewed_by_id  *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// DonationEventEdges holds the relations/edges for other nodes in the graph.
+type DonationEventEdges struct {
+	// Subdistrict holds the value of the subdistrict edge.
+	Subdistrict *Subdistrict `json:"subdistrict,omitempty"`
+	// PMI location running the mobile unit and approving the event.
+	PmiLocation *PMILocation `json:"pmi_location,omitempty"`
+	// Organizer holds the value of the organizer edge.
+	Organizer *Account `json:"organizer,omitempty"`
+	// ReviewedBy holds the value of the reviewed_by edge.
+	ReviewedBy *Account `json:"reviewed_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// SubdistrictOrErr returns the Subdistrict value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationEventEdges) SubdistrictOrErr() (*Subdistrict, error) {
+	if e.Subdistrict != nil {
+		return e.Subdistrict, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: subdistrict.Label}
+	}
+	return nil, &NotLoadedError{edge: "subdistrict"}
+}
+
+// PmiLocationOrErr returns the PmiLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationEventEdges) PmiLocationOrErr() (*PMILocation, error) {
+	if e.PmiLocation != nil {
+		return e.PmiLocation, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: pmilocation.Label}
+	}
+	return nil, &NotLoadedError{edge: "pmi_location"}
+}
+
+// OrganizerOrErr returns the Organizer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationEventEdges) OrganizerOrErr() (*Account, error) {
+	if e.Organizer != nil {
+		return e.Organizer, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "organizer"}
+}
+
+// ReviewedByOrErr returns the ReviewedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationEventEdges) ReviewedByOrErr() (*Account, error) {
+	if e.ReviewedBy != nil {
+		return e.ReviewedBy, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "reviewed_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DonationEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case donationevent.FieldLatLng:
+			values[i] = new(schema.GeoPoint)
+		case donationevent.FieldCreatedAt, donationevent.FieldUpdatedAt, donationevent.FieldDeletedAt, donationevent.FieldStartsAt, donationevent.FieldEndsAt, donationevent.FieldCapacity:
+			values[i] = new(sql.NullInt64)
+		case donationevent.FieldTitle, donationevent.FieldDescription, donationevent.FieldHostName, donationevent.FieldHostType, donationevent.FieldVenue, donationevent.FieldStreet, donationevent.FieldStatus, donationevent.FieldReviewNote:
+			values[i] = new(sql.NullString)
+		case donationevent.FieldID:
+			values[i] = new(uuid.UUID)
+		case donationevent.ForeignKeys[0]: // subdistrict_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case donationevent.ForeignKeys[1]: // pmi_location_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case donationevent.ForeignKeys[2]: // organizer_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case donationevent.ForeignKeys[3]: // reviewed_by_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DonationEvent fields.
+func (_m *DonationEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case donationevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case donationevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case donationevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case donationevent.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case donationevent.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case donationevent.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case donationevent.FieldHostName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host_name", values[i])
+			} else if value.Valid {
+				_m.HostName = value.String
+			}
+		case donationevent.FieldHostType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host_type", values[i])
+			} else if value.Valid {
+				_m.HostType = donationevent.HostType(value.String)
+			}
+		case donationevent.FieldVenue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field venue", values[i])
+			} else if value.Valid {
+				_m.Venue = value.String
+			}
+		case donationevent.FieldStreet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field street", values[i])
+			} else if value.Valid {
+				_m.Street = value.String
+			}
+		case donationevent.FieldLatLng:
+			if value, ok := values[i].(*schema.GeoPoint); !ok {
+				return fmt.Errorf("unexpected type %T for field lat_lng", values[i])
+			} else if value != nil {
+				_m.LatLng = value
+			}
+		case donationevent.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				_m.StartsAt = value.Int64
+			}
+		case donationevent.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				_m.EndsAt = value.Int64
+			}
+		case donationevent.FieldCapacity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field capacity", values[i])
+			} else if value.Valid {
+				_m.Capacity = int(value.Int64)
+			}
+		case donationevent.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = donationevent.Status(value.String)
+			}
+		case donationevent.FieldReviewNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_note", values[i])
+			} else if value.Valid {
+				_m.ReviewNote = value.String
+			}
+		case donationevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field subdistrict_id", values[i])
+			} else if value.Valid {
+				_m.subdistrict_id = new(uuid.UUID)
+				*_m.subdistrict_id = *value.S.(*uuid.UUID)
+			}
+		case donationevent.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field pmi_location_id", values[i])
+			} else if value.Valid {
+				_m.pmi_location_id = new(uuid.UUID)
+				*_m.pmi_location_id = *value.S.(*uuid.UUID)
+			}
+		case donationevent.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field organizer_id", values[i])
+			} else if value.Valid {
+				_m.organizer_id = new(uuid.UUID)
+				*_m.organizer_id = *value.S.(*uuid.UUID)
+			}
+		case donationevent.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by_id", values[i])
+			} else if value.Valid {
+				_m.reviewed_by_id = new(uuid.UUID)
+				*_m.reviewed_by_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DonationEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *DonationEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySubdistrict queries the "subdistrict" edge of the DonationEvent entity.
+func (_m *DonationEvent) QuerySubdistrict() *SubdistrictQuery {
+	return NewDonationEventClient(_m.config).QuerySubdistrict(_m)
+}
+
+// QueryPmiLocation queries the "pmi_location" edge of the DonationEvent entity.
+func (_m *DonationEvent) QueryPmiLocation() *PMILocationQuery {
+	return NewDonationEventClient(_m.config).QueryPmiLocation(_m)
+}
+
+// QueryOrganizer queries the "organizer" edge of the DonationEvent entity.
+func (_m *DonationEvent) QueryOrganizer() *AccountQuery {
+	return NewDonationEventClient(_m.config).QueryOrganizer(_m)
+}
+
+// QueryReviewedBy queries the "reviewed_by" edge of the DonationEvent entity.
+func (_m *DonationEvent) QueryReviewedBy() *AccountQuery {
+	return NewDonationEventClient(_m.config).QueryReviewedBy(_m)
+}
+
+// Update returns a builder for updating this DonationEvent.
+// Note that you need to call DonationEvent.Unwrap() before calling this method if this DonationEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DonationEvent) Update() *DonationEventUpdateOne {
+	return NewDonationEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DonationEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DonationEvent) Unwrap() *DonationEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DonationEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DonationEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("DonationEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("host_name=")
+	builder.WriteString(_m.HostName)
+	builder.WriteString(", ")
+	builder.WriteString("host_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HostType))
+	builder.WriteString(", ")
+	builder.WriteString("venue=")
+	builder.WriteString(_m.Venue)
+	builder.WriteString(", ")
+	builder.WriteString("street=")
+	builder.WriteString(_m.Street)
+	builder.WriteString(", ")
+	builder.WriteString("lat_lng=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LatLng))
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartsAt))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndsAt))
+	builder.WriteString(", ")
+	builder.WriteString("capacity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Capacity))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("review_note=")
+	builder.WriteString(_m.ReviewNote)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DonationEvents is a parsable slice of DonationEvent.
+type DonationEvents []*DonationEvent
@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package donationevent
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the donationevent type in the database.
+	Label = "donation_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldHostName holds the string denoting the host_name field in the database.
+	FieldHostName = "host_name"
+	// FieldHostType holds the string denoting the host_type field in the database.
+	FieldHostType = "host_type"
+	// FieldVenue holds the string denoting the venue field in the database.
+	FieldVenue = "venue"
+	// FieldStreet holds the string denoting the street field in the database.
+	FieldStreet = "street"
+	// FieldLatLng holds the string denoting the lat_lng field in the database.
+	FieldLatLng = "lat_lng"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldCapacity holds the string denoting the capacity field in the database.
+	FieldCapacity = "capacity"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReviewNote holds the string denoting the review_note field in the database.
+	FieldReviewNote = "review_note"
+	// EdgeSubdistrict holds the string denoting the subdistrict edge name in mutations.
+	EdgeSubdistrict = "subdistrict"
+	// EdgePmiLocation holds the string denoting the pmi_location edge name in mutations.
+	EdgePmiLocation = "pmi_location"
+	// EdgeOrganizer holds the string denoting the organizer edge name in mutations.
+	EdgeOrganizer = "organizer"
+	// EdgeReviewedBy holds the string denoting the reviewed_by edge name in mutations.
+	EdgeReviewedBy = "reviewed_by"
+	// Table holds the table name of the donationevent in the database.
+	Table = "donation_events"
+	// SubdistrictTable is the table that holds the subdistrict relation/edge.
+	SubdistrictTable = "donation_events"
+	// SubdistrictInverseTable is the table name for the Subdistrict entity.
+	// It exists in this package in order to avoid circular dependency with the "subdistrict" package.
+	SubdistrictInverseTable = "subdistricts"
+	// SubdistrictColumn is the table column denoting the subdistrict relation/edge.
+	SubdistrictColumn = "subdistrict_id"
+	// PmiLocationTable is the table that holds the pmi_location relation/edge.
+	PmiLocationTable = "donation_events"
+	// PmiLocationInverseTable is the table name for the PMILocation entity.
+	// It exists in this package in order to avoid circular dependency with the "pmilocation" package.
+	PmiLocationInverseTable = "pmi_locations"
+	// PmiLocationColumn is the table column denoting the pmi_location relation/edge.
+	PmiLocationColumn = "pmi_location_id"
+	// OrganizerTable is the table that holds the organizer relation/edge.
+	OrganizerTable = "donation_events"
+	// OrganizerInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	OrganizerInverseTable = "accounts"
+	// OrganizerColumn is the table column denoting the organizer relation/edge.
+	OrganizerColumn = "organizer_id"
+	// ReviewedByTable is the table that holds the reviewed_by relation/edge.
+	ReviewedByTable = "donation_events"
+	// ReviewedByInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	ReviewedByInverseTable = "accounts"
+	// ReviewedByColumn is the table column denoting the reviewed_by relation/edge.
+	ReviewedByColumn = "reviewed_by_id"
+)
+
+// Columns holds all SQL columns for donationevent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldTitle,
+	FieldDescription,
+	FieldHostName,
+	FieldHostType,
+	FieldVenue,
+	FieldStreet,
+	FieldLatLng,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldCapacity,
+	FieldStatus,
+	FieldReviewNote,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "donation_events"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"subdistrict_id",
+	"pmi_location_id",
+	"organizer_id",
+	"reviewed_by_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// HostNameValidator is a validator for the "host_name" field. It is called by the builders before save.
+	HostNameValidator func(string) error
+	// VenueValidator is a validator for the "venue" field. It is called by the builders before save.
+	VenueValidator func(string) error
+	// StreetValidator is a validator for the "street" field. It is called by the builders before save.
+	StreetValidator func(string) error
+	// StartsAtValidator is a validator for the "starts_at" field. It is called by the builders before save.
+	StartsAtValidator func(int64) error
+	// EndsAtValidator is a validator for the "ends_at" field. It is called by the builders before save.
+	EndsAtValidator func(int64) error
+	// CapacityValidator is a validator for the "capacity" field. It is called by the builders before save.
+	CapacityValidator func(int) error
+	// ReviewNoteValidator is a validator for the "review_note" field. It is called by the builders before save.
+	ReviewNoteValidator func(string) error
+)
+
+// HostType defines the type for the "host_type" enum field.
+type HostType string
+
+// HostType values.
+const (
+	HostTypeOffice       HostType = "OFFICE"
+	HostTypeCampus       HostType = "CAMPUS"
+	HostTypeWorshipPlace HostType = "WORSHIP_PLACE"
+	HostTypeCommunity    HostType = "COMMUNITY"
+	HostTypeOther        HostType = "OTHER"
+)
+
+func (ht HostType) String() string {
+	return string(ht)
+}
+
+// HostTypeValidator is a validator for the "host_type" field enum values. It is called by the builders before save.
+func HostTypeValidator(ht HostType) error {
+	switch ht {
+	case HostTypeOffice, HostTypeCampus, HostTypeWorshipPlace, HostTypeCommunity, HostTypeOther:
+		return nil
+	default:
+		return fmt.Errorf("donationevent: invalid enum value for host_type field: %q", ht)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "PENDING"
+	StatusApproved  Status = "APPROVED"
+	StatusRejected  Status = "REJECTED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApproved, StatusRejected, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("donationevent: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the DonationEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByHostName orders the results by the host_name field.
+func ByHostName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostName, opts...).ToFunc()
+}
+
+// ByHostType orders the results by the host_type field.
+func ByHostType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostType, opts...).ToFunc()
+}
+
+// ByVenue orders the results by the venue field.
+func ByVenue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVenue, opts...).ToFunc()
+}
+
+// ByStreet orders the results by the street field.
+func ByStreet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStreet, opts...).ToFunc()
+}
+
+// ByLatLng orders the results by the lat_lng field.
+func ByLatLng(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatLng, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByCapacity orders the results by the capacity field.
+func ByCapacity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCapacity, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReviewNote orders the results by the review_note field.
+func ByReviewNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewNote, opts...).ToFunc()
+}
+
+// BySubdistrictField orders the results by subdistrict field.
+func BySubdistrictField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubdistrictStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPmiLocationField orders the results by pmi_location field.
+func ByPmiLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPmiLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOrganizerField orders the results by organizer field.
+func ByOrganizerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReviewedByField orders the results by reviewed_by field.
+func ByReviewedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSubdistrictStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubdistrictInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SubdistrictTable, SubdistrictColumn),
+	)
+}
+func newPmiLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PmiLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+	)
+}
+func newOrganizerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, OrganizerTable, OrganizerColumn),
+	)
+}
+func newReviewedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ReviewedByTable, ReviewedByColumn),
+	)
+}
@@ -0,0 +1,978 @@
+// Code generated by ent, DO NOT EDIT.
+
+package donationevent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/schema"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldDescription, v))
+}
+
+// HostName applies equality check predicate on the "host_name" field. It's identical to HostNameEQ.
+func HostName(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldHostName, v))
+}
+
+// Venue applies equality check predicate on the "venue" field. It's identical to VenueEQ.
+func Venue(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldVenue, v))
+}
+
+// Street applies equality check predicate on the "street" field. It's identical to StreetEQ.
+func Street(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldStreet, v))
+}
+
+// LatLng applies equality check predicate on the "lat_lng" field. It's identical to LatLngEQ.
+func LatLng(v *schema.GeoPoint) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldLatLng, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// Capacity applies equality check predicate on the "capacity" field. It's identical to CapacityEQ.
+func Capacity(v int) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldCapacity, v))
+}
+
+// ReviewNote applies equality check predicate on the "review_note" field. It's identical to ReviewNoteEQ.
+func ReviewNote(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// HostNameEQ applies the EQ predicate on the "host_name" field.
+func HostNameEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldHostName, v))
+}
+
+// HostNameNEQ applies the NEQ predicate on the "host_name" field.
+func HostNameNEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldHostName, v))
+}
+
+// HostNameIn applies the In predicate on the "host_name" field.
+func HostNameIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldHostName, vs...))
+}
+
+// HostNameNotIn applies the NotIn predicate on the "host_name" field.
+func HostNameNotIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldHostName, vs...))
+}
+
+// HostNameGT applies the GT predicate on the "host_name" field.
+func HostNameGT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldHostName, v))
+}
+
+// HostNameGTE applies the GTE predicate on the "host_name" field.
+func HostNameGTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldHostName, v))
+}
+
+// HostNameLT applies the LT predicate on the "host_name" field.
+func HostNameLT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldHostName, v))
+}
+
+// HostNameLTE applies the LTE predicate on the "host_name" field.
+func HostNameLTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldHostName, v))
+}
+
+// HostNameContains applies the Contains predicate on the "host_name" field.
+func HostNameContains(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContains(FieldHostName, v))
+}
+
+// HostNameHasPrefix applies the HasPrefix predicate on the "host_name" field.
+func HostNameHasPrefix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasPrefix(FieldHostName, v))
+}
+
+// HostNameHasSuffix applies the HasSuffix predicate on the "host_name" field.
+func HostNameHasSuffix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasSuffix(FieldHostName, v))
+}
+
+// HostNameEqualFold applies the EqualFold predicate on the "host_name" field.
+func HostNameEqualFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEqualFold(FieldHostName, v))
+}
+
+// HostNameContainsFold applies the ContainsFold predicate on the "host_name" field.
+func HostNameContainsFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContainsFold(FieldHostName, v))
+}
+
+// HostTypeEQ applies the EQ predicate on the "host_type" field.
+func HostTypeEQ(v HostType) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldHostType, v))
+}
+
+// HostTypeNEQ applies the NEQ predicate on the "host_type" field.
+func HostTypeNEQ(v HostType) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldHostType, v))
+}
+
+// HostTypeIn applies the In predicate on the "host_type" field.
+func HostTypeIn(vs ...HostType) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldHostType, vs...))
+}
+
+// HostTypeNotIn applies the NotIn predicate on the "host_type" field.
+func HostTypeNotIn(vs ...HostType) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldHostType, vs...))
+}
+
+// VenueEQ applies the EQ predicate on the "venue" field.
+func VenueEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldVenue, v))
+}
+
+// VenueNEQ applies the NEQ predicate on the "venue" field.
+func VenueNEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldVenue, v))
+}
+
+// VenueIn applies the In predicate on the "venue" field.
+func VenueIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldVenue, vs...))
+}
+
+// VenueNotIn applies the NotIn predicate on the "venue" field.
+func VenueNotIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldVenue, vs...))
+}
+
+// VenueGT applies the GT predicate on the "venue" field.
+func VenueGT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldVenue, v))
+}
+
+// VenueGTE applies the GTE predicate on the "venue" field.
+func VenueGTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldVenue, v))
+}
+
+// VenueLT applies the LT predicate on the "venue" field.
+func VenueLT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldVenue, v))
+}
+
+// VenueLTE applies the LTE predicate on the "venue" field.
+func VenueLTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldVenue, v))
+}
+
+// VenueContains applies the Contains predicate on the "venue" field.
+func VenueContains(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContains(FieldVenue, v))
+}
+
+// VenueHasPrefix applies the HasPrefix predicate on the "venue" field.
+func VenueHasPrefix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasPrefix(FieldVenue, v))
+}
+
+// VenueHasSuffix applies the HasSuffix predicate on the "venue" field.
+func VenueHasSuffix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasSuffix(FieldVenue, v))
+}
+
+// VenueEqualFold applies the EqualFold predicate on the "venue" field.
+func VenueEqualFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEqualFold(FieldVenue, v))
+}
+
+// VenueContainsFold applies the ContainsFold predicate on the "venue" field.
+func VenueContainsFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContainsFold(FieldVenue, v))
+}
+
+// StreetEQ applies the EQ predicate on the "street" field.
+func StreetEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldStreet, v))
+}
+
+// StreetNEQ applies the NEQ predicate on the "street" field.
+func StreetNEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldStreet, v))
+}
+
+// StreetIn applies the In predicate on the "street" field.
+func StreetIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldStreet, vs...))
+}
+
+// StreetNotIn applies the NotIn predicate on the "street" field.
+func StreetNotIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldStreet, vs...))
+}
+
+// StreetGT applies the GT predicate on the "street" field.
+func StreetGT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldStreet, v))
+}
+
+// StreetGTE applies the GTE predicate on the "street" field.
+func StreetGTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldStreet, v))
+}
+
+// StreetLT applies the LT predicate on the "street" field.
+func StreetLT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldStreet, v))
+}
+
+// StreetLTE applies the LTE predicate on the "street" field.
+func StreetLTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldStreet, v))
+}
+
+// StreetContains applies the Contains predicate on the "street" field.
+func StreetContains(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContains(FieldStreet, v))
+}
+
+// StreetHasPrefix applies the HasPrefix predicate on the "street" field.
+func StreetHasPrefix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasPrefix(FieldStreet, v))
+}
+
+// StreetHasSuffix applies the HasSuffix predicate on the "street" field.
+func StreetHasSuffix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasSuffix(FieldStreet, v))
+}
+
+// StreetEqualFold applies the EqualFold predicate on the "street" field.
+func StreetEqualFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEqualFold(FieldStreet, v))
+}
+
+// StreetContainsFold applies the ContainsFold predicate on the "street" field.
+func StreetContainsFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContainsFold(FieldStreet, v))
+}
+
+// LatLngEQ applies the EQ predicate on the "lat_lng" field.
+func LatLngEQ(v *schema.GeoPoint) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldLatLng, v))
+}
+
+// LatLngNEQ applies the NEQ predicate on the "lat_lng" field.
+func LatLngNEQ(v *schema.GeoPoint) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldLatLng, v))
+}
+
+// LatLngIn applies the In predicate on the "lat_lng" field.
+func LatLngIn(vs ...*schema.GeoPoint) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldLatLng, vs...))
+}
+
+// LatLngNotIn applies the NotIn predicate on the "lat_lng" field.
+func LatLngNotIn(vs ...*schema.GeoPoint) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldLatLng, vs...))
+}
+
+// LatLngGT applies the GT predicate on the "lat_lng" field.
+func LatLngGT(v *schema.GeoPoint) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldLatLng, v))
+}
+
+// LatLngGTE applies the GTE predicate on the "lat_lng" field.
+func LatLngGTE(v *schema.GeoPoint) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldLatLng, v))
+}
+
+// LatLngLT applies the LT predicate on the "lat_lng" field.
+func LatLngLT(v *schema.GeoPoint) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldLatLng, v))
+}
+
+// LatLngLTE applies the LTE predicate on the "lat_lng" field.
+func LatLngLTE(v *schema.GeoPoint) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldLatLng, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v int64) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// CapacityEQ applies the EQ predicate on the "capacity" field.
+func CapacityEQ(v int) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldCapacity, v))
+}
+
+// CapacityNEQ applies the NEQ predicate on the "capacity" field.
+func CapacityNEQ(v int) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldCapacity, v))
+}
+
+// CapacityIn applies the In predicate on the "capacity" field.
+func CapacityIn(vs ...int) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldCapacity, vs...))
+}
+
+// CapacityNotIn applies the NotIn predicate on the "capacity" field.
+func CapacityNotIn(vs ...int) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldCapacity, vs...))
+}
+
+// CapacityGT applies the GT predicate on the "capacity" field.
+func CapacityGT(v int) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldCapacity, v))
+}
+
+// CapacityGTE applies the GTE predicate on the "capacity" field.
+func CapacityGTE(v int) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldCapacity, v))
+}
+
+// CapacityLT applies the LT predicate on the "capacity" field.
+func CapacityLT(v int) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldCapacity, v))
+}
+
+// CapacityLTE applies the LTE predicate on the "capacity" field.
+func CapacityLTE(v int) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldCapacity, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReviewNoteEQ applies the EQ predicate on the "review_note" field.
+func ReviewNoteEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteNEQ applies the NEQ predicate on the "review_note" field.
+func ReviewNoteNEQ(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteIn applies the In predicate on the "review_note" field.
+func ReviewNoteIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteNotIn applies the NotIn predicate on the "review_note" field.
+func ReviewNoteNotIn(vs ...string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteGT applies the GT predicate on the "review_note" field.
+func ReviewNoteGT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGT(FieldReviewNote, v))
+}
+
+// ReviewNoteGTE applies the GTE predicate on the "review_note" field.
+func ReviewNoteGTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldGTE(FieldReviewNote, v))
+}
+
+// ReviewNoteLT applies the LT predicate on the "review_note" field.
+func ReviewNoteLT(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLT(FieldReviewNote, v))
+}
+
+// ReviewNoteLTE applies the LTE predicate on the "review_note" field.
+func ReviewNoteLTE(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldLTE(FieldReviewNote, v))
+}
+
+// ReviewNoteContains applies the Contains predicate on the "review_note" field.
+func ReviewNoteContains(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContains(FieldReviewNote, v))
+}
+
+// ReviewNoteHasPrefix applies the HasPrefix predicate on the "review_note" field.
+func ReviewNoteHasPrefix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasPrefix(FieldReviewNote, v))
+}
+
+// ReviewNoteHasSuffix applies the HasSuffix predicate on the "review_note" field.
+func ReviewNoteHasSuffix(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldHasSuffix(FieldReviewNote, v))
+}
+
+// ReviewNoteIsNil applies the IsNil predicate on the "review_note" field.
+func ReviewNoteIsNil() predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldIsNull(FieldReviewNote))
+}
+
+// ReviewNoteNotNil applies the NotNil predicate on the "review_note" field.
+func ReviewNoteNotNil() predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldNotNull(FieldReviewNote))
+}
+
+// ReviewNoteEqualFold applies the EqualFold predicate on the "review_note" field.
+func ReviewNoteEqualFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldEqualFold(FieldReviewNote, v))
+}
+
+// ReviewNoteContainsFold applies the ContainsFold predicate on the "review_note" field.
+func ReviewNoteContainsFold(v string) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.FieldContainsFold(FieldReviewNote, v))
+}
+
+// HasSubdistrict applies the HasEdge predicate on the "subdistrict" edge.
+func HasSubdistrict() predicate.DonationEvent {
+	return predicate.DonationEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SubdistrictTable, SubdistrictColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubdistrictWith applies the HasEdge predicate on the "subdistrict" edge with a given conditions (other predicates).
+func HasSubdistrictWith(preds ...predicate.Subdistrict) predicate.DonationEvent {
+	return predicate.DonationEvent(func(s *sql.Selector) {
+		step := newSubdistrictStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPmiLocation applies the HasEdge predicate on the "pmi_location" edge.
+func HasPmiLocation() predicate.DonationEvent {
+	return predicate.DonationEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPmiLocationWith applies the HasEdge predicate on the "pmi_location" edge with a given conditions (other predicates).
+func HasPmiLocationWith(preds ...predicate.PMILocation) predicate.DonationEvent {
+	return predicate.DonationEvent(func(s *sql.Selector) {
+		step := newPmiLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrganizer applies the HasEdge predicate on the "organizer" edge.
+func HasOrganizer() predicate.DonationEvent {
+	return predicate.DonationEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizerTable, OrganizerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizerWith applies the HasEdge predicate on the "organizer" edge with a given conditions (other predicates).
+func HasOrganizerWith(preds ...predicate.Account) predicate.DonationEvent {
+	return predicate.DonationEvent(func(s *sql.Selector) {
+		step := newOrganizerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewedBy applies the HasEdge predicate on the "reviewed_by" edge.
+func HasReviewedBy() predicate.DonationEvent {
+	return predicate.DonationEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ReviewedByTable, ReviewedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewedByWith applies the HasEdge predicate on the "reviewed_by" edge with a given conditions (other predicates).
+func HasReviewedByWith(preds ...predicate.Account) predicate.DonationEvent {
+	return predicate.DonationEvent(func(s *sql.Selector) {
+		step := newReviewedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DonationEvent) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DonationEvent) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DonationEvent) predicate.DonationEvent {
+	return predicate.DonationEvent(sql.NotPredicates(p))
+}
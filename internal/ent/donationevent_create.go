@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/schema"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+)
+
+// DonationEventCreate is the builder for creating a DonationEvent entity.
+type DonationEventCreate struct {
+	config
+	mutation *DonationEventMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DonationEventCreate) SetCreatedAt(v int64) *DonationEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DonationEventCreate) SetUpdatedAt(v int64) *DonationEventCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DonationEventCreate) SetNillableUpdatedAt(v *int64) *DonationEventCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *DonationEventCreate) SetDeletedAt(v int64) *DonationEventCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *DonationEventCreate) SetNillableDeletedAt(v *int64) *DonationEventCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *DonationEventCreate) SetTitle(v string) *DonationEventCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *DonationEventCreate) SetDescription(v string) *DonationEventCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *DonationEventCreate) SetNillableDescription(v *string) *DonationEventCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetHostName sets the "host_name" field.
+func (_c *DonationEventCreate) SetHostName(v string) *DonationEventCreate {
+	_c.mutation.SetHostName(v)
+	return _c
+}
+
+// SetHostType sets the "host_type" field.
+func (_c *DonationEventCreate) SetHostType(v donationevent.HostType) *DonationEventCreate {
+	_c.mutation.SetHostType(v)
+	return _c
+}
+
+// SetVenue sets the "venue" field.
+func (_c *DonationEventCreate) SetVenue(v string) *DonationEventCreate {
+	_c.mutation.SetVenue(v)
+	return _c
+}
+
+// SetStreet sets the "street" field.
+func (_c *DonationEventCreate) SetStreet(v string) *DonationEventCreate {
+	_c.mutation.SetStreet(v)
+	return _c
+}
+
+// SetLatLng sets the "lat_lng" field.
+func (_c *DonationEventCreate) SetLatLng(v *schema.GeoPoint) *DonationEventCreate {
+	_c.mutation.SetLatLng(v)
+	return _c
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_c *DonationEventCreate) SetStartsAt(v int64) *DonationEventCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_c *DonationEventCreate) SetEndsAt(v int64) *DonationEventCreate {
+	_c.mutation.SetEndsAt(v)
+	return _c
+}
+
+// SetCapacity sets the "capacity" field.
+func (_c *DonationEventCreate) SetCapacity(v int) *DonationEventCreate {
+	_c.mutation.SetCapacity(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DonationEventCreate) SetStatus(v donationevent.Status) *DonationEventCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *DonationEventCreate) SetNillableStatus(v *donationevent.Status) *DonationEventCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_c *DonationEventCreate) SetReviewNote(v string) *DonationEventCreate {
+	_c.mutation.SetReviewNote(v)
+	return _c
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_c *DonationEventCreate) SetNillableReviewNote(v *string) *DonationEventCreate {
+	if v != nil {
+		_c.SetReviewNote(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DonationEventCreate) SetID(v uuid.UUID) *DonationEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetSubdistrictID sets the "subdistrict" edge to the Subdistrict entity by ID.
+func (_c *DonationEventCreate) SetSubdistrictID(id uuid.UUID) *DonationEventCreate {
+	_c.mutation.SetSubdistrictID(id)
+	return _c
+}
+
+// SetSubdistrict sets the "subdistrict" edge to the Subdistrict entity.
+func (_c *DonationEventCreate) SetSubdistrict(v *Subdistrict) *DonationEventCreate {
+	return _c.SetSubdistrictID(v.ID)
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_c *DonationEventCreate) SetPmiLocationID(id uuid.UUID) *DonationEventCreate {
+	_c.mutation.SetPmiLocationID(id)
+	return _c
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_c *DonationEventCreate) SetPmiLocation(v *PMILocation) *DonationEventCreate {
+	return _c.SetPmiLocationID(v.ID)
+}
+
+// SetOrganizerID sets the "organizer" edge to the Account entity by ID.
+func (_c *DonationEventCreate) SetOrganizerID(id uuid.UUID) *DonationEventCreate {
+	_c.mutation.SetOrganizerID(id)
+	return _c
+}
+
+// SetOrganizer sets the "organizer" edge to the Account entity.
+func (_c *DonationEventCreate) SetOrganizer(v *Account) *DonationEventCreate {
+	return _c.SetOrganizerID(v.ID)
+}
+
+// SetReviewedByID sets the "reviewed_by" edge to the Account entity by ID.
+func (_c *DonationEventCreate) SetReviewedByID(id uuid.UUID) *DonationEventCreate {
+	_c.mutation.SetReviewedByID(id)
+	return _c
+}
+
+// SetNillableReviewedByID sets the "reviewed_by" edge to the Account entity by ID if the given value is not nil.
+func (_c *DonationEventCreate) SetNillableReviewedByID(id *uuid.UUID) *DonationEventCreate {
+	if id != nil {
+		_c = _c.SetReviewedByID(*id)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the Account entity.
+func (_c *DonationEventCreate) SetReviewedBy(v *Account) *DonationEventCreate {
+	return _c.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the DonationEventMutation object of the builder.
+func (_c *DonationEventCreate) Mutation() *DonationEventMutation {
+	return _c.mutation
+}
+
+// Save creates the DonationEvent in the database.
+func (_c *DonationEventCreate) Save(ctx context.Context) (*DonationEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DonationEventCreate) SaveX(ctx context.Context) *DonationEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DonationEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DonationEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DonationEventCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := donationevent.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DonationEventCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := donationevent.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := donationevent.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := donationevent.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "DonationEvent.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := donationevent.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HostName(); !ok {
+		return &ValidationError{Name: "host_name", err: errors.New(`ent: missing required field "DonationEvent.host_name"`)}
+	}
+	if v, ok := _c.mutation.HostName(); ok {
+		if err := donationevent.HostNameValidator(v); err != nil {
+			return &ValidationError{Name: "host_name", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.host_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HostType(); !ok {
+		return &ValidationError{Name: "host_type", err: errors.New(`ent: missing required field "DonationEvent.host_type"`)}
+	}
+	if v, ok := _c.mutation.HostType(); ok {
+		if err := donationevent.HostTypeValidator(v); err != nil {
+			return &ValidationError{Name: "host_type", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.host_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Venue(); !ok {
+		return &ValidationError{Name: "venue", err: errors.New(`ent: missing required field "DonationEvent.venue"`)}
+	}
+	if v, ok := _c.mutation.Venue(); ok {
+		if err := donationevent.VenueValidator(v); err != nil {
+			return &ValidationError{Name: "venue", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.venue": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Street(); !ok {
+		return &ValidationError{Name: "street", err: errors.New(`ent: missing required field "DonationEvent.street"`)}
+	}
+	if v, ok := _c.mutation.Street(); ok {
+		if err := donationevent.StreetValidator(v); err != nil {
+			return &ValidationError{Name: "street", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.street": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LatLng(); !ok {
+		return &ValidationError{Name: "lat_lng", err: errors.New(`ent: missing required field "DonationEvent.lat_lng"`)}
+	}
+	if _, ok := _c.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "DonationEvent.starts_at"`)}
+	}
+	if v, ok := _c.mutation.StartsAt(); ok {
+		if err := donationevent.StartsAtValidator(v); err != nil {
+			return &ValidationError{Name: "starts_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.starts_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "DonationEvent.ends_at"`)}
+	}
+	if v, ok := _c.mutation.EndsAt(); ok {
+		if err := donationevent.EndsAtValidator(v); err != nil {
+			return &ValidationError{Name: "ends_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.ends_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Capacity(); !ok {
+		return &ValidationError{Name: "capacity", err: errors.New(`ent: missing required field "DonationEvent.capacity"`)}
+	}
+	if v, ok := _c.mutation.Capacity(); ok {
+		if err := donationevent.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.capacity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DonationEvent.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := donationevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ReviewNote(); ok {
+		if err := donationevent.ReviewNoteValidator(v); err != nil {
+			return &ValidationError{Name: "review_note", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.review_note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.SubdistrictIDs()) == 0 {
+		return &ValidationError{Name: "subdistrict", err: errors.New(`ent: missing required edge "DonationEvent.subdistrict"`)}
+	}
+	if len(_c.mutation.PmiLocationIDs()) == 0 {
+		return &ValidationError{Name: "pmi_location", err: errors.New(`ent: missing required edge "DonationEvent.pmi_location"`)}
+	}
+	if len(_c.mutation.OrganizerIDs()) == 0 {
+		return &ValidationError{Name: "organizer", err: errors.New(`ent: missing required edge "DonationEvent.organizer"`)}
+	}
+	return nil
+}
+
+func (_c *DonationEventCreate) sqlSave(ctx context.Context) (*DonationEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DonationEventCreate) createSpec() (*DonationEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DonationEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(donationevent.Table, sqlgraph.NewFieldSpec(donationevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(donationevent.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(donationevent.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(donationevent.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(donationevent.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(donationevent.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.HostName(); ok {
+		_spec.SetField(donationevent.FieldHostName, field.TypeString, value)
+		_node.HostName = value
+	}
+	if value, ok := _c.mutation.HostType(); ok {
+		_spec.SetField(donationevent.FieldHostType, field.TypeEnum, value)
+		_node.HostType = value
+	}
+	if value, ok := _c.mutation.Venue(); ok {
+		_spec.SetField(donationevent.FieldVenue, field.TypeString, value)
+		_node.Venue = value
+	}
+	if value, ok := _c.mutation.Street(); ok {
+		_spec.SetField(donationevent.FieldStreet, field.TypeString, value)
+		_node.Street = value
+	}
+	if value, ok := _c.mutation.LatLng(); ok {
+		_spec.SetField(donationevent.FieldLatLng, field.TypeOther, value)
+		_node.LatLng = value
+	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(donationevent.FieldStartsAt, field.TypeInt64, value)
+		_node.StartsAt = value
+	}
+	if value, ok := _c.mutation.EndsAt(); ok {
+		_spec.SetField(donationevent.FieldEndsAt, field.TypeInt64, value)
+		_node.EndsAt = value
+	}
+	if value, ok := _c.mutation.Capacity(); ok {
+		_spec.SetField(donationevent.FieldCapacity, field.TypeInt, value)
+		_node.Capacity = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(donationevent.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ReviewNote(); ok {
+		_spec.SetField(donationevent.FieldReviewNote, field.TypeString, value)
+		_node.ReviewNote = value
+	}
+	if nodes := _c.mutation.SubdistrictIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.SubdistrictTable,
+			Columns: []string{donationevent.SubdistrictColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subdistrict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.subdistrict_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.PmiLocationTable,
+			Columns: []string{donationevent.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pmi_location_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrganizerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.OrganizerTable,
+			Columns: []string{donationevent.OrganizerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.organizer_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.ReviewedByTable,
+			Columns: []string{donationevent.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.reviewed_by_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DonationEventCreateBulk is the builder for creating many DonationEvent entities in bulk.
+type DonationEventCreateBulk struct {
+	config
+	err      error
+	builders []*DonationEventCreate
+}
+
+// Save creates the DonationEvent entities in the database.
+func (_c *DonationEventCreateBulk) Save(ctx context.Context) ([]*DonationEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DonationEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DonationEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DonationEventCreateBulk) SaveX(ctx context.Context) []*DonationEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DonationEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DonationEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/schema"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+)
+
+// DonationEventUpdate is the builder for updating DonationEvent entities.
+type DonationEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DonationEventMutation
+}
+
+// Where appends a list predicates to the DonationEventUpdate builder.
+func (_u *DonationEventUpdate) Where(ps ...predicate.DonationEvent) *DonationEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DonationEventUpdate) SetUpdatedAt(v int64) *DonationEventUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *DonationEventUpdate) AddUpdatedAt(v int64) *DonationEventUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *DonationEventUpdate) ClearUpdatedAt() *DonationEventUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *DonationEventUpdate) SetDeletedAt(v int64) *DonationEventUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableDeletedAt(v *int64) *DonationEventUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *DonationEventUpdate) AddDeletedAt(v int64) *DonationEventUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *DonationEventUpdate) ClearDeletedAt() *DonationEventUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *DonationEventUpdate) SetTitle(v string) *DonationEventUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableTitle(v *string) *DonationEventUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *DonationEventUpdate) SetDescription(v string) *DonationEventUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableDescription(v *string) *DonationEventUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *DonationEventUpdate) ClearDescription() *DonationEventUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetHostName sets the "host_name" field.
+func (_u *DonationEventUpdate) SetHostName(v string) *DonationEventUpdate {
+	_u.mutation.SetHostName(v)
+	return _u
+}
+
+// SetNillableHostName sets the "host_name" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableHostName(v *string) *DonationEventUpdate {
+	if v != nil {
+		_u.SetHostName(*v)
+	}
+	return _u
+}
+
+// SetHostType sets the "host_type" field.
+func (_u *DonationEventUpdate) SetHostType(v donationevent.HostType) *DonationEventUpdate {
+	_u.mutation.SetHostType(v)
+	return _u
+}
+
+// SetNillableHostType sets the "host_type" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableHostType(v *donationevent.HostType) *DonationEventUpdate {
+	if v != nil {
+		_u.SetHostType(*v)
+	}
+	return _u
+}
+
+// SetVenue sets the "venue" field.
+func (_u *DonationEventUpdate) SetVenue(v string) *DonationEventUpdate {
+	_u.mutation.SetVenue(v)
+	return _u
+}
+
+// SetNillableVenue sets the "venue" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableVenue(v *string) *DonationEventUpdate {
+	if v != nil {
+		_u.SetVenue(*v)
+	}
+	return _u
+}
+
+// SetStreet sets the "street" field.
+func (_u *DonationEventUpdate) SetStreet(v string) *DonationEventUpdate {
+	_u.mutation.SetStreet(v)
+	return _u
+}
+
+// SetNillableStreet sets the "street" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableStreet(v *string) *DonationEventUpdate {
+	if v != nil {
+		_u.SetStreet(*v)
+	}
+	return _u
+}
+
+// SetLatLng sets the "lat_lng" field.
+func (_u *DonationEventUpdate) SetLatLng(v *schema.GeoPoint) *DonationEventUpdate {
+	_u.mutation.SetLatLng(v)
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *DonationEventUpdate) SetStartsAt(v int64) *DonationEventUpdate {
+	_u.mutation.ResetStartsAt()
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableStartsAt(v *int64) *DonationEventUpdate {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// AddStartsAt adds value to the "starts_at" field.
+func (_u *DonationEventUpdate) AddStartsAt(v int64) *DonationEventUpdate {
+	_u.mutation.AddStartsAt(v)
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *DonationEventUpdate) SetEndsAt(v int64) *DonationEventUpdate {
+	_u.mutation.ResetEndsAt()
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableEndsAt(v *int64) *DonationEventUpdate {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// AddEndsAt adds value to the "ends_at" field.
+func (_u *DonationEventUpdate) AddEndsAt(v int64) *DonationEventUpdate {
+	_u.mutation.AddEndsAt(v)
+	return _u
+}
+
+// SetCapacity sets the "capacity" field.
+func (_u *DonationEventUpdate) SetCapacity(v int) *DonationEventUpdate {
+	_u.mutation.ResetCapacity()
+	_u.mutation.SetCapacity(v)
+	return _u
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableCapacity(v *int) *DonationEventUpdate {
+	if v != nil {
+		_u.SetCapacity(*v)
+	}
+	return _u
+}
+
+// AddCapacity adds value to the "capacity" field.
+func (_u *DonationEventUpdate) AddCapacity(v int) *DonationEventUpdate {
+	_u.mutation.AddCapacity(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DonationEventUpdate) SetStatus(v donationevent.Status) *DonationEventUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableStatus(v *donationevent.Status) *DonationEventUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_u *DonationEventUpdate) SetReviewNote(v string) *DonationEventUpdate {
+	_u.mutation.SetReviewNote(v)
+	return _u
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableReviewNote(v *string) *DonationEventUpdate {
+	if v != nil {
+		_u.SetReviewNote(*v)
+	}
+	return _u
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (_u *DonationEventUpdate) ClearReviewNote() *DonationEventUpdate {
+	_u.mutation.ClearReviewNote()
+	return _u
+}
+
+// SetSubdistrictID sets the "subdistrict" edge to the Subdistrict entity by ID.
+func (_u *DonationEventUpdate) SetSubdistrictID(id uuid.UUID) *DonationEventUpdate {
+	_u.mutation.SetSubdistrictID(id)
+	return _u
+}
+
+// SetSubdistrict sets the "subdistrict" edge to the Subdistrict entity.
+func (_u *DonationEventUpdate) SetSubdistrict(v *Subdistrict) *DonationEventUpdate {
+	return _u.SetSubdistrictID(v.ID)
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_u *DonationEventUpdate) SetPmiLocationID(id uuid.UUID) *DonationEventUpdate {
+	_u.mutation.SetPmiLocationID(id)
+	return _u
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_u *DonationEventUpdate) SetPmiLocation(v *PMILocation) *DonationEventUpdate {
+	return _u.SetPmiLocationID(v.ID)
+}
+
+// SetReviewedByID sets the "reviewed_by" edge to the Account entity by ID.
+func (_u *DonationEventUpdate) SetReviewedByID(id uuid.UUID) *DonationEventUpdate {
+	_u.mutation.SetReviewedByID(id)
+	return _u
+}
+
+// SetNillableReviewedByID sets the "reviewed_by" edge to the Account entity by ID if the given value is not nil.
+func (_u *DonationEventUpdate) SetNillableReviewedByID(id *uuid.UUID) *DonationEventUpdate {
+	if id != nil {
+		_u = _u.SetReviewedByID(*id)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the Account entity.
+func (_u *DonationEventUpdate) SetReviewedBy(v *Account) *DonationEventUpdate {
+	return _u.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the DonationEventMutation object of the builder.
+func (_u *DonationEventUpdate) Mutation() *DonationEventMutation {
+	return _u.mutation
+}
+
+// ClearSubdistrict clears the "subdistrict" edge to the Subdistrict entity.
+func (_u *DonationEventUpdate) ClearSubdistrict() *DonationEventUpdate {
+	_u.mutation.ClearSubdistrict()
+	return _u
+}
+
+// ClearPmiLocation clears the "pmi_location" edge to the PMILocation entity.
+func (_u *DonationEventUpdate) ClearPmiLocation() *DonationEventUpdate {
+	_u.mutation.ClearPmiLocation()
+	return _u
+}
+
+// ClearReviewedBy clears the "reviewed_by" edge to the Account entity.
+func (_u *DonationEventUpdate) ClearReviewedBy() *DonationEventUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DonationEventUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DonationEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DonationEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DonationEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DonationEventUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := donationevent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DonationEventUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := donationevent.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := donationevent.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := donationevent.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HostName(); ok {
+		if err := donationevent.HostNameValidator(v); err != nil {
+			return &ValidationError{Name: "host_name", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.host_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HostType(); ok {
+		if err := donationevent.HostTypeValidator(v); err != nil {
+			return &ValidationError{Name: "host_type", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.host_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Venue(); ok {
+		if err := donationevent.VenueValidator(v); err != nil {
+			return &ValidationError{Name: "venue", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.venue": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Street(); ok {
+		if err := donationevent.StreetValidator(v); err != nil {
+			return &ValidationError{Name: "street", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.street": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StartsAt(); ok {
+		if err := donationevent.StartsAtValidator(v); err != nil {
+			return &ValidationError{Name: "starts_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.starts_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndsAt(); ok {
+		if err := donationevent.EndsAtValidator(v); err != nil {
+			return &ValidationError{Name: "ends_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.ends_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Capacity(); ok {
+		if err := donationevent.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.capacity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := donationevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReviewNote(); ok {
+		if err := donationevent.ReviewNoteValidator(v); err != nil {
+			return &ValidationError{Name: "review_note", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.review_note": %w`, err)}
+		}
+	}
+	if _u.mutation.SubdistrictCleared() && len(_u.mutation.SubdistrictIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DonationEvent.subdistrict"`)
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DonationEvent.pmi_location"`)
+	}
+	if _u.mutation.OrganizerCleared() && len(_u.mutation.OrganizerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DonationEvent.organizer"`)
+	}
+	return nil
+}
+
+func (_u *DonationEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(donationevent.Table, donationevent.Columns, sqlgraph.NewFieldSpec(donationevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(donationevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(donationevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(donationevent.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(donationevent.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(donationevent.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(donationevent.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(donationevent.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(donationevent.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(donationevent.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostName(); ok {
+		_spec.SetField(donationevent.FieldHostName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HostType(); ok {
+		_spec.SetField(donationevent.FieldHostType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Venue(); ok {
+		_spec.SetField(donationevent.FieldVenue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Street(); ok {
+		_spec.SetField(donationevent.FieldStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LatLng(); ok {
+		_spec.SetField(donationevent.FieldLatLng, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(donationevent.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStartsAt(); ok {
+		_spec.AddField(donationevent.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(donationevent.FieldEndsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEndsAt(); ok {
+		_spec.AddField(donationevent.FieldEndsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Capacity(); ok {
+		_spec.SetField(donationevent.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCapacity(); ok {
+		_spec.AddField(donationevent.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(donationevent.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewNote(); ok {
+		_spec.SetField(donationevent.FieldReviewNote, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNoteCleared() {
+		_spec.ClearField(donationevent.FieldReviewNote, field.TypeString)
+	}
+	if _u.mutation.SubdistrictCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.SubdistrictTable,
+			Columns: []string{donationevent.SubdistrictColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subdistrict.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubdistrictIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.SubdistrictTable,
+			Columns: []string{donationevent.SubdistrictColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subdistrict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PmiLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.PmiLocationTable,
+			Columns: []string{donationevent.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.PmiLocationTable,
+			Columns: []string{donationevent.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.ReviewedByTable,
+			Columns: []string{donationevent.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.ReviewedByTable,
+			Columns: []string{donationevent.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{donationevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DonationEventUpdateOne is the builder for updating a single DonationEvent entity.
+type DonationEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DonationEventMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DonationEventUpdateOne) SetUpdatedAt(v int64) *DonationEventUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *DonationEventUpdateOne) AddUpdatedAt(v int64) *DonationEventUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *DonationEventUpdateOne) ClearUpdatedAt() *DonationEventUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *DonationEventUpdateOne) SetDeletedAt(v int64) *DonationEventUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableDeletedAt(v *int64) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *DonationEventUpdateOne) AddDeletedAt(v int64) *DonationEventUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *DonationEventUpdateOne) ClearDeletedAt() *DonationEventUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *DonationEventUpdateOne) SetTitle(v string) *DonationEventUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableTitle(v *string) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *DonationEventUpdateOne) SetDescription(v string) *DonationEventUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableDescription(v *string) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *DonationEventUpdateOne) ClearDescription() *DonationEventUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetHostName sets the "host_name" field.
+func (_u *DonationEventUpdateOne) SetHostName(v string) *DonationEventUpdateOne {
+	_u.mutation.SetHostName(v)
+	return _u
+}
+
+// SetNillableHostName sets the "host_name" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableHostName(v *string) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetHostName(*v)
+	}
+	return _u
+}
+
+// SetHostType sets the "host_type" field.
+func (_u *DonationEventUpdateOne) SetHostType(v donationevent.HostType) *DonationEventUpdateOne {
+	_u.mutation.SetHostType(v)
+	return _u
+}
+
+// SetNillableHostType sets the "host_type" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableHostType(v *donationevent.HostType) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetHostType(*v)
+	}
+	return _u
+}
+
+// SetVenue sets the "venue" field.
+func (_u *DonationEventUpdateOne) SetVenue(v string) *DonationEventUpdateOne {
+	_u.mutation.SetVenue(v)
+	return _u
+}
+
+// SetNillableVenue sets the "venue" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableVenue(v *string) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetVenue(*v)
+	}
+	return _u
+}
+
+// SetStreet sets the "street" field.
+func (_u *DonationEventUpdateOne) SetStreet(v string) *DonationEventUpdateOne {
+	_u.mutation.SetStreet(v)
+	return _u
+}
+
+// SetNillableStreet sets the "street" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableStreet(v *string) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetStreet(*v)
+	}
+	return _u
+}
+
+// SetLatLng sets the "lat_lng" field.
+func (_u *DonationEventUpdateOne) SetLatLng(v *schema.GeoPoint) *DonationEventUpdateOne {
+	_u.mutation.SetLatLng(v)
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *DonationEventUpdateOne) SetStartsAt(v int64) *DonationEventUpdateOne {
+	_u.mutation.ResetStartsAt()
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableStartsAt(v *int64) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// AddStartsAt adds value to the "starts_at" field.
+func (_u *DonationEventUpdateOne) AddStartsAt(v int64) *DonationEventUpdateOne {
+	_u.mutation.AddStartsAt(v)
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *DonationEventUpdateOne) SetEndsAt(v int64) *DonationEventUpdateOne {
+	_u.mutation.ResetEndsAt()
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableEndsAt(v *int64) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// AddEndsAt adds value to the "ends_at" field.
+func (_u *DonationEventUpdateOne) AddEndsAt(v int64) *DonationEventUpdateOne {
+	_u.mutation.AddEndsAt(v)
+	return _u
+}
+
+// SetCapacity sets the "capacity" field.
+func (_u *DonationEventUpdateOne) SetCapacity(v int) *DonationEventUpdateOne {
+	_u.mutation.ResetCapacity()
+	_u.mutation.SetCapacity(v)
+	return _u
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableCapacity(v *int) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetCapacity(*v)
+	}
+	return _u
+}
+
+// AddCapacity adds value to the "capacity" field.
+func (_u *DonationEventUpdateOne) AddCapacity(v int) *DonationEventUpdateOne {
+	_u.mutation.AddCapacity(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DonationEventUpdateOne) SetStatus(v donationevent.Status) *DonationEventUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableStatus(v *donationevent.Status) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_u *DonationEventUpdateOne) SetReviewNote(v string) *DonationEventUpdateOne {
+	_u.mutation.SetReviewNote(v)
+	return _u
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableReviewNote(v *string) *DonationEventUpdateOne {
+	if v != nil {
+		_u.SetReviewNote(*v)
+	}
+	return _u
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (_u *DonationEventUpdateOne) ClearReviewNote() *DonationEventUpdateOne {
+	_u.mutation.ClearReviewNote()
+	return _u
+}
+
+// SetSubdistrictID sets the "subdistrict" edge to the Subdistrict entity by ID.
+func (_u *DonationEventUpdateOne) SetSubdistrictID(id uuid.UUID) *DonationEventUpdateOne {
+	_u.mutation.SetSubdistrictID(id)
+	return _u
+}
+
+// SetSubdistrict sets the "subdistrict" edge to the Subdistrict entity.
+func (_u *DonationEventUpdateOne) SetSubdistrict(v *Subdistrict) *DonationEventUpdateOne {
+	return _u.SetSubdistrictID(v.ID)
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_u *DonationEventUpdateOne) SetPmiLocationID(id uuid.UUID) *DonationEventUpdateOne {
+	_u.mutation.SetPmiLocationID(id)
+	return _u
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_u *DonationEventUpdateOne) SetPmiLocation(v *PMILocation) *DonationEventUpdateOne {
+	return _u.SetPmiLocationID(v.ID)
+}
+
+// SetReviewedByID sets the "reviewed_by" edge to the Account entity by ID.
+func (_u *DonationEventUpdateOne) SetReviewedByID(id uuid.UUID) *DonationEventUpdateOne {
+	_u.mutation.SetReviewedByID(id)
+	return _u
+}
+
+// SetNillableReviewedByID sets the "reviewed_by" edge to the Account entity by ID if the given value is not nil.
+func (_u *DonationEventUpdateOne) SetNillableReviewedByID(id *uuid.UUID) *DonationEventUpdateOne {
+	if id != nil {
+		_u = _u.SetReviewedByID(*id)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the Account entity.
+func (_u *DonationEventUpdateOne) SetReviewedBy(v *Account) *DonationEventUpdateOne {
+	return _u.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the DonationEventMutation object of the builder.
+func (_u *DonationEventUpdateOne) Mutation() *DonationEventMutation {
+	return _u.mutation
+}
+
+// ClearSubdistrict clears the "subdistrict" edge to the Subdistrict entity.
+func (_u *DonationEventUpdateOne) ClearSubdistrict() *DonationEventUpdateOne {
+	_u.mutation.ClearSubdistrict()
+	return _u
+}
+
+// ClearPmiLocation clears the "pmi_location" edge to the PMILocation entity.
+func (_u *DonationEventUpdateOne) ClearPmiLocation() *DonationEventUpdateOne {
+	_u.mutation.ClearPmiLocation()
+	return _u
+}
+
+// ClearReviewedBy clears the "reviewed_by" edge to the Account entity.
+func (_u *DonationEventUpdateOne) ClearReviewedBy() *DonationEventUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// Where appends a list predicates to the DonationEventUpdate builder.
+func (_u *DonationEventUpdateOne) Where(ps ...predicate.DonationEvent) *DonationEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DonationEventUpdateOne) Select(field string, fields ...string) *DonationEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DonationEvent entity.
+func (_u *DonationEventUpdateOne) Save(ctx context.Context) (*DonationEvent, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DonationEventUpdateOne) SaveX(ctx context.Context) *DonationEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DonationEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DonationEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DonationEventUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := donationevent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DonationEventUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := donationevent.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := donationevent.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := donationevent.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HostName(); ok {
+		if err := donationevent.HostNameValidator(v); err != nil {
+			return &ValidationError{Name: "host_name", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.host_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HostType(); ok {
+		if err := donationevent.HostTypeValidator(v); err != nil {
+			return &ValidationError{Name: "host_type", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.host_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Venue(); ok {
+		if err := donationevent.VenueValidator(v); err != nil {
+			return &ValidationError{Name: "venue", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.venue": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Street(); ok {
+		if err := donationevent.StreetValidator(v); err != nil {
+			return &ValidationError{Name: "street", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.street": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StartsAt(); ok {
+		if err := donationevent.StartsAtValidator(v); err != nil {
+			return &ValidationError{Name: "starts_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.starts_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndsAt(); ok {
+		if err := donationevent.EndsAtValidator(v); err != nil {
+			return &ValidationError{Name: "ends_at", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.ends_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Capacity(); ok {
+		if err := donationevent.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.capacity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := donationevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReviewNote(); ok {
+		if err := donationevent.ReviewNoteValidator(v); err != nil {
+			return &ValidationError{Name: "review_note", err: fmt.Errorf(`ent: validator failed for field "DonationEvent.review_note": %w`, err)}
+		}
+	}
+	if _u.mutation.SubdistrictCleared() && len(_u.mutation.SubdistrictIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DonationEvent.subdistrict"`)
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DonationEvent.pmi_location"`)
+	}
+	if _u.mutation.OrganizerCleared() && len(_u.mutation.OrganizerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DonationEvent.organizer"`)
+	}
+	return nil
+}
+
+func (_u *DonationEventUpdateOne) sqlSave(ctx context.Context) (_node *DonationEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(donationevent.Table, donationevent.Columns, sqlgraph.NewFieldSpec(donationevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DonationEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, donationevent.FieldID)
+		for _, f := range fields {
+			if !donationevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != donationevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(donationevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(donationevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(donationevent.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(donationevent.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(donationevent.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(donationevent.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(donationevent.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(donationevent.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(donationevent.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostName(); ok {
+		_spec.SetField(donationevent.FieldHostName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HostType(); ok {
+		_spec.SetField(donationevent.FieldHostType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Venue(); ok {
+		_spec.SetField(donationevent.FieldVenue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Street(); ok {
+		_spec.SetField(donationevent.FieldStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LatLng(); ok {
+		_spec.SetField(donationevent.FieldLatLng, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(donationevent.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStartsAt(); ok {
+		_spec.AddField(donationevent.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(donationevent.FieldEndsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEndsAt(); ok {
+		_spec.AddField(donationevent.FieldEndsAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Capacity(); ok {
+		_spec.SetField(donationevent.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCapacity(); ok {
+		_spec.AddField(donationevent.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(donationevent.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewNote(); ok {
+		_spec.SetField(donationevent.FieldReviewNote, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNoteCleared() {
+		_spec.ClearField(donationevent.FieldReviewNote, field.TypeString)
+	}
+	if _u.mutation.SubdistrictCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.SubdistrictTable,
+			Columns: []string{donationevent.SubdistrictColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subdistrict.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubdistrictIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.SubdistrictTable,
+			Columns: []string{donationevent.SubdistrictColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subdistrict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PmiLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.PmiLocationTable,
+			Columns: []string{donationevent.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.PmiLocationTable,
+			Columns: []string{donationevent.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.ReviewedByTable,
+			Columns: []string{donationevent.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationevent.ReviewedByTable,
+			Columns: []string{donationevent.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DonationEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{donationevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/donationreminder"
+)
+
+// DonationReminder is the model entity for the DonationReminder schema.
+type DonationReminder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Time the donor becomes eligible again in milliseconds.
+	EligibleAt int64 `json:"eligible_at"`
+	// Time the reminder was sent in milliseconds.
+	SentAt int64 `json:"sent_at"`
+	// Channels the reminder was delivered through.
+	Channels []string `json:"channels"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DonationReminderQuery when eager-loading is set.
+	Edges        DonationReminderEdges `json:"edges"`
+	account_id   *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// DonationReminderEdges holds the relations/edges for other nodes in the graph.
+type DonationReminderEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Donation holds the value of the donation edge.
+	Donation *Donation `json:"donation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationReminderEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// DonationOrErr returns the Donation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DonationReminderEdges) DonationOrErr() (*Donation, error) {
+	if e.Donation != nil {
+		return e.Donation, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: donation.Label}
+	}
+	return nil, &NotLoadedError{edge: "donation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DonationReminder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case donationreminder.FieldChannels:
+			values[i] = new([]byte)
+		case donationreminder.FieldCreatedAt, donationreminder.FieldUpdatedAt, donationreminder.FieldDeletedAt, donationreminder.FieldEligibleAt, donationreminder.FieldSentAt:
+			values[i] = new(sql.NullInt64)
+		case donationreminder.FieldID:
+			values[i] = new(uuid.UUID)
+		case donationreminder.ForeignKeys[0]: // account_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DonationReminder fields.
+func (_m *DonationReminder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case donationreminder.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case donationreminder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case donationreminder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case donationreminder.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case donationreminder.FieldEligibleAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field eligible_at", values[i])
+			} else if value.Valid {
+				_m.EligibleAt = value.Int64
+			}
+		case donationreminder.FieldSentAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = value.Int64
+			}
+		case donationreminder.FieldChannels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field channels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Channels); err != nil {
+					return fmt.Errorf("unmarshal field channels: %w", err)
+				}
+			}
+		case donationreminder.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.account_id = new(uuid.UUID)
+				*_m.account_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DonationReminder.
+// This includes values selected through modifiers, order, etc.
+func (_m *DonationReminder) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the DonationReminder entity.
+func (_m *DonationReminder) QueryAccount() *AccountQuery {
+	return NewDonationReminderClient(_m.config).QueryAccount(_m)
+}
+
+// QueryDonation queries the "donation" edge of the DonationReminder entity.
+func (_m *DonationReminder) QueryDonation() *DonationQuery {
+	return NewDonationReminderClient(_m.config).QueryDonation(_m)
+}
+
+// Update returns a builder for updating this DonationReminder.
+// Note that you need to call DonationReminder.Unwrap() before calling this method if this DonationReminder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DonationReminder) Update() *DonationReminderUpdateOne {
+	return NewDonationReminderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DonationReminder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DonationReminder) Unwrap() *DonationReminder {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DonationReminder is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DonationReminder) String() string {
+	var builder strings.Builder
+	builder.WriteString("DonationReminder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("eligible_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EligibleAt))
+	builder.WriteString(", ")
+	builder.WriteString("sent_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SentAt))
+	builder.WriteString(", ")
+	builder.WriteString("channels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Channels))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DonationReminders is a parsable slice of DonationReminder.
+type DonationReminders []*DonationReminder
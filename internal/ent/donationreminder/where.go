@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package donationreminder
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// EligibleAt applies equality check predicate on the "eligible_at" field. It's identical to EligibleAtEQ.
+func EligibleAt(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldEligibleAt, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldSentAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// EligibleAtEQ applies the EQ predicate on the "eligible_at" field.
+func EligibleAtEQ(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldEligibleAt, v))
+}
+
+// EligibleAtNEQ applies the NEQ predicate on the "eligible_at" field.
+func EligibleAtNEQ(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNEQ(FieldEligibleAt, v))
+}
+
+// EligibleAtIn applies the In predicate on the "eligible_at" field.
+func EligibleAtIn(vs ...int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldIn(FieldEligibleAt, vs...))
+}
+
+// EligibleAtNotIn applies the NotIn predicate on the "eligible_at" field.
+func EligibleAtNotIn(vs ...int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNotIn(FieldEligibleAt, vs...))
+}
+
+// EligibleAtGT applies the GT predicate on the "eligible_at" field.
+func EligibleAtGT(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGT(FieldEligibleAt, v))
+}
+
+// EligibleAtGTE applies the GTE predicate on the "eligible_at" field.
+func EligibleAtGTE(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGTE(FieldEligibleAt, v))
+}
+
+// EligibleAtLT applies the LT predicate on the "eligible_at" field.
+func EligibleAtLT(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLT(FieldEligibleAt, v))
+}
+
+// EligibleAtLTE applies the LTE predicate on the "eligible_at" field.
+func EligibleAtLTE(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLTE(FieldEligibleAt, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v int64) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.FieldLTE(FieldSentAt, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.DonationReminder {
+	return predicate.DonationReminder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.DonationReminder {
+	return predicate.DonationReminder(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDonation applies the HasEdge predicate on the "donation" edge.
+func HasDonation() predicate.DonationReminder {
+	return predicate.DonationReminder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, DonationTable, DonationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDonationWith applies the HasEdge predicate on the "donation" edge with a given conditions (other predicates).
+func HasDonationWith(preds ...predicate.Donation) predicate.DonationReminder {
+	return predicate.DonationReminder(func(s *sql.Selector) {
+		step := newDonationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DonationReminder) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DonationReminder) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DonationReminder) predicate.DonationReminder {
+	return predicate.DonationReminder(sql.NotPredicates(p))
+}
@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/donationreminder"
+)
+
+// DonationReminderCreate is the builder for creating a DonationReminder entity.
+type DonationReminderCreate struct {
+	config
+	mutation *DonationReminderMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DonationReminderCreate) SetCreatedAt(v int64) *DonationReminderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DonationReminderCreate) SetUpdatedAt(v int64) *DonationReminderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DonationReminderCreate) SetNillableUpdatedAt(v *int64) *DonationReminderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *DonationReminderCreate) SetDeletedAt(v int64) *DonationReminderCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *DonationReminderCreate) SetNillableDeletedAt(v *int64) *DonationReminderCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetEligibleAt sets the "eligible_at" field.
+func (_c *DonationReminderCreate) SetEligibleAt(v int64) *DonationReminderCreate {
+	_c.mutation.SetEligibleAt(v)
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *DonationReminderCreate) SetSentAt(v int64) *DonationReminderCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetChannels sets the "channels" field.
+func (_c *DonationReminderCreate) SetChannels(v []string) *DonationReminderCreate {
+	_c.mutation.SetChannels(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DonationReminderCreate) SetID(v uuid.UUID) *DonationReminderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *DonationReminderCreate) SetAccountID(id uuid.UUID) *DonationReminderCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *DonationReminderCreate) SetAccount(v *Account) *DonationReminderCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetDonationID sets the "donation" edge to the Donation entity by ID.
+func (_c *DonationReminderCreate) SetDonationID(id uuid.UUID) *DonationReminderCreate {
+	_c.mutation.SetDonationID(id)
+	return _c
+}
+
+// SetDonation sets the "donation" edge to the Donation entity.
+func (_c *DonationReminderCreate) SetDonation(v *Donation) *DonationReminderCreate {
+	return _c.SetDonationID(v.ID)
+}
+
+// Mutation returns the DonationReminderMutation object of the builder.
+func (_c *DonationReminderCreate) Mutation() *DonationReminderMutation {
+	return _c.mutation
+}
+
+// Save creates the DonationReminder in the database.
+func (_c *DonationReminderCreate) Save(ctx context.Context) (*DonationReminder, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DonationReminderCreate) SaveX(ctx context.Context) *DonationReminder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DonationReminderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DonationReminderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DonationReminderCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := donationreminder.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "DonationReminder.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := donationreminder.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "DonationReminder.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := donationreminder.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "DonationReminder.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EligibleAt(); !ok {
+		return &ValidationError{Name: "eligible_at", err: errors.New(`ent: missing required field "DonationReminder.eligible_at"`)}
+	}
+	if v, ok := _c.mutation.EligibleAt(); ok {
+		if err := donationreminder.EligibleAtValidator(v); err != nil {
+			return &ValidationError{Name: "eligible_at", err: fmt.Errorf(`ent: validator failed for field "DonationReminder.eligible_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SentAt(); !ok {
+		return &ValidationError{Name: "sent_at", err: errors.New(`ent: missing required field "DonationReminder.sent_at"`)}
+	}
+	if v, ok := _c.mutation.SentAt(); ok {
+		if err := donationreminder.SentAtValidator(v); err != nil {
+			return &ValidationError{Name: "sent_at", err: fmt.Errorf(`ent: validator failed for field "DonationReminder.sent_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Channels(); !ok {
+		return &ValidationError{Name: "channels", err: errors.New(`ent: missing required field "DonationReminder.channels"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "DonationReminder.account"`)}
+	}
+	if len(_c.mutation.DonationIDs()) == 0 {
+		return &ValidationError{Name: "donation", err: errors.New(`ent: missing required edge "DonationReminder.donation"`)}
+	}
+	return nil
+}
+
+func (_c *DonationReminderCreate) sqlSave(ctx context.Context) (*DonationReminder, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DonationReminderCreate) createSpec() (*DonationReminder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DonationReminder{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(donationreminder.Table, sqlgraph.NewFieldSpec(donationreminder.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(donationreminder.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(donationreminder.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(donationreminder.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.EligibleAt(); ok {
+		_spec.SetField(donationreminder.FieldEligibleAt, field.TypeInt64, value)
+		_node.EligibleAt = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(donationreminder.FieldSentAt, field.TypeInt64, value)
+		_node.SentAt = value
+	}
+	if value, ok := _c.mutation.Channels(); ok {
+		_spec.SetField(donationreminder.FieldChannels, field.TypeJSON, value)
+		_node.Channels = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   donationreminder.AccountTable,
+			Columns: []string{donationreminder.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DonationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   donationreminder.DonationTable,
+			Columns: []string{donationreminder.DonationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(donation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DonationReminderCreateBulk is the builder for creating many DonationReminder entities in bulk.
+type DonationReminderCreateBulk struct {
+	config
+	err      error
+	builders []*DonationReminderCreate
+}
+
+// Save creates the DonationReminder entities in the database.
+func (_c *DonationReminderCreateBulk) Save(ctx context.Context) ([]*DonationReminder, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DonationReminder, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DonationReminderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DonationReminderCreateBulk) SaveX(ctx context.Context) []*DonationReminder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DonationReminderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DonationReminderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
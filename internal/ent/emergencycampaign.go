@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+)
+
+// EmergencyCampaign is the model entity for the EmergencyCampaign schema.
+type EmergencyCampaign struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Component holds the value of the "component" field.
+	Component emergencycampaign.Component `json:"component"`
+	// NeededDonors holds the value of the "needed_donors" field.
+	NeededDonors int `json:"needed_donors"`
+	// Number of donors who accepted the call-out.
+	CommittedDonors int `json:"committed_donors"`
+	// Search radius around the PMI location in meters.
+	RadiusM int `json:"radius_m"`
+	// Number of donors notified per wave.
+	WaveSize int `json:"wave_size"`
+	// Number of waves sent so far.
+	Waves int `json:"waves"`
+	// Time the last wave was sent in milliseconds.
+	LastWaveAt int64 `json:"last_wave_at"`
+	// EXHAUSTED means no more eligible donors were found in the radius.
+	Status emergencycampaign.Status `json:"status"`
+	// Message sent to the donors with the call-out.
+	Message string `json:"message"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmergencyCampaignQuery when eager-loading is set.
+	Edges           EmergencyCampaignEdges `json:"edges"`
+	pmi_location_id *uuid.UUID
+	blood_type_id   *uuid.UUID
+	created_by_id   *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// EmergencyCampaignEdges holds the relations/edges for other nodes in the graph.
+type EmergencyCampaignEdges struct {
+	// PmiLocation holds the value of the pmi_location edge.
+	PmiLocation *PMILocation `json:"pmi_location,omitempty"`
+	// Blood type of the recipients the donors must be compatible with.
+	BloodType *BloodType `json:"blood_type,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *Account `json:"created_by,omitempty"`
+	// Contacts holds the value of the contacts edge.
+	Contacts []*EmergencyContact `json:"contacts,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PmiLocationOrErr returns the PmiLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmergencyCampaignEdges) PmiLocationOrErr() (*PMILocation, error) {
+	if e.PmiLocation != nil {
+		return e.PmiLocation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: pmilocation.Label}
+	}
+	return nil, &NotLoadedError{edge: "pmi_location"}
+}
+
+// BloodTypeOrErr returns the BloodType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmergencyCampaignEdges) BloodTypeOrErr() (*BloodType, error) {
+	if e.BloodType != nil {
+		return e.BloodType, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: bloodtype.Label}
+	}
+	return nil, &NotLoadedError{edge: "blood_type"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmergencyCampaignEdges) CreatedByOrErr() (*Account, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
+// ContactsOrErr returns the Contacts value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmergencyCampaignEdges) ContactsOrErr() ([]*EmergencyContact, error) {
+	if e.loadedTypes[3] {
+		return e.Contacts, nil
+	}
+	return nil, &NotLoadedError{edge: "contacts"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmergencyCampaign) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emergencycampaign.FieldCreatedAt, emergencycampaign.FieldUpdatedAt, emergencycampaign.FieldDeletedAt, emergencycampaign.FieldNeededDonors, emergencycampaign.FieldCommittedDonors, emergencycampaign.FieldRadiusM, emergencycampaign.FieldWaveSize, emergencycampaign.FieldWaves, emergencycampaign.FieldLastWaveAt:
+			values[i] = new(sql.NullInt64)
+		case emergencycampaign.FieldComponent, emergencycampaign.FieldStatus, emergencycampaign.FieldMessage:
+			values[i] = new(sql.NullString)
+		case emergencycampaign.FieldID:
+			values[i] = new(uuid.UUID)
+		case emergencycampaign.ForeignKeys[0]: // pmi_location_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case emergencycampaign.ForeignKeys[1]: // blood_type_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case emergencycampaign.ForeignKeys[2]: // created_by_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmergencyCampaign fields.
+func (_m *EmergencyCampaign) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emergencycampaign.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case emergencycampaign.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case emergencycampaign.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case emergencycampaign.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case emergencycampaign.FieldComponent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field component", values[i])
+			} else if value.Valid {
+				_m.Component = emergencycampaign.Component(value.String)
+			}
+		case emergencycampaign.FieldNeededDonors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field needed_donors", values[i])
+			} else if value.Valid {
+				_m.NeededDonors = int(value.Int64)
+			}
+		case emergencycampaign.FieldCommittedDonors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field committed_donors", values[i])
+			} else if value.Valid {
+				_m.CommittedDonors = int(value.Int64)
+			}
+		case emergencycampaign.FieldRadiusM:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field radius_m", values[i])
+			} else if value.Valid {
+				_m.RadiusM = int(value.Int64)
+			}
+		case emergencycampaign.FieldWaveSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wave_size", values[i])
+			} else if value.Valid {
+				_m.WaveSize = int(value.Int64)
+			}
+		case emergencycampaign.FieldWaves:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field waves", values[i])
+			} else if value.Valid {
+				_m.Waves = int(value.Int64)
+			}
+		case emergencycampaign.FieldLastWaveAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_wave_at", values[i])
+			} else if value.Valid {
+				_m.LastWaveAt = value.Int64
+			}
+		case emergencycampaign.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = emergencycampaign.Status(value.String)
+			}
+		case emergencycampaign.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case emergencycampaign.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field pmi_location_id", values[i])
+			} else if value.Valid {
+				_m.pmi_location_id = new(uuid.UUID)
+				*_m.pmi_location_id = *value.S.(*uuid.UUID)
+			}
+		case emergencycampaign.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field blood_type_id", values[i])
+			} else if value.Valid {
+				_m.blood_type_id = new(uuid.UUID)
+				*_m.blood_type_id = *value.S.(*uuid.UUID)
+			}
+		case emergencycampaign.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				_m.created_by_id = new(uuid.UUID)
+				*_m.created_by_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmergencyCampaign.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmergencyCampaign) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPmiLocation queries the "pmi_location" edge of the EmergencyCampaign entity.
+func (_m *EmergencyCampaign) QueryPmiLocation() *PMILocationQuery {
+	return NewEmergencyCampaignClient(_m.config).QueryPmiLocation(_m)
+}
+
+// QueryBloodType queries the "blood_type" edge of the EmergencyCampaign entity.
+func (_m *EmergencyCampaign) QueryBloodType() *BloodTypeQuery {
+	return NewEmergencyCampaignClient(_m.config).QueryBloodType(_m)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the EmergencyCampaign entity.
+func (_m *EmergencyCampaign) QueryCreatedBy() *AccountQuery {
+	return NewEmergencyCampaignClient(_m.config).QueryCreatedBy(_m)
+}
+
+// QueryContacts queries the "contacts" edge of the EmergencyCampaign entity.
+func (_m *EmergencyCampaign) QueryContacts() *EmergencyContactQuery {
+	return NewEmergencyCampaignClient(_m.config).QueryContacts(_m)
+}
+
+// Update returns a builder for updating this EmergencyCampaign.
+// Note that you need to call EmergencyCampaign.Unwrap() before calling this method if this EmergencyCampaign
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmergencyCampaign) Update() *EmergencyCampaignUpdateOne {
+	return NewEmergencyCampaignClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmergencyCampaign entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmergencyCampaign) Unwrap() *EmergencyCampaign {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmergencyCampaign is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmergencyCampaign) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmergencyCampaign(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("component=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Component))
+	builder.WriteString(", ")
+	builder.WriteString("needed_donors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NeededDonors))
+	builder.WriteString(", ")
+	builder.WriteString("committed_donors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CommittedDonors))
+	builder.WriteString(", ")
+	builder.WriteString("radius_m=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RadiusM))
+	builder.WriteString(", ")
+	builder.WriteString("wave_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WaveSize))
+	builder.WriteString(", ")
+	builder.WriteString("waves=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Waves))
+	builder.WriteString(", ")
+	builder.WriteString("last_wave_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastWaveAt))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmergencyCampaigns is a parsable slice of EmergencyCampaign.
+type EmergencyCampaigns []*EmergencyCampaign
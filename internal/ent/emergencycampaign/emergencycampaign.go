@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emergencycampaign
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the emergencycampaign type in the database.
+	Label = "emergency_campaign"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldComponent holds the string denoting the component field in the database.
+	FieldComponent = "component"
+	// FieldNeededDonors holds the string denoting the needed_donors field in the database.
+	FieldNeededDonors = "needed_donors"
+	// FieldCommittedDonors holds the string denoting the committed_donors field in the database.
+	FieldCommittedDonors = "committed_donors"
+	// FieldRadiusM holds the string denoting the radius_m field in the database.
+	FieldRadiusM = "radius_m"
+	// FieldWaveSize holds the string denoting the wave_size field in the database.
+	FieldWaveSize = "wave_size"
+	// FieldWaves holds the string denoting the waves field in the database.
+	FieldWaves = "waves"
+	// FieldLastWaveAt holds the string denoting the last_wave_at field in the database.
+	FieldLastWaveAt = "last_wave_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// EdgePmiLocation holds the string denoting the pmi_location edge name in mutations.
+	EdgePmiLocation = "pmi_location"
+	// EdgeBloodType holds the string denoting the blood_type edge name in mutations.
+	EdgeBloodType = "blood_type"
+	// EdgeCreatedBy holds the string denoting the created_by edge name in mutations.
+	EdgeCreatedBy = "created_by"
+	// EdgeContacts holds the string denoting the contacts edge name in mutations.
+	EdgeContacts = "contacts"
+	// Table holds the table name of the emergencycampaign in the database.
+	Table = "emergency_campaigns"
+	// PmiLocationTable is the table that holds the pmi_location relation/edge.
+	PmiLocationTable = "emergency_campaigns"
+	// PmiLocationInverseTable is the table name for the PMILocation entity.
+	// It exists in this package in order to avoid circular dependency with the "pmilocation" package.
+	PmiLocationInverseTable = "pmi_locations"
+	// PmiLocationColumn is the table column denoting the pmi_location relation/edge.
+	PmiLocationColumn = "pmi_location_id"
+	// BloodTypeTable is the table that holds the blood_type relation/edge.
+	BloodTypeTable = "emergency_campaigns"
+	// BloodTypeInverseTable is the table name for the BloodType entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodtype" package.
+	BloodTypeInverseTable = "blood_types"
+	// BloodTypeColumn is the table column denoting the blood_type relation/edge.
+	BloodTypeColumn = "blood_type_id"
+	// CreatedByTable is the table that holds the created_by relation/edge.
+	CreatedByTable = "emergency_campaigns"
+	// CreatedByInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	CreatedByInverseTable = "accounts"
+	// CreatedByColumn is the table column denoting the created_by relation/edge.
+	CreatedByColumn = "created_by_id"
+	// ContactsTable is the table that holds the contacts relation/edge.
+	ContactsTable = "emergency_contacts"
+	// ContactsInverseTable is the table name for the EmergencyContact entity.
+	// It exists in this package in order to avoid circular dependency with the "emergencycontact" package.
+	ContactsInverseTable = "emergency_contacts"
+	// ContactsColumn is the table column denoting the contacts relation/edge.
+	ContactsColumn = "emergency_campaign_id"
+)
+
+// Columns holds all SQL columns for emergencycampaign fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldComponent,
+	FieldNeededDonors,
+	FieldCommittedDonors,
+	FieldRadiusM,
+	FieldWaveSize,
+	FieldWaves,
+	FieldLastWaveAt,
+	FieldStatus,
+	FieldMessage,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "emergency_campaigns"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"pmi_location_id",
+	"blood_type_id",
+	"created_by_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// NeededDonorsValidator is a validator for the "needed_donors" field. It is called by the builders before save.
+	NeededDonorsValidator func(int) error
+	// DefaultCommittedDonors holds the default value on creation for the "committed_donors" field.
+	DefaultCommittedDonors int
+	// CommittedDonorsValidator is a validator for the "committed_donors" field. It is called by the builders before save.
+	CommittedDonorsValidator func(int) error
+	// RadiusMValidator is a validator for the "radius_m" field. It is called by the builders before save.
+	RadiusMValidator func(int) error
+	// WaveSizeValidator is a validator for the "wave_size" field. It is called by the builders before save.
+	WaveSizeValidator func(int) error
+	// DefaultWaves holds the default value on creation for the "waves" field.
+	DefaultWaves int
+	// WavesValidator is a validator for the "waves" field. It is called by the builders before save.
+	WavesValidator func(int) error
+	// MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	MessageValidator func(string) error
+)
+
+// Component defines the type for the "component" enum field.
+type Component string
+
+// Component values.
+const (
+	ComponentWholeBlood Component = "WHOLE_BLOOD"
+	ComponentPRC        Component = "PRC"
+	ComponentTC         Component = "TC"
+	ComponentFFP        Component = "FFP"
+)
+
+func (c Component) String() string {
+	return string(c)
+}
+
+// ComponentValidator is a validator for the "component" field enum values. It is called by the builders before save.
+func ComponentValidator(c Component) error {
+	switch c {
+	case ComponentWholeBlood, ComponentPRC, ComponentTC, ComponentFFP:
+		return nil
+	default:
+		return fmt.Errorf("emergencycampaign: invalid enum value for component field: %q", c)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive    Status = "ACTIVE"
+	StatusFulfilled Status = "FULFILLED"
+	StatusExhausted Status = "EXHAUSTED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusFulfilled, StatusExhausted, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("emergencycampaign: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the EmergencyCampaign queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByComponent orders the results by the component field.
+func ByComponent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComponent, opts...).ToFunc()
+}
+
+// ByNeededDonors orders the results by the needed_donors field.
+func ByNeededDonors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNeededDonors, opts...).ToFunc()
+}
+
+// ByCommittedDonors orders the results by the committed_donors field.
+func ByCommittedDonors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommittedDonors, opts...).ToFunc()
+}
+
+// ByRadiusM orders the results by the radius_m field.
+func ByRadiusM(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRadiusM, opts...).ToFunc()
+}
+
+// ByWaveSize orders the results by the wave_size field.
+func ByWaveSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWaveSize, opts...).ToFunc()
+}
+
+// ByWaves orders the results by the waves field.
+func ByWaves(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWaves, opts...).ToFunc()
+}
+
+// ByLastWaveAt orders the results by the last_wave_at field.
+func ByLastWaveAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastWaveAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// ByPmiLocationField orders the results by pmi_location field.
+func ByPmiLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPmiLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBloodTypeField orders the results by blood_type field.
+func ByBloodTypeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBloodTypeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCreatedByField orders the results by created_by field.
+func ByCreatedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreatedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByContactsCount orders the results by contacts count.
+func ByContactsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContactsStep(), opts...)
+	}
+}
+
+// ByContacts orders the results by contacts terms.
+func ByContacts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContactsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPmiLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PmiLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+	)
+}
+func newBloodTypeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BloodTypeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, BloodTypeTable, BloodTypeColumn),
+	)
+}
+func newCreatedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreatedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CreatedByTable, CreatedByColumn),
+	)
+}
+func newContactsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContactsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ContactsTable, ContactsColumn),
+	)
+}
@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emergencycampaign
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// NeededDonors applies equality check predicate on the "needed_donors" field. It's identical to NeededDonorsEQ.
+func NeededDonors(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldNeededDonors, v))
+}
+
+// CommittedDonors applies equality check predicate on the "committed_donors" field. It's identical to CommittedDonorsEQ.
+func CommittedDonors(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldCommittedDonors, v))
+}
+
+// RadiusM applies equality check predicate on the "radius_m" field. It's identical to RadiusMEQ.
+func RadiusM(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldRadiusM, v))
+}
+
+// WaveSize applies equality check predicate on the "wave_size" field. It's identical to WaveSizeEQ.
+func WaveSize(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldWaveSize, v))
+}
+
+// Waves applies equality check predicate on the "waves" field. It's identical to WavesEQ.
+func Waves(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldWaves, v))
+}
+
+// LastWaveAt applies equality check predicate on the "last_wave_at" field. It's identical to LastWaveAtEQ.
+func LastWaveAt(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldLastWaveAt, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ComponentEQ applies the EQ predicate on the "component" field.
+func ComponentEQ(v Component) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldComponent, v))
+}
+
+// ComponentNEQ applies the NEQ predicate on the "component" field.
+func ComponentNEQ(v Component) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldComponent, v))
+}
+
+// ComponentIn applies the In predicate on the "component" field.
+func ComponentIn(vs ...Component) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldComponent, vs...))
+}
+
+// ComponentNotIn applies the NotIn predicate on the "component" field.
+func ComponentNotIn(vs ...Component) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldComponent, vs...))
+}
+
+// NeededDonorsEQ applies the EQ predicate on the "needed_donors" field.
+func NeededDonorsEQ(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldNeededDonors, v))
+}
+
+// NeededDonorsNEQ applies the NEQ predicate on the "needed_donors" field.
+func NeededDonorsNEQ(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldNeededDonors, v))
+}
+
+// NeededDonorsIn applies the In predicate on the "needed_donors" field.
+func NeededDonorsIn(vs ...int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldNeededDonors, vs...))
+}
+
+// NeededDonorsNotIn applies the NotIn predicate on the "needed_donors" field.
+func NeededDonorsNotIn(vs ...int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldNeededDonors, vs...))
+}
+
+// NeededDonorsGT applies the GT predicate on the "needed_donors" field.
+func NeededDonorsGT(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldNeededDonors, v))
+}
+
+// NeededDonorsGTE applies the GTE predicate on the "needed_donors" field.
+func NeededDonorsGTE(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldNeededDonors, v))
+}
+
+// NeededDonorsLT applies the LT predicate on the "needed_donors" field.
+func NeededDonorsLT(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldNeededDonors, v))
+}
+
+// NeededDonorsLTE applies the LTE predicate on the "needed_donors" field.
+func NeededDonorsLTE(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldNeededDonors, v))
+}
+
+// CommittedDonorsEQ applies the EQ predicate on the "committed_donors" field.
+func CommittedDonorsEQ(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldCommittedDonors, v))
+}
+
+// CommittedDonorsNEQ applies the NEQ predicate on the "committed_donors" field.
+func CommittedDonorsNEQ(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldCommittedDonors, v))
+}
+
+// CommittedDonorsIn applies the In predicate on the "committed_donors" field.
+func CommittedDonorsIn(vs ...int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldCommittedDonors, vs...))
+}
+
+// CommittedDonorsNotIn applies the NotIn predicate on the "committed_donors" field.
+func CommittedDonorsNotIn(vs ...int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldCommittedDonors, vs...))
+}
+
+// CommittedDonorsGT applies the GT predicate on the "committed_donors" field.
+func CommittedDonorsGT(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldCommittedDonors, v))
+}
+
+// CommittedDonorsGTE applies the GTE predicate on the "committed_donors" field.
+func CommittedDonorsGTE(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldCommittedDonors, v))
+}
+
+// CommittedDonorsLT applies the LT predicate on the "committed_donors" field.
+func CommittedDonorsLT(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldCommittedDonors, v))
+}
+
+// CommittedDonorsLTE applies the LTE predicate on the "committed_donors" field.
+func CommittedDonorsLTE(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldCommittedDonors, v))
+}
+
+// RadiusMEQ applies the EQ predicate on the "radius_m" field.
+func RadiusMEQ(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldRadiusM, v))
+}
+
+// RadiusMNEQ applies the NEQ predicate on the "radius_m" field.
+func RadiusMNEQ(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldRadiusM, v))
+}
+
+// RadiusMIn applies the In predicate on the "radius_m" field.
+func RadiusMIn(vs ...int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldRadiusM, vs...))
+}
+
+// RadiusMNotIn applies the NotIn predicate on the "radius_m" field.
+func RadiusMNotIn(vs ...int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldRadiusM, vs...))
+}
+
+// RadiusMGT applies the GT predicate on the "radius_m" field.
+func RadiusMGT(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldRadiusM, v))
+}
+
+// RadiusMGTE applies the GTE predicate on the "radius_m" field.
+func RadiusMGTE(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldRadiusM, v))
+}
+
+// RadiusMLT applies the LT predicate on the "radius_m" field.
+func RadiusMLT(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldRadiusM, v))
+}
+
+// RadiusMLTE applies the LTE predicate on the "radius_m" field.
+func RadiusMLTE(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldRadiusM, v))
+}
+
+// WaveSizeEQ applies the EQ predicate on the "wave_size" field.
+func WaveSizeEQ(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldWaveSize, v))
+}
+
+// WaveSizeNEQ applies the NEQ predicate on the "wave_size" field.
+func WaveSizeNEQ(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldWaveSize, v))
+}
+
+// WaveSizeIn applies the In predicate on the "wave_size" field.
+func WaveSizeIn(vs ...int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldWaveSize, vs...))
+}
+
+// WaveSizeNotIn applies the NotIn predicate on the "wave_size" field.
+func WaveSizeNotIn(vs ...int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldWaveSize, vs...))
+}
+
+// WaveSizeGT applies the GT predicate on the "wave_size" field.
+func WaveSizeGT(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldWaveSize, v))
+}
+
+// WaveSizeGTE applies the GTE predicate on the "wave_size" field.
+func WaveSizeGTE(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldWaveSize, v))
+}
+
+// WaveSizeLT applies the LT predicate on the "wave_size" field.
+func WaveSizeLT(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldWaveSize, v))
+}
+
+// WaveSizeLTE applies the LTE predicate on the "wave_size" field.
+func WaveSizeLTE(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldWaveSize, v))
+}
+
+// WavesEQ applies the EQ predicate on the "waves" field.
+func WavesEQ(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldWaves, v))
+}
+
+// WavesNEQ applies the NEQ predicate on the "waves" field.
+func WavesNEQ(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldWaves, v))
+}
+
+// WavesIn applies the In predicate on the "waves" field.
+func WavesIn(vs ...int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldWaves, vs...))
+}
+
+// WavesNotIn applies the NotIn predicate on the "waves" field.
+func WavesNotIn(vs ...int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldWaves, vs...))
+}
+
+// WavesGT applies the GT predicate on the "waves" field.
+func WavesGT(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldWaves, v))
+}
+
+// WavesGTE applies the GTE predicate on the "waves" field.
+func WavesGTE(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldWaves, v))
+}
+
+// WavesLT applies the LT predicate on the "waves" field.
+func WavesLT(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldWaves, v))
+}
+
+// WavesLTE applies the LTE predicate on the "waves" field.
+func WavesLTE(v int) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldWaves, v))
+}
+
+// LastWaveAtEQ applies the EQ predicate on the "last_wave_at" field.
+func LastWaveAtEQ(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldLastWaveAt, v))
+}
+
+// LastWaveAtNEQ applies the NEQ predicate on the "last_wave_at" field.
+func LastWaveAtNEQ(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldLastWaveAt, v))
+}
+
+// LastWaveAtIn applies the In predicate on the "last_wave_at" field.
+func LastWaveAtIn(vs ...int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldLastWaveAt, vs...))
+}
+
+// LastWaveAtNotIn applies the NotIn predicate on the "last_wave_at" field.
+func LastWaveAtNotIn(vs ...int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldLastWaveAt, vs...))
+}
+
+// LastWaveAtGT applies the GT predicate on the "last_wave_at" field.
+func LastWaveAtGT(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldLastWaveAt, v))
+}
+
+// LastWaveAtGTE applies the GTE predicate on the "last_wave_at" field.
+func LastWaveAtGTE(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldLastWaveAt, v))
+}
+
+// LastWaveAtLT applies the LT predicate on the "last_wave_at" field.
+func LastWaveAtLT(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldLastWaveAt, v))
+}
+
+// LastWaveAtLTE applies the LTE predicate on the "last_wave_at" field.
+func LastWaveAtLTE(v int64) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldLastWaveAt, v))
+}
+
+// LastWaveAtIsNil applies the IsNil predicate on the "last_wave_at" field.
+func LastWaveAtIsNil() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIsNull(FieldLastWaveAt))
+}
+
+// LastWaveAtNotNil applies the NotNil predicate on the "last_wave_at" field.
+func LastWaveAtNotNil() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotNull(FieldLastWaveAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// HasPmiLocation applies the HasEdge predicate on the "pmi_location" edge.
+func HasPmiLocation() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PmiLocationTable, PmiLocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPmiLocationWith applies the HasEdge predicate on the "pmi_location" edge with a given conditions (other predicates).
+func HasPmiLocationWith(preds ...predicate.PMILocation) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(func(s *sql.Selector) {
+		step := newPmiLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBloodType applies the HasEdge predicate on the "blood_type" edge.
+func HasBloodType() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BloodTypeTable, BloodTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBloodTypeWith applies the HasEdge predicate on the "blood_type" edge with a given conditions (other predicates).
+func HasBloodTypeWith(preds ...predicate.BloodType) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(func(s *sql.Selector) {
+		step := newBloodTypeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreatedBy applies the HasEdge predicate on the "created_by" edge.
+func HasCreatedBy() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CreatedByTable, CreatedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedByWith applies the HasEdge predicate on the "created_by" edge with a given conditions (other predicates).
+func HasCreatedByWith(preds ...predicate.Account) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(func(s *sql.Selector) {
+		step := newCreatedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContacts applies the HasEdge predicate on the "contacts" edge.
+func HasContacts() predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ContactsTable, ContactsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContactsWith applies the HasEdge predicate on the "contacts" edge with a given conditions (other predicates).
+func HasContactsWith(preds ...predicate.EmergencyContact) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(func(s *sql.Selector) {
+		step := newContactsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmergencyCampaign) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmergencyCampaign) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmergencyCampaign) predicate.EmergencyCampaign {
+	return predicate.EmergencyCampaign(sql.NotPredicates(p))
+}
@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+)
+
+// EmergencyCampaignCreate is the builder for creating a EmergencyCampaign entity.
+type EmergencyCampaignCreate struct {
+	config
+	mutation *EmergencyCampaignMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmergencyCampaignCreate) SetCreatedAt(v int64) *EmergencyCampaignCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EmergencyCampaignCreate) SetUpdatedAt(v int64) *EmergencyCampaignCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EmergencyCampaignCreate) SetNillableUpdatedAt(v *int64) *EmergencyCampaignCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *EmergencyCampaignCreate) SetDeletedAt(v int64) *EmergencyCampaignCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *EmergencyCampaignCreate) SetNillableDeletedAt(v *int64) *EmergencyCampaignCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetComponent sets the "component" field.
+func (_c *EmergencyCampaignCreate) SetComponent(v emergencycampaign.Component) *EmergencyCampaignCreate {
+	_c.mutation.SetComponent(v)
+	return _c
+}
+
+// SetNeededDonors sets the "needed_donors" field.
+func (_c *EmergencyCampaignCreate) SetNeededDonors(v int) *EmergencyCampaignCreate {
+	_c.mutation.SetNeededDonors(v)
+	return _c
+}
+
+// SetCommittedDonors sets the "committed_donors" field.
+func (_c *EmergencyCampaignCreate) SetCommittedDonors(v int) *EmergencyCampaignCreate {
+	_c.mutation.SetCommittedDonors(v)
+	return _c
+}
+
+// SetNillableCommittedDonors sets the "committed_donors" field if the given value is not nil.
+func (_c *EmergencyCampaignCreate) SetNillableCommittedDonors(v *int) *EmergencyCampaignCreate {
+	if v != nil {
+		_c.SetCommittedDonors(*v)
+	}
+	return _c
+}
+
+// SetRadiusM sets the "radius_m" field.
+func (_c *EmergencyCampaignCreate) SetRadiusM(v int) *EmergencyCampaignCreate {
+	_c.mutation.SetRadiusM(v)
+	return _c
+}
+
+// SetWaveSize sets the "wave_size" field.
+func (_c *EmergencyCampaignCreate) SetWaveSize(v int) *EmergencyCampaignCreate {
+	_c.mutation.SetWaveSize(v)
+	return _c
+}
+
+// SetWaves sets the "waves" field.
+func (_c *EmergencyCampaignCreate) SetWaves(v int) *EmergencyCampaignCreate {
+	_c.mutation.SetWaves(v)
+	return _c
+}
+
+// SetNillableWaves sets the "waves" field if the given value is not nil.
+func (_c *EmergencyCampaignCreate) SetNillableWaves(v *int) *EmergencyCampaignCreate {
+	if v != nil {
+		_c.SetWaves(*v)
+	}
+	return _c
+}
+
+// SetLastWaveAt sets the "last_wave_at" field.
+func (_c *EmergencyCampaignCreate) SetLastWaveAt(v int64) *EmergencyCampaignCreate {
+	_c.mutation.SetLastWaveAt(v)
+	return _c
+}
+
+// SetNillableLastWaveAt sets the "last_wave_at" field if the given value is not nil.
+func (_c *EmergencyCampaignCreate) SetNillableLastWaveAt(v *int64) *EmergencyCampaignCreate {
+	if v != nil {
+		_c.SetLastWaveAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EmergencyCampaignCreate) SetStatus(v emergencycampaign.Status) *EmergencyCampaignCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EmergencyCampaignCreate) SetNillableStatus(v *emergencycampaign.Status) *EmergencyCampaignCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *EmergencyCampaignCreate) SetMessage(v string) *EmergencyCampaignCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *EmergencyCampaignCreate) SetNillableMessage(v *string) *EmergencyCampaignCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmergencyCampaignCreate) SetID(v uuid.UUID) *EmergencyCampaignCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetPmiLocationID sets the "pmi_location" edge to the PMILocation entity by ID.
+func (_c *EmergencyCampaignCreate) SetPmiLocationID(id uuid.UUID) *EmergencyCampaignCreate {
+	_c.mutation.SetPmiLocationID(id)
+	return _c
+}
+
+// SetPmiLocation sets the "pmi_location" edge to the PMILocation entity.
+func (_c *EmergencyCampaignCreate) SetPmiLocation(v *PMILocation) *EmergencyCampaignCreate {
+	return _c.SetPmiLocationID(v.ID)
+}
+
+// SetBloodTypeID sets the "blood_type" edge to the BloodType entity by ID.
+func (_c *EmergencyCampaignCreate) SetBloodTypeID(id uuid.UUID) *EmergencyCampaignCreate {
+	_c.mutation.SetBloodTypeID(id)
+	return _c
+}
+
+// SetBloodType sets the "blood_type" edge to the BloodType entity.
+func (_c *EmergencyCampaignCreate) SetBloodType(v *BloodType) *EmergencyCampaignCreate {
+	return _c.SetBloodTypeID(v.ID)
+}
+
+// SetCreatedByID sets the "created_by" edge to the Account entity by ID.
+func (_c *EmergencyCampaignCreate) SetCreatedByID(id uuid.UUID) *EmergencyCampaignCreate {
+	_c.mutation.SetCreatedByID(id)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" edge to the Account entity.
+func (_c *EmergencyCampaignCreate) SetCreatedBy(v *Account) *EmergencyCampaignCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// AddContactIDs adds the "contacts" edge to the EmergencyContact entity by IDs.
+func (_c *EmergencyCampaignCreate) AddContactIDs(ids ...uuid.UUID) *EmergencyCampaignCreate {
+	_c.mutation.AddContactIDs(ids...)
+	return _c
+}
+
+// AddContacts adds the "contacts" edges to the EmergencyContact entity.
+func (_c *EmergencyCampaignCreate) AddContacts(v ...*EmergencyContact) *EmergencyCampaignCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContactIDs(ids...)
+}
+
+// Mutation returns the EmergencyCampaignMutation object of the builder.
+func (_c *EmergencyCampaignCreate) Mutation() *EmergencyCampaignMutation {
+	return _c.mutation
+}
+
+// Save creates the EmergencyCampaign in the database.
+func (_c *EmergencyCampaignCreate) Save(ctx context.Context) (*EmergencyCampaign, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmergencyCampaignCreate) SaveX(ctx context.Context) *EmergencyCampaign {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmergencyCampaignCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmergencyCampaignCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmergencyCampaignCreate) defaults() {
+	if _, ok := _c.mutation.CommittedDonors(); !ok {
+		v := emergencycampaign.DefaultCommittedDonors
+		_c.mutation.SetCommittedDonors(v)
+	}
+	if _, ok := _c.mutation.Waves(); !ok {
+		v := emergencycampaign.DefaultWaves
+		_c.mutation.SetWaves(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := emergencycampaign.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmergencyCampaignCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := emergencycampaign.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := emergencycampaign.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := emergencycampaign.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Component(); !ok {
+		return &ValidationError{Name: "component", err: errors.New(`ent: missing required field "EmergencyCampaign.component"`)}
+	}
+	if v, ok := _c.mutation.Component(); ok {
+		if err := emergencycampaign.ComponentValidator(v); err != nil {
+			return &ValidationError{Name: "component", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.component": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NeededDonors(); !ok {
+		return &ValidationError{Name: "needed_donors", err: errors.New(`ent: missing required field "EmergencyCampaign.needed_donors"`)}
+	}
+	if v, ok := _c.mutation.NeededDonors(); ok {
+		if err := emergencycampaign.NeededDonorsValidator(v); err != nil {
+			return &ValidationError{Name: "needed_donors", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.needed_donors": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CommittedDonors(); !ok {
+		return &ValidationError{Name: "committed_donors", err: errors.New(`ent: missing required field "EmergencyCampaign.committed_donors"`)}
+	}
+	if v, ok := _c.mutation.CommittedDonors(); ok {
+		if err := emergencycampaign.CommittedDonorsValidator(v); err != nil {
+			return &ValidationError{Name: "committed_donors", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.committed_donors": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RadiusM(); !ok {
+		return &ValidationError{Name: "radius_m", err: errors.New(`ent: missing required field "EmergencyCampaign.radius_m"`)}
+	}
+	if v, ok := _c.mutation.RadiusM(); ok {
+		if err := emergencycampaign.RadiusMValidator(v); err != nil {
+			return &ValidationError{Name: "radius_m", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.radius_m": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WaveSize(); !ok {
+		return &ValidationError{Name: "wave_size", err: errors.New(`ent: missing required field "EmergencyCampaign.wave_size"`)}
+	}
+	if v, ok := _c.mutation.WaveSize(); ok {
+		if err := emergencycampaign.WaveSizeValidator(v); err != nil {
+			return &ValidationError{Name: "wave_size", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.wave_size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Waves(); !ok {
+		return &ValidationError{Name: "waves", err: errors.New(`ent: missing required field "EmergencyCampaign.waves"`)}
+	}
+	if v, ok := _c.mutation.Waves(); ok {
+		if err := emergencycampaign.WavesValidator(v); err != nil {
+			return &ValidationError{Name: "waves", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.waves": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EmergencyCampaign.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := emergencycampaign.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Message(); ok {
+		if err := emergencycampaign.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.message": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PmiLocationIDs()) == 0 {
+		return &ValidationError{Name: "pmi_location", err: errors.New(`ent: missing required edge "EmergencyCampaign.pmi_location"`)}
+	}
+	if len(_c.mutation.BloodTypeIDs()) == 0 {
+		return &ValidationError{Name: "blood_type", err: errors.New(`ent: missing required edge "EmergencyCampaign.blood_type"`)}
+	}
+	if len(_c.mutation.CreatedByIDs()) == 0 {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required edge "EmergencyCampaign.created_by"`)}
+	}
+	return nil
+}
+
+func (_c *EmergencyCampaignCreate) sqlSave(ctx context.Context) (*EmergencyCampaign, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmergencyCampaignCreate) createSpec() (*EmergencyCampaign, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmergencyCampaign{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emergencycampaign.Table, sqlgraph.NewFieldSpec(emergencycampaign.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emergencycampaign.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencycampaign.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(emergencycampaign.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Component(); ok {
+		_spec.SetField(emergencycampaign.FieldComponent, field.TypeEnum, value)
+		_node.Component = value
+	}
+	if value, ok := _c.mutation.NeededDonors(); ok {
+		_spec.SetField(emergencycampaign.FieldNeededDonors, field.TypeInt, value)
+		_node.NeededDonors = value
+	}
+	if value, ok := _c.mutation.CommittedDonors(); ok {
+		_spec.SetField(emergencycampaign.FieldCommittedDonors, field.TypeInt, value)
+		_node.CommittedDonors = value
+	}
+	if value, ok := _c.mutation.RadiusM(); ok {
+		_spec.SetField(emergencycampaign.FieldRadiusM, field.TypeInt, value)
+		_node.RadiusM = value
+	}
+	if value, ok := _c.mutation.WaveSize(); ok {
+		_spec.SetField(emergencycampaign.FieldWaveSize, field.TypeInt, value)
+		_node.WaveSize = value
+	}
+	if value, ok := _c.mutation.Waves(); ok {
+		_spec.SetField(emergencycampaign.FieldWaves, field.TypeInt, value)
+		_node.Waves = value
+	}
+	if value, ok := _c.mutation.LastWaveAt(); ok {
+		_spec.SetField(emergencycampaign.FieldLastWaveAt, field.TypeInt64, value)
+		_node.LastWaveAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(emergencycampaign.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(emergencycampaign.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if nodes := _c.mutation.PmiLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   emergencycampaign.PmiLocationTable,
+			Columns: []string{emergencycampaign.PmiLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pmilocation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pmi_location_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BloodTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   emergencycampaign.BloodTypeTable,
+			Columns: []string{emergencycampaign.BloodTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodtype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blood_type_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   emergencycampaign.CreatedByTable,
+			Columns: []string{emergencycampaign.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.created_by_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   emergencycampaign.ContactsTable,
+			Columns: []string{emergencycampaign.ContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmergencyCampaignCreateBulk is the builder for creating many EmergencyCampaign entities in bulk.
+type EmergencyCampaignCreateBulk struct {
+	config
+	err      error
+	builders []*EmergencyCampaignCreate
+}
+
+// Save creates the EmergencyCampaign entities in the database.
+func (_c *EmergencyCampaignCreateBulk) Save(ctx context.Context) ([]*EmergencyCampaign, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmergencyCampaign, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmergencyCampaignMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmergencyCampaignCreateBulk) SaveX(ctx context.Context) []*EmergencyCampaign {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmergencyCampaignCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmergencyCampaignCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// EmergencyCampaignDelete is the builder for deleting a EmergencyCampaign entity.
+type EmergencyCampaignDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmergencyCampaignMutation
+}
+
+// Where appends a list predicates to the EmergencyCampaignDelete builder.
+func (_d *EmergencyCampaignDelete) Where(ps ...predicate.EmergencyCampaign) *EmergencyCampaignDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EmergencyCampaignDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmergencyCampaignDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EmergencyCampaignDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(emergencycampaign.Table, sqlgraph.NewFieldSpec(emergencycampaign.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EmergencyCampaignDeleteOne is the builder for deleting a single EmergencyCampaign entity.
+type EmergencyCampaignDeleteOne struct {
+	_d *EmergencyCampaignDelete
+}
+
+// Where appends a list predicates to the EmergencyCampaignDelete builder.
+func (_d *EmergencyCampaignDeleteOne) Where(ps ...predicate.EmergencyCampaign) *EmergencyCampaignDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EmergencyCampaignDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{emergencycampaign.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmergencyCampaignDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,841 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// EmergencyCampaignQuery is the builder for querying EmergencyCampaign entities.
+type EmergencyCampaignQuery struct {
+	config
+	ctx             *QueryContext
+	order           []emergencycampaign.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.EmergencyCampaign
+	withPmiLocation *PMILocationQuery
+	withBloodType   *BloodTypeQuery
+	withCreatedBy   *AccountQuery
+	withContacts    *EmergencyContactQuery
+	withFKs         bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmergencyCampaignQuery builder.
+func (_q *EmergencyCampaignQuery) Where(ps ...predicate.EmergencyCampaign) *EmergencyCampaignQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *EmergencyCampaignQuery) Limit(limit int) *EmergencyCampaignQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *EmergencyCampaignQuery) Offset(offset int) *EmergencyCampaignQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *EmergencyCampaignQuery) Unique(unique bool) *EmergencyCampaignQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *EmergencyCampaignQuery) Order(o ...emergencycampaign.OrderOption) *EmergencyCampaignQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryPmiLocation chains the current query on the "pmi_location" edge.
+func (_q *EmergencyCampaignQuery) QueryPmiLocation() *PMILocationQuery {
+	query := (&PMILocationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emergencycampaign.Table, emergencycampaign.FieldID, selector),
+			sqlgraph.To(pmilocation.Table, pmilocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, emergencycampaign.PmiLocationTable, emergencycampaign.PmiLocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBloodType chains the current query on the "blood_type" edge.
+func (_q *EmergencyCampaignQuery) QueryBloodType() *BloodTypeQuery {
+	query := (&BloodTypeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emergencycampaign.Table, emergencycampaign.FieldID, selector),
+			sqlgraph.To(bloodtype.Table, bloodtype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, emergencycampaign.BloodTypeTable, emergencycampaign.BloodTypeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreatedBy chains the current query on the "created_by" edge.
+func (_q *EmergencyCampaignQuery) QueryCreatedBy() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emergencycampaign.Table, emergencycampaign.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, emergencycampaign.CreatedByTable, emergencycampaign.CreatedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContacts chains the current query on the "contacts" edge.
+func (_q *EmergencyCampaignQuery) QueryContacts() *EmergencyContactQuery {
+	query := (&EmergencyContactClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emergencycampaign.Table, emergencycampaign.FieldID, selector),
+			sqlgraph.To(emergencycontact.Table, emergencycontact.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, emergencycampaign.ContactsTable, emergencycampaign.ContactsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EmergencyCampaign entity from the query.
+// Returns a *NotFoundError when no EmergencyCampaign was found.
+func (_q *EmergencyCampaignQuery) First(ctx context.Context) (*EmergencyCampaign, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{emergencycampaign.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *EmergencyCampaignQuery) FirstX(ctx context.Context) *EmergencyCampaign {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EmergencyCampaign ID from the query.
+// Returns a *NotFoundError when no EmergencyCampaign ID was found.
+func (_q *EmergencyCampaignQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{emergencycampaign.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *EmergencyCampaignQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EmergencyCampaign entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmergencyCampaign entity is found.
+// Returns a *NotFoundError when no EmergencyCampaign entities are found.
+func (_q *EmergencyCampaignQuery) Only(ctx context.Context) (*EmergencyCampaign, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{emergencycampaign.Label}
+	default:
+		return nil, &NotSingularError{emergencycampaign.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *EmergencyCampaignQuery) OnlyX(ctx context.Context) *EmergencyCampaign {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EmergencyCampaign ID in the query.
+// Returns a *NotSingularError when more than one EmergencyCampaign ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *EmergencyCampaignQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{emergencycampaign.Label}
+	default:
+		err = &NotSingularError{emergencycampaign.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *EmergencyCampaignQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EmergencyCampaigns.
+func (_q *EmergencyCampaignQuery) All(ctx context.Context) ([]*EmergencyCampaign, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EmergencyCampaign, *EmergencyCampaignQuery]()
+	return withInterceptors[[]*EmergencyCampaign](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *EmergencyCampaignQuery) AllX(ctx context.Context) []*EmergencyCampaign {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EmergencyCampaign IDs.
+func (_q *EmergencyCampaignQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(emergencycampaign.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *EmergencyCampaignQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *EmergencyCampaignQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*EmergencyCampaignQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *EmergencyCampaignQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *EmergencyCampaignQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *EmergencyCampaignQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmergencyCampaignQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *EmergencyCampaignQuery) Clone() *EmergencyCampaignQuery {
+	if _q == nil {
+		return nil
+	}
+	return &EmergencyCampaignQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]emergencycampaign.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.EmergencyCampaign{}, _q.predicates...),
+		withPmiLocation: _q.withPmiLocation.Clone(),
+		withBloodType:   _q.withBloodType.Clone(),
+		withCreatedBy:   _q.withCreatedBy.Clone(),
+		withContacts:    _q.withContacts.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithPmiLocation tells the query-builder to eager-load the nodes that are connected to
+// the "pmi_location" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmergencyCampaignQuery) WithPmiLocation(opts ...func(*PMILocationQuery)) *EmergencyCampaignQuery {
+	query := (&PMILocationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPmiLocation = query
+	return _q
+}
+
+// WithBloodType tells the query-builder to eager-load the nodes that are connected to
+// the "blood_type" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmergencyCampaignQuery) WithBloodType(opts ...func(*BloodTypeQuery)) *EmergencyCampaignQuery {
+	query := (&BloodTypeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBloodType = query
+	return _q
+}
+
+// WithCreatedBy tells the query-builder to eager-load the nodes that are connected to
+// the "created_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmergencyCampaignQuery) WithCreatedBy(opts ...func(*AccountQuery)) *EmergencyCampaignQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCreatedBy = query
+	return _q
+}
+
+// WithContacts tells the query-builder to eager-load the nodes that are connected to
+// the "contacts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmergencyCampaignQuery) WithContacts(opts ...func(*EmergencyContactQuery)) *EmergencyCampaignQuery {
+	query := (&EmergencyContactClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContacts = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt int64 `json:"created_at"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmergencyCampaign.Query().
+//		GroupBy(emergencycampaign.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *EmergencyCampaignQuery) GroupBy(field string, fields ...string) *EmergencyCampaignGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmergencyCampaignGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = emergencycampaign.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt int64 `json:"created_at"`
+//	}
+//
+//	client.EmergencyCampaign.Query().
+//		Select(emergencycampaign.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *EmergencyCampaignQuery) Select(fields ...string) *EmergencyCampaignSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &EmergencyCampaignSelect{EmergencyCampaignQuery: _q}
+	sbuild.label = emergencycampaign.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmergencyCampaignSelect configured with the given aggregations.
+func (_q *EmergencyCampaignQuery) Aggregate(fns ...AggregateFunc) *EmergencyCampaignSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *EmergencyCampaignQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !emergencycampaign.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *EmergencyCampaignQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EmergencyCampaign, error) {
+	var (
+		nodes       = []*EmergencyCampaign{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [4]bool{
+			_q.withPmiLocation != nil,
+			_q.withBloodType != nil,
+			_q.withCreatedBy != nil,
+			_q.withContacts != nil,
+		}
+	)
+	if _q.withPmiLocation != nil || _q.withBloodType != nil || _q.withCreatedBy != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, emergencycampaign.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EmergencyCampaign).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EmergencyCampaign{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withPmiLocation; query != nil {
+		if err := _q.loadPmiLocation(ctx, query, nodes, nil,
+			func(n *EmergencyCampaign, e *PMILocation) { n.Edges.PmiLocation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBloodType; query != nil {
+		if err := _q.loadBloodType(ctx, query, nodes, nil,
+			func(n *EmergencyCampaign, e *BloodType) { n.Edges.BloodType = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCreatedBy; query != nil {
+		if err := _q.loadCreatedBy(ctx, query, nodes, nil,
+			func(n *EmergencyCampaign, e *Account) { n.Edges.CreatedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withContacts; query != nil {
+		if err := _q.loadContacts(ctx, query, nodes,
+			func(n *EmergencyCampaign) { n.Edges.Contacts = []*EmergencyContact{} },
+			func(n *EmergencyCampaign, e *EmergencyContact) { n.Edges.Contacts = append(n.Edges.Contacts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *EmergencyCampaignQuery) loadPmiLocation(ctx context.Context, query *PMILocationQuery, nodes []*EmergencyCampaign, init func(*EmergencyCampaign), assign func(*EmergencyCampaign, *PMILocation)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*EmergencyCampaign)
+	for i := range nodes {
+		if nodes[i].pmi_location_id == nil {
+			continue
+		}
+		fk := *nodes[i].pmi_location_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(pmilocation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pmi_location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *EmergencyCampaignQuery) loadBloodType(ctx context.Context, query *BloodTypeQuery, nodes []*EmergencyCampaign, init func(*EmergencyCampaign), assign func(*EmergencyCampaign, *BloodType)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*EmergencyCampaign)
+	for i := range nodes {
+		if nodes[i].blood_type_id == nil {
+			continue
+		}
+		fk := *nodes[i].blood_type_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bloodtype.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "blood_type_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *EmergencyCampaignQuery) loadCreatedBy(ctx context.Context, query *AccountQuery, nodes []*EmergencyCampaign, init func(*EmergencyCampaign), assign func(*EmergencyCampaign, *Account)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*EmergencyCampaign)
+	for i := range nodes {
+		if nodes[i].created_by_id == nil {
+			continue
+		}
+		fk := *nodes[i].created_by_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "created_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *EmergencyCampaignQuery) loadContacts(ctx context.Context, query *EmergencyContactQuery, nodes []*EmergencyCampaign, init func(*EmergencyCampaign), assign func(*EmergencyCampaign, *EmergencyContact)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*EmergencyCampaign)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.EmergencyContact(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(emergencycampaign.ContactsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.emergency_campaign_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "emergency_campaign_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "emergency_campaign_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *EmergencyCampaignQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *EmergencyCampaignQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(emergencycampaign.Table, emergencycampaign.Columns, sqlgraph.NewFieldSpec(emergencycampaign.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emergencycampaign.FieldID)
+		for i := range fields {
+			if fields[i] != emergencycampaign.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *EmergencyCampaignQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(emergencycampaign.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = emergencycampaign.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EmergencyCampaignGroupBy is the group-by builder for EmergencyCampaign entities.
+type EmergencyCampaignGroupBy struct {
+	selector
+	build *EmergencyCampaignQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *EmergencyCampaignGroupBy) Aggregate(fns ...AggregateFunc) *EmergencyCampaignGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *EmergencyCampaignGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmergencyCampaignQuery, *EmergencyCampaignGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *EmergencyCampaignGroupBy) sqlScan(ctx context.Context, root *EmergencyCampaignQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EmergencyCampaignSelect is the builder for selecting fields of EmergencyCampaign entities.
+type EmergencyCampaignSelect struct {
+	*EmergencyCampaignQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *EmergencyCampaignSelect) Aggregate(fns ...AggregateFunc) *EmergencyCampaignSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *EmergencyCampaignSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmergencyCampaignQuery, *EmergencyCampaignSelect](ctx, _s.EmergencyCampaignQuery, _s, _s.inters, v)
+}
+
+func (_s *EmergencyCampaignSelect) sqlScan(ctx context.Context, root *EmergencyCampaignQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,845 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// EmergencyCampaignUpdate is the builder for updating EmergencyCampaign entities.
+type EmergencyCampaignUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmergencyCampaignMutation
+}
+
+// Where appends a list predicates to the EmergencyCampaignUpdate builder.
+func (_u *EmergencyCampaignUpdate) Where(ps ...predicate.EmergencyCampaign) *EmergencyCampaignUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmergencyCampaignUpdate) SetUpdatedAt(v int64) *EmergencyCampaignUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *EmergencyCampaignUpdate) AddUpdatedAt(v int64) *EmergencyCampaignUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *EmergencyCampaignUpdate) ClearUpdatedAt() *EmergencyCampaignUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EmergencyCampaignUpdate) SetDeletedAt(v int64) *EmergencyCampaignUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdate) SetNillableDeletedAt(v *int64) *EmergencyCampaignUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *EmergencyCampaignUpdate) AddDeletedAt(v int64) *EmergencyCampaignUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EmergencyCampaignUpdate) ClearDeletedAt() *EmergencyCampaignUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCommittedDonors sets the "committed_donors" field.
+func (_u *EmergencyCampaignUpdate) SetCommittedDonors(v int) *EmergencyCampaignUpdate {
+	_u.mutation.ResetCommittedDonors()
+	_u.mutation.SetCommittedDonors(v)
+	return _u
+}
+
+// SetNillableCommittedDonors sets the "committed_donors" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdate) SetNillableCommittedDonors(v *int) *EmergencyCampaignUpdate {
+	if v != nil {
+		_u.SetCommittedDonors(*v)
+	}
+	return _u
+}
+
+// AddCommittedDonors adds value to the "committed_donors" field.
+func (_u *EmergencyCampaignUpdate) AddCommittedDonors(v int) *EmergencyCampaignUpdate {
+	_u.mutation.AddCommittedDonors(v)
+	return _u
+}
+
+// SetWaves sets the "waves" field.
+func (_u *EmergencyCampaignUpdate) SetWaves(v int) *EmergencyCampaignUpdate {
+	_u.mutation.ResetWaves()
+	_u.mutation.SetWaves(v)
+	return _u
+}
+
+// SetNillableWaves sets the "waves" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdate) SetNillableWaves(v *int) *EmergencyCampaignUpdate {
+	if v != nil {
+		_u.SetWaves(*v)
+	}
+	return _u
+}
+
+// AddWaves adds value to the "waves" field.
+func (_u *EmergencyCampaignUpdate) AddWaves(v int) *EmergencyCampaignUpdate {
+	_u.mutation.AddWaves(v)
+	return _u
+}
+
+// SetLastWaveAt sets the "last_wave_at" field.
+func (_u *EmergencyCampaignUpdate) SetLastWaveAt(v int64) *EmergencyCampaignUpdate {
+	_u.mutation.ResetLastWaveAt()
+	_u.mutation.SetLastWaveAt(v)
+	return _u
+}
+
+// SetNillableLastWaveAt sets the "last_wave_at" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdate) SetNillableLastWaveAt(v *int64) *EmergencyCampaignUpdate {
+	if v != nil {
+		_u.SetLastWaveAt(*v)
+	}
+	return _u
+}
+
+// AddLastWaveAt adds value to the "last_wave_at" field.
+func (_u *EmergencyCampaignUpdate) AddLastWaveAt(v int64) *EmergencyCampaignUpdate {
+	_u.mutation.AddLastWaveAt(v)
+	return _u
+}
+
+// ClearLastWaveAt clears the value of the "last_wave_at" field.
+func (_u *EmergencyCampaignUpdate) ClearLastWaveAt() *EmergencyCampaignUpdate {
+	_u.mutation.ClearLastWaveAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmergencyCampaignUpdate) SetStatus(v emergencycampaign.Status) *EmergencyCampaignUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdate) SetNillableStatus(v *emergencycampaign.Status) *EmergencyCampaignUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *EmergencyCampaignUpdate) SetMessage(v string) *EmergencyCampaignUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdate) SetNillableMessage(v *string) *EmergencyCampaignUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *EmergencyCampaignUpdate) ClearMessage() *EmergencyCampaignUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// AddContactIDs adds the "contacts" edge to the EmergencyContact entity by IDs.
+func (_u *EmergencyCampaignUpdate) AddContactIDs(ids ...uuid.UUID) *EmergencyCampaignUpdate {
+	_u.mutation.AddContactIDs(ids...)
+	return _u
+}
+
+// AddContacts adds the "contacts" edges to the EmergencyContact entity.
+func (_u *EmergencyCampaignUpdate) AddContacts(v ...*EmergencyContact) *EmergencyCampaignUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContactIDs(ids...)
+}
+
+// Mutation returns the EmergencyCampaignMutation object of the builder.
+func (_u *EmergencyCampaignUpdate) Mutation() *EmergencyCampaignMutation {
+	return _u.mutation
+}
+
+// ClearContacts clears all "contacts" edges to the EmergencyContact entity.
+func (_u *EmergencyCampaignUpdate) ClearContacts() *EmergencyCampaignUpdate {
+	_u.mutation.ClearContacts()
+	return _u
+}
+
+// RemoveContactIDs removes the "contacts" edge to EmergencyContact entities by IDs.
+func (_u *EmergencyCampaignUpdate) RemoveContactIDs(ids ...uuid.UUID) *EmergencyCampaignUpdate {
+	_u.mutation.RemoveContactIDs(ids...)
+	return _u
+}
+
+// RemoveContacts removes "contacts" edges to EmergencyContact entities.
+func (_u *EmergencyCampaignUpdate) RemoveContacts(v ...*EmergencyContact) *EmergencyCampaignUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContactIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmergencyCampaignUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmergencyCampaignUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmergencyCampaignUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmergencyCampaignUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmergencyCampaignUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := emergencycampaign.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmergencyCampaignUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := emergencycampaign.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := emergencycampaign.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommittedDonors(); ok {
+		if err := emergencycampaign.CommittedDonorsValidator(v); err != nil {
+			return &ValidationError{Name: "committed_donors", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.committed_donors": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Waves(); ok {
+		if err := emergencycampaign.WavesValidator(v); err != nil {
+			return &ValidationError{Name: "waves", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.waves": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := emergencycampaign.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := emergencycampaign.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.message": %w`, err)}
+		}
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyCampaign.pmi_location"`)
+	}
+	if _u.mutation.BloodTypeCleared() && len(_u.mutation.BloodTypeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyCampaign.blood_type"`)
+	}
+	if _u.mutation.CreatedByCleared() && len(_u.mutation.CreatedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyCampaign.created_by"`)
+	}
+	return nil
+}
+
+func (_u *EmergencyCampaignUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emergencycampaign.Table, emergencycampaign.Columns, sqlgraph.NewFieldSpec(emergencycampaign.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencycampaign.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(emergencycampaign.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(emergencycampaign.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(emergencycampaign.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(emergencycampaign.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(emergencycampaign.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.CommittedDonors(); ok {
+		_spec.SetField(emergencycampaign.FieldCommittedDonors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommittedDonors(); ok {
+		_spec.AddField(emergencycampaign.FieldCommittedDonors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Waves(); ok {
+		_spec.SetField(emergencycampaign.FieldWaves, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWaves(); ok {
+		_spec.AddField(emergencycampaign.FieldWaves, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastWaveAt(); ok {
+		_spec.SetField(emergencycampaign.FieldLastWaveAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastWaveAt(); ok {
+		_spec.AddField(emergencycampaign.FieldLastWaveAt, field.TypeInt64, value)
+	}
+	if _u.mutation.LastWaveAtCleared() {
+		_spec.ClearField(emergencycampaign.FieldLastWaveAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(emergencycampaign.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(emergencycampaign.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(emergencycampaign.FieldMessage, field.TypeString)
+	}
+	if _u.mutation.ContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   emergencycampaign.ContactsTable,
+			Columns: []string{emergencycampaign.ContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContactsIDs(); len(nodes) > 0 && !_u.mutation.ContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   emergencycampaign.ContactsTable,
+			Columns: []string{emergencycampaign.ContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   emergencycampaign.ContactsTable,
+			Columns: []string{emergencycampaign.ContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emergencycampaign.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmergencyCampaignUpdateOne is the builder for updating a single EmergencyCampaign entity.
+type EmergencyCampaignUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmergencyCampaignMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmergencyCampaignUpdateOne) SetUpdatedAt(v int64) *EmergencyCampaignUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *EmergencyCampaignUpdateOne) AddUpdatedAt(v int64) *EmergencyCampaignUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *EmergencyCampaignUpdateOne) ClearUpdatedAt() *EmergencyCampaignUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EmergencyCampaignUpdateOne) SetDeletedAt(v int64) *EmergencyCampaignUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdateOne) SetNillableDeletedAt(v *int64) *EmergencyCampaignUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *EmergencyCampaignUpdateOne) AddDeletedAt(v int64) *EmergencyCampaignUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EmergencyCampaignUpdateOne) ClearDeletedAt() *EmergencyCampaignUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCommittedDonors sets the "committed_donors" field.
+func (_u *EmergencyCampaignUpdateOne) SetCommittedDonors(v int) *EmergencyCampaignUpdateOne {
+	_u.mutation.ResetCommittedDonors()
+	_u.mutation.SetCommittedDonors(v)
+	return _u
+}
+
+// SetNillableCommittedDonors sets the "committed_donors" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdateOne) SetNillableCommittedDonors(v *int) *EmergencyCampaignUpdateOne {
+	if v != nil {
+		_u.SetCommittedDonors(*v)
+	}
+	return _u
+}
+
+// AddCommittedDonors adds value to the "committed_donors" field.
+func (_u *EmergencyCampaignUpdateOne) AddCommittedDonors(v int) *EmergencyCampaignUpdateOne {
+	_u.mutation.AddCommittedDonors(v)
+	return _u
+}
+
+// SetWaves sets the "waves" field.
+func (_u *EmergencyCampaignUpdateOne) SetWaves(v int) *EmergencyCampaignUpdateOne {
+	_u.mutation.ResetWaves()
+	_u.mutation.SetWaves(v)
+	return _u
+}
+
+// SetNillableWaves sets the "waves" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdateOne) SetNillableWaves(v *int) *EmergencyCampaignUpdateOne {
+	if v != nil {
+		_u.SetWaves(*v)
+	}
+	return _u
+}
+
+// AddWaves adds value to the "waves" field.
+func (_u *EmergencyCampaignUpdateOne) AddWaves(v int) *EmergencyCampaignUpdateOne {
+	_u.mutation.AddWaves(v)
+	return _u
+}
+
+// SetLastWaveAt sets the "last_wave_at" field.
+func (_u *EmergencyCampaignUpdateOne) SetLastWaveAt(v int64) *EmergencyCampaignUpdateOne {
+	_u.mutation.ResetLastWaveAt()
+	_u.mutation.SetLastWaveAt(v)
+	return _u
+}
+
+// SetNillableLastWaveAt sets the "last_wave_at" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdateOne) SetNillableLastWaveAt(v *int64) *EmergencyCampaignUpdateOne {
+	if v != nil {
+		_u.SetLastWaveAt(*v)
+	}
+	return _u
+}
+
+// AddLastWaveAt adds value to the "last_wave_at" field.
+func (_u *EmergencyCampaignUpdateOne) AddLastWaveAt(v int64) *EmergencyCampaignUpdateOne {
+	_u.mutation.AddLastWaveAt(v)
+	return _u
+}
+
+// ClearLastWaveAt clears the value of the "last_wave_at" field.
+func (_u *EmergencyCampaignUpdateOne) ClearLastWaveAt() *EmergencyCampaignUpdateOne {
+	_u.mutation.ClearLastWaveAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmergencyCampaignUpdateOne) SetStatus(v emergencycampaign.Status) *EmergencyCampaignUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdateOne) SetNillableStatus(v *emergencycampaign.Status) *EmergencyCampaignUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *EmergencyCampaignUpdateOne) SetMessage(v string) *EmergencyCampaignUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *EmergencyCampaignUpdateOne) SetNillableMessage(v *string) *EmergencyCampaignUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *EmergencyCampaignUpdateOne) ClearMessage() *EmergencyCampaignUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// AddContactIDs adds the "contacts" edge to the EmergencyContact entity by IDs.
+func (_u *EmergencyCampaignUpdateOne) AddContactIDs(ids ...uuid.UUID) *EmergencyCampaignUpdateOne {
+	_u.mutation.AddContactIDs(ids...)
+	return _u
+}
+
+// AddContacts adds the "contacts" edges to the EmergencyContact entity.
+func (_u *EmergencyCampaignUpdateOne) AddContacts(v ...*EmergencyContact) *EmergencyCampaignUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContactIDs(ids...)
+}
+
+// Mutation returns the EmergencyCampaignMutation object of the builder.
+func (_u *EmergencyCampaignUpdateOne) Mutation() *EmergencyCampaignMutation {
+	return _u.mutation
+}
+
+// ClearContacts clears all "contacts" edges to the EmergencyContact entity.
+func (_u *EmergencyCampaignUpdateOne) ClearContacts() *EmergencyCampaignUpdateOne {
+	_u.mutation.ClearContacts()
+	return _u
+}
+
+// RemoveContactIDs removes the "contacts" edge to EmergencyContact entities by IDs.
+func (_u *EmergencyCampaignUpdateOne) RemoveContactIDs(ids ...uuid.UUID) *EmergencyCampaignUpdateOne {
+	_u.mutation.RemoveContactIDs(ids...)
+	return _u
+}
+
+// RemoveContacts removes "contacts" edges to EmergencyContact entities.
+func (_u *EmergencyCampaignUpdateOne) RemoveContacts(v ...*EmergencyContact) *EmergencyCampaignUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContactIDs(ids...)
+}
+
+// Where appends a list predicates to the EmergencyCampaignUpdate builder.
+func (_u *EmergencyCampaignUpdateOne) Where(ps ...predicate.EmergencyCampaign) *EmergencyCampaignUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmergencyCampaignUpdateOne) Select(field string, fields ...string) *EmergencyCampaignUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmergencyCampaign entity.
+func (_u *EmergencyCampaignUpdateOne) Save(ctx context.Context) (*EmergencyCampaign, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmergencyCampaignUpdateOne) SaveX(ctx context.Context) *EmergencyCampaign {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmergencyCampaignUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmergencyCampaignUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmergencyCampaignUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := emergencycampaign.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmergencyCampaignUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := emergencycampaign.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := emergencycampaign.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommittedDonors(); ok {
+		if err := emergencycampaign.CommittedDonorsValidator(v); err != nil {
+			return &ValidationError{Name: "committed_donors", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.committed_donors": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Waves(); ok {
+		if err := emergencycampaign.WavesValidator(v); err != nil {
+			return &ValidationError{Name: "waves", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.waves": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := emergencycampaign.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := emergencycampaign.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "EmergencyCampaign.message": %w`, err)}
+		}
+	}
+	if _u.mutation.PmiLocationCleared() && len(_u.mutation.PmiLocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyCampaign.pmi_location"`)
+	}
+	if _u.mutation.BloodTypeCleared() && len(_u.mutation.BloodTypeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyCampaign.blood_type"`)
+	}
+	if _u.mutation.CreatedByCleared() && len(_u.mutation.CreatedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyCampaign.created_by"`)
+	}
+	return nil
+}
+
+func (_u *EmergencyCampaignUpdateOne) sqlSave(ctx context.Context) (_node *EmergencyCampaign, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emergencycampaign.Table, emergencycampaign.Columns, sqlgraph.NewFieldSpec(emergencycampaign.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmergencyCampaign.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emergencycampaign.FieldID)
+		for _, f := range fields {
+			if !emergencycampaign.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emergencycampaign.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencycampaign.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(emergencycampaign.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(emergencycampaign.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(emergencycampaign.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(emergencycampaign.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(emergencycampaign.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.CommittedDonors(); ok {
+		_spec.SetField(emergencycampaign.FieldCommittedDonors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommittedDonors(); ok {
+		_spec.AddField(emergencycampaign.FieldCommittedDonors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Waves(); ok {
+		_spec.SetField(emergencycampaign.FieldWaves, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWaves(); ok {
+		_spec.AddField(emergencycampaign.FieldWaves, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastWaveAt(); ok {
+		_spec.SetField(emergencycampaign.FieldLastWaveAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastWaveAt(); ok {
+		_spec.AddField(emergencycampaign.FieldLastWaveAt, field.TypeInt64, value)
+	}
+	if _u.mutation.LastWaveAtCleared() {
+		_spec.ClearField(emergencycampaign.FieldLastWaveAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(emergencycampaign.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(emergencycampaign.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(emergencycampaign.FieldMessage, field.TypeString)
+	}
+	if _u.mutation.ContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   emergencycampaign.ContactsTable,
+			Columns: []string{emergencycampaign.ContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContactsIDs(); len(nodes) > 0 && !_u.mutation.ContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   emergencycampaign.ContactsTable,
+			Columns: []string{emergencycampaign.ContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   emergencycampaign.ContactsTable,
+			Columns: []string{emergencycampaign.ContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EmergencyCampaign{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emergencycampaign.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
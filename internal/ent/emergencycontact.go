@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+)
+
+// EmergencyContact is the model entity for the EmergencyContact schema.
+type EmergencyContact struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Wave holds the value of the "wave" field.
+	Wave int `json:"wave"`
+	// Distance between the donor home and the PMI location in meters.
+	DistanceM int `json:"distance_m"`
+	// Status holds the value of the "status" field.
+	Status emergencycontact.Status `json:"status"`
+	// Time the donor responded in milliseconds.
+	RespondedAt int64 `json:"responded_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmergencyContactQuery when eager-loading is set.
+	Edges                 EmergencyContactEdges `json:"edges"`
+	emergency_campaign_id *uuid.UUID
+	account_id            *uuid.UUID
+	selectValues          sql.SelectValues
+}
+
+// EmergencyContactEdges holds the relations/edges for other nodes in the graph.
+type EmergencyContactEdges struct {
+	// Campaign holds the value of the campaign edge.
+	Campaign *EmergencyCampaign `json:"campaign,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CampaignOrErr returns the Campaign value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmergencyContactEdges) CampaignOrErr() (*EmergencyCampaign, error) {
+	if e.Campaign != nil {
+		return e.Campaign, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: emergencycampaign.Label}
+	}
+	return nil, &NotLoadedError{edge: "campaign"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmergencyContactEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmergencyContact) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emergencycontact.FieldCreatedAt, emergencycontact.FieldUpdatedAt, emergencycontact.FieldDeletedAt, emergencycontact.FieldWave, emergencycontact.FieldDistanceM, emergencycontact.FieldRespondedAt:
+			values[i] = new(sql.NullInt64)
+		case emergencycontact.FieldStatus:
+			values[i] = new(sql.NullString)
+		case emergencycontact.FieldID:
+			values[i] = new(uuid.UUID)
+		case emergencycontact.ForeignKeys[0]: // emergency_campaign_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case emergencycontact.ForeignKeys[1]: // account_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmergencyContact fields.
+func (_m *EmergencyContact) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emergencycontact.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case emergencycontact.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case emergencycontact.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case emergencycontact.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case emergencycontact.FieldWave:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wave", values[i])
+			} else if value.Valid {
+				_m.Wave = int(value.Int64)
+			}
+		case emergencycontact.FieldDistanceM:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field distance_m", values[i])
+			} else if value.Valid {
+				_m.DistanceM = int(value.Int64)
+			}
+		case emergencycontact.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = emergencycontact.Status(value.String)
+			}
+		case emergencycontact.FieldRespondedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[i])
+			} else if value.Valid {
+				_m.RespondedAt = value.Int64
+			}
+		case emergencycontact.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field emergency_campaign_id", values[i])
+			} else if value.Valid {
+				_m.emergency_campaign_id = new(uuid.UUID)
+				*_m.emergency_campaign_id = *value.S.(*uuid.UUID)
+			}
+		case emergencycontact.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.account_id = new(uuid.UUID)
+				*_m.account_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmergencyContact.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmergencyContact) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCampaign queries the "campaign" edge of the EmergencyContact entity.
+func (_m *EmergencyContact) QueryCampaign() *EmergencyCampaignQuery {
+	return NewEmergencyContactClient(_m.config).QueryCampaign(_m)
+}
+
+// QueryAccount queries the "account" edge of the EmergencyContact entity.
+func (_m *EmergencyContact) QueryAccount() *AccountQuery {
+	return NewEmergencyContactClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this EmergencyContact.
+// Note that you need to call EmergencyContact.Unwrap() before calling this method if this EmergencyContact
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmergencyContact) Update() *EmergencyContactUpdateOne {
+	return NewEmergencyContactClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmergencyContact entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmergencyContact) Unwrap() *EmergencyContact {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmergencyContact is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmergencyContact) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmergencyContact(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("wave=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Wave))
+	builder.WriteString(", ")
+	builder.WriteString("distance_m=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DistanceM))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("responded_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RespondedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmergencyContacts is a parsable slice of EmergencyContact.
+type EmergencyContacts []*EmergencyContact
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emergencycontact
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the emergencycontact type in the database.
+	Label = "emergency_contact"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldWave holds the string denoting the wave field in the database.
+	FieldWave = "wave"
+	// FieldDistanceM holds the string denoting the distance_m field in the database.
+	FieldDistanceM = "distance_m"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRespondedAt holds the string denoting the responded_at field in the database.
+	FieldRespondedAt = "responded_at"
+	// EdgeCampaign holds the string denoting the campaign edge name in mutations.
+	EdgeCampaign = "campaign"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// Table holds the table name of the emergencycontact in the database.
+	Table = "emergency_contacts"
+	// CampaignTable is the table that holds the campaign relation/edge.
+	CampaignTable = "emergency_contacts"
+	// CampaignInverseTable is the table name for the EmergencyCampaign entity.
+	// It exists in this package in order to avoid circular dependency with the "emergencycampaign" package.
+	CampaignInverseTable = "emergency_campaigns"
+	// CampaignColumn is the table column denoting the campaign relation/edge.
+	CampaignColumn = "emergency_campaign_id"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "emergency_contacts"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+)
+
+// Columns holds all SQL columns for emergencycontact fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldWave,
+	FieldDistanceM,
+	FieldStatus,
+	FieldRespondedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "emergency_contacts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"emergency_campaign_id",
+	"account_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// WaveValidator is a validator for the "wave" field. It is called by the builders before save.
+	WaveValidator func(int) error
+	// DistanceMValidator is a validator for the "distance_m" field. It is called by the builders before save.
+	DistanceMValidator func(int) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusNotified is the default value of the Status enum.
+const DefaultStatus = StatusNotified
+
+// Status values.
+const (
+	StatusNotified Status = "NOTIFIED"
+	StatusAccepted Status = "ACCEPTED"
+	StatusDeclined Status = "DECLINED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusNotified, StatusAccepted, StatusDeclined:
+		return nil
+	default:
+		return fmt.Errorf("emergencycontact: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the EmergencyContact queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByWave orders the results by the wave field.
+func ByWave(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWave, opts...).ToFunc()
+}
+
+// ByDistanceM orders the results by the distance_m field.
+func ByDistanceM(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDistanceM, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRespondedAt orders the results by the responded_at field.
+func ByRespondedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRespondedAt, opts...).ToFunc()
+}
+
+// ByCampaignField orders the results by campaign field.
+func ByCampaignField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCampaignStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCampaignStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CampaignInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CampaignTable, CampaignColumn),
+	)
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+	)
+}
@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emergencycontact
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Wave applies equality check predicate on the "wave" field. It's identical to WaveEQ.
+func Wave(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldWave, v))
+}
+
+// DistanceM applies equality check predicate on the "distance_m" field. It's identical to DistanceMEQ.
+func DistanceM(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldDistanceM, v))
+}
+
+// RespondedAt applies equality check predicate on the "responded_at" field. It's identical to RespondedAtEQ.
+func RespondedAt(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// WaveEQ applies the EQ predicate on the "wave" field.
+func WaveEQ(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldWave, v))
+}
+
+// WaveNEQ applies the NEQ predicate on the "wave" field.
+func WaveNEQ(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldWave, v))
+}
+
+// WaveIn applies the In predicate on the "wave" field.
+func WaveIn(vs ...int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldWave, vs...))
+}
+
+// WaveNotIn applies the NotIn predicate on the "wave" field.
+func WaveNotIn(vs ...int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldWave, vs...))
+}
+
+// WaveGT applies the GT predicate on the "wave" field.
+func WaveGT(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldWave, v))
+}
+
+// WaveGTE applies the GTE predicate on the "wave" field.
+func WaveGTE(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldWave, v))
+}
+
+// WaveLT applies the LT predicate on the "wave" field.
+func WaveLT(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldWave, v))
+}
+
+// WaveLTE applies the LTE predicate on the "wave" field.
+func WaveLTE(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldWave, v))
+}
+
+// DistanceMEQ applies the EQ predicate on the "distance_m" field.
+func DistanceMEQ(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldDistanceM, v))
+}
+
+// DistanceMNEQ applies the NEQ predicate on the "distance_m" field.
+func DistanceMNEQ(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldDistanceM, v))
+}
+
+// DistanceMIn applies the In predicate on the "distance_m" field.
+func DistanceMIn(vs ...int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldDistanceM, vs...))
+}
+
+// DistanceMNotIn applies the NotIn predicate on the "distance_m" field.
+func DistanceMNotIn(vs ...int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldDistanceM, vs...))
+}
+
+// DistanceMGT applies the GT predicate on the "distance_m" field.
+func DistanceMGT(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldDistanceM, v))
+}
+
+// DistanceMGTE applies the GTE predicate on the "distance_m" field.
+func DistanceMGTE(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldDistanceM, v))
+}
+
+// DistanceMLT applies the LT predicate on the "distance_m" field.
+func DistanceMLT(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldDistanceM, v))
+}
+
+// DistanceMLTE applies the LTE predicate on the "distance_m" field.
+func DistanceMLTE(v int) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldDistanceM, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// RespondedAtEQ applies the EQ predicate on the "responded_at" field.
+func RespondedAtEQ(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtNEQ applies the NEQ predicate on the "responded_at" field.
+func RespondedAtNEQ(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtIn applies the In predicate on the "responded_at" field.
+func RespondedAtIn(vs ...int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtNotIn applies the NotIn predicate on the "responded_at" field.
+func RespondedAtNotIn(vs ...int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtGT applies the GT predicate on the "responded_at" field.
+func RespondedAtGT(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGT(FieldRespondedAt, v))
+}
+
+// RespondedAtGTE applies the GTE predicate on the "responded_at" field.
+func RespondedAtGTE(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldGTE(FieldRespondedAt, v))
+}
+
+// RespondedAtLT applies the LT predicate on the "responded_at" field.
+func RespondedAtLT(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLT(FieldRespondedAt, v))
+}
+
+// RespondedAtLTE applies the LTE predicate on the "responded_at" field.
+func RespondedAtLTE(v int64) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldLTE(FieldRespondedAt, v))
+}
+
+// RespondedAtIsNil applies the IsNil predicate on the "responded_at" field.
+func RespondedAtIsNil() predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldIsNull(FieldRespondedAt))
+}
+
+// RespondedAtNotNil applies the NotNil predicate on the "responded_at" field.
+func RespondedAtNotNil() predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.FieldNotNull(FieldRespondedAt))
+}
+
+// HasCampaign applies the HasEdge predicate on the "campaign" edge.
+func HasCampaign() predicate.EmergencyContact {
+	return predicate.EmergencyContact(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CampaignTable, CampaignColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCampaignWith applies the HasEdge predicate on the "campaign" edge with a given conditions (other predicates).
+func HasCampaignWith(preds ...predicate.EmergencyCampaign) predicate.EmergencyContact {
+	return predicate.EmergencyContact(func(s *sql.Selector) {
+		step := newCampaignStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.EmergencyContact {
+	return predicate.EmergencyContact(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.EmergencyContact {
+	return predicate.EmergencyContact(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmergencyContact) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmergencyContact) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmergencyContact) predicate.EmergencyContact {
+	return predicate.EmergencyContact(sql.NotPredicates(p))
+}
@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+)
+
+// EmergencyContactCreate is the builder for creating a EmergencyContact entity.
+type EmergencyContactCreate struct {
+	config
+	mutation *EmergencyContactMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmergencyContactCreate) SetCreatedAt(v int64) *EmergencyContactCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EmergencyContactCreate) SetUpdatedAt(v int64) *EmergencyContactCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EmergencyContactCreate) SetNillableUpdatedAt(v *int64) *EmergencyContactCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *EmergencyContactCreate) SetDeletedAt(v int64) *EmergencyContactCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *EmergencyContactCreate) SetNillableDeletedAt(v *int64) *EmergencyContactCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetWave sets the "wave" field.
+func (_c *EmergencyContactCreate) SetWave(v int) *EmergencyContactCreate {
+	_c.mutation.SetWave(v)
+	return _c
+}
+
+// SetDistanceM sets the "distance_m" field.
+func (_c *EmergencyContactCreate) SetDistanceM(v int) *EmergencyContactCreate {
+	_c.mutation.SetDistanceM(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EmergencyContactCreate) SetStatus(v emergencycontact.Status) *EmergencyContactCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EmergencyContactCreate) SetNillableStatus(v *emergencycontact.Status) *EmergencyContactCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_c *EmergencyContactCreate) SetRespondedAt(v int64) *EmergencyContactCreate {
+	_c.mutation.SetRespondedAt(v)
+	return _c
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_c *EmergencyContactCreate) SetNillableRespondedAt(v *int64) *EmergencyContactCreate {
+	if v != nil {
+		_c.SetRespondedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmergencyContactCreate) SetID(v uuid.UUID) *EmergencyContactCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetCampaignID sets the "campaign" edge to the EmergencyCampaign entity by ID.
+func (_c *EmergencyContactCreate) SetCampaignID(id uuid.UUID) *EmergencyContactCreate {
+	_c.mutation.SetCampaignID(id)
+	return _c
+}
+
+// SetCampaign sets the "campaign" edge to the EmergencyCampaign entity.
+func (_c *EmergencyContactCreate) SetCampaign(v *EmergencyCampaign) *EmergencyContactCreate {
+	return _c.SetCampaignID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *EmergencyContactCreate) SetAccountID(id uuid.UUID) *EmergencyContactCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *EmergencyContactCreate) SetAccount(v *Account) *EmergencyContactCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the EmergencyContactMutation object of the builder.
+func (_c *EmergencyContactCreate) Mutation() *EmergencyContactMutation {
+	return _c.mutation
+}
+
+// Save creates the EmergencyContact in the database.
+func (_c *EmergencyContactCreate) Save(ctx context.Context) (*EmergencyContact, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmergencyContactCreate) SaveX(ctx context.Context) *EmergencyContact {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmergencyContactCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmergencyContactCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmergencyContactCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := emergencycontact.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmergencyContactCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := emergencycontact.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := emergencycontact.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := emergencycontact.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Wave(); !ok {
+		return &ValidationError{Name: "wave", err: errors.New(`ent: missing required field "EmergencyContact.wave"`)}
+	}
+	if v, ok := _c.mutation.Wave(); ok {
+		if err := emergencycontact.WaveValidator(v); err != nil {
+			return &ValidationError{Name: "wave", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.wave": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DistanceM(); !ok {
+		return &ValidationError{Name: "distance_m", err: errors.New(`ent: missing required field "EmergencyContact.distance_m"`)}
+	}
+	if v, ok := _c.mutation.DistanceM(); ok {
+		if err := emergencycontact.DistanceMValidator(v); err != nil {
+			return &ValidationError{Name: "distance_m", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.distance_m": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EmergencyContact.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := emergencycontact.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.CampaignIDs()) == 0 {
+		return &ValidationError{Name: "campaign", err: errors.New(`ent: missing required edge "EmergencyContact.campaign"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "EmergencyContact.account"`)}
+	}
+	return nil
+}
+
+func (_c *EmergencyContactCreate) sqlSave(ctx context.Context) (*EmergencyContact, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmergencyContactCreate) createSpec() (*EmergencyContact, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmergencyContact{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emergencycontact.Table, sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emergencycontact.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencycontact.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(emergencycontact.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Wave(); ok {
+		_spec.SetField(emergencycontact.FieldWave, field.TypeInt, value)
+		_node.Wave = value
+	}
+	if value, ok := _c.mutation.DistanceM(); ok {
+		_spec.SetField(emergencycontact.FieldDistanceM, field.TypeInt, value)
+		_node.DistanceM = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(emergencycontact.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RespondedAt(); ok {
+		_spec.SetField(emergencycontact.FieldRespondedAt, field.TypeInt64, value)
+		_node.RespondedAt = value
+	}
+	if nodes := _c.mutation.CampaignIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   emergencycontact.CampaignTable,
+			Columns: []string{emergencycontact.CampaignColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emergencycampaign.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.emergency_campaign_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   emergencycontact.AccountTable,
+			Columns: []string{emergencycontact.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmergencyContactCreateBulk is the builder for creating many EmergencyContact entities in bulk.
+type EmergencyContactCreateBulk struct {
+	config
+	err      error
+	builders []*EmergencyContactCreate
+}
+
+// Save creates the EmergencyContact entities in the database.
+func (_c *EmergencyContactCreateBulk) Save(ctx context.Context) ([]*EmergencyContact, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmergencyContact, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmergencyContactMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmergencyContactCreateBulk) SaveX(ctx context.Context) []*EmergencyContact {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmergencyContactCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmergencyContactCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
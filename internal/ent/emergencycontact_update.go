@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// EmergencyContactUpdate is the builder for updating EmergencyContact entities.
+type EmergencyContactUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmergencyContactMutation
+}
+
+// Where appends a list predicates to the EmergencyContactUpdate builder.
+func (_u *EmergencyContactUpdate) Where(ps ...predicate.EmergencyContact) *EmergencyContactUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmergencyContactUpdate) SetUpdatedAt(v int64) *EmergencyContactUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *EmergencyContactUpdate) AddUpdatedAt(v int64) *EmergencyContactUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *EmergencyContactUpdate) ClearUpdatedAt() *EmergencyContactUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EmergencyContactUpdate) SetDeletedAt(v int64) *EmergencyContactUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EmergencyContactUpdate) SetNillableDeletedAt(v *int64) *EmergencyContactUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *EmergencyContactUpdate) AddDeletedAt(v int64) *EmergencyContactUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EmergencyContactUpdate) ClearDeletedAt() *EmergencyContactUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmergencyContactUpdate) SetStatus(v emergencycontact.Status) *EmergencyContactUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmergencyContactUpdate) SetNillableStatus(v *emergencycontact.Status) *EmergencyContactUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *EmergencyContactUpdate) SetRespondedAt(v int64) *EmergencyContactUpdate {
+	_u.mutation.ResetRespondedAt()
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *EmergencyContactUpdate) SetNillableRespondedAt(v *int64) *EmergencyContactUpdate {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// AddRespondedAt adds value to the "responded_at" field.
+func (_u *EmergencyContactUpdate) AddRespondedAt(v int64) *EmergencyContactUpdate {
+	_u.mutation.AddRespondedAt(v)
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *EmergencyContactUpdate) ClearRespondedAt() *EmergencyContactUpdate {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// Mutation returns the EmergencyContactMutation object of the builder.
+func (_u *EmergencyContactUpdate) Mutation() *EmergencyContactMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmergencyContactUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmergencyContactUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmergencyContactUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmergencyContactUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmergencyContactUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := emergencycontact.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmergencyContactUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := emergencycontact.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := emergencycontact.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := emergencycontact.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.status": %w`, err)}
+		}
+	}
+	if _u.mutation.CampaignCleared() && len(_u.mutation.CampaignIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyContact.campaign"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyContact.account"`)
+	}
+	return nil
+}
+
+func (_u *EmergencyContactUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emergencycontact.Table, emergencycontact.Columns, sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencycontact.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(emergencycontact.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(emergencycontact.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(emergencycontact.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(emergencycontact.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(emergencycontact.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(emergencycontact.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(emergencycontact.FieldRespondedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRespondedAt(); ok {
+		_spec.AddField(emergencycontact.FieldRespondedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(emergencycontact.FieldRespondedAt, field.TypeInt64)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emergencycontact.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmergencyContactUpdateOne is the builder for updating a single EmergencyContact entity.
+type EmergencyContactUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmergencyContactMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmergencyContactUpdateOne) SetUpdatedAt(v int64) *EmergencyContactUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *EmergencyContactUpdateOne) AddUpdatedAt(v int64) *EmergencyContactUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *EmergencyContactUpdateOne) ClearUpdatedAt() *EmergencyContactUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EmergencyContactUpdateOne) SetDeletedAt(v int64) *EmergencyContactUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EmergencyContactUpdateOne) SetNillableDeletedAt(v *int64) *EmergencyContactUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *EmergencyContactUpdateOne) AddDeletedAt(v int64) *EmergencyContactUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EmergencyContactUpdateOne) ClearDeletedAt() *EmergencyContactUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmergencyContactUpdateOne) SetStatus(v emergencycontact.Status) *EmergencyContactUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmergencyContactUpdateOne) SetNillableStatus(v *emergencycontact.Status) *EmergencyContactUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *EmergencyContactUpdateOne) SetRespondedAt(v int64) *EmergencyContactUpdateOne {
+	_u.mutation.ResetRespondedAt()
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *EmergencyContactUpdateOne) SetNillableRespondedAt(v *int64) *EmergencyContactUpdateOne {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// AddRespondedAt adds value to the "responded_at" field.
+func (_u *EmergencyContactUpdateOne) AddRespondedAt(v int64) *EmergencyContactUpdateOne {
+	_u.mutation.AddRespondedAt(v)
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *EmergencyContactUpdateOne) ClearRespondedAt() *EmergencyContactUpdateOne {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// Mutation returns the EmergencyContactMutation object of the builder.
+func (_u *EmergencyContactUpdateOne) Mutation() *EmergencyContactMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmergencyContactUpdate builder.
+func (_u *EmergencyContactUpdateOne) Where(ps ...predicate.EmergencyContact) *EmergencyContactUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmergencyContactUpdateOne) Select(field string, fields ...string) *EmergencyContactUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmergencyContact entity.
+func (_u *EmergencyContactUpdateOne) Save(ctx context.Context) (*EmergencyContact, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmergencyContactUpdateOne) SaveX(ctx context.Context) *EmergencyContact {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmergencyContactUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmergencyContactUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmergencyContactUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := emergencycontact.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmergencyContactUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := emergencycontact.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := emergencycontact.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := emergencycontact.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmergencyContact.status": %w`, err)}
+		}
+	}
+	if _u.mutation.CampaignCleared() && len(_u.mutation.CampaignIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyContact.campaign"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmergencyContact.account"`)
+	}
+	return nil
+}
+
+func (_u *EmergencyContactUpdateOne) sqlSave(ctx context.Context) (_node *EmergencyContact, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emergencycontact.Table, emergencycontact.Columns, sqlgraph.NewFieldSpec(emergencycontact.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmergencyContact.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emergencycontact.FieldID)
+		for _, f := range fields {
+			if !emergencycontact.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emergencycontact.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencycontact.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(emergencycontact.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(emergencycontact.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(emergencycontact.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(emergencycontact.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(emergencycontact.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(emergencycontact.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(emergencycontact.FieldRespondedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRespondedAt(); ok {
+		_spec.AddField(emergencycontact.FieldRespondedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(emergencycontact.FieldRespondedAt, field.TypeInt64)
+	}
+	_node = &EmergencyContact{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emergencycontact.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,16 +13,37 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/apikey"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/auditlog"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
 	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
 	"github.com/sembraniteam/setetes/internal/ent/casbinrule"
+	"github.com/sembraniteam/setetes/internal/ent/certificate"
 	"github.com/sembraniteam/setetes/internal/ent/city"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
 	"github.com/sembraniteam/setetes/internal/ent/district"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+	"github.com/sembraniteam/setetes/internal/ent/donationreminder"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/notificationpreference"
 	"github.com/sembraniteam/setetes/internal/ent/otp"
 	"github.com/sembraniteam/setetes/internal/ent/password"
 	"github.com/sembraniteam/setetes/internal/ent/permission"
 	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
 	"github.com/sembraniteam/setetes/internal/ent/province"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
 	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/roleassignment"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
 	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
 )
 
@@ -84,18 +105,39 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			account.Table:     account.ValidColumn,
-			bloodtype.Table:   bloodtype.ValidColumn,
-			casbinrule.Table:  casbinrule.ValidColumn,
-			city.Table:        city.ValidColumn,
-			district.Table:    district.ValidColumn,
-			otp.Table:         otp.ValidColumn,
-			pmilocation.Table: pmilocation.ValidColumn,
-			password.Table:    password.ValidColumn,
-			permission.Table:  permission.ValidColumn,
-			province.Table:    province.ValidColumn,
-			role.Table:        role.ValidColumn,
-			subdistrict.Table: subdistrict.ValidColumn,
+			apikey.Table:                 apikey.ValidColumn,
+			account.Table:                account.ValidColumn,
+			appointment.Table:            appointment.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
+			bloodrequest.Table:           bloodrequest.ValidColumn,
+			bloodstock.Table:             bloodstock.ValidColumn,
+			bloodtype.Table:              bloodtype.ValidColumn,
+			bloodunit.Table:              bloodunit.ValidColumn,
+			bloodunitevent.Table:         bloodunitevent.ValidColumn,
+			casbinrule.Table:             casbinrule.ValidColumn,
+			certificate.Table:            certificate.ValidColumn,
+			city.Table:                   city.ValidColumn,
+			deferral.Table:               deferral.ValidColumn,
+			district.Table:               district.ValidColumn,
+			donation.Table:               donation.ValidColumn,
+			donationevent.Table:          donationevent.ValidColumn,
+			donationreminder.Table:       donationreminder.ValidColumn,
+			emergencycampaign.Table:      emergencycampaign.ValidColumn,
+			emergencycontact.Table:       emergencycontact.ValidColumn,
+			hospital.Table:               hospital.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			otp.Table:                    otp.ValidColumn,
+			pmilocation.Table:            pmilocation.ValidColumn,
+			password.Table:               password.ValidColumn,
+			permission.Table:             permission.ValidColumn,
+			province.Table:               province.ValidColumn,
+			questionnaire.Table:          questionnaire.ValidColumn,
+			role.Table:                   role.ValidColumn,
+			roleassignment.Table:         roleassignment.ValidColumn,
+			screeningquestion.Table:      screeningquestion.ValidColumn,
+			screeningsubmission.Table:    screeningsubmission.ValidColumn,
+			stockmovement.Table:          stockmovement.ValidColumn,
+			subdistrict.Table:            subdistrict.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
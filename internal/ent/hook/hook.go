@@ -9,6 +9,18 @@ import (
 	"github.com/sembraniteam/setetes/internal/ent"
 )
 
+// The APIKeyFunc type is an adapter to allow the use of ordinary
+// function as APIKey mutator.
+type APIKeyFunc func(context.Context, *ent.APIKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APIKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIKeyMutation", m)
+}
+
 // The AccountFunc type is an adapter to allow the use of ordinary
 // function as Account mutator.
 type AccountFunc func(context.Context, *ent.AccountMutation) (ent.Value, error)
@@ -21,6 +33,54 @@ func (f AccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountMutation", m)
 }
 
+// The AppointmentFunc type is an adapter to allow the use of ordinary
+// function as Appointment mutator.
+type AppointmentFunc func(context.Context, *ent.AppointmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AppointmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AppointmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AppointmentMutation", m)
+}
+
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+}
+
+// The BloodRequestFunc type is an adapter to allow the use of ordinary
+// function as BloodRequest mutator.
+type BloodRequestFunc func(context.Context, *ent.BloodRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BloodRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BloodRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BloodRequestMutation", m)
+}
+
+// The BloodStockFunc type is an adapter to allow the use of ordinary
+// function as BloodStock mutator.
+type BloodStockFunc func(context.Context, *ent.BloodStockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BloodStockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BloodStockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BloodStockMutation", m)
+}
+
 // The BloodTypeFunc type is an adapter to allow the use of ordinary
 // function as BloodType mutator.
 type BloodTypeFunc func(context.Context, *ent.BloodTypeMutation) (ent.Value, error)
@@ -33,6 +93,30 @@ func (f BloodTypeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BloodTypeMutation", m)
 }
 
+// The BloodUnitFunc type is an adapter to allow the use of ordinary
+// function as BloodUnit mutator.
+type BloodUnitFunc func(context.Context, *ent.BloodUnitMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BloodUnitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BloodUnitMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BloodUnitMutation", m)
+}
+
+// The BloodUnitEventFunc type is an adapter to allow the use of ordinary
+// function as BloodUnitEvent mutator.
+type BloodUnitEventFunc func(context.Context, *ent.BloodUnitEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BloodUnitEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BloodUnitEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BloodUnitEventMutation", m)
+}
+
 // The CasbinRuleFunc type is an adapter to allow the use of ordinary
 // function as CasbinRule mutator.
 type CasbinRuleFunc func(context.Context, *ent.CasbinRuleMutation) (ent.Value, error)
@@ -45,6 +129,18 @@ func (f CasbinRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CasbinRuleMutation", m)
 }
 
+// The CertificateFunc type is an adapter to allow the use of ordinary
+// function as Certificate mutator.
+type CertificateFunc func(context.Context, *ent.CertificateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CertificateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CertificateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CertificateMutation", m)
+}
+
 // The CityFunc type is an adapter to allow the use of ordinary
 // function as City mutator.
 type CityFunc func(context.Context, *ent.CityMutation) (ent.Value, error)
@@ -57,6 +153,18 @@ func (f CityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CityMutation", m)
 }
 
+// The DeferralFunc type is an adapter to allow the use of ordinary
+// function as Deferral mutator.
+type DeferralFunc func(context.Context, *ent.DeferralMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeferralFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeferralMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeferralMutation", m)
+}
+
 // The DistrictFunc type is an adapter to allow the use of ordinary
 // function as District mutator.
 type DistrictFunc func(context.Context, *ent.DistrictMutation) (ent.Value, error)
@@ -69,6 +177,90 @@ func (f DistrictFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DistrictMutation", m)
 }
 
+// The DonationFunc type is an adapter to allow the use of ordinary
+// function as Donation mutator.
+type DonationFunc func(context.Context, *ent.DonationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DonationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DonationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DonationMutation", m)
+}
+
+// The DonationEventFunc type is an adapter to allow the use of ordinary
+// function as DonationEvent mutator.
+type DonationEventFunc func(context.Context, *ent.DonationEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DonationEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DonationEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DonationEventMutation", m)
+}
+
+// The DonationReminderFunc type is an adapter to allow the use of ordinary
+// function as DonationReminder mutator.
+type DonationReminderFunc func(context.Context, *ent.DonationReminderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DonationReminderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DonationReminderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DonationReminderMutation", m)
+}
+
+// The EmergencyCampaignFunc type is an adapter to allow the use of ordinary
+// function as EmergencyCampaign mutator.
+type EmergencyCampaignFunc func(context.Context, *ent.EmergencyCampaignMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmergencyCampaignFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmergencyCampaignMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmergencyCampaignMutation", m)
+}
+
+// The EmergencyContactFunc type is an adapter to allow the use of ordinary
+// function as EmergencyContact mutator.
+type EmergencyContactFunc func(context.Context, *ent.EmergencyContactMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmergencyContactFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmergencyContactMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmergencyContactMutation", m)
+}
+
+// The HospitalFunc type is an adapter to allow the use of ordinary
+// function as Hospital mutator.
+type HospitalFunc func(context.Context, *ent.HospitalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HospitalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HospitalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HospitalMutation", m)
+}
+
+// The NotificationPreferenceFunc type is an adapter to allow the use of ordinary
+// function as NotificationPreference mutator.
+type NotificationPreferenceFunc func(context.Context, *ent.NotificationPreferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationPreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationPreferenceMutation", m)
+}
+
 // The OTPFunc type is an adapter to allow the use of ordinary
 // function as OTP mutator.
 type OTPFunc func(context.Context, *ent.OTPMutation) (ent.Value, error)
@@ -129,6 +321,18 @@ func (f ProvinceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProvinceMutation", m)
 }
 
+// The QuestionnaireFunc type is an adapter to allow the use of ordinary
+// function as Questionnaire mutator.
+type QuestionnaireFunc func(context.Context, *ent.QuestionnaireMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuestionnaireFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuestionnaireMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuestionnaireMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
@@ -141,6 +345,54 @@ func (f RoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleMutation", m)
 }
 
+// The RoleAssignmentFunc type is an adapter to allow the use of ordinary
+// function as RoleAssignment mutator.
+type RoleAssignmentFunc func(context.Context, *ent.RoleAssignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleAssignmentMutation", m)
+}
+
+// The ScreeningQuestionFunc type is an adapter to allow the use of ordinary
+// function as ScreeningQuestion mutator.
+type ScreeningQuestionFunc func(context.Context, *ent.ScreeningQuestionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScreeningQuestionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScreeningQuestionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScreeningQuestionMutation", m)
+}
+
+// The ScreeningSubmissionFunc type is an adapter to allow the use of ordinary
+// function as ScreeningSubmission mutator.
+type ScreeningSubmissionFunc func(context.Context, *ent.ScreeningSubmissionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScreeningSubmissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScreeningSubmissionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScreeningSubmissionMutation", m)
+}
+
+// The StockMovementFunc type is an adapter to allow the use of ordinary
+// function as StockMovement mutator.
+type StockMovementFunc func(context.Context, *ent.StockMovementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StockMovementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StockMovementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StockMovementMutation", m)
+}
+
 // The SubdistrictFunc type is an adapter to allow the use of ordinary
 // function as Subdistrict mutator.
 type SubdistrictFunc func(context.Context, *ent.SubdistrictMutation) (ent.Value, error)
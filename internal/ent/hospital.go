@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+)
+
+// Hospital is the model entity for the Hospital schema.
+type Hospital struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Hospital registration code issued by the Ministry of Health.
+	Code string `json:"code"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// Street holds the value of the "street" field.
+	Street string `json:"street"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email"`
+	// DialCode holds the value of the "dial_code" field.
+	DialCode string `json:"dial_code"`
+	// PhoneNumber holds the value of the "phone_number" field.
+	PhoneNumber string `json:"phone_number"`
+	// Activated holds the value of the "activated" field.
+	Activated bool `json:"activated"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HospitalQuery when eager-loading is set.
+	Edges          HospitalEdges `json:"edges"`
+	subdistrict_id *uuid.UUID
+	selectValues   sql.SelectValues
+}
+
+// HospitalEdges holds the relations/edges for other nodes in the graph.
+type HospitalEdges struct {
+	// Subdistrict holds the value of the subdistrict edge.
+	Subdistrict *Subdistrict `json:"subdistrict,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*Account `json:"members,omitempty"`
+	// BloodRequests holds the value of the blood_requests edge.
+	BloodRequests []*BloodRequest `json:"blood_requests,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// SubdistrictOrErr returns the Subdistrict value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HospitalEdges) SubdistrictOrErr() (*Subdistrict, error) {
+	if e.Subdistrict != nil {
+		return e.Subdistrict, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: subdistrict.Label}
+	}
+	return nil, &NotLoadedError{edge: "subdistrict"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e HospitalEdges) MembersOrErr() ([]*Account, error) {
+	if e.loadedTypes[1] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// BloodRequestsOrErr returns the BloodRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e HospitalEdges) BloodRequestsOrErr() ([]*BloodRequest, error) {
+	if e.loadedTypes[2] {
+		return e.BloodRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "blood_requests"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Hospital) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hospital.FieldActivated:
+			values[i] = new(sql.NullBool)
+		case hospital.FieldCreatedAt, hospital.FieldUpdatedAt, hospital.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case hospital.FieldCode, hospital.FieldName, hospital.FieldStreet, hospital.FieldEmail, hospital.FieldDialCode, hospital.FieldPhoneNumber:
+			values[i] = new(sql.NullString)
+		case hospital.FieldID:
+			values[i] = new(uuid.UUID)
+		case hospital.ForeignKeys[0]: // subdistrict_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Hospital fields.
+func (_m *Hospital) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hospital.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case hospital.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case hospital.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case hospital.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case hospital.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case hospital.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case hospital.FieldStreet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field street", values[i])
+			} else if value.Valid {
+				_m.Street = value.String
+			}
+		case hospital.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case hospital.FieldDialCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dial_code", values[i])
+			} else if value.Valid {
+				_m.DialCode = value.String
+			}
+		case hospital.FieldPhoneNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone_number", values[i])
+			} else if value.Valid {
+				_m.PhoneNumber = value.String
+			}
+		case hospital.FieldActivated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field activated", values[i])
+			} else if value.Valid {
+				_m.Activated = value.Bool
+			}
+		case hospital.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field subdistrict_id", values[i])
+			} else if value.Valid {
+				_m.subdistrict_id = new(uuid.UUID)
+				*_m.subdistrict_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Hospital.
+// This includes values selected through modifiers, order, etc.
+func (_m *Hospital) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySubdistrict queries the "subdistrict" edge of the Hospital entity.
+func (_m *Hospital) QuerySubdistrict() *SubdistrictQuery {
+	return NewHospitalClient(_m.config).QuerySubdistrict(_m)
+}
+
+// QueryMembers queries the "members" edge of the Hospital entity.
+func (_m *Hospital) QueryMembers() *AccountQuery {
+	return NewHospitalClient(_m.config).QueryMembers(_m)
+}
+
+// QueryBloodRequests queries the "blood_requests" edge of the Hospital entity.
+func (_m *Hospital) QueryBloodRequests() *BloodRequestQuery {
+	return NewHospitalClient(_m.config).QueryBloodRequests(_m)
+}
+
+// Update returns a builder for updating this Hospital.
+// Note that you need to call Hospital.Unwrap() before calling this method if this Hospital
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Hospital) Update() *HospitalUpdateOne {
+	return NewHospitalClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Hospital entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Hospital) Unwrap() *Hospital {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Hospital is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Hospital) String() string {
+	var builder strings.Builder
+	builder.WriteString("Hospital(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("street=")
+	builder.WriteString(_m.Street)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("dial_code=")
+	builder.WriteString(_m.DialCode)
+	builder.WriteString(", ")
+	builder.WriteString("phone_number=")
+	builder.WriteString(_m.PhoneNumber)
+	builder.WriteString(", ")
+	builder.WriteString("activated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Activated))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Hospitals is a parsable slice of Hospital.
+type Hospitals []*Hospital
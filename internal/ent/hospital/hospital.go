@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hospital
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the hospital type in the database.
+	Label = "hospital"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStreet holds the string denoting the street field in the database.
+	FieldStreet = "street"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldDialCode holds the string denoting the dial_code field in the database.
+	FieldDialCode = "dial_code"
+	// FieldPhoneNumber holds the string denoting the phone_number field in the database.
+	FieldPhoneNumber = "phone_number"
+	// FieldActivated holds the string denoting the activated field in the database.
+	FieldActivated = "activated"
+	// EdgeSubdistrict holds the string denoting the subdistrict edge name in mutations.
+	EdgeSubdistrict = "subdistrict"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// EdgeBloodRequests holds the string denoting the blood_requests edge name in mutations.
+	EdgeBloodRequests = "blood_requests"
+	// Table holds the table name of the hospital in the database.
+	Table = "hospitals"
+	// SubdistrictTable is the table that holds the subdistrict relation/edge.
+	SubdistrictTable = "hospitals"
+	// SubdistrictInverseTable is the table name for the Subdistrict entity.
+	// It exists in this package in order to avoid circular dependency with the "subdistrict" package.
+	SubdistrictInverseTable = "subdistricts"
+	// SubdistrictColumn is the table column denoting the subdistrict relation/edge.
+	SubdistrictColumn = "subdistrict_id"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "accounts"
+	// MembersInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	MembersInverseTable = "accounts"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "hospital_id"
+	// BloodRequestsTable is the table that holds the blood_requests relation/edge.
+	BloodRequestsTable = "blood_requests"
+	// BloodRequestsInverseTable is the table name for the BloodRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodrequest" package.
+	BloodRequestsInverseTable = "blood_requests"
+	// BloodRequestsColumn is the table column denoting the blood_requests relation/edge.
+	BloodRequestsColumn = "hospital_id"
+)
+
+// Columns holds all SQL columns for hospital fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldCode,
+	FieldName,
+	FieldStreet,
+	FieldEmail,
+	FieldDialCode,
+	FieldPhoneNumber,
+	FieldActivated,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "hospitals"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"subdistrict_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// StreetValidator is a validator for the "street" field. It is called by the builders before save.
+	StreetValidator func(string) error
+	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	EmailValidator func(string) error
+	// DialCodeValidator is a validator for the "dial_code" field. It is called by the builders before save.
+	DialCodeValidator func(string) error
+	// PhoneNumberValidator is a validator for the "phone_number" field. It is called by the builders before save.
+	PhoneNumberValidator func(string) error
+	// DefaultActivated holds the default value on creation for the "activated" field.
+	DefaultActivated bool
+)
+
+// OrderOption defines the ordering options for the Hospital queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByStreet orders the results by the street field.
+func ByStreet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStreet, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByDialCode orders the results by the dial_code field.
+func ByDialCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDialCode, opts...).ToFunc()
+}
+
+// ByPhoneNumber orders the results by the phone_number field.
+func ByPhoneNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhoneNumber, opts...).ToFunc()
+}
+
+// ByActivated orders the results by the activated field.
+func ByActivated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActivated, opts...).ToFunc()
+}
+
+// BySubdistrictField orders the results by subdistrict field.
+func BySubdistrictField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubdistrictStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBloodRequestsCount orders the results by blood_requests count.
+func ByBloodRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBloodRequestsStep(), opts...)
+	}
+}
+
+// ByBloodRequests orders the results by blood_requests terms.
+func ByBloodRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBloodRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSubdistrictStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubdistrictInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SubdistrictTable, SubdistrictColumn),
+	)
+}
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MembersTable, MembersColumn),
+	)
+}
+func newBloodRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BloodRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, BloodRequestsTable, BloodRequestsColumn),
+	)
+}
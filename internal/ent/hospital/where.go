@@ -0,0 +1,739 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hospital
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Hospital {
+	return predicate.Hospital(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldName, v))
+}
+
+// Street applies equality check predicate on the "street" field. It's identical to StreetEQ.
+func Street(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldStreet, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldEmail, v))
+}
+
+// DialCode applies equality check predicate on the "dial_code" field. It's identical to DialCodeEQ.
+func DialCode(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldDialCode, v))
+}
+
+// PhoneNumber applies equality check predicate on the "phone_number" field. It's identical to PhoneNumberEQ.
+func PhoneNumber(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldPhoneNumber, v))
+}
+
+// Activated applies equality check predicate on the "activated" field. It's identical to ActivatedEQ.
+func Activated(v bool) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldActivated, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Hospital {
+	return predicate.Hospital(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Hospital {
+	return predicate.Hospital(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContainsFold(FieldCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContainsFold(FieldName, v))
+}
+
+// StreetEQ applies the EQ predicate on the "street" field.
+func StreetEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldStreet, v))
+}
+
+// StreetNEQ applies the NEQ predicate on the "street" field.
+func StreetNEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldStreet, v))
+}
+
+// StreetIn applies the In predicate on the "street" field.
+func StreetIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldIn(FieldStreet, vs...))
+}
+
+// StreetNotIn applies the NotIn predicate on the "street" field.
+func StreetNotIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotIn(FieldStreet, vs...))
+}
+
+// StreetGT applies the GT predicate on the "street" field.
+func StreetGT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGT(FieldStreet, v))
+}
+
+// StreetGTE applies the GTE predicate on the "street" field.
+func StreetGTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGTE(FieldStreet, v))
+}
+
+// StreetLT applies the LT predicate on the "street" field.
+func StreetLT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLT(FieldStreet, v))
+}
+
+// StreetLTE applies the LTE predicate on the "street" field.
+func StreetLTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLTE(FieldStreet, v))
+}
+
+// StreetContains applies the Contains predicate on the "street" field.
+func StreetContains(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContains(FieldStreet, v))
+}
+
+// StreetHasPrefix applies the HasPrefix predicate on the "street" field.
+func StreetHasPrefix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasPrefix(FieldStreet, v))
+}
+
+// StreetHasSuffix applies the HasSuffix predicate on the "street" field.
+func StreetHasSuffix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasSuffix(FieldStreet, v))
+}
+
+// StreetEqualFold applies the EqualFold predicate on the "street" field.
+func StreetEqualFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEqualFold(FieldStreet, v))
+}
+
+// StreetContainsFold applies the ContainsFold predicate on the "street" field.
+func StreetContainsFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContainsFold(FieldStreet, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.Hospital {
+	return predicate.Hospital(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// DialCodeEQ applies the EQ predicate on the "dial_code" field.
+func DialCodeEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldDialCode, v))
+}
+
+// DialCodeNEQ applies the NEQ predicate on the "dial_code" field.
+func DialCodeNEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldDialCode, v))
+}
+
+// DialCodeIn applies the In predicate on the "dial_code" field.
+func DialCodeIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldIn(FieldDialCode, vs...))
+}
+
+// DialCodeNotIn applies the NotIn predicate on the "dial_code" field.
+func DialCodeNotIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotIn(FieldDialCode, vs...))
+}
+
+// DialCodeGT applies the GT predicate on the "dial_code" field.
+func DialCodeGT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGT(FieldDialCode, v))
+}
+
+// DialCodeGTE applies the GTE predicate on the "dial_code" field.
+func DialCodeGTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGTE(FieldDialCode, v))
+}
+
+// DialCodeLT applies the LT predicate on the "dial_code" field.
+func DialCodeLT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLT(FieldDialCode, v))
+}
+
+// DialCodeLTE applies the LTE predicate on the "dial_code" field.
+func DialCodeLTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLTE(FieldDialCode, v))
+}
+
+// DialCodeContains applies the Contains predicate on the "dial_code" field.
+func DialCodeContains(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContains(FieldDialCode, v))
+}
+
+// DialCodeHasPrefix applies the HasPrefix predicate on the "dial_code" field.
+func DialCodeHasPrefix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasPrefix(FieldDialCode, v))
+}
+
+// DialCodeHasSuffix applies the HasSuffix predicate on the "dial_code" field.
+func DialCodeHasSuffix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasSuffix(FieldDialCode, v))
+}
+
+// DialCodeEqualFold applies the EqualFold predicate on the "dial_code" field.
+func DialCodeEqualFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEqualFold(FieldDialCode, v))
+}
+
+// DialCodeContainsFold applies the ContainsFold predicate on the "dial_code" field.
+func DialCodeContainsFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContainsFold(FieldDialCode, v))
+}
+
+// PhoneNumberEQ applies the EQ predicate on the "phone_number" field.
+func PhoneNumberEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldPhoneNumber, v))
+}
+
+// PhoneNumberNEQ applies the NEQ predicate on the "phone_number" field.
+func PhoneNumberNEQ(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldPhoneNumber, v))
+}
+
+// PhoneNumberIn applies the In predicate on the "phone_number" field.
+func PhoneNumberIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldIn(FieldPhoneNumber, vs...))
+}
+
+// PhoneNumberNotIn applies the NotIn predicate on the "phone_number" field.
+func PhoneNumberNotIn(vs ...string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNotIn(FieldPhoneNumber, vs...))
+}
+
+// PhoneNumberGT applies the GT predicate on the "phone_number" field.
+func PhoneNumberGT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGT(FieldPhoneNumber, v))
+}
+
+// PhoneNumberGTE applies the GTE predicate on the "phone_number" field.
+func PhoneNumberGTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldGTE(FieldPhoneNumber, v))
+}
+
+// PhoneNumberLT applies the LT predicate on the "phone_number" field.
+func PhoneNumberLT(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLT(FieldPhoneNumber, v))
+}
+
+// PhoneNumberLTE applies the LTE predicate on the "phone_number" field.
+func PhoneNumberLTE(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldLTE(FieldPhoneNumber, v))
+}
+
+// PhoneNumberContains applies the Contains predicate on the "phone_number" field.
+func PhoneNumberContains(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContains(FieldPhoneNumber, v))
+}
+
+// PhoneNumberHasPrefix applies the HasPrefix predicate on the "phone_number" field.
+func PhoneNumberHasPrefix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasPrefix(FieldPhoneNumber, v))
+}
+
+// PhoneNumberHasSuffix applies the HasSuffix predicate on the "phone_number" field.
+func PhoneNumberHasSuffix(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldHasSuffix(FieldPhoneNumber, v))
+}
+
+// PhoneNumberEqualFold applies the EqualFold predicate on the "phone_number" field.
+func PhoneNumberEqualFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEqualFold(FieldPhoneNumber, v))
+}
+
+// PhoneNumberContainsFold applies the ContainsFold predicate on the "phone_number" field.
+func PhoneNumberContainsFold(v string) predicate.Hospital {
+	return predicate.Hospital(sql.FieldContainsFold(FieldPhoneNumber, v))
+}
+
+// ActivatedEQ applies the EQ predicate on the "activated" field.
+func ActivatedEQ(v bool) predicate.Hospital {
+	return predicate.Hospital(sql.FieldEQ(FieldActivated, v))
+}
+
+// ActivatedNEQ applies the NEQ predicate on the "activated" field.
+func ActivatedNEQ(v bool) predicate.Hospital {
+	return predicate.Hospital(sql.FieldNEQ(FieldActivated, v))
+}
+
+// HasSubdistrict applies the HasEdge predicate on the "subdistrict" edge.
+func HasSubdistrict() predicate.Hospital {
+	return predicate.Hospital(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SubdistrictTable, SubdistrictColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubdistrictWith applies the HasEdge predicate on the "subdistrict" edge with a given conditions (other predicates).
+func HasSubdistrictWith(preds ...predicate.Subdistrict) predicate.Hospital {
+	return predicate.Hospital(func(s *sql.Selector) {
+		step := newSubdistrictStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMembers applies the HasEdge predicate on the "members" edge.
+func HasMembers() predicate.Hospital {
+	return predicate.Hospital(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MembersTable, MembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembersWith applies the HasEdge predicate on the "members" edge with a given conditions (other predicates).
+func HasMembersWith(preds ...predicate.Account) predicate.Hospital {
+	return predicate.Hospital(func(s *sql.Selector) {
+		step := newMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBloodRequests applies the HasEdge predicate on the "blood_requests" edge.
+func HasBloodRequests() predicate.Hospital {
+	return predicate.Hospital(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, BloodRequestsTable, BloodRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBloodRequestsWith applies the HasEdge predicate on the "blood_requests" edge with a given conditions (other predicates).
+func HasBloodRequestsWith(preds ...predicate.BloodRequest) predicate.Hospital {
+	return predicate.Hospital(func(s *sql.Selector) {
+		step := newBloodRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Hospital) predicate.Hospital {
+	return predicate.Hospital(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Hospital) predicate.Hospital {
+	return predicate.Hospital(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Hospital) predicate.Hospital {
+	return predicate.Hospital(sql.NotPredicates(p))
+}
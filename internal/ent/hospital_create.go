@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+)
+
+// HospitalCreate is the builder for creating a Hospital entity.
+type HospitalCreate struct {
+	config
+	mutation *HospitalMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HospitalCreate) SetCreatedAt(v int64) *HospitalCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *HospitalCreate) SetUpdatedAt(v int64) *HospitalCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *HospitalCreate) SetNillableUpdatedAt(v *int64) *HospitalCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *HospitalCreate) SetDeletedAt(v int64) *HospitalCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *HospitalCreate) SetNillableDeletedAt(v *int64) *HospitalCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *HospitalCreate) SetCode(v string) *HospitalCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *HospitalCreate) SetName(v string) *HospitalCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetStreet sets the "street" field.
+func (_c *HospitalCreate) SetStreet(v string) *HospitalCreate {
+	_c.mutation.SetStreet(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *HospitalCreate) SetEmail(v string) *HospitalCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *HospitalCreate) SetNillableEmail(v *string) *HospitalCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetDialCode sets the "dial_code" field.
+func (_c *HospitalCreate) SetDialCode(v string) *HospitalCreate {
+	_c.mutation.SetDialCode(v)
+	return _c
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (_c *HospitalCreate) SetPhoneNumber(v string) *HospitalCreate {
+	_c.mutation.SetPhoneNumber(v)
+	return _c
+}
+
+// SetActivated sets the "activated" field.
+func (_c *HospitalCreate) SetActivated(v bool) *HospitalCreate {
+	_c.mutation.SetActivated(v)
+	return _c
+}
+
+// SetNillableActivated sets the "activated" field if the given value is not nil.
+func (_c *HospitalCreate) SetNillableActivated(v *bool) *HospitalCreate {
+	if v != nil {
+		_c.SetActivated(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *HospitalCreate) SetID(v uuid.UUID) *HospitalCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetSubdistrictID sets the "subdistrict" edge to the Subdistrict entity by ID.
+func (_c *HospitalCreate) SetSubdistrictID(id uuid.UUID) *HospitalCreate {
+	_c.mutation.SetSubdistrictID(id)
+	return _c
+}
+
+// SetSubdistrict sets the "subdistrict" edge to the Subdistrict entity.
+func (_c *HospitalCreate) SetSubdistrict(v *Subdistrict) *HospitalCreate {
+	return _c.SetSubdistrictID(v.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the Account entity by IDs.
+func (_c *HospitalCreate) AddMemberIDs(ids ...uuid.UUID) *HospitalCreate {
+	_c.mutation.AddMemberIDs(ids...)
+	return _c
+}
+
+// AddMembers adds the "members" edges to the Account entity.
+func (_c *HospitalCreate) AddMembers(v ...*Account) *HospitalCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberIDs(ids...)
+}
+
+// AddBloodRequestIDs adds the "blood_requests" edge to the BloodRequest entity by IDs.
+func (_c *HospitalCreate) AddBloodRequestIDs(ids ...uuid.UUID) *HospitalCreate {
+	_c.mutation.AddBloodRequestIDs(ids...)
+	return _c
+}
+
+// AddBloodRequests adds the "blood_requests" edges to the BloodRequest entity.
+func (_c *HospitalCreate) AddBloodRequests(v ...*BloodRequest) *HospitalCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBloodRequestIDs(ids...)
+}
+
+// Mutation returns the HospitalMutation object of the builder.
+func (_c *HospitalCreate) Mutation() *HospitalMutation {
+	return _c.mutation
+}
+
+// Save creates the Hospital in the database.
+func (_c *HospitalCreate) Save(ctx context.Context) (*Hospital, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HospitalCreate) SaveX(ctx context.Context) *Hospital {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HospitalCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HospitalCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HospitalCreate) defaults() {
+	if _, ok := _c.mutation.Activated(); !ok {
+		v := hospital.DefaultActivated
+		_c.mutation.SetActivated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HospitalCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := hospital.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "Hospital.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := hospital.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Hospital.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := hospital.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Hospital.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Hospital.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := hospital.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Hospital.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Hospital.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := hospital.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Hospital.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Street(); !ok {
+		return &ValidationError{Name: "street", err: errors.New(`ent: missing required field "Hospital.street"`)}
+	}
+	if v, ok := _c.mutation.Street(); ok {
+		if err := hospital.StreetValidator(v); err != nil {
+			return &ValidationError{Name: "street", err: fmt.Errorf(`ent: validator failed for field "Hospital.street": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := hospital.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Hospital.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DialCode(); !ok {
+		return &ValidationError{Name: "dial_code", err: errors.New(`ent: missing required field "Hospital.dial_code"`)}
+	}
+	if v, ok := _c.mutation.DialCode(); ok {
+		if err := hospital.DialCodeValidator(v); err != nil {
+			return &ValidationError{Name: "dial_code", err: fmt.Errorf(`ent: validator failed for field "Hospital.dial_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PhoneNumber(); !ok {
+		return &ValidationError{Name: "phone_number", err: errors.New(`ent: missing required field "Hospital.phone_number"`)}
+	}
+	if v, ok := _c.mutation.PhoneNumber(); ok {
+		if err := hospital.PhoneNumberValidator(v); err != nil {
+			return &ValidationError{Name: "phone_number", err: fmt.Errorf(`ent: validator failed for field "Hospital.phone_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Activated(); !ok {
+		return &ValidationError{Name: "activated", err: errors.New(`ent: missing required field "Hospital.activated"`)}
+	}
+	if len(_c.mutation.SubdistrictIDs()) == 0 {
+		return &ValidationError{Name: "subdistrict", err: errors.New(`ent: missing required edge "Hospital.subdistrict"`)}
+	}
+	return nil
+}
+
+func (_c *HospitalCreate) sqlSave(ctx context.Context) (*Hospital, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HospitalCreate) createSpec() (*Hospital, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Hospital{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(hospital.Table, sqlgraph.NewFieldSpec(hospital.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(hospital.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(hospital.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(hospital.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(hospital.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(hospital.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Street(); ok {
+		_spec.SetField(hospital.FieldStreet, field.TypeString, value)
+		_node.Street = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(hospital.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.DialCode(); ok {
+		_spec.SetField(hospital.FieldDialCode, field.TypeString, value)
+		_node.DialCode = value
+	}
+	if value, ok := _c.mutation.PhoneNumber(); ok {
+		_spec.SetField(hospital.FieldPhoneNumber, field.TypeString, value)
+		_node.PhoneNumber = value
+	}
+	if value, ok := _c.mutation.Activated(); ok {
+		_spec.SetField(hospital.FieldActivated, field.TypeBool, value)
+		_node.Activated = value
+	}
+	if nodes := _c.mutation.SubdistrictIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hospital.SubdistrictTable,
+			Columns: []string{hospital.SubdistrictColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subdistrict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.subdistrict_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.MembersTable,
+			Columns: []string{hospital.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BloodRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.BloodRequestsTable,
+			Columns: []string{hospital.BloodRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HospitalCreateBulk is the builder for creating many Hospital entities in bulk.
+type HospitalCreateBulk struct {
+	config
+	err      error
+	builders []*HospitalCreate
+}
+
+// Save creates the Hospital entities in the database.
+func (_c *HospitalCreateBulk) Save(ctx context.Context) ([]*Hospital, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Hospital, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HospitalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HospitalCreateBulk) SaveX(ctx context.Context) []*Hospital {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HospitalCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HospitalCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1099 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+)
+
+// HospitalUpdate is the builder for updating Hospital entities.
+type HospitalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HospitalMutation
+}
+
+// Where appends a list predicates to the HospitalUpdate builder.
+func (_u *HospitalUpdate) Where(ps ...predicate.Hospital) *HospitalUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HospitalUpdate) SetUpdatedAt(v int64) *HospitalUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *HospitalUpdate) AddUpdatedAt(v int64) *HospitalUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *HospitalUpdate) ClearUpdatedAt() *HospitalUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *HospitalUpdate) SetDeletedAt(v int64) *HospitalUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *HospitalUpdate) SetNillableDeletedAt(v *int64) *HospitalUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *HospitalUpdate) AddDeletedAt(v int64) *HospitalUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *HospitalUpdate) ClearDeletedAt() *HospitalUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *HospitalUpdate) SetCode(v string) *HospitalUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *HospitalUpdate) SetNillableCode(v *string) *HospitalUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HospitalUpdate) SetName(v string) *HospitalUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HospitalUpdate) SetNillableName(v *string) *HospitalUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStreet sets the "street" field.
+func (_u *HospitalUpdate) SetStreet(v string) *HospitalUpdate {
+	_u.mutation.SetStreet(v)
+	return _u
+}
+
+// SetNillableStreet sets the "street" field if the given value is not nil.
+func (_u *HospitalUpdate) SetNillableStreet(v *string) *HospitalUpdate {
+	if v != nil {
+		_u.SetStreet(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *HospitalUpdate) SetEmail(v string) *HospitalUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *HospitalUpdate) SetNillableEmail(v *string) *HospitalUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *HospitalUpdate) ClearEmail() *HospitalUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetDialCode sets the "dial_code" field.
+func (_u *HospitalUpdate) SetDialCode(v string) *HospitalUpdate {
+	_u.mutation.SetDialCode(v)
+	return _u
+}
+
+// SetNillableDialCode sets the "dial_code" field if the given value is not nil.
+func (_u *HospitalUpdate) SetNillableDialCode(v *string) *HospitalUpdate {
+	if v != nil {
+		_u.SetDialCode(*v)
+	}
+	return _u
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (_u *HospitalUpdate) SetPhoneNumber(v string) *HospitalUpdate {
+	_u.mutation.SetPhoneNumber(v)
+	return _u
+}
+
+// SetNillablePhoneNumber sets the "phone_number" field if the given value is not nil.
+func (_u *HospitalUpdate) SetNillablePhoneNumber(v *string) *HospitalUpdate {
+	if v != nil {
+		_u.SetPhoneNumber(*v)
+	}
+	return _u
+}
+
+// SetActivated sets the "activated" field.
+func (_u *HospitalUpdate) SetActivated(v bool) *HospitalUpdate {
+	_u.mutation.SetActivated(v)
+	return _u
+}
+
+// SetNillableActivated sets the "activated" field if the given value is not nil.
+func (_u *HospitalUpdate) SetNillableActivated(v *bool) *HospitalUpdate {
+	if v != nil {
+		_u.SetActivated(*v)
+	}
+	return _u
+}
+
+// SetSubdistrictID sets the "subdistrict" edge to the Subdistrict entity by ID.
+func (_u *HospitalUpdate) SetSubdistrictID(id uuid.UUID) *HospitalUpdate {
+	_u.mutation.SetSubdistrictID(id)
+	return _u
+}
+
+// SetSubdistrict sets the "subdistrict" edge to the Subdistrict entity.
+func (_u *HospitalUpdate) SetSubdistrict(v *Subdistrict) *HospitalUpdate {
+	return _u.SetSubdistrictID(v.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the Account entity by IDs.
+func (_u *HospitalUpdate) AddMemberIDs(ids ...uuid.UUID) *HospitalUpdate {
+	_u.mutation.AddMemberIDs(ids...)
+	return _u
+}
+
+// AddMembers adds the "members" edges to the Account entity.
+func (_u *HospitalUpdate) AddMembers(v ...*Account) *HospitalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIDs(ids...)
+}
+
+// AddBloodRequestIDs adds the "blood_requests" edge to the BloodRequest entity by IDs.
+func (_u *HospitalUpdate) AddBloodRequestIDs(ids ...uuid.UUID) *HospitalUpdate {
+	_u.mutation.AddBloodRequestIDs(ids...)
+	return _u
+}
+
+// AddBloodRequests adds the "blood_requests" edges to the BloodRequest entity.
+func (_u *HospitalUpdate) AddBloodRequests(v ...*BloodRequest) *HospitalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBloodRequestIDs(ids...)
+}
+
+// Mutation returns the HospitalMutation object of the builder.
+func (_u *HospitalUpdate) Mutation() *HospitalMutation {
+	return _u.mutation
+}
+
+// ClearSubdistrict clears the "subdistrict" edge to the Subdistrict entity.
+func (_u *HospitalUpdate) ClearSubdistrict() *HospitalUpdate {
+	_u.mutation.ClearSubdistrict()
+	return _u
+}
+
+// ClearMembers clears all "members" edges to the Account entity.
+func (_u *HospitalUpdate) ClearMembers() *HospitalUpdate {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to Account entities by IDs.
+func (_u *HospitalUpdate) RemoveMemberIDs(ids ...uuid.UUID) *HospitalUpdate {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to Account entities.
+func (_u *HospitalUpdate) RemoveMembers(v ...*Account) *HospitalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// ClearBloodRequests clears all "blood_requests" edges to the BloodRequest entity.
+func (_u *HospitalUpdate) ClearBloodRequests() *HospitalUpdate {
+	_u.mutation.ClearBloodRequests()
+	return _u
+}
+
+// RemoveBloodRequestIDs removes the "blood_requests" edge to BloodRequest entities by IDs.
+func (_u *HospitalUpdate) RemoveBloodRequestIDs(ids ...uuid.UUID) *HospitalUpdate {
+	_u.mutation.RemoveBloodRequestIDs(ids...)
+	return _u
+}
+
+// RemoveBloodRequests removes "blood_requests" edges to BloodRequest entities.
+func (_u *HospitalUpdate) RemoveBloodRequests(v ...*BloodRequest) *HospitalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBloodRequestIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HospitalUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HospitalUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HospitalUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HospitalUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HospitalUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := hospital.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HospitalUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := hospital.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Hospital.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := hospital.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Hospital.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Code(); ok {
+		if err := hospital.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Hospital.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := hospital.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Hospital.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Street(); ok {
+		if err := hospital.StreetValidator(v); err != nil {
+			return &ValidationError{Name: "street", err: fmt.Errorf(`ent: validator failed for field "Hospital.street": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := hospital.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Hospital.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DialCode(); ok {
+		if err := hospital.DialCodeValidator(v); err != nil {
+			return &ValidationError{Name: "dial_code", err: fmt.Errorf(`ent: validator failed for field "Hospital.dial_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PhoneNumber(); ok {
+		if err := hospital.PhoneNumberValidator(v); err != nil {
+			return &ValidationError{Name: "phone_number", err: fmt.Errorf(`ent: validator failed for field "Hospital.phone_number": %w`, err)}
+		}
+	}
+	if _u.mutation.SubdistrictCleared() && len(_u.mutation.SubdistrictIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Hospital.subdistrict"`)
+	}
+	return nil
+}
+
+func (_u *HospitalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hospital.Table, hospital.Columns, sqlgraph.NewFieldSpec(hospital.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(hospital.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(hospital.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(hospital.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(hospital.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(hospital.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(hospital.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(hospital.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(hospital.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Street(); ok {
+		_spec.SetField(hospital.FieldStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(hospital.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(hospital.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.DialCode(); ok {
+		_spec.SetField(hospital.FieldDialCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PhoneNumber(); ok {
+		_spec.SetField(hospital.FieldPhoneNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Activated(); ok {
+		_spec.SetField(hospital.FieldActivated, field.TypeBool, value)
+	}
+	if _u.mutation.SubdistrictCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hospital.SubdistrictTable,
+			Columns: []string{hospital.SubdistrictColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subdistrict.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubdistrictIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hospital.SubdistrictTable,
+			Columns: []string{hospital.SubdistrictColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subdistrict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.MembersTable,
+			Columns: []string{hospital.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembersIDs(); len(nodes) > 0 && !_u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.MembersTable,
+			Columns: []string{hospital.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.MembersTable,
+			Columns: []string{hospital.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BloodRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.BloodRequestsTable,
+			Columns: []string{hospital.BloodRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBloodRequestsIDs(); len(nodes) > 0 && !_u.mutation.BloodRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.BloodRequestsTable,
+			Columns: []string{hospital.BloodRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BloodRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.BloodRequestsTable,
+			Columns: []string{hospital.BloodRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hospital.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HospitalUpdateOne is the builder for updating a single Hospital entity.
+type HospitalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HospitalMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HospitalUpdateOne) SetUpdatedAt(v int64) *HospitalUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *HospitalUpdateOne) AddUpdatedAt(v int64) *HospitalUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *HospitalUpdateOne) ClearUpdatedAt() *HospitalUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *HospitalUpdateOne) SetDeletedAt(v int64) *HospitalUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *HospitalUpdateOne) SetNillableDeletedAt(v *int64) *HospitalUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *HospitalUpdateOne) AddDeletedAt(v int64) *HospitalUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *HospitalUpdateOne) ClearDeletedAt() *HospitalUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *HospitalUpdateOne) SetCode(v string) *HospitalUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *HospitalUpdateOne) SetNillableCode(v *string) *HospitalUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HospitalUpdateOne) SetName(v string) *HospitalUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HospitalUpdateOne) SetNillableName(v *string) *HospitalUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStreet sets the "street" field.
+func (_u *HospitalUpdateOne) SetStreet(v string) *HospitalUpdateOne {
+	_u.mutation.SetStreet(v)
+	return _u
+}
+
+// SetNillableStreet sets the "street" field if the given value is not nil.
+func (_u *HospitalUpdateOne) SetNillableStreet(v *string) *HospitalUpdateOne {
+	if v != nil {
+		_u.SetStreet(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *HospitalUpdateOne) SetEmail(v string) *HospitalUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *HospitalUpdateOne) SetNillableEmail(v *string) *HospitalUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *HospitalUpdateOne) ClearEmail() *HospitalUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetDialCode sets the "dial_code" field.
+func (_u *HospitalUpdateOne) SetDialCode(v string) *HospitalUpdateOne {
+	_u.mutation.SetDialCode(v)
+	return _u
+}
+
+// SetNillableDialCode sets the "dial_code" field if the given value is not nil.
+func (_u *HospitalUpdateOne) SetNillableDialCode(v *string) *HospitalUpdateOne {
+	if v != nil {
+		_u.SetDialCode(*v)
+	}
+	return _u
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (_u *HospitalUpdateOne) SetPhoneNumber(v string) *HospitalUpdateOne {
+	_u.mutation.SetPhoneNumber(v)
+	return _u
+}
+
+// SetNillablePhoneNumber sets the "phone_number" field if the given value is not nil.
+func (_u *HospitalUpdateOne) SetNillablePhoneNumber(v *string) *HospitalUpdateOne {
+	if v != nil {
+		_u.SetPhoneNumber(*v)
+	}
+	return _u
+}
+
+// SetActivated sets the "activated" field.
+func (_u *HospitalUpdateOne) SetActivated(v bool) *HospitalUpdateOne {
+	_u.mutation.SetActivated(v)
+	return _u
+}
+
+// SetNillableActivated sets the "activated" field if the given value is not nil.
+func (_u *HospitalUpdateOne) SetNillableActivated(v *bool) *HospitalUpdateOne {
+	if v != nil {
+		_u.SetActivated(*v)
+	}
+	return _u
+}
+
+// SetSubdistrictID sets the "subdistrict" edge to the Subdistrict entity by ID.
+func (_u *HospitalUpdateOne) SetSubdistrictID(id uuid.UUID) *HospitalUpdateOne {
+	_u.mutation.SetSubdistrictID(id)
+	return _u
+}
+
+// SetSubdistrict sets the "subdistrict" edge to the Subdistrict entity.
+func (_u *HospitalUpdateOne) SetSubdistrict(v *Subdistrict) *HospitalUpdateOne {
+	return _u.SetSubdistrictID(v.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the Account entity by IDs.
+func (_u *HospitalUpdateOne) AddMemberIDs(ids ...uuid.UUID) *HospitalUpdateOne {
+	_u.mutation.AddMemberIDs(ids...)
+	return _u
+}
+
+// AddMembers adds the "members" edges to the Account entity.
+func (_u *HospitalUpdateOne) AddMembers(v ...*Account) *HospitalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIDs(ids...)
+}
+
+// AddBloodRequestIDs adds the "blood_requests" edge to the BloodRequest entity by IDs.
+func (_u *HospitalUpdateOne) AddBloodRequestIDs(ids ...uuid.UUID) *HospitalUpdateOne {
+	_u.mutation.AddBloodRequestIDs(ids...)
+	return _u
+}
+
+// AddBloodRequests adds the "blood_requests" edges to the BloodRequest entity.
+func (_u *HospitalUpdateOne) AddBloodRequests(v ...*BloodRequest) *HospitalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBloodRequestIDs(ids...)
+}
+
+// Mutation returns the HospitalMutation object of the builder.
+func (_u *HospitalUpdateOne) Mutation() *HospitalMutation {
+	return _u.mutation
+}
+
+// ClearSubdistrict clears the "subdistrict" edge to the Subdistrict entity.
+func (_u *HospitalUpdateOne) ClearSubdistrict() *HospitalUpdateOne {
+	_u.mutation.ClearSubdistrict()
+	return _u
+}
+
+// ClearMembers clears all "members" edges to the Account entity.
+func (_u *HospitalUpdateOne) ClearMembers() *HospitalUpdateOne {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to Account entities by IDs.
+func (_u *HospitalUpdateOne) RemoveMemberIDs(ids ...uuid.UUID) *HospitalUpdateOne {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to Account entities.
+func (_u *HospitalUpdateOne) RemoveMembers(v ...*Account) *HospitalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// ClearBloodRequests clears all "blood_requests" edges to the BloodRequest entity.
+func (_u *HospitalUpdateOne) ClearBloodRequests() *HospitalUpdateOne {
+	_u.mutation.ClearBloodRequests()
+	return _u
+}
+
+// RemoveBloodRequestIDs removes the "blood_requests" edge to BloodRequest entities by IDs.
+func (_u *HospitalUpdateOne) RemoveBloodRequestIDs(ids ...uuid.UUID) *HospitalUpdateOne {
+	_u.mutation.RemoveBloodRequestIDs(ids...)
+	return _u
+}
+
+// RemoveBloodRequests removes "blood_requests" edges to BloodRequest entities.
+func (_u *HospitalUpdateOne) RemoveBloodRequests(v ...*BloodRequest) *HospitalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBloodRequestIDs(ids...)
+}
+
+// Where appends a list predicates to the HospitalUpdate builder.
+func (_u *HospitalUpdateOne) Where(ps ...predicate.Hospital) *HospitalUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HospitalUpdateOne) Select(field string, fields ...string) *HospitalUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Hospital entity.
+func (_u *HospitalUpdateOne) Save(ctx context.Context) (*Hospital, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HospitalUpdateOne) SaveX(ctx context.Context) *Hospital {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HospitalUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HospitalUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HospitalUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := hospital.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HospitalUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := hospital.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Hospital.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := hospital.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Hospital.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Code(); ok {
+		if err := hospital.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Hospital.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := hospital.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Hospital.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Street(); ok {
+		if err := hospital.StreetValidator(v); err != nil {
+			return &ValidationError{Name: "street", err: fmt.Errorf(`ent: validator failed for field "Hospital.street": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := hospital.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Hospital.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DialCode(); ok {
+		if err := hospital.DialCodeValidator(v); err != nil {
+			return &ValidationError{Name: "dial_code", err: fmt.Errorf(`ent: validator failed for field "Hospital.dial_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PhoneNumber(); ok {
+		if err := hospital.PhoneNumberValidator(v); err != nil {
+			return &ValidationError{Name: "phone_number", err: fmt.Errorf(`ent: validator failed for field "Hospital.phone_number": %w`, err)}
+		}
+	}
+	if _u.mutation.SubdistrictCleared() && len(_u.mutation.SubdistrictIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Hospital.subdistrict"`)
+	}
+	return nil
+}
+
+func (_u *HospitalUpdateOne) sqlSave(ctx context.Context) (_node *Hospital, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hospital.Table, hospital.Columns, sqlgraph.NewFieldSpec(hospital.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Hospital.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hospital.FieldID)
+		for _, f := range fields {
+			if !hospital.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != hospital.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(hospital.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(hospital.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(hospital.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(hospital.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(hospital.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(hospital.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(hospital.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(hospital.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Street(); ok {
+		_spec.SetField(hospital.FieldStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(hospital.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(hospital.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.DialCode(); ok {
+		_spec.SetField(hospital.FieldDialCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PhoneNumber(); ok {
+		_spec.SetField(hospital.FieldPhoneNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Activated(); ok {
+		_spec.SetField(hospital.FieldActivated, field.TypeBool, value)
+	}
+	if _u.mutation.SubdistrictCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hospital.SubdistrictTable,
+			Columns: []string{hospital.SubdistrictColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subdistrict.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubdistrictIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hospital.SubdistrictTable,
+			Columns: []string{hospital.SubdistrictColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subdistrict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.MembersTable,
+			Columns: []string{hospital.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembersIDs(); len(nodes) > 0 && !_u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.MembersTable,
+			Columns: []string{hospital.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.MembersTable,
+			Columns: []string{hospital.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BloodRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.BloodRequestsTable,
+			Columns: []string{hospital.BloodRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBloodRequestsIDs(); len(nodes) > 0 && !_u.mutation.BloodRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.BloodRequestsTable,
+			Columns: []string{hospital.BloodRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BloodRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   hospital.BloodRequestsTable,
+			Columns: []string{hospital.BloodRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Hospital{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hospital.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,54 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "name", Type: field.TypeString, Size: 164},
+		{Name: "prefix", Type: field.TypeString, Unique: true, Size: 8, Comment: "Public part of the key used to look it up, such as stk_<prefix>_<secret>."},
+		{Name: "secret_hash", Type: field.TypeString, Size: 64, Comment: "SHA256 hash of the secret part of the key.", SchemaType: map[string]string{"postgres": "char(64)"}},
+		{Name: "scopes", Type: field.TypeJSON, Comment: "Keys of the permissions the key may use."},
+		{Name: "expires_at", Type: field.TypeInt64},
+		{Name: "last_used_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "hospital_id", Type: field.TypeUUID},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_accounts_account",
+				Columns:    []*schema.Column{APIKeysColumns[11]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "api_keys_hospitals_hospital",
+				Columns:    []*schema.Column{APIKeysColumns[12]},
+				RefColumns: []*schema.Column{HospitalsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[3]},
+			},
+			{
+				Name:    "apikey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[8]},
+			},
+		},
+	}
 	// AccountsColumns holds the columns for the "accounts" table.
 	AccountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
@@ -18,122 +66,890 @@ var (
 		{Name: "national_id_hash", Type: field.TypeString, Unique: true, Size: 64, Comment: "SHA256 hash of a user's national identity number (e.g., KTP). Stored securely to avoid saving raw identity numbers."},
 		{Name: "national_id_masked", Type: field.TypeString, Size: 8, Comment: "Masked of national identity number (e.g., KTP).", SchemaType: map[string]string{"postgres": "char(8)"}},
 		{Name: "full_name", Type: field.TypeString, Size: 164},
+		{Name: "birth_date", Type: field.TypeTime, Nullable: true, Comment: "Date of birth derived from the national identity number, used for donor age checks.", SchemaType: map[string]string{"postgres": "date"}},
 		{Name: "gender", Type: field.TypeEnum, Enums: []string{"FEMALE", "MALE"}},
 		{Name: "email", Type: field.TypeString, Unique: true, Size: 164},
 		{Name: "country_iso_code", Type: field.TypeString, Size: 2, Comment: "ISO 3166-1 alpha-2 country code representing the user's country (e.g., ID for Indonesia, US for United States)."},
 		{Name: "dial_code", Type: field.TypeString, Size: 6, Comment: "International dialing code of the user's country (e.g., 62 for Indonesia, 1 for United States),  without '+'. Used for constructing complete phone numbers."},
 		{Name: "phone_number", Type: field.TypeString, Unique: true, Size: 13},
+		{Name: "home_lat_lng", Type: field.TypeOther, Nullable: true, Comment: "Home location shared by the donor, used to find nearby donors in emergencies.", SchemaType: map[string]string{"postgres": "geography(Point,4326)"}},
 		{Name: "activated", Type: field.TypeBool, Default: false},
 		{Name: "locked", Type: field.TypeBool, Comment: "Permanently locked by this account.", Default: false},
 		{Name: "temp_locked_at", Type: field.TypeInt64, Nullable: true, Comment: "Temporary locked by this account based on time milliseconds."},
 		{Name: "blood_type_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "role_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "hospital_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "account_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+	}
+	// AccountsTable holds the schema information for the "accounts" table.
+	AccountsTable = &schema.Table{
+		Name:       "accounts",
+		Columns:    AccountsColumns,
+		PrimaryKey: []*schema.Column{AccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "accounts_blood_types_blood_type",
+				Columns:    []*schema.Column{AccountsColumns[17]},
+				RefColumns: []*schema.Column{BloodTypesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "accounts_roles_role",
+				Columns:    []*schema.Column{AccountsColumns[18]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "accounts_hospitals_hospital",
+				Columns:    []*schema.Column{AccountsColumns[19]},
+				RefColumns: []*schema.Column{HospitalsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "accounts_notification_preferences_account",
+				Columns:    []*schema.Column{AccountsColumns[20]},
+				RefColumns: []*schema.Column{NotificationPreferencesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "account_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[3]},
+			},
+			{
+				Name:    "account_home_lat_lng",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[13]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "GIST",
+				},
+			},
+		},
+	}
+	// AppointmentsColumns holds the columns for the "appointments" table.
+	AppointmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "scheduled_at", Type: field.TypeInt64, Comment: "Scheduled donation time in milliseconds."},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"BOOKED", "ATTENDED", "CANCELLED", "NO_SHOW"}, Default: "BOOKED"},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "pmi_location_id", Type: field.TypeUUID},
+		{Name: "appointment_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+	}
+	// AppointmentsTable holds the schema information for the "appointments" table.
+	AppointmentsTable = &schema.Table{
+		Name:       "appointments",
+		Columns:    AppointmentsColumns,
+		PrimaryKey: []*schema.Column{AppointmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "appointments_accounts_account",
+				Columns:    []*schema.Column{AppointmentsColumns[6]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "appointments_pmi_locations_pmi_location",
+				Columns:    []*schema.Column{AppointmentsColumns[7]},
+				RefColumns: []*schema.Column{PmiLocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "appointments_donations_appointment",
+				Columns:    []*schema.Column{AppointmentsColumns[8]},
+				RefColumns: []*schema.Column{DonationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "appointment_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{AppointmentsColumns[3]},
+			},
+			{
+				Name:    "appointment_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{AppointmentsColumns[4]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true, Comment: "Account that acted. Empty for anonymous requests and system jobs."},
+		{Name: "subject_id", Type: field.TypeUUID, Nullable: true, Comment: "Account the actor impersonated. Empty unless the actor impersonated one."},
+		{Name: "session_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Token identifier (jti) of the session the actor used."},
+		{Name: "request_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "action", Type: field.TypeString, Size: 64, Comment: "Mutation operation, such as create, or security action, such as login.failed."},
+		{Name: "entity", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "entity_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "before", Type: field.TypeJSON, Nullable: true, Comment: "Values of the changed fields before the change."},
+		{Name: "after", Type: field.TypeJSON, Nullable: true, Comment: "Values of the changed fields after the change."},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 45},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3]},
+			},
+			{
+				Name:    "auditlog_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4]},
+			},
+			{
+				Name:    "auditlog_subject_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5]},
+			},
+			{
+				Name:    "auditlog_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[7]},
+			},
+			{
+				Name:    "auditlog_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[8]},
+			},
+			{
+				Name:    "auditlog_entity_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[9], AuditLogsColumns[10]},
+			},
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+		},
+	}
+	// BloodRequestsColumns holds the columns for the "blood_requests" table.
+	BloodRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "patient_name", Type: field.TypeString, Size: 164},
+		{Name: "patient_record_number", Type: field.TypeString, Nullable: true, Size: 32, Comment: "Medical record number of the patient at the hospital."},
+		{Name: "component", Type: field.TypeEnum, Enums: []string{"WHOLE_BLOOD", "PRC", "TC", "FFP"}},
+		{Name: "quantity", Type: field.TypeInt, Comment: "Number of bags requested."},
+		{Name: "fulfilled_quantity", Type: field.TypeInt, Comment: "Number of bags issued to the hospital so far.", Default: 0},
+		{Name: "urgency", Type: field.TypeEnum, Enums: []string{"ROUTINE", "URGENT", "EMERGENCY"}},
+		{Name: "required_by", Type: field.TypeInt64, Comment: "Time the blood is needed at the hospital in milliseconds."},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"SUBMITTED", "ACCEPTED", "PARTIALLY_FULFILLED", "FULFILLED", "REJECTED", "CANCELLED"}, Default: "SUBMITTED"},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 300},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 300, Comment: "Why the request was rejected or cancelled."},
+		{Name: "hospital_id", Type: field.TypeUUID},
+		{Name: "blood_type_id", Type: field.TypeUUID},
+		{Name: "pmi_location_id", Type: field.TypeUUID},
+		{Name: "requested_by_id", Type: field.TypeUUID},
+		{Name: "handled_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// BloodRequestsTable holds the schema information for the "blood_requests" table.
+	BloodRequestsTable = &schema.Table{
+		Name:       "blood_requests",
+		Columns:    BloodRequestsColumns,
+		PrimaryKey: []*schema.Column{BloodRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blood_requests_hospitals_hospital",
+				Columns:    []*schema.Column{BloodRequestsColumns[14]},
+				RefColumns: []*schema.Column{HospitalsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blood_requests_blood_types_blood_type",
+				Columns:    []*schema.Column{BloodRequestsColumns[15]},
+				RefColumns: []*schema.Column{BloodTypesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blood_requests_pmi_locations_pmi_location",
+				Columns:    []*schema.Column{BloodRequestsColumns[16]},
+				RefColumns: []*schema.Column{PmiLocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blood_requests_accounts_requested_by",
+				Columns:    []*schema.Column{BloodRequestsColumns[17]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blood_requests_accounts_handled_by",
+				Columns:    []*schema.Column{BloodRequestsColumns[18]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bloodrequest_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{BloodRequestsColumns[3]},
+			},
+			{
+				Name:    "bloodrequest_status_required_by",
+				Unique:  false,
+				Columns: []*schema.Column{BloodRequestsColumns[11], BloodRequestsColumns[10]},
+			},
+		},
+	}
+	// BloodStocksColumns holds the columns for the "blood_stocks" table.
+	BloodStocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "component", Type: field.TypeEnum, Comment: "Blood component: whole blood, packed red cells, thrombocyte concentrate or fresh frozen plasma.", Enums: []string{"WHOLE_BLOOD", "PRC", "TC", "FFP"}},
+		{Name: "quantity", Type: field.TypeInt, Comment: "Number of bags in stock. Only changed through stock movements.", Default: 0},
+		{Name: "pmi_location_id", Type: field.TypeUUID},
+		{Name: "blood_type_id", Type: field.TypeUUID},
+	}
+	// BloodStocksTable holds the schema information for the "blood_stocks" table.
+	BloodStocksTable = &schema.Table{
+		Name:       "blood_stocks",
+		Columns:    BloodStocksColumns,
+		PrimaryKey: []*schema.Column{BloodStocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blood_stocks_pmi_locations_pmi_location",
+				Columns:    []*schema.Column{BloodStocksColumns[6]},
+				RefColumns: []*schema.Column{PmiLocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blood_stocks_blood_types_blood_type",
+				Columns:    []*schema.Column{BloodStocksColumns[7]},
+				RefColumns: []*schema.Column{BloodTypesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bloodstock_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{BloodStocksColumns[3]},
+			},
+			{
+				Name:    "bloodstock_component_pmi_location_id_blood_type_id",
+				Unique:  true,
+				Columns: []*schema.Column{BloodStocksColumns[4], BloodStocksColumns[6], BloodStocksColumns[7]},
+			},
+		},
+	}
+	// BloodTypesColumns holds the columns for the "blood_types" table.
+	BloodTypesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "group", Type: field.TypeEnum, Comment: "comment:The ABO blood group classification (A, B, AB, or O).", Enums: []string{"A", "B", "AB", "O"}},
+		{Name: "rhesus", Type: field.TypeEnum, Nullable: true, Comment: "The Rhesus (Rh) factor of the blood group, either POSITIVE or NEGATIVE.", Enums: []string{"POSITIVE", "NEGATIVE"}},
+	}
+	// BloodTypesTable holds the schema information for the "blood_types" table.
+	BloodTypesTable = &schema.Table{
+		Name:       "blood_types",
+		Columns:    BloodTypesColumns,
+		PrimaryKey: []*schema.Column{BloodTypesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bloodtype_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{BloodTypesColumns[3]},
+			},
+		},
+	}
+	// BloodUnitsColumns holds the columns for the "blood_units" table.
+	BloodUnitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "barcode", Type: field.TypeString, Unique: true, Size: 64, Comment: "Barcode printed on the bag label."},
+		{Name: "component", Type: field.TypeEnum, Enums: []string{"WHOLE_BLOOD", "PRC", "TC", "FFP"}},
+		{Name: "collected_at", Type: field.TypeInt64, Comment: "Time the blood was collected in milliseconds."},
+		{Name: "expires_at", Type: field.TypeInt64, Comment: "End of the shelf life in milliseconds."},
+		{Name: "status", Type: field.TypeEnum, Comment: "Only changed through the lifecycle state machine.", Enums: []string{"QUARANTINED", "TESTED", "AVAILABLE", "RESERVED", "ISSUED", "EXPIRED", "DISCARDED"}, Default: "QUARANTINED"},
+		{Name: "pmi_location_id", Type: field.TypeUUID},
+		{Name: "blood_type_id", Type: field.TypeUUID},
+		{Name: "donation_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "blood_request_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// BloodUnitsTable holds the schema information for the "blood_units" table.
+	BloodUnitsTable = &schema.Table{
+		Name:       "blood_units",
+		Columns:    BloodUnitsColumns,
+		PrimaryKey: []*schema.Column{BloodUnitsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blood_units_pmi_locations_pmi_location",
+				Columns:    []*schema.Column{BloodUnitsColumns[9]},
+				RefColumns: []*schema.Column{PmiLocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blood_units_blood_types_blood_type",
+				Columns:    []*schema.Column{BloodUnitsColumns[10]},
+				RefColumns: []*schema.Column{BloodTypesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blood_units_donations_donation",
+				Columns:    []*schema.Column{BloodUnitsColumns[11]},
+				RefColumns: []*schema.Column{DonationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "blood_units_blood_requests_request",
+				Columns:    []*schema.Column{BloodUnitsColumns[12]},
+				RefColumns: []*schema.Column{BloodRequestsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bloodunit_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{BloodUnitsColumns[3]},
+			},
+			{
+				Name:    "bloodunit_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{BloodUnitsColumns[8], BloodUnitsColumns[7]},
+			},
+		},
+	}
+	// BloodUnitEventsColumns holds the columns for the "blood_unit_events" table.
+	BloodUnitEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "from_status", Type: field.TypeString, Nullable: true, Size: 16, Comment: "Status before the transition. Empty for the registration."},
+		{Name: "to_status", Type: field.TypeString, Size: 16},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 300},
+		{Name: "blood_unit_id", Type: field.TypeUUID},
+		{Name: "performed_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// BloodUnitEventsTable holds the schema information for the "blood_unit_events" table.
+	BloodUnitEventsTable = &schema.Table{
+		Name:       "blood_unit_events",
+		Columns:    BloodUnitEventsColumns,
+		PrimaryKey: []*schema.Column{BloodUnitEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blood_unit_events_blood_units_unit",
+				Columns:    []*schema.Column{BloodUnitEventsColumns[7]},
+				RefColumns: []*schema.Column{BloodUnitsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blood_unit_events_accounts_performed_by",
+				Columns:    []*schema.Column{BloodUnitEventsColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bloodunitevent_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{BloodUnitEventsColumns[3]},
+			},
+		},
+	}
+	// CasbinRuleColumns holds the columns for the "casbin_rule" table.
+	CasbinRuleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "ptype", Type: field.TypeString, Nullable: true},
+		{Name: "v0", Type: field.TypeString, Nullable: true},
+		{Name: "v1", Type: field.TypeString, Nullable: true},
+		{Name: "v2", Type: field.TypeString, Nullable: true},
+		{Name: "v3", Type: field.TypeString, Nullable: true},
+		{Name: "v4", Type: field.TypeString, Nullable: true},
+		{Name: "v5", Type: field.TypeString, Nullable: true},
+	}
+	// CasbinRuleTable holds the schema information for the "casbin_rule" table.
+	CasbinRuleTable = &schema.Table{
+		Name:       "casbin_rule",
+		Columns:    CasbinRuleColumns,
+		PrimaryKey: []*schema.Column{CasbinRuleColumns[0]},
+	}
+	// CertificatesColumns holds the columns for the "certificates" table.
+	CertificatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "milestone", Type: field.TypeInt, Comment: "Number of donations the certificate recognizes."},
+		{Name: "holder_name", Type: field.TypeString, Size: 164, Comment: "Donor name at the time the certificate was awarded."},
+		{Name: "awarded_at", Type: field.TypeInt64, Comment: "Time of the donation that reached the milestone in milliseconds."},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "donation_id", Type: field.TypeUUID},
+	}
+	// CertificatesTable holds the schema information for the "certificates" table.
+	CertificatesTable = &schema.Table{
+		Name:       "certificates",
+		Columns:    CertificatesColumns,
+		PrimaryKey: []*schema.Column{CertificatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "certificates_accounts_account",
+				Columns:    []*schema.Column{CertificatesColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "certificates_donations_donation",
+				Columns:    []*schema.Column{CertificatesColumns[8]},
+				RefColumns: []*schema.Column{DonationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certificate_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[3]},
+			},
+			{
+				Name:    "certificate_milestone_account_id",
+				Unique:  true,
+				Columns: []*schema.Column{CertificatesColumns[4], CertificatesColumns[7]},
+			},
+		},
+	}
+	// CitiesColumns holds the columns for the "cities" table.
+	CitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "bps_code", Type: field.TypeString, Unique: true, Size: 4, SchemaType: map[string]string{"postgres": "char(4)"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "province_id", Type: field.TypeUUID},
+	}
+	// CitiesTable holds the schema information for the "cities" table.
+	CitiesTable = &schema.Table{
+		Name:       "cities",
+		Columns:    CitiesColumns,
+		PrimaryKey: []*schema.Column{CitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cities_provinces_city",
+				Columns:    []*schema.Column{CitiesColumns[3]},
+				RefColumns: []*schema.Column{ProvincesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// DeferralsColumns holds the columns for the "deferrals" table.
+	DeferralsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"TEMPORARY", "PERMANENT"}},
+		{Name: "reason", Type: field.TypeString, Size: 300},
+		{Name: "starts_at", Type: field.TypeInt64, Comment: "Start of the deferral in milliseconds."},
+		{Name: "ends_at", Type: field.TypeInt64, Nullable: true, Comment: "End of a temporary deferral in milliseconds. Empty for permanent deferrals."},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "created_by_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "screening_submission_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// DeferralsTable holds the schema information for the "deferrals" table.
+	DeferralsTable = &schema.Table{
+		Name:       "deferrals",
+		Columns:    DeferralsColumns,
+		PrimaryKey: []*schema.Column{DeferralsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "deferrals_accounts_account",
+				Columns:    []*schema.Column{DeferralsColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "deferrals_accounts_created_by",
+				Columns:    []*schema.Column{DeferralsColumns[9]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "deferrals_screening_submissions_deferrals",
+				Columns:    []*schema.Column{DeferralsColumns[10]},
+				RefColumns: []*schema.Column{ScreeningSubmissionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deferral_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeferralsColumns[3]},
+			},
+			{
+				Name:    "deferral_ends_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeferralsColumns[7]},
+			},
+		},
+	}
+	// DistrictsColumns holds the columns for the "districts" table.
+	DistrictsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "bps_code", Type: field.TypeString, Unique: true, Size: 7, SchemaType: map[string]string{"postgres": "char(7)"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "city_id", Type: field.TypeUUID},
+	}
+	// DistrictsTable holds the schema information for the "districts" table.
+	DistrictsTable = &schema.Table{
+		Name:       "districts",
+		Columns:    DistrictsColumns,
+		PrimaryKey: []*schema.Column{DistrictsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "districts_cities_district",
+				Columns:    []*schema.Column{DistrictsColumns[3]},
+				RefColumns: []*schema.Column{CitiesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// DonationsColumns holds the columns for the "donations" table.
+	DonationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "donated_at", Type: field.TypeInt64, Comment: "Time the blood was collected in milliseconds."},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"WHOLE_BLOOD", "APHERESIS_PLATELETS", "APHERESIS_PLASMA"}},
+		{Name: "volume_ml", Type: field.TypeInt16, Comment: "Collected volume in milliliters.", SchemaType: map[string]string{"postgres": "smallint"}},
+		{Name: "bag_number", Type: field.TypeString, Unique: true, Size: 64, Comment: "Number printed on the blood bag label."},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "pmi_location_id", Type: field.TypeUUID},
+		{Name: "recorded_by_id", Type: field.TypeUUID},
+		{Name: "donation_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+	}
+	// DonationsTable holds the schema information for the "donations" table.
+	DonationsTable = &schema.Table{
+		Name:       "donations",
+		Columns:    DonationsColumns,
+		PrimaryKey: []*schema.Column{DonationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "donations_accounts_account",
+				Columns:    []*schema.Column{DonationsColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "donations_pmi_locations_pmi_location",
+				Columns:    []*schema.Column{DonationsColumns[9]},
+				RefColumns: []*schema.Column{PmiLocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "donations_accounts_recorded_by",
+				Columns:    []*schema.Column{DonationsColumns[10]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "donations_donation_reminders_donation",
+				Columns:    []*schema.Column{DonationsColumns[11]},
+				RefColumns: []*schema.Column{DonationRemindersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "donation_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{DonationsColumns[3]},
+			},
+			{
+				Name:    "donation_donated_at",
+				Unique:  false,
+				Columns: []*schema.Column{DonationsColumns[4]},
+			},
+		},
+	}
+	// DonationEventsColumns holds the columns for the "donation_events" table.
+	DonationEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "title", Type: field.TypeString, Size: 164},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "host_name", Type: field.TypeString, Size: 164, Comment: "Organization hosting the event, e.g. a company, campus or mosque."},
+		{Name: "host_type", Type: field.TypeEnum, Enums: []string{"OFFICE", "CAMPUS", "WORSHIP_PLACE", "COMMUNITY", "OTHER"}},
+		{Name: "venue", Type: field.TypeString, Size: 164},
+		{Name: "street", Type: field.TypeString, Size: 2147483647},
+		{Name: "lat_lng", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "geography(Point,4326)"}},
+		{Name: "starts_at", Type: field.TypeInt64, Comment: "Start of the event in milliseconds."},
+		{Name: "ends_at", Type: field.TypeInt64, Comment: "End of the event in milliseconds."},
+		{Name: "capacity", Type: field.TypeInt, Comment: "Maximum number of donors the mobile unit can serve."},
+		{Name: "status", Type: field.TypeEnum, Comment: "Only APPROVED events are listed publicly.", Enums: []string{"PENDING", "APPROVED", "REJECTED", "CANCELLED"}, Default: "PENDING"},
+		{Name: "review_note", Type: field.TypeString, Nullable: true, Size: 300},
+		{Name: "subdistrict_id", Type: field.TypeUUID},
+		{Name: "pmi_location_id", Type: field.TypeUUID},
+		{Name: "organizer_id", Type: field.TypeUUID},
+		{Name: "reviewed_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// DonationEventsTable holds the schema information for the "donation_events" table.
+	DonationEventsTable = &schema.Table{
+		Name:       "donation_events",
+		Columns:    DonationEventsColumns,
+		PrimaryKey: []*schema.Column{DonationEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "donation_events_subdistricts_subdistrict",
+				Columns:    []*schema.Column{DonationEventsColumns[16]},
+				RefColumns: []*schema.Column{SubdistrictsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "donation_events_pmi_locations_pmi_location",
+				Columns:    []*schema.Column{DonationEventsColumns[17]},
+				RefColumns: []*schema.Column{PmiLocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "donation_events_accounts_organizer",
+				Columns:    []*schema.Column{DonationEventsColumns[18]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "donation_events_accounts_reviewed_by",
+				Columns:    []*schema.Column{DonationEventsColumns[19]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "donationevent_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{DonationEventsColumns[3]},
+			},
+			{
+				Name:    "donationevent_status_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{DonationEventsColumns[14], DonationEventsColumns[11]},
+			},
+		},
+	}
+	// DonationRemindersColumns holds the columns for the "donation_reminders" table.
+	DonationRemindersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "eligible_at", Type: field.TypeInt64, Comment: "Time the donor becomes eligible again in milliseconds."},
+		{Name: "sent_at", Type: field.TypeInt64, Comment: "Time the reminder was sent in milliseconds."},
+		{Name: "channels", Type: field.TypeJSON, Comment: "Channels the reminder was delivered through."},
+		{Name: "account_id", Type: field.TypeUUID},
+	}
+	// DonationRemindersTable holds the schema information for the "donation_reminders" table.
+	DonationRemindersTable = &schema.Table{
+		Name:       "donation_reminders",
+		Columns:    DonationRemindersColumns,
+		PrimaryKey: []*schema.Column{DonationRemindersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "donation_reminders_accounts_account",
+				Columns:    []*schema.Column{DonationRemindersColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "donationreminder_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{DonationRemindersColumns[3]},
+			},
+		},
 	}
-	// AccountsTable holds the schema information for the "accounts" table.
-	AccountsTable = &schema.Table{
-		Name:       "accounts",
-		Columns:    AccountsColumns,
-		PrimaryKey: []*schema.Column{AccountsColumns[0]},
+	// EmergencyCampaignsColumns holds the columns for the "emergency_campaigns" table.
+	EmergencyCampaignsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "component", Type: field.TypeEnum, Enums: []string{"WHOLE_BLOOD", "PRC", "TC", "FFP"}},
+		{Name: "needed_donors", Type: field.TypeInt},
+		{Name: "committed_donors", Type: field.TypeInt, Comment: "Number of donors who accepted the call-out.", Default: 0},
+		{Name: "radius_m", Type: field.TypeInt, Comment: "Search radius around the PMI location in meters."},
+		{Name: "wave_size", Type: field.TypeInt, Comment: "Number of donors notified per wave."},
+		{Name: "waves", Type: field.TypeInt, Comment: "Number of waves sent so far.", Default: 0},
+		{Name: "last_wave_at", Type: field.TypeInt64, Nullable: true, Comment: "Time the last wave was sent in milliseconds."},
+		{Name: "status", Type: field.TypeEnum, Comment: "EXHAUSTED means no more eligible donors were found in the radius.", Enums: []string{"ACTIVE", "FULFILLED", "EXHAUSTED", "CANCELLED"}, Default: "ACTIVE"},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 300, Comment: "Message sent to the donors with the call-out."},
+		{Name: "pmi_location_id", Type: field.TypeUUID},
+		{Name: "blood_type_id", Type: field.TypeUUID},
+		{Name: "created_by_id", Type: field.TypeUUID},
+	}
+	// EmergencyCampaignsTable holds the schema information for the "emergency_campaigns" table.
+	EmergencyCampaignsTable = &schema.Table{
+		Name:       "emergency_campaigns",
+		Columns:    EmergencyCampaignsColumns,
+		PrimaryKey: []*schema.Column{EmergencyCampaignsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "accounts_blood_types_blood_type",
-				Columns:    []*schema.Column{AccountsColumns[15]},
+				Symbol:     "emergency_campaigns_pmi_locations_pmi_location",
+				Columns:    []*schema.Column{EmergencyCampaignsColumns[13]},
+				RefColumns: []*schema.Column{PmiLocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "emergency_campaigns_blood_types_blood_type",
+				Columns:    []*schema.Column{EmergencyCampaignsColumns[14]},
 				RefColumns: []*schema.Column{BloodTypesColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "accounts_roles_role",
-				Columns:    []*schema.Column{AccountsColumns[16]},
-				RefColumns: []*schema.Column{RolesColumns[0]},
-				OnDelete:   schema.SetNull,
+				Symbol:     "emergency_campaigns_accounts_created_by",
+				Columns:    []*schema.Column{EmergencyCampaignsColumns[15]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "account_deleted_at",
+				Name:    "emergencycampaign_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[3]},
+				Columns: []*schema.Column{EmergencyCampaignsColumns[3]},
+			},
+			{
+				Name:    "emergencycampaign_status",
+				Unique:  false,
+				Columns: []*schema.Column{EmergencyCampaignsColumns[11]},
 			},
 		},
 	}
-	// BloodTypesColumns holds the columns for the "blood_types" table.
-	BloodTypesColumns = []*schema.Column{
+	// EmergencyContactsColumns holds the columns for the "emergency_contacts" table.
+	EmergencyContactsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
 		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
 		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
-		{Name: "group", Type: field.TypeEnum, Comment: "comment:The ABO blood group classification (A, B, AB, or O).", Enums: []string{"A", "B", "AB", "O"}},
-		{Name: "rhesus", Type: field.TypeEnum, Nullable: true, Comment: "The Rhesus (Rh) factor of the blood group, either POSITIVE or NEGATIVE.", Enums: []string{"POSITIVE", "NEGATIVE"}},
+		{Name: "wave", Type: field.TypeInt},
+		{Name: "distance_m", Type: field.TypeInt, Comment: "Distance between the donor home and the PMI location in meters."},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"NOTIFIED", "ACCEPTED", "DECLINED"}, Default: "NOTIFIED"},
+		{Name: "responded_at", Type: field.TypeInt64, Nullable: true, Comment: "Time the donor responded in milliseconds."},
+		{Name: "emergency_campaign_id", Type: field.TypeUUID},
+		{Name: "account_id", Type: field.TypeUUID},
 	}
-	// BloodTypesTable holds the schema information for the "blood_types" table.
-	BloodTypesTable = &schema.Table{
-		Name:       "blood_types",
-		Columns:    BloodTypesColumns,
-		PrimaryKey: []*schema.Column{BloodTypesColumns[0]},
+	// EmergencyContactsTable holds the schema information for the "emergency_contacts" table.
+	EmergencyContactsTable = &schema.Table{
+		Name:       "emergency_contacts",
+		Columns:    EmergencyContactsColumns,
+		PrimaryKey: []*schema.Column{EmergencyContactsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "emergency_contacts_emergency_campaigns_campaign",
+				Columns:    []*schema.Column{EmergencyContactsColumns[8]},
+				RefColumns: []*schema.Column{EmergencyCampaignsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "emergency_contacts_accounts_account",
+				Columns:    []*schema.Column{EmergencyContactsColumns[9]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "bloodtype_deleted_at",
+				Name:    "emergencycontact_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{BloodTypesColumns[3]},
+				Columns: []*schema.Column{EmergencyContactsColumns[3]},
+			},
+			{
+				Name:    "emergencycontact_emergency_campaign_id_account_id",
+				Unique:  true,
+				Columns: []*schema.Column{EmergencyContactsColumns[8], EmergencyContactsColumns[9]},
 			},
 		},
 	}
-	// CasbinRuleColumns holds the columns for the "casbin_rule" table.
-	CasbinRuleColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "ptype", Type: field.TypeString, Nullable: true},
-		{Name: "v0", Type: field.TypeString, Nullable: true},
-		{Name: "v1", Type: field.TypeString, Nullable: true},
-		{Name: "v2", Type: field.TypeString, Nullable: true},
-		{Name: "v3", Type: field.TypeString, Nullable: true},
-		{Name: "v4", Type: field.TypeString, Nullable: true},
-		{Name: "v5", Type: field.TypeString, Nullable: true},
-	}
-	// CasbinRuleTable holds the schema information for the "casbin_rule" table.
-	CasbinRuleTable = &schema.Table{
-		Name:       "casbin_rule",
-		Columns:    CasbinRuleColumns,
-		PrimaryKey: []*schema.Column{CasbinRuleColumns[0]},
-	}
-	// CitiesColumns holds the columns for the "cities" table.
-	CitiesColumns = []*schema.Column{
+	// HospitalsColumns holds the columns for the "hospitals" table.
+	HospitalsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
-		{Name: "bps_code", Type: field.TypeString, Unique: true, Size: 4, SchemaType: map[string]string{"postgres": "char(4)"}},
-		{Name: "name", Type: field.TypeString},
-		{Name: "province_id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 16, Comment: "Hospital registration code issued by the Ministry of Health."},
+		{Name: "name", Type: field.TypeString, Size: 164},
+		{Name: "street", Type: field.TypeString, Size: 2147483647},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 164},
+		{Name: "dial_code", Type: field.TypeString, Size: 6},
+		{Name: "phone_number", Type: field.TypeString, Size: 13},
+		{Name: "activated", Type: field.TypeBool, Default: true},
+		{Name: "subdistrict_id", Type: field.TypeUUID},
 	}
-	// CitiesTable holds the schema information for the "cities" table.
-	CitiesTable = &schema.Table{
-		Name:       "cities",
-		Columns:    CitiesColumns,
-		PrimaryKey: []*schema.Column{CitiesColumns[0]},
+	// HospitalsTable holds the schema information for the "hospitals" table.
+	HospitalsTable = &schema.Table{
+		Name:       "hospitals",
+		Columns:    HospitalsColumns,
+		PrimaryKey: []*schema.Column{HospitalsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "cities_provinces_city",
-				Columns:    []*schema.Column{CitiesColumns[3]},
-				RefColumns: []*schema.Column{ProvincesColumns[0]},
+				Symbol:     "hospitals_subdistricts_subdistrict",
+				Columns:    []*schema.Column{HospitalsColumns[11]},
+				RefColumns: []*schema.Column{SubdistrictsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hospital_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{HospitalsColumns[3]},
+			},
+		},
 	}
-	// DistrictsColumns holds the columns for the "districts" table.
-	DistrictsColumns = []*schema.Column{
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
-		{Name: "bps_code", Type: field.TypeString, Unique: true, Size: 7, SchemaType: map[string]string{"postgres": "char(7)"}},
-		{Name: "name", Type: field.TypeString},
-		{Name: "city_id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "reminders_enabled", Type: field.TypeBool, Comment: "Whether the donor is reminded when they may donate again.", Default: true},
+		{Name: "push_enabled", Type: field.TypeBool, Default: true},
+		{Name: "email_enabled", Type: field.TypeBool, Default: true},
+		{Name: "sms_enabled", Type: field.TypeBool, Default: false},
+		{Name: "push_token", Type: field.TypeString, Nullable: true, Size: 512, Comment: "Device token push notifications are delivered to."},
+		{Name: "timezone", Type: field.TypeString, Size: 64, Comment: "IANA time zone the quiet hours are read in.", Default: "Asia/Jakarta"},
+		{Name: "quiet_hours_start", Type: field.TypeString, Nullable: true, Size: 5, Comment: "Local time formatted as HH:MM from which no notification is sent."},
+		{Name: "quiet_hours_end", Type: field.TypeString, Nullable: true, Size: 5, Comment: "Local time formatted as HH:MM at which notifications resume."},
 	}
-	// DistrictsTable holds the schema information for the "districts" table.
-	DistrictsTable = &schema.Table{
-		Name:       "districts",
-		Columns:    DistrictsColumns,
-		PrimaryKey: []*schema.Column{DistrictsColumns[0]},
-		ForeignKeys: []*schema.ForeignKey{
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		Indexes: []*schema.Index{
 			{
-				Symbol:     "districts_cities_district",
-				Columns:    []*schema.Column{DistrictsColumns[3]},
-				RefColumns: []*schema.Column{CitiesColumns[0]},
-				OnDelete:   schema.NoAction,
+				Name:    "notificationpreference_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationPreferencesColumns[3]},
 			},
 		},
 	}
@@ -248,6 +1064,7 @@ var (
 		{Name: "domain", Type: field.TypeString, Size: 164},
 		{Name: "resource", Type: field.TypeString},
 		{Name: "action", Type: field.TypeString},
+		{Name: "condition", Type: field.TypeString, Nullable: true, Size: 64},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 300},
 	}
 	// PermissionsTable holds the schema information for the "permissions" table.
@@ -285,6 +1102,29 @@ var (
 		Columns:    ProvincesColumns,
 		PrimaryKey: []*schema.Column{ProvincesColumns[0]},
 	}
+	// QuestionnairesColumns holds the columns for the "questionnaires" table.
+	QuestionnairesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "version", Type: field.TypeInt, Unique: true, Comment: "Questionnaire version. A published version is never edited, a new version is created instead."},
+		{Name: "title", Type: field.TypeString, Size: 164},
+		{Name: "activated", Type: field.TypeBool, Comment: "Only one version is activated and presented to donors.", Default: false},
+	}
+	// QuestionnairesTable holds the schema information for the "questionnaires" table.
+	QuestionnairesTable = &schema.Table{
+		Name:       "questionnaires",
+		Columns:    QuestionnairesColumns,
+		PrimaryKey: []*schema.Column{QuestionnairesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "questionnaire_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionnairesColumns[3]},
+			},
+		},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
@@ -315,11 +1155,205 @@ var (
 			},
 		},
 	}
+	// RoleAssignmentsColumns holds the columns for the "role_assignments" table.
+	RoleAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "domain", Type: field.TypeString, Size: 164},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "role_id", Type: field.TypeUUID},
+		{Name: "assigned_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// RoleAssignmentsTable holds the schema information for the "role_assignments" table.
+	RoleAssignmentsTable = &schema.Table{
+		Name:       "role_assignments",
+		Columns:    RoleAssignmentsColumns,
+		PrimaryKey: []*schema.Column{RoleAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_assignments_accounts_account",
+				Columns:    []*schema.Column{RoleAssignmentsColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_assignments_roles_role",
+				Columns:    []*schema.Column{RoleAssignmentsColumns[6]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_assignments_accounts_assigned_by",
+				Columns:    []*schema.Column{RoleAssignmentsColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roleassignment_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{RoleAssignmentsColumns[3]},
+			},
+			{
+				Name:    "roleassignment_domain_account_id_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{RoleAssignmentsColumns[4], RoleAssignmentsColumns[5], RoleAssignmentsColumns[6]},
+			},
+		},
+	}
+	// ScreeningQuestionsColumns holds the columns for the "screening_questions" table.
+	ScreeningQuestionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "key", Type: field.TypeString, Size: 64, Comment: "Stable identifier of the question within its questionnaire, used as the answer key."},
+		{Name: "position", Type: field.TypeInt16, SchemaType: map[string]string{"postgres": "smallint"}},
+		{Name: "text", Type: field.TypeString, Size: 2147483647},
+		{Name: "answer_type", Type: field.TypeEnum, Enums: []string{"YES_NO", "CHOICE", "NUMBER", "DATE", "TEXT"}},
+		{Name: "options", Type: field.TypeJSON, Nullable: true, Comment: "Allowed answers of a CHOICE question."},
+		{Name: "required", Type: field.TypeBool, Default: true},
+		{Name: "deferral_answers", Type: field.TypeJSON, Nullable: true, Comment: "Answers that defer the donor when given."},
+		{Name: "deferral_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"TEMPORARY", "PERMANENT"}},
+		{Name: "deferral_days", Type: field.TypeInt16, Nullable: true, Comment: "Length of a temporary deferral in days.", SchemaType: map[string]string{"postgres": "smallint"}},
+		{Name: "deferral_reason", Type: field.TypeString, Nullable: true, Size: 300},
+		{Name: "questionnaire_id", Type: field.TypeUUID},
+	}
+	// ScreeningQuestionsTable holds the schema information for the "screening_questions" table.
+	ScreeningQuestionsTable = &schema.Table{
+		Name:       "screening_questions",
+		Columns:    ScreeningQuestionsColumns,
+		PrimaryKey: []*schema.Column{ScreeningQuestionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "screening_questions_questionnaires_questions",
+				Columns:    []*schema.Column{ScreeningQuestionsColumns[14]},
+				RefColumns: []*schema.Column{QuestionnairesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "screeningquestion_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScreeningQuestionsColumns[3]},
+			},
+			{
+				Name:    "screeningquestion_key_questionnaire_id",
+				Unique:  true,
+				Columns: []*schema.Column{ScreeningQuestionsColumns[4], ScreeningQuestionsColumns[14]},
+			},
+		},
+	}
+	// ScreeningSubmissionsColumns holds the columns for the "screening_submissions" table.
+	ScreeningSubmissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "answers", Type: field.TypeJSON, Comment: "Answers keyed by question key."},
+		{Name: "deferred", Type: field.TypeBool, Comment: "Whether any answer triggered a deferral.", Default: false},
+		{Name: "signed_at", Type: field.TypeInt64, Comment: "Time the donor signed the declaration in milliseconds."},
+		{Name: "payload_hash", Type: field.TypeString, Size: 64, Comment: "SHA256 of the signed payload.", SchemaType: map[string]string{"postgres": "char(64)"}},
+		{Name: "signature", Type: field.TypeString, Comment: "Ed25519 signature of the payload hash by the server key."},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "questionnaire_id", Type: field.TypeUUID},
+		{Name: "appointment_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// ScreeningSubmissionsTable holds the schema information for the "screening_submissions" table.
+	ScreeningSubmissionsTable = &schema.Table{
+		Name:       "screening_submissions",
+		Columns:    ScreeningSubmissionsColumns,
+		PrimaryKey: []*schema.Column{ScreeningSubmissionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "screening_submissions_accounts_account",
+				Columns:    []*schema.Column{ScreeningSubmissionsColumns[9]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "screening_submissions_questionnaires_questionnaire",
+				Columns:    []*schema.Column{ScreeningSubmissionsColumns[10]},
+				RefColumns: []*schema.Column{QuestionnairesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "screening_submissions_appointments_appointment",
+				Columns:    []*schema.Column{ScreeningSubmissionsColumns[11]},
+				RefColumns: []*schema.Column{AppointmentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "screeningsubmission_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScreeningSubmissionsColumns[3]},
+			},
+		},
+	}
+	// StockMovementsColumns holds the columns for the "stock_movements" table.
+	StockMovementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
+		{Name: "created_at", Type: field.TypeInt64, Default: schema.Expr("FLOOR(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)")},
+		{Name: "updated_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeInt64, Nullable: true, Comment: "Represents soft delete timestamp in milliseconds."},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"COLLECT", "ISSUE", "DISCARD", "TRANSFER_IN", "TRANSFER_OUT"}},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "balance", Type: field.TypeInt, Comment: "Stock quantity after the movement was applied."},
+		{Name: "transfer_id", Type: field.TypeUUID, Nullable: true, Comment: "Shared by the TRANSFER_OUT and TRANSFER_IN movements of one transfer."},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 300},
+		{Name: "blood_stock_id", Type: field.TypeUUID},
+		{Name: "performed_by_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "blood_unit_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// StockMovementsTable holds the schema information for the "stock_movements" table.
+	StockMovementsTable = &schema.Table{
+		Name:       "stock_movements",
+		Columns:    StockMovementsColumns,
+		PrimaryKey: []*schema.Column{StockMovementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stock_movements_blood_stocks_stock",
+				Columns:    []*schema.Column{StockMovementsColumns[9]},
+				RefColumns: []*schema.Column{BloodStocksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "stock_movements_accounts_performed_by",
+				Columns:    []*schema.Column{StockMovementsColumns[10]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "stock_movements_blood_units_unit",
+				Columns:    []*schema.Column{StockMovementsColumns[11]},
+				RefColumns: []*schema.Column{BloodUnitsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stockmovement_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{StockMovementsColumns[3]},
+			},
+			{
+				Name:    "stockmovement_transfer_id",
+				Unique:  false,
+				Columns: []*schema.Column{StockMovementsColumns[7]},
+			},
+		},
+	}
 	// SubdistrictsColumns holds the columns for the "subdistricts" table.
 	SubdistrictsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true, Default: schema.Expr("uuid_generate_v4()")},
 		{Name: "bps_code", Type: field.TypeString, Unique: true, Size: 10, SchemaType: map[string]string{"postgres": "char(10)"}},
-		{Name: "postal_code", Type: field.TypeString, Unique: true, Size: 5, SchemaType: map[string]string{"postgres": "char(5)"}},
+		{Name: "postal_code", Type: field.TypeString, Size: 5, SchemaType: map[string]string{"postgres": "char(5)"}},
 		{Name: "name", Type: field.TypeString},
 		{Name: "district_id", Type: field.TypeUUID},
 	}
@@ -362,27 +1396,84 @@ var (
 			},
 		},
 	}
+	// RolePermissionsColumns holds the columns for the "role_permissions" table.
+	RolePermissionsColumns = []*schema.Column{
+		{Name: "role_id", Type: field.TypeUUID},
+		{Name: "permission_id", Type: field.TypeUUID},
+	}
+	// RolePermissionsTable holds the schema information for the "role_permissions" table.
+	RolePermissionsTable = &schema.Table{
+		Name:       "role_permissions",
+		Columns:    RolePermissionsColumns,
+		PrimaryKey: []*schema.Column{RolePermissionsColumns[0], RolePermissionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_permissions_role_id",
+				Columns:    []*schema.Column{RolePermissionsColumns[0]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_permissions_permission_id",
+				Columns:    []*schema.Column{RolePermissionsColumns[1]},
+				RefColumns: []*schema.Column{PermissionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
 		AccountsTable,
+		AppointmentsTable,
+		AuditLogsTable,
+		BloodRequestsTable,
+		BloodStocksTable,
 		BloodTypesTable,
+		BloodUnitsTable,
+		BloodUnitEventsTable,
 		CasbinRuleTable,
+		CertificatesTable,
 		CitiesTable,
+		DeferralsTable,
 		DistrictsTable,
+		DonationsTable,
+		DonationEventsTable,
+		DonationRemindersTable,
+		EmergencyCampaignsTable,
+		EmergencyContactsTable,
+		HospitalsTable,
+		NotificationPreferencesTable,
 		OtpsTable,
 		PmiLocationsTable,
 		PasswordsTable,
 		PermissionsTable,
 		ProvincesTable,
+		QuestionnairesTable,
 		RolesTable,
+		RoleAssignmentsTable,
+		ScreeningQuestionsTable,
+		ScreeningSubmissionsTable,
+		StockMovementsTable,
 		SubdistrictsTable,
 		RoleParentTable,
+		RolePermissionsTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = AccountsTable
+	APIKeysTable.ForeignKeys[1].RefTable = HospitalsTable
+	APIKeysTable.Annotation = &entsql.Annotation{
+		Table: "api_keys",
+	}
+	APIKeysTable.Annotation.Checks = map[string]string{
+		"secret_hash": "length(secret_hash) = 64",
+	}
 	AccountsTable.ForeignKeys[0].RefTable = BloodTypesTable
 	AccountsTable.ForeignKeys[1].RefTable = RolesTable
+	AccountsTable.ForeignKeys[2].RefTable = HospitalsTable
+	AccountsTable.ForeignKeys[3].RefTable = NotificationPreferencesTable
 	AccountsTable.Annotation = &entsql.Annotation{}
 	AccountsTable.Annotation.Checks = map[string]string{
 		"country_iso_code":   "length(country_iso_code) = 2",
@@ -391,19 +1482,91 @@ func init() {
 		"national_id_masked": "length(national_id_masked) = 8",
 		"phone_number":       "length(phone_number) >= 11 and length(phone_number) <= 13",
 	}
+	AppointmentsTable.ForeignKeys[0].RefTable = AccountsTable
+	AppointmentsTable.ForeignKeys[1].RefTable = PmiLocationsTable
+	AppointmentsTable.ForeignKeys[2].RefTable = DonationsTable
+	BloodRequestsTable.ForeignKeys[0].RefTable = HospitalsTable
+	BloodRequestsTable.ForeignKeys[1].RefTable = BloodTypesTable
+	BloodRequestsTable.ForeignKeys[2].RefTable = PmiLocationsTable
+	BloodRequestsTable.ForeignKeys[3].RefTable = AccountsTable
+	BloodRequestsTable.ForeignKeys[4].RefTable = AccountsTable
+	BloodRequestsTable.Annotation = &entsql.Annotation{}
+	BloodRequestsTable.Annotation.Checks = map[string]string{
+		"fulfilled_quantity": "fulfilled_quantity <= quantity",
+	}
+	BloodStocksTable.ForeignKeys[0].RefTable = PmiLocationsTable
+	BloodStocksTable.ForeignKeys[1].RefTable = BloodTypesTable
+	BloodStocksTable.Annotation = &entsql.Annotation{
+		Table: "blood_stocks",
+	}
+	BloodStocksTable.Annotation.Checks = map[string]string{
+		"quantity": "quantity >= 0",
+	}
+	BloodUnitsTable.ForeignKeys[0].RefTable = PmiLocationsTable
+	BloodUnitsTable.ForeignKeys[1].RefTable = BloodTypesTable
+	BloodUnitsTable.ForeignKeys[2].RefTable = DonationsTable
+	BloodUnitsTable.ForeignKeys[3].RefTable = BloodRequestsTable
+	BloodUnitsTable.Annotation = &entsql.Annotation{}
+	BloodUnitsTable.Annotation.Checks = map[string]string{
+		"barcode":    "length(barcode) >= 3 and length(barcode) <= 64",
+		"expires_at": "expires_at > collected_at",
+	}
+	BloodUnitEventsTable.ForeignKeys[0].RefTable = BloodUnitsTable
+	BloodUnitEventsTable.ForeignKeys[1].RefTable = AccountsTable
 	CasbinRuleTable.Annotation = &entsql.Annotation{
 		Table: "casbin_rule",
 	}
+	CertificatesTable.ForeignKeys[0].RefTable = AccountsTable
+	CertificatesTable.ForeignKeys[1].RefTable = DonationsTable
 	CitiesTable.ForeignKeys[0].RefTable = ProvincesTable
 	CitiesTable.Annotation = &entsql.Annotation{}
 	CitiesTable.Annotation.Checks = map[string]string{
 		"bps_code": "length(bps_code) = 4",
 	}
+	DeferralsTable.ForeignKeys[0].RefTable = AccountsTable
+	DeferralsTable.ForeignKeys[1].RefTable = AccountsTable
+	DeferralsTable.ForeignKeys[2].RefTable = ScreeningSubmissionsTable
+	DeferralsTable.Annotation = &entsql.Annotation{}
+	DeferralsTable.Annotation.Checks = map[string]string{
+		"reason": "length(reason) >= 3 and length(reason) <= 300",
+	}
 	DistrictsTable.ForeignKeys[0].RefTable = CitiesTable
 	DistrictsTable.Annotation = &entsql.Annotation{}
 	DistrictsTable.Annotation.Checks = map[string]string{
 		"bps_code": "length(bps_code) = 7",
 	}
+	DonationsTable.ForeignKeys[0].RefTable = AccountsTable
+	DonationsTable.ForeignKeys[1].RefTable = PmiLocationsTable
+	DonationsTable.ForeignKeys[2].RefTable = AccountsTable
+	DonationsTable.ForeignKeys[3].RefTable = DonationRemindersTable
+	DonationsTable.Annotation = &entsql.Annotation{}
+	DonationsTable.Annotation.Checks = map[string]string{
+		"bag_number": "length(bag_number) >= 3 and length(bag_number) <= 64",
+	}
+	DonationEventsTable.ForeignKeys[0].RefTable = SubdistrictsTable
+	DonationEventsTable.ForeignKeys[1].RefTable = PmiLocationsTable
+	DonationEventsTable.ForeignKeys[2].RefTable = AccountsTable
+	DonationEventsTable.ForeignKeys[3].RefTable = AccountsTable
+	DonationEventsTable.Annotation = &entsql.Annotation{}
+	DonationEventsTable.Annotation.Checks = map[string]string{
+		"ends_at": "ends_at > starts_at",
+	}
+	DonationRemindersTable.ForeignKeys[0].RefTable = AccountsTable
+	EmergencyCampaignsTable.ForeignKeys[0].RefTable = PmiLocationsTable
+	EmergencyCampaignsTable.ForeignKeys[1].RefTable = BloodTypesTable
+	EmergencyCampaignsTable.ForeignKeys[2].RefTable = AccountsTable
+	EmergencyCampaignsTable.Annotation = &entsql.Annotation{}
+	EmergencyCampaignsTable.Annotation.Checks = map[string]string{
+		"committed_donors": "committed_donors <= needed_donors",
+	}
+	EmergencyContactsTable.ForeignKeys[0].RefTable = EmergencyCampaignsTable
+	EmergencyContactsTable.ForeignKeys[1].RefTable = AccountsTable
+	HospitalsTable.ForeignKeys[0].RefTable = SubdistrictsTable
+	HospitalsTable.Annotation = &entsql.Annotation{}
+	HospitalsTable.Annotation.Checks = map[string]string{
+		"name":         "length(name) >= 3 and length(name) <= 164",
+		"phone_number": "length(phone_number) >= 11 and length(phone_number) <= 13",
+	}
 	OtpsTable.ForeignKeys[0].RefTable = AccountsTable
 	OtpsTable.Annotation = &entsql.Annotation{
 		Table: "otps",
@@ -430,6 +1593,10 @@ func init() {
 	ProvincesTable.Annotation.Checks = map[string]string{
 		"bps_code": "length(bps_code) = 2",
 	}
+	QuestionnairesTable.Annotation = &entsql.Annotation{}
+	QuestionnairesTable.Annotation.Checks = map[string]string{
+		"title": "length(title) >= 3 and length(title) <= 164",
+	}
 	RolesTable.Annotation = &entsql.Annotation{}
 	RolesTable.Annotation.Checks = map[string]string{
 		"description": "length(description) >= 30 and length(description) <= 300",
@@ -437,6 +1604,24 @@ func init() {
 		"key":         "length(key) >= 3 and length(key) <= 164",
 		"name":        "length(name) >= 3 and length(name) <= 164",
 	}
+	RoleAssignmentsTable.ForeignKeys[0].RefTable = AccountsTable
+	RoleAssignmentsTable.ForeignKeys[1].RefTable = RolesTable
+	RoleAssignmentsTable.ForeignKeys[2].RefTable = AccountsTable
+	RoleAssignmentsTable.Annotation = &entsql.Annotation{}
+	RoleAssignmentsTable.Annotation.Checks = map[string]string{
+		"domain": "length(domain) >= 1 and length(domain) <= 164",
+	}
+	ScreeningQuestionsTable.ForeignKeys[0].RefTable = QuestionnairesTable
+	ScreeningSubmissionsTable.ForeignKeys[0].RefTable = AccountsTable
+	ScreeningSubmissionsTable.ForeignKeys[1].RefTable = QuestionnairesTable
+	ScreeningSubmissionsTable.ForeignKeys[2].RefTable = AppointmentsTable
+	ScreeningSubmissionsTable.Annotation = &entsql.Annotation{}
+	ScreeningSubmissionsTable.Annotation.Checks = map[string]string{
+		"payload_hash": "length(payload_hash) = 64",
+	}
+	StockMovementsTable.ForeignKeys[0].RefTable = BloodStocksTable
+	StockMovementsTable.ForeignKeys[1].RefTable = AccountsTable
+	StockMovementsTable.ForeignKeys[2].RefTable = BloodUnitsTable
 	SubdistrictsTable.ForeignKeys[0].RefTable = DistrictsTable
 	SubdistrictsTable.Annotation = &entsql.Annotation{}
 	SubdistrictsTable.Annotation.Checks = map[string]string{
@@ -445,4 +1630,6 @@ func init() {
 	}
 	RoleParentTable.ForeignKeys[0].RefTable = RolesTable
 	RoleParentTable.ForeignKeys[1].RefTable = RolesTable
+	RolePermissionsTable.ForeignKeys[0].RefTable = RolesTable
+	RolePermissionsTable.ForeignKeys[1].RefTable = PermissionsTable
 }
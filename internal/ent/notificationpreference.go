@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/notificationpreference"
+)
+
+// NotificationPreference is the model entity for the NotificationPreference schema.
+type NotificationPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Whether the donor is reminded when they may donate again.
+	RemindersEnabled bool `json:"reminders_enabled"`
+	// PushEnabled holds the value of the "push_enabled" field.
+	PushEnabled bool `json:"push_enabled"`
+	// EmailEnabled holds the value of the "email_enabled" field.
+	EmailEnabled bool `json:"email_enabled"`
+	// SmsEnabled holds the value of the "sms_enabled" field.
+	SmsEnabled bool `json:"sms_enabled"`
+	// Device token push notifications are delivered to.
+	PushToken string `json:"-"`
+	// IANA time zone the quiet hours are read in.
+	Timezone string `json:"timezone"`
+	// Local time formatted as HH:MM from which no notification is sent.
+	QuietHoursStart string `json:"quiet_hours_start"`
+	// Local time formatted as HH:MM at which notifications resume.
+	QuietHoursEnd string `json:"quiet_hours_end"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationPreferenceQuery when eager-loading is set.
+	Edges        NotificationPreferenceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NotificationPreferenceEdges holds the relations/edges for other nodes in the graph.
+type NotificationPreferenceEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationPreferenceEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationPreference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreference.FieldRemindersEnabled, notificationpreference.FieldPushEnabled, notificationpreference.FieldEmailEnabled, notificationpreference.FieldSmsEnabled:
+			values[i] = new(sql.NullBool)
+		case notificationpreference.FieldCreatedAt, notificationpreference.FieldUpdatedAt, notificationpreference.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case notificationpreference.FieldPushToken, notificationpreference.FieldTimezone, notificationpreference.FieldQuietHoursStart, notificationpreference.FieldQuietHoursEnd:
+			values[i] = new(sql.NullString)
+		case notificationpreference.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationPreference fields.
+func (_m *NotificationPreference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreference.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case notificationpreference.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case notificationpreference.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case notificationpreference.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case notificationpreference.FieldRemindersEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field reminders_enabled", values[i])
+			} else if value.Valid {
+				_m.RemindersEnabled = value.Bool
+			}
+		case notificationpreference.FieldPushEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field push_enabled", values[i])
+			} else if value.Valid {
+				_m.PushEnabled = value.Bool
+			}
+		case notificationpreference.FieldEmailEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email_enabled", values[i])
+			} else if value.Valid {
+				_m.EmailEnabled = value.Bool
+			}
+		case notificationpreference.FieldSmsEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sms_enabled", values[i])
+			} else if value.Valid {
+				_m.SmsEnabled = value.Bool
+			}
+		case notificationpreference.FieldPushToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field push_token", values[i])
+			} else if value.Valid {
+				_m.PushToken = value.String
+			}
+		case notificationpreference.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
+		case notificationpreference.FieldQuietHoursStart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_hours_start", values[i])
+			} else if value.Valid {
+				_m.QuietHoursStart = value.String
+			}
+		case notificationpreference.FieldQuietHoursEnd:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_hours_end", values[i])
+			} else if value.Valid {
+				_m.QuietHoursEnd = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationPreference.
+// This includes values selected through modifiers, order, etc.
+func (_m *NotificationPreference) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the NotificationPreference entity.
+func (_m *NotificationPreference) QueryAccount() *AccountQuery {
+	return NewNotificationPreferenceClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this NotificationPreference.
+// Note that you need to call NotificationPreference.Unwrap() before calling this method if this NotificationPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NotificationPreference) Update() *NotificationPreferenceUpdateOne {
+	return NewNotificationPreferenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NotificationPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NotificationPreference) Unwrap() *NotificationPreference {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationPreference is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NotificationPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("reminders_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RemindersEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("push_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PushEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("email_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmailEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("sms_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SmsEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("push_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("quiet_hours_start=")
+	builder.WriteString(_m.QuietHoursStart)
+	builder.WriteString(", ")
+	builder.WriteString("quiet_hours_end=")
+	builder.WriteString(_m.QuietHoursEnd)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationPreferences is a parsable slice of NotificationPreference.
+type NotificationPreferences []*NotificationPreference
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the notificationpreference type in the database.
+	Label = "notification_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldRemindersEnabled holds the string denoting the reminders_enabled field in the database.
+	FieldRemindersEnabled = "reminders_enabled"
+	// FieldPushEnabled holds the string denoting the push_enabled field in the database.
+	FieldPushEnabled = "push_enabled"
+	// FieldEmailEnabled holds the string denoting the email_enabled field in the database.
+	FieldEmailEnabled = "email_enabled"
+	// FieldSmsEnabled holds the string denoting the sms_enabled field in the database.
+	FieldSmsEnabled = "sms_enabled"
+	// FieldPushToken holds the string denoting the push_token field in the database.
+	FieldPushToken = "push_token"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldQuietHoursStart holds the string denoting the quiet_hours_start field in the database.
+	FieldQuietHoursStart = "quiet_hours_start"
+	// FieldQuietHoursEnd holds the string denoting the quiet_hours_end field in the database.
+	FieldQuietHoursEnd = "quiet_hours_end"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// Table holds the table name of the notificationpreference in the database.
+	Table = "notification_preferences"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "accounts"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+)
+
+// Columns holds all SQL columns for notificationpreference fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldRemindersEnabled,
+	FieldPushEnabled,
+	FieldEmailEnabled,
+	FieldSmsEnabled,
+	FieldPushToken,
+	FieldTimezone,
+	FieldQuietHoursStart,
+	FieldQuietHoursEnd,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// DefaultRemindersEnabled holds the default value on creation for the "reminders_enabled" field.
+	DefaultRemindersEnabled bool
+	// DefaultPushEnabled holds the default value on creation for the "push_enabled" field.
+	DefaultPushEnabled bool
+	// DefaultEmailEnabled holds the default value on creation for the "email_enabled" field.
+	DefaultEmailEnabled bool
+	// DefaultSmsEnabled holds the default value on creation for the "sms_enabled" field.
+	DefaultSmsEnabled bool
+	// PushTokenValidator is a validator for the "push_token" field. It is called by the builders before save.
+	PushTokenValidator func(string) error
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
+	// QuietHoursStartValidator is a validator for the "quiet_hours_start" field. It is called by the builders before save.
+	QuietHoursStartValidator func(string) error
+	// QuietHoursEndValidator is a validator for the "quiet_hours_end" field. It is called by the builders before save.
+	QuietHoursEndValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the NotificationPreference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByRemindersEnabled orders the results by the reminders_enabled field.
+func ByRemindersEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemindersEnabled, opts...).ToFunc()
+}
+
+// ByPushEnabled orders the results by the push_enabled field.
+func ByPushEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPushEnabled, opts...).ToFunc()
+}
+
+// ByEmailEnabled orders the results by the email_enabled field.
+func ByEmailEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailEnabled, opts...).ToFunc()
+}
+
+// BySmsEnabled orders the results by the sms_enabled field.
+func BySmsEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSmsEnabled, opts...).ToFunc()
+}
+
+// ByPushToken orders the results by the push_token field.
+func ByPushToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPushToken, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByQuietHoursStart orders the results by the quiet_hours_start field.
+func ByQuietHoursStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietHoursStart, opts...).ToFunc()
+}
+
+// ByQuietHoursEnd orders the results by the quiet_hours_end field.
+func ByQuietHoursEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietHoursEnd, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, AccountTable, AccountColumn),
+	)
+}
@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// RemindersEnabled applies equality check predicate on the "reminders_enabled" field. It's identical to RemindersEnabledEQ.
+func RemindersEnabled(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldRemindersEnabled, v))
+}
+
+// PushEnabled applies equality check predicate on the "push_enabled" field. It's identical to PushEnabledEQ.
+func PushEnabled(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldPushEnabled, v))
+}
+
+// EmailEnabled applies equality check predicate on the "email_enabled" field. It's identical to EmailEnabledEQ.
+func EmailEnabled(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldEmailEnabled, v))
+}
+
+// SmsEnabled applies equality check predicate on the "sms_enabled" field. It's identical to SmsEnabledEQ.
+func SmsEnabled(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldSmsEnabled, v))
+}
+
+// PushToken applies equality check predicate on the "push_token" field. It's identical to PushTokenEQ.
+func PushToken(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldPushToken, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldTimezone, v))
+}
+
+// QuietHoursStart applies equality check predicate on the "quiet_hours_start" field. It's identical to QuietHoursStartEQ.
+func QuietHoursStart(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldQuietHoursStart, v))
+}
+
+// QuietHoursEnd applies equality check predicate on the "quiet_hours_end" field. It's identical to QuietHoursEndEQ.
+func QuietHoursEnd(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldQuietHoursEnd, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// RemindersEnabledEQ applies the EQ predicate on the "reminders_enabled" field.
+func RemindersEnabledEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldRemindersEnabled, v))
+}
+
+// RemindersEnabledNEQ applies the NEQ predicate on the "reminders_enabled" field.
+func RemindersEnabledNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldRemindersEnabled, v))
+}
+
+// PushEnabledEQ applies the EQ predicate on the "push_enabled" field.
+func PushEnabledEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldPushEnabled, v))
+}
+
+// PushEnabledNEQ applies the NEQ predicate on the "push_enabled" field.
+func PushEnabledNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldPushEnabled, v))
+}
+
+// EmailEnabledEQ applies the EQ predicate on the "email_enabled" field.
+func EmailEnabledEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldEmailEnabled, v))
+}
+
+// EmailEnabledNEQ applies the NEQ predicate on the "email_enabled" field.
+func EmailEnabledNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldEmailEnabled, v))
+}
+
+// SmsEnabledEQ applies the EQ predicate on the "sms_enabled" field.
+func SmsEnabledEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldSmsEnabled, v))
+}
+
+// SmsEnabledNEQ applies the NEQ predicate on the "sms_enabled" field.
+func SmsEnabledNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldSmsEnabled, v))
+}
+
+// PushTokenEQ applies the EQ predicate on the "push_token" field.
+func PushTokenEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldPushToken, v))
+}
+
+// PushTokenNEQ applies the NEQ predicate on the "push_token" field.
+func PushTokenNEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldPushToken, v))
+}
+
+// PushTokenIn applies the In predicate on the "push_token" field.
+func PushTokenIn(vs ...string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldPushToken, vs...))
+}
+
+// PushTokenNotIn applies the NotIn predicate on the "push_token" field.
+func PushTokenNotIn(vs ...string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldPushToken, vs...))
+}
+
+// PushTokenGT applies the GT predicate on the "push_token" field.
+func PushTokenGT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldPushToken, v))
+}
+
+// PushTokenGTE applies the GTE predicate on the "push_token" field.
+func PushTokenGTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldPushToken, v))
+}
+
+// PushTokenLT applies the LT predicate on the "push_token" field.
+func PushTokenLT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldPushToken, v))
+}
+
+// PushTokenLTE applies the LTE predicate on the "push_token" field.
+func PushTokenLTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldPushToken, v))
+}
+
+// PushTokenContains applies the Contains predicate on the "push_token" field.
+func PushTokenContains(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldContains(FieldPushToken, v))
+}
+
+// PushTokenHasPrefix applies the HasPrefix predicate on the "push_token" field.
+func PushTokenHasPrefix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldHasPrefix(FieldPushToken, v))
+}
+
+// PushTokenHasSuffix applies the HasSuffix predicate on the "push_token" field.
+func PushTokenHasSuffix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldHasSuffix(FieldPushToken, v))
+}
+
+// PushTokenIsNil applies the IsNil predicate on the "push_token" field.
+func PushTokenIsNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIsNull(FieldPushToken))
+}
+
+// PushTokenNotNil applies the NotNil predicate on the "push_token" field.
+func PushTokenNotNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotNull(FieldPushToken))
+}
+
+// PushTokenEqualFold applies the EqualFold predicate on the "push_token" field.
+func PushTokenEqualFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEqualFold(FieldPushToken, v))
+}
+
+// PushTokenContainsFold applies the ContainsFold predicate on the "push_token" field.
+func PushTokenContainsFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldContainsFold(FieldPushToken, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// QuietHoursStartEQ applies the EQ predicate on the "quiet_hours_start" field.
+func QuietHoursStartEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldQuietHoursStart, v))
+}
+
+// QuietHoursStartNEQ applies the NEQ predicate on the "quiet_hours_start" field.
+func QuietHoursStartNEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldQuietHoursStart, v))
+}
+
+// QuietHoursStartIn applies the In predicate on the "quiet_hours_start" field.
+func QuietHoursStartIn(vs ...string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldQuietHoursStart, vs...))
+}
+
+// QuietHoursStartNotIn applies the NotIn predicate on the "quiet_hours_start" field.
+func QuietHoursStartNotIn(vs ...string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldQuietHoursStart, vs...))
+}
+
+// QuietHoursStartGT applies the GT predicate on the "quiet_hours_start" field.
+func QuietHoursStartGT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldQuietHoursStart, v))
+}
+
+// QuietHoursStartGTE applies the GTE predicate on the "quiet_hours_start" field.
+func QuietHoursStartGTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldQuietHoursStart, v))
+}
+
+// QuietHoursStartLT applies the LT predicate on the "quiet_hours_start" field.
+func QuietHoursStartLT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldQuietHoursStart, v))
+}
+
+// QuietHoursStartLTE applies the LTE predicate on the "quiet_hours_start" field.
+func QuietHoursStartLTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldQuietHoursStart, v))
+}
+
+// QuietHoursStartContains applies the Contains predicate on the "quiet_hours_start" field.
+func QuietHoursStartContains(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldContains(FieldQuietHoursStart, v))
+}
+
+// QuietHoursStartHasPrefix applies the HasPrefix predicate on the "quiet_hours_start" field.
+func QuietHoursStartHasPrefix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldHasPrefix(FieldQuietHoursStart, v))
+}
+
+// QuietHoursStartHasSuffix applies the HasSuffix predicate on the "quiet_hours_start" field.
+func QuietHoursStartHasSuffix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldHasSuffix(FieldQuietHoursStart, v))
+}
+
+// QuietHoursStartIsNil applies the IsNil predicate on the "quiet_hours_start" field.
+func QuietHoursStartIsNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIsNull(FieldQuietHoursStart))
+}
+
+// QuietHoursStartNotNil applies the NotNil predicate on the "quiet_hours_start" field.
+func QuietHoursStartNotNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotNull(FieldQuietHoursStart))
+}
+
+// QuietHoursStartEqualFold applies the EqualFold predicate on the "quiet_hours_start" field.
+func QuietHoursStartEqualFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEqualFold(FieldQuietHoursStart, v))
+}
+
+// QuietHoursStartContainsFold applies the ContainsFold predicate on the "quiet_hours_start" field.
+func QuietHoursStartContainsFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldContainsFold(FieldQuietHoursStart, v))
+}
+
+// QuietHoursEndEQ applies the EQ predicate on the "quiet_hours_end" field.
+func QuietHoursEndEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldQuietHoursEnd, v))
+}
+
+// QuietHoursEndNEQ applies the NEQ predicate on the "quiet_hours_end" field.
+func QuietHoursEndNEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldQuietHoursEnd, v))
+}
+
+// QuietHoursEndIn applies the In predicate on the "quiet_hours_end" field.
+func QuietHoursEndIn(vs ...string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldQuietHoursEnd, vs...))
+}
+
+// QuietHoursEndNotIn applies the NotIn predicate on the "quiet_hours_end" field.
+func QuietHoursEndNotIn(vs ...string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldQuietHoursEnd, vs...))
+}
+
+// QuietHoursEndGT applies the GT predicate on the "quiet_hours_end" field.
+func QuietHoursEndGT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldQuietHoursEnd, v))
+}
+
+// QuietHoursEndGTE applies the GTE predicate on the "quiet_hours_end" field.
+func QuietHoursEndGTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldQuietHoursEnd, v))
+}
+
+// QuietHoursEndLT applies the LT predicate on the "quiet_hours_end" field.
+func QuietHoursEndLT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldQuietHoursEnd, v))
+}
+
+// QuietHoursEndLTE applies the LTE predicate on the "quiet_hours_end" field.
+func QuietHoursEndLTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldQuietHoursEnd, v))
+}
+
+// QuietHoursEndContains applies the Contains predicate on the "quiet_hours_end" field.
+func QuietHoursEndContains(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldContains(FieldQuietHoursEnd, v))
+}
+
+// QuietHoursEndHasPrefix applies the HasPrefix predicate on the "quiet_hours_end" field.
+func QuietHoursEndHasPrefix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldHasPrefix(FieldQuietHoursEnd, v))
+}
+
+// QuietHoursEndHasSuffix applies the HasSuffix predicate on the "quiet_hours_end" field.
+func QuietHoursEndHasSuffix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldHasSuffix(FieldQuietHoursEnd, v))
+}
+
+// QuietHoursEndIsNil applies the IsNil predicate on the "quiet_hours_end" field.
+func QuietHoursEndIsNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIsNull(FieldQuietHoursEnd))
+}
+
+// QuietHoursEndNotNil applies the NotNil predicate on the "quiet_hours_end" field.
+func QuietHoursEndNotNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotNull(FieldQuietHoursEnd))
+}
+
+// QuietHoursEndEqualFold applies the EqualFold predicate on the "quiet_hours_end" field.
+func QuietHoursEndEqualFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEqualFold(FieldQuietHoursEnd, v))
+}
+
+// QuietHoursEndContainsFold applies the ContainsFold predicate on the "quiet_hours_end" field.
+func QuietHoursEndContainsFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldContainsFold(FieldQuietHoursEnd, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.NotPredicates(p))
+}
@@ -0,0 +1,729 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sembraniteam/setetes/internal/ent/notificationpreference"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// NotificationPreferenceUpdate is the builder for updating NotificationPreference entities.
+type NotificationPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdate) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationPreferenceUpdate) SetUpdatedAt(v int64) *NotificationPreferenceUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *NotificationPreferenceUpdate) AddUpdatedAt(v int64) *NotificationPreferenceUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *NotificationPreferenceUpdate) ClearUpdatedAt() *NotificationPreferenceUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *NotificationPreferenceUpdate) SetDeletedAt(v int64) *NotificationPreferenceUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableDeletedAt(v *int64) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *NotificationPreferenceUpdate) AddDeletedAt(v int64) *NotificationPreferenceUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *NotificationPreferenceUpdate) ClearDeletedAt() *NotificationPreferenceUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetRemindersEnabled sets the "reminders_enabled" field.
+func (_u *NotificationPreferenceUpdate) SetRemindersEnabled(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetRemindersEnabled(v)
+	return _u
+}
+
+// SetNillableRemindersEnabled sets the "reminders_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableRemindersEnabled(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetRemindersEnabled(*v)
+	}
+	return _u
+}
+
+// SetPushEnabled sets the "push_enabled" field.
+func (_u *NotificationPreferenceUpdate) SetPushEnabled(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetPushEnabled(v)
+	return _u
+}
+
+// SetNillablePushEnabled sets the "push_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillablePushEnabled(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetPushEnabled(*v)
+	}
+	return _u
+}
+
+// SetEmailEnabled sets the "email_enabled" field.
+func (_u *NotificationPreferenceUpdate) SetEmailEnabled(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetEmailEnabled(v)
+	return _u
+}
+
+// SetNillableEmailEnabled sets the "email_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableEmailEnabled(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetEmailEnabled(*v)
+	}
+	return _u
+}
+
+// SetSmsEnabled sets the "sms_enabled" field.
+func (_u *NotificationPreferenceUpdate) SetSmsEnabled(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetSmsEnabled(v)
+	return _u
+}
+
+// SetNillableSmsEnabled sets the "sms_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableSmsEnabled(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetSmsEnabled(*v)
+	}
+	return _u
+}
+
+// SetPushToken sets the "push_token" field.
+func (_u *NotificationPreferenceUpdate) SetPushToken(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetPushToken(v)
+	return _u
+}
+
+// SetNillablePushToken sets the "push_token" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillablePushToken(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetPushToken(*v)
+	}
+	return _u
+}
+
+// ClearPushToken clears the value of the "push_token" field.
+func (_u *NotificationPreferenceUpdate) ClearPushToken() *NotificationPreferenceUpdate {
+	_u.mutation.ClearPushToken()
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *NotificationPreferenceUpdate) SetTimezone(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableTimezone(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (_u *NotificationPreferenceUpdate) SetQuietHoursStart(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetQuietHoursStart(v)
+	return _u
+}
+
+// SetNillableQuietHoursStart sets the "quiet_hours_start" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableQuietHoursStart(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetQuietHoursStart(*v)
+	}
+	return _u
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (_u *NotificationPreferenceUpdate) ClearQuietHoursStart() *NotificationPreferenceUpdate {
+	_u.mutation.ClearQuietHoursStart()
+	return _u
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (_u *NotificationPreferenceUpdate) SetQuietHoursEnd(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetQuietHoursEnd(v)
+	return _u
+}
+
+// SetNillableQuietHoursEnd sets the "quiet_hours_end" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableQuietHoursEnd(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetQuietHoursEnd(*v)
+	}
+	return _u
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (_u *NotificationPreferenceUpdate) ClearQuietHoursEnd() *NotificationPreferenceUpdate {
+	_u.mutation.ClearQuietHoursEnd()
+	return _u
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdate) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationPreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationPreferenceUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := notificationpreference.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := notificationpreference.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PushToken(); ok {
+		if err := notificationpreference.PushTokenValidator(v); err != nil {
+			return &ValidationError{Name: "push_token", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.push_token": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := notificationpreference.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuietHoursStart(); ok {
+		if err := notificationpreference.QuietHoursStartValidator(v); err != nil {
+			return &ValidationError{Name: "quiet_hours_start", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.quiet_hours_start": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuietHoursEnd(); ok {
+		if err := notificationpreference.QuietHoursEndValidator(v); err != nil {
+			return &ValidationError{Name: "quiet_hours_end", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.quiet_hours_end": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreference.account"`)
+	}
+	return nil
+}
+
+func (_u *NotificationPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(notificationpreference.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(notificationpreference.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(notificationpreference.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(notificationpreference.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(notificationpreference.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RemindersEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldRemindersEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PushEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldPushEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EmailEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldEmailEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SmsEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldSmsEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PushToken(); ok {
+		_spec.SetField(notificationpreference.FieldPushToken, field.TypeString, value)
+	}
+	if _u.mutation.PushTokenCleared() {
+		_spec.ClearField(notificationpreference.FieldPushToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(notificationpreference.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuietHoursStart(); ok {
+		_spec.SetField(notificationpreference.FieldQuietHoursStart, field.TypeString, value)
+	}
+	if _u.mutation.QuietHoursStartCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietHoursStart, field.TypeString)
+	}
+	if value, ok := _u.mutation.QuietHoursEnd(); ok {
+		_spec.SetField(notificationpreference.FieldQuietHoursEnd, field.TypeString, value)
+	}
+	if _u.mutation.QuietHoursEndCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietHoursEnd, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationPreferenceUpdateOne is the builder for updating a single NotificationPreference entity.
+type NotificationPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationPreferenceUpdateOne) SetUpdatedAt(v int64) *NotificationPreferenceUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *NotificationPreferenceUpdateOne) AddUpdatedAt(v int64) *NotificationPreferenceUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *NotificationPreferenceUpdateOne) ClearUpdatedAt() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *NotificationPreferenceUpdateOne) SetDeletedAt(v int64) *NotificationPreferenceUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableDeletedAt(v *int64) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *NotificationPreferenceUpdateOne) AddDeletedAt(v int64) *NotificationPreferenceUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *NotificationPreferenceUpdateOne) ClearDeletedAt() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetRemindersEnabled sets the "reminders_enabled" field.
+func (_u *NotificationPreferenceUpdateOne) SetRemindersEnabled(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetRemindersEnabled(v)
+	return _u
+}
+
+// SetNillableRemindersEnabled sets the "reminders_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableRemindersEnabled(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetRemindersEnabled(*v)
+	}
+	return _u
+}
+
+// SetPushEnabled sets the "push_enabled" field.
+func (_u *NotificationPreferenceUpdateOne) SetPushEnabled(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetPushEnabled(v)
+	return _u
+}
+
+// SetNillablePushEnabled sets the "push_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillablePushEnabled(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetPushEnabled(*v)
+	}
+	return _u
+}
+
+// SetEmailEnabled sets the "email_enabled" field.
+func (_u *NotificationPreferenceUpdateOne) SetEmailEnabled(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetEmailEnabled(v)
+	return _u
+}
+
+// SetNillableEmailEnabled sets the "email_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableEmailEnabled(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetEmailEnabled(*v)
+	}
+	return _u
+}
+
+// SetSmsEnabled sets the "sms_enabled" field.
+func (_u *NotificationPreferenceUpdateOne) SetSmsEnabled(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetSmsEnabled(v)
+	return _u
+}
+
+// SetNillableSmsEnabled sets the "sms_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableSmsEnabled(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetSmsEnabled(*v)
+	}
+	return _u
+}
+
+// SetPushToken sets the "push_token" field.
+func (_u *NotificationPreferenceUpdateOne) SetPushToken(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetPushToken(v)
+	return _u
+}
+
+// SetNillablePushToken sets the "push_token" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillablePushToken(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetPushToken(*v)
+	}
+	return _u
+}
+
+// ClearPushToken clears the value of the "push_token" field.
+func (_u *NotificationPreferenceUpdateOne) ClearPushToken() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearPushToken()
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *NotificationPreferenceUpdateOne) SetTimezone(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableTimezone(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (_u *NotificationPreferenceUpdateOne) SetQuietHoursStart(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetQuietHoursStart(v)
+	return _u
+}
+
+// SetNillableQuietHoursStart sets the "quiet_hours_start" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableQuietHoursStart(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetQuietHoursStart(*v)
+	}
+	return _u
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (_u *NotificationPreferenceUpdateOne) ClearQuietHoursStart() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearQuietHoursStart()
+	return _u
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (_u *NotificationPreferenceUpdateOne) SetQuietHoursEnd(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetQuietHoursEnd(v)
+	return _u
+}
+
+// SetNillableQuietHoursEnd sets the "quiet_hours_end" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableQuietHoursEnd(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetQuietHoursEnd(*v)
+	}
+	return _u
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (_u *NotificationPreferenceUpdateOne) ClearQuietHoursEnd() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearQuietHoursEnd()
+	return _u
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdateOne) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdateOne) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationPreferenceUpdateOne) Select(field string, fields ...string) *NotificationPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationPreference entity.
+func (_u *NotificationPreferenceUpdateOne) Save(ctx context.Context) (*NotificationPreference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) SaveX(ctx context.Context) *NotificationPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationPreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationPreferenceUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := notificationpreference.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := notificationpreference.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PushToken(); ok {
+		if err := notificationpreference.PushTokenValidator(v); err != nil {
+			return &ValidationError{Name: "push_token", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.push_token": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := notificationpreference.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuietHoursStart(); ok {
+		if err := notificationpreference.QuietHoursStartValidator(v); err != nil {
+			return &ValidationError{Name: "quiet_hours_start", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.quiet_hours_start": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuietHoursEnd(); ok {
+		if err := notificationpreference.QuietHoursEndValidator(v); err != nil {
+			return &ValidationError{Name: "quiet_hours_end", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.quiet_hours_end": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreference.account"`)
+	}
+	return nil
+}
+
+func (_u *NotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for _, f := range fields {
+			if !notificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(notificationpreference.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(notificationpreference.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(notificationpreference.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(notificationpreference.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(notificationpreference.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RemindersEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldRemindersEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PushEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldPushEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EmailEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldEmailEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SmsEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldSmsEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PushToken(); ok {
+		_spec.SetField(notificationpreference.FieldPushToken, field.TypeString, value)
+	}
+	if _u.mutation.PushTokenCleared() {
+		_spec.ClearField(notificationpreference.FieldPushToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(notificationpreference.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuietHoursStart(); ok {
+		_spec.SetField(notificationpreference.FieldQuietHoursStart, field.TypeString, value)
+	}
+	if _u.mutation.QuietHoursStartCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietHoursStart, field.TypeString)
+	}
+	if value, ok := _u.mutation.QuietHoursEnd(); ok {
+		_spec.SetField(notificationpreference.FieldQuietHoursEnd, field.TypeString, value)
+	}
+	if _u.mutation.QuietHoursEndCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietHoursEnd, field.TypeString)
+	}
+	_node = &NotificationPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -33,11 +33,34 @@ type Permission struct {
 	Resource string `json:"resource"`
 	// Action holds the value of the "action" field.
 	Action string `json:"action"`
+	// Name of the attribute condition a request must satisfy.
+	Condition string `json:"condition"`
 	// Description holds the value of the "description" field.
-	Description  string `json:"description"`
+	Description string `json:"description"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PermissionQuery when eager-loading is set.
+	Edges        PermissionEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// PermissionEdges holds the relations/edges for other nodes in the graph.
+type PermissionEdges struct {
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e PermissionEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[0] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Permission) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -45,7 +68,7 @@ func (*Permission) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case permission.FieldCreatedAt, permission.FieldUpdatedAt, permission.FieldDeletedAt:
 			values[i] = new(sql.NullInt64)
-		case permission.FieldName, permission.FieldKey, permission.FieldDomain, permission.FieldResource, permission.FieldAction, permission.FieldDescription:
+		case permission.FieldName, permission.FieldKey, permission.FieldDomain, permission.FieldResource, permission.FieldAction, permission.FieldCondition, permission.FieldDescription:
 			values[i] = new(sql.NullString)
 		case permission.FieldID:
 			values[i] = new(uuid.UUID)
@@ -118,6 +141,12 @@ func (_m *Permission) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Action = value.String
 			}
+		case permission.FieldCondition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field condition", values[i])
+			} else if value.Valid {
+				_m.Condition = value.String
+			}
 		case permission.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
@@ -137,6 +166,11 @@ func (_m *Permission) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryRoles queries the "roles" edge of the Permission entity.
+func (_m *Permission) QueryRoles() *RoleQuery {
+	return NewPermissionClient(_m.config).QueryRoles(_m)
+}
+
 // Update returns a builder for updating this Permission.
 // Note that you need to call Permission.Unwrap() before calling this method if this Permission
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -184,6 +218,9 @@ func (_m *Permission) String() string {
 	builder.WriteString("action=")
 	builder.WriteString(_m.Action)
 	builder.WriteString(", ")
+	builder.WriteString("condition=")
+	builder.WriteString(_m.Condition)
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteByte(')')
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/sembraniteam/setetes/internal/ent/permission"
+	"github.com/sembraniteam/setetes/internal/ent/role"
 )
 
 // PermissionCreate is the builder for creating a Permission entity.
@@ -84,6 +85,20 @@ func (_c *PermissionCreate) SetAction(v string) *PermissionCreate {
 	return _c
 }
 
+// SetCondition sets the "condition" field.
+func (_c *PermissionCreate) SetCondition(v string) *PermissionCreate {
+	_c.mutation.SetCondition(v)
+	return _c
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (_c *PermissionCreate) SetNillableCondition(v *string) *PermissionCreate {
+	if v != nil {
+		_c.SetCondition(*v)
+	}
+	return _c
+}
+
 // SetDescription sets the "description" field.
 func (_c *PermissionCreate) SetDescription(v string) *PermissionCreate {
 	_c.mutation.SetDescription(v)
@@ -104,6 +119,21 @@ func (_c *PermissionCreate) SetID(v uuid.UUID) *PermissionCreate {
 	return _c
 }
 
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_c *PermissionCreate) AddRoleIDs(ids ...uuid.UUID) *PermissionCreate {
+	_c.mutation.AddRoleIDs(ids...)
+	return _c
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_c *PermissionCreate) AddRoles(v ...*Role) *PermissionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRoleIDs(ids...)
+}
+
 // Mutation returns the PermissionMutation object of the builder.
 func (_c *PermissionCreate) Mutation() *PermissionMutation {
 	return _c.mutation
@@ -193,6 +223,11 @@ func (_c *PermissionCreate) check() error {
 			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Permission.action": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Condition(); ok {
+		if err := permission.ConditionValidator(v); err != nil {
+			return &ValidationError{Name: "condition", err: fmt.Errorf(`ent: validator failed for field "Permission.condition": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.Description(); ok {
 		if err := permission.DescriptionValidator(v); err != nil {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Permission.description": %w`, err)}
@@ -265,10 +300,30 @@ func (_c *PermissionCreate) createSpec() (*Permission, *sqlgraph.CreateSpec) {
 		_spec.SetField(permission.FieldAction, field.TypeString, value)
 		_node.Action = value
 	}
+	if value, ok := _c.mutation.Condition(); ok {
+		_spec.SetField(permission.FieldCondition, field.TypeString, value)
+		_node.Condition = value
+	}
 	if value, ok := _c.mutation.Description(); ok {
 		_spec.SetField(permission.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if nodes := _c.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   permission.RolesTable,
+			Columns: permission.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
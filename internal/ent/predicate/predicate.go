@@ -6,21 +6,69 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
 
+// Appointment is the predicate function for appointment builders.
+type Appointment func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// BloodRequest is the predicate function for bloodrequest builders.
+type BloodRequest func(*sql.Selector)
+
+// BloodStock is the predicate function for bloodstock builders.
+type BloodStock func(*sql.Selector)
+
 // BloodType is the predicate function for bloodtype builders.
 type BloodType func(*sql.Selector)
 
+// BloodUnit is the predicate function for bloodunit builders.
+type BloodUnit func(*sql.Selector)
+
+// BloodUnitEvent is the predicate function for bloodunitevent builders.
+type BloodUnitEvent func(*sql.Selector)
+
 // CasbinRule is the predicate function for casbinrule builders.
 type CasbinRule func(*sql.Selector)
 
+// Certificate is the predicate function for certificate builders.
+type Certificate func(*sql.Selector)
+
 // City is the predicate function for city builders.
 type City func(*sql.Selector)
 
+// Deferral is the predicate function for deferral builders.
+type Deferral func(*sql.Selector)
+
 // District is the predicate function for district builders.
 type District func(*sql.Selector)
 
+// Donation is the predicate function for donation builders.
+type Donation func(*sql.Selector)
+
+// DonationEvent is the predicate function for donationevent builders.
+type DonationEvent func(*sql.Selector)
+
+// DonationReminder is the predicate function for donationreminder builders.
+type DonationReminder func(*sql.Selector)
+
+// EmergencyCampaign is the predicate function for emergencycampaign builders.
+type EmergencyCampaign func(*sql.Selector)
+
+// EmergencyContact is the predicate function for emergencycontact builders.
+type EmergencyContact func(*sql.Selector)
+
+// Hospital is the predicate function for hospital builders.
+type Hospital func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
 // OTP is the predicate function for otp builders.
 type OTP func(*sql.Selector)
 
@@ -36,8 +84,23 @@ type Permission func(*sql.Selector)
 // Province is the predicate function for province builders.
 type Province func(*sql.Selector)
 
+// Questionnaire is the predicate function for questionnaire builders.
+type Questionnaire func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// RoleAssignment is the predicate function for roleassignment builders.
+type RoleAssignment func(*sql.Selector)
+
+// ScreeningQuestion is the predicate function for screeningquestion builders.
+type ScreeningQuestion func(*sql.Selector)
+
+// ScreeningSubmission is the predicate function for screeningsubmission builders.
+type ScreeningSubmission func(*sql.Selector)
+
+// StockMovement is the predicate function for stockmovement builders.
+type StockMovement func(*sql.Selector)
+
 // Subdistrict is the predicate function for subdistrict builders.
 type Subdistrict func(*sql.Selector)
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
+)
+
+// Questionnaire is the model entity for the Questionnaire schema.
+type Questionnaire struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Questionnaire version. A published version is never edited, a new version is created instead.
+	Version int `json:"version"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title"`
+	// Only one version is activated and presented to donors.
+	Activated bool `json:"activated"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuestionnaireQuery when eager-loading is set.
+	Edges        QuestionnaireEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuestionnaireEdges holds the relations/edges for other nodes in the graph.
+type QuestionnaireEdges struct {
+	// Questions holds the value of the questions edge.
+	Questions []*ScreeningQuestion `json:"questions,omitempty"`
+	// Submissions holds the value of the submissions edge.
+	Submissions []*ScreeningSubmission `json:"submissions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// QuestionsOrErr returns the Questions value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionnaireEdges) QuestionsOrErr() ([]*ScreeningQuestion, error) {
+	if e.loadedTypes[0] {
+		return e.Questions, nil
+	}
+	return nil, &NotLoadedError{edge: "questions"}
+}
+
+// SubmissionsOrErr returns the Submissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionnaireEdges) SubmissionsOrErr() ([]*ScreeningSubmission, error) {
+	if e.loadedTypes[1] {
+		return e.Submissions, nil
+	}
+	return nil, &NotLoadedError{edge: "submissions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Questionnaire) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case questionnaire.FieldActivated:
+			values[i] = new(sql.NullBool)
+		case questionnaire.FieldCreatedAt, questionnaire.FieldUpdatedAt, questionnaire.FieldDeletedAt, questionnaire.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case questionnaire.FieldTitle:
+			values[i] = new(sql.NullString)
+		case questionnaire.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Questionnaire fields.
+func (_m *Questionnaire) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case questionnaire.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case questionnaire.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case questionnaire.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case questionnaire.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case questionnaire.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case questionnaire.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case questionnaire.FieldActivated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field activated", values[i])
+			} else if value.Valid {
+				_m.Activated = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Questionnaire.
+// This includes values selected through modifiers, order, etc.
+func (_m *Questionnaire) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryQuestions queries the "questions" edge of the Questionnaire entity.
+func (_m *Questionnaire) QueryQuestions() *ScreeningQuestionQuery {
+	return NewQuestionnaireClient(_m.config).QueryQuestions(_m)
+}
+
+// QuerySubmissions queries the "submissions" edge of the Questionnaire entity.
+func (_m *Questionnaire) QuerySubmissions() *ScreeningSubmissionQuery {
+	return NewQuestionnaireClient(_m.config).QuerySubmissions(_m)
+}
+
+// Update returns a builder for updating this Questionnaire.
+// Note that you need to call Questionnaire.Unwrap() before calling this method if this Questionnaire
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Questionnaire) Update() *QuestionnaireUpdateOne {
+	return NewQuestionnaireClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Questionnaire entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Questionnaire) Unwrap() *Questionnaire {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Questionnaire is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Questionnaire) String() string {
+	var builder strings.Builder
+	builder.WriteString("Questionnaire(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("activated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Activated))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Questionnaires is a parsable slice of Questionnaire.
+type Questionnaires []*Questionnaire
@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+)
+
+// QuestionnaireCreate is the builder for creating a Questionnaire entity.
+type QuestionnaireCreate struct {
+	config
+	mutation *QuestionnaireMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QuestionnaireCreate) SetCreatedAt(v int64) *QuestionnaireCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *QuestionnaireCreate) SetUpdatedAt(v int64) *QuestionnaireCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *QuestionnaireCreate) SetNillableUpdatedAt(v *int64) *QuestionnaireCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *QuestionnaireCreate) SetDeletedAt(v int64) *QuestionnaireCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *QuestionnaireCreate) SetNillableDeletedAt(v *int64) *QuestionnaireCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *QuestionnaireCreate) SetVersion(v int) *QuestionnaireCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *QuestionnaireCreate) SetTitle(v string) *QuestionnaireCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetActivated sets the "activated" field.
+func (_c *QuestionnaireCreate) SetActivated(v bool) *QuestionnaireCreate {
+	_c.mutation.SetActivated(v)
+	return _c
+}
+
+// SetNillableActivated sets the "activated" field if the given value is not nil.
+func (_c *QuestionnaireCreate) SetNillableActivated(v *bool) *QuestionnaireCreate {
+	if v != nil {
+		_c.SetActivated(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuestionnaireCreate) SetID(v uuid.UUID) *QuestionnaireCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddQuestionIDs adds the "questions" edge to the ScreeningQuestion entity by IDs.
+func (_c *QuestionnaireCreate) AddQuestionIDs(ids ...uuid.UUID) *QuestionnaireCreate {
+	_c.mutation.AddQuestionIDs(ids...)
+	return _c
+}
+
+// AddQuestions adds the "questions" edges to the ScreeningQuestion entity.
+func (_c *QuestionnaireCreate) AddQuestions(v ...*ScreeningQuestion) *QuestionnaireCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddQuestionIDs(ids...)
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the ScreeningSubmission entity by IDs.
+func (_c *QuestionnaireCreate) AddSubmissionIDs(ids ...uuid.UUID) *QuestionnaireCreate {
+	_c.mutation.AddSubmissionIDs(ids...)
+	return _c
+}
+
+// AddSubmissions adds the "submissions" edges to the ScreeningSubmission entity.
+func (_c *QuestionnaireCreate) AddSubmissions(v ...*ScreeningSubmission) *QuestionnaireCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSubmissionIDs(ids...)
+}
+
+// Mutation returns the QuestionnaireMutation object of the builder.
+func (_c *QuestionnaireCreate) Mutation() *QuestionnaireMutation {
+	return _c.mutation
+}
+
+// Save creates the Questionnaire in the database.
+func (_c *QuestionnaireCreate) Save(ctx context.Context) (*Questionnaire, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuestionnaireCreate) SaveX(ctx context.Context) *Questionnaire {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionnaireCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionnaireCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuestionnaireCreate) defaults() {
+	if _, ok := _c.mutation.Activated(); !ok {
+		v := questionnaire.DefaultActivated
+		_c.mutation.SetActivated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuestionnaireCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := questionnaire.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := questionnaire.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := questionnaire.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Questionnaire.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := questionnaire.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Questionnaire.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := questionnaire.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Activated(); !ok {
+		return &ValidationError{Name: "activated", err: errors.New(`ent: missing required field "Questionnaire.activated"`)}
+	}
+	return nil
+}
+
+func (_c *QuestionnaireCreate) sqlSave(ctx context.Context) (*Questionnaire, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuestionnaireCreate) createSpec() (*Questionnaire, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Questionnaire{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(questionnaire.Table, sqlgraph.NewFieldSpec(questionnaire.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(questionnaire.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnaire.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(questionnaire.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(questionnaire.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(questionnaire.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Activated(); ok {
+		_spec.SetField(questionnaire.FieldActivated, field.TypeBool, value)
+		_node.Activated = value
+	}
+	if nodes := _c.mutation.QuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.QuestionsTable,
+			Columns: []string{questionnaire.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   questionnaire.SubmissionsTable,
+			Columns: []string{questionnaire.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuestionnaireCreateBulk is the builder for creating many Questionnaire entities in bulk.
+type QuestionnaireCreateBulk struct {
+	config
+	err      error
+	builders []*QuestionnaireCreate
+}
+
+// Save creates the Questionnaire entities in the database.
+func (_c *QuestionnaireCreateBulk) Save(ctx context.Context) ([]*Questionnaire, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Questionnaire, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestionnaireMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuestionnaireCreateBulk) SaveX(ctx context.Context) []*Questionnaire {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionnaireCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionnaireCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
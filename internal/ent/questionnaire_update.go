@@ -0,0 +1,762 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+)
+
+// QuestionnaireUpdate is the builder for updating Questionnaire entities.
+type QuestionnaireUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuestionnaireMutation
+}
+
+// Where appends a list predicates to the QuestionnaireUpdate builder.
+func (_u *QuestionnaireUpdate) Where(ps ...predicate.Questionnaire) *QuestionnaireUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuestionnaireUpdate) SetUpdatedAt(v int64) *QuestionnaireUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *QuestionnaireUpdate) AddUpdatedAt(v int64) *QuestionnaireUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *QuestionnaireUpdate) ClearUpdatedAt() *QuestionnaireUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *QuestionnaireUpdate) SetDeletedAt(v int64) *QuestionnaireUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *QuestionnaireUpdate) SetNillableDeletedAt(v *int64) *QuestionnaireUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *QuestionnaireUpdate) AddDeletedAt(v int64) *QuestionnaireUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *QuestionnaireUpdate) ClearDeletedAt() *QuestionnaireUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *QuestionnaireUpdate) SetTitle(v string) *QuestionnaireUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *QuestionnaireUpdate) SetNillableTitle(v *string) *QuestionnaireUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetActivated sets the "activated" field.
+func (_u *QuestionnaireUpdate) SetActivated(v bool) *QuestionnaireUpdate {
+	_u.mutation.SetActivated(v)
+	return _u
+}
+
+// SetNillableActivated sets the "activated" field if the given value is not nil.
+func (_u *QuestionnaireUpdate) SetNillableActivated(v *bool) *QuestionnaireUpdate {
+	if v != nil {
+		_u.SetActivated(*v)
+	}
+	return _u
+}
+
+// AddQuestionIDs adds the "questions" edge to the ScreeningQuestion entity by IDs.
+func (_u *QuestionnaireUpdate) AddQuestionIDs(ids ...uuid.UUID) *QuestionnaireUpdate {
+	_u.mutation.AddQuestionIDs(ids...)
+	return _u
+}
+
+// AddQuestions adds the "questions" edges to the ScreeningQuestion entity.
+func (_u *QuestionnaireUpdate) AddQuestions(v ...*ScreeningQuestion) *QuestionnaireUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionIDs(ids...)
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the ScreeningSubmission entity by IDs.
+func (_u *QuestionnaireUpdate) AddSubmissionIDs(ids ...uuid.UUID) *QuestionnaireUpdate {
+	_u.mutation.AddSubmissionIDs(ids...)
+	return _u
+}
+
+// AddSubmissions adds the "submissions" edges to the ScreeningSubmission entity.
+func (_u *QuestionnaireUpdate) AddSubmissions(v ...*ScreeningSubmission) *QuestionnaireUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSubmissionIDs(ids...)
+}
+
+// Mutation returns the QuestionnaireMutation object of the builder.
+func (_u *QuestionnaireUpdate) Mutation() *QuestionnaireMutation {
+	return _u.mutation
+}
+
+// ClearQuestions clears all "questions" edges to the ScreeningQuestion entity.
+func (_u *QuestionnaireUpdate) ClearQuestions() *QuestionnaireUpdate {
+	_u.mutation.ClearQuestions()
+	return _u
+}
+
+// RemoveQuestionIDs removes the "questions" edge to ScreeningQuestion entities by IDs.
+func (_u *QuestionnaireUpdate) RemoveQuestionIDs(ids ...uuid.UUID) *QuestionnaireUpdate {
+	_u.mutation.RemoveQuestionIDs(ids...)
+	return _u
+}
+
+// RemoveQuestions removes "questions" edges to ScreeningQuestion entities.
+func (_u *QuestionnaireUpdate) RemoveQuestions(v ...*ScreeningQuestion) *QuestionnaireUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuestionIDs(ids...)
+}
+
+// ClearSubmissions clears all "submissions" edges to the ScreeningSubmission entity.
+func (_u *QuestionnaireUpdate) ClearSubmissions() *QuestionnaireUpdate {
+	_u.mutation.ClearSubmissions()
+	return _u
+}
+
+// RemoveSubmissionIDs removes the "submissions" edge to ScreeningSubmission entities by IDs.
+func (_u *QuestionnaireUpdate) RemoveSubmissionIDs(ids ...uuid.UUID) *QuestionnaireUpdate {
+	_u.mutation.RemoveSubmissionIDs(ids...)
+	return _u
+}
+
+// RemoveSubmissions removes "submissions" edges to ScreeningSubmission entities.
+func (_u *QuestionnaireUpdate) RemoveSubmissions(v ...*ScreeningSubmission) *QuestionnaireUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSubmissionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuestionnaireUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionnaireUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuestionnaireUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionnaireUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuestionnaireUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := questionnaire.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionnaireUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := questionnaire.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := questionnaire.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := questionnaire.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QuestionnaireUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionnaire.Table, questionnaire.Columns, sqlgraph.NewFieldSpec(questionnaire.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnaire.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(questionnaire.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(questionnaire.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(questionnaire.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(questionnaire.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(questionnaire.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(questionnaire.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Activated(); ok {
+		_spec.SetField(questionnaire.FieldActivated, field.TypeBool, value)
+	}
+	if _u.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.QuestionsTable,
+			Columns: []string{questionnaire.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuestionsIDs(); len(nodes) > 0 && !_u.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.QuestionsTable,
+			Columns: []string{questionnaire.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.QuestionsTable,
+			Columns: []string{questionnaire.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   questionnaire.SubmissionsTable,
+			Columns: []string{questionnaire.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSubmissionsIDs(); len(nodes) > 0 && !_u.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   questionnaire.SubmissionsTable,
+			Columns: []string{questionnaire.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   questionnaire.SubmissionsTable,
+			Columns: []string{questionnaire.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionnaire.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuestionnaireUpdateOne is the builder for updating a single Questionnaire entity.
+type QuestionnaireUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuestionnaireMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuestionnaireUpdateOne) SetUpdatedAt(v int64) *QuestionnaireUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *QuestionnaireUpdateOne) AddUpdatedAt(v int64) *QuestionnaireUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *QuestionnaireUpdateOne) ClearUpdatedAt() *QuestionnaireUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *QuestionnaireUpdateOne) SetDeletedAt(v int64) *QuestionnaireUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *QuestionnaireUpdateOne) SetNillableDeletedAt(v *int64) *QuestionnaireUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *QuestionnaireUpdateOne) AddDeletedAt(v int64) *QuestionnaireUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *QuestionnaireUpdateOne) ClearDeletedAt() *QuestionnaireUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *QuestionnaireUpdateOne) SetTitle(v string) *QuestionnaireUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *QuestionnaireUpdateOne) SetNillableTitle(v *string) *QuestionnaireUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetActivated sets the "activated" field.
+func (_u *QuestionnaireUpdateOne) SetActivated(v bool) *QuestionnaireUpdateOne {
+	_u.mutation.SetActivated(v)
+	return _u
+}
+
+// SetNillableActivated sets the "activated" field if the given value is not nil.
+func (_u *QuestionnaireUpdateOne) SetNillableActivated(v *bool) *QuestionnaireUpdateOne {
+	if v != nil {
+		_u.SetActivated(*v)
+	}
+	return _u
+}
+
+// AddQuestionIDs adds the "questions" edge to the ScreeningQuestion entity by IDs.
+func (_u *QuestionnaireUpdateOne) AddQuestionIDs(ids ...uuid.UUID) *QuestionnaireUpdateOne {
+	_u.mutation.AddQuestionIDs(ids...)
+	return _u
+}
+
+// AddQuestions adds the "questions" edges to the ScreeningQuestion entity.
+func (_u *QuestionnaireUpdateOne) AddQuestions(v ...*ScreeningQuestion) *QuestionnaireUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionIDs(ids...)
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the ScreeningSubmission entity by IDs.
+func (_u *QuestionnaireUpdateOne) AddSubmissionIDs(ids ...uuid.UUID) *QuestionnaireUpdateOne {
+	_u.mutation.AddSubmissionIDs(ids...)
+	return _u
+}
+
+// AddSubmissions adds the "submissions" edges to the ScreeningSubmission entity.
+func (_u *QuestionnaireUpdateOne) AddSubmissions(v ...*ScreeningSubmission) *QuestionnaireUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSubmissionIDs(ids...)
+}
+
+// Mutation returns the QuestionnaireMutation object of the builder.
+func (_u *QuestionnaireUpdateOne) Mutation() *QuestionnaireMutation {
+	return _u.mutation
+}
+
+// ClearQuestions clears all "questions" edges to the ScreeningQuestion entity.
+func (_u *QuestionnaireUpdateOne) ClearQuestions() *QuestionnaireUpdateOne {
+	_u.mutation.ClearQuestions()
+	return _u
+}
+
+// RemoveQuestionIDs removes the "questions" edge to ScreeningQuestion entities by IDs.
+func (_u *QuestionnaireUpdateOne) RemoveQuestionIDs(ids ...uuid.UUID) *QuestionnaireUpdateOne {
+	_u.mutation.RemoveQuestionIDs(ids...)
+	return _u
+}
+
+// RemoveQuestions removes "questions" edges to ScreeningQuestion entities.
+func (_u *QuestionnaireUpdateOne) RemoveQuestions(v ...*ScreeningQuestion) *QuestionnaireUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuestionIDs(ids...)
+}
+
+// ClearSubmissions clears all "submissions" edges to the ScreeningSubmission entity.
+func (_u *QuestionnaireUpdateOne) ClearSubmissions() *QuestionnaireUpdateOne {
+	_u.mutation.ClearSubmissions()
+	return _u
+}
+
+// RemoveSubmissionIDs removes the "submissions" edge to ScreeningSubmission entities by IDs.
+func (_u *QuestionnaireUpdateOne) RemoveSubmissionIDs(ids ...uuid.UUID) *QuestionnaireUpdateOne {
+	_u.mutation.RemoveSubmissionIDs(ids...)
+	return _u
+}
+
+// RemoveSubmissions removes "submissions" edges to ScreeningSubmission entities.
+func (_u *QuestionnaireUpdateOne) RemoveSubmissions(v ...*ScreeningSubmission) *QuestionnaireUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSubmissionIDs(ids...)
+}
+
+// Where appends a list predicates to the QuestionnaireUpdate builder.
+func (_u *QuestionnaireUpdateOne) Where(ps ...predicate.Questionnaire) *QuestionnaireUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuestionnaireUpdateOne) Select(field string, fields ...string) *QuestionnaireUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Questionnaire entity.
+func (_u *QuestionnaireUpdateOne) Save(ctx context.Context) (*Questionnaire, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionnaireUpdateOne) SaveX(ctx context.Context) *Questionnaire {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuestionnaireUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionnaireUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuestionnaireUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := questionnaire.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionnaireUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := questionnaire.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := questionnaire.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := questionnaire.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QuestionnaireUpdateOne) sqlSave(ctx context.Context) (_node *Questionnaire, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionnaire.Table, questionnaire.Columns, sqlgraph.NewFieldSpec(questionnaire.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Questionnaire.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionnaire.FieldID)
+		for _, f := range fields {
+			if !questionnaire.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != questionnaire.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnaire.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(questionnaire.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(questionnaire.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(questionnaire.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(questionnaire.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(questionnaire.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(questionnaire.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Activated(); ok {
+		_spec.SetField(questionnaire.FieldActivated, field.TypeBool, value)
+	}
+	if _u.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.QuestionsTable,
+			Columns: []string{questionnaire.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuestionsIDs(); len(nodes) > 0 && !_u.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.QuestionsTable,
+			Columns: []string{questionnaire.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.QuestionsTable,
+			Columns: []string{questionnaire.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   questionnaire.SubmissionsTable,
+			Columns: []string{questionnaire.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSubmissionsIDs(); len(nodes) > 0 && !_u.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   questionnaire.SubmissionsTable,
+			Columns: []string{questionnaire.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   questionnaire.SubmissionsTable,
+			Columns: []string{questionnaire.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Questionnaire{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionnaire.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
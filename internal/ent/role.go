@@ -47,9 +47,13 @@ type RoleEdges struct {
 	Children []*Role `json:"children,omitempty"`
 	// Parent holds the value of the parent edge.
 	Parent []*Role `json:"parent,omitempty"`
+	// Permissions holds the value of the permissions edge.
+	Permissions []*Permission `json:"permissions,omitempty"`
+	// Assignments holds the value of the assignments edge.
+	Assignments []*RoleAssignment `json:"assignments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // AccountsOrErr returns the Accounts value or an error if the edge
@@ -79,6 +83,24 @@ func (e RoleEdges) ParentOrErr() ([]*Role, error) {
 	return nil, &NotLoadedError{edge: "parent"}
 }
 
+// PermissionsOrErr returns the Permissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) PermissionsOrErr() ([]*Permission, error) {
+	if e.loadedTypes[3] {
+		return e.Permissions, nil
+	}
+	return nil, &NotLoadedError{edge: "permissions"}
+}
+
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) AssignmentsOrErr() ([]*RoleAssignment, error) {
+	if e.loadedTypes[4] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Role) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -189,6 +211,16 @@ func (_m *Role) QueryParent() *RoleQuery {
 	return NewRoleClient(_m.config).QueryParent(_m)
 }
 
+// QueryPermissions queries the "permissions" edge of the Role entity.
+func (_m *Role) QueryPermissions() *PermissionQuery {
+	return NewRoleClient(_m.config).QueryPermissions(_m)
+}
+
+// QueryAssignments queries the "assignments" edge of the Role entity.
+func (_m *Role) QueryAssignments() *RoleAssignmentQuery {
+	return NewRoleClient(_m.config).QueryAssignments(_m)
+}
+
 // Update returns a builder for updating this Role.
 // Note that you need to call Role.Unwrap() before calling this method if this Role
 // was returned from a transaction, and the transaction was committed or rolled back.
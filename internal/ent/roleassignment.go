@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/roleassignment"
+)
+
+// RoleAssignment is the model entity for the RoleAssignment schema.
+type RoleAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Domain holds the value of the "domain" field.
+	Domain string `json:"domain"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleAssignmentQuery when eager-loading is set.
+	Edges          RoleAssignmentEdges `json:"edges"`
+	account_id     *uuid.UUID
+	role_id        *uuid.UUID
+	assigned_by_id *uuid.UUID
+	selectValues   sql.SelectValues
+}
+
+// RoleAssignmentEdges holds the relations/edges for other nodes in the graph.
+type RoleAssignmentEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Role holds the value of the role edge.
+	Role *Role `json:"role,omitempty"`
+	// AssignedBy holds the value of the assigned_by edge.
+	AssignedBy *Account `json:"assigned_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleAssignmentEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleAssignmentEdges) RoleOrErr() (*Role, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: role.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// AssignedByOrErr returns the AssignedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleAssignmentEdges) AssignedByOrErr() (*Account, error) {
+	if e.AssignedBy != nil {
+		return e.AssignedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "assigned_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roleassignment.FieldCreatedAt, roleassignment.FieldUpdatedAt, roleassignment.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case roleassignment.FieldDomain:
+			values[i] = new(sql.NullString)
+		case roleassignment.FieldID:
+			values[i] = new(uuid.UUID)
+		case roleassignment.ForeignKeys[0]: // account_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case roleassignment.ForeignKeys[1]: // role_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case roleassignment.ForeignKeys[2]: // assigned_by_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleAssignment fields.
+func (_m *RoleAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roleassignment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case roleassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case roleassignment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case roleassignment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case roleassignment.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				_m.Domain = value.String
+			}
+		case roleassignment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.account_id = new(uuid.UUID)
+				*_m.account_id = *value.S.(*uuid.UUID)
+			}
+		case roleassignment.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				_m.role_id = new(uuid.UUID)
+				*_m.role_id = *value.S.(*uuid.UUID)
+			}
+		case roleassignment.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_by_id", values[i])
+			} else if value.Valid {
+				_m.assigned_by_id = new(uuid.UUID)
+				*_m.assigned_by_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleAssignment.
+// This includes values selected through modifiers, order, etc.
+func (_m *RoleAssignment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the RoleAssignment entity.
+func (_m *RoleAssignment) QueryAccount() *AccountQuery {
+	return NewRoleAssignmentClient(_m.config).QueryAccount(_m)
+}
+
+// QueryRole queries the "role" edge of the RoleAssignment entity.
+func (_m *RoleAssignment) QueryRole() *RoleQuery {
+	return NewRoleAssignmentClient(_m.config).QueryRole(_m)
+}
+
+// QueryAssignedBy queries the "assigned_by" edge of the RoleAssignment entity.
+func (_m *RoleAssignment) QueryAssignedBy() *AccountQuery {
+	return NewRoleAssignmentClient(_m.config).QueryAssignedBy(_m)
+}
+
+// Update returns a builder for updating this RoleAssignment.
+// Note that you need to call RoleAssignment.Unwrap() before calling this method if this RoleAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RoleAssignment) Update() *RoleAssignmentUpdateOne {
+	return NewRoleAssignmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RoleAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RoleAssignment) Unwrap() *RoleAssignment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleAssignment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RoleAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("domain=")
+	builder.WriteString(_m.Domain)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleAssignments is a parsable slice of RoleAssignment.
+type RoleAssignments []*RoleAssignment
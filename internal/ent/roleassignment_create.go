@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/roleassignment"
+)
+
+// RoleAssignmentCreate is the builder for creating a RoleAssignment entity.
+type RoleAssignmentCreate struct {
+	config
+	mutation *RoleAssignmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RoleAssignmentCreate) SetCreatedAt(v int64) *RoleAssignmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RoleAssignmentCreate) SetUpdatedAt(v int64) *RoleAssignmentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RoleAssignmentCreate) SetNillableUpdatedAt(v *int64) *RoleAssignmentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *RoleAssignmentCreate) SetDeletedAt(v int64) *RoleAssignmentCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *RoleAssignmentCreate) SetNillableDeletedAt(v *int64) *RoleAssignmentCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDomain sets the "domain" field.
+func (_c *RoleAssignmentCreate) SetDomain(v string) *RoleAssignmentCreate {
+	_c.mutation.SetDomain(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RoleAssignmentCreate) SetID(v uuid.UUID) *RoleAssignmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *RoleAssignmentCreate) SetAccountID(id uuid.UUID) *RoleAssignmentCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *RoleAssignmentCreate) SetAccount(v *Account) *RoleAssignmentCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetRoleID sets the "role" edge to the Role entity by ID.
+func (_c *RoleAssignmentCreate) SetRoleID(id uuid.UUID) *RoleAssignmentCreate {
+	_c.mutation.SetRoleID(id)
+	return _c
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (_c *RoleAssignmentCreate) SetRole(v *Role) *RoleAssignmentCreate {
+	return _c.SetRoleID(v.ID)
+}
+
+// SetAssignedByID sets the "assigned_by" edge to the Account entity by ID.
+func (_c *RoleAssignmentCreate) SetAssignedByID(id uuid.UUID) *RoleAssignmentCreate {
+	_c.mutation.SetAssignedByID(id)
+	return _c
+}
+
+// SetNillableAssignedByID sets the "assigned_by" edge to the Account entity by ID if the given value is not nil.
+func (_c *RoleAssignmentCreate) SetNillableAssignedByID(id *uuid.UUID) *RoleAssignmentCreate {
+	if id != nil {
+		_c = _c.SetAssignedByID(*id)
+	}
+	return _c
+}
+
+// SetAssignedBy sets the "assigned_by" edge to the Account entity.
+func (_c *RoleAssignmentCreate) SetAssignedBy(v *Account) *RoleAssignmentCreate {
+	return _c.SetAssignedByID(v.ID)
+}
+
+// Mutation returns the RoleAssignmentMutation object of the builder.
+func (_c *RoleAssignmentCreate) Mutation() *RoleAssignmentMutation {
+	return _c.mutation
+}
+
+// Save creates the RoleAssignment in the database.
+func (_c *RoleAssignmentCreate) Save(ctx context.Context) (*RoleAssignment, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RoleAssignmentCreate) SaveX(ctx context.Context) *RoleAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleAssignmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RoleAssignmentCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := roleassignment.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "RoleAssignment.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := roleassignment.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "RoleAssignment.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := roleassignment.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "RoleAssignment.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Domain(); !ok {
+		return &ValidationError{Name: "domain", err: errors.New(`ent: missing required field "RoleAssignment.domain"`)}
+	}
+	if v, ok := _c.mutation.Domain(); ok {
+		if err := roleassignment.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "RoleAssignment.domain": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "RoleAssignment.account"`)}
+	}
+	if len(_c.mutation.RoleIDs()) == 0 {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "RoleAssignment.role"`)}
+	}
+	return nil
+}
+
+func (_c *RoleAssignmentCreate) sqlSave(ctx context.Context) (*RoleAssignment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RoleAssignmentCreate) createSpec() (*RoleAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleAssignment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(roleassignment.Table, sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(roleassignment.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(roleassignment.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(roleassignment.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Domain(); ok {
+		_spec.SetField(roleassignment.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleassignment.AccountTable,
+			Columns: []string{roleassignment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleassignment.RoleTable,
+			Columns: []string{roleassignment.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.role_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AssignedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleassignment.AssignedByTable,
+			Columns: []string{roleassignment.AssignedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.assigned_by_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RoleAssignmentCreateBulk is the builder for creating many RoleAssignment entities in bulk.
+type RoleAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*RoleAssignmentCreate
+}
+
+// Save creates the RoleAssignment entities in the database.
+func (_c *RoleAssignmentCreateBulk) Save(ctx context.Context) ([]*RoleAssignment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RoleAssignment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RoleAssignmentCreateBulk) SaveX(ctx context.Context) []*RoleAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,16 +4,37 @@ package ent
 
 import (
 	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/apikey"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/auditlog"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
 	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
+	"github.com/sembraniteam/setetes/internal/ent/certificate"
 	"github.com/sembraniteam/setetes/internal/ent/city"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
 	"github.com/sembraniteam/setetes/internal/ent/district"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+	"github.com/sembraniteam/setetes/internal/ent/donationreminder"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/notificationpreference"
 	"github.com/sembraniteam/setetes/internal/ent/otp"
 	"github.com/sembraniteam/setetes/internal/ent/password"
 	"github.com/sembraniteam/setetes/internal/ent/permission"
 	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
 	"github.com/sembraniteam/setetes/internal/ent/province"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
 	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/roleassignment"
 	"github.com/sembraniteam/setetes/internal/ent/schema"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
 	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
 )
 
@@ -21,6 +42,91 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyMixin := schema.APIKey{}.Mixin()
+	apikeyMixinFields0 := apikeyMixin[0].Fields()
+	_ = apikeyMixinFields0
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyMixinFields0[1].Descriptor()
+	// apikey.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	apikey.CreatedAtValidator = apikeyDescCreatedAt.Validators[0].(func(int64) error)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyMixinFields0[2].Descriptor()
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() int64)
+	// apikey.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	apikey.UpdatedAtValidator = apikeyDescUpdatedAt.Validators[0].(func(int64) error)
+	// apikeyDescDeletedAt is the schema descriptor for deleted_at field.
+	apikeyDescDeletedAt := apikeyMixinFields0[3].Descriptor()
+	// apikey.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	apikey.DeletedAtValidator = apikeyDescDeletedAt.Validators[0].(func(int64) error)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[0].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = func() func(string) error {
+		validators := apikeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[1].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = func() func(string) error {
+		validators := apikeyDescPrefix.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(prefix string) error {
+			for _, fn := range fns {
+				if err := fn(prefix); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescSecretHash is the schema descriptor for secret_hash field.
+	apikeyDescSecretHash := apikeyFields[2].Descriptor()
+	// apikey.SecretHashValidator is a validator for the "secret_hash" field. It is called by the builders before save.
+	apikey.SecretHashValidator = func() func(string) error {
+		validators := apikeyDescSecretHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(secret_hash string) error {
+			for _, fn := range fns {
+				if err := fn(secret_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescExpiresAt is the schema descriptor for expires_at field.
+	apikeyDescExpiresAt := apikeyFields[4].Descriptor()
+	// apikey.ExpiresAtValidator is a validator for the "expires_at" field. It is called by the builders before save.
+	apikey.ExpiresAtValidator = apikeyDescExpiresAt.Validators[0].(func(int64) error)
+	// apikeyDescLastUsedAt is the schema descriptor for last_used_at field.
+	apikeyDescLastUsedAt := apikeyFields[5].Descriptor()
+	// apikey.LastUsedAtValidator is a validator for the "last_used_at" field. It is called by the builders before save.
+	apikey.LastUsedAtValidator = apikeyDescLastUsedAt.Validators[0].(func(int64) error)
+	// apikeyDescRevokedAt is the schema descriptor for revoked_at field.
+	apikeyDescRevokedAt := apikeyFields[6].Descriptor()
+	// apikey.RevokedAtValidator is a validator for the "revoked_at" field. It is called by the builders before save.
+	apikey.RevokedAtValidator = apikeyDescRevokedAt.Validators[0].(func(int64) error)
 	accountMixin := schema.Account{}.Mixin()
 	accountMixinFields0 := accountMixin[0].Fields()
 	_ = accountMixinFields0
@@ -95,7 +201,7 @@ func init() {
 		}
 	}()
 	// accountDescEmail is the schema descriptor for email field.
-	accountDescEmail := accountFields[4].Descriptor()
+	accountDescEmail := accountFields[5].Descriptor()
 	// account.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	account.EmailValidator = func() func(string) error {
 		validators := accountDescEmail.Validators
@@ -113,7 +219,7 @@ func init() {
 		}
 	}()
 	// accountDescCountryIsoCode is the schema descriptor for country_iso_code field.
-	accountDescCountryIsoCode := accountFields[5].Descriptor()
+	accountDescCountryIsoCode := accountFields[6].Descriptor()
 	// account.CountryIsoCodeValidator is a validator for the "country_iso_code" field. It is called by the builders before save.
 	account.CountryIsoCodeValidator = func() func(string) error {
 		validators := accountDescCountryIsoCode.Validators
@@ -131,7 +237,7 @@ func init() {
 		}
 	}()
 	// accountDescDialCode is the schema descriptor for dial_code field.
-	accountDescDialCode := accountFields[6].Descriptor()
+	accountDescDialCode := accountFields[7].Descriptor()
 	// account.DialCodeValidator is a validator for the "dial_code" field. It is called by the builders before save.
 	account.DialCodeValidator = func() func(string) error {
 		validators := accountDescDialCode.Validators
@@ -149,7 +255,7 @@ func init() {
 		}
 	}()
 	// accountDescPhoneNumber is the schema descriptor for phone_number field.
-	accountDescPhoneNumber := accountFields[7].Descriptor()
+	accountDescPhoneNumber := accountFields[8].Descriptor()
 	// account.PhoneNumberValidator is a validator for the "phone_number" field. It is called by the builders before save.
 	account.PhoneNumberValidator = func() func(string) error {
 		validators := accountDescPhoneNumber.Validators
@@ -167,17 +273,181 @@ func init() {
 		}
 	}()
 	// accountDescActivated is the schema descriptor for activated field.
-	accountDescActivated := accountFields[8].Descriptor()
+	accountDescActivated := accountFields[10].Descriptor()
 	// account.DefaultActivated holds the default value on creation for the activated field.
 	account.DefaultActivated = accountDescActivated.Default.(bool)
 	// accountDescLocked is the schema descriptor for locked field.
-	accountDescLocked := accountFields[9].Descriptor()
+	accountDescLocked := accountFields[11].Descriptor()
 	// account.DefaultLocked holds the default value on creation for the locked field.
 	account.DefaultLocked = accountDescLocked.Default.(bool)
 	// accountDescTempLockedAt is the schema descriptor for temp_locked_at field.
-	accountDescTempLockedAt := accountFields[10].Descriptor()
+	accountDescTempLockedAt := accountFields[12].Descriptor()
 	// account.TempLockedAtValidator is a validator for the "temp_locked_at" field. It is called by the builders before save.
 	account.TempLockedAtValidator = accountDescTempLockedAt.Validators[0].(func(int64) error)
+	appointmentMixin := schema.Appointment{}.Mixin()
+	appointmentMixinFields0 := appointmentMixin[0].Fields()
+	_ = appointmentMixinFields0
+	appointmentFields := schema.Appointment{}.Fields()
+	_ = appointmentFields
+	// appointmentDescCreatedAt is the schema descriptor for created_at field.
+	appointmentDescCreatedAt := appointmentMixinFields0[1].Descriptor()
+	// appointment.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	appointment.CreatedAtValidator = appointmentDescCreatedAt.Validators[0].(func(int64) error)
+	// appointmentDescUpdatedAt is the schema descriptor for updated_at field.
+	appointmentDescUpdatedAt := appointmentMixinFields0[2].Descriptor()
+	// appointment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	appointment.UpdateDefaultUpdatedAt = appointmentDescUpdatedAt.UpdateDefault.(func() int64)
+	// appointment.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	appointment.UpdatedAtValidator = appointmentDescUpdatedAt.Validators[0].(func(int64) error)
+	// appointmentDescDeletedAt is the schema descriptor for deleted_at field.
+	appointmentDescDeletedAt := appointmentMixinFields0[3].Descriptor()
+	// appointment.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	appointment.DeletedAtValidator = appointmentDescDeletedAt.Validators[0].(func(int64) error)
+	// appointmentDescScheduledAt is the schema descriptor for scheduled_at field.
+	appointmentDescScheduledAt := appointmentFields[0].Descriptor()
+	// appointment.ScheduledAtValidator is a validator for the "scheduled_at" field. It is called by the builders before save.
+	appointment.ScheduledAtValidator = appointmentDescScheduledAt.Validators[0].(func(int64) error)
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlogMixinFields0 := auditlogMixin[0].Fields()
+	_ = auditlogMixinFields0
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogMixinFields0[1].Descriptor()
+	// auditlog.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	auditlog.CreatedAtValidator = auditlogDescCreatedAt.Validators[0].(func(int64) error)
+	// auditlogDescUpdatedAt is the schema descriptor for updated_at field.
+	auditlogDescUpdatedAt := auditlogMixinFields0[2].Descriptor()
+	// auditlog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditlog.UpdateDefaultUpdatedAt = auditlogDescUpdatedAt.UpdateDefault.(func() int64)
+	// auditlog.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	auditlog.UpdatedAtValidator = auditlogDescUpdatedAt.Validators[0].(func(int64) error)
+	// auditlogDescDeletedAt is the schema descriptor for deleted_at field.
+	auditlogDescDeletedAt := auditlogMixinFields0[3].Descriptor()
+	// auditlog.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	auditlog.DeletedAtValidator = auditlogDescDeletedAt.Validators[0].(func(int64) error)
+	// auditlogDescSessionID is the schema descriptor for session_id field.
+	auditlogDescSessionID := auditlogFields[2].Descriptor()
+	// auditlog.SessionIDValidator is a validator for the "session_id" field. It is called by the builders before save.
+	auditlog.SessionIDValidator = auditlogDescSessionID.Validators[0].(func(string) error)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[4].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = func() func(string) error {
+		validators := auditlogDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// auditlogDescEntity is the schema descriptor for entity field.
+	auditlogDescEntity := auditlogFields[5].Descriptor()
+	// auditlog.EntityValidator is a validator for the "entity" field. It is called by the builders before save.
+	auditlog.EntityValidator = auditlogDescEntity.Validators[0].(func(string) error)
+	// auditlogDescEntityID is the schema descriptor for entity_id field.
+	auditlogDescEntityID := auditlogFields[6].Descriptor()
+	// auditlog.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
+	auditlog.EntityIDValidator = auditlogDescEntityID.Validators[0].(func(string) error)
+	// auditlogDescIP is the schema descriptor for ip field.
+	auditlogDescIP := auditlogFields[9].Descriptor()
+	// auditlog.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	auditlog.IPValidator = auditlogDescIP.Validators[0].(func(string) error)
+	bloodrequestMixin := schema.BloodRequest{}.Mixin()
+	bloodrequestMixinFields0 := bloodrequestMixin[0].Fields()
+	_ = bloodrequestMixinFields0
+	bloodrequestFields := schema.BloodRequest{}.Fields()
+	_ = bloodrequestFields
+	// bloodrequestDescCreatedAt is the schema descriptor for created_at field.
+	bloodrequestDescCreatedAt := bloodrequestMixinFields0[1].Descriptor()
+	// bloodrequest.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	bloodrequest.CreatedAtValidator = bloodrequestDescCreatedAt.Validators[0].(func(int64) error)
+	// bloodrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	bloodrequestDescUpdatedAt := bloodrequestMixinFields0[2].Descriptor()
+	// bloodrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bloodrequest.UpdateDefaultUpdatedAt = bloodrequestDescUpdatedAt.UpdateDefault.(func() int64)
+	// bloodrequest.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	bloodrequest.UpdatedAtValidator = bloodrequestDescUpdatedAt.Validators[0].(func(int64) error)
+	// bloodrequestDescDeletedAt is the schema descriptor for deleted_at field.
+	bloodrequestDescDeletedAt := bloodrequestMixinFields0[3].Descriptor()
+	// bloodrequest.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	bloodrequest.DeletedAtValidator = bloodrequestDescDeletedAt.Validators[0].(func(int64) error)
+	// bloodrequestDescPatientName is the schema descriptor for patient_name field.
+	bloodrequestDescPatientName := bloodrequestFields[0].Descriptor()
+	// bloodrequest.PatientNameValidator is a validator for the "patient_name" field. It is called by the builders before save.
+	bloodrequest.PatientNameValidator = func() func(string) error {
+		validators := bloodrequestDescPatientName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(patient_name string) error {
+			for _, fn := range fns {
+				if err := fn(patient_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// bloodrequestDescPatientRecordNumber is the schema descriptor for patient_record_number field.
+	bloodrequestDescPatientRecordNumber := bloodrequestFields[1].Descriptor()
+	// bloodrequest.PatientRecordNumberValidator is a validator for the "patient_record_number" field. It is called by the builders before save.
+	bloodrequest.PatientRecordNumberValidator = bloodrequestDescPatientRecordNumber.Validators[0].(func(string) error)
+	// bloodrequestDescQuantity is the schema descriptor for quantity field.
+	bloodrequestDescQuantity := bloodrequestFields[3].Descriptor()
+	// bloodrequest.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	bloodrequest.QuantityValidator = bloodrequestDescQuantity.Validators[0].(func(int) error)
+	// bloodrequestDescFulfilledQuantity is the schema descriptor for fulfilled_quantity field.
+	bloodrequestDescFulfilledQuantity := bloodrequestFields[4].Descriptor()
+	// bloodrequest.DefaultFulfilledQuantity holds the default value on creation for the fulfilled_quantity field.
+	bloodrequest.DefaultFulfilledQuantity = bloodrequestDescFulfilledQuantity.Default.(int)
+	// bloodrequest.FulfilledQuantityValidator is a validator for the "fulfilled_quantity" field. It is called by the builders before save.
+	bloodrequest.FulfilledQuantityValidator = bloodrequestDescFulfilledQuantity.Validators[0].(func(int) error)
+	// bloodrequestDescRequiredBy is the schema descriptor for required_by field.
+	bloodrequestDescRequiredBy := bloodrequestFields[6].Descriptor()
+	// bloodrequest.RequiredByValidator is a validator for the "required_by" field. It is called by the builders before save.
+	bloodrequest.RequiredByValidator = bloodrequestDescRequiredBy.Validators[0].(func(int64) error)
+	// bloodrequestDescNote is the schema descriptor for note field.
+	bloodrequestDescNote := bloodrequestFields[8].Descriptor()
+	// bloodrequest.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	bloodrequest.NoteValidator = bloodrequestDescNote.Validators[0].(func(string) error)
+	// bloodrequestDescReason is the schema descriptor for reason field.
+	bloodrequestDescReason := bloodrequestFields[9].Descriptor()
+	// bloodrequest.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	bloodrequest.ReasonValidator = bloodrequestDescReason.Validators[0].(func(string) error)
+	bloodstockMixin := schema.BloodStock{}.Mixin()
+	bloodstockMixinFields0 := bloodstockMixin[0].Fields()
+	_ = bloodstockMixinFields0
+	bloodstockFields := schema.BloodStock{}.Fields()
+	_ = bloodstockFields
+	// bloodstockDescCreatedAt is the schema descriptor for created_at field.
+	bloodstockDescCreatedAt := bloodstockMixinFields0[1].Descriptor()
+	// bloodstock.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	bloodstock.CreatedAtValidator = bloodstockDescCreatedAt.Validators[0].(func(int64) error)
+	// bloodstockDescUpdatedAt is the schema descriptor for updated_at field.
+	bloodstockDescUpdatedAt := bloodstockMixinFields0[2].Descriptor()
+	// bloodstock.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bloodstock.UpdateDefaultUpdatedAt = bloodstockDescUpdatedAt.UpdateDefault.(func() int64)
+	// bloodstock.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	bloodstock.UpdatedAtValidator = bloodstockDescUpdatedAt.Validators[0].(func(int64) error)
+	// bloodstockDescDeletedAt is the schema descriptor for deleted_at field.
+	bloodstockDescDeletedAt := bloodstockMixinFields0[3].Descriptor()
+	// bloodstock.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	bloodstock.DeletedAtValidator = bloodstockDescDeletedAt.Validators[0].(func(int64) error)
+	// bloodstockDescQuantity is the schema descriptor for quantity field.
+	bloodstockDescQuantity := bloodstockFields[1].Descriptor()
+	// bloodstock.DefaultQuantity holds the default value on creation for the quantity field.
+	bloodstock.DefaultQuantity = bloodstockDescQuantity.Default.(int)
+	// bloodstock.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	bloodstock.QuantityValidator = bloodstockDescQuantity.Validators[0].(func(int) error)
 	bloodtypeMixin := schema.BloodType{}.Mixin()
 	bloodtypeMixinFields0 := bloodtypeMixin[0].Fields()
 	_ = bloodtypeMixinFields0
@@ -197,18 +467,607 @@ func init() {
 	bloodtypeDescDeletedAt := bloodtypeMixinFields0[3].Descriptor()
 	// bloodtype.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
 	bloodtype.DeletedAtValidator = bloodtypeDescDeletedAt.Validators[0].(func(int64) error)
+	bloodunitMixin := schema.BloodUnit{}.Mixin()
+	bloodunitMixinFields0 := bloodunitMixin[0].Fields()
+	_ = bloodunitMixinFields0
+	bloodunitFields := schema.BloodUnit{}.Fields()
+	_ = bloodunitFields
+	// bloodunitDescCreatedAt is the schema descriptor for created_at field.
+	bloodunitDescCreatedAt := bloodunitMixinFields0[1].Descriptor()
+	// bloodunit.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	bloodunit.CreatedAtValidator = bloodunitDescCreatedAt.Validators[0].(func(int64) error)
+	// bloodunitDescUpdatedAt is the schema descriptor for updated_at field.
+	bloodunitDescUpdatedAt := bloodunitMixinFields0[2].Descriptor()
+	// bloodunit.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bloodunit.UpdateDefaultUpdatedAt = bloodunitDescUpdatedAt.UpdateDefault.(func() int64)
+	// bloodunit.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	bloodunit.UpdatedAtValidator = bloodunitDescUpdatedAt.Validators[0].(func(int64) error)
+	// bloodunitDescDeletedAt is the schema descriptor for deleted_at field.
+	bloodunitDescDeletedAt := bloodunitMixinFields0[3].Descriptor()
+	// bloodunit.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	bloodunit.DeletedAtValidator = bloodunitDescDeletedAt.Validators[0].(func(int64) error)
+	// bloodunitDescBarcode is the schema descriptor for barcode field.
+	bloodunitDescBarcode := bloodunitFields[0].Descriptor()
+	// bloodunit.BarcodeValidator is a validator for the "barcode" field. It is called by the builders before save.
+	bloodunit.BarcodeValidator = func() func(string) error {
+		validators := bloodunitDescBarcode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(barcode string) error {
+			for _, fn := range fns {
+				if err := fn(barcode); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// bloodunitDescCollectedAt is the schema descriptor for collected_at field.
+	bloodunitDescCollectedAt := bloodunitFields[2].Descriptor()
+	// bloodunit.CollectedAtValidator is a validator for the "collected_at" field. It is called by the builders before save.
+	bloodunit.CollectedAtValidator = bloodunitDescCollectedAt.Validators[0].(func(int64) error)
+	// bloodunitDescExpiresAt is the schema descriptor for expires_at field.
+	bloodunitDescExpiresAt := bloodunitFields[3].Descriptor()
+	// bloodunit.ExpiresAtValidator is a validator for the "expires_at" field. It is called by the builders before save.
+	bloodunit.ExpiresAtValidator = bloodunitDescExpiresAt.Validators[0].(func(int64) error)
+	blooduniteventMixin := schema.BloodUnitEvent{}.Mixin()
+	blooduniteventMixinFields0 := blooduniteventMixin[0].Fields()
+	_ = blooduniteventMixinFields0
+	blooduniteventFields := schema.BloodUnitEvent{}.Fields()
+	_ = blooduniteventFields
+	// blooduniteventDescCreatedAt is the schema descriptor for created_at field.
+	blooduniteventDescCreatedAt := blooduniteventMixinFields0[1].Descriptor()
+	// bloodunitevent.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	bloodunitevent.CreatedAtValidator = blooduniteventDescCreatedAt.Validators[0].(func(int64) error)
+	// blooduniteventDescUpdatedAt is the schema descriptor for updated_at field.
+	blooduniteventDescUpdatedAt := blooduniteventMixinFields0[2].Descriptor()
+	// bloodunitevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bloodunitevent.UpdateDefaultUpdatedAt = blooduniteventDescUpdatedAt.UpdateDefault.(func() int64)
+	// bloodunitevent.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	bloodunitevent.UpdatedAtValidator = blooduniteventDescUpdatedAt.Validators[0].(func(int64) error)
+	// blooduniteventDescDeletedAt is the schema descriptor for deleted_at field.
+	blooduniteventDescDeletedAt := blooduniteventMixinFields0[3].Descriptor()
+	// bloodunitevent.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	bloodunitevent.DeletedAtValidator = blooduniteventDescDeletedAt.Validators[0].(func(int64) error)
+	// blooduniteventDescFromStatus is the schema descriptor for from_status field.
+	blooduniteventDescFromStatus := blooduniteventFields[0].Descriptor()
+	// bloodunitevent.FromStatusValidator is a validator for the "from_status" field. It is called by the builders before save.
+	bloodunitevent.FromStatusValidator = blooduniteventDescFromStatus.Validators[0].(func(string) error)
+	// blooduniteventDescToStatus is the schema descriptor for to_status field.
+	blooduniteventDescToStatus := blooduniteventFields[1].Descriptor()
+	// bloodunitevent.ToStatusValidator is a validator for the "to_status" field. It is called by the builders before save.
+	bloodunitevent.ToStatusValidator = blooduniteventDescToStatus.Validators[0].(func(string) error)
+	// blooduniteventDescNote is the schema descriptor for note field.
+	blooduniteventDescNote := blooduniteventFields[2].Descriptor()
+	// bloodunitevent.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	bloodunitevent.NoteValidator = blooduniteventDescNote.Validators[0].(func(string) error)
+	certificateMixin := schema.Certificate{}.Mixin()
+	certificateMixinFields0 := certificateMixin[0].Fields()
+	_ = certificateMixinFields0
+	certificateFields := schema.Certificate{}.Fields()
+	_ = certificateFields
+	// certificateDescCreatedAt is the schema descriptor for created_at field.
+	certificateDescCreatedAt := certificateMixinFields0[1].Descriptor()
+	// certificate.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	certificate.CreatedAtValidator = certificateDescCreatedAt.Validators[0].(func(int64) error)
+	// certificateDescUpdatedAt is the schema descriptor for updated_at field.
+	certificateDescUpdatedAt := certificateMixinFields0[2].Descriptor()
+	// certificate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	certificate.UpdateDefaultUpdatedAt = certificateDescUpdatedAt.UpdateDefault.(func() int64)
+	// certificate.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	certificate.UpdatedAtValidator = certificateDescUpdatedAt.Validators[0].(func(int64) error)
+	// certificateDescDeletedAt is the schema descriptor for deleted_at field.
+	certificateDescDeletedAt := certificateMixinFields0[3].Descriptor()
+	// certificate.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	certificate.DeletedAtValidator = certificateDescDeletedAt.Validators[0].(func(int64) error)
+	// certificateDescMilestone is the schema descriptor for milestone field.
+	certificateDescMilestone := certificateFields[0].Descriptor()
+	// certificate.MilestoneValidator is a validator for the "milestone" field. It is called by the builders before save.
+	certificate.MilestoneValidator = certificateDescMilestone.Validators[0].(func(int) error)
+	// certificateDescHolderName is the schema descriptor for holder_name field.
+	certificateDescHolderName := certificateFields[1].Descriptor()
+	// certificate.HolderNameValidator is a validator for the "holder_name" field. It is called by the builders before save.
+	certificate.HolderNameValidator = func() func(string) error {
+		validators := certificateDescHolderName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(holder_name string) error {
+			for _, fn := range fns {
+				if err := fn(holder_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// certificateDescAwardedAt is the schema descriptor for awarded_at field.
+	certificateDescAwardedAt := certificateFields[2].Descriptor()
+	// certificate.AwardedAtValidator is a validator for the "awarded_at" field. It is called by the builders before save.
+	certificate.AwardedAtValidator = certificateDescAwardedAt.Validators[0].(func(int64) error)
 	cityFields := schema.City{}.Fields()
 	_ = cityFields
 	// cityDescBpsCode is the schema descriptor for bps_code field.
 	cityDescBpsCode := cityFields[1].Descriptor()
 	// city.BpsCodeValidator is a validator for the "bps_code" field. It is called by the builders before save.
 	city.BpsCodeValidator = cityDescBpsCode.Validators[0].(func(string) error)
+	deferralMixin := schema.Deferral{}.Mixin()
+	deferralMixinFields0 := deferralMixin[0].Fields()
+	_ = deferralMixinFields0
+	deferralFields := schema.Deferral{}.Fields()
+	_ = deferralFields
+	// deferralDescCreatedAt is the schema descriptor for created_at field.
+	deferralDescCreatedAt := deferralMixinFields0[1].Descriptor()
+	// deferral.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	deferral.CreatedAtValidator = deferralDescCreatedAt.Validators[0].(func(int64) error)
+	// deferralDescUpdatedAt is the schema descriptor for updated_at field.
+	deferralDescUpdatedAt := deferralMixinFields0[2].Descriptor()
+	// deferral.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deferral.UpdateDefaultUpdatedAt = deferralDescUpdatedAt.UpdateDefault.(func() int64)
+	// deferral.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	deferral.UpdatedAtValidator = deferralDescUpdatedAt.Validators[0].(func(int64) error)
+	// deferralDescDeletedAt is the schema descriptor for deleted_at field.
+	deferralDescDeletedAt := deferralMixinFields0[3].Descriptor()
+	// deferral.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	deferral.DeletedAtValidator = deferralDescDeletedAt.Validators[0].(func(int64) error)
+	// deferralDescReason is the schema descriptor for reason field.
+	deferralDescReason := deferralFields[1].Descriptor()
+	// deferral.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	deferral.ReasonValidator = func() func(string) error {
+		validators := deferralDescReason.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(reason string) error {
+			for _, fn := range fns {
+				if err := fn(reason); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// deferralDescStartsAt is the schema descriptor for starts_at field.
+	deferralDescStartsAt := deferralFields[2].Descriptor()
+	// deferral.StartsAtValidator is a validator for the "starts_at" field. It is called by the builders before save.
+	deferral.StartsAtValidator = deferralDescStartsAt.Validators[0].(func(int64) error)
+	// deferralDescEndsAt is the schema descriptor for ends_at field.
+	deferralDescEndsAt := deferralFields[3].Descriptor()
+	// deferral.EndsAtValidator is a validator for the "ends_at" field. It is called by the builders before save.
+	deferral.EndsAtValidator = deferralDescEndsAt.Validators[0].(func(int64) error)
 	districtFields := schema.District{}.Fields()
 	_ = districtFields
 	// districtDescBpsCode is the schema descriptor for bps_code field.
 	districtDescBpsCode := districtFields[1].Descriptor()
 	// district.BpsCodeValidator is a validator for the "bps_code" field. It is called by the builders before save.
 	district.BpsCodeValidator = districtDescBpsCode.Validators[0].(func(string) error)
+	donationMixin := schema.Donation{}.Mixin()
+	donationMixinFields0 := donationMixin[0].Fields()
+	_ = donationMixinFields0
+	donationFields := schema.Donation{}.Fields()
+	_ = donationFields
+	// donationDescCreatedAt is the schema descriptor for created_at field.
+	donationDescCreatedAt := donationMixinFields0[1].Descriptor()
+	// donation.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	donation.CreatedAtValidator = donationDescCreatedAt.Validators[0].(func(int64) error)
+	// donationDescUpdatedAt is the schema descriptor for updated_at field.
+	donationDescUpdatedAt := donationMixinFields0[2].Descriptor()
+	// donation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	donation.UpdateDefaultUpdatedAt = donationDescUpdatedAt.UpdateDefault.(func() int64)
+	// donation.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	donation.UpdatedAtValidator = donationDescUpdatedAt.Validators[0].(func(int64) error)
+	// donationDescDeletedAt is the schema descriptor for deleted_at field.
+	donationDescDeletedAt := donationMixinFields0[3].Descriptor()
+	// donation.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	donation.DeletedAtValidator = donationDescDeletedAt.Validators[0].(func(int64) error)
+	// donationDescDonatedAt is the schema descriptor for donated_at field.
+	donationDescDonatedAt := donationFields[0].Descriptor()
+	// donation.DonatedAtValidator is a validator for the "donated_at" field. It is called by the builders before save.
+	donation.DonatedAtValidator = donationDescDonatedAt.Validators[0].(func(int64) error)
+	// donationDescVolumeMl is the schema descriptor for volume_ml field.
+	donationDescVolumeMl := donationFields[2].Descriptor()
+	// donation.VolumeMlValidator is a validator for the "volume_ml" field. It is called by the builders before save.
+	donation.VolumeMlValidator = donationDescVolumeMl.Validators[0].(func(int16) error)
+	// donationDescBagNumber is the schema descriptor for bag_number field.
+	donationDescBagNumber := donationFields[3].Descriptor()
+	// donation.BagNumberValidator is a validator for the "bag_number" field. It is called by the builders before save.
+	donation.BagNumberValidator = func() func(string) error {
+		validators := donationDescBagNumber.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(bag_number string) error {
+			for _, fn := range fns {
+				if err := fn(bag_number); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	donationeventMixin := schema.DonationEvent{}.Mixin()
+	donationeventMixinFields0 := donationeventMixin[0].Fields()
+	_ = donationeventMixinFields0
+	donationeventFields := schema.DonationEvent{}.Fields()
+	_ = donationeventFields
+	// donationeventDescCreatedAt is the schema descriptor for created_at field.
+	donationeventDescCreatedAt := donationeventMixinFields0[1].Descriptor()
+	// donationevent.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	donationevent.CreatedAtValidator = donationeventDescCreatedAt.Validators[0].(func(int64) error)
+	// donationeventDescUpdatedAt is the schema descriptor for updated_at field.
+	donationeventDescUpdatedAt := donationeventMixinFields0[2].Descriptor()
+	// donationevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	donationevent.UpdateDefaultUpdatedAt = donationeventDescUpdatedAt.UpdateDefault.(func() int64)
+	// donationevent.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	donationevent.UpdatedAtValidator = donationeventDescUpdatedAt.Validators[0].(func(int64) error)
+	// donationeventDescDeletedAt is the schema descriptor for deleted_at field.
+	donationeventDescDeletedAt := donationeventMixinFields0[3].Descriptor()
+	// donationevent.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	donationevent.DeletedAtValidator = donationeventDescDeletedAt.Validators[0].(func(int64) error)
+	// donationeventDescTitle is the schema descriptor for title field.
+	donationeventDescTitle := donationeventFields[0].Descriptor()
+	// donationevent.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	donationevent.TitleValidator = func() func(string) error {
+		validators := donationeventDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// donationeventDescHostName is the schema descriptor for host_name field.
+	donationeventDescHostName := donationeventFields[2].Descriptor()
+	// donationevent.HostNameValidator is a validator for the "host_name" field. It is called by the builders before save.
+	donationevent.HostNameValidator = func() func(string) error {
+		validators := donationeventDescHostName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(host_name string) error {
+			for _, fn := range fns {
+				if err := fn(host_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// donationeventDescVenue is the schema descriptor for venue field.
+	donationeventDescVenue := donationeventFields[4].Descriptor()
+	// donationevent.VenueValidator is a validator for the "venue" field. It is called by the builders before save.
+	donationevent.VenueValidator = func() func(string) error {
+		validators := donationeventDescVenue.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(venue string) error {
+			for _, fn := range fns {
+				if err := fn(venue); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// donationeventDescStreet is the schema descriptor for street field.
+	donationeventDescStreet := donationeventFields[5].Descriptor()
+	// donationevent.StreetValidator is a validator for the "street" field. It is called by the builders before save.
+	donationevent.StreetValidator = donationeventDescStreet.Validators[0].(func(string) error)
+	// donationeventDescStartsAt is the schema descriptor for starts_at field.
+	donationeventDescStartsAt := donationeventFields[7].Descriptor()
+	// donationevent.StartsAtValidator is a validator for the "starts_at" field. It is called by the builders before save.
+	donationevent.StartsAtValidator = donationeventDescStartsAt.Validators[0].(func(int64) error)
+	// donationeventDescEndsAt is the schema descriptor for ends_at field.
+	donationeventDescEndsAt := donationeventFields[8].Descriptor()
+	// donationevent.EndsAtValidator is a validator for the "ends_at" field. It is called by the builders before save.
+	donationevent.EndsAtValidator = donationeventDescEndsAt.Validators[0].(func(int64) error)
+	// donationeventDescCapacity is the schema descriptor for capacity field.
+	donationeventDescCapacity := donationeventFields[9].Descriptor()
+	// donationevent.CapacityValidator is a validator for the "capacity" field. It is called by the builders before save.
+	donationevent.CapacityValidator = donationeventDescCapacity.Validators[0].(func(int) error)
+	// donationeventDescReviewNote is the schema descriptor for review_note field.
+	donationeventDescReviewNote := donationeventFields[11].Descriptor()
+	// donationevent.ReviewNoteValidator is a validator for the "review_note" field. It is called by the builders before save.
+	donationevent.ReviewNoteValidator = donationeventDescReviewNote.Validators[0].(func(string) error)
+	donationreminderMixin := schema.DonationReminder{}.Mixin()
+	donationreminderMixinFields0 := donationreminderMixin[0].Fields()
+	_ = donationreminderMixinFields0
+	donationreminderFields := schema.DonationReminder{}.Fields()
+	_ = donationreminderFields
+	// donationreminderDescCreatedAt is the schema descriptor for created_at field.
+	donationreminderDescCreatedAt := donationreminderMixinFields0[1].Descriptor()
+	// donationreminder.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	donationreminder.CreatedAtValidator = donationreminderDescCreatedAt.Validators[0].(func(int64) error)
+	// donationreminderDescUpdatedAt is the schema descriptor for updated_at field.
+	donationreminderDescUpdatedAt := donationreminderMixinFields0[2].Descriptor()
+	// donationreminder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	donationreminder.UpdateDefaultUpdatedAt = donationreminderDescUpdatedAt.UpdateDefault.(func() int64)
+	// donationreminder.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	donationreminder.UpdatedAtValidator = donationreminderDescUpdatedAt.Validators[0].(func(int64) error)
+	// donationreminderDescDeletedAt is the schema descriptor for deleted_at field.
+	donationreminderDescDeletedAt := donationreminderMixinFields0[3].Descriptor()
+	// donationreminder.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	donationreminder.DeletedAtValidator = donationreminderDescDeletedAt.Validators[0].(func(int64) error)
+	// donationreminderDescEligibleAt is the schema descriptor for eligible_at field.
+	donationreminderDescEligibleAt := donationreminderFields[0].Descriptor()
+	// donationreminder.EligibleAtValidator is a validator for the "eligible_at" field. It is called by the builders before save.
+	donationreminder.EligibleAtValidator = donationreminderDescEligibleAt.Validators[0].(func(int64) error)
+	// donationreminderDescSentAt is the schema descriptor for sent_at field.
+	donationreminderDescSentAt := donationreminderFields[1].Descriptor()
+	// donationreminder.SentAtValidator is a validator for the "sent_at" field. It is called by the builders before save.
+	donationreminder.SentAtValidator = donationreminderDescSentAt.Validators[0].(func(int64) error)
+	emergencycampaignMixin := schema.EmergencyCampaign{}.Mixin()
+	emergencycampaignMixinFields0 := emergencycampaignMixin[0].Fields()
+	_ = emergencycampaignMixinFields0
+	emergencycampaignFields := schema.EmergencyCampaign{}.Fields()
+	_ = emergencycampaignFields
+	// emergencycampaignDescCreatedAt is the schema descriptor for created_at field.
+	emergencycampaignDescCreatedAt := emergencycampaignMixinFields0[1].Descriptor()
+	// emergencycampaign.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	emergencycampaign.CreatedAtValidator = emergencycampaignDescCreatedAt.Validators[0].(func(int64) error)
+	// emergencycampaignDescUpdatedAt is the schema descriptor for updated_at field.
+	emergencycampaignDescUpdatedAt := emergencycampaignMixinFields0[2].Descriptor()
+	// emergencycampaign.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emergencycampaign.UpdateDefaultUpdatedAt = emergencycampaignDescUpdatedAt.UpdateDefault.(func() int64)
+	// emergencycampaign.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	emergencycampaign.UpdatedAtValidator = emergencycampaignDescUpdatedAt.Validators[0].(func(int64) error)
+	// emergencycampaignDescDeletedAt is the schema descriptor for deleted_at field.
+	emergencycampaignDescDeletedAt := emergencycampaignMixinFields0[3].Descriptor()
+	// emergencycampaign.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	emergencycampaign.DeletedAtValidator = emergencycampaignDescDeletedAt.Validators[0].(func(int64) error)
+	// emergencycampaignDescNeededDonors is the schema descriptor for needed_donors field.
+	emergencycampaignDescNeededDonors := emergencycampaignFields[1].Descriptor()
+	// emergencycampaign.NeededDonorsValidator is a validator for the "needed_donors" field. It is called by the builders before save.
+	emergencycampaign.NeededDonorsValidator = emergencycampaignDescNeededDonors.Validators[0].(func(int) error)
+	// emergencycampaignDescCommittedDonors is the schema descriptor for committed_donors field.
+	emergencycampaignDescCommittedDonors := emergencycampaignFields[2].Descriptor()
+	// emergencycampaign.DefaultCommittedDonors holds the default value on creation for the committed_donors field.
+	emergencycampaign.DefaultCommittedDonors = emergencycampaignDescCommittedDonors.Default.(int)
+	// emergencycampaign.CommittedDonorsValidator is a validator for the "committed_donors" field. It is called by the builders before save.
+	emergencycampaign.CommittedDonorsValidator = emergencycampaignDescCommittedDonors.Validators[0].(func(int) error)
+	// emergencycampaignDescRadiusM is the schema descriptor for radius_m field.
+	emergencycampaignDescRadiusM := emergencycampaignFields[3].Descriptor()
+	// emergencycampaign.RadiusMValidator is a validator for the "radius_m" field. It is called by the builders before save.
+	emergencycampaign.RadiusMValidator = emergencycampaignDescRadiusM.Validators[0].(func(int) error)
+	// emergencycampaignDescWaveSize is the schema descriptor for wave_size field.
+	emergencycampaignDescWaveSize := emergencycampaignFields[4].Descriptor()
+	// emergencycampaign.WaveSizeValidator is a validator for the "wave_size" field. It is called by the builders before save.
+	emergencycampaign.WaveSizeValidator = emergencycampaignDescWaveSize.Validators[0].(func(int) error)
+	// emergencycampaignDescWaves is the schema descriptor for waves field.
+	emergencycampaignDescWaves := emergencycampaignFields[5].Descriptor()
+	// emergencycampaign.DefaultWaves holds the default value on creation for the waves field.
+	emergencycampaign.DefaultWaves = emergencycampaignDescWaves.Default.(int)
+	// emergencycampaign.WavesValidator is a validator for the "waves" field. It is called by the builders before save.
+	emergencycampaign.WavesValidator = emergencycampaignDescWaves.Validators[0].(func(int) error)
+	// emergencycampaignDescMessage is the schema descriptor for message field.
+	emergencycampaignDescMessage := emergencycampaignFields[8].Descriptor()
+	// emergencycampaign.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	emergencycampaign.MessageValidator = emergencycampaignDescMessage.Validators[0].(func(string) error)
+	emergencycontactMixin := schema.EmergencyContact{}.Mixin()
+	emergencycontactMixinFields0 := emergencycontactMixin[0].Fields()
+	_ = emergencycontactMixinFields0
+	emergencycontactFields := schema.EmergencyContact{}.Fields()
+	_ = emergencycontactFields
+	// emergencycontactDescCreatedAt is the schema descriptor for created_at field.
+	emergencycontactDescCreatedAt := emergencycontactMixinFields0[1].Descriptor()
+	// emergencycontact.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	emergencycontact.CreatedAtValidator = emergencycontactDescCreatedAt.Validators[0].(func(int64) error)
+	// emergencycontactDescUpdatedAt is the schema descriptor for updated_at field.
+	emergencycontactDescUpdatedAt := emergencycontactMixinFields0[2].Descriptor()
+	// emergencycontact.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emergencycontact.UpdateDefaultUpdatedAt = emergencycontactDescUpdatedAt.UpdateDefault.(func() int64)
+	// emergencycontact.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	emergencycontact.UpdatedAtValidator = emergencycontactDescUpdatedAt.Validators[0].(func(int64) error)
+	// emergencycontactDescDeletedAt is the schema descriptor for deleted_at field.
+	emergencycontactDescDeletedAt := emergencycontactMixinFields0[3].Descriptor()
+	// emergencycontact.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	emergencycontact.DeletedAtValidator = emergencycontactDescDeletedAt.Validators[0].(func(int64) error)
+	// emergencycontactDescWave is the schema descriptor for wave field.
+	emergencycontactDescWave := emergencycontactFields[0].Descriptor()
+	// emergencycontact.WaveValidator is a validator for the "wave" field. It is called by the builders before save.
+	emergencycontact.WaveValidator = emergencycontactDescWave.Validators[0].(func(int) error)
+	// emergencycontactDescDistanceM is the schema descriptor for distance_m field.
+	emergencycontactDescDistanceM := emergencycontactFields[1].Descriptor()
+	// emergencycontact.DistanceMValidator is a validator for the "distance_m" field. It is called by the builders before save.
+	emergencycontact.DistanceMValidator = emergencycontactDescDistanceM.Validators[0].(func(int) error)
+	hospitalMixin := schema.Hospital{}.Mixin()
+	hospitalMixinFields0 := hospitalMixin[0].Fields()
+	_ = hospitalMixinFields0
+	hospitalFields := schema.Hospital{}.Fields()
+	_ = hospitalFields
+	// hospitalDescCreatedAt is the schema descriptor for created_at field.
+	hospitalDescCreatedAt := hospitalMixinFields0[1].Descriptor()
+	// hospital.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	hospital.CreatedAtValidator = hospitalDescCreatedAt.Validators[0].(func(int64) error)
+	// hospitalDescUpdatedAt is the schema descriptor for updated_at field.
+	hospitalDescUpdatedAt := hospitalMixinFields0[2].Descriptor()
+	// hospital.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	hospital.UpdateDefaultUpdatedAt = hospitalDescUpdatedAt.UpdateDefault.(func() int64)
+	// hospital.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	hospital.UpdatedAtValidator = hospitalDescUpdatedAt.Validators[0].(func(int64) error)
+	// hospitalDescDeletedAt is the schema descriptor for deleted_at field.
+	hospitalDescDeletedAt := hospitalMixinFields0[3].Descriptor()
+	// hospital.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	hospital.DeletedAtValidator = hospitalDescDeletedAt.Validators[0].(func(int64) error)
+	// hospitalDescCode is the schema descriptor for code field.
+	hospitalDescCode := hospitalFields[0].Descriptor()
+	// hospital.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	hospital.CodeValidator = func() func(string) error {
+		validators := hospitalDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// hospitalDescName is the schema descriptor for name field.
+	hospitalDescName := hospitalFields[1].Descriptor()
+	// hospital.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	hospital.NameValidator = func() func(string) error {
+		validators := hospitalDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// hospitalDescStreet is the schema descriptor for street field.
+	hospitalDescStreet := hospitalFields[2].Descriptor()
+	// hospital.StreetValidator is a validator for the "street" field. It is called by the builders before save.
+	hospital.StreetValidator = hospitalDescStreet.Validators[0].(func(string) error)
+	// hospitalDescEmail is the schema descriptor for email field.
+	hospitalDescEmail := hospitalFields[3].Descriptor()
+	// hospital.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	hospital.EmailValidator = func() func(string) error {
+		validators := hospitalDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// hospitalDescDialCode is the schema descriptor for dial_code field.
+	hospitalDescDialCode := hospitalFields[4].Descriptor()
+	// hospital.DialCodeValidator is a validator for the "dial_code" field. It is called by the builders before save.
+	hospital.DialCodeValidator = func() func(string) error {
+		validators := hospitalDescDialCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(dial_code string) error {
+			for _, fn := range fns {
+				if err := fn(dial_code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// hospitalDescPhoneNumber is the schema descriptor for phone_number field.
+	hospitalDescPhoneNumber := hospitalFields[5].Descriptor()
+	// hospital.PhoneNumberValidator is a validator for the "phone_number" field. It is called by the builders before save.
+	hospital.PhoneNumberValidator = func() func(string) error {
+		validators := hospitalDescPhoneNumber.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(phone_number string) error {
+			for _, fn := range fns {
+				if err := fn(phone_number); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// hospitalDescActivated is the schema descriptor for activated field.
+	hospitalDescActivated := hospitalFields[6].Descriptor()
+	// hospital.DefaultActivated holds the default value on creation for the activated field.
+	hospital.DefaultActivated = hospitalDescActivated.Default.(bool)
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreferenceMixinFields0 := notificationpreferenceMixin[0].Fields()
+	_ = notificationpreferenceMixinFields0
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	notificationpreferenceDescCreatedAt := notificationpreferenceMixinFields0[1].Descriptor()
+	// notificationpreference.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	notificationpreference.CreatedAtValidator = notificationpreferenceDescCreatedAt.Validators[0].(func(int64) error)
+	// notificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferenceDescUpdatedAt := notificationpreferenceMixinFields0[2].Descriptor()
+	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() int64)
+	// notificationpreference.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	notificationpreference.UpdatedAtValidator = notificationpreferenceDescUpdatedAt.Validators[0].(func(int64) error)
+	// notificationpreferenceDescDeletedAt is the schema descriptor for deleted_at field.
+	notificationpreferenceDescDeletedAt := notificationpreferenceMixinFields0[3].Descriptor()
+	// notificationpreference.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	notificationpreference.DeletedAtValidator = notificationpreferenceDescDeletedAt.Validators[0].(func(int64) error)
+	// notificationpreferenceDescRemindersEnabled is the schema descriptor for reminders_enabled field.
+	notificationpreferenceDescRemindersEnabled := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.DefaultRemindersEnabled holds the default value on creation for the reminders_enabled field.
+	notificationpreference.DefaultRemindersEnabled = notificationpreferenceDescRemindersEnabled.Default.(bool)
+	// notificationpreferenceDescPushEnabled is the schema descriptor for push_enabled field.
+	notificationpreferenceDescPushEnabled := notificationpreferenceFields[1].Descriptor()
+	// notificationpreference.DefaultPushEnabled holds the default value on creation for the push_enabled field.
+	notificationpreference.DefaultPushEnabled = notificationpreferenceDescPushEnabled.Default.(bool)
+	// notificationpreferenceDescEmailEnabled is the schema descriptor for email_enabled field.
+	notificationpreferenceDescEmailEnabled := notificationpreferenceFields[2].Descriptor()
+	// notificationpreference.DefaultEmailEnabled holds the default value on creation for the email_enabled field.
+	notificationpreference.DefaultEmailEnabled = notificationpreferenceDescEmailEnabled.Default.(bool)
+	// notificationpreferenceDescSmsEnabled is the schema descriptor for sms_enabled field.
+	notificationpreferenceDescSmsEnabled := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultSmsEnabled holds the default value on creation for the sms_enabled field.
+	notificationpreference.DefaultSmsEnabled = notificationpreferenceDescSmsEnabled.Default.(bool)
+	// notificationpreferenceDescPushToken is the schema descriptor for push_token field.
+	notificationpreferenceDescPushToken := notificationpreferenceFields[4].Descriptor()
+	// notificationpreference.PushTokenValidator is a validator for the "push_token" field. It is called by the builders before save.
+	notificationpreference.PushTokenValidator = notificationpreferenceDescPushToken.Validators[0].(func(string) error)
+	// notificationpreferenceDescTimezone is the schema descriptor for timezone field.
+	notificationpreferenceDescTimezone := notificationpreferenceFields[5].Descriptor()
+	// notificationpreference.DefaultTimezone holds the default value on creation for the timezone field.
+	notificationpreference.DefaultTimezone = notificationpreferenceDescTimezone.Default.(string)
+	// notificationpreference.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	notificationpreference.TimezoneValidator = func() func(string) error {
+		validators := notificationpreferenceDescTimezone.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(timezone string) error {
+			for _, fn := range fns {
+				if err := fn(timezone); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationpreferenceDescQuietHoursStart is the schema descriptor for quiet_hours_start field.
+	notificationpreferenceDescQuietHoursStart := notificationpreferenceFields[6].Descriptor()
+	// notificationpreference.QuietHoursStartValidator is a validator for the "quiet_hours_start" field. It is called by the builders before save.
+	notificationpreference.QuietHoursStartValidator = notificationpreferenceDescQuietHoursStart.Validators[0].(func(string) error)
+	// notificationpreferenceDescQuietHoursEnd is the schema descriptor for quiet_hours_end field.
+	notificationpreferenceDescQuietHoursEnd := notificationpreferenceFields[7].Descriptor()
+	// notificationpreference.QuietHoursEndValidator is a validator for the "quiet_hours_end" field. It is called by the builders before save.
+	notificationpreference.QuietHoursEndValidator = notificationpreferenceDescQuietHoursEnd.Validators[0].(func(string) error)
 	otpMixin := schema.OTP{}.Mixin()
 	otpMixinFields0 := otpMixin[0].Fields()
 	_ = otpMixinFields0
@@ -437,8 +1296,12 @@ func init() {
 	permissionDescAction := permissionFields[4].Descriptor()
 	// permission.ActionValidator is a validator for the "action" field. It is called by the builders before save.
 	permission.ActionValidator = permissionDescAction.Validators[0].(func(string) error)
+	// permissionDescCondition is the schema descriptor for condition field.
+	permissionDescCondition := permissionFields[5].Descriptor()
+	// permission.ConditionValidator is a validator for the "condition" field. It is called by the builders before save.
+	permission.ConditionValidator = permissionDescCondition.Validators[0].(func(string) error)
 	// permissionDescDescription is the schema descriptor for description field.
-	permissionDescDescription := permissionFields[5].Descriptor()
+	permissionDescDescription := permissionFields[6].Descriptor()
 	// permission.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	permission.DescriptionValidator = func() func(string) error {
 		validators := permissionDescDescription.Validators
@@ -461,6 +1324,51 @@ func init() {
 	provinceDescBpsCode := provinceFields[1].Descriptor()
 	// province.BpsCodeValidator is a validator for the "bps_code" field. It is called by the builders before save.
 	province.BpsCodeValidator = provinceDescBpsCode.Validators[0].(func(string) error)
+	questionnaireMixin := schema.Questionnaire{}.Mixin()
+	questionnaireMixinFields0 := questionnaireMixin[0].Fields()
+	_ = questionnaireMixinFields0
+	questionnaireFields := schema.Questionnaire{}.Fields()
+	_ = questionnaireFields
+	// questionnaireDescCreatedAt is the schema descriptor for created_at field.
+	questionnaireDescCreatedAt := questionnaireMixinFields0[1].Descriptor()
+	// questionnaire.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	questionnaire.CreatedAtValidator = questionnaireDescCreatedAt.Validators[0].(func(int64) error)
+	// questionnaireDescUpdatedAt is the schema descriptor for updated_at field.
+	questionnaireDescUpdatedAt := questionnaireMixinFields0[2].Descriptor()
+	// questionnaire.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	questionnaire.UpdateDefaultUpdatedAt = questionnaireDescUpdatedAt.UpdateDefault.(func() int64)
+	// questionnaire.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	questionnaire.UpdatedAtValidator = questionnaireDescUpdatedAt.Validators[0].(func(int64) error)
+	// questionnaireDescDeletedAt is the schema descriptor for deleted_at field.
+	questionnaireDescDeletedAt := questionnaireMixinFields0[3].Descriptor()
+	// questionnaire.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	questionnaire.DeletedAtValidator = questionnaireDescDeletedAt.Validators[0].(func(int64) error)
+	// questionnaireDescVersion is the schema descriptor for version field.
+	questionnaireDescVersion := questionnaireFields[0].Descriptor()
+	// questionnaire.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	questionnaire.VersionValidator = questionnaireDescVersion.Validators[0].(func(int) error)
+	// questionnaireDescTitle is the schema descriptor for title field.
+	questionnaireDescTitle := questionnaireFields[1].Descriptor()
+	// questionnaire.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	questionnaire.TitleValidator = func() func(string) error {
+		validators := questionnaireDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// questionnaireDescActivated is the schema descriptor for activated field.
+	questionnaireDescActivated := questionnaireFields[2].Descriptor()
+	// questionnaire.DefaultActivated holds the default value on creation for the activated field.
+	questionnaire.DefaultActivated = questionnaireDescActivated.Default.(bool)
 	roleMixin := schema.Role{}.Mixin()
 	roleMixinFields0 := roleMixin[0].Fields()
 	_ = roleMixinFields0
@@ -559,6 +1467,167 @@ func init() {
 	roleDescActivated := roleFields[4].Descriptor()
 	// role.DefaultActivated holds the default value on creation for the activated field.
 	role.DefaultActivated = roleDescActivated.Default.(bool)
+	roleassignmentMixin := schema.RoleAssignment{}.Mixin()
+	roleassignmentMixinFields0 := roleassignmentMixin[0].Fields()
+	_ = roleassignmentMixinFields0
+	roleassignmentFields := schema.RoleAssignment{}.Fields()
+	_ = roleassignmentFields
+	// roleassignmentDescCreatedAt is the schema descriptor for created_at field.
+	roleassignmentDescCreatedAt := roleassignmentMixinFields0[1].Descriptor()
+	// roleassignment.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	roleassignment.CreatedAtValidator = roleassignmentDescCreatedAt.Validators[0].(func(int64) error)
+	// roleassignmentDescUpdatedAt is the schema descriptor for updated_at field.
+	roleassignmentDescUpdatedAt := roleassignmentMixinFields0[2].Descriptor()
+	// roleassignment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	roleassignment.UpdateDefaultUpdatedAt = roleassignmentDescUpdatedAt.UpdateDefault.(func() int64)
+	// roleassignment.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	roleassignment.UpdatedAtValidator = roleassignmentDescUpdatedAt.Validators[0].(func(int64) error)
+	// roleassignmentDescDeletedAt is the schema descriptor for deleted_at field.
+	roleassignmentDescDeletedAt := roleassignmentMixinFields0[3].Descriptor()
+	// roleassignment.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	roleassignment.DeletedAtValidator = roleassignmentDescDeletedAt.Validators[0].(func(int64) error)
+	// roleassignmentDescDomain is the schema descriptor for domain field.
+	roleassignmentDescDomain := roleassignmentFields[0].Descriptor()
+	// roleassignment.DomainValidator is a validator for the "domain" field. It is called by the builders before save.
+	roleassignment.DomainValidator = func() func(string) error {
+		validators := roleassignmentDescDomain.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(domain string) error {
+			for _, fn := range fns {
+				if err := fn(domain); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	screeningquestionMixin := schema.ScreeningQuestion{}.Mixin()
+	screeningquestionMixinFields0 := screeningquestionMixin[0].Fields()
+	_ = screeningquestionMixinFields0
+	screeningquestionFields := schema.ScreeningQuestion{}.Fields()
+	_ = screeningquestionFields
+	// screeningquestionDescCreatedAt is the schema descriptor for created_at field.
+	screeningquestionDescCreatedAt := screeningquestionMixinFields0[1].Descriptor()
+	// screeningquestion.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	screeningquestion.CreatedAtValidator = screeningquestionDescCreatedAt.Validators[0].(func(int64) error)
+	// screeningquestionDescUpdatedAt is the schema descriptor for updated_at field.
+	screeningquestionDescUpdatedAt := screeningquestionMixinFields0[2].Descriptor()
+	// screeningquestion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	screeningquestion.UpdateDefaultUpdatedAt = screeningquestionDescUpdatedAt.UpdateDefault.(func() int64)
+	// screeningquestion.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	screeningquestion.UpdatedAtValidator = screeningquestionDescUpdatedAt.Validators[0].(func(int64) error)
+	// screeningquestionDescDeletedAt is the schema descriptor for deleted_at field.
+	screeningquestionDescDeletedAt := screeningquestionMixinFields0[3].Descriptor()
+	// screeningquestion.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	screeningquestion.DeletedAtValidator = screeningquestionDescDeletedAt.Validators[0].(func(int64) error)
+	// screeningquestionDescKey is the schema descriptor for key field.
+	screeningquestionDescKey := screeningquestionFields[0].Descriptor()
+	// screeningquestion.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	screeningquestion.KeyValidator = func() func(string) error {
+		validators := screeningquestionDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// screeningquestionDescPosition is the schema descriptor for position field.
+	screeningquestionDescPosition := screeningquestionFields[1].Descriptor()
+	// screeningquestion.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	screeningquestion.PositionValidator = screeningquestionDescPosition.Validators[0].(func(int16) error)
+	// screeningquestionDescText is the schema descriptor for text field.
+	screeningquestionDescText := screeningquestionFields[2].Descriptor()
+	// screeningquestion.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	screeningquestion.TextValidator = screeningquestionDescText.Validators[0].(func(string) error)
+	// screeningquestionDescRequired is the schema descriptor for required field.
+	screeningquestionDescRequired := screeningquestionFields[5].Descriptor()
+	// screeningquestion.DefaultRequired holds the default value on creation for the required field.
+	screeningquestion.DefaultRequired = screeningquestionDescRequired.Default.(bool)
+	// screeningquestionDescDeferralDays is the schema descriptor for deferral_days field.
+	screeningquestionDescDeferralDays := screeningquestionFields[8].Descriptor()
+	// screeningquestion.DeferralDaysValidator is a validator for the "deferral_days" field. It is called by the builders before save.
+	screeningquestion.DeferralDaysValidator = screeningquestionDescDeferralDays.Validators[0].(func(int16) error)
+	// screeningquestionDescDeferralReason is the schema descriptor for deferral_reason field.
+	screeningquestionDescDeferralReason := screeningquestionFields[9].Descriptor()
+	// screeningquestion.DeferralReasonValidator is a validator for the "deferral_reason" field. It is called by the builders before save.
+	screeningquestion.DeferralReasonValidator = screeningquestionDescDeferralReason.Validators[0].(func(string) error)
+	screeningsubmissionMixin := schema.ScreeningSubmission{}.Mixin()
+	screeningsubmissionMixinFields0 := screeningsubmissionMixin[0].Fields()
+	_ = screeningsubmissionMixinFields0
+	screeningsubmissionFields := schema.ScreeningSubmission{}.Fields()
+	_ = screeningsubmissionFields
+	// screeningsubmissionDescCreatedAt is the schema descriptor for created_at field.
+	screeningsubmissionDescCreatedAt := screeningsubmissionMixinFields0[1].Descriptor()
+	// screeningsubmission.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	screeningsubmission.CreatedAtValidator = screeningsubmissionDescCreatedAt.Validators[0].(func(int64) error)
+	// screeningsubmissionDescUpdatedAt is the schema descriptor for updated_at field.
+	screeningsubmissionDescUpdatedAt := screeningsubmissionMixinFields0[2].Descriptor()
+	// screeningsubmission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	screeningsubmission.UpdateDefaultUpdatedAt = screeningsubmissionDescUpdatedAt.UpdateDefault.(func() int64)
+	// screeningsubmission.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	screeningsubmission.UpdatedAtValidator = screeningsubmissionDescUpdatedAt.Validators[0].(func(int64) error)
+	// screeningsubmissionDescDeletedAt is the schema descriptor for deleted_at field.
+	screeningsubmissionDescDeletedAt := screeningsubmissionMixinFields0[3].Descriptor()
+	// screeningsubmission.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	screeningsubmission.DeletedAtValidator = screeningsubmissionDescDeletedAt.Validators[0].(func(int64) error)
+	// screeningsubmissionDescDeferred is the schema descriptor for deferred field.
+	screeningsubmissionDescDeferred := screeningsubmissionFields[1].Descriptor()
+	// screeningsubmission.DefaultDeferred holds the default value on creation for the deferred field.
+	screeningsubmission.DefaultDeferred = screeningsubmissionDescDeferred.Default.(bool)
+	// screeningsubmissionDescSignedAt is the schema descriptor for signed_at field.
+	screeningsubmissionDescSignedAt := screeningsubmissionFields[2].Descriptor()
+	// screeningsubmission.SignedAtValidator is a validator for the "signed_at" field. It is called by the builders before save.
+	screeningsubmission.SignedAtValidator = screeningsubmissionDescSignedAt.Validators[0].(func(int64) error)
+	// screeningsubmissionDescPayloadHash is the schema descriptor for payload_hash field.
+	screeningsubmissionDescPayloadHash := screeningsubmissionFields[3].Descriptor()
+	// screeningsubmission.PayloadHashValidator is a validator for the "payload_hash" field. It is called by the builders before save.
+	screeningsubmission.PayloadHashValidator = screeningsubmissionDescPayloadHash.Validators[0].(func(string) error)
+	// screeningsubmissionDescSignature is the schema descriptor for signature field.
+	screeningsubmissionDescSignature := screeningsubmissionFields[4].Descriptor()
+	// screeningsubmission.SignatureValidator is a validator for the "signature" field. It is called by the builders before save.
+	screeningsubmission.SignatureValidator = screeningsubmissionDescSignature.Validators[0].(func(string) error)
+	stockmovementMixin := schema.StockMovement{}.Mixin()
+	stockmovementMixinFields0 := stockmovementMixin[0].Fields()
+	_ = stockmovementMixinFields0
+	stockmovementFields := schema.StockMovement{}.Fields()
+	_ = stockmovementFields
+	// stockmovementDescCreatedAt is the schema descriptor for created_at field.
+	stockmovementDescCreatedAt := stockmovementMixinFields0[1].Descriptor()
+	// stockmovement.CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	stockmovement.CreatedAtValidator = stockmovementDescCreatedAt.Validators[0].(func(int64) error)
+	// stockmovementDescUpdatedAt is the schema descriptor for updated_at field.
+	stockmovementDescUpdatedAt := stockmovementMixinFields0[2].Descriptor()
+	// stockmovement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	stockmovement.UpdateDefaultUpdatedAt = stockmovementDescUpdatedAt.UpdateDefault.(func() int64)
+	// stockmovement.UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	stockmovement.UpdatedAtValidator = stockmovementDescUpdatedAt.Validators[0].(func(int64) error)
+	// stockmovementDescDeletedAt is the schema descriptor for deleted_at field.
+	stockmovementDescDeletedAt := stockmovementMixinFields0[3].Descriptor()
+	// stockmovement.DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	stockmovement.DeletedAtValidator = stockmovementDescDeletedAt.Validators[0].(func(int64) error)
+	// stockmovementDescQuantity is the schema descriptor for quantity field.
+	stockmovementDescQuantity := stockmovementFields[1].Descriptor()
+	// stockmovement.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	stockmovement.QuantityValidator = stockmovementDescQuantity.Validators[0].(func(int) error)
+	// stockmovementDescBalance is the schema descriptor for balance field.
+	stockmovementDescBalance := stockmovementFields[2].Descriptor()
+	// stockmovement.BalanceValidator is a validator for the "balance" field. It is called by the builders before save.
+	stockmovement.BalanceValidator = stockmovementDescBalance.Validators[0].(func(int) error)
+	// stockmovementDescNote is the schema descriptor for note field.
+	stockmovementDescNote := stockmovementFields[4].Descriptor()
+	// stockmovement.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	stockmovement.NoteValidator = stockmovementDescNote.Validators[0].(func(string) error)
 	subdistrictFields := schema.Subdistrict{}.Fields()
 	_ = subdistrictFields
 	// subdistrictDescBpsCode is the schema descriptor for bps_code field.
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Account holds the schema definition for the Account entity.
@@ -40,6 +41,12 @@ func (Account) Fields() []ent.Field {
 			MinLen(3).
 			MaxLen(164).
 			StructTag(`json:"full_name"`),
+		field.Time("birth_date").
+			Optional().
+			Nillable().
+			StructTag(`json:"birth_date"`).
+			SchemaType(map[string]string{dialect.Postgres: "date"}).
+			Comment("Date of birth derived from the national identity number, used for donor age checks."),
 		field.Enum("gender").
 			NamedValues(
 				"Female", "FEMALE",
@@ -68,6 +75,11 @@ func (Account) Fields() []ent.Field {
 			MaxLen(13).
 			Unique().
 			StructTag(`json:"phone_number"`),
+		field.Other("home_lat_lng", &GeoPoint{}).
+			SchemaType(map[string]string{dialect.Postgres: "geography(Point,4326)"}).
+			Optional().
+			StructTag(`json:"home_lat_lng"`).
+			Comment("Home location shared by the donor, used to find nearby donors in emergencies."),
 		field.Bool("activated").Default(false).StructTag(`json:"activated"`),
 		field.Bool("locked").
 			Default(false).
@@ -95,6 +107,25 @@ func (Account) Edges() []ent.Edge {
 		edge.To("role", Role.Type).
 			Unique().
 			StorageKey(edge.Column("role_id")),
+		edge.From("donations", Donation.Type).
+			Ref("account"),
+		edge.From("appointments", Appointment.Type).
+			Ref("account"),
+		edge.From("deferrals", Deferral.Type).
+			Ref("account"),
+		edge.From("emergency_contacts", EmergencyContact.Type).
+			Ref("account"),
+		edge.From("notification_preference", NotificationPreference.Type).
+			Ref("account").
+			Unique(),
+		edge.From("certificates", Certificate.Type).
+			Ref("account"),
+		edge.From("role_assignments", RoleAssignment.Type).
+			Ref("account"),
+		edge.To("hospital", Hospital.Type).
+			Unique().
+			StorageKey(edge.Column("hospital_id")).
+			Comment("Hospital the account acts for when requesting blood."),
 	}
 }
 
@@ -115,3 +146,11 @@ func (Account) Annotations() []schema.Annotation {
 		},
 	}
 }
+
+// Indexes of the Account.
+func (Account) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("home_lat_lng").
+			Annotations(entsql.IndexType("GIST")),
+	}
+}
@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// APIKey holds the schema definition for the APIKey entity, a credential a
+// system, such as a hospital information system, calls Setetes with. A key
+// acts as the account that owns it for its organization, limited to the
+// permissions in its scopes.
+type APIKey struct {
+	ent.Schema
+}
+
+// Mixin for the APIKey.
+func (APIKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MinLen(3).
+			MaxLen(164).
+			StructTag(`json:"name"`),
+		field.String("prefix").
+			MaxLen(8).
+			NotEmpty().
+			Unique().
+			Immutable().
+			StructTag(`json:"prefix"`).
+			Comment("Public part of the key used to look it up, such as stk_<prefix>_<secret>."),
+		field.String("secret_hash").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive().
+			Immutable().
+			SchemaType(map[string]string{dialect.Postgres: "char(64)"}).
+			Comment("SHA256 hash of the secret part of the key."),
+		field.JSON("scopes", []string{}).
+			StructTag(`json:"scopes"`).
+			Comment("Keys of the permissions the key may use."),
+		field.Int64("expires_at").
+			Positive().
+			StructTag(`json:"expires_at"`),
+		field.Int64("last_used_at").
+			Positive().
+			Optional().
+			Nillable().
+			StructTag(`json:"last_used_at"`),
+		field.Int64("revoked_at").
+			Positive().
+			Optional().
+			Nillable().
+			StructTag(`json:"revoked_at"`),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("account", Account.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("account_id")).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Account the key acts as."),
+		edge.To("hospital", Hospital.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("hospital_id")).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Organization the key is issued for."),
+	}
+}
+
+// Annotations of the APIKey.
+func (APIKey) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			Table:        "api_keys",
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"secret_hash": "length(secret_hash) = 64",
+			},
+		},
+	}
+}
+
+// Indexes of the APIKey.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
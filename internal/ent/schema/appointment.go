@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Appointment holds the schema definition for the Appointment entity.
+type Appointment struct {
+	ent.Schema
+}
+
+// Mixin for the Appointment.
+func (Appointment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the Appointment.
+func (Appointment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("scheduled_at").
+			Positive().
+			StructTag(`json:"scheduled_at"`).
+			Comment("Scheduled donation time in milliseconds."),
+		field.Enum("status").
+			NamedValues(
+				"Booked", "BOOKED",
+				"Attended", "ATTENDED",
+				"Cancelled", "CANCELLED",
+				"NoShow", "NO_SHOW",
+			).
+			Default("BOOKED").
+			StructTag(`json:"status"`),
+	}
+}
+
+// Edges of the Appointment.
+func (Appointment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("account", Account.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("account_id")),
+		edge.To("pmi_location", PMILocation.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("pmi_location_id")),
+		edge.From("donation", Donation.Type).
+			Ref("appointment").
+			Unique(),
+	}
+}
+
+// Annotations of the Appointment.
+func (Appointment) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+		},
+	}
+}
+
+// Indexes of the Appointment.
+func (Appointment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scheduled_at"),
+	}
+}
@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity, an
+// append-only record of a data change or a security-sensitive action. The
+// actor is stored without a foreign key, so records outlive the accounts
+// they mention.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Mixin for the AuditLog.
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("actor_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"actor_id"`).
+			Comment("Account that acted. Empty for anonymous requests and system jobs."),
+		field.UUID("subject_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"subject_id"`).
+			Comment("Account the actor impersonated. Empty unless the actor impersonated one."),
+		field.String("session_id").
+			MaxLen(64).
+			Optional().
+			Immutable().
+			StructTag(`json:"session_id"`).
+			Comment("Token identifier (jti) of the session the actor used."),
+		field.UUID("request_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"request_id"`),
+		field.String("action").
+			NotEmpty().
+			MaxLen(64).
+			Immutable().
+			StructTag(`json:"action"`).
+			Comment("Mutation operation, such as create, or security action, such as login.failed."),
+		field.String("entity").
+			MaxLen(64).
+			Optional().
+			Immutable().
+			StructTag(`json:"entity"`),
+		field.String("entity_id").
+			MaxLen(64).
+			Optional().
+			Immutable().
+			StructTag(`json:"entity_id"`),
+		field.JSON("before", map[string]any{}).
+			Optional().
+			Immutable().
+			StructTag(`json:"before"`).
+			Comment("Values of the changed fields before the change."),
+		field.JSON("after", map[string]any{}).
+			Optional().
+			Immutable().
+			StructTag(`json:"after"`).
+			Comment("Values of the changed fields after the change."),
+		field.String("ip").
+			MaxLen(45).
+			Optional().
+			Immutable().
+			StructTag(`json:"ip"`),
+	}
+}
+
+// Annotations of the AuditLog.
+func (AuditLog) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+		},
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("actor_id"),
+		index.Fields("subject_id"),
+		index.Fields("request_id"),
+		index.Fields("action"),
+		index.Fields("entity", "entity_id"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,145 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BloodRequest holds the schema definition for the BloodRequest entity.
+type BloodRequest struct {
+	ent.Schema
+}
+
+// Mixin for the BloodRequest.
+func (BloodRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the BloodRequest.
+func (BloodRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("patient_name").
+			MinLen(3).
+			MaxLen(164).
+			Immutable().
+			StructTag(`json:"patient_name"`),
+		field.String("patient_record_number").
+			MaxLen(32).
+			Optional().
+			Immutable().
+			StructTag(`json:"patient_record_number"`).
+			Comment("Medical record number of the patient at the hospital."),
+		field.Enum("component").
+			NamedValues(
+				"WholeBlood", "WHOLE_BLOOD",
+				"PRC", "PRC",
+				"TC", "TC",
+				"FFP", "FFP",
+			).
+			Immutable().
+			StructTag(`json:"component"`),
+		field.Int("quantity").
+			Positive().
+			Immutable().
+			StructTag(`json:"quantity"`).
+			Comment("Number of bags requested."),
+		field.Int("fulfilled_quantity").
+			NonNegative().
+			Default(0).
+			StructTag(`json:"fulfilled_quantity"`).
+			Comment("Number of bags issued to the hospital so far."),
+		field.Enum("urgency").
+			NamedValues(
+				"Routine", "ROUTINE",
+				"Urgent", "URGENT",
+				"Emergency", "EMERGENCY",
+			).
+			Immutable().
+			StructTag(`json:"urgency"`),
+		field.Int64("required_by").
+			Positive().
+			Immutable().
+			StructTag(`json:"required_by"`).
+			Comment("Time the blood is needed at the hospital in milliseconds."),
+		field.Enum("status").
+			NamedValues(
+				"Submitted", "SUBMITTED",
+				"Accepted", "ACCEPTED",
+				"PartiallyFulfilled", "PARTIALLY_FULFILLED",
+				"Fulfilled", "FULFILLED",
+				"Rejected", "REJECTED",
+				"Cancelled", "CANCELLED",
+			).
+			Default("SUBMITTED").
+			StructTag(`json:"status"`),
+		field.String("note").
+			MaxLen(300).
+			Optional().
+			StructTag(`json:"note"`),
+		field.String("reason").
+			MaxLen(300).
+			Optional().
+			StructTag(`json:"reason"`).
+			Comment("Why the request was rejected or cancelled."),
+	}
+}
+
+// Edges of the BloodRequest.
+func (BloodRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("hospital", Hospital.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("hospital_id")),
+		edge.To("blood_type", BloodType.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("blood_type_id")).
+			Comment("Blood type of the patient."),
+		edge.To("pmi_location", PMILocation.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("pmi_location_id")).
+			Comment("PMI location the blood is requested from."),
+		edge.To("requested_by", Account.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("requested_by_id")),
+		edge.To("handled_by", Account.Type).
+			Unique().
+			StorageKey(edge.Column("handled_by_id")),
+		edge.From("units", BloodUnit.Type).
+			Ref("request"),
+	}
+}
+
+// Annotations of the BloodRequest.
+func (BloodRequest) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"fulfilled_quantity": "fulfilled_quantity <= quantity",
+			},
+		},
+	}
+}
+
+// Indexes of the BloodRequest.
+func (BloodRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "required_by"),
+	}
+}
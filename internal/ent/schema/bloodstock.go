@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BloodStock holds the schema definition for the BloodStock entity.
+type BloodStock struct {
+	ent.Schema
+}
+
+// Mixin for the BloodStock.
+func (BloodStock) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the BloodStock.
+func (BloodStock) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("component").
+			NamedValues(
+				"WholeBlood", "WHOLE_BLOOD",
+				"PRC", "PRC",
+				"TC", "TC",
+				"FFP", "FFP",
+			).
+			StructTag(`json:"component"`).
+			Comment("Blood component: whole blood, packed red cells, thrombocyte concentrate or fresh frozen plasma."),
+		field.Int("quantity").
+			NonNegative().
+			Default(0).
+			StructTag(`json:"quantity"`).
+			Comment("Number of bags in stock. Only changed through stock movements."),
+	}
+}
+
+// Edges of the BloodStock.
+func (BloodStock) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("pmi_location", PMILocation.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("pmi_location_id")),
+		edge.To("blood_type", BloodType.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("blood_type_id")),
+		edge.From("movements", StockMovement.Type).
+			Ref("stock"),
+	}
+}
+
+// Annotations of the BloodStock.
+func (BloodStock) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			Table:        "blood_stocks",
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"quantity": "quantity >= 0",
+			},
+		},
+	}
+}
+
+// Indexes of the BloodStock.
+func (BloodStock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("component").
+			Edges("pmi_location", "blood_type").
+			Unique(),
+	}
+}
@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BloodUnit holds the schema definition for the BloodUnit entity.
+type BloodUnit struct {
+	ent.Schema
+}
+
+// Mixin for the BloodUnit.
+func (BloodUnit) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the BloodUnit.
+func (BloodUnit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("barcode").
+			MinLen(3).
+			MaxLen(64).
+			Unique().
+			Immutable().
+			StructTag(`json:"barcode"`).
+			Comment("Barcode printed on the bag label."),
+		field.Enum("component").
+			NamedValues(
+				"WholeBlood", "WHOLE_BLOOD",
+				"PRC", "PRC",
+				"TC", "TC",
+				"FFP", "FFP",
+			).
+			Immutable().
+			StructTag(`json:"component"`),
+		field.Int64("collected_at").
+			Positive().
+			Immutable().
+			StructTag(`json:"collected_at"`).
+			Comment("Time the blood was collected in milliseconds."),
+		field.Int64("expires_at").
+			Positive().
+			StructTag(`json:"expires_at"`).
+			Comment("End of the shelf life in milliseconds."),
+		field.Enum("status").
+			NamedValues(
+				"Quarantined", "QUARANTINED",
+				"Tested", "TESTED",
+				"Available", "AVAILABLE",
+				"Reserved", "RESERVED",
+				"Issued", "ISSUED",
+				"Expired", "EXPIRED",
+				"Discarded", "DISCARDED",
+			).
+			Default("QUARANTINED").
+			StructTag(`json:"status"`).
+			Comment("Only changed through the lifecycle state machine."),
+	}
+}
+
+// Edges of the BloodUnit.
+func (BloodUnit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("pmi_location", PMILocation.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("pmi_location_id")),
+		edge.To("blood_type", BloodType.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("blood_type_id")),
+		edge.To("donation", Donation.Type).
+			Unique().
+			Immutable().
+			StorageKey(edge.Column("donation_id")),
+		edge.To("request", BloodRequest.Type).
+			Unique().
+			StorageKey(edge.Column("blood_request_id")).
+			Comment("Blood request the unit is reserved for or issued to."),
+		edge.From("events", BloodUnitEvent.Type).
+			Ref("unit"),
+		edge.From("movements", StockMovement.Type).
+			Ref("unit"),
+	}
+}
+
+// Annotations of the BloodUnit.
+func (BloodUnit) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"barcode":    "length(barcode) >= 3 and length(barcode) <= 64",
+				"expires_at": "expires_at > collected_at",
+			},
+		},
+	}
+}
+
+// Indexes of the BloodUnit.
+func (BloodUnit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "expires_at"),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// BloodUnitEvent holds the schema definition for the BloodUnitEvent entity.
+type BloodUnitEvent struct {
+	ent.Schema
+}
+
+// Mixin for the BloodUnitEvent.
+func (BloodUnitEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the BloodUnitEvent.
+func (BloodUnitEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("from_status").
+			MaxLen(16).
+			Optional().
+			Immutable().
+			StructTag(`json:"from_status"`).
+			Comment("Status before the transition. Empty for the registration."),
+		field.String("to_status").
+			MaxLen(16).
+			Immutable().
+			StructTag(`json:"to_status"`),
+		field.String("note").
+			MaxLen(300).
+			Optional().
+			Immutable().
+			StructTag(`json:"note"`),
+	}
+}
+
+// Edges of the BloodUnitEvent.
+func (BloodUnitEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("unit", BloodUnit.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("blood_unit_id")),
+		edge.To("performed_by", Account.Type).
+			Unique().
+			Immutable().
+			StorageKey(edge.Column("performed_by_id")).
+			Comment("Staff who changed the status. Empty for system jobs."),
+	}
+}
+
+// Annotations of the BloodUnitEvent.
+func (BloodUnitEvent) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Certificate holds the schema definition for the Certificate entity, the
+// recognition awarded to a donor for reaching a donation milestone.
+type Certificate struct {
+	ent.Schema
+}
+
+// Mixin for the Certificate.
+func (Certificate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the Certificate.
+func (Certificate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("milestone").
+			Positive().
+			Immutable().
+			StructTag(`json:"milestone"`).
+			Comment("Number of donations the certificate recognizes."),
+		field.String("holder_name").
+			MinLen(3).
+			MaxLen(164).
+			Immutable().
+			StructTag(`json:"holder_name"`).
+			Comment("Donor name at the time the certificate was awarded."),
+		field.Int64("awarded_at").
+			Positive().
+			Immutable().
+			StructTag(`json:"awarded_at"`).
+			Comment("Time of the donation that reached the milestone in milliseconds."),
+	}
+}
+
+// Edges of the Certificate.
+func (Certificate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("account", Account.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("account_id")),
+		edge.To("donation", Donation.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("donation_id")),
+	}
+}
+
+// Annotations of the Certificate.
+func (Certificate) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+		},
+	}
+}
+
+// Indexes of the Certificate.
+func (Certificate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("milestone").
+			Edges("account").
+			Unique(),
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Deferral holds the schema definition for the Deferral entity.
+type Deferral struct {
+	ent.Schema
+}
+
+// Mixin for the Deferral.
+func (Deferral) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the Deferral.
+func (Deferral) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			NamedValues(
+				"Temporary", "TEMPORARY",
+				"Permanent", "PERMANENT",
+			).
+			StructTag(`json:"type"`),
+		field.String("reason").
+			MinLen(3).
+			MaxLen(300).
+			StructTag(`json:"reason"`),
+		field.Int64("starts_at").
+			Positive().
+			StructTag(`json:"starts_at"`).
+			Comment("Start of the deferral in milliseconds."),
+		field.Int64("ends_at").
+			Positive().
+			Optional().
+			StructTag(`json:"ends_at"`).
+			Comment("End of a temporary deferral in milliseconds. Empty for permanent deferrals."),
+	}
+}
+
+// Edges of the Deferral.
+func (Deferral) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("account", Account.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("account_id")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("created_by", Account.Type).
+			Unique().
+			StorageKey(edge.Column("created_by_id")),
+		edge.From("screening", ScreeningSubmission.Type).
+			Ref("deferrals").
+			Unique(),
+	}
+}
+
+// Annotations of the Deferral.
+func (Deferral) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"reason": "length(reason) >= 3 and length(reason) <= 300",
+			},
+		},
+	}
+}
+
+// Indexes of the Deferral.
+func (Deferral) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ends_at"),
+	}
+}
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Donation holds the schema definition for the Donation entity.
+type Donation struct {
+	ent.Schema
+}
+
+// Mixin for the Donation.
+func (Donation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the Donation.
+func (Donation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("donated_at").
+			Positive().
+			StructTag(`json:"donated_at"`).
+			Comment("Time the blood was collected in milliseconds."),
+		field.Enum("type").
+			NamedValues(
+				"WholeBlood", "WHOLE_BLOOD",
+				"ApheresisPlatelets", "APHERESIS_PLATELETS",
+				"ApheresisPlasma", "APHERESIS_PLASMA",
+			).
+			StructTag(`json:"type"`),
+		field.Int16("volume_ml").
+			Positive().
+			StructTag(`json:"volume_ml"`).
+			SchemaType(map[string]string{dialect.Postgres: "smallint"}).
+			Comment("Collected volume in milliliters."),
+		field.String("bag_number").
+			MinLen(3).
+			MaxLen(64).
+			Unique().
+			StructTag(`json:"bag_number"`).
+			Comment("Number printed on the blood bag label."),
+	}
+}
+
+// Edges of the Donation.
+func (Donation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("account", Account.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("account_id")),
+		edge.To("pmi_location", PMILocation.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("pmi_location_id")),
+		edge.To("appointment", Appointment.Type).
+			Unique().
+			StorageKey(edge.Column("appointment_id")),
+		edge.To("recorded_by", Account.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("recorded_by_id")),
+		edge.From("reminder", DonationReminder.Type).
+			Ref("donation").
+			Unique(),
+	}
+}
+
+// Annotations of the Donation.
+func (Donation) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"bag_number": "length(bag_number) >= 3 and length(bag_number) <= 64",
+			},
+		},
+	}
+}
+
+// Indexes of the Donation.
+func (Donation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("donated_at"),
+	}
+}
@@ -0,0 +1,129 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DonationEvent holds the schema definition for the DonationEvent entity, a
+// mobile blood drive held outside a PMI building.
+type DonationEvent struct {
+	ent.Schema
+}
+
+// Mixin for the DonationEvent.
+func (DonationEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the DonationEvent.
+func (DonationEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").
+			MinLen(3).
+			MaxLen(164).
+			StructTag(`json:"title"`),
+		field.Text("description").
+			Optional().
+			StructTag(`json:"description"`),
+		field.String("host_name").
+			MinLen(3).
+			MaxLen(164).
+			StructTag(`json:"host_name"`).
+			Comment("Organization hosting the event, e.g. a company, campus or mosque."),
+		field.Enum("host_type").
+			NamedValues(
+				"Office", "OFFICE",
+				"Campus", "CAMPUS",
+				"WorshipPlace", "WORSHIP_PLACE",
+				"Community", "COMMUNITY",
+				"Other", "OTHER",
+			).
+			StructTag(`json:"host_type"`),
+		field.String("venue").
+			MinLen(3).
+			MaxLen(164).
+			StructTag(`json:"venue"`),
+		field.Text("street").NotEmpty().StructTag(`json:"street"`),
+		field.Other("lat_lng", &GeoPoint{}).
+			SchemaType(map[string]string{dialect.Postgres: "geography(Point,4326)"}).
+			StructTag(`json:"lat_lng"`),
+		field.Int64("starts_at").
+			Positive().
+			StructTag(`json:"starts_at"`).
+			Comment("Start of the event in milliseconds."),
+		field.Int64("ends_at").
+			Positive().
+			StructTag(`json:"ends_at"`).
+			Comment("End of the event in milliseconds."),
+		field.Int("capacity").
+			Positive().
+			StructTag(`json:"capacity"`).
+			Comment("Maximum number of donors the mobile unit can serve."),
+		field.Enum("status").
+			NamedValues(
+				"Pending", "PENDING",
+				"Approved", "APPROVED",
+				"Rejected", "REJECTED",
+				"Cancelled", "CANCELLED",
+			).
+			Default("PENDING").
+			StructTag(`json:"status"`).
+			Comment("Only APPROVED events are listed publicly."),
+		field.String("review_note").
+			MaxLen(300).
+			Optional().
+			StructTag(`json:"review_note"`),
+	}
+}
+
+// Edges of the DonationEvent.
+func (DonationEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("subdistrict", Subdistrict.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("subdistrict_id")),
+		edge.To("pmi_location", PMILocation.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("pmi_location_id")).
+			Comment("PMI location running the mobile unit and approving the event."),
+		edge.To("organizer", Account.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("organizer_id")),
+		edge.To("reviewed_by", Account.Type).
+			Unique().
+			StorageKey(edge.Column("reviewed_by_id")),
+	}
+}
+
+// Annotations of the DonationEvent.
+func (DonationEvent) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"ends_at": "ends_at > starts_at",
+			},
+		},
+	}
+}
+
+// Indexes of the DonationEvent.
+func (DonationEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "starts_at"),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// DonationReminder holds the schema definition for the DonationReminder
+// entity, the reminder sent to a donor after a donation once they may donate
+// again.
+type DonationReminder struct {
+	ent.Schema
+}
+
+// Mixin for the DonationReminder.
+func (DonationReminder) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the DonationReminder.
+func (DonationReminder) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("eligible_at").
+			Positive().
+			Immutable().
+			StructTag(`json:"eligible_at"`).
+			Comment("Time the donor becomes eligible again in milliseconds."),
+		field.Int64("sent_at").
+			Positive().
+			Immutable().
+			StructTag(`json:"sent_at"`).
+			Comment("Time the reminder was sent in milliseconds."),
+		field.Strings("channels").
+			Immutable().
+			StructTag(`json:"channels"`).
+			Comment("Channels the reminder was delivered through."),
+	}
+}
+
+// Edges of the DonationReminder.
+func (DonationReminder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("account", Account.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("account_id")),
+		edge.To("donation", Donation.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("donation_id")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Annotations of the DonationReminder.
+func (DonationReminder) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmergencyCampaign holds the schema definition for the EmergencyCampaign
+// entity.
+type EmergencyCampaign struct {
+	ent.Schema
+}
+
+// Mixin for the EmergencyCampaign.
+func (EmergencyCampaign) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the EmergencyCampaign.
+func (EmergencyCampaign) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("component").
+			NamedValues(
+				"WholeBlood", "WHOLE_BLOOD",
+				"PRC", "PRC",
+				"TC", "TC",
+				"FFP", "FFP",
+			).
+			Immutable().
+			StructTag(`json:"component"`),
+		field.Int("needed_donors").
+			Positive().
+			Immutable().
+			StructTag(`json:"needed_donors"`),
+		field.Int("committed_donors").
+			NonNegative().
+			Default(0).
+			StructTag(`json:"committed_donors"`).
+			Comment("Number of donors who accepted the call-out."),
+		field.Int("radius_m").
+			Positive().
+			Immutable().
+			StructTag(`json:"radius_m"`).
+			Comment("Search radius around the PMI location in meters."),
+		field.Int("wave_size").
+			Positive().
+			Immutable().
+			StructTag(`json:"wave_size"`).
+			Comment("Number of donors notified per wave."),
+		field.Int("waves").
+			NonNegative().
+			Default(0).
+			StructTag(`json:"waves"`).
+			Comment("Number of waves sent so far."),
+		field.Int64("last_wave_at").
+			Optional().
+			StructTag(`json:"last_wave_at"`).
+			Comment("Time the last wave was sent in milliseconds."),
+		field.Enum("status").
+			NamedValues(
+				"Active", "ACTIVE",
+				"Fulfilled", "FULFILLED",
+				"Exhausted", "EXHAUSTED",
+				"Cancelled", "CANCELLED",
+			).
+			Default("ACTIVE").
+			StructTag(`json:"status"`).
+			Comment("EXHAUSTED means no more eligible donors were found in the radius."),
+		field.String("message").
+			MaxLen(300).
+			Optional().
+			StructTag(`json:"message"`).
+			Comment("Message sent to the donors with the call-out."),
+	}
+}
+
+// Edges of the EmergencyCampaign.
+func (EmergencyCampaign) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("pmi_location", PMILocation.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("pmi_location_id")),
+		edge.To("blood_type", BloodType.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("blood_type_id")).
+			Comment("Blood type of the recipients the donors must be compatible with."),
+		edge.To("created_by", Account.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("created_by_id")),
+		edge.From("contacts", EmergencyContact.Type).
+			Ref("campaign"),
+	}
+}
+
+// Annotations of the EmergencyCampaign.
+func (EmergencyCampaign) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"committed_donors": "committed_donors <= needed_donors",
+			},
+		},
+	}
+}
+
+// Indexes of the EmergencyCampaign.
+func (EmergencyCampaign) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
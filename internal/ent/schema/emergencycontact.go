@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmergencyContact holds the schema definition for the EmergencyContact
+// entity, a donor notified by an emergency campaign.
+type EmergencyContact struct {
+	ent.Schema
+}
+
+// Mixin for the EmergencyContact.
+func (EmergencyContact) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the EmergencyContact.
+func (EmergencyContact) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("wave").
+			Positive().
+			Immutable().
+			StructTag(`json:"wave"`),
+		field.Int("distance_m").
+			NonNegative().
+			Immutable().
+			StructTag(`json:"distance_m"`).
+			Comment("Distance between the donor home and the PMI location in meters."),
+		field.Enum("status").
+			NamedValues(
+				"Notified", "NOTIFIED",
+				"Accepted", "ACCEPTED",
+				"Declined", "DECLINED",
+			).
+			Default("NOTIFIED").
+			StructTag(`json:"status"`),
+		field.Int64("responded_at").
+			Optional().
+			StructTag(`json:"responded_at"`).
+			Comment("Time the donor responded in milliseconds."),
+	}
+}
+
+// Edges of the EmergencyContact.
+func (EmergencyContact) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("campaign", EmergencyCampaign.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("emergency_campaign_id")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("account", Account.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("account_id")),
+	}
+}
+
+// Annotations of the EmergencyContact.
+func (EmergencyContact) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+		},
+	}
+}
+
+// Indexes of the EmergencyContact.
+func (EmergencyContact) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("campaign", "account").
+			Unique(),
+	}
+}
@@ -3,8 +3,10 @@ package schema
 import (
 	"database/sql/driver"
 	"fmt"
+	"strings"
 
 	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/ewkbhex"
 	"github.com/twpayne/go-geom/encoding/wkt"
 )
 
@@ -38,7 +40,18 @@ func (g *GeoPoint) Scan(src any) error {
 		return fmt.Errorf("cannot convert %T to GeoPoint", src)
 	}
 
-	geomObj, err := wkt.Unmarshal(str)
+	// PostGIS returns geography columns as hex encoded EWKB, while values
+	// written by this type are WKT.
+	var (
+		geomObj geom.T
+		err     error
+	)
+	if strings.HasPrefix(str, "POINT") {
+		geomObj, err = wkt.Unmarshal(str)
+	} else {
+		geomObj, err = ewkbhex.Decode(str)
+	}
+
 	if err != nil {
 		return err
 	}
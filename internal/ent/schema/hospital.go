@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Hospital holds the schema definition for the Hospital entity.
+type Hospital struct {
+	ent.Schema
+}
+
+// Mixin for the Hospital.
+func (Hospital) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the Hospital.
+func (Hospital) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			MinLen(3).
+			MaxLen(16).
+			Unique().
+			StructTag(`json:"code"`).
+			Comment("Hospital registration code issued by the Ministry of Health."),
+		field.String("name").
+			MinLen(3).
+			MaxLen(164).
+			StructTag(`json:"name"`),
+		field.Text("street").NotEmpty().StructTag(`json:"street"`),
+		field.String("email").
+			MinLen(3).
+			MaxLen(164).
+			Optional().
+			StructTag(`json:"email"`),
+		field.String("dial_code").
+			MinLen(1).
+			MaxLen(6).
+			StructTag(`json:"dial_code"`),
+		field.String("phone_number").
+			MinLen(11).
+			MaxLen(13).
+			StructTag(`json:"phone_number"`),
+		field.Bool("activated").Default(true).StructTag(`json:"activated"`),
+	}
+}
+
+// Edges of the Hospital.
+func (Hospital) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("subdistrict", Subdistrict.Type).
+			Unique().
+			Required().
+			StorageKey(edge.Column("subdistrict_id")),
+		edge.From("members", Account.Type).
+			Ref("hospital"),
+		edge.From("blood_requests", BloodRequest.Type).
+			Ref("hospital"),
+	}
+}
+
+// Annotations of the Hospital.
+func (Hospital) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"name":         "length(name) >= 3 and length(name) <= 164",
+				"phone_number": "length(phone_number) >= 11 and length(phone_number) <= 13",
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationPreference holds the schema definition for the
+// NotificationPreference entity, how and when an account wants to be
+// notified.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Mixin for the NotificationPreference.
+func (NotificationPreference) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bool("reminders_enabled").
+			Default(true).
+			StructTag(`json:"reminders_enabled"`).
+			Comment("Whether the donor is reminded when they may donate again."),
+		field.Bool("push_enabled").
+			Default(true).
+			StructTag(`json:"push_enabled"`),
+		field.Bool("email_enabled").
+			Default(true).
+			StructTag(`json:"email_enabled"`),
+		field.Bool("sms_enabled").
+			Default(false).
+			StructTag(`json:"sms_enabled"`),
+		field.String("push_token").
+			MaxLen(512).
+			Optional().
+			Sensitive().
+			Comment("Device token push notifications are delivered to."),
+		field.String("timezone").
+			MaxLen(64).
+			NotEmpty().
+			Default("Asia/Jakarta").
+			StructTag(`json:"timezone"`).
+			Comment("IANA time zone the quiet hours are read in."),
+		field.String("quiet_hours_start").
+			MaxLen(5).
+			Optional().
+			StructTag(`json:"quiet_hours_start"`).
+			Comment("Local time formatted as HH:MM from which no notification is sent."),
+		field.String("quiet_hours_end").
+			MaxLen(5).
+			Optional().
+			StructTag(`json:"quiet_hours_end"`).
+			Comment("Local time formatted as HH:MM at which notifications resume."),
+	}
+}
+
+// Edges of the NotificationPreference.
+func (NotificationPreference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("account", Account.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("account_id")),
+	}
+}
+
+// Annotations of the NotificationPreference.
+func (NotificationPreference) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -40,6 +41,11 @@ func (Permission) Fields() []ent.Field {
 			StructTag(`json:"domain"`),
 		field.String("resource").NotEmpty().StructTag(`json:"resource"`),
 		field.String("action").NotEmpty().StructTag(`json:"action"`),
+		field.String("condition").
+			Optional().
+			MaxLen(64).
+			Comment("Name of the attribute condition a request must satisfy.").
+			StructTag(`json:"condition"`),
 		field.String("description").
 			Optional().
 			MinLen(30).
@@ -50,7 +56,9 @@ func (Permission) Fields() []ent.Field {
 
 // Edges of the Permission.
 func (Permission) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("roles", Role.Type).Ref("permissions"),
+	}
 }
 
 func (Permission) Annotations() []schema.Annotation {
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Questionnaire holds the schema definition for the Questionnaire entity.
+type Questionnaire struct {
+	ent.Schema
+}
+
+// Mixin for the Questionnaire.
+func (Questionnaire) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the Questionnaire.
+func (Questionnaire) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").
+			Positive().
+			Unique().
+			Immutable().
+			StructTag(`json:"version"`).
+			Comment("Questionnaire version. A published version is never edited, a new version is created instead."),
+		field.String("title").
+			MinLen(3).
+			MaxLen(164).
+			StructTag(`json:"title"`),
+		field.Bool("activated").
+			Default(false).
+			StructTag(`json:"activated"`).
+			Comment("Only one version is activated and presented to donors."),
+	}
+}
+
+// Edges of the Questionnaire.
+func (Questionnaire) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("questions", ScreeningQuestion.Type).
+			StorageKey(edge.Column("questionnaire_id")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("submissions", ScreeningSubmission.Type).
+			Ref("questionnaire"),
+	}
+}
+
+// Annotations of the Questionnaire.
+func (Questionnaire) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"title": "length(title) >= 3 and length(title) <= 164",
+			},
+		},
+	}
+}
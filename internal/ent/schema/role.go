@@ -53,6 +53,8 @@ func (Role) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("accounts", Account.Type).Ref("role"),
 		edge.To("parent", Role.Type).From("children"),
+		edge.To("permissions", Permission.Type),
+		edge.From("assignments", RoleAssignment.Type).Ref("role"),
 	}
 }
 
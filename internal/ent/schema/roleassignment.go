@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoleAssignment holds the schema definition for the RoleAssignment entity,
+// a role granted to an account within a domain. Every assignment mirrors a
+// casbin grouping policy.
+type RoleAssignment struct {
+	ent.Schema
+}
+
+// Mixin for the RoleAssignment.
+func (RoleAssignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the RoleAssignment.
+func (RoleAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("domain").
+			NotEmpty().
+			MinLen(1).
+			MaxLen(164).
+			Immutable().
+			StructTag(`json:"domain"`),
+	}
+}
+
+// Edges of the RoleAssignment.
+func (RoleAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("account", Account.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("account_id")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("role", Role.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("role_id")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("assigned_by", Account.Type).
+			Unique().
+			Immutable().
+			StorageKey(edge.Column("assigned_by_id")),
+	}
+}
+
+// Annotations of the RoleAssignment.
+func (RoleAssignment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		&entsql.Annotation{
+			Checks: map[string]string{
+				"domain": "length(domain) >= 1 and length(domain) <= 164",
+			},
+		},
+	}
+}
+
+// Indexes of the RoleAssignment.
+func (RoleAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("domain").
+			Edges("account", "role").
+			Unique(),
+	}
+}
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScreeningQuestion holds the schema definition for the ScreeningQuestion
+// entity.
+type ScreeningQuestion struct {
+	ent.Schema
+}
+
+// Mixin for the ScreeningQuestion.
+func (ScreeningQuestion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the ScreeningQuestion.
+func (ScreeningQuestion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			MinLen(1).
+			MaxLen(64).
+			StructTag(`json:"key"`).
+			Comment("Stable identifier of the question within its questionnaire, used as the answer key."),
+		field.Int16("position").
+			NonNegative().
+			StructTag(`json:"position"`).
+			SchemaType(map[string]string{dialect.Postgres: "smallint"}),
+		field.Text("text").NotEmpty().StructTag(`json:"text"`),
+		field.Enum("answer_type").
+			NamedValues(
+				"YesNo", "YES_NO",
+				"Choice", "CHOICE",
+				"Number", "NUMBER",
+				"Date", "DATE",
+				"Text", "TEXT",
+			).
+			StructTag(`json:"answer_type"`),
+		field.Strings("options").
+			Optional().
+			StructTag(`json:"options"`).
+			Comment("Allowed answers of a CHOICE question."),
+		field.Bool("required").Default(true).StructTag(`json:"required"`),
+		field.Strings("deferral_answers").
+			Optional().
+			StructTag(`json:"deferral_answers"`).
+			Comment("Answers that defer the donor when given."),
+		field.Enum("deferral_type").
+			NamedValues(
+				"Temporary", "TEMPORARY",
+				"Permanent", "PERMANENT",
+			).
+			Optional().
+			StructTag(`json:"deferral_type"`),
+		field.Int16("deferral_days").
+			Positive().
+			Optional().
+			StructTag(`json:"deferral_days"`).
+			SchemaType(map[string]string{dialect.Postgres: "smallint"}).
+			Comment("Length of a temporary deferral in days."),
+		field.String("deferral_reason").
+			MaxLen(300).
+			Optional().
+			StructTag(`json:"deferral_reason"`),
+	}
+}
+
+// Edges of the ScreeningQuestion.
+func (ScreeningQuestion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("questionnaire", Questionnaire.Type).
+			Ref("questions").
+			Unique().
+			Required(),
+	}
+}
+
+// Annotations of the ScreeningQuestion.
+func (ScreeningQuestion) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+		},
+	}
+}
+
+// Indexes of the ScreeningQuestion.
+func (ScreeningQuestion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key").Edges("questionnaire").Unique(),
+	}
+}
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ScreeningSubmission holds the schema definition for the ScreeningSubmission
+// entity.
+type ScreeningSubmission struct {
+	ent.Schema
+}
+
+// Mixin for the ScreeningSubmission.
+func (ScreeningSubmission) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the ScreeningSubmission.
+func (ScreeningSubmission) Fields() []ent.Field {
+	return []ent.Field{
+		field.JSON("answers", map[string]string{}).
+			Immutable().
+			StructTag(`json:"answers"`).
+			Comment("Answers keyed by question key."),
+		field.Bool("deferred").
+			Default(false).
+			Immutable().
+			StructTag(`json:"deferred"`).
+			Comment("Whether any answer triggered a deferral."),
+		field.Int64("signed_at").
+			Positive().
+			Immutable().
+			StructTag(`json:"signed_at"`).
+			Comment("Time the donor signed the declaration in milliseconds."),
+		field.String("payload_hash").
+			MaxLen(64).
+			Immutable().
+			StructTag(`json:"payload_hash"`).
+			SchemaType(map[string]string{dialect.Postgres: "char(64)"}).
+			Comment("SHA256 of the signed payload."),
+		field.String("signature").
+			NotEmpty().
+			Immutable().
+			StructTag(`json:"signature"`).
+			Comment("Ed25519 signature of the payload hash by the server key."),
+	}
+}
+
+// Edges of the ScreeningSubmission.
+func (ScreeningSubmission) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("account", Account.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("account_id")),
+		edge.To("questionnaire", Questionnaire.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("questionnaire_id")),
+		edge.To("appointment", Appointment.Type).
+			Unique().
+			Immutable().
+			StorageKey(edge.Column("appointment_id")),
+		edge.To("deferrals", Deferral.Type).
+			StorageKey(edge.Column("screening_submission_id")),
+	}
+}
+
+// Annotations of the ScreeningSubmission.
+func (ScreeningSubmission) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+			Checks: map[string]string{
+				"payload_hash": "length(payload_hash) = 64",
+			},
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// StockMovement holds the schema definition for the StockMovement entity.
+type StockMovement struct {
+	ent.Schema
+}
+
+// Mixin for the StockMovement.
+func (StockMovement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the StockMovement.
+func (StockMovement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			NamedValues(
+				"Collect", "COLLECT",
+				"Issue", "ISSUE",
+				"Discard", "DISCARD",
+				"TransferIn", "TRANSFER_IN",
+				"TransferOut", "TRANSFER_OUT",
+			).
+			Immutable().
+			StructTag(`json:"type"`),
+		field.Int("quantity").
+			Positive().
+			Immutable().
+			StructTag(`json:"quantity"`),
+		field.Int("balance").
+			NonNegative().
+			Immutable().
+			StructTag(`json:"balance"`).
+			Comment("Stock quantity after the movement was applied."),
+		field.UUID("transfer_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"transfer_id"`).
+			Comment("Shared by the TRANSFER_OUT and TRANSFER_IN movements of one transfer."),
+		field.String("note").
+			MaxLen(300).
+			Optional().
+			Immutable().
+			StructTag(`json:"note"`),
+	}
+}
+
+// Edges of the StockMovement.
+func (StockMovement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("stock", BloodStock.Type).
+			Unique().
+			Required().
+			Immutable().
+			StorageKey(edge.Column("blood_stock_id")),
+		edge.To("performed_by", Account.Type).
+			Unique().
+			Immutable().
+			StorageKey(edge.Column("performed_by_id")).
+			Comment("Staff who performed the movement. Empty for system jobs."),
+		edge.To("unit", BloodUnit.Type).
+			Unique().
+			Immutable().
+			StorageKey(edge.Column("blood_unit_id")).
+			Comment("Blood unit whose lifecycle caused the movement."),
+	}
+}
+
+// Annotations of the StockMovement.
+func (StockMovement) Annotations() []schema.Annotation {
+	withComment := true
+
+	return []schema.Annotation{
+		&entsql.Annotation{
+			WithComments: &withComment,
+		},
+	}
+}
+
+// Indexes of the StockMovement.
+func (StockMovement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("transfer_id"),
+	}
+}
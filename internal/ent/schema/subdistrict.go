@@ -30,7 +30,6 @@ func (Subdistrict) Fields() []ent.Field {
 			SchemaType(map[string]string{dialect.Postgres: "char(10)"}),
 		field.String("postal_code").
 			MaxLen(5).
-			Unique().
 			StructTag(`json:"postal_code"`).
 			SchemaType(map[string]string{dialect.Postgres: "char(5)"}),
 		field.String("name").StructTag(`json:"name"`),
@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+)
+
+// ScreeningQuestion is the model entity for the ScreeningQuestion schema.
+type ScreeningQuestion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Stable identifier of the question within its questionnaire, used as the answer key.
+	Key string `json:"key"`
+	// Position holds the value of the "position" field.
+	Position int16 `json:"position"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text"`
+	// AnswerType holds the value of the "answer_type" field.
+	AnswerType screeningquestion.AnswerType `json:"answer_type"`
+	// Allowed answers of a CHOICE question.
+	Options []string `json:"options"`
+	// Required holds the value of the "required" field.
+	Required bool `json:"required"`
+	// Answers that defer the donor when given.
+	DeferralAnswers []string `json:"deferral_answers"`
+	// DeferralType holds the value of the "deferral_type" field.
+	DeferralType screeningquestion.DeferralType `json:"deferral_type"`
+	// Length of a temporary deferral in days.
+	DeferralDays int16 `json:"deferral_days"`
+	// DeferralReason holds the value of the "deferral_reason" field.
+	DeferralReason string `json:"deferral_reason"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScreeningQuestionQuery when eager-loading is set.
+	Edges            ScreeningQuestionEdges `json:"edges"`
+	questionnaire_id *uuid.UUID
+	selectValues     sql.SelectValues
+}
+
+// ScreeningQuestionEdges holds the relations/edges for other nodes in the graph.
+type ScreeningQuestionEdges struct {
+	// Questionnaire holds the value of the questionnaire edge.
+	Questionnaire *Questionnaire `json:"questionnaire,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// QuestionnaireOrErr returns the Questionnaire value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScreeningQuestionEdges) QuestionnaireOrErr() (*Questionnaire, error) {
+	if e.Questionnaire != nil {
+		return e.Questionnaire, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: questionnaire.Label}
+	}
+	return nil, &NotLoadedError{edge: "questionnaire"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScreeningQuestion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case screeningquestion.FieldOptions, screeningquestion.FieldDeferralAnswers:
+			values[i] = new([]byte)
+		case screeningquestion.FieldRequired:
+			values[i] = new(sql.NullBool)
+		case screeningquestion.FieldCreatedAt, screeningquestion.FieldUpdatedAt, screeningquestion.FieldDeletedAt, screeningquestion.FieldPosition, screeningquestion.FieldDeferralDays:
+			values[i] = new(sql.NullInt64)
+		case screeningquestion.FieldKey, screeningquestion.FieldText, screeningquestion.FieldAnswerType, screeningquestion.FieldDeferralType, screeningquestion.FieldDeferralReason:
+			values[i] = new(sql.NullString)
+		case screeningquestion.FieldID:
+			values[i] = new(uuid.UUID)
+		case screeningquestion.ForeignKeys[0]: // questionnaire_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScreeningQuestion fields.
+func (_m *ScreeningQuestion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case screeningquestion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case screeningquestion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case screeningquestion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case screeningquestion.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case screeningquestion.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case screeningquestion.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int16(value.Int64)
+			}
+		case screeningquestion.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				_m.Text = value.String
+			}
+		case screeningquestion.FieldAnswerType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_type", values[i])
+			} else if value.Valid {
+				_m.AnswerType = screeningquestion.AnswerType(value.String)
+			}
+		case screeningquestion.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
+		case screeningquestion.FieldRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field required", values[i])
+			} else if value.Valid {
+				_m.Required = value.Bool
+			}
+		case screeningquestion.FieldDeferralAnswers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field deferral_answers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DeferralAnswers); err != nil {
+					return fmt.Errorf("unmarshal field deferral_answers: %w", err)
+				}
+			}
+		case screeningquestion.FieldDeferralType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deferral_type", values[i])
+			} else if value.Valid {
+				_m.DeferralType = screeningquestion.DeferralType(value.String)
+			}
+		case screeningquestion.FieldDeferralDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deferral_days", values[i])
+			} else if value.Valid {
+				_m.DeferralDays = int16(value.Int64)
+			}
+		case screeningquestion.FieldDeferralReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deferral_reason", values[i])
+			} else if value.Valid {
+				_m.DeferralReason = value.String
+			}
+		case screeningquestion.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field questionnaire_id", values[i])
+			} else if value.Valid {
+				_m.questionnaire_id = new(uuid.UUID)
+				*_m.questionnaire_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScreeningQuestion.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScreeningQuestion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryQuestionnaire queries the "questionnaire" edge of the ScreeningQuestion entity.
+func (_m *ScreeningQuestion) QueryQuestionnaire() *QuestionnaireQuery {
+	return NewScreeningQuestionClient(_m.config).QueryQuestionnaire(_m)
+}
+
+// Update returns a builder for updating this ScreeningQuestion.
+// Note that you need to call ScreeningQuestion.Unwrap() before calling this method if this ScreeningQuestion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScreeningQuestion) Update() *ScreeningQuestionUpdateOne {
+	return NewScreeningQuestionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScreeningQuestion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScreeningQuestion) Unwrap() *ScreeningQuestion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScreeningQuestion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScreeningQuestion) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScreeningQuestion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("answer_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnswerType))
+	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Options))
+	builder.WriteString(", ")
+	builder.WriteString("required=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Required))
+	builder.WriteString(", ")
+	builder.WriteString("deferral_answers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeferralAnswers))
+	builder.WriteString(", ")
+	builder.WriteString("deferral_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeferralType))
+	builder.WriteString(", ")
+	builder.WriteString("deferral_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeferralDays))
+	builder.WriteString(", ")
+	builder.WriteString("deferral_reason=")
+	builder.WriteString(_m.DeferralReason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScreeningQuestions is a parsable slice of ScreeningQuestion.
+type ScreeningQuestions []*ScreeningQuestion
@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package screeningquestion
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the screeningquestion type in the database.
+	Label = "screening_question"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldText holds the string denoting the text field in the database.
+	FieldText = "text"
+	// FieldAnswerType holds the string denoting the answer_type field in the database.
+	FieldAnswerType = "answer_type"
+	// FieldOptions holds the string denoting the options field in the database.
+	FieldOptions = "options"
+	// FieldRequired holds the string denoting the required field in the database.
+	FieldRequired = "required"
+	// FieldDeferralAnswers holds the string denoting the deferral_answers field in the database.
+	FieldDeferralAnswers = "deferral_answers"
+	// FieldDeferralType holds the string denoting the deferral_type field in the database.
+	FieldDeferralType = "deferral_type"
+	// FieldDeferralDays holds the string denoting the deferral_days field in the database.
+	FieldDeferralDays = "deferral_days"
+	// FieldDeferralReason holds the string denoting the deferral_reason field in the database.
+	FieldDeferralReason = "deferral_reason"
+	// EdgeQuestionnaire holds the string denoting the questionnaire edge name in mutations.
+	EdgeQuestionnaire = "questionnaire"
+	// Table holds the table name of the screeningquestion in the database.
+	Table = "screening_questions"
+	// QuestionnaireTable is the table that holds the questionnaire relation/edge.
+	QuestionnaireTable = "screening_questions"
+	// QuestionnaireInverseTable is the table name for the Questionnaire entity.
+	// It exists in this package in order to avoid circular dependency with the "questionnaire" package.
+	QuestionnaireInverseTable = "questionnaires"
+	// QuestionnaireColumn is the table column denoting the questionnaire relation/edge.
+	QuestionnaireColumn = "questionnaire_id"
+)
+
+// Columns holds all SQL columns for screeningquestion fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldKey,
+	FieldPosition,
+	FieldText,
+	FieldAnswerType,
+	FieldOptions,
+	FieldRequired,
+	FieldDeferralAnswers,
+	FieldDeferralType,
+	FieldDeferralDays,
+	FieldDeferralReason,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "screening_questions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"questionnaire_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int16) error
+	// TextValidator is a validator for the "text" field. It is called by the builders before save.
+	TextValidator func(string) error
+	// DefaultRequired holds the default value on creation for the "required" field.
+	DefaultRequired bool
+	// DeferralDaysValidator is a validator for the "deferral_days" field. It is called by the builders before save.
+	DeferralDaysValidator func(int16) error
+	// DeferralReasonValidator is a validator for the "deferral_reason" field. It is called by the builders before save.
+	DeferralReasonValidator func(string) error
+)
+
+// AnswerType defines the type for the "answer_type" enum field.
+type AnswerType string
+
+// AnswerType values.
+const (
+	AnswerTypeYesNo  AnswerType = "YES_NO"
+	AnswerTypeChoice AnswerType = "CHOICE"
+	AnswerTypeNumber AnswerType = "NUMBER"
+	AnswerTypeDate   AnswerType = "DATE"
+	AnswerTypeText   AnswerType = "TEXT"
+)
+
+func (at AnswerType) String() string {
+	return string(at)
+}
+
+// AnswerTypeValidator is a validator for the "answer_type" field enum values. It is called by the builders before save.
+func AnswerTypeValidator(at AnswerType) error {
+	switch at {
+	case AnswerTypeYesNo, AnswerTypeChoice, AnswerTypeNumber, AnswerTypeDate, AnswerTypeText:
+		return nil
+	default:
+		return fmt.Errorf("screeningquestion: invalid enum value for answer_type field: %q", at)
+	}
+}
+
+// DeferralType defines the type for the "deferral_type" enum field.
+type DeferralType string
+
+// DeferralType values.
+const (
+	DeferralTypeTemporary DeferralType = "TEMPORARY"
+	DeferralTypePermanent DeferralType = "PERMANENT"
+)
+
+func (dt DeferralType) String() string {
+	return string(dt)
+}
+
+// DeferralTypeValidator is a validator for the "deferral_type" field enum values. It is called by the builders before save.
+func DeferralTypeValidator(dt DeferralType) error {
+	switch dt {
+	case DeferralTypeTemporary, DeferralTypePermanent:
+		return nil
+	default:
+		return fmt.Errorf("screeningquestion: invalid enum value for deferral_type field: %q", dt)
+	}
+}
+
+// OrderOption defines the ordering options for the ScreeningQuestion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByText orders the results by the text field.
+func ByText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldText, opts...).ToFunc()
+}
+
+// ByAnswerType orders the results by the answer_type field.
+func ByAnswerType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerType, opts...).ToFunc()
+}
+
+// ByRequired orders the results by the required field.
+func ByRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequired, opts...).ToFunc()
+}
+
+// ByDeferralType orders the results by the deferral_type field.
+func ByDeferralType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeferralType, opts...).ToFunc()
+}
+
+// ByDeferralDays orders the results by the deferral_days field.
+func ByDeferralDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeferralDays, opts...).ToFunc()
+}
+
+// ByDeferralReason orders the results by the deferral_reason field.
+func ByDeferralReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeferralReason, opts...).ToFunc()
+}
+
+// ByQuestionnaireField orders the results by questionnaire field.
+func ByQuestionnaireField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionnaireStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newQuestionnaireStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionnaireInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, QuestionnaireTable, QuestionnaireColumn),
+	)
+}
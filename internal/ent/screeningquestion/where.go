@@ -0,0 +1,653 @@
+// Code generated by ent, DO NOT EDIT.
+
+package screeningquestion
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldKey, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldPosition, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldText, v))
+}
+
+// Required applies equality check predicate on the "required" field. It's identical to RequiredEQ.
+func Required(v bool) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldRequired, v))
+}
+
+// DeferralDays applies equality check predicate on the "deferral_days" field. It's identical to DeferralDaysEQ.
+func DeferralDays(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldDeferralDays, v))
+}
+
+// DeferralReason applies equality check predicate on the "deferral_reason" field. It's identical to DeferralReasonEQ.
+func DeferralReason(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldDeferralReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldContainsFold(FieldKey, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLTE(FieldPosition, v))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldContainsFold(FieldText, v))
+}
+
+// AnswerTypeEQ applies the EQ predicate on the "answer_type" field.
+func AnswerTypeEQ(v AnswerType) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldAnswerType, v))
+}
+
+// AnswerTypeNEQ applies the NEQ predicate on the "answer_type" field.
+func AnswerTypeNEQ(v AnswerType) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldAnswerType, v))
+}
+
+// AnswerTypeIn applies the In predicate on the "answer_type" field.
+func AnswerTypeIn(vs ...AnswerType) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldAnswerType, vs...))
+}
+
+// AnswerTypeNotIn applies the NotIn predicate on the "answer_type" field.
+func AnswerTypeNotIn(vs ...AnswerType) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldAnswerType, vs...))
+}
+
+// OptionsIsNil applies the IsNil predicate on the "options" field.
+func OptionsIsNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIsNull(FieldOptions))
+}
+
+// OptionsNotNil applies the NotNil predicate on the "options" field.
+func OptionsNotNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotNull(FieldOptions))
+}
+
+// RequiredEQ applies the EQ predicate on the "required" field.
+func RequiredEQ(v bool) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldRequired, v))
+}
+
+// RequiredNEQ applies the NEQ predicate on the "required" field.
+func RequiredNEQ(v bool) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldRequired, v))
+}
+
+// DeferralAnswersIsNil applies the IsNil predicate on the "deferral_answers" field.
+func DeferralAnswersIsNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIsNull(FieldDeferralAnswers))
+}
+
+// DeferralAnswersNotNil applies the NotNil predicate on the "deferral_answers" field.
+func DeferralAnswersNotNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotNull(FieldDeferralAnswers))
+}
+
+// DeferralTypeEQ applies the EQ predicate on the "deferral_type" field.
+func DeferralTypeEQ(v DeferralType) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldDeferralType, v))
+}
+
+// DeferralTypeNEQ applies the NEQ predicate on the "deferral_type" field.
+func DeferralTypeNEQ(v DeferralType) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldDeferralType, v))
+}
+
+// DeferralTypeIn applies the In predicate on the "deferral_type" field.
+func DeferralTypeIn(vs ...DeferralType) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldDeferralType, vs...))
+}
+
+// DeferralTypeNotIn applies the NotIn predicate on the "deferral_type" field.
+func DeferralTypeNotIn(vs ...DeferralType) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldDeferralType, vs...))
+}
+
+// DeferralTypeIsNil applies the IsNil predicate on the "deferral_type" field.
+func DeferralTypeIsNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIsNull(FieldDeferralType))
+}
+
+// DeferralTypeNotNil applies the NotNil predicate on the "deferral_type" field.
+func DeferralTypeNotNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotNull(FieldDeferralType))
+}
+
+// DeferralDaysEQ applies the EQ predicate on the "deferral_days" field.
+func DeferralDaysEQ(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldDeferralDays, v))
+}
+
+// DeferralDaysNEQ applies the NEQ predicate on the "deferral_days" field.
+func DeferralDaysNEQ(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldDeferralDays, v))
+}
+
+// DeferralDaysIn applies the In predicate on the "deferral_days" field.
+func DeferralDaysIn(vs ...int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldDeferralDays, vs...))
+}
+
+// DeferralDaysNotIn applies the NotIn predicate on the "deferral_days" field.
+func DeferralDaysNotIn(vs ...int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldDeferralDays, vs...))
+}
+
+// DeferralDaysGT applies the GT predicate on the "deferral_days" field.
+func DeferralDaysGT(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGT(FieldDeferralDays, v))
+}
+
+// DeferralDaysGTE applies the GTE predicate on the "deferral_days" field.
+func DeferralDaysGTE(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGTE(FieldDeferralDays, v))
+}
+
+// DeferralDaysLT applies the LT predicate on the "deferral_days" field.
+func DeferralDaysLT(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLT(FieldDeferralDays, v))
+}
+
+// DeferralDaysLTE applies the LTE predicate on the "deferral_days" field.
+func DeferralDaysLTE(v int16) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLTE(FieldDeferralDays, v))
+}
+
+// DeferralDaysIsNil applies the IsNil predicate on the "deferral_days" field.
+func DeferralDaysIsNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIsNull(FieldDeferralDays))
+}
+
+// DeferralDaysNotNil applies the NotNil predicate on the "deferral_days" field.
+func DeferralDaysNotNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotNull(FieldDeferralDays))
+}
+
+// DeferralReasonEQ applies the EQ predicate on the "deferral_reason" field.
+func DeferralReasonEQ(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEQ(FieldDeferralReason, v))
+}
+
+// DeferralReasonNEQ applies the NEQ predicate on the "deferral_reason" field.
+func DeferralReasonNEQ(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNEQ(FieldDeferralReason, v))
+}
+
+// DeferralReasonIn applies the In predicate on the "deferral_reason" field.
+func DeferralReasonIn(vs ...string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIn(FieldDeferralReason, vs...))
+}
+
+// DeferralReasonNotIn applies the NotIn predicate on the "deferral_reason" field.
+func DeferralReasonNotIn(vs ...string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotIn(FieldDeferralReason, vs...))
+}
+
+// DeferralReasonGT applies the GT predicate on the "deferral_reason" field.
+func DeferralReasonGT(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGT(FieldDeferralReason, v))
+}
+
+// DeferralReasonGTE applies the GTE predicate on the "deferral_reason" field.
+func DeferralReasonGTE(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldGTE(FieldDeferralReason, v))
+}
+
+// DeferralReasonLT applies the LT predicate on the "deferral_reason" field.
+func DeferralReasonLT(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLT(FieldDeferralReason, v))
+}
+
+// DeferralReasonLTE applies the LTE predicate on the "deferral_reason" field.
+func DeferralReasonLTE(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldLTE(FieldDeferralReason, v))
+}
+
+// DeferralReasonContains applies the Contains predicate on the "deferral_reason" field.
+func DeferralReasonContains(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldContains(FieldDeferralReason, v))
+}
+
+// DeferralReasonHasPrefix applies the HasPrefix predicate on the "deferral_reason" field.
+func DeferralReasonHasPrefix(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldHasPrefix(FieldDeferralReason, v))
+}
+
+// DeferralReasonHasSuffix applies the HasSuffix predicate on the "deferral_reason" field.
+func DeferralReasonHasSuffix(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldHasSuffix(FieldDeferralReason, v))
+}
+
+// DeferralReasonIsNil applies the IsNil predicate on the "deferral_reason" field.
+func DeferralReasonIsNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldIsNull(FieldDeferralReason))
+}
+
+// DeferralReasonNotNil applies the NotNil predicate on the "deferral_reason" field.
+func DeferralReasonNotNil() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldNotNull(FieldDeferralReason))
+}
+
+// DeferralReasonEqualFold applies the EqualFold predicate on the "deferral_reason" field.
+func DeferralReasonEqualFold(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldEqualFold(FieldDeferralReason, v))
+}
+
+// DeferralReasonContainsFold applies the ContainsFold predicate on the "deferral_reason" field.
+func DeferralReasonContainsFold(v string) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.FieldContainsFold(FieldDeferralReason, v))
+}
+
+// HasQuestionnaire applies the HasEdge predicate on the "questionnaire" edge.
+func HasQuestionnaire() predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuestionnaireTable, QuestionnaireColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuestionnaireWith applies the HasEdge predicate on the "questionnaire" edge with a given conditions (other predicates).
+func HasQuestionnaireWith(preds ...predicate.Questionnaire) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(func(s *sql.Selector) {
+		step := newQuestionnaireStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScreeningQuestion) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScreeningQuestion) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScreeningQuestion) predicate.ScreeningQuestion {
+	return predicate.ScreeningQuestion(sql.NotPredicates(p))
+}
@@ -0,0 +1,467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+)
+
+// ScreeningQuestionCreate is the builder for creating a ScreeningQuestion entity.
+type ScreeningQuestionCreate struct {
+	config
+	mutation *ScreeningQuestionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScreeningQuestionCreate) SetCreatedAt(v int64) *ScreeningQuestionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScreeningQuestionCreate) SetUpdatedAt(v int64) *ScreeningQuestionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ScreeningQuestionCreate) SetNillableUpdatedAt(v *int64) *ScreeningQuestionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ScreeningQuestionCreate) SetDeletedAt(v int64) *ScreeningQuestionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ScreeningQuestionCreate) SetNillableDeletedAt(v *int64) *ScreeningQuestionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *ScreeningQuestionCreate) SetKey(v string) *ScreeningQuestionCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *ScreeningQuestionCreate) SetPosition(v int16) *ScreeningQuestionCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *ScreeningQuestionCreate) SetText(v string) *ScreeningQuestionCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetAnswerType sets the "answer_type" field.
+func (_c *ScreeningQuestionCreate) SetAnswerType(v screeningquestion.AnswerType) *ScreeningQuestionCreate {
+	_c.mutation.SetAnswerType(v)
+	return _c
+}
+
+// SetOptions sets the "options" field.
+func (_c *ScreeningQuestionCreate) SetOptions(v []string) *ScreeningQuestionCreate {
+	_c.mutation.SetOptions(v)
+	return _c
+}
+
+// SetRequired sets the "required" field.
+func (_c *ScreeningQuestionCreate) SetRequired(v bool) *ScreeningQuestionCreate {
+	_c.mutation.SetRequired(v)
+	return _c
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_c *ScreeningQuestionCreate) SetNillableRequired(v *bool) *ScreeningQuestionCreate {
+	if v != nil {
+		_c.SetRequired(*v)
+	}
+	return _c
+}
+
+// SetDeferralAnswers sets the "deferral_answers" field.
+func (_c *ScreeningQuestionCreate) SetDeferralAnswers(v []string) *ScreeningQuestionCreate {
+	_c.mutation.SetDeferralAnswers(v)
+	return _c
+}
+
+// SetDeferralType sets the "deferral_type" field.
+func (_c *ScreeningQuestionCreate) SetDeferralType(v screeningquestion.DeferralType) *ScreeningQuestionCreate {
+	_c.mutation.SetDeferralType(v)
+	return _c
+}
+
+// SetNillableDeferralType sets the "deferral_type" field if the given value is not nil.
+func (_c *ScreeningQuestionCreate) SetNillableDeferralType(v *screeningquestion.DeferralType) *ScreeningQuestionCreate {
+	if v != nil {
+		_c.SetDeferralType(*v)
+	}
+	return _c
+}
+
+// SetDeferralDays sets the "deferral_days" field.
+func (_c *ScreeningQuestionCreate) SetDeferralDays(v int16) *ScreeningQuestionCreate {
+	_c.mutation.SetDeferralDays(v)
+	return _c
+}
+
+// SetNillableDeferralDays sets the "deferral_days" field if the given value is not nil.
+func (_c *ScreeningQuestionCreate) SetNillableDeferralDays(v *int16) *ScreeningQuestionCreate {
+	if v != nil {
+		_c.SetDeferralDays(*v)
+	}
+	return _c
+}
+
+// SetDeferralReason sets the "deferral_reason" field.
+func (_c *ScreeningQuestionCreate) SetDeferralReason(v string) *ScreeningQuestionCreate {
+	_c.mutation.SetDeferralReason(v)
+	return _c
+}
+
+// SetNillableDeferralReason sets the "deferral_reason" field if the given value is not nil.
+func (_c *ScreeningQuestionCreate) SetNillableDeferralReason(v *string) *ScreeningQuestionCreate {
+	if v != nil {
+		_c.SetDeferralReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScreeningQuestionCreate) SetID(v uuid.UUID) *ScreeningQuestionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by ID.
+func (_c *ScreeningQuestionCreate) SetQuestionnaireID(id uuid.UUID) *ScreeningQuestionCreate {
+	_c.mutation.SetQuestionnaireID(id)
+	return _c
+}
+
+// SetQuestionnaire sets the "questionnaire" edge to the Questionnaire entity.
+func (_c *ScreeningQuestionCreate) SetQuestionnaire(v *Questionnaire) *ScreeningQuestionCreate {
+	return _c.SetQuestionnaireID(v.ID)
+}
+
+// Mutation returns the ScreeningQuestionMutation object of the builder.
+func (_c *ScreeningQuestionCreate) Mutation() *ScreeningQuestionMutation {
+	return _c.mutation
+}
+
+// Save creates the ScreeningQuestion in the database.
+func (_c *ScreeningQuestionCreate) Save(ctx context.Context) (*ScreeningQuestion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScreeningQuestionCreate) SaveX(ctx context.Context) *ScreeningQuestion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScreeningQuestionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScreeningQuestionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScreeningQuestionCreate) defaults() {
+	if _, ok := _c.mutation.Required(); !ok {
+		v := screeningquestion.DefaultRequired
+		_c.mutation.SetRequired(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScreeningQuestionCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := screeningquestion.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := screeningquestion.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := screeningquestion.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ScreeningQuestion.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := screeningquestion.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ScreeningQuestion.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := screeningquestion.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.position": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "ScreeningQuestion.text"`)}
+	}
+	if v, ok := _c.mutation.Text(); ok {
+		if err := screeningquestion.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.text": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AnswerType(); !ok {
+		return &ValidationError{Name: "answer_type", err: errors.New(`ent: missing required field "ScreeningQuestion.answer_type"`)}
+	}
+	if v, ok := _c.mutation.AnswerType(); ok {
+		if err := screeningquestion.AnswerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "answer_type", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.answer_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Required(); !ok {
+		return &ValidationError{Name: "required", err: errors.New(`ent: missing required field "ScreeningQuestion.required"`)}
+	}
+	if v, ok := _c.mutation.DeferralType(); ok {
+		if err := screeningquestion.DeferralTypeValidator(v); err != nil {
+			return &ValidationError{Name: "deferral_type", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deferral_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeferralDays(); ok {
+		if err := screeningquestion.DeferralDaysValidator(v); err != nil {
+			return &ValidationError{Name: "deferral_days", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deferral_days": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeferralReason(); ok {
+		if err := screeningquestion.DeferralReasonValidator(v); err != nil {
+			return &ValidationError{Name: "deferral_reason", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deferral_reason": %w`, err)}
+		}
+	}
+	if len(_c.mutation.QuestionnaireIDs()) == 0 {
+		return &ValidationError{Name: "questionnaire", err: errors.New(`ent: missing required edge "ScreeningQuestion.questionnaire"`)}
+	}
+	return nil
+}
+
+func (_c *ScreeningQuestionCreate) sqlSave(ctx context.Context) (*ScreeningQuestion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScreeningQuestionCreate) createSpec() (*ScreeningQuestion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScreeningQuestion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(screeningquestion.Table, sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(screeningquestion.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningquestion.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(screeningquestion.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(screeningquestion.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(screeningquestion.FieldPosition, field.TypeInt16, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(screeningquestion.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.AnswerType(); ok {
+		_spec.SetField(screeningquestion.FieldAnswerType, field.TypeEnum, value)
+		_node.AnswerType = value
+	}
+	if value, ok := _c.mutation.Options(); ok {
+		_spec.SetField(screeningquestion.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := _c.mutation.Required(); ok {
+		_spec.SetField(screeningquestion.FieldRequired, field.TypeBool, value)
+		_node.Required = value
+	}
+	if value, ok := _c.mutation.DeferralAnswers(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralAnswers, field.TypeJSON, value)
+		_node.DeferralAnswers = value
+	}
+	if value, ok := _c.mutation.DeferralType(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralType, field.TypeEnum, value)
+		_node.DeferralType = value
+	}
+	if value, ok := _c.mutation.DeferralDays(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralDays, field.TypeInt16, value)
+		_node.DeferralDays = value
+	}
+	if value, ok := _c.mutation.DeferralReason(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralReason, field.TypeString, value)
+		_node.DeferralReason = value
+	}
+	if nodes := _c.mutation.QuestionnaireIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   screeningquestion.QuestionnaireTable,
+			Columns: []string{screeningquestion.QuestionnaireColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaire.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.questionnaire_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScreeningQuestionCreateBulk is the builder for creating many ScreeningQuestion entities in bulk.
+type ScreeningQuestionCreateBulk struct {
+	config
+	err      error
+	builders []*ScreeningQuestionCreate
+}
+
+// Save creates the ScreeningQuestion entities in the database.
+func (_c *ScreeningQuestionCreateBulk) Save(ctx context.Context) ([]*ScreeningQuestion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScreeningQuestion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScreeningQuestionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScreeningQuestionCreateBulk) SaveX(ctx context.Context) []*ScreeningQuestion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScreeningQuestionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScreeningQuestionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
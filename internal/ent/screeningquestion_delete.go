@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+)
+
+// ScreeningQuestionDelete is the builder for deleting a ScreeningQuestion entity.
+type ScreeningQuestionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScreeningQuestionMutation
+}
+
+// Where appends a list predicates to the ScreeningQuestionDelete builder.
+func (_d *ScreeningQuestionDelete) Where(ps ...predicate.ScreeningQuestion) *ScreeningQuestionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ScreeningQuestionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ScreeningQuestionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ScreeningQuestionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(screeningquestion.Table, sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ScreeningQuestionDeleteOne is the builder for deleting a single ScreeningQuestion entity.
+type ScreeningQuestionDeleteOne struct {
+	_d *ScreeningQuestionDelete
+}
+
+// Where appends a list predicates to the ScreeningQuestionDelete builder.
+func (_d *ScreeningQuestionDeleteOne) Where(ps ...predicate.ScreeningQuestion) *ScreeningQuestionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ScreeningQuestionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{screeningquestion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ScreeningQuestionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1010 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+)
+
+// ScreeningQuestionUpdate is the builder for updating ScreeningQuestion entities.
+type ScreeningQuestionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScreeningQuestionMutation
+}
+
+// Where appends a list predicates to the ScreeningQuestionUpdate builder.
+func (_u *ScreeningQuestionUpdate) Where(ps ...predicate.ScreeningQuestion) *ScreeningQuestionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScreeningQuestionUpdate) SetUpdatedAt(v int64) *ScreeningQuestionUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *ScreeningQuestionUpdate) AddUpdatedAt(v int64) *ScreeningQuestionUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ScreeningQuestionUpdate) ClearUpdatedAt() *ScreeningQuestionUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ScreeningQuestionUpdate) SetDeletedAt(v int64) *ScreeningQuestionUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdate) SetNillableDeletedAt(v *int64) *ScreeningQuestionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *ScreeningQuestionUpdate) AddDeletedAt(v int64) *ScreeningQuestionUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ScreeningQuestionUpdate) ClearDeletedAt() *ScreeningQuestionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *ScreeningQuestionUpdate) SetKey(v string) *ScreeningQuestionUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdate) SetNillableKey(v *string) *ScreeningQuestionUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *ScreeningQuestionUpdate) SetPosition(v int16) *ScreeningQuestionUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdate) SetNillablePosition(v *int16) *ScreeningQuestionUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *ScreeningQuestionUpdate) AddPosition(v int16) *ScreeningQuestionUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *ScreeningQuestionUpdate) SetText(v string) *ScreeningQuestionUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdate) SetNillableText(v *string) *ScreeningQuestionUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetAnswerType sets the "answer_type" field.
+func (_u *ScreeningQuestionUpdate) SetAnswerType(v screeningquestion.AnswerType) *ScreeningQuestionUpdate {
+	_u.mutation.SetAnswerType(v)
+	return _u
+}
+
+// SetNillableAnswerType sets the "answer_type" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdate) SetNillableAnswerType(v *screeningquestion.AnswerType) *ScreeningQuestionUpdate {
+	if v != nil {
+		_u.SetAnswerType(*v)
+	}
+	return _u
+}
+
+// SetOptions sets the "options" field.
+func (_u *ScreeningQuestionUpdate) SetOptions(v []string) *ScreeningQuestionUpdate {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// AppendOptions appends value to the "options" field.
+func (_u *ScreeningQuestionUpdate) AppendOptions(v []string) *ScreeningQuestionUpdate {
+	_u.mutation.AppendOptions(v)
+	return _u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (_u *ScreeningQuestionUpdate) ClearOptions() *ScreeningQuestionUpdate {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// SetRequired sets the "required" field.
+func (_u *ScreeningQuestionUpdate) SetRequired(v bool) *ScreeningQuestionUpdate {
+	_u.mutation.SetRequired(v)
+	return _u
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdate) SetNillableRequired(v *bool) *ScreeningQuestionUpdate {
+	if v != nil {
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// SetDeferralAnswers sets the "deferral_answers" field.
+func (_u *ScreeningQuestionUpdate) SetDeferralAnswers(v []string) *ScreeningQuestionUpdate {
+	_u.mutation.SetDeferralAnswers(v)
+	return _u
+}
+
+// AppendDeferralAnswers appends value to the "deferral_answers" field.
+func (_u *ScreeningQuestionUpdate) AppendDeferralAnswers(v []string) *ScreeningQuestionUpdate {
+	_u.mutation.AppendDeferralAnswers(v)
+	return _u
+}
+
+// ClearDeferralAnswers clears the value of the "deferral_answers" field.
+func (_u *ScreeningQuestionUpdate) ClearDeferralAnswers() *ScreeningQuestionUpdate {
+	_u.mutation.ClearDeferralAnswers()
+	return _u
+}
+
+// SetDeferralType sets the "deferral_type" field.
+func (_u *ScreeningQuestionUpdate) SetDeferralType(v screeningquestion.DeferralType) *ScreeningQuestionUpdate {
+	_u.mutation.SetDeferralType(v)
+	return _u
+}
+
+// SetNillableDeferralType sets the "deferral_type" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdate) SetNillableDeferralType(v *screeningquestion.DeferralType) *ScreeningQuestionUpdate {
+	if v != nil {
+		_u.SetDeferralType(*v)
+	}
+	return _u
+}
+
+// ClearDeferralType clears the value of the "deferral_type" field.
+func (_u *ScreeningQuestionUpdate) ClearDeferralType() *ScreeningQuestionUpdate {
+	_u.mutation.ClearDeferralType()
+	return _u
+}
+
+// SetDeferralDays sets the "deferral_days" field.
+func (_u *ScreeningQuestionUpdate) SetDeferralDays(v int16) *ScreeningQuestionUpdate {
+	_u.mutation.ResetDeferralDays()
+	_u.mutation.SetDeferralDays(v)
+	return _u
+}
+
+// SetNillableDeferralDays sets the "deferral_days" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdate) SetNillableDeferralDays(v *int16) *ScreeningQuestionUpdate {
+	if v != nil {
+		_u.SetDeferralDays(*v)
+	}
+	return _u
+}
+
+// AddDeferralDays adds value to the "deferral_days" field.
+func (_u *ScreeningQuestionUpdate) AddDeferralDays(v int16) *ScreeningQuestionUpdate {
+	_u.mutation.AddDeferralDays(v)
+	return _u
+}
+
+// ClearDeferralDays clears the value of the "deferral_days" field.
+func (_u *ScreeningQuestionUpdate) ClearDeferralDays() *ScreeningQuestionUpdate {
+	_u.mutation.ClearDeferralDays()
+	return _u
+}
+
+// SetDeferralReason sets the "deferral_reason" field.
+func (_u *ScreeningQuestionUpdate) SetDeferralReason(v string) *ScreeningQuestionUpdate {
+	_u.mutation.SetDeferralReason(v)
+	return _u
+}
+
+// SetNillableDeferralReason sets the "deferral_reason" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdate) SetNillableDeferralReason(v *string) *ScreeningQuestionUpdate {
+	if v != nil {
+		_u.SetDeferralReason(*v)
+	}
+	return _u
+}
+
+// ClearDeferralReason clears the value of the "deferral_reason" field.
+func (_u *ScreeningQuestionUpdate) ClearDeferralReason() *ScreeningQuestionUpdate {
+	_u.mutation.ClearDeferralReason()
+	return _u
+}
+
+// SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by ID.
+func (_u *ScreeningQuestionUpdate) SetQuestionnaireID(id uuid.UUID) *ScreeningQuestionUpdate {
+	_u.mutation.SetQuestionnaireID(id)
+	return _u
+}
+
+// SetQuestionnaire sets the "questionnaire" edge to the Questionnaire entity.
+func (_u *ScreeningQuestionUpdate) SetQuestionnaire(v *Questionnaire) *ScreeningQuestionUpdate {
+	return _u.SetQuestionnaireID(v.ID)
+}
+
+// Mutation returns the ScreeningQuestionMutation object of the builder.
+func (_u *ScreeningQuestionUpdate) Mutation() *ScreeningQuestionMutation {
+	return _u.mutation
+}
+
+// ClearQuestionnaire clears the "questionnaire" edge to the Questionnaire entity.
+func (_u *ScreeningQuestionUpdate) ClearQuestionnaire() *ScreeningQuestionUpdate {
+	_u.mutation.ClearQuestionnaire()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScreeningQuestionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScreeningQuestionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScreeningQuestionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScreeningQuestionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScreeningQuestionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := screeningquestion.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScreeningQuestionUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := screeningquestion.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := screeningquestion.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := screeningquestion.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := screeningquestion.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Text(); ok {
+		if err := screeningquestion.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.text": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AnswerType(); ok {
+		if err := screeningquestion.AnswerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "answer_type", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.answer_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeferralType(); ok {
+		if err := screeningquestion.DeferralTypeValidator(v); err != nil {
+			return &ValidationError{Name: "deferral_type", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deferral_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeferralDays(); ok {
+		if err := screeningquestion.DeferralDaysValidator(v); err != nil {
+			return &ValidationError{Name: "deferral_days", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deferral_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeferralReason(); ok {
+		if err := screeningquestion.DeferralReasonValidator(v); err != nil {
+			return &ValidationError{Name: "deferral_reason", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deferral_reason": %w`, err)}
+		}
+	}
+	if _u.mutation.QuestionnaireCleared() && len(_u.mutation.QuestionnaireIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScreeningQuestion.questionnaire"`)
+	}
+	return nil
+}
+
+func (_u *ScreeningQuestionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(screeningquestion.Table, screeningquestion.Columns, sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningquestion.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(screeningquestion.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(screeningquestion.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(screeningquestion.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(screeningquestion.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(screeningquestion.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(screeningquestion.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(screeningquestion.FieldPosition, field.TypeInt16, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(screeningquestion.FieldPosition, field.TypeInt16, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(screeningquestion.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AnswerType(); ok {
+		_spec.SetField(screeningquestion.FieldAnswerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(screeningquestion.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, screeningquestion.FieldOptions, value)
+		})
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(screeningquestion.FieldOptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(screeningquestion.FieldRequired, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeferralAnswers(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralAnswers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDeferralAnswers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, screeningquestion.FieldDeferralAnswers, value)
+		})
+	}
+	if _u.mutation.DeferralAnswersCleared() {
+		_spec.ClearField(screeningquestion.FieldDeferralAnswers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeferralType(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralType, field.TypeEnum, value)
+	}
+	if _u.mutation.DeferralTypeCleared() {
+		_spec.ClearField(screeningquestion.FieldDeferralType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.DeferralDays(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralDays, field.TypeInt16, value)
+	}
+	if value, ok := _u.mutation.AddedDeferralDays(); ok {
+		_spec.AddField(screeningquestion.FieldDeferralDays, field.TypeInt16, value)
+	}
+	if _u.mutation.DeferralDaysCleared() {
+		_spec.ClearField(screeningquestion.FieldDeferralDays, field.TypeInt16)
+	}
+	if value, ok := _u.mutation.DeferralReason(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralReason, field.TypeString, value)
+	}
+	if _u.mutation.DeferralReasonCleared() {
+		_spec.ClearField(screeningquestion.FieldDeferralReason, field.TypeString)
+	}
+	if _u.mutation.QuestionnaireCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   screeningquestion.QuestionnaireTable,
+			Columns: []string{screeningquestion.QuestionnaireColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaire.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionnaireIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   screeningquestion.QuestionnaireTable,
+			Columns: []string{screeningquestion.QuestionnaireColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaire.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{screeningquestion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScreeningQuestionUpdateOne is the builder for updating a single ScreeningQuestion entity.
+type ScreeningQuestionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScreeningQuestionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScreeningQuestionUpdateOne) SetUpdatedAt(v int64) *ScreeningQuestionUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *ScreeningQuestionUpdateOne) AddUpdatedAt(v int64) *ScreeningQuestionUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ScreeningQuestionUpdateOne) ClearUpdatedAt() *ScreeningQuestionUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ScreeningQuestionUpdateOne) SetDeletedAt(v int64) *ScreeningQuestionUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdateOne) SetNillableDeletedAt(v *int64) *ScreeningQuestionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *ScreeningQuestionUpdateOne) AddDeletedAt(v int64) *ScreeningQuestionUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ScreeningQuestionUpdateOne) ClearDeletedAt() *ScreeningQuestionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *ScreeningQuestionUpdateOne) SetKey(v string) *ScreeningQuestionUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdateOne) SetNillableKey(v *string) *ScreeningQuestionUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *ScreeningQuestionUpdateOne) SetPosition(v int16) *ScreeningQuestionUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdateOne) SetNillablePosition(v *int16) *ScreeningQuestionUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *ScreeningQuestionUpdateOne) AddPosition(v int16) *ScreeningQuestionUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *ScreeningQuestionUpdateOne) SetText(v string) *ScreeningQuestionUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdateOne) SetNillableText(v *string) *ScreeningQuestionUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetAnswerType sets the "answer_type" field.
+func (_u *ScreeningQuestionUpdateOne) SetAnswerType(v screeningquestion.AnswerType) *ScreeningQuestionUpdateOne {
+	_u.mutation.SetAnswerType(v)
+	return _u
+}
+
+// SetNillableAnswerType sets the "answer_type" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdateOne) SetNillableAnswerType(v *screeningquestion.AnswerType) *ScreeningQuestionUpdateOne {
+	if v != nil {
+		_u.SetAnswerType(*v)
+	}
+	return _u
+}
+
+// SetOptions sets the "options" field.
+func (_u *ScreeningQuestionUpdateOne) SetOptions(v []string) *ScreeningQuestionUpdateOne {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// AppendOptions appends value to the "options" field.
+func (_u *ScreeningQuestionUpdateOne) AppendOptions(v []string) *ScreeningQuestionUpdateOne {
+	_u.mutation.AppendOptions(v)
+	return _u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (_u *ScreeningQuestionUpdateOne) ClearOptions() *ScreeningQuestionUpdateOne {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// SetRequired sets the "required" field.
+func (_u *ScreeningQuestionUpdateOne) SetRequired(v bool) *ScreeningQuestionUpdateOne {
+	_u.mutation.SetRequired(v)
+	return _u
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdateOne) SetNillableRequired(v *bool) *ScreeningQuestionUpdateOne {
+	if v != nil {
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// SetDeferralAnswers sets the "deferral_answers" field.
+func (_u *ScreeningQuestionUpdateOne) SetDeferralAnswers(v []string) *ScreeningQuestionUpdateOne {
+	_u.mutation.SetDeferralAnswers(v)
+	return _u
+}
+
+// AppendDeferralAnswers appends value to the "deferral_answers" field.
+func (_u *ScreeningQuestionUpdateOne) AppendDeferralAnswers(v []string) *ScreeningQuestionUpdateOne {
+	_u.mutation.AppendDeferralAnswers(v)
+	return _u
+}
+
+// ClearDeferralAnswers clears the value of the "deferral_answers" field.
+func (_u *ScreeningQuestionUpdateOne) ClearDeferralAnswers() *ScreeningQuestionUpdateOne {
+	_u.mutation.ClearDeferralAnswers()
+	return _u
+}
+
+// SetDeferralType sets the "deferral_type" field.
+func (_u *ScreeningQuestionUpdateOne) SetDeferralType(v screeningquestion.DeferralType) *ScreeningQuestionUpdateOne {
+	_u.mutation.SetDeferralType(v)
+	return _u
+}
+
+// SetNillableDeferralType sets the "deferral_type" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdateOne) SetNillableDeferralType(v *screeningquestion.DeferralType) *ScreeningQuestionUpdateOne {
+	if v != nil {
+		_u.SetDeferralType(*v)
+	}
+	return _u
+}
+
+// ClearDeferralType clears the value of the "deferral_type" field.
+func (_u *ScreeningQuestionUpdateOne) ClearDeferralType() *ScreeningQuestionUpdateOne {
+	_u.mutation.ClearDeferralType()
+	return _u
+}
+
+// SetDeferralDays sets the "deferral_days" field.
+func (_u *ScreeningQuestionUpdateOne) SetDeferralDays(v int16) *ScreeningQuestionUpdateOne {
+	_u.mutation.ResetDeferralDays()
+	_u.mutation.SetDeferralDays(v)
+	return _u
+}
+
+// SetNillableDeferralDays sets the "deferral_days" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdateOne) SetNillableDeferralDays(v *int16) *ScreeningQuestionUpdateOne {
+	if v != nil {
+		_u.SetDeferralDays(*v)
+	}
+	return _u
+}
+
+// AddDeferralDays adds value to the "deferral_days" field.
+func (_u *ScreeningQuestionUpdateOne) AddDeferralDays(v int16) *ScreeningQuestionUpdateOne {
+	_u.mutation.AddDeferralDays(v)
+	return _u
+}
+
+// ClearDeferralDays clears the value of the "deferral_days" field.
+func (_u *ScreeningQuestionUpdateOne) ClearDeferralDays() *ScreeningQuestionUpdateOne {
+	_u.mutation.ClearDeferralDays()
+	return _u
+}
+
+// SetDeferralReason sets the "deferral_reason" field.
+func (_u *ScreeningQuestionUpdateOne) SetDeferralReason(v string) *ScreeningQuestionUpdateOne {
+	_u.mutation.SetDeferralReason(v)
+	return _u
+}
+
+// SetNillableDeferralReason sets the "deferral_reason" field if the given value is not nil.
+func (_u *ScreeningQuestionUpdateOne) SetNillableDeferralReason(v *string) *ScreeningQuestionUpdateOne {
+	if v != nil {
+		_u.SetDeferralReason(*v)
+	}
+	return _u
+}
+
+// ClearDeferralReason clears the value of the "deferral_reason" field.
+func (_u *ScreeningQuestionUpdateOne) ClearDeferralReason() *ScreeningQuestionUpdateOne {
+	_u.mutation.ClearDeferralReason()
+	return _u
+}
+
+// SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by ID.
+func (_u *ScreeningQuestionUpdateOne) SetQuestionnaireID(id uuid.UUID) *ScreeningQuestionUpdateOne {
+	_u.mutation.SetQuestionnaireID(id)
+	return _u
+}
+
+// SetQuestionnaire sets the "questionnaire" edge to the Questionnaire entity.
+func (_u *ScreeningQuestionUpdateOne) SetQuestionnaire(v *Questionnaire) *ScreeningQuestionUpdateOne {
+	return _u.SetQuestionnaireID(v.ID)
+}
+
+// Mutation returns the ScreeningQuestionMutation object of the builder.
+func (_u *ScreeningQuestionUpdateOne) Mutation() *ScreeningQuestionMutation {
+	return _u.mutation
+}
+
+// ClearQuestionnaire clears the "questionnaire" edge to the Questionnaire entity.
+func (_u *ScreeningQuestionUpdateOne) ClearQuestionnaire() *ScreeningQuestionUpdateOne {
+	_u.mutation.ClearQuestionnaire()
+	return _u
+}
+
+// Where appends a list predicates to the ScreeningQuestionUpdate builder.
+func (_u *ScreeningQuestionUpdateOne) Where(ps ...predicate.ScreeningQuestion) *ScreeningQuestionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScreeningQuestionUpdateOne) Select(field string, fields ...string) *ScreeningQuestionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScreeningQuestion entity.
+func (_u *ScreeningQuestionUpdateOne) Save(ctx context.Context) (*ScreeningQuestion, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScreeningQuestionUpdateOne) SaveX(ctx context.Context) *ScreeningQuestion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScreeningQuestionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScreeningQuestionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScreeningQuestionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := screeningquestion.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScreeningQuestionUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := screeningquestion.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := screeningquestion.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deleted_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := screeningquestion.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := screeningquestion.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Text(); ok {
+		if err := screeningquestion.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.text": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AnswerType(); ok {
+		if err := screeningquestion.AnswerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "answer_type", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.answer_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeferralType(); ok {
+		if err := screeningquestion.DeferralTypeValidator(v); err != nil {
+			return &ValidationError{Name: "deferral_type", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deferral_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeferralDays(); ok {
+		if err := screeningquestion.DeferralDaysValidator(v); err != nil {
+			return &ValidationError{Name: "deferral_days", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deferral_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeferralReason(); ok {
+		if err := screeningquestion.DeferralReasonValidator(v); err != nil {
+			return &ValidationError{Name: "deferral_reason", err: fmt.Errorf(`ent: validator failed for field "ScreeningQuestion.deferral_reason": %w`, err)}
+		}
+	}
+	if _u.mutation.QuestionnaireCleared() && len(_u.mutation.QuestionnaireIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScreeningQuestion.questionnaire"`)
+	}
+	return nil
+}
+
+func (_u *ScreeningQuestionUpdateOne) sqlSave(ctx context.Context) (_node *ScreeningQuestion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(screeningquestion.Table, screeningquestion.Columns, sqlgraph.NewFieldSpec(screeningquestion.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScreeningQuestion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, screeningquestion.FieldID)
+		for _, f := range fields {
+			if !screeningquestion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != screeningquestion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningquestion.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(screeningquestion.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(screeningquestion.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(screeningquestion.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(screeningquestion.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(screeningquestion.FieldDeletedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(screeningquestion.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(screeningquestion.FieldPosition, field.TypeInt16, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(screeningquestion.FieldPosition, field.TypeInt16, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(screeningquestion.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AnswerType(); ok {
+		_spec.SetField(screeningquestion.FieldAnswerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(screeningquestion.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, screeningquestion.FieldOptions, value)
+		})
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(screeningquestion.FieldOptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(screeningquestion.FieldRequired, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeferralAnswers(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralAnswers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDeferralAnswers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, screeningquestion.FieldDeferralAnswers, value)
+		})
+	}
+	if _u.mutation.DeferralAnswersCleared() {
+		_spec.ClearField(screeningquestion.FieldDeferralAnswers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeferralType(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralType, field.TypeEnum, value)
+	}
+	if _u.mutation.DeferralTypeCleared() {
+		_spec.ClearField(screeningquestion.FieldDeferralType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.DeferralDays(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralDays, field.TypeInt16, value)
+	}
+	if value, ok := _u.mutation.AddedDeferralDays(); ok {
+		_spec.AddField(screeningquestion.FieldDeferralDays, field.TypeInt16, value)
+	}
+	if _u.mutation.DeferralDaysCleared() {
+		_spec.ClearField(screeningquestion.FieldDeferralDays, field.TypeInt16)
+	}
+	if value, ok := _u.mutation.DeferralReason(); ok {
+		_spec.SetField(screeningquestion.FieldDeferralReason, field.TypeString, value)
+	}
+	if _u.mutation.DeferralReasonCleared() {
+		_spec.ClearField(screeningquestion.FieldDeferralReason, field.TypeString)
+	}
+	if _u.mutation.QuestionnaireCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   screeningquestion.QuestionnaireTable,
+			Columns: []string{screeningquestion.QuestionnaireColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaire.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionnaireIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   screeningquestion.QuestionnaireTable,
+			Columns: []string{screeningquestion.QuestionnaireColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaire.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScreeningQuestion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{screeningquestion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
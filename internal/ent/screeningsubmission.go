@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+)
+
+// ScreeningSubmission is the model entity for the ScreeningSubmission schema.
+type ScreeningSubmission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Answers keyed by question key.
+	Answers map[string]string `json:"answers"`
+	// Whether any answer triggered a deferral.
+	Deferred bool `json:"deferred"`
+	// Time the donor signed the declaration in milliseconds.
+	SignedAt int64 `json:"signed_at"`
+	// SHA256 of the signed payload.
+	PayloadHash string `json:"payload_hash"`
+	// Ed25519 signature of the payload hash by the server key.
+	Signature string `json:"signature"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScreeningSubmissionQuery when eager-loading is set.
+	Edges            ScreeningSubmissionEdges `json:"edges"`
+	account_id       *uuid.UUID
+	questionnaire_id *uuid.UUID
+	appointment_id   *uuid.UUID
+	selectValues     sql.SelectValues
+}
+
+// ScreeningSubmissionEdges holds the relations/edges for other nodes in the graph.
+type ScreeningSubmissionEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Questionnaire holds the value of the questionnaire edge.
+	Questionnaire *Questionnaire `json:"questionnaire,omitempty"`
+	// Appointment holds the value of the appointment edge.
+	Appointment *Appointment `json:"appointment,omitempty"`
+	// Deferrals holds the value of the deferrals edge.
+	Deferrals []*Deferral `json:"deferrals,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScreeningSubmissionEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// QuestionnaireOrErr returns the Questionnaire value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScreeningSubmissionEdges) QuestionnaireOrErr() (*Questionnaire, error) {
+	if e.Questionnaire != nil {
+		return e.Questionnaire, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: questionnaire.Label}
+	}
+	return nil, &NotLoadedError{edge: "questionnaire"}
+}
+
+// AppointmentOrErr returns the Appointment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScreeningSubmissionEdges) AppointmentOrErr() (*Appointment, error) {
+	if e.Appointment != nil {
+		return e.Appointment, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: appointment.Label}
+	}
+	return nil, &NotLoadedError{edge: "appointment"}
+}
+
+// DeferralsOrErr returns the Deferrals value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScreeningSubmissionEdges) DeferralsOrErr() ([]*Deferral, error) {
+	if e.loadedTypes[3] {
+		return e.Deferrals, nil
+	}
+	return nil, &NotLoadedError{edge: "deferrals"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScreeningSubmission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case screeningsubmission.FieldAnswers:
+			values[i] = new([]byte)
+		case screeningsubmission.FieldDeferred:
+			values[i] = new(sql.NullBool)
+		case screeningsubmission.FieldCreatedAt, screeningsubmission.FieldUpdatedAt, screeningsubmission.FieldDeletedAt, screeningsubmission.FieldSignedAt:
+			values[i] = new(sql.NullInt64)
+		case screeningsubmission.FieldPayloadHash, screeningsubmission.FieldSignature:
+			values[i] = new(sql.NullString)
+		case screeningsubmission.FieldID:
+			values[i] = new(uuid.UUID)
+		case screeningsubmission.ForeignKeys[0]: // account_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case screeningsubmission.ForeignKeys[1]: // questionnaire_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case screeningsubmission.ForeignKeys[2]: // appointment_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScreeningSubmission fields.
+func (_m *ScreeningSubmission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case screeningsubmission.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case screeningsubmission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case screeningsubmission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case screeningsubmission.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case screeningsubmission.FieldAnswers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field answers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Answers); err != nil {
+					return fmt.Errorf("unmarshal field answers: %w", err)
+				}
+			}
+		case screeningsubmission.FieldDeferred:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field deferred", values[i])
+			} else if value.Valid {
+				_m.Deferred = value.Bool
+			}
+		case screeningsubmission.FieldSignedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field signed_at", values[i])
+			} else if value.Valid {
+				_m.SignedAt = value.Int64
+			}
+		case screeningsubmission.FieldPayloadHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload_hash", values[i])
+			} else if value.Valid {
+				_m.PayloadHash = value.String
+			}
+		case screeningsubmission.FieldSignature:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signature", values[i])
+			} else if value.Valid {
+				_m.Signature = value.String
+			}
+		case screeningsubmission.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.account_id = new(uuid.UUID)
+				*_m.account_id = *value.S.(*uuid.UUID)
+			}
+		case screeningsubmission.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field questionnaire_id", values[i])
+			} else if value.Valid {
+				_m.questionnaire_id = new(uuid.UUID)
+				*_m.questionnaire_id = *value.S.(*uuid.UUID)
+			}
+		case screeningsubmission.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field appointment_id", values[i])
+			} else if value.Valid {
+				_m.appointment_id = new(uuid.UUID)
+				*_m.appointment_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScreeningSubmission.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScreeningSubmission) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the ScreeningSubmission entity.
+func (_m *ScreeningSubmission) QueryAccount() *AccountQuery {
+	return NewScreeningSubmissionClient(_m.config).QueryAccount(_m)
+}
+
+// QueryQuestionnaire queries the "questionnaire" edge of the ScreeningSubmission entity.
+func (_m *ScreeningSubmission) QueryQuestionnaire() *QuestionnaireQuery {
+	return NewScreeningSubmissionClient(_m.config).QueryQuestionnaire(_m)
+}
+
+// QueryAppointment queries the "appointment" edge of the ScreeningSubmission entity.
+func (_m *ScreeningSubmission) QueryAppointment() *AppointmentQuery {
+	return NewScreeningSubmissionClient(_m.config).QueryAppointment(_m)
+}
+
+// QueryDeferrals queries the "deferrals" edge of the ScreeningSubmission entity.
+func (_m *ScreeningSubmission) QueryDeferrals() *DeferralQuery {
+	return NewScreeningSubmissionClient(_m.config).QueryDeferrals(_m)
+}
+
+// Update returns a builder for updating this ScreeningSubmission.
+// Note that you need to call ScreeningSubmission.Unwrap() before calling this method if this ScreeningSubmission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScreeningSubmission) Update() *ScreeningSubmissionUpdateOne {
+	return NewScreeningSubmissionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScreeningSubmission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScreeningSubmission) Unwrap() *ScreeningSubmission {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScreeningSubmission is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScreeningSubmission) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScreeningSubmission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("answers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Answers))
+	builder.WriteString(", ")
+	builder.WriteString("deferred=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Deferred))
+	builder.WriteString(", ")
+	builder.WriteString("signed_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SignedAt))
+	builder.WriteString(", ")
+	builder.WriteString("payload_hash=")
+	builder.WriteString(_m.PayloadHash)
+	builder.WriteString(", ")
+	builder.WriteString("signature=")
+	builder.WriteString(_m.Signature)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScreeningSubmissions is a parsable slice of ScreeningSubmission.
+type ScreeningSubmissions []*ScreeningSubmission
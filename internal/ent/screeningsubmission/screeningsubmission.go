@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package screeningsubmission
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the screeningsubmission type in the database.
+	Label = "screening_submission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldAnswers holds the string denoting the answers field in the database.
+	FieldAnswers = "answers"
+	// FieldDeferred holds the string denoting the deferred field in the database.
+	FieldDeferred = "deferred"
+	// FieldSignedAt holds the string denoting the signed_at field in the database.
+	FieldSignedAt = "signed_at"
+	// FieldPayloadHash holds the string denoting the payload_hash field in the database.
+	FieldPayloadHash = "payload_hash"
+	// FieldSignature holds the string denoting the signature field in the database.
+	FieldSignature = "signature"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeQuestionnaire holds the string denoting the questionnaire edge name in mutations.
+	EdgeQuestionnaire = "questionnaire"
+	// EdgeAppointment holds the string denoting the appointment edge name in mutations.
+	EdgeAppointment = "appointment"
+	// EdgeDeferrals holds the string denoting the deferrals edge name in mutations.
+	EdgeDeferrals = "deferrals"
+	// Table holds the table name of the screeningsubmission in the database.
+	Table = "screening_submissions"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "screening_submissions"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// QuestionnaireTable is the table that holds the questionnaire relation/edge.
+	QuestionnaireTable = "screening_submissions"
+	// QuestionnaireInverseTable is the table name for the Questionnaire entity.
+	// It exists in this package in order to avoid circular dependency with the "questionnaire" package.
+	QuestionnaireInverseTable = "questionnaires"
+	// QuestionnaireColumn is the table column denoting the questionnaire relation/edge.
+	QuestionnaireColumn = "questionnaire_id"
+	// AppointmentTable is the table that holds the appointment relation/edge.
+	AppointmentTable = "screening_submissions"
+	// AppointmentInverseTable is the table name for the Appointment entity.
+	// It exists in this package in order to avoid circular dependency with the "appointment" package.
+	AppointmentInverseTable = "appointments"
+	// AppointmentColumn is the table column denoting the appointment relation/edge.
+	AppointmentColumn = "appointment_id"
+	// DeferralsTable is the table that holds the deferrals relation/edge.
+	DeferralsTable = "deferrals"
+	// DeferralsInverseTable is the table name for the Deferral entity.
+	// It exists in this package in order to avoid circular dependency with the "deferral" package.
+	DeferralsInverseTable = "deferrals"
+	// DeferralsColumn is the table column denoting the deferrals relation/edge.
+	DeferralsColumn = "screening_submission_id"
+)
+
+// Columns holds all SQL columns for screeningsubmission fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldAnswers,
+	FieldDeferred,
+	FieldSignedAt,
+	FieldPayloadHash,
+	FieldSignature,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "screening_submissions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"account_id",
+	"questionnaire_id",
+	"appointment_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// DefaultDeferred holds the default value on creation for the "deferred" field.
+	DefaultDeferred bool
+	// SignedAtValidator is a validator for the "signed_at" field. It is called by the builders before save.
+	SignedAtValidator func(int64) error
+	// PayloadHashValidator is a validator for the "payload_hash" field. It is called by the builders before save.
+	PayloadHashValidator func(string) error
+	// SignatureValidator is a validator for the "signature" field. It is called by the builders before save.
+	SignatureValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ScreeningSubmission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDeferred orders the results by the deferred field.
+func ByDeferred(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeferred, opts...).ToFunc()
+}
+
+// BySignedAt orders the results by the signed_at field.
+func BySignedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignedAt, opts...).ToFunc()
+}
+
+// ByPayloadHash orders the results by the payload_hash field.
+func ByPayloadHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayloadHash, opts...).ToFunc()
+}
+
+// BySignature orders the results by the signature field.
+func BySignature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignature, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByQuestionnaireField orders the results by questionnaire field.
+func ByQuestionnaireField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionnaireStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAppointmentField orders the results by appointment field.
+func ByAppointmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAppointmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDeferralsCount orders the results by deferrals count.
+func ByDeferralsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeferralsStep(), opts...)
+	}
+}
+
+// ByDeferrals orders the results by deferrals terms.
+func ByDeferrals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeferralsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+	)
+}
+func newQuestionnaireStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionnaireInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, QuestionnaireTable, QuestionnaireColumn),
+	)
+}
+func newAppointmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AppointmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AppointmentTable, AppointmentColumn),
+	)
+}
+func newDeferralsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeferralsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeferralsTable, DeferralsColumn),
+	)
+}
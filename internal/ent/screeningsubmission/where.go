@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package screeningsubmission
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Deferred applies equality check predicate on the "deferred" field. It's identical to DeferredEQ.
+func Deferred(v bool) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldDeferred, v))
+}
+
+// SignedAt applies equality check predicate on the "signed_at" field. It's identical to SignedAtEQ.
+func SignedAt(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldSignedAt, v))
+}
+
+// PayloadHash applies equality check predicate on the "payload_hash" field. It's identical to PayloadHashEQ.
+func PayloadHash(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldPayloadHash, v))
+}
+
+// Signature applies equality check predicate on the "signature" field. It's identical to SignatureEQ.
+func Signature(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldSignature, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeferredEQ applies the EQ predicate on the "deferred" field.
+func DeferredEQ(v bool) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldDeferred, v))
+}
+
+// DeferredNEQ applies the NEQ predicate on the "deferred" field.
+func DeferredNEQ(v bool) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNEQ(FieldDeferred, v))
+}
+
+// SignedAtEQ applies the EQ predicate on the "signed_at" field.
+func SignedAtEQ(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldSignedAt, v))
+}
+
+// SignedAtNEQ applies the NEQ predicate on the "signed_at" field.
+func SignedAtNEQ(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNEQ(FieldSignedAt, v))
+}
+
+// SignedAtIn applies the In predicate on the "signed_at" field.
+func SignedAtIn(vs ...int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldIn(FieldSignedAt, vs...))
+}
+
+// SignedAtNotIn applies the NotIn predicate on the "signed_at" field.
+func SignedAtNotIn(vs ...int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNotIn(FieldSignedAt, vs...))
+}
+
+// SignedAtGT applies the GT predicate on the "signed_at" field.
+func SignedAtGT(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGT(FieldSignedAt, v))
+}
+
+// SignedAtGTE applies the GTE predicate on the "signed_at" field.
+func SignedAtGTE(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGTE(FieldSignedAt, v))
+}
+
+// SignedAtLT applies the LT predicate on the "signed_at" field.
+func SignedAtLT(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLT(FieldSignedAt, v))
+}
+
+// SignedAtLTE applies the LTE predicate on the "signed_at" field.
+func SignedAtLTE(v int64) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLTE(FieldSignedAt, v))
+}
+
+// PayloadHashEQ applies the EQ predicate on the "payload_hash" field.
+func PayloadHashEQ(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldPayloadHash, v))
+}
+
+// PayloadHashNEQ applies the NEQ predicate on the "payload_hash" field.
+func PayloadHashNEQ(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNEQ(FieldPayloadHash, v))
+}
+
+// PayloadHashIn applies the In predicate on the "payload_hash" field.
+func PayloadHashIn(vs ...string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldIn(FieldPayloadHash, vs...))
+}
+
+// PayloadHashNotIn applies the NotIn predicate on the "payload_hash" field.
+func PayloadHashNotIn(vs ...string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNotIn(FieldPayloadHash, vs...))
+}
+
+// PayloadHashGT applies the GT predicate on the "payload_hash" field.
+func PayloadHashGT(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGT(FieldPayloadHash, v))
+}
+
+// PayloadHashGTE applies the GTE predicate on the "payload_hash" field.
+func PayloadHashGTE(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGTE(FieldPayloadHash, v))
+}
+
+// PayloadHashLT applies the LT predicate on the "payload_hash" field.
+func PayloadHashLT(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLT(FieldPayloadHash, v))
+}
+
+// PayloadHashLTE applies the LTE predicate on the "payload_hash" field.
+func PayloadHashLTE(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLTE(FieldPayloadHash, v))
+}
+
+// PayloadHashContains applies the Contains predicate on the "payload_hash" field.
+func PayloadHashContains(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldContains(FieldPayloadHash, v))
+}
+
+// PayloadHashHasPrefix applies the HasPrefix predicate on the "payload_hash" field.
+func PayloadHashHasPrefix(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldHasPrefix(FieldPayloadHash, v))
+}
+
+// PayloadHashHasSuffix applies the HasSuffix predicate on the "payload_hash" field.
+func PayloadHashHasSuffix(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldHasSuffix(FieldPayloadHash, v))
+}
+
+// PayloadHashEqualFold applies the EqualFold predicate on the "payload_hash" field.
+func PayloadHashEqualFold(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEqualFold(FieldPayloadHash, v))
+}
+
+// PayloadHashContainsFold applies the ContainsFold predicate on the "payload_hash" field.
+func PayloadHashContainsFold(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldContainsFold(FieldPayloadHash, v))
+}
+
+// SignatureEQ applies the EQ predicate on the "signature" field.
+func SignatureEQ(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEQ(FieldSignature, v))
+}
+
+// SignatureNEQ applies the NEQ predicate on the "signature" field.
+func SignatureNEQ(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNEQ(FieldSignature, v))
+}
+
+// SignatureIn applies the In predicate on the "signature" field.
+func SignatureIn(vs ...string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldIn(FieldSignature, vs...))
+}
+
+// SignatureNotIn applies the NotIn predicate on the "signature" field.
+func SignatureNotIn(vs ...string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldNotIn(FieldSignature, vs...))
+}
+
+// SignatureGT applies the GT predicate on the "signature" field.
+func SignatureGT(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGT(FieldSignature, v))
+}
+
+// SignatureGTE applies the GTE predicate on the "signature" field.
+func SignatureGTE(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldGTE(FieldSignature, v))
+}
+
+// SignatureLT applies the LT predicate on the "signature" field.
+func SignatureLT(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLT(FieldSignature, v))
+}
+
+// SignatureLTE applies the LTE predicate on the "signature" field.
+func SignatureLTE(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldLTE(FieldSignature, v))
+}
+
+// SignatureContains applies the Contains predicate on the "signature" field.
+func SignatureContains(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldContains(FieldSignature, v))
+}
+
+// SignatureHasPrefix applies the HasPrefix predicate on the "signature" field.
+func SignatureHasPrefix(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldHasPrefix(FieldSignature, v))
+}
+
+// SignatureHasSuffix applies the HasSuffix predicate on the "signature" field.
+func SignatureHasSuffix(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldHasSuffix(FieldSignature, v))
+}
+
+// SignatureEqualFold applies the EqualFold predicate on the "signature" field.
+func SignatureEqualFold(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldEqualFold(FieldSignature, v))
+}
+
+// SignatureContainsFold applies the ContainsFold predicate on the "signature" field.
+func SignatureContainsFold(v string) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.FieldContainsFold(FieldSignature, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuestionnaire applies the HasEdge predicate on the "questionnaire" edge.
+func HasQuestionnaire() predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, QuestionnaireTable, QuestionnaireColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuestionnaireWith applies the HasEdge predicate on the "questionnaire" edge with a given conditions (other predicates).
+func HasQuestionnaireWith(preds ...predicate.Questionnaire) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(func(s *sql.Selector) {
+		step := newQuestionnaireStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAppointment applies the HasEdge predicate on the "appointment" edge.
+func HasAppointment() predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AppointmentTable, AppointmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppointmentWith applies the HasEdge predicate on the "appointment" edge with a given conditions (other predicates).
+func HasAppointmentWith(preds ...predicate.Appointment) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(func(s *sql.Selector) {
+		step := newAppointmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeferrals applies the HasEdge predicate on the "deferrals" edge.
+func HasDeferrals() predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeferralsTable, DeferralsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeferralsWith applies the HasEdge predicate on the "deferrals" edge with a given conditions (other predicates).
+func HasDeferralsWith(preds ...predicate.Deferral) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(func(s *sql.Selector) {
+		step := newDeferralsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScreeningSubmission) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScreeningSubmission) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScreeningSubmission) predicate.ScreeningSubmission {
+	return predicate.ScreeningSubmission(sql.NotPredicates(p))
+}
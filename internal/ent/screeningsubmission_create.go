@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+)
+
+// ScreeningSubmissionCreate is the builder for creating a ScreeningSubmission entity.
+type ScreeningSubmissionCreate struct {
+	config
+	mutation *ScreeningSubmissionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScreeningSubmissionCreate) SetCreatedAt(v int64) *ScreeningSubmissionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScreeningSubmissionCreate) SetUpdatedAt(v int64) *ScreeningSubmissionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ScreeningSubmissionCreate) SetNillableUpdatedAt(v *int64) *ScreeningSubmissionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ScreeningSubmissionCreate) SetDeletedAt(v int64) *ScreeningSubmissionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ScreeningSubmissionCreate) SetNillableDeletedAt(v *int64) *ScreeningSubmissionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetAnswers sets the "answers" field.
+func (_c *ScreeningSubmissionCreate) SetAnswers(v map[string]string) *ScreeningSubmissionCreate {
+	_c.mutation.SetAnswers(v)
+	return _c
+}
+
+// SetDeferred sets the "deferred" field.
+func (_c *ScreeningSubmissionCreate) SetDeferred(v bool) *ScreeningSubmissionCreate {
+	_c.mutation.SetDeferred(v)
+	return _c
+}
+
+// SetNillableDeferred sets the "deferred" field if the given value is not nil.
+func (_c *ScreeningSubmissionCreate) SetNillableDeferred(v *bool) *ScreeningSubmissionCreate {
+	if v != nil {
+		_c.SetDeferred(*v)
+	}
+	return _c
+}
+
+// SetSignedAt sets the "signed_at" field.
+func (_c *ScreeningSubmissionCreate) SetSignedAt(v int64) *ScreeningSubmissionCreate {
+	_c.mutation.SetSignedAt(v)
+	return _c
+}
+
+// SetPayloadHash sets the "payload_hash" field.
+func (_c *ScreeningSubmissionCreate) SetPayloadHash(v string) *ScreeningSubmissionCreate {
+	_c.mutation.SetPayloadHash(v)
+	return _c
+}
+
+// SetSignature sets the "signature" field.
+func (_c *ScreeningSubmissionCreate) SetSignature(v string) *ScreeningSubmissionCreate {
+	_c.mutation.SetSignature(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScreeningSubmissionCreate) SetID(v uuid.UUID) *ScreeningSubmissionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *ScreeningSubmissionCreate) SetAccountID(id uuid.UUID) *ScreeningSubmissionCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *ScreeningSubmissionCreate) SetAccount(v *Account) *ScreeningSubmissionCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by ID.
+func (_c *ScreeningSubmissionCreate) SetQuestionnaireID(id uuid.UUID) *ScreeningSubmissionCreate {
+	_c.mutation.SetQuestionnaireID(id)
+	return _c
+}
+
+// SetQuestionnaire sets the "questionnaire" edge to the Questionnaire entity.
+func (_c *ScreeningSubmissionCreate) SetQuestionnaire(v *Questionnaire) *ScreeningSubmissionCreate {
+	return _c.SetQuestionnaireID(v.ID)
+}
+
+// SetAppointmentID sets the "appointment" edge to the Appointment entity by ID.
+func (_c *ScreeningSubmissionCreate) SetAppointmentID(id uuid.UUID) *ScreeningSubmissionCreate {
+	_c.mutation.SetAppointmentID(id)
+	return _c
+}
+
+// SetNillableAppointmentID sets the "appointment" edge to the Appointment entity by ID if the given value is not nil.
+func (_c *ScreeningSubmissionCreate) SetNillableAppointmentID(id *uuid.UUID) *ScreeningSubmissionCreate {
+	if id != nil {
+		_c = _c.SetAppointmentID(*id)
+	}
+	return _c
+}
+
+// SetAppointment sets the "appointment" edge to the Appointment entity.
+func (_c *ScreeningSubmissionCreate) SetAppointment(v *Appointment) *ScreeningSubmissionCreate {
+	return _c.SetAppointmentID(v.ID)
+}
+
+// AddDeferralIDs adds the "deferrals" edge to the Deferral entity by IDs.
+func (_c *ScreeningSubmissionCreate) AddDeferralIDs(ids ...uuid.UUID) *ScreeningSubmissionCreate {
+	_c.mutation.AddDeferralIDs(ids...)
+	return _c
+}
+
+// AddDeferrals adds the "deferrals" edges to the Deferral entity.
+func (_c *ScreeningSubmissionCreate) AddDeferrals(v ...*Deferral) *ScreeningSubmissionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeferralIDs(ids...)
+}
+
+// Mutation returns the ScreeningSubmissionMutation object of the builder.
+func (_c *ScreeningSubmissionCreate) Mutation() *ScreeningSubmissionMutation {
+	return _c.mutation
+}
+
+// Save creates the ScreeningSubmission in the database.
+func (_c *ScreeningSubmissionCreate) Save(ctx context.Context) (*ScreeningSubmission, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScreeningSubmissionCreate) SaveX(ctx context.Context) *ScreeningSubmission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScreeningSubmissionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScreeningSubmissionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScreeningSubmissionCreate) defaults() {
+	if _, ok := _c.mutation.Deferred(); !ok {
+		v := screeningsubmission.DefaultDeferred
+		_c.mutation.SetDeferred(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScreeningSubmissionCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := screeningsubmission.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningSubmission.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := screeningsubmission.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningSubmission.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := screeningsubmission.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningSubmission.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Answers(); !ok {
+		return &ValidationError{Name: "answers", err: errors.New(`ent: missing required field "ScreeningSubmission.answers"`)}
+	}
+	if _, ok := _c.mutation.Deferred(); !ok {
+		return &ValidationError{Name: "deferred", err: errors.New(`ent: missing required field "ScreeningSubmission.deferred"`)}
+	}
+	if _, ok := _c.mutation.SignedAt(); !ok {
+		return &ValidationError{Name: "signed_at", err: errors.New(`ent: missing required field "ScreeningSubmission.signed_at"`)}
+	}
+	if v, ok := _c.mutation.SignedAt(); ok {
+		if err := screeningsubmission.SignedAtValidator(v); err != nil {
+			return &ValidationError{Name: "signed_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningSubmission.signed_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PayloadHash(); !ok {
+		return &ValidationError{Name: "payload_hash", err: errors.New(`ent: missing required field "ScreeningSubmission.payload_hash"`)}
+	}
+	if v, ok := _c.mutation.PayloadHash(); ok {
+		if err := screeningsubmission.PayloadHashValidator(v); err != nil {
+			return &ValidationError{Name: "payload_hash", err: fmt.Errorf(`ent: validator failed for field "ScreeningSubmission.payload_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Signature(); !ok {
+		return &ValidationError{Name: "signature", err: errors.New(`ent: missing required field "ScreeningSubmission.signature"`)}
+	}
+	if v, ok := _c.mutation.Signature(); ok {
+		if err := screeningsubmission.SignatureValidator(v); err != nil {
+			return &ValidationError{Name: "signature", err: fmt.Errorf(`ent: validator failed for field "ScreeningSubmission.signature": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "ScreeningSubmission.account"`)}
+	}
+	if len(_c.mutation.QuestionnaireIDs()) == 0 {
+		return &ValidationError{Name: "questionnaire", err: errors.New(`ent: missing required edge "ScreeningSubmission.questionnaire"`)}
+	}
+	return nil
+}
+
+func (_c *ScreeningSubmissionCreate) sqlSave(ctx context.Context) (*ScreeningSubmission, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScreeningSubmissionCreate) createSpec() (*ScreeningSubmission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScreeningSubmission{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(screeningsubmission.Table, sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(screeningsubmission.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningsubmission.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(screeningsubmission.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Answers(); ok {
+		_spec.SetField(screeningsubmission.FieldAnswers, field.TypeJSON, value)
+		_node.Answers = value
+	}
+	if value, ok := _c.mutation.Deferred(); ok {
+		_spec.SetField(screeningsubmission.FieldDeferred, field.TypeBool, value)
+		_node.Deferred = value
+	}
+	if value, ok := _c.mutation.SignedAt(); ok {
+		_spec.SetField(screeningsubmission.FieldSignedAt, field.TypeInt64, value)
+		_node.SignedAt = value
+	}
+	if value, ok := _c.mutation.PayloadHash(); ok {
+		_spec.SetField(screeningsubmission.FieldPayloadHash, field.TypeString, value)
+		_node.PayloadHash = value
+	}
+	if value, ok := _c.mutation.Signature(); ok {
+		_spec.SetField(screeningsubmission.FieldSignature, field.TypeString, value)
+		_node.Signature = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   screeningsubmission.AccountTable,
+			Columns: []string{screeningsubmission.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuestionnaireIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   screeningsubmission.QuestionnaireTable,
+			Columns: []string{screeningsubmission.QuestionnaireColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaire.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.questionnaire_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AppointmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   screeningsubmission.AppointmentTable,
+			Columns: []string{screeningsubmission.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.appointment_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   screeningsubmission.DeferralsTable,
+			Columns: []string{screeningsubmission.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScreeningSubmissionCreateBulk is the builder for creating many ScreeningSubmission entities in bulk.
+type ScreeningSubmissionCreateBulk struct {
+	config
+	err      error
+	builders []*ScreeningSubmissionCreate
+}
+
+// Save creates the ScreeningSubmission entities in the database.
+func (_c *ScreeningSubmissionCreateBulk) Save(ctx context.Context) ([]*ScreeningSubmission, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScreeningSubmission, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScreeningSubmissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScreeningSubmissionCreateBulk) SaveX(ctx context.Context) []*ScreeningSubmission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScreeningSubmissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScreeningSubmissionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+)
+
+// ScreeningSubmissionDelete is the builder for deleting a ScreeningSubmission entity.
+type ScreeningSubmissionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScreeningSubmissionMutation
+}
+
+// Where appends a list predicates to the ScreeningSubmissionDelete builder.
+func (_d *ScreeningSubmissionDelete) Where(ps ...predicate.ScreeningSubmission) *ScreeningSubmissionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ScreeningSubmissionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ScreeningSubmissionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ScreeningSubmissionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(screeningsubmission.Table, sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ScreeningSubmissionDeleteOne is the builder for deleting a single ScreeningSubmission entity.
+type ScreeningSubmissionDeleteOne struct {
+	_d *ScreeningSubmissionDelete
+}
+
+// Where appends a list predicates to the ScreeningSubmissionDelete builder.
+func (_d *ScreeningSubmissionDeleteOne) Where(ps ...predicate.ScreeningSubmission) *ScreeningSubmissionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ScreeningSubmissionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{screeningsubmission.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ScreeningSubmissionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,841 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+)
+
+// ScreeningSubmissionQuery is the builder for querying ScreeningSubmission entities.
+type ScreeningSubmissionQuery struct {
+	config
+	ctx               *QueryContext
+	order             []screeningsubmission.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.ScreeningSubmission
+	withAccount       *AccountQuery
+	withQuestionnaire *QuestionnaireQuery
+	withAppointment   *AppointmentQuery
+	withDeferrals     *DeferralQuery
+	withFKs           bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScreeningSubmissionQuery builder.
+func (_q *ScreeningSubmissionQuery) Where(ps ...predicate.ScreeningSubmission) *ScreeningSubmissionQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ScreeningSubmissionQuery) Limit(limit int) *ScreeningSubmissionQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ScreeningSubmissionQuery) Offset(offset int) *ScreeningSubmissionQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ScreeningSubmissionQuery) Unique(unique bool) *ScreeningSubmissionQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ScreeningSubmissionQuery) Order(o ...screeningsubmission.OrderOption) *ScreeningSubmissionQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (_q *ScreeningSubmissionQuery) QueryAccount() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningsubmission.Table, screeningsubmission.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, screeningsubmission.AccountTable, screeningsubmission.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryQuestionnaire chains the current query on the "questionnaire" edge.
+func (_q *ScreeningSubmissionQuery) QueryQuestionnaire() *QuestionnaireQuery {
+	query := (&QuestionnaireClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningsubmission.Table, screeningsubmission.FieldID, selector),
+			sqlgraph.To(questionnaire.Table, questionnaire.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, screeningsubmission.QuestionnaireTable, screeningsubmission.QuestionnaireColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAppointment chains the current query on the "appointment" edge.
+func (_q *ScreeningSubmissionQuery) QueryAppointment() *AppointmentQuery {
+	query := (&AppointmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningsubmission.Table, screeningsubmission.FieldID, selector),
+			sqlgraph.To(appointment.Table, appointment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, screeningsubmission.AppointmentTable, screeningsubmission.AppointmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeferrals chains the current query on the "deferrals" edge.
+func (_q *ScreeningSubmissionQuery) QueryDeferrals() *DeferralQuery {
+	query := (&DeferralClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningsubmission.Table, screeningsubmission.FieldID, selector),
+			sqlgraph.To(deferral.Table, deferral.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, screeningsubmission.DeferralsTable, screeningsubmission.DeferralsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScreeningSubmission entity from the query.
+// Returns a *NotFoundError when no ScreeningSubmission was found.
+func (_q *ScreeningSubmissionQuery) First(ctx context.Context) (*ScreeningSubmission, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{screeningsubmission.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ScreeningSubmissionQuery) FirstX(ctx context.Context) *ScreeningSubmission {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScreeningSubmission ID from the query.
+// Returns a *NotFoundError when no ScreeningSubmission ID was found.
+func (_q *ScreeningSubmissionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{screeningsubmission.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *ScreeningSubmissionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScreeningSubmission entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScreeningSubmission entity is found.
+// Returns a *NotFoundError when no ScreeningSubmission entities are found.
+func (_q *ScreeningSubmissionQuery) Only(ctx context.Context) (*ScreeningSubmission, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{screeningsubmission.Label}
+	default:
+		return nil, &NotSingularError{screeningsubmission.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ScreeningSubmissionQuery) OnlyX(ctx context.Context) *ScreeningSubmission {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScreeningSubmission ID in the query.
+// Returns a *NotSingularError when more than one ScreeningSubmission ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *ScreeningSubmissionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{screeningsubmission.Label}
+	default:
+		err = &NotSingularError{screeningsubmission.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *ScreeningSubmissionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScreeningSubmissions.
+func (_q *ScreeningSubmissionQuery) All(ctx context.Context) ([]*ScreeningSubmission, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScreeningSubmission, *ScreeningSubmissionQuery]()
+	return withInterceptors[[]*ScreeningSubmission](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ScreeningSubmissionQuery) AllX(ctx context.Context) []*ScreeningSubmission {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScreeningSubmission IDs.
+func (_q *ScreeningSubmissionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(screeningsubmission.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *ScreeningSubmissionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *ScreeningSubmissionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ScreeningSubmissionQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ScreeningSubmissionQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ScreeningSubmissionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ScreeningSubmissionQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScreeningSubmissionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ScreeningSubmissionQuery) Clone() *ScreeningSubmissionQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ScreeningSubmissionQuery{
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]screeningsubmission.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.ScreeningSubmission{}, _q.predicates...),
+		withAccount:       _q.withAccount.Clone(),
+		withQuestionnaire: _q.withQuestionnaire.Clone(),
+		withAppointment:   _q.withAppointment.Clone(),
+		withDeferrals:     _q.withDeferrals.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ScreeningSubmissionQuery) WithAccount(opts ...func(*AccountQuery)) *ScreeningSubmissionQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAccount = query
+	return _q
+}
+
+// WithQuestionnaire tells the query-builder to eager-load the nodes that are connected to
+// the "questionnaire" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ScreeningSubmissionQuery) WithQuestionnaire(opts ...func(*QuestionnaireQuery)) *ScreeningSubmissionQuery {
+	query := (&QuestionnaireClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withQuestionnaire = query
+	return _q
+}
+
+// WithAppointment tells the query-builder to eager-load the nodes that are connected to
+// the "appointment" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ScreeningSubmissionQuery) WithAppointment(opts ...func(*AppointmentQuery)) *ScreeningSubmissionQuery {
+	query := (&AppointmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAppointment = query
+	return _q
+}
+
+// WithDeferrals tells the query-builder to eager-load the nodes that are connected to
+// the "deferrals" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ScreeningSubmissionQuery) WithDeferrals(opts ...func(*DeferralQuery)) *ScreeningSubmissionQuery {
+	query := (&DeferralClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDeferrals = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt int64 `json:"created_at"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScreeningSubmission.Query().
+//		GroupBy(screeningsubmission.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *ScreeningSubmissionQuery) GroupBy(field string, fields ...string) *ScreeningSubmissionGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScreeningSubmissionGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = screeningsubmission.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt int64 `json:"created_at"`
+//	}
+//
+//	client.ScreeningSubmission.Query().
+//		Select(screeningsubmission.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *ScreeningSubmissionQuery) Select(fields ...string) *ScreeningSubmissionSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ScreeningSubmissionSelect{ScreeningSubmissionQuery: _q}
+	sbuild.label = screeningsubmission.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScreeningSubmissionSelect configured with the given aggregations.
+func (_q *ScreeningSubmissionQuery) Aggregate(fns ...AggregateFunc) *ScreeningSubmissionSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ScreeningSubmissionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !screeningsubmission.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *ScreeningSubmissionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScreeningSubmission, error) {
+	var (
+		nodes       = []*ScreeningSubmission{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [4]bool{
+			_q.withAccount != nil,
+			_q.withQuestionnaire != nil,
+			_q.withAppointment != nil,
+			_q.withDeferrals != nil,
+		}
+	)
+	if _q.withAccount != nil || _q.withQuestionnaire != nil || _q.withAppointment != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, screeningsubmission.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScreeningSubmission).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScreeningSubmission{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withAccount; query != nil {
+		if err := _q.loadAccount(ctx, query, nodes, nil,
+			func(n *ScreeningSubmission, e *Account) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withQuestionnaire; query != nil {
+		if err := _q.loadQuestionnaire(ctx, query, nodes, nil,
+			func(n *ScreeningSubmission, e *Questionnaire) { n.Edges.Questionnaire = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAppointment; query != nil {
+		if err := _q.loadAppointment(ctx, query, nodes, nil,
+			func(n *ScreeningSubmission, e *Appointment) { n.Edges.Appointment = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDeferrals; query != nil {
+		if err := _q.loadDeferrals(ctx, query, nodes,
+			func(n *ScreeningSubmission) { n.Edges.Deferrals = []*Deferral{} },
+			func(n *ScreeningSubmission, e *Deferral) { n.Edges.Deferrals = append(n.Edges.Deferrals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *ScreeningSubmissionQuery) loadAccount(ctx context.Context, query *AccountQuery, nodes []*ScreeningSubmission, init func(*ScreeningSubmission), assign func(*ScreeningSubmission, *Account)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScreeningSubmission)
+	for i := range nodes {
+		if nodes[i].account_id == nil {
+			continue
+		}
+		fk := *nodes[i].account_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ScreeningSubmissionQuery) loadQuestionnaire(ctx context.Context, query *QuestionnaireQuery, nodes []*ScreeningSubmission, init func(*ScreeningSubmission), assign func(*ScreeningSubmission, *Questionnaire)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScreeningSubmission)
+	for i := range nodes {
+		if nodes[i].questionnaire_id == nil {
+			continue
+		}
+		fk := *nodes[i].questionnaire_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(questionnaire.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "questionnaire_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ScreeningSubmissionQuery) loadAppointment(ctx context.Context, query *AppointmentQuery, nodes []*ScreeningSubmission, init func(*ScreeningSubmission), assign func(*ScreeningSubmission, *Appointment)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScreeningSubmission)
+	for i := range nodes {
+		if nodes[i].appointment_id == nil {
+			continue
+		}
+		fk := *nodes[i].appointment_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(appointment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "appointment_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ScreeningSubmissionQuery) loadDeferrals(ctx context.Context, query *DeferralQuery, nodes []*ScreeningSubmission, init func(*ScreeningSubmission), assign func(*ScreeningSubmission, *Deferral)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ScreeningSubmission)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Deferral(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(screeningsubmission.DeferralsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.screening_submission_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "screening_submission_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "screening_submission_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *ScreeningSubmissionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ScreeningSubmissionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(screeningsubmission.Table, screeningsubmission.Columns, sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, screeningsubmission.FieldID)
+		for i := range fields {
+			if fields[i] != screeningsubmission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ScreeningSubmissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(screeningsubmission.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = screeningsubmission.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ScreeningSubmissionGroupBy is the group-by builder for ScreeningSubmission entities.
+type ScreeningSubmissionGroupBy struct {
+	selector
+	build *ScreeningSubmissionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ScreeningSubmissionGroupBy) Aggregate(fns ...AggregateFunc) *ScreeningSubmissionGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ScreeningSubmissionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScreeningSubmissionQuery, *ScreeningSubmissionGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ScreeningSubmissionGroupBy) sqlScan(ctx context.Context, root *ScreeningSubmissionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScreeningSubmissionSelect is the builder for selecting fields of ScreeningSubmission entities.
+type ScreeningSubmissionSelect struct {
+	*ScreeningSubmissionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ScreeningSubmissionSelect) Aggregate(fns ...AggregateFunc) *ScreeningSubmissionSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ScreeningSubmissionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScreeningSubmissionQuery, *ScreeningSubmissionSelect](ctx, _s.ScreeningSubmissionQuery, _s, _s.inters, v)
+}
+
+func (_s *ScreeningSubmissionSelect) sqlScan(ctx context.Context, root *ScreeningSubmissionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
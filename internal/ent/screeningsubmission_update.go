@@ -0,0 +1,533 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/screeningsubmission"
+)
+
+// ScreeningSubmissionUpdate is the builder for updating ScreeningSubmission entities.
+type ScreeningSubmissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScreeningSubmissionMutation
+}
+
+// Where appends a list predicates to the ScreeningSubmissionUpdate builder.
+func (_u *ScreeningSubmissionUpdate) Where(ps ...predicate.ScreeningSubmission) *ScreeningSubmissionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScreeningSubmissionUpdate) SetUpdatedAt(v int64) *ScreeningSubmissionUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *ScreeningSubmissionUpdate) AddUpdatedAt(v int64) *ScreeningSubmissionUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ScreeningSubmissionUpdate) ClearUpdatedAt() *ScreeningSubmissionUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ScreeningSubmissionUpdate) SetDeletedAt(v int64) *ScreeningSubmissionUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ScreeningSubmissionUpdate) SetNillableDeletedAt(v *int64) *ScreeningSubmissionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *ScreeningSubmissionUpdate) AddDeletedAt(v int64) *ScreeningSubmissionUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ScreeningSubmissionUpdate) ClearDeletedAt() *ScreeningSubmissionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// AddDeferralIDs adds the "deferrals" edge to the Deferral entity by IDs.
+func (_u *ScreeningSubmissionUpdate) AddDeferralIDs(ids ...uuid.UUID) *ScreeningSubmissionUpdate {
+	_u.mutation.AddDeferralIDs(ids...)
+	return _u
+}
+
+// AddDeferrals adds the "deferrals" edges to the Deferral entity.
+func (_u *ScreeningSubmissionUpdate) AddDeferrals(v ...*Deferral) *ScreeningSubmissionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeferralIDs(ids...)
+}
+
+// Mutation returns the ScreeningSubmissionMutation object of the builder.
+func (_u *ScreeningSubmissionUpdate) Mutation() *ScreeningSubmissionMutation {
+	return _u.mutation
+}
+
+// ClearDeferrals clears all "deferrals" edges to the Deferral entity.
+func (_u *ScreeningSubmissionUpdate) ClearDeferrals() *ScreeningSubmissionUpdate {
+	_u.mutation.ClearDeferrals()
+	return _u
+}
+
+// RemoveDeferralIDs removes the "deferrals" edge to Deferral entities by IDs.
+func (_u *ScreeningSubmissionUpdate) RemoveDeferralIDs(ids ...uuid.UUID) *ScreeningSubmissionUpdate {
+	_u.mutation.RemoveDeferralIDs(ids...)
+	return _u
+}
+
+// RemoveDeferrals removes "deferrals" edges to Deferral entities.
+func (_u *ScreeningSubmissionUpdate) RemoveDeferrals(v ...*Deferral) *ScreeningSubmissionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeferralIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScreeningSubmissionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScreeningSubmissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScreeningSubmissionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScreeningSubmissionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScreeningSubmissionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := screeningsubmission.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScreeningSubmissionUpdate) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := screeningsubmission.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningSubmission.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := screeningsubmission.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningSubmission.deleted_at": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScreeningSubmission.account"`)
+	}
+	if _u.mutation.QuestionnaireCleared() && len(_u.mutation.QuestionnaireIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScreeningSubmission.questionnaire"`)
+	}
+	return nil
+}
+
+func (_u *ScreeningSubmissionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(screeningsubmission.Table, screeningsubmission.Columns, sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningsubmission.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(screeningsubmission.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(screeningsubmission.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(screeningsubmission.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(screeningsubmission.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(screeningsubmission.FieldDeletedAt, field.TypeInt64)
+	}
+	if _u.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   screeningsubmission.DeferralsTable,
+			Columns: []string{screeningsubmission.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeferralsIDs(); len(nodes) > 0 && !_u.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   screeningsubmission.DeferralsTable,
+			Columns: []string{screeningsubmission.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   screeningsubmission.DeferralsTable,
+			Columns: []string{screeningsubmission.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{screeningsubmission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScreeningSubmissionUpdateOne is the builder for updating a single ScreeningSubmission entity.
+type ScreeningSubmissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScreeningSubmissionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScreeningSubmissionUpdateOne) SetUpdatedAt(v int64) *ScreeningSubmissionUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *ScreeningSubmissionUpdateOne) AddUpdatedAt(v int64) *ScreeningSubmissionUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ScreeningSubmissionUpdateOne) ClearUpdatedAt() *ScreeningSubmissionUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ScreeningSubmissionUpdateOne) SetDeletedAt(v int64) *ScreeningSubmissionUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ScreeningSubmissionUpdateOne) SetNillableDeletedAt(v *int64) *ScreeningSubmissionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *ScreeningSubmissionUpdateOne) AddDeletedAt(v int64) *ScreeningSubmissionUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ScreeningSubmissionUpdateOne) ClearDeletedAt() *ScreeningSubmissionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// AddDeferralIDs adds the "deferrals" edge to the Deferral entity by IDs.
+func (_u *ScreeningSubmissionUpdateOne) AddDeferralIDs(ids ...uuid.UUID) *ScreeningSubmissionUpdateOne {
+	_u.mutation.AddDeferralIDs(ids...)
+	return _u
+}
+
+// AddDeferrals adds the "deferrals" edges to the Deferral entity.
+func (_u *ScreeningSubmissionUpdateOne) AddDeferrals(v ...*Deferral) *ScreeningSubmissionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeferralIDs(ids...)
+}
+
+// Mutation returns the ScreeningSubmissionMutation object of the builder.
+func (_u *ScreeningSubmissionUpdateOne) Mutation() *ScreeningSubmissionMutation {
+	return _u.mutation
+}
+
+// ClearDeferrals clears all "deferrals" edges to the Deferral entity.
+func (_u *ScreeningSubmissionUpdateOne) ClearDeferrals() *ScreeningSubmissionUpdateOne {
+	_u.mutation.ClearDeferrals()
+	return _u
+}
+
+// RemoveDeferralIDs removes the "deferrals" edge to Deferral entities by IDs.
+func (_u *ScreeningSubmissionUpdateOne) RemoveDeferralIDs(ids ...uuid.UUID) *ScreeningSubmissionUpdateOne {
+	_u.mutation.RemoveDeferralIDs(ids...)
+	return _u
+}
+
+// RemoveDeferrals removes "deferrals" edges to Deferral entities.
+func (_u *ScreeningSubmissionUpdateOne) RemoveDeferrals(v ...*Deferral) *ScreeningSubmissionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeferralIDs(ids...)
+}
+
+// Where appends a list predicates to the ScreeningSubmissionUpdate builder.
+func (_u *ScreeningSubmissionUpdateOne) Where(ps ...predicate.ScreeningSubmission) *ScreeningSubmissionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScreeningSubmissionUpdateOne) Select(field string, fields ...string) *ScreeningSubmissionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScreeningSubmission entity.
+func (_u *ScreeningSubmissionUpdateOne) Save(ctx context.Context) (*ScreeningSubmission, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScreeningSubmissionUpdateOne) SaveX(ctx context.Context) *ScreeningSubmission {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScreeningSubmissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScreeningSubmissionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScreeningSubmissionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := screeningsubmission.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScreeningSubmissionUpdateOne) check() error {
+	if v, ok := _u.mutation.UpdatedAt(); ok {
+		if err := screeningsubmission.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningSubmission.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedAt(); ok {
+		if err := screeningsubmission.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "ScreeningSubmission.deleted_at": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScreeningSubmission.account"`)
+	}
+	if _u.mutation.QuestionnaireCleared() && len(_u.mutation.QuestionnaireIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScreeningSubmission.questionnaire"`)
+	}
+	return nil
+}
+
+func (_u *ScreeningSubmissionUpdateOne) sqlSave(ctx context.Context) (_node *ScreeningSubmission, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(screeningsubmission.Table, screeningsubmission.Columns, sqlgraph.NewFieldSpec(screeningsubmission.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScreeningSubmission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, screeningsubmission.FieldID)
+		for _, f := range fields {
+			if !screeningsubmission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != screeningsubmission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningsubmission.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(screeningsubmission.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(screeningsubmission.FieldUpdatedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(screeningsubmission.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(screeningsubmission.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(screeningsubmission.FieldDeletedAt, field.TypeInt64)
+	}
+	if _u.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   screeningsubmission.DeferralsTable,
+			Columns: []string{screeningsubmission.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeferralsIDs(); len(nodes) > 0 && !_u.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   screeningsubmission.DeferralsTable,
+			Columns: []string{screeningsubmission.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   screeningsubmission.DeferralsTable,
+			Columns: []string{screeningsubmission.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deferral.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScreeningSubmission{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{screeningsubmission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
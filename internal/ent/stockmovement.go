@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+// StockMovement is the model entity for the StockMovement schema.
+type StockMovement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at"`
+	// Represents soft delete timestamp in milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// Type holds the value of the "type" field.
+	Type stockmovement.Type `json:"type"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity"`
+	// Stock quantity after the movement was applied.
+	Balance int `json:"balance"`
+	// Shared by the TRANSFER_OUT and TRANSFER_IN movements of one transfer.
+	TransferID *uuid.UUID `json:"transfer_id"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StockMovementQuery when eager-loading is set.
+	Edges           StockMovementEdges `json:"edges"`
+	blood_stock_id  *uuid.UUID
+	performed_by_id *uuid.UUID
+	blood_unit_id   *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// StockMovementEdges holds the relations/edges for other nodes in the graph.
+type StockMovementEdges struct {
+	// Stock holds the value of the stock edge.
+	Stock *BloodStock `json:"stock,omitempty"`
+	// Staff who performed the movement. Empty for system jobs.
+	PerformedBy *Account `json:"performed_by,omitempty"`
+	// Blood unit whose lifecycle caused the movement.
+	Unit *BloodUnit `json:"unit,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// StockOrErr returns the Stock value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) StockOrErr() (*BloodStock, error) {
+	if e.Stock != nil {
+		return e.Stock, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: bloodstock.Label}
+	}
+	return nil, &NotLoadedError{edge: "stock"}
+}
+
+// PerformedByOrErr returns the PerformedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) PerformedByOrErr() (*Account, error) {
+	if e.PerformedBy != nil {
+		return e.PerformedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "performed_by"}
+}
+
+// UnitOrErr returns the Unit value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) UnitOrErr() (*BloodUnit, error) {
+	if e.Unit != nil {
+		return e.Unit, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: bloodunit.Label}
+	}
+	return nil, &NotLoadedError{edge: "unit"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockMovement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stockmovement.FieldTransferID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case stockmovement.FieldCreatedAt, stockmovement.FieldUpdatedAt, stockmovement.FieldDeletedAt, stockmovement.FieldQuantity, stockmovement.FieldBalance:
+			values[i] = new(sql.NullInt64)
+		case stockmovement.FieldType, stockmovement.FieldNote:
+			values[i] = new(sql.NullString)
+		case stockmovement.FieldID:
+			values[i] = new(uuid.UUID)
+		case stockmovement.ForeignKeys[0]: // blood_stock_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case stockmovement.ForeignKeys[1]: // performed_by_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case stockmovement.ForeignKeys[2]: // blood_unit_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockMovement fields.
+func (_m *StockMovement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stockmovement.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case stockmovement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case stockmovement.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		case stockmovement.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case stockmovement.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = stockmovement.Type(value.String)
+			}
+		case stockmovement.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case stockmovement.FieldBalance:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				_m.Balance = int(value.Int64)
+			}
+		case stockmovement.FieldTransferID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer_id", values[i])
+			} else if value.Valid {
+				_m.TransferID = new(uuid.UUID)
+				*_m.TransferID = *value.S.(*uuid.UUID)
+			}
+		case stockmovement.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case stockmovement.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field blood_stock_id", values[i])
+			} else if value.Valid {
+				_m.blood_stock_id = new(uuid.UUID)
+				*_m.blood_stock_id = *value.S.(*uuid.UUID)
+			}
+		case stockmovement.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field performed_by_id", values[i])
+			} else if value.Valid {
+				_m.performed_by_id = new(uuid.UUID)
+				*_m.performed_by_id = *value.S.(*uuid.UUID)
+			}
+		case stockmovement.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field blood_unit_id", values[i])
+			} else if value.Valid {
+				_m.blood_unit_id = new(uuid.UUID)
+				*_m.blood_unit_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StockMovement.
+// This includes values selected through modifiers, order, etc.
+func (_m *StockMovement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStock queries the "stock" edge of the StockMovement entity.
+func (_m *StockMovement) QueryStock() *BloodStockQuery {
+	return NewStockMovementClient(_m.config).QueryStock(_m)
+}
+
+// QueryPerformedBy queries the "performed_by" edge of the StockMovement entity.
+func (_m *StockMovement) QueryPerformedBy() *AccountQuery {
+	return NewStockMovementClient(_m.config).QueryPerformedBy(_m)
+}
+
+// QueryUnit queries the "unit" edge of the StockMovement entity.
+func (_m *StockMovement) QueryUnit() *BloodUnitQuery {
+	return NewStockMovementClient(_m.config).QueryUnit(_m)
+}
+
+// Update returns a builder for updating this StockMovement.
+// Note that you need to call StockMovement.Unwrap() before calling this method if this StockMovement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StockMovement) Update() *StockMovementUpdateOne {
+	return NewStockMovementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StockMovement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StockMovement) Unwrap() *StockMovement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockMovement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StockMovement) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockMovement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Balance))
+	builder.WriteString(", ")
+	if v := _m.TransferID; v != nil {
+		builder.WriteString("transfer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockMovements is a parsable slice of StockMovement.
+type StockMovements []*StockMovement
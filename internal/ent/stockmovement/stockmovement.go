@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stockmovement
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the stockmovement type in the database.
+	Label = "stock_movement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldBalance holds the string denoting the balance field in the database.
+	FieldBalance = "balance"
+	// FieldTransferID holds the string denoting the transfer_id field in the database.
+	FieldTransferID = "transfer_id"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// EdgeStock holds the string denoting the stock edge name in mutations.
+	EdgeStock = "stock"
+	// EdgePerformedBy holds the string denoting the performed_by edge name in mutations.
+	EdgePerformedBy = "performed_by"
+	// EdgeUnit holds the string denoting the unit edge name in mutations.
+	EdgeUnit = "unit"
+	// Table holds the table name of the stockmovement in the database.
+	Table = "stock_movements"
+	// StockTable is the table that holds the stock relation/edge.
+	StockTable = "stock_movements"
+	// StockInverseTable is the table name for the BloodStock entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodstock" package.
+	StockInverseTable = "blood_stocks"
+	// StockColumn is the table column denoting the stock relation/edge.
+	StockColumn = "blood_stock_id"
+	// PerformedByTable is the table that holds the performed_by relation/edge.
+	PerformedByTable = "stock_movements"
+	// PerformedByInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	PerformedByInverseTable = "accounts"
+	// PerformedByColumn is the table column denoting the performed_by relation/edge.
+	PerformedByColumn = "performed_by_id"
+	// UnitTable is the table that holds the unit relation/edge.
+	UnitTable = "stock_movements"
+	// UnitInverseTable is the table name for the BloodUnit entity.
+	// It exists in this package in order to avoid circular dependency with the "bloodunit" package.
+	UnitInverseTable = "blood_units"
+	// UnitColumn is the table column denoting the unit relation/edge.
+	UnitColumn = "blood_unit_id"
+)
+
+// Columns holds all SQL columns for stockmovement fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldType,
+	FieldQuantity,
+	FieldBalance,
+	FieldTransferID,
+	FieldNote,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "stock_movements"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"blood_stock_id",
+	"performed_by_id",
+	"blood_unit_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedAtValidator is a validator for the "created_at" field. It is called by the builders before save.
+	CreatedAtValidator func(int64) error
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// UpdatedAtValidator is a validator for the "updated_at" field. It is called by the builders before save.
+	UpdatedAtValidator func(int64) error
+	// DeletedAtValidator is a validator for the "deleted_at" field. It is called by the builders before save.
+	DeletedAtValidator func(int64) error
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+	// BalanceValidator is a validator for the "balance" field. It is called by the builders before save.
+	BalanceValidator func(int) error
+	// NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	NoteValidator func(string) error
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeCollect     Type = "COLLECT"
+	TypeIssue       Type = "ISSUE"
+	TypeDiscard     Type = "DISCARD"
+	TypeTransferIn  Type = "TRANSFER_IN"
+	TypeTransferOut Type = "TRANSFER_OUT"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeCollect, TypeIssue, TypeDiscard, TypeTransferIn, TypeTransferOut:
+		return nil
+	default:
+		return fmt.Errorf("stockmovement: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the StockMovement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByBalance orders the results by the balance field.
+func ByBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalance, opts...).ToFunc()
+}
+
+// ByTransferID orders the results by the transfer_id field.
+func ByTransferID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransferID, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByStockField orders the results by stock field.
+func ByStockField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStockStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPerformedByField orders the results by performed_by field.
+func ByPerformedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPerformedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUnitField orders the results by unit field.
+func ByUnitField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUnitStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStockStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StockInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, StockTable, StockColumn),
+	)
+}
+func newPerformedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PerformedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PerformedByTable, PerformedByColumn),
+	)
+}
+func newUnitStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UnitInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UnitTable, UnitColumn),
+	)
+}
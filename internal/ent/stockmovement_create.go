@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+// StockMovementCreate is the builder for creating a StockMovement entity.
+type StockMovementCreate struct {
+	config
+	mutation *StockMovementMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StockMovementCreate) SetCreatedAt(v int64) *StockMovementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *StockMovementCreate) SetUpdatedAt(v int64) *StockMovementCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableUpdatedAt(v *int64) *StockMovementCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *StockMovementCreate) SetDeletedAt(v int64) *StockMovementCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableDeletedAt(v *int64) *StockMovementCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *StockMovementCreate) SetType(v stockmovement.Type) *StockMovementCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *StockMovementCreate) SetQuantity(v int) *StockMovementCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetBalance sets the "balance" field.
+func (_c *StockMovementCreate) SetBalance(v int) *StockMovementCreate {
+	_c.mutation.SetBalance(v)
+	return _c
+}
+
+// SetTransferID sets the "transfer_id" field.
+func (_c *StockMovementCreate) SetTransferID(v uuid.UUID) *StockMovementCreate {
+	_c.mutation.SetTransferID(v)
+	return _c
+}
+
+// SetNillableTransferID sets the "transfer_id" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableTransferID(v *uuid.UUID) *StockMovementCreate {
+	if v != nil {
+		_c.SetTransferID(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *StockMovementCreate) SetNote(v string) *StockMovementCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableNote(v *string) *StockMovementCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StockMovementCreate) SetID(v uuid.UUID) *StockMovementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetStockID sets the "stock" edge to the BloodStock entity by ID.
+func (_c *StockMovementCreate) SetStockID(id uuid.UUID) *StockMovementCreate {
+	_c.mutation.SetStockID(id)
+	return _c
+}
+
+// SetStock sets the "stock" edge to the BloodStock entity.
+func (_c *StockMovementCreate) SetStock(v *BloodStock) *StockMovementCreate {
+	return _c.SetStockID(v.ID)
+}
+
+// SetPerformedByID sets the "performed_by" edge to the Account entity by ID.
+func (_c *StockMovementCreate) SetPerformedByID(id uuid.UUID) *StockMovementCreate {
+	_c.mutation.SetPerformedByID(id)
+	return _c
+}
+
+// SetNillablePerformedByID sets the "performed_by" edge to the Account entity by ID if the given value is not nil.
+func (_c *StockMovementCreate) SetNillablePerformedByID(id *uuid.UUID) *StockMovementCreate {
+	if id != nil {
+		_c = _c.SetPerformedByID(*id)
+	}
+	return _c
+}
+
+// SetPerformedBy sets the "performed_by" edge to the Account entity.
+func (_c *StockMovementCreate) SetPerformedBy(v *Account) *StockMovementCreate {
+	return _c.SetPerformedByID(v.ID)
+}
+
+// SetUnitID sets the "unit" edge to the BloodUnit entity by ID.
+func (_c *StockMovementCreate) SetUnitID(id uuid.UUID) *StockMovementCreate {
+	_c.mutation.SetUnitID(id)
+	return _c
+}
+
+// SetNillableUnitID sets the "unit" edge to the BloodUnit entity by ID if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableUnitID(id *uuid.UUID) *StockMovementCreate {
+	if id != nil {
+		_c = _c.SetUnitID(*id)
+	}
+	return _c
+}
+
+// SetUnit sets the "unit" edge to the BloodUnit entity.
+func (_c *StockMovementCreate) SetUnit(v *BloodUnit) *StockMovementCreate {
+	return _c.SetUnitID(v.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (_c *StockMovementCreate) Mutation() *StockMovementMutation {
+	return _c.mutation
+}
+
+// Save creates the StockMovement in the database.
+func (_c *StockMovementCreate) Save(ctx context.Context) (*StockMovement, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StockMovementCreate) SaveX(ctx context.Context) *StockMovement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StockMovementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StockMovementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StockMovementCreate) check() error {
+	if v, ok := _c.mutation.CreatedAt(); ok {
+		if err := stockmovement.CreatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "created_at", err: fmt.Errorf(`ent: validator failed for field "StockMovement.created_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UpdatedAt(); ok {
+		if err := stockmovement.UpdatedAtValidator(v); err != nil {
+			return &ValidationError{Name: "updated_at", err: fmt.Errorf(`ent: validator failed for field "StockMovement.updated_at": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeletedAt(); ok {
+		if err := stockmovement.DeletedAtValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_at", err: fmt.Errorf(`ent: validator failed for field "StockMovement.deleted_at": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "StockMovement.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := stockmovement.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "StockMovement.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "StockMovement.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := stockmovement.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "StockMovement.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "StockMovement.balance"`)}
+	}
+	if v, ok := _c.mutation.Balance(); ok {
+		if err := stockmovement.BalanceValidator(v); err != nil {
+			return &ValidationError{Name: "balance", err: fmt.Errorf(`ent: validator failed for field "StockMovement.balance": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := stockmovement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "StockMovement.note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.StockIDs()) == 0 {
+		return &ValidationError{Name: "stock", err: errors.New(`ent: missing required edge "StockMovement.stock"`)}
+	}
+	return nil
+}
+
+func (_c *StockMovementCreate) sqlSave(ctx context.Context) (*StockMovement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StockMovementCreate) createSpec() (*StockMovement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StockMovement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(stockmovement.Table, sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(stockmovement.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(stockmovement.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(stockmovement.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(stockmovement.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(stockmovement.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Balance(); ok {
+		_spec.SetField(stockmovement.FieldBalance, field.TypeInt, value)
+		_node.Balance = value
+	}
+	if value, ok := _c.mutation.TransferID(); ok {
+		_spec.SetField(stockmovement.FieldTransferID, field.TypeUUID, value)
+		_node.TransferID = &value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(stockmovement.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if nodes := _c.mutation.StockIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.StockTable,
+			Columns: []string{stockmovement.StockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodstock.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blood_stock_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PerformedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.PerformedByTable,
+			Columns: []string{stockmovement.PerformedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.performed_by_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UnitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.UnitTable,
+			Columns: []string{stockmovement.UnitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bloodunit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blood_unit_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StockMovementCreateBulk is the builder for creating many StockMovement entities in bulk.
+type StockMovementCreateBulk struct {
+	config
+	err      error
+	builders []*StockMovementCreate
+}
+
+// Save creates the StockMovement entities in the database.
+func (_c *StockMovementCreateBulk) Save(ctx context.Context) ([]*StockMovement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StockMovement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StockMovementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StockMovementCreateBulk) SaveX(ctx context.Context) []*StockMovement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StockMovementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StockMovementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
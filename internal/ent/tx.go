@@ -12,16 +12,48 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// Appointment is the client for interacting with the Appointment builders.
+	Appointment *AppointmentClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// BloodRequest is the client for interacting with the BloodRequest builders.
+	BloodRequest *BloodRequestClient
+	// BloodStock is the client for interacting with the BloodStock builders.
+	BloodStock *BloodStockClient
 	// BloodType is the client for interacting with the BloodType builders.
 	BloodType *BloodTypeClient
+	// BloodUnit is the client for interacting with the BloodUnit builders.
+	BloodUnit *BloodUnitClient
+	// BloodUnitEvent is the client for interacting with the BloodUnitEvent builders.
+	BloodUnitEvent *BloodUnitEventClient
 	// CasbinRule is the client for interacting with the CasbinRule builders.
 	CasbinRule *CasbinRuleClient
+	// Certificate is the client for interacting with the Certificate builders.
+	Certificate *CertificateClient
 	// City is the client for interacting with the City builders.
 	City *CityClient
+	// Deferral is the client for interacting with the Deferral builders.
+	Deferral *DeferralClient
 	// District is the client for interacting with the District builders.
 	District *DistrictClient
+	// Donation is the client for interacting with the Donation builders.
+	Donation *DonationClient
+	// DonationEvent is the client for interacting with the DonationEvent builders.
+	DonationEvent *DonationEventClient
+	// DonationReminder is the client for interacting with the DonationReminder builders.
+	DonationReminder *DonationReminderClient
+	// EmergencyCampaign is the client for interacting with the EmergencyCampaign builders.
+	EmergencyCampaign *EmergencyCampaignClient
+	// EmergencyContact is the client for interacting with the EmergencyContact builders.
+	EmergencyContact *EmergencyContactClient
+	// Hospital is the client for interacting with the Hospital builders.
+	Hospital *HospitalClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
 	// OTP is the client for interacting with the OTP builders.
 	OTP *OTPClient
 	// PMILocation is the client for interacting with the PMILocation builders.
@@ -32,8 +64,18 @@ type Tx struct {
 	Permission *PermissionClient
 	// Province is the client for interacting with the Province builders.
 	Province *ProvinceClient
+	// Questionnaire is the client for interacting with the Questionnaire builders.
+	Questionnaire *QuestionnaireClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// RoleAssignment is the client for interacting with the RoleAssignment builders.
+	RoleAssignment *RoleAssignmentClient
+	// ScreeningQuestion is the client for interacting with the ScreeningQuestion builders.
+	ScreeningQuestion *ScreeningQuestionClient
+	// ScreeningSubmission is the client for interacting with the ScreeningSubmission builders.
+	ScreeningSubmission *ScreeningSubmissionClient
+	// StockMovement is the client for interacting with the StockMovement builders.
+	StockMovement *StockMovementClient
 	// Subdistrict is the client for interacting with the Subdistrict builders.
 	Subdistrict *SubdistrictClient
 
@@ -167,17 +209,38 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
 	tx.Account = NewAccountClient(tx.config)
+	tx.Appointment = NewAppointmentClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.BloodRequest = NewBloodRequestClient(tx.config)
+	tx.BloodStock = NewBloodStockClient(tx.config)
 	tx.BloodType = NewBloodTypeClient(tx.config)
+	tx.BloodUnit = NewBloodUnitClient(tx.config)
+	tx.BloodUnitEvent = NewBloodUnitEventClient(tx.config)
 	tx.CasbinRule = NewCasbinRuleClient(tx.config)
+	tx.Certificate = NewCertificateClient(tx.config)
 	tx.City = NewCityClient(tx.config)
+	tx.Deferral = NewDeferralClient(tx.config)
 	tx.District = NewDistrictClient(tx.config)
+	tx.Donation = NewDonationClient(tx.config)
+	tx.DonationEvent = NewDonationEventClient(tx.config)
+	tx.DonationReminder = NewDonationReminderClient(tx.config)
+	tx.EmergencyCampaign = NewEmergencyCampaignClient(tx.config)
+	tx.EmergencyContact = NewEmergencyContactClient(tx.config)
+	tx.Hospital = NewHospitalClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
 	tx.OTP = NewOTPClient(tx.config)
 	tx.PMILocation = NewPMILocationClient(tx.config)
 	tx.Password = NewPasswordClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
 	tx.Province = NewProvinceClient(tx.config)
+	tx.Questionnaire = NewQuestionnaireClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.RoleAssignment = NewRoleAssignmentClient(tx.config)
+	tx.ScreeningQuestion = NewScreeningQuestionClient(tx.config)
+	tx.ScreeningSubmission = NewScreeningSubmissionClient(tx.config)
+	tx.StockMovement = NewStockMovementClient(tx.config)
 	tx.Subdistrict = NewSubdistrictClient(tx.config)
 }
 
@@ -188,7 +251,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Account.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -35,7 +35,7 @@ func (a *Account) Authorize(ctx *gin.Context) {
 		return
 	}
 
-	tokenPair, err := a.service.Authorize(*body)
+	tokenPair, err := scoped(ctx, a.service).Authorize(*body)
 	if err != nil {
 		a.log.Error("authorize failed", slog.Any("error", err))
 		response.InvalidParameter(ctx, err.Error())
@@ -53,7 +53,7 @@ func (a *Account) Register(ctx *gin.Context) {
 		return
 	}
 
-	if err := a.service.Register(*body); err != nil {
+	if err := scoped(ctx, a.service).Register(*body); err != nil {
 		a.log.Error("register account failed", slog.Any("error", err))
 		if ent.IsConstraintError(err) {
 			response.BadRequest(
@@ -82,7 +82,7 @@ func (a *Account) Activate(ctx *gin.Context) {
 		return
 	}
 
-	if err := a.service.Activate(*body); err != nil {
+	if err := scoped(ctx, a.service).Activate(*body); err != nil {
 		a.log.Error("activate account failed", slog.Any("error", err))
 		response.InvalidParameter(ctx, err.Error())
 		return
@@ -99,7 +99,7 @@ func (a *Account) ResendOTP(ctx *gin.Context) {
 		return
 	}
 
-	if err := a.service.ResendOTP(*body); err != nil {
+	if err := scoped(ctx, a.service).ResendOTP(*body); err != nil {
 		a.log.Error("resend OTP failed", slog.Any("error", err))
 		response.InvalidParameter(ctx, err.Error())
 		return
@@ -112,7 +112,7 @@ func (a *Account) Self(ctx *gin.Context) {
 	httpContext := httpx.NewContext(ctx)
 	session := httpContext.GetUserSession()
 	if session != nil {
-		account, err := a.service.Self(session.ID)
+		account, err := scoped(ctx, a.service).Self(session.ID)
 		if err != nil {
 			a.log.Error("get account failed", slog.Any("error", err))
 			response.Error(ctx, err)
@@ -125,3 +125,55 @@ func (a *Account) Self(ctx *gin.Context) {
 		return
 	}
 }
+
+func (a *Account) HomeLocation(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.HomeLocation](ctx)
+	if berr != nil {
+		a.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	if err := scoped(ctx, a.service).SetHomeLocation(session.ID, *body); err != nil {
+		a.log.Error("set home location failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, nil)
+}
+
+func (a *Account) Impersonate(ctx *gin.Context) {
+	w := httpx.NewContext(ctx)
+	session := w.GetUserSession()
+	claims := w.GetUserSessionClaims()
+	if session == nil || session.Anonymous || claims == nil {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.Impersonation](ctx)
+	if berr != nil {
+		a.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	tokenPair, err := scoped(
+		ctx,
+		a.service,
+	).Impersonate(session.ActorID, claims.Claims.Platform, *body)
+	if err != nil {
+		a.log.Error("impersonate failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, tokenPair)
+}
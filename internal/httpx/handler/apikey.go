@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	APIKey struct {
+		service service.APIKey
+		log     *slog.Logger
+	}
+)
+
+func NewAPIKey(i do.Injector) (APIKey, error) {
+	return APIKey{
+		service: do.MustInvoke[service.APIKey](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (a *APIKey) Keys(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	page, berr := response.ValidateQuery[response.Pagination](ctx)
+	if berr != nil {
+		a.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	keys, total, err := scoped(ctx, a.service).Keys(session.ID, *page)
+	if err != nil {
+		a.log.Error("get api keys failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		response.Entries(
+			responsetypes.APIKeys(keys),
+			page.GetHasReachedMax(int64(total)),
+			page.GetTotalPages(int64(total)),
+		),
+	)
+}
+
+func (a *APIKey) Create(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.APIKey](ctx)
+	if berr != nil {
+		a.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	issued, err := scoped(ctx, a.service).Create(session.ID, *body)
+	a.issued(ctx, issued, err)
+}
+
+func (a *APIKey) Rotate(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	issued, err := scoped(ctx, a.service).Rotate(session.ID, id)
+	a.issued(ctx, issued, err)
+}
+
+func (a *APIKey) Revoke(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	if err = scoped(ctx, a.service).Revoke(session.ID, id); err != nil {
+		a.log.Error("revoke api key failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, nil)
+}
+
+func (a *APIKey) issued(
+	ctx *gin.Context,
+	issued *service.IssuedAPIKey,
+	err error,
+) {
+	if err != nil {
+		a.log.Error("issue api key failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.IssuedAPIKey{IssuedAPIKey: issued}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Audit struct {
+		service service.Audit
+		log     *slog.Logger
+	}
+)
+
+func NewAudit(i do.Injector) (Audit, error) {
+	return Audit{
+		service: do.MustInvoke[service.Audit](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (a *Audit) Logs(ctx *gin.Context) {
+	filter, berr := response.ValidateQuery[request.AuditFilter](ctx)
+	if berr != nil {
+		a.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	logs, total, err := scoped(ctx, a.service).Logs(*filter)
+	if err != nil {
+		a.log.Error("get audit logs failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		response.Entries(
+			responsetypes.AuditLogs(logs),
+			filter.GetHasReachedMax(int64(total)),
+			filter.GetTotalPages(int64(total)),
+		),
+	)
+}
@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	BloodRequest struct {
+		service service.BloodRequest
+		log     *slog.Logger
+	}
+)
+
+func NewBloodRequest(i do.Injector) (BloodRequest, error) {
+	return BloodRequest{
+		service: do.MustInvoke[service.BloodRequest](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (b *BloodRequest) Submit(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.BloodRequest](ctx)
+	if berr != nil {
+		b.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	req, err := scoped(ctx, b.service).Submit(session.ID, *body)
+	if err != nil {
+		b.log.Error("submit blood request failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.BloodRequest{BloodRequest: req}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (b *BloodRequest) Hospital(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	page, berr := response.ValidateQuery[response.Pagination](ctx)
+	if berr != nil {
+		b.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	requests, total, err := scoped(ctx, b.service).Hospital(session.ID, *page)
+	b.entries(ctx, page, requests, total, err)
+}
+
+func (b *BloodRequest) HospitalRequest(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	req, err := scoped(ctx, b.service).HospitalRequest(session.ID, id)
+	b.result(ctx, req, err)
+}
+
+func (b *BloodRequest) Cancel(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.BloodRequestReason](ctx)
+	if berr != nil {
+		b.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	req, err := scoped(ctx, b.service).Cancel(session.ID, id, *body)
+	b.result(ctx, req, err)
+}
+
+func (b *BloodRequest) Location(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	page, berr := response.ValidateQuery[response.Pagination](ctx)
+	if berr != nil {
+		b.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	requests, total, err := scoped(ctx, b.service).Location(id, *page)
+	b.entries(ctx, page, requests, total, err)
+}
+
+func (b *BloodRequest) Get(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	req, err := scoped(ctx, b.service).Get(id)
+	b.result(ctx, req, err)
+}
+
+func (b *BloodRequest) Accept(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	req, err := scoped(ctx, b.service).Accept(session.ID, id)
+	b.result(ctx, req, err)
+}
+
+func (b *BloodRequest) Reject(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.BloodRequestReason](ctx)
+	if berr != nil {
+		b.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	req, err := scoped(ctx, b.service).Reject(session.ID, id, *body)
+	b.result(ctx, req, err)
+}
+
+func (b *BloodRequest) Fulfil(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	req, err := scoped(ctx, b.service).Fulfil(session.ID, id)
+	b.result(ctx, req, err)
+}
+
+func (b *BloodRequest) result(
+	ctx *gin.Context,
+	req *ent.BloodRequest,
+	err error,
+) {
+	if err != nil {
+		b.log.Error("blood request failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.BloodRequest{BloodRequest: req}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (b *BloodRequest) entries(
+	ctx *gin.Context,
+	page *response.Pagination,
+	requests []*ent.BloodRequest,
+	total int,
+	err error,
+) {
+	if err != nil {
+		b.log.Error("get blood requests failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		response.Entries(
+			responsetypes.BloodRequests(requests),
+			page.GetHasReachedMax(int64(total)),
+			page.GetTotalPages(int64(total)),
+		),
+	)
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Card struct {
+		service service.Card
+		log     *slog.Logger
+	}
+)
+
+func NewCard(i do.Injector) (Card, error) {
+	return Card{
+		service: do.MustInvoke[service.Card](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (c *Card) Self(ctx *gin.Context) {
+	hc := httpx.NewContext(ctx)
+	session := hc.GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	platform := ""
+	if claims := hc.GetUserSessionClaims(); claims != nil {
+		platform = claims.Claims.Platform
+	}
+
+	card, err := scoped(ctx, c.service).Issue(session.ID, platform)
+	if err != nil {
+		c.log.Error("issue donor card failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	res := responsetypes.DonorCard{DonorCard: card}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (c *Card) CheckIn(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.CheckIn](ctx)
+	if berr != nil {
+		c.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	checkIn, err := scoped(ctx, c.service).CheckIn(*body)
+	if err != nil {
+		c.log.Error("check in donor failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.CheckIn{CheckIn: checkIn}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Certificate struct {
+		service service.Certificate
+		log     *slog.Logger
+	}
+)
+
+func NewCertificate(i do.Injector) (Certificate, error) {
+	return Certificate{
+		service: do.MustInvoke[service.Certificate](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (c *Certificate) Self(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	milestones, err := scoped(ctx, c.service).Self(session.ID)
+	if err != nil {
+		c.log.Error("get milestones failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	res := responsetypes.Milestones{Milestones: milestones}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (c *Certificate) Download(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	cert, pdf, err := scoped(ctx, c.service).Download(session.ID, id)
+	if err != nil {
+		c.log.Error("download certificate failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(
+		`attachment; filename="setetes-certificate-%d.pdf"`,
+		cert.Milestone,
+	))
+	ctx.Data(http.StatusOK, "application/pdf", pdf)
+}
+
+func (c *Certificate) Verify(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	cert, err := scoped(ctx, c.service).Verify(id)
+	if err != nil {
+		c.log.Error("verify certificate failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Certificate{Certificate: cert}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Donation struct {
+		service service.Donation
+		log     *slog.Logger
+	}
+)
+
+func NewDonation(i do.Injector) (Donation, error) {
+	return Donation{
+		service: do.MustInvoke[service.Donation](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (d *Donation) Record(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.Donation](ctx)
+	if berr != nil {
+		d.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	don, err := scoped(ctx, d.service).Record(session.ID, *body)
+	if err != nil {
+		d.log.Error("record donation failed", slog.Any("error", err))
+		if ent.IsConstraintError(err) {
+			response.BadRequest(
+				ctx,
+				httpx.DuplicateKeyCode,
+				response.NewMessage(
+					response.Warning,
+					"bag number is already recorded",
+				),
+			)
+			return
+		}
+
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Donation{Donation: don}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (d *Donation) Self(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	d.history(ctx, session.ID)
+}
+
+func (d *Donation) Account(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	d.history(ctx, id)
+}
+
+func (d *Donation) history(ctx *gin.Context, accountID uuid.UUID) {
+	page, berr := response.ValidateQuery[response.Pagination](ctx)
+	if berr != nil {
+		d.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	donations, total, err := scoped(ctx, d.service).History(accountID, *page)
+	if err != nil {
+		d.log.Error("get donation history failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		response.Entries(
+			responsetypes.Donations(donations),
+			page.GetHasReachedMax(int64(total)),
+			page.GetTotalPages(int64(total)),
+		),
+	)
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Eligibility struct {
+		service service.Eligibility
+		log     *slog.Logger
+	}
+)
+
+func NewEligibility(i do.Injector) (Eligibility, error) {
+	return Eligibility{
+		service: do.MustInvoke[service.Eligibility](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (e *Eligibility) Self(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	query, berr := response.ValidateQuery[request.Eligibility](ctx)
+	if berr != nil {
+		e.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	result, err := scoped(ctx, e.service).Check(session.ID, query.GetType())
+	if err != nil {
+		e.log.Error("check eligibility failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	res := responsetypes.Eligibility{Result: result, Type: query.GetType()}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (e *Eligibility) Defer(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.Deferral](ctx)
+	if berr != nil {
+		e.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	def, err := scoped(ctx, e.service).Defer(session.ID, *body)
+	if err != nil {
+		e.log.Error("create deferral failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Deferral{Deferral: def}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
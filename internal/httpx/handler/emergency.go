@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Emergency struct {
+		service service.Emergency
+		log     *slog.Logger
+	}
+)
+
+func NewEmergency(i do.Injector) (Emergency, error) {
+	return Emergency{
+		service: do.MustInvoke[service.Emergency](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (e *Emergency) Create(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.EmergencyCampaign](ctx)
+	if berr != nil {
+		e.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	c, err := scoped(ctx, e.service).Create(session.ID, *body)
+	if err != nil {
+		e.log.Error("create emergency campaign failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.EmergencyCampaign{EmergencyCampaign: c}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (e *Emergency) Get(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	c, err := scoped(ctx, e.service).Get(id)
+	e.campaign(ctx, c, err)
+}
+
+func (e *Emergency) Cancel(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	c, err := scoped(ctx, e.service).Cancel(id)
+	e.campaign(ctx, c, err)
+}
+
+func (e *Emergency) NextWave(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	c, err := scoped(ctx, e.service).NextWave(id)
+	e.campaign(ctx, c, err)
+}
+
+func (e *Emergency) Self(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	contacts, err := scoped(ctx, e.service).Self(session.ID)
+	if err != nil {
+		e.log.Error("get emergency call-outs failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		responsetypes.EmergencyCallOuts(contacts),
+	)
+}
+
+func (e *Emergency) Respond(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.EmergencyResponse](ctx)
+	if berr != nil {
+		e.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	contact, err := scoped(ctx, e.service).Respond(session.ID, id, *body)
+	if err != nil {
+		e.log.Error("respond emergency call-out failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.EmergencyCallOut{EmergencyContact: contact}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (e *Emergency) campaign(
+	ctx *gin.Context,
+	c *ent.EmergencyCampaign,
+	err error,
+) {
+	if err != nil {
+		e.log.Error("emergency campaign failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.EmergencyCampaign{EmergencyCampaign: c}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
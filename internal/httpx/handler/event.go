@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Event struct {
+		service service.Event
+		log     *slog.Logger
+	}
+)
+
+func NewEvent(i do.Injector) (Event, error) {
+	return Event{
+		service: do.MustInvoke[service.Event](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (e *Event) GrantOrganizer(ctx *gin.Context) {
+	body, berr := response.ValidateJSON[request.Organizer](ctx)
+	if berr != nil {
+		e.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	if err := scoped(ctx, e.service).GrantOrganizer(*body); err != nil {
+		e.log.Error("grant organizer failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Created(ctx, response.MsgSuccess, nil)
+}
+
+func (e *Event) Create(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.DonationEvent](ctx)
+	if berr != nil {
+		e.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	event, err := scoped(ctx, e.service).Create(session.ID, *body)
+	if err != nil {
+		e.log.Error("create donation event failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.DonationEvent{DonationEvent: event}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (e *Event) Update(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.DonationEvent](ctx)
+	if berr != nil {
+		e.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	event, err := scoped(ctx, e.service).Update(session.ID, id, *body)
+	e.event(ctx, event, err)
+}
+
+func (e *Event) Cancel(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	event, err := scoped(ctx, e.service).Cancel(session.ID, id)
+	e.event(ctx, event, err)
+}
+
+func (e *Event) Organizer(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	page, berr := response.ValidateQuery[response.Pagination](ctx)
+	if berr != nil {
+		e.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	events, total, err := scoped(ctx, e.service).Organizer(session.ID, *page)
+	e.entries(ctx, page, events, total, err)
+}
+
+func (e *Event) Location(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	page, berr := response.ValidateQuery[response.Pagination](ctx)
+	if berr != nil {
+		e.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	events, total, err := scoped(ctx, e.service).Location(id, *page)
+	e.entries(ctx, page, events, total, err)
+}
+
+func (e *Event) Approve(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	event, err := scoped(ctx, e.service).Approve(session.ID, id)
+	e.event(ctx, event, err)
+}
+
+func (e *Event) Reject(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.EventRejection](ctx)
+	if berr != nil {
+		e.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	event, err := scoped(ctx, e.service).Reject(session.ID, id, *body)
+	e.event(ctx, event, err)
+}
+
+func (e *Event) Discover(ctx *gin.Context) {
+	filter, berr := response.ValidateQuery[request.EventFilter](ctx)
+	if berr != nil {
+		e.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	events, total, err := scoped(ctx, e.service).Discover(*filter)
+	e.entries(ctx, &filter.Pagination, events, total, err)
+}
+
+func (e *Event) Get(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	event, err := scoped(ctx, e.service).Get(id)
+	e.event(ctx, event, err)
+}
+
+func (e *Event) event(
+	ctx *gin.Context,
+	event *ent.DonationEvent,
+	err error,
+) {
+	if err != nil {
+		e.log.Error("donation event failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.DonationEvent{DonationEvent: event}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (e *Event) entries(
+	ctx *gin.Context,
+	page *response.Pagination,
+	events []*ent.DonationEvent,
+	total int,
+	err error,
+) {
+	if err != nil {
+		e.log.Error("get donation events failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		response.Entries(
+			responsetypes.DonationEvents(events),
+			page.GetHasReachedMax(int64(total)),
+			page.GetTotalPages(int64(total)),
+		),
+	)
+}
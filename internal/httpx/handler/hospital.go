@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Hospital struct {
+		service service.Hospital
+		log     *slog.Logger
+	}
+)
+
+func NewHospital(i do.Injector) (Hospital, error) {
+	return Hospital{
+		service: do.MustInvoke[service.Hospital](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (h *Hospital) Create(ctx *gin.Context) {
+	body, berr := response.ValidateJSON[request.Hospital](ctx)
+	if berr != nil {
+		h.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	hosp, err := scoped(ctx, h.service).Create(*body)
+	if err != nil {
+		h.log.Error("create hospital failed", slog.Any("error", err))
+		if ent.IsConstraintError(err) {
+			response.BadRequest(
+				ctx,
+				httpx.DuplicateKeyCode,
+				response.NewMessage(
+					response.Warning,
+					"hospital code is already registered",
+				),
+			)
+			return
+		}
+
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Hospital{Hospital: hosp}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (h *Hospital) AddMember(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.HospitalMember](ctx)
+	if berr != nil {
+		h.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	if err = scoped(ctx, h.service).AddMember(id, *body); err != nil {
+		h.log.Error("add hospital member failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, nil)
+}
@@ -4,4 +4,19 @@ import "github.com/samber/do/v2"
 
 var Packages = do.Package(
 	do.Lazy[Account](NewAccount),
+	do.Lazy[APIKey](NewAPIKey),
+	do.Lazy[Audit](NewAudit),
+	do.Lazy[BloodRequest](NewBloodRequest),
+	do.Lazy[BloodUnit](NewBloodUnit),
+	do.Lazy[Card](NewCard),
+	do.Lazy[Certificate](NewCertificate),
+	do.Lazy[Donation](NewDonation),
+	do.Lazy[Eligibility](NewEligibility),
+	do.Lazy[Emergency](NewEmergency),
+	do.Lazy[Event](NewEvent),
+	do.Lazy[Hospital](NewHospital),
+	do.Lazy[RBAC](NewRBAC),
+	do.Lazy[Reminder](NewReminder),
+	do.Lazy[Screening](NewScreening),
+	do.Lazy[Stock](NewStock),
 )
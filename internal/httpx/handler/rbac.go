@@ -0,0 +1,452 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/httpx/route"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	RBAC struct {
+		service service.RBAC
+		routes  route.Registry
+		log     *slog.Logger
+	}
+)
+
+func NewRBAC(i do.Injector) (RBAC, error) {
+	return RBAC{
+		service: do.MustInvoke[service.RBAC](i),
+		routes:  do.MustInvoke[route.Registry](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (r *RBAC) Roles(ctx *gin.Context) {
+	page, berr := response.ValidateQuery[response.Pagination](ctx)
+	if berr != nil {
+		r.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	roles, total, err := scoped(ctx, r.service).Roles(*page)
+	if err != nil {
+		r.log.Error("get roles failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		response.Entries(
+			responsetypes.Roles(roles),
+			page.GetHasReachedMax(int64(total)),
+			page.GetTotalPages(int64(total)),
+		),
+	)
+}
+
+func (r *RBAC) Role(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	rl, err := scoped(ctx, r.service).Role(id)
+	if err != nil {
+		r.log.Error("get role failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Role{Role: rl}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (r *RBAC) CreateRole(ctx *gin.Context) {
+	body, berr := response.ValidateJSON[request.Role](ctx)
+	if berr != nil {
+		r.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	rl, err := scoped(ctx, r.service).CreateRole(*body)
+	if err != nil {
+		r.log.Error("create role failed", slog.Any("error", err))
+		r.invalid(ctx, err, "role key is already used")
+		return
+	}
+
+	res := responsetypes.Role{Role: rl}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (r *RBAC) UpdateRole(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.RoleUpdate](ctx)
+	if berr != nil {
+		r.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	rl, err := scoped(ctx, r.service).UpdateRole(id, *body)
+	if err != nil {
+		r.log.Error("update role failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Role{Role: rl}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (r *RBAC) DeleteRole(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	if err = scoped(ctx, r.service).DeleteRole(id); err != nil {
+		r.log.Error("delete role failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, nil)
+}
+
+func (r *RBAC) AttachPermissions(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.RolePermissions](ctx)
+	if berr != nil {
+		r.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	rl, err := scoped(ctx, r.service).AttachPermissions(id, *body)
+	if err != nil {
+		r.log.Error("attach permissions failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Role{Role: rl}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (r *RBAC) DetachPermission(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	permissionID, err := uuid.Parse(ctx.Param("permission_id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "permission_id must be a valid UUID")
+		return
+	}
+
+	if err = scoped(ctx, r.service).DetachPermission(id, permissionID); err != nil {
+		r.log.Error("detach permission failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, nil)
+}
+
+func (r *RBAC) AddParent(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.RoleParent](ctx)
+	if berr != nil {
+		r.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	rl, err := scoped(ctx, r.service).AddParent(id, *body)
+	if err != nil {
+		r.log.Error("add parent role failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Role{Role: rl}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (r *RBAC) RemoveParent(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	parentID, err := uuid.Parse(ctx.Param("parent_id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "parent_id must be a valid UUID")
+		return
+	}
+
+	if err = scoped(ctx, r.service).RemoveParent(id, parentID); err != nil {
+		r.log.Error("remove parent role failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, nil)
+}
+
+func (r *RBAC) EffectivePermissions(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	perms, err := scoped(ctx, r.service).EffectivePermissions(id)
+	if err != nil {
+		r.log.Error(
+			"get effective permissions failed",
+			slog.Any("error", err),
+		)
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		responsetypes.EffectivePermissions(perms),
+	)
+}
+
+func (r *RBAC) Permissions(ctx *gin.Context) {
+	page, berr := response.ValidateQuery[response.Pagination](ctx)
+	if berr != nil {
+		r.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	perms, total, err := scoped(ctx, r.service).Permissions(*page)
+	if err != nil {
+		r.log.Error("get permissions failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		response.Entries(
+			responsetypes.Permissions(perms),
+			page.GetHasReachedMax(int64(total)),
+			page.GetTotalPages(int64(total)),
+		),
+	)
+}
+
+func (r *RBAC) Permission(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	perm, err := scoped(ctx, r.service).Permission(id)
+	if err != nil {
+		r.log.Error("get permission failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Permission{Permission: perm}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (r *RBAC) CreatePermission(ctx *gin.Context) {
+	body, berr := response.ValidateJSON[request.Permission](ctx)
+	if berr != nil {
+		r.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	perm, err := scoped(ctx, r.service).CreatePermission(*body)
+	if err != nil {
+		r.log.Error("create permission failed", slog.Any("error", err))
+		r.invalid(ctx, err, "permission key is already used in the domain")
+		return
+	}
+
+	res := responsetypes.Permission{Permission: perm}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (r *RBAC) UpdatePermission(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.PermissionUpdate](ctx)
+	if berr != nil {
+		r.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	perm, err := scoped(ctx, r.service).UpdatePermission(id, *body)
+	if err != nil {
+		r.log.Error("update permission failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Permission{Permission: perm}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (r *RBAC) DeletePermission(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	if err = scoped(ctx, r.service).DeletePermission(id); err != nil {
+		r.log.Error("delete permission failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, nil)
+}
+
+func (r *RBAC) Assignments(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	assignments, err := scoped(ctx, r.service).Assignments(id)
+	if err != nil {
+		r.log.Error("get role assignments failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		responsetypes.RoleAssignments(assignments),
+	)
+}
+
+func (r *RBAC) Assign(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.RoleAssignment](ctx)
+	if berr != nil {
+		r.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	assignment, err := scoped(ctx, r.service).Assign(session.ID, *body)
+	if err != nil {
+		r.log.Error("assign role failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.RoleAssignment{RoleAssignment: assignment}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (r *RBAC) Unassign(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	if err = scoped(ctx, r.service).Unassign(id); err != nil {
+		r.log.Error("unassign role failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, nil)
+}
+
+func (r *RBAC) invalid(ctx *gin.Context, err error, duplicate string) {
+	if ent.IsConstraintError(err) {
+		response.BadRequest(
+			ctx,
+			httpx.DuplicateKeyCode,
+			response.NewMessage(response.Warning, duplicate),
+		)
+		return
+	}
+
+	response.InvalidParameter(ctx, err.Error())
+}
+
+// Routes lists every route with whether it is public and the permission
+// guarding it.
+func (r *RBAC) Routes(ctx *gin.Context) {
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		responsetypes.Routes(r.routes.Routes()),
+	)
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Reminder struct {
+		service service.Reminder
+		log     *slog.Logger
+	}
+)
+
+func NewReminder(i do.Injector) (Reminder, error) {
+	return Reminder{
+		service: do.MustInvoke[service.Reminder](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (r *Reminder) Preference(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	pref, err := scoped(ctx, r.service).Preference(session.ID)
+	if err != nil {
+		r.log.Error(
+			"get notification preference failed",
+			slog.Any("error", err),
+		)
+		response.Error(ctx, err)
+		return
+	}
+
+	res := responsetypes.NotificationPreference{NotificationPreference: pref}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (r *Reminder) SetPreference(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.NotificationPreference](ctx)
+	if berr != nil {
+		r.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	pref, err := scoped(ctx, r.service).SetPreference(session.ID, *body)
+	if err != nil {
+		r.log.Error(
+			"update notification preference failed",
+			slog.Any("error", err),
+		)
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.NotificationPreference{NotificationPreference: pref}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
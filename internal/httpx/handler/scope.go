@@ -0,0 +1,13 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+// scoped returns the service bound to the context of the request, so its
+// queries end with the request and the audit log attributes their changes
+// to the actor of the request.
+func scoped[S service.Scoped[S]](ctx *gin.Context, s S) S {
+	return s.WithContext(ctx.Request.Context())
+}
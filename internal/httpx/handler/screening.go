@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Screening struct {
+		service service.Screening
+		log     *slog.Logger
+	}
+)
+
+func NewScreening(i do.Injector) (Screening, error) {
+	return Screening{
+		service: do.MustInvoke[service.Screening](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (s *Screening) Active(ctx *gin.Context) {
+	qn, err := scoped(ctx, s.service).Active()
+	if err != nil {
+		s.log.Error("get active questionnaire failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Questionnaire{Questionnaire: qn}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (s *Screening) Create(ctx *gin.Context) {
+	body, berr := response.ValidateJSON[request.Questionnaire](ctx)
+	if berr != nil {
+		s.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	qn, err := scoped(ctx, s.service).CreateQuestionnaire(*body)
+	if err != nil {
+		s.log.Error("create questionnaire failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.Questionnaire{Questionnaire: qn}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (s *Screening) Activate(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	if err = scoped(ctx, s.service).Activate(id); err != nil {
+		s.log.Error("activate questionnaire failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, nil)
+}
+
+func (s *Screening) Submit(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.ScreeningSubmission](ctx)
+	if berr != nil {
+		s.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	submission, err := scoped(ctx, s.service).Submit(session.ID, *body)
+	if err != nil {
+		s.log.Error("submit screening failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.ScreeningSubmission{ScreeningSubmission: submission}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Stock struct {
+		service service.Stock
+		log     *slog.Logger
+	}
+)
+
+func NewStock(i do.Injector) (Stock, error) {
+	return Stock{
+		service: do.MustInvoke[service.Stock](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (s *Stock) Move(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.StockMovement](ctx)
+	if berr != nil {
+		s.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	movements, err := scoped(ctx, s.service).Move(session.ID, *body)
+	if err != nil {
+		s.log.Error("move stock failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Created(
+		ctx,
+		response.MsgSuccess,
+		responsetypes.StockMovements(movements),
+	)
+}
+
+func (s *Stock) Location(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	stocks, err := scoped(ctx, s.service).Location(id)
+	if err != nil {
+		s.log.Error("get location stock failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, responsetypes.BloodStocks(stocks))
+}
+
+func (s *Stock) Movements(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	page, berr := response.ValidateQuery[response.Pagination](ctx)
+	if berr != nil {
+		s.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	movements, total, err := scoped(ctx, s.service).Movements(id, *page)
+	if err != nil {
+		s.log.Error("get stock movements failed", slog.Any("error", err))
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Ok(
+		ctx,
+		response.MsgSuccess,
+		response.Entries(
+			responsetypes.StockMovements(movements),
+			page.GetHasReachedMax(int64(total)),
+			page.GetTotalPages(int64(total)),
+		),
+	)
+}
+
+func (s *Stock) Region(ctx *gin.Context) {
+	query, berr := response.ValidateQuery[request.StockRegion](ctx)
+	if berr != nil {
+		s.log.Error("validate query failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	stocks, err := scoped(ctx, s.service).Region(query.Region)
+	if err != nil {
+		s.log.Error("get region stock failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	response.Ok(ctx, response.MsgSuccess, responsetypes.RegionStocks(stocks))
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/response/responsetypes"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	BloodUnit struct {
+		service service.BloodUnit
+		log     *slog.Logger
+	}
+)
+
+func NewBloodUnit(i do.Injector) (BloodUnit, error) {
+	return BloodUnit{
+		service: do.MustInvoke[service.BloodUnit](i),
+		log:     slog.Default(),
+	}, nil
+}
+
+func (b *BloodUnit) Register(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.BloodUnit](ctx)
+	if berr != nil {
+		b.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	unit, err := scoped(ctx, b.service).Register(session.ID, *body)
+	if err != nil {
+		b.log.Error("register blood unit failed", slog.Any("error", err))
+		if ent.IsConstraintError(err) {
+			response.BadRequest(
+				ctx,
+				httpx.DuplicateKeyCode,
+				response.NewMessage(
+					response.Warning,
+					"barcode is already registered",
+				),
+			)
+			return
+		}
+
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.BloodUnit{BloodUnit: unit}
+
+	response.Created(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (b *BloodUnit) Get(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	unit, err := scoped(ctx, b.service).Get(id)
+	if err != nil {
+		b.log.Error("get blood unit failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.BloodUnit{BloodUnit: unit}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (b *BloodUnit) Barcode(ctx *gin.Context) {
+	unit, err := scoped(ctx, b.service).Barcode(ctx.Param("barcode"))
+	if err != nil {
+		b.log.Error("get blood unit failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.BloodUnit{BloodUnit: unit}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
+
+func (b *BloodUnit) Transition(ctx *gin.Context) {
+	session := httpx.NewContext(ctx).GetUserSession()
+	if session == nil || session.Anonymous {
+		response.Unauthorized(ctx)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.InvalidParameter(ctx, "id must be a valid UUID")
+		return
+	}
+
+	body, berr := response.ValidateJSON[request.BloodUnitStatus](ctx)
+	if berr != nil {
+		b.log.Error("validate request failed", slog.Any("error", berr))
+		response.Error(ctx, berr)
+		return
+	}
+
+	unit, err := scoped(ctx, b.service).Transition(session.ID, id, *body)
+	if err != nil {
+		b.log.Error("change blood unit status failed", slog.Any("error", err))
+		response.InvalidParameter(ctx, err.Error())
+		return
+	}
+
+	res := responsetypes.BloodUnit{BloodUnit: unit}
+
+	response.Ok(ctx, response.MsgSuccess, res.ToResponse())
+}
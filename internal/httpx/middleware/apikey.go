@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"log/slog"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+)
+
+const headerXAPIKey = "X-API-Key"
+
+// authAPIKey authorizes a request made with an API key as the account of the
+// key, once the request is signed by the hospital of the key and the
+// permission of the route is in the scopes of the key. The signature is
+// verified before the key is looked up, and the use of the key is only
+// recorded once the request is authorized. Sensitive routes are never
+// reachable with a key.
+func authAPIKey(c *gin.Context, config Config, token string) {
+	resource := c.Request.URL.Path
+	action := c.Request.Method
+
+	partner, ok := config.signatures.authenticate(c)
+	if !ok {
+		return
+	}
+
+	key, err := config.keys.Verify(c.Request.Context(), token)
+	if err != nil {
+		log.Warn(
+			"Invalid API key",
+			slog.String("method", action),
+			slog.String("url", resource),
+			slog.String("error", err.Error()),
+		)
+		response.Unauthorized(c)
+		c.Abort()
+		return
+	}
+
+	if partner != key.Edges.Hospital.ID.String() {
+		log.Warn(
+			"API key signed by another partner",
+			slog.String("key", key.Prefix),
+			slog.String("partner", partner),
+			slog.String("method", action),
+			slog.String("url", resource),
+		)
+		response.Unauthorized(c)
+		c.Abort()
+		return
+	}
+
+	rt, ok := config.routes[action+" "+c.FullPath()]
+	if !ok || rt.Permission == nil || rt.IsSensitive() ||
+		!slices.Contains(key.Scopes, rt.Permission.Key) {
+		log.Warn(
+			"Route out of API key scopes",
+			slog.String("key", key.Prefix),
+			slog.String("method", action),
+			slog.String("url", resource),
+		)
+		response.Forbidden(c)
+		c.Abort()
+		return
+	}
+
+	subject := key.Edges.Account.ID
+	domain, ok := authorize(c, config, subject.String())
+	if !ok {
+		return
+	}
+
+	if err = config.keys.Touch(c.Request.Context(), key); err != nil {
+		log.Error(
+			"Failed to record API key use",
+			slog.String("key", key.Prefix),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	httpx.NewContext(c).SetUserSession(httpx.UserSession{
+		ID:       subject,
+		ActorID:  subject,
+		Domain:   domain,
+		APIKeyID: key.ID,
+	})
+
+	c.Next()
+}
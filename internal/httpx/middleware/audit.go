@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/audit"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/httpx"
+)
+
+// Audit carries the actor of the request in the request context, so the
+// audit log attributes the changes made while serving it, and records every
+// request made while impersonating. It must run after RequestID and
+// Authorization.
+func Audit(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		w := httpx.NewContext(c)
+		actor := audit.Actor{IP: c.ClientIP()}
+		if rid := w.GetRequestID(); rid != uuid.Nil {
+			actor.RequestID = &rid
+		}
+
+		us := w.GetUserSession()
+		if us != nil && !us.Anonymous {
+			actor.ID = &us.ActorID
+			if us.Impersonated() {
+				actor.SubjectID = &us.ID
+			}
+		}
+
+		if claims := w.GetUserSessionClaims(); claims != nil {
+			actor.SessionID = claims.Claims.TokenIdentifier
+		} else if us != nil && us.APIKeyID != uuid.Nil {
+			actor.SessionID = "apikey:" + us.APIKeyID.String()
+		}
+
+		ctx := audit.NewContext(c.Request.Context(), actor)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		if actor.SubjectID == nil {
+			return
+		}
+
+		// The request context may be done once the handler returns.
+		if err := audit.Record(
+			context.WithoutCancel(ctx),
+			client,
+			audit.Entry{
+				Action:   audit.ActionImpersonationRequest,
+				Entity:   ent.TypeAccount,
+				EntityID: us.ID.String(),
+				After: map[string]any{
+					"method": c.Request.Method,
+					"path":   c.Request.URL.Path,
+					"status": c.Writer.Status(),
+				},
+			},
+		); err != nil {
+			log.Error(
+				"Failed to record impersonated request",
+				slog.String("actor", us.ActorID.String()),
+				slog.String("subject", us.ID.String()),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
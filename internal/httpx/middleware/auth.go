@@ -1,26 +1,39 @@
 package middleware
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
 	"log/slog"
+	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gobwas/glob"
 	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/credential"
 	"github.com/sembraniteam/setetes/internal/cryptox/pasetox"
 	"github.com/sembraniteam/setetes/internal/httpx"
 	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/route"
 	"github.com/sembraniteam/setetes/internal/rbac"
 )
 
 var log = slog.Default()
 
-const rulesLen = 3
+const (
+	headerXDomain = "X-Domain"
+	regionParam   = "region"
+)
 
 type Config struct {
-	manager  *rbac.Manager
-	verifier *pasetox.Verifier
-	patterns []glob.Glob
+	manager    *rbac.Manager
+	verifier   *pasetox.Verifier
+	patterns   []glob.Glob
+	keys       *credential.Verifier
+	signatures *SignatureConfig
+	routes     map[string]route.Route
 }
 
 func NewAuthorizationConfig(
@@ -48,6 +61,24 @@ func NewAuthorizationConfig(
 	}
 }
 
+// WithAPIKeys accepts API keys in the X-API-Key header as an alternative to
+// bearer tokens. A key is limited to the routes whose permission is in its
+// scopes, and every request made with a key must be signed by its hospital.
+func (c *Config) WithAPIKeys(
+	keys *credential.Verifier,
+	signatures *SignatureConfig,
+	registry route.Registry,
+) *Config {
+	c.keys = keys
+	c.signatures = signatures
+	c.routes = make(map[string]route.Route)
+	for _, rt := range registry.Routes() {
+		c.routes[rt.Method+" "+rt.Path] = rt
+	}
+
+	return c
+}
+
 func (c *Config) Authorization() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		auth(ctx, *c)
@@ -58,7 +89,6 @@ func auth(
 	c *gin.Context,
 	config Config,
 ) {
-	enforcer := config.manager.GetEnforcer()
 	ctx := httpx.NewContext(c)
 	resource := c.Request.URL.Path
 	action := c.Request.Method
@@ -74,6 +104,11 @@ func auth(
 		}
 	}
 
+	if key := c.GetHeader(headerXAPIKey); key != "" && config.keys != nil {
+		authAPIKey(c, config, key)
+		return
+	}
+
 	header := c.GetHeader("Authorization")
 	if header == "" {
 		log.Warn(
@@ -111,87 +146,239 @@ func auth(
 		return
 	}
 
-	var domain string
-	grouping, err := enforcer.GetFilteredGroupingPolicy(0, claims.Subject)
+	domain, ok := authorize(c, config, claims.Subject)
+	if !ok {
+		return
+	}
+
+	parsedSubject, err := uuid.Parse(claims.Subject)
 	if err != nil {
 		log.Error(
-			"Failed to get filtered grouping policy",
+			"Invalid UUID format",
+			slog.String("subject", claims.Subject),
 			slog.String("method", action),
 			slog.String("url", resource),
 			slog.String("error", err.Error()),
 		)
+		response.Unauthorized(c)
+		c.Abort()
+		return
 	}
 
-	for _, rule := range grouping {
-		if len(rule) >= rulesLen {
-			domain = rule[2]
-			break
+	actor := parsedSubject
+	if claims.Actor != "" {
+		if actor, err = uuid.Parse(claims.Actor); err != nil {
+			log.Error(
+				"Invalid actor UUID format",
+				slog.String("actor", claims.Actor),
+				slog.String("method", action),
+				slog.String("url", resource),
+				slog.String("error", err.Error()),
+			)
+			response.Unauthorized(c)
+			c.Abort()
+			return
 		}
+
+		log.Info(
+			"Impersonated request",
+			slog.String("actor", claims.Actor),
+			slog.String("subject", claims.Subject),
+			slog.String("method", action),
+			slog.String("url", resource),
+		)
 	}
 
-	if domain == "" {
+	ctx.SetUserSession(httpx.UserSession{
+		ID:        parsedSubject,
+		ActorID:   actor,
+		Anonymous: false,
+		Domain:    domain,
+	})
+	ctx.SetUserSessionClaims(httpx.UserSessionClaims{
+		Claims: *claims,
+	})
+
+	c.Next()
+}
+
+// authorize enforces the request for the subject and returns the domain it is
+// allowed in. It responds and aborts the request when it is not allowed.
+func authorize(c *gin.Context, config Config, subject string) (string, bool) {
+	resource := c.Request.URL.Path
+	action := c.Request.Method
+
+	domains, err := config.manager.Domains(subject)
+	if err != nil {
+		log.Error(
+			"Failed to get domains of subject",
+			slog.String("method", action),
+			slog.String("url", resource),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	if len(domains) == 0 {
 		log.Warn(
 			"Missing domain for subject",
-			slog.String("subject", claims.Subject),
+			slog.String("subject", subject),
 			slog.String("method", action),
 			slog.String("url", resource),
 		)
 		response.Forbidden(c)
 		c.Abort()
-		return
+		return "", false
 	}
 
-	allowed, err := enforcer.Enforce(
-		claims.Subject,
-		domain,
-		resource,
-		action,
-	)
+	requested, err := requestDomain(c, config.manager)
 	if err != nil {
 		log.Error(
-			"Failed to check permissions",
-			slog.String("subject", claims.Subject),
+			"Failed to resolve request domain",
+			slog.String("subject", subject),
 			slog.String("method", action),
 			slog.String("url", resource),
 			slog.String("error", err.Error()),
 		)
 		response.Unauthorized(c)
 		c.Abort()
-		return
+		return "", false
 	}
 
-	if !allowed {
+	header := strings.TrimSpace(c.GetHeader(headerXDomain))
+	domains = candidateDomains(domains, requested, header)
+	if len(domains) == 0 {
 		log.Warn(
-			"Permission denied",
-			slog.String("subject", claims.Subject),
+			"Requested domain not held by subject",
+			slog.String("subject", subject),
+			slog.String("domain", header),
 			slog.String("method", action),
 			slog.String("url", resource),
 		)
 		response.Forbidden(c)
 		c.Abort()
-		return
+		return "", false
 	}
 
-	parsedSubject, err := uuid.Parse(claims.Subject)
-	if err != nil {
-		log.Error(
-			"Invalid UUID format",
-			slog.String("subject", claims.Subject),
+	var domain string
+	for _, d := range domains {
+		allowed, err := config.manager.Enforce(
+			c.Request.Context(),
+			subject,
+			d,
+			resource,
+			action,
+		)
+		if err != nil {
+			log.Error(
+				"Failed to check permissions",
+				slog.String("subject", subject),
+				slog.String("method", action),
+				slog.String("url", resource),
+				slog.String("error", err.Error()),
+			)
+			response.Unauthorized(c)
+			c.Abort()
+			return "", false
+		}
+
+		if allowed {
+			domain = d
+			break
+		}
+	}
+
+	if domain == "" {
+		log.Warn(
+			"Permission denied",
+			slog.String("subject", subject),
 			slog.String("method", action),
 			slog.String("url", resource),
-			slog.String("error", err.Error()),
 		)
-		response.Unauthorized(c)
+		response.Forbidden(c)
 		c.Abort()
-		return
+		return "", false
 	}
-	ctx.SetUserSession(httpx.UserSession{
-		ID:        parsedSubject,
-		Anonymous: false,
-	})
-	ctx.SetUserSessionClaims(httpx.UserSessionClaims{
-		Claims: *claims,
-	})
 
-	c.Next()
+	return domain, true
+}
+
+// candidateDomains returns the domains a request is enforced in, in order.
+// A request resolved to a domain is evaluated in that domain only, which the
+// subject holds when one of its domains covers it. Otherwise the X-Domain
+// header picks one of the domains the subject holds, and without it every
+// domain of the subject is tried. A header naming a domain the subject does
+// not hold leaves none.
+func candidateDomains(held []string, resolved, header string) []string {
+	if resolved != "" {
+		return []string{resolved}
+	}
+
+	if header == "" {
+		return held
+	}
+
+	if slices.Contains(held, header) {
+		return []string{header}
+	}
+
+	return nil
+}
+
+// requestDomain returns the domain a request is made in: the region of a
+// :region path parameter or the region of the resource the request targets.
+// It returns an empty string when neither is known. The X-Domain header is
+// not read, so a client cannot move a request out of the region of its
+// resource.
+func requestDomain(c *gin.Context, manager *rbac.Manager) (string, error) {
+	if code := c.Param(regionParam); code != "" {
+		return rbac.RegionDomain(code), nil
+	}
+
+	route := c.FullPath()
+	if !manager.HasDomainResolver(route) {
+		return "", nil
+	}
+
+	params := make(map[string]string, len(c.Params))
+	for _, p := range c.Params {
+		params[p.Key] = p.Value
+	}
+
+	if err := bodyParams(c, params); err != nil {
+		return "", err
+	}
+
+	return manager.ResolveDomain(c.Request.Context(), route, params)
+}
+
+// bodyParams adds the top-level string fields of a JSON body to params
+// without overriding the path parameters, then restores the body for the
+// handler. The body is read whatever its content type, which the JSON
+// binding of the handler ignores too. A body that is not a JSON object adds
+// nothing and is left for the handler to reject.
+func bodyParams(c *gin.Context, params map[string]string) error {
+	if c.Request.Body == nil || c.Request.Body == http.NoBody {
+		return nil
+	}
+
+	raw, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(raw))
+
+	var fields map[string]any
+	_ = json.Unmarshal(raw, &fields)
+
+	for key, value := range fields {
+		if _, ok := params[key]; ok {
+			continue
+		}
+
+		if v, ok := value.(string); ok {
+			params[key] = v
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,313 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sembraniteam/setetes/internal/database/sqlitex"
+	"github.com/sembraniteam/setetes/internal/rbac"
+)
+
+const (
+	subject      = "4f0c7a52-3a1e-4d7b-9b0e-6f2d8c1a9e31"
+	locationPath = "/stock/v1/locations/:id"
+)
+
+func TestCandidateDomains(t *testing.T) {
+	held := []string{"region:31", "region:32"}
+	tests := []struct {
+		name     string
+		resolved string
+		header   string
+		want     []string
+	}{
+		{
+			name: "every held domain",
+			want: held,
+		},
+		{
+			name:     "resolved domain",
+			resolved: "region:3171",
+			want:     []string{"region:3171"},
+		},
+		{
+			name:     "resolved domain wins over header",
+			resolved: "region:3271",
+			header:   "region:31",
+			want:     []string{"region:3271"},
+		},
+		{
+			name:   "header picks a held domain",
+			header: "region:32",
+			want:   []string{"region:32"},
+		},
+		{
+			name:   "header names a domain not held",
+			header: "region:33",
+		},
+		{
+			name:   "header names a region below a held one",
+			header: "region:3171",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := candidateDomains(held, tt.resolved, tt.header)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf(
+					"candidateDomains(%v, %q, %q) = %v, want %v",
+					held, tt.resolved, tt.header, got, tt.want,
+				)
+			}
+		})
+	}
+}
+
+func TestRequestDomain(t *testing.T) {
+	manager := newManager(t)
+	manager.RegisterDomainResolvers(map[string]rbac.DomainResolver{
+		locationPath:          paramDomain("id"),
+		"/stock/v1/movements": paramDomain("pmi_location_id"),
+	})
+
+	tests := []struct {
+		name   string
+		route  string
+		method string
+		path   string
+		body   string
+		want   string
+	}{
+		{
+			name:   "region path parameter",
+			route:  "/stock/v1/regions/:region",
+			method: http.MethodGet,
+			path:   "/stock/v1/regions/3171",
+			want:   "region:3171",
+		},
+		{
+			name:   "resource path parameter",
+			route:  locationPath,
+			method: http.MethodGet,
+			path:   "/stock/v1/locations/3271",
+			want:   "region:3271",
+		},
+		{
+			name:   "path parameter wins over body field",
+			route:  locationPath,
+			method: http.MethodPost,
+			path:   "/stock/v1/locations/3271",
+			body:   `{"id": "3171"}`,
+			want:   "region:3271",
+		},
+		{
+			name:   "body field",
+			route:  "/stock/v1/movements",
+			method: http.MethodPost,
+			path:   "/stock/v1/movements",
+			body:   `{"pmi_location_id": "3171", "quantity": 2}`,
+			want:   "region:3171",
+		},
+		{
+			name:   "body without the field",
+			route:  "/stock/v1/movements",
+			method: http.MethodPost,
+			path:   "/stock/v1/movements",
+			body:   `{"quantity": 2}`,
+		},
+		{
+			name:   "body that is not JSON",
+			route:  "/stock/v1/movements",
+			method: http.MethodPost,
+			path:   "/stock/v1/movements",
+			body:   "pmi_location_id=3171",
+		},
+		{
+			name:   "route without resolver",
+			route:  "/account/v1/self",
+			method: http.MethodGet,
+			path:   "/account/v1/self",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got, body string
+			router := gin.New()
+			router.Handle(tt.method, tt.route, func(c *gin.Context) {
+				var err error
+				if got, err = requestDomain(c, manager); err != nil {
+					t.Fatalf("requestDomain: %v", err)
+				}
+
+				raw, _ := io.ReadAll(c.Request.Body)
+				body = string(raw)
+			})
+
+			req := httptest.NewRequest(
+				tt.method,
+				tt.path,
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set(headerXDomain, "region:99")
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			if got != tt.want {
+				t.Errorf("requestDomain() = %q, want %q", got, tt.want)
+			}
+
+			if body != tt.body {
+				t.Errorf("handler read body %q, want %q", body, tt.body)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	manager := newManager(t)
+	manager.RegisterDomainResolvers(map[string]rbac.DomainResolver{
+		locationPath: paramDomain("id"),
+	})
+
+	if err := manager.AddPolicy(
+		"pmi-staff",
+		rbac.AnyDomain,
+		locationPath,
+		http.MethodGet,
+	); err != nil {
+		t.Fatalf("add policy: %v", err)
+	}
+
+	if err := manager.AddPolicy(
+		"pmi-staff",
+		rbac.AnyDomain,
+		"/stock/v1/summary",
+		http.MethodGet,
+	); err != nil {
+		t.Fatalf("add policy: %v", err)
+	}
+
+	for _, domain := range []string{"region:31", "region:32"} {
+		if err := manager.AddRoleForUser(
+			subject,
+			"pmi-staff",
+			domain,
+		); err != nil {
+			t.Fatalf("add role: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		route      string
+		path       string
+		header     string
+		wantStatus int
+		wantDomain string
+	}{
+		{
+			name:       "resource in a held region",
+			route:      locationPath,
+			path:       "/stock/v1/locations/3171",
+			wantStatus: http.StatusOK,
+			wantDomain: "region:3171",
+		},
+		{
+			name:       "resource outside the held regions",
+			route:      locationPath,
+			path:       "/stock/v1/locations/3371",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "header cannot move a resolved resource",
+			route:      locationPath,
+			path:       "/stock/v1/locations/3371",
+			header:     "region:31",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "first held domain without header",
+			route:      "/stock/v1/summary",
+			path:       "/stock/v1/summary",
+			wantStatus: http.StatusOK,
+			wantDomain: "region:31",
+		},
+		{
+			name:       "header picks a held domain",
+			route:      "/stock/v1/summary",
+			path:       "/stock/v1/summary",
+			header:     "region:32",
+			wantStatus: http.StatusOK,
+			wantDomain: "region:32",
+		},
+		{
+			name:       "header names a domain not held",
+			route:      "/stock/v1/summary",
+			path:       "/stock/v1/summary",
+			header:     "region:33",
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var domain string
+			router := gin.New()
+			router.GET(tt.route, func(c *gin.Context) {
+				var ok bool
+				if domain, ok = authorize(
+					c,
+					Config{manager: manager},
+					subject,
+				); ok {
+					c.Status(http.StatusOK)
+				}
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set(headerXDomain, tt.header)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			if domain != tt.wantDomain {
+				t.Errorf("domain = %q, want %q", domain, tt.wantDomain)
+			}
+		})
+	}
+}
+
+func newManager(t *testing.T) *rbac.Manager {
+	t.Helper()
+
+	manager, err := rbac.New(sqlitex.Open(t))
+	if err != nil {
+		t.Fatalf("new rbac manager: %v", err)
+	}
+
+	return manager
+}
+
+// paramDomain resolves the region whose BPS code is in the parameter.
+func paramDomain(name string) rbac.DomainResolver {
+	return func(_ context.Context, params map[string]string) (string, error) {
+		if params[name] == "" {
+			return "", nil
+		}
+
+		return rbac.RegionDomain(params[name]), nil
+	}
+}
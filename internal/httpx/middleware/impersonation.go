@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sembraniteam/setetes/internal/httpx"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+)
+
+// NoImpersonation forbids a sensitive route, such as changing a password or
+// deleting data, to sessions impersonating their subject. It must run after
+// Authorization.
+func NoImpersonation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		us := httpx.NewContext(c).GetUserSession()
+		if us != nil && us.Impersonated() {
+			log.Warn(
+				"Sensitive action while impersonating",
+				slog.String("actor", us.ActorID.String()),
+				slog.String("subject", us.ID.String()),
+				slog.String("method", c.Request.Method),
+				slog.String("url", c.Request.URL.Path),
+			)
+			response.Forbidden(c)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
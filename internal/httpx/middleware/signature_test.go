@@ -0,0 +1,485 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sembraniteam/setetes/internal/database/sqlitex"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/httpx"
+)
+
+const (
+	signedPath = "/request/v1/requests"
+	signedBody = `{"pmi_location_id":"5c2e9a1d-7b4f-4e8a-9c3d-2f1a6b8e0d47"}`
+)
+
+// signedRequest is a request signed by a partner, which each test case
+// changes before it is sent.
+type signedRequest struct {
+	partner   string
+	secret    string
+	method    string
+	uri       string
+	timestamp string
+	nonce     string
+	body      string
+	signature string
+}
+
+func newSignedRequest(partner, secret string) signedRequest {
+	return signedRequest{
+		partner:   partner,
+		secret:    secret,
+		method:    http.MethodPost,
+		uri:       signedPath,
+		timestamp: strconv.FormatInt(time.Now().Unix(), 10),
+		nonce:     strings.ReplaceAll(uuid.NewString(), "-", ""),
+		body:      signedBody,
+	}
+}
+
+// sign returns the hex HMAC-SHA256 of the request as its partner signs it.
+func (r signedRequest) sign() string {
+	digest := sha256.Sum256([]byte(r.body))
+	mac := hmac.New(sha256.New, []byte(r.secret))
+	_, _ = mac.Write([]byte(strings.Join([]string{
+		r.method,
+		r.uri,
+		r.timestamp,
+		r.nonce,
+		hex.EncodeToString(digest[:]),
+	}, "\n")))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (r signedRequest) build() *http.Request {
+	req := httptest.NewRequest(r.method, r.uri, strings.NewReader(r.body))
+	signature := r.signature
+	if signature == "" {
+		signature = r.sign()
+	}
+
+	req.Header.Set(headerXSignature, signature)
+	req.Header.Set(headerXSignatureKey, r.partner)
+	req.Header.Set(headerXSignatureTimestamp, r.timestamp)
+	req.Header.Set(headerXSignatureNonce, r.nonce)
+
+	return req
+}
+
+func TestVerifySignature(t *testing.T) {
+	partner := uuid.NewString()
+	secret := "partner-secret"
+	s := NewSignatureConfig(
+		newRedis(t),
+		nil,
+		map[string]string{partner: secret},
+		time.Minute,
+	)
+
+	tests := []struct {
+		name    string
+		change  func(r *signedRequest)
+		tamper  func(req *http.Request)
+		wantErr string
+	}{
+		{
+			name: "valid signature",
+		},
+		{
+			name: "partner key in upper case",
+			change: func(r *signedRequest) {
+				r.partner = strings.ToUpper(r.partner)
+			},
+		},
+		{
+			name: "missing signature",
+			tamper: func(req *http.Request) {
+				req.Header.Del(headerXSignature)
+			},
+			wantErr: "missing signature",
+		},
+		{
+			name: "unknown partner",
+			change: func(r *signedRequest) {
+				r.partner = uuid.NewString()
+			},
+			wantErr: "unknown partner",
+		},
+		{
+			name: "invalid timestamp",
+			change: func(r *signedRequest) {
+				r.timestamp = "yesterday"
+			},
+			wantErr: "invalid timestamp",
+		},
+		{
+			name: "stale timestamp",
+			change: func(r *signedRequest) {
+				r.timestamp = strconv.FormatInt(
+					time.Now().Add(-2*time.Minute).Unix(),
+					10,
+				)
+			},
+			wantErr: "stale timestamp",
+		},
+		{
+			name: "timestamp in the future",
+			change: func(r *signedRequest) {
+				r.timestamp = strconv.FormatInt(
+					time.Now().Add(2*time.Minute).Unix(),
+					10,
+				)
+			},
+			wantErr: "stale timestamp",
+		},
+		{
+			name: "invalid nonce",
+			change: func(r *signedRequest) {
+				r.nonce = "short"
+			},
+			wantErr: "invalid nonce",
+		},
+		{
+			name: "signature not in hex",
+			change: func(r *signedRequest) {
+				r.signature = "not-hex"
+			},
+			wantErr: "invalid signature encoding",
+		},
+		{
+			name: "signed with another secret",
+			change: func(r *signedRequest) {
+				r.secret = "other-secret"
+			},
+			wantErr: "signature mismatch",
+		},
+		{
+			name: "tampered body",
+			tamper: func(req *http.Request) {
+				req.Body = io.NopCloser(strings.NewReader(`{}`))
+			},
+			wantErr: "signature mismatch",
+		},
+		{
+			name: "tampered query",
+			tamper: func(req *http.Request) {
+				req.URL.RawQuery = "page=2"
+				req.RequestURI = signedPath + "?page=2"
+			},
+			wantErr: "signature mismatch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newSignedRequest(partner, secret)
+			if tt.change != nil {
+				tt.change(&r)
+			}
+
+			req := r.build()
+			if tt.tamper != nil {
+				tt.tamper(req)
+			}
+
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = req
+
+			got, err := s.verify(c)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("verify() error = %v, want %s", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("verify() error = %v", err)
+			}
+
+			if got != partner {
+				t.Errorf("verify() = %q, want %q", got, partner)
+			}
+
+			body, _ := io.ReadAll(c.Request.Body)
+			if string(body) != r.body {
+				t.Errorf(
+					"body left for the handler = %q, want %q",
+					body,
+					r.body,
+				)
+			}
+		})
+	}
+}
+
+func TestVerifySignatureRejectsReplay(t *testing.T) {
+	partner := uuid.NewString()
+	s := NewSignatureConfig(
+		newRedis(t),
+		nil,
+		map[string]string{partner: "partner-secret"},
+		time.Minute,
+	)
+
+	r := newSignedRequest(partner, "partner-secret")
+	for i, want := range []string{"", "replayed nonce"} {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = r.build()
+
+		_, err := s.verify(c)
+		if got := errString(err); got != want {
+			t.Errorf("request %d: verify() error = %q, want %q", i+1, got, want)
+		}
+	}
+}
+
+func TestSignature(t *testing.T) {
+	client := sqlitex.Open(t)
+	member := newMember(t, client, "RS001", "3171010001")
+	other := newMember(t, client, "RS002", "3271010001")
+	outsider := newAccount(t, client, "outsider", "081234560000", nil)
+
+	memberPartner := member.Edges.Hospital.ID.String()
+	otherPartner := other.Edges.Hospital.ID.String()
+	secrets := map[string]string{
+		memberPartner: "member-secret",
+		otherPartner:  "other-secret",
+	}
+	s := NewSignatureConfig(newRedis(t), client, secrets, time.Minute)
+
+	bearer := httpx.UserSession{ID: member.ID, ActorID: member.ID}
+	tests := []struct {
+		name       string
+		session    *httpx.UserSession
+		partner    string
+		unsigned   bool
+		wantStatus int
+	}{
+		{
+			name:       "bearer call signed by its hospital",
+			session:    &bearer,
+			partner:    memberPartner,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unsigned bearer call",
+			session:    &bearer,
+			unsigned:   true,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "bearer call signed by another hospital",
+			session:    &bearer,
+			partner:    otherPartner,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "account outside any hospital",
+			session: &httpx.UserSession{
+				ID:      outsider.ID,
+				ActorID: outsider.ID,
+			},
+			partner:    memberPartner,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "anonymous call",
+			session:    &httpx.UserSession{Anonymous: true},
+			partner:    memberPartner,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "no session",
+			partner:    memberPartner,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "API key call verified by Authorization",
+			session: &httpx.UserSession{
+				ID:       member.ID,
+				ActorID:  member.ID,
+				APIKeyID: uuid.New(),
+			},
+			unsigned:   true,
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body string
+			router := gin.New()
+			router.POST(
+				signedPath,
+				func(c *gin.Context) {
+					if tt.session != nil {
+						httpx.NewContext(c).SetUserSession(*tt.session)
+					}
+				},
+				s.Signature(),
+				func(c *gin.Context) {
+					raw, _ := io.ReadAll(c.Request.Body)
+					body = string(raw)
+					c.Status(http.StatusOK)
+				},
+			)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				signedPath,
+				strings.NewReader(signedBody),
+			)
+			if !tt.unsigned {
+				req = newSignedRequest(tt.partner, secrets[tt.partner]).build()
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			if tt.wantStatus == http.StatusOK && body != signedBody {
+				t.Errorf("handler read body %q, want %q", body, signedBody)
+			}
+		})
+	}
+}
+
+func newRedis(t *testing.T) *redis.Client {
+	t.Helper()
+
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+	})
+
+	return rdb
+}
+
+// newMember creates an account that is a member of a new hospital in a new
+// subdistrict with the BPS code.
+func newMember(
+	t *testing.T,
+	client *ent.Client,
+	code, bpsCode string,
+) *ent.Account {
+	t.Helper()
+
+	ctx := context.Background()
+	province, err := client.Province.Create().
+		SetBpsCode(bpsCode[:2]).
+		SetName("Province " + code).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create province: %v", err)
+	}
+
+	city, err := client.City.Create().
+		SetBpsCode(bpsCode[:4]).
+		SetName("City " + code).
+		SetProvince(province).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create city: %v", err)
+	}
+
+	district, err := client.District.Create().
+		SetBpsCode(bpsCode[:7]).
+		SetName("District " + code).
+		SetCity(city).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create district: %v", err)
+	}
+
+	subdistrict, err := client.Subdistrict.Create().
+		SetBpsCode(bpsCode).
+		SetPostalCode("10110").
+		SetName("Subdistrict " + code).
+		SetDistrict(district).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create subdistrict: %v", err)
+	}
+
+	h, err := client.Hospital.Create().
+		SetCode(code).
+		SetName("Hospital " + code).
+		SetStreet("Jl. Kesehatan No. 1").
+		SetDialCode("62").
+		SetPhoneNumber("02155500" + code[2:]).
+		SetSubdistrict(subdistrict).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create hospital: %v", err)
+	}
+
+	acc := newAccount(t, client, code, "0812345600"+code[3:], h)
+	acc.Edges.Hospital = h
+
+	return acc
+}
+
+func newAccount(
+	t *testing.T,
+	client *ent.Client,
+	name, phone string,
+	h *ent.Hospital,
+) *ent.Account {
+	t.Helper()
+
+	acc, err := client.Account.Create().
+		SetNationalIDHash(uuid.NewString()).
+		SetNationalIDMasked("****" + phone[len(phone)-4:]).
+		SetFullName("Staff " + name).
+		SetGender(account.GenderFemale).
+		SetEmail(name + "@hospital.test").
+		SetCountryIsoCode("ID").
+		SetDialCode("62").
+		SetPhoneNumber(phone).
+		SetActivated(true).
+		SetNillableHospitalID(hospitalID(h)).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	return acc
+}
+
+func hospitalID(h *ent.Hospital) *uuid.UUID {
+	if h == nil {
+		return nil
+	}
+
+	return &h.ID
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
@@ -1,10 +1,20 @@
 package request
 
 import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/sembraniteam/setetes/internal/ent/account"
 	"github.com/sembraniteam/setetes/internal/ent/otp"
 )
 
+const (
+	nikLen          = 16
+	femaleDayOffset = 40
+)
+
 type (
 	Authorize struct {
 		Email    string `json:"email"    validate:"required,email"`
@@ -13,7 +23,7 @@ type (
 	}
 
 	Account struct {
-		NationalID     string `json:"national_id"      validate:"required,len=16"`
+		NationalID     string `json:"national_id"      validate:"required,len=16,numeric"`
 		FullName       string `json:"full_name"        validate:"required,min=3,max=164"`
 		Gender         string `json:"gender"           validate:"required,oneof=FEMALE MALE" reason:"oneof=gender must be one of FEMALE, MALE"`
 		Email          string `json:"email"            validate:"required,email"`
@@ -32,6 +42,11 @@ type (
 		Email string `json:"email" validate:"required,email"`
 		Type  string `json:"type"  validate:"required,oneof=ACTIVATION RESET_PASSWORD CHANGE_PASSWORD" reason:"oneof=type must be one of ACTIVATION, RESET_PASSWORD, CHANGE_PASSWORD"`
 	}
+
+	Impersonation struct {
+		AccountID uuid.UUID `json:"account_id" validate:"required"`
+		Reason    string    `json:"reason"     validate:"required,min=3,max=300"`
+	}
 )
 
 func (a *Account) GetGender() account.Gender {
@@ -43,6 +58,48 @@ func (a *Account) GetGender() account.Gender {
 	}
 }
 
+// GetBirthDate decodes the date of birth embedded in digits 7 to 12 of the
+// national identity number (DDMMYY). Women have 40 added to the day.
+func (a *Account) GetBirthDate() (time.Time, error) {
+	if len(a.NationalID) != nikLen {
+		return time.Time{}, errors.New("invalid national_id length")
+	}
+
+	dd, err := strconv.Atoi(a.NationalID[6:8])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	mm, err := strconv.Atoi(a.NationalID[8:10])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	yy, err := strconv.Atoi(a.NationalID[10:12])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if dd > femaleDayOffset {
+		dd -= femaleDayOffset
+	}
+
+	now := time.Now()
+	year := 2000 + yy
+	if year > now.Year() {
+		year -= 100
+	}
+
+	birth := time.Date(year, time.Month(mm), dd, 0, 0, 0, 0, time.UTC)
+	if birth.Day() != dd || int(birth.Month()) != mm || birth.After(now) {
+		return time.Time{}, errors.New(
+			"national_id contains an invalid birth date",
+		)
+	}
+
+	return birth, nil
+}
+
 func (r *ResendOTP) GetType() otp.Type {
 	switch r.Type {
 	case "ACTIVATION":
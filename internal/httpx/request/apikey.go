@@ -0,0 +1,9 @@
+package request
+
+type (
+	APIKey struct {
+		Name      string   `json:"name"       validate:"required,min=3,max=164"`
+		Scopes    []string `json:"scopes"     validate:"required,min=1,max=100,dive,required,max=64"`
+		ExpiresAt int64    `json:"expires_at" validate:"omitempty,gt=0"`
+	}
+)
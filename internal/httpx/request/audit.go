@@ -0,0 +1,18 @@
+package request
+
+import "github.com/sembraniteam/setetes/internal/httpx/response"
+
+type (
+	AuditFilter struct {
+		response.Pagination
+		ActorID   string `form:"actor_id"   validate:"omitempty,uuid"`
+		SubjectID string `form:"subject_id" validate:"omitempty,uuid"`
+		SessionID string `form:"session_id" validate:"omitempty,max=64"`
+		RequestID string `form:"request_id" validate:"omitempty,uuid"`
+		Action    string `form:"action"     validate:"omitempty,max=64"`
+		Entity    string `form:"entity"     validate:"omitempty,max=64"`
+		EntityID  string `form:"entity_id"  validate:"omitempty,max=64"`
+		From      int64  `form:"from"       validate:"omitempty,gt=0"`
+		To        int64  `form:"to"         validate:"omitempty,gtfield=From"`
+	}
+)
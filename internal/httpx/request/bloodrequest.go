@@ -0,0 +1,39 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+)
+
+type (
+	BloodRequest struct {
+		BloodType
+		PMILocationID       uuid.UUID `json:"pmi_location_id"       validate:"required"`
+		Component           string    `json:"component"             validate:"required,oneof=WHOLE_BLOOD PRC TC FFP"   reason:"oneof=component must be one of WHOLE_BLOOD, PRC, TC, FFP"`
+		Quantity            int       `json:"quantity"              validate:"required,min=1,max=100"`
+		Urgency             string    `json:"urgency"               validate:"required,oneof=ROUTINE URGENT EMERGENCY" reason:"oneof=urgency must be one of ROUTINE, URGENT, EMERGENCY"`
+		RequiredBy          int64     `json:"required_by"           validate:"required,gt=0"`
+		PatientName         string    `json:"patient_name"          validate:"required,min=3,max=164"`
+		PatientRecordNumber string    `json:"patient_record_number" validate:"omitempty,max=32"`
+		Note                string    `json:"note"                  validate:"omitempty,max=300"`
+	}
+
+	BloodRequestReason struct {
+		Reason string `json:"reason" validate:"required,min=3,max=300"`
+	}
+)
+
+func (b *BloodRequest) GetComponent() bloodrequest.Component {
+	return bloodrequest.Component(getComponent(b.Component))
+}
+
+func (b *BloodRequest) GetUrgency() bloodrequest.Urgency {
+	switch b.Urgency {
+	case "URGENT":
+		return bloodrequest.UrgencyUrgent
+	case "EMERGENCY":
+		return bloodrequest.UrgencyEmergency
+	default:
+		return bloodrequest.UrgencyRoutine
+	}
+}
@@ -0,0 +1,17 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+)
+
+type CheckIn struct {
+	Token         string    `json:"token"           validate:"required"`
+	PMILocationID uuid.UUID `json:"pmi_location_id" validate:"required"`
+	Type          string    `json:"type"            validate:"omitempty,oneof=WHOLE_BLOOD APHERESIS_PLATELETS APHERESIS_PLASMA" reason:"oneof=type must be one of WHOLE_BLOOD, APHERESIS_PLATELETS, APHERESIS_PLASMA"`
+}
+
+func (c *CheckIn) GetType() donation.Type {
+	e := Eligibility{Type: c.Type}
+	return e.GetType()
+}
@@ -0,0 +1,22 @@
+package request
+
+import (
+	"github.com/sembraniteam/setetes/internal/ent/schema"
+	"github.com/twpayne/go-geom"
+)
+
+const srid = 4326
+
+type Coordinates struct {
+	Latitude  *float64 `json:"latitude"  validate:"required,latitude"`
+	Longitude *float64 `json:"longitude" validate:"required,longitude"`
+}
+
+func (c *Coordinates) GetGeoPoint() *schema.GeoPoint {
+	point := geom.NewPointFlat(
+		geom.XY,
+		[]float64{*c.Longitude, *c.Latitude},
+	).SetSRID(srid)
+
+	return &schema.GeoPoint{Point: point}
+}
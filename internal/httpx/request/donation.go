@@ -0,0 +1,29 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+)
+
+type (
+	Donation struct {
+		AccountID     uuid.UUID  `json:"account_id"      validate:"required"`
+		PMILocationID uuid.UUID  `json:"pmi_location_id" validate:"required"`
+		AppointmentID *uuid.UUID `json:"appointment_id"  validate:"omitempty"`
+		DonatedAt     int64      `json:"donated_at"      validate:"required,gt=0"`
+		Type          string     `json:"type"            validate:"required,oneof=WHOLE_BLOOD APHERESIS_PLATELETS APHERESIS_PLASMA" reason:"oneof=type must be one of WHOLE_BLOOD, APHERESIS_PLATELETS, APHERESIS_PLASMA"`
+		VolumeML      int16      `json:"volume_ml"       validate:"required,min=50,max=700"`
+		BagNumber     string     `json:"bag_number"      validate:"required,min=3,max=64"`
+	}
+)
+
+func (d *Donation) GetType() donation.Type {
+	switch d.Type {
+	case "APHERESIS_PLATELETS":
+		return donation.TypeApheresisPlatelets
+	case "APHERESIS_PLASMA":
+		return donation.TypeApheresisPlasma
+	default:
+		return donation.TypeWholeBlood
+	}
+}
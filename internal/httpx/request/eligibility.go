@@ -0,0 +1,41 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+)
+
+type (
+	Eligibility struct {
+		Type string `form:"type" validate:"omitempty,oneof=WHOLE_BLOOD APHERESIS_PLATELETS APHERESIS_PLASMA" reason:"oneof=type must be one of WHOLE_BLOOD, APHERESIS_PLATELETS, APHERESIS_PLASMA"`
+	}
+
+	Deferral struct {
+		AccountID uuid.UUID `json:"account_id" validate:"required"`
+		Type      string    `json:"type"       validate:"required,oneof=TEMPORARY PERMANENT"        reason:"oneof=type must be one of TEMPORARY, PERMANENT"`
+		Reason    string    `json:"reason"     validate:"required,min=3,max=300"`
+		StartsAt  int64     `json:"starts_at"  validate:"omitempty,gt=0"`
+		EndsAt    int64     `json:"ends_at"    validate:"required_if=Type TEMPORARY,omitempty,gt=0"`
+	}
+)
+
+func (e *Eligibility) GetType() donation.Type {
+	switch e.Type {
+	case "APHERESIS_PLATELETS":
+		return donation.TypeApheresisPlatelets
+	case "APHERESIS_PLASMA":
+		return donation.TypeApheresisPlasma
+	default:
+		return donation.TypeWholeBlood
+	}
+}
+
+func (d *Deferral) GetType() deferral.Type {
+	switch d.Type {
+	case "PERMANENT":
+		return deferral.TypePermanent
+	default:
+		return deferral.TypeTemporary
+	}
+}
@@ -0,0 +1,51 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+)
+
+const (
+	defaultWaveSize = 20
+	responseAccept  = "ACCEPT"
+)
+
+type (
+	EmergencyCampaign struct {
+		BloodType
+		PMILocationID uuid.UUID `json:"pmi_location_id" validate:"required"`
+		Component     string    `json:"component"       validate:"required,oneof=WHOLE_BLOOD PRC TC FFP" reason:"oneof=component must be one of WHOLE_BLOOD, PRC, TC, FFP"`
+		NeededDonors  int       `json:"needed_donors"   validate:"required,min=1,max=100"`
+		RadiusKM      int       `json:"radius_km"       validate:"required,min=1,max=100"`
+		WaveSize      int       `json:"wave_size"       validate:"omitempty,min=1,max=500"`
+		Message       string    `json:"message"         validate:"omitempty,max=300"`
+	}
+
+	EmergencyResponse struct {
+		Response string `json:"response" validate:"required,oneof=ACCEPT DECLINE" reason:"oneof=response must be one of ACCEPT, DECLINE"`
+	}
+
+	HomeLocation struct {
+		Coordinates
+	}
+)
+
+func (e *EmergencyCampaign) GetComponent() emergencycampaign.Component {
+	return emergencycampaign.Component(getComponent(e.Component))
+}
+
+func (e *EmergencyCampaign) GetRadius() int {
+	return e.RadiusKM * 1000
+}
+
+func (e *EmergencyCampaign) GetWaveSize() int {
+	if e.WaveSize == 0 {
+		return defaultWaveSize
+	}
+
+	return e.WaveSize
+}
+
+func (e *EmergencyResponse) IsAccepted() bool {
+	return e.Response == responseAccept
+}
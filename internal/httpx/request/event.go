@@ -0,0 +1,54 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+)
+
+type (
+	DonationEvent struct {
+		Coordinates
+		Title         string    `json:"title"           validate:"required,min=3,max=164"`
+		Description   string    `json:"description"     validate:"omitempty,max=2000"`
+		HostName      string    `json:"host_name"       validate:"required,min=3,max=164"`
+		HostType      string    `json:"host_type"       validate:"required,oneof=OFFICE CAMPUS WORSHIP_PLACE COMMUNITY OTHER" reason:"oneof=host_type must be one of OFFICE, CAMPUS, WORSHIP_PLACE, COMMUNITY, OTHER"`
+		Venue         string    `json:"venue"           validate:"required,min=3,max=164"`
+		Street        string    `json:"street"          validate:"required"`
+		SubdistrictID uuid.UUID `json:"subdistrict_id"  validate:"required"`
+		PMILocationID uuid.UUID `json:"pmi_location_id" validate:"required"`
+		StartsAt      int64     `json:"starts_at"       validate:"required,gt=0"`
+		EndsAt        int64     `json:"ends_at"         validate:"required,gtfield=StartsAt"`
+		Capacity      int       `json:"capacity"        validate:"required,min=1,max=1000"`
+	}
+
+	EventRejection struct {
+		Reason string `json:"reason" validate:"required,min=3,max=300"`
+	}
+
+	EventFilter struct {
+		response.Pagination
+		Region string `form:"region" validate:"omitempty,numeric,max=10"`
+		From   int64  `form:"from"   validate:"omitempty,gt=0"`
+		To     int64  `form:"to"     validate:"omitempty,gtfield=From"`
+	}
+
+	Organizer struct {
+		AccountID uuid.UUID `json:"account_id" validate:"required"`
+	}
+)
+
+func (d *DonationEvent) GetHostType() donationevent.HostType {
+	switch d.HostType {
+	case "OFFICE":
+		return donationevent.HostTypeOffice
+	case "CAMPUS":
+		return donationevent.HostTypeCampus
+	case "WORSHIP_PLACE":
+		return donationevent.HostTypeWorshipPlace
+	case "COMMUNITY":
+		return donationevent.HostTypeCommunity
+	default:
+		return donationevent.HostTypeOther
+	}
+}
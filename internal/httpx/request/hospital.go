@@ -0,0 +1,19 @@
+package request
+
+import "github.com/google/uuid"
+
+type (
+	Hospital struct {
+		Code          string    `json:"code"           validate:"required,min=3,max=16"`
+		Name          string    `json:"name"           validate:"required,min=3,max=164"`
+		Street        string    `json:"street"         validate:"required"`
+		Email         string    `json:"email"          validate:"omitempty,email,max=164"`
+		DialCode      string    `json:"dial_code"      validate:"required,numeric,min=1,max=6"`
+		PhoneNumber   string    `json:"phone_number"   validate:"required,numeric,min=11,max=13"`
+		SubdistrictID uuid.UUID `json:"subdistrict_id" validate:"required"`
+	}
+
+	HospitalMember struct {
+		AccountID uuid.UUID `json:"account_id" validate:"required"`
+	}
+)
@@ -0,0 +1,12 @@
+package request
+
+type NotificationPreference struct {
+	RemindersEnabled *bool  `json:"reminders_enabled" validate:"required"`
+	PushEnabled      *bool  `json:"push_enabled"      validate:"required"`
+	EmailEnabled     *bool  `json:"email_enabled"     validate:"required"`
+	SMSEnabled       *bool  `json:"sms_enabled"       validate:"required"`
+	PushToken        string `json:"push_token"        validate:"omitempty,max=512"`
+	Timezone         string `json:"timezone"          validate:"required,timezone"`
+	QuietHoursStart  string `json:"quiet_hours_start" validate:"required_with=QuietHoursEnd,omitempty,datetime=15:04"   reason:"datetime=quiet_hours_start must be formatted as HH:MM"`
+	QuietHoursEnd    string `json:"quiet_hours_end"   validate:"required_with=QuietHoursStart,omitempty,datetime=15:04" reason:"datetime=quiet_hours_end must be formatted as HH:MM"`
+}
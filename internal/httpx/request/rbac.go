@@ -0,0 +1,51 @@
+package request
+
+import "github.com/google/uuid"
+
+type (
+	Role struct {
+		Name        string `json:"name"        validate:"required,min=3,max=164"`
+		Key         string `json:"key"         validate:"required,min=3,max=164"`
+		Domain      string `json:"domain"      validate:"required,min=1,max=164"`
+		Description string `json:"description" validate:"omitempty,min=30,max=300"`
+		Activated   bool   `json:"activated"`
+	}
+
+	RoleUpdate struct {
+		Name        string `json:"name"        validate:"required,min=3,max=164"`
+		Description string `json:"description" validate:"omitempty,min=30,max=300"`
+		Activated   *bool  `json:"activated"   validate:"required"`
+	}
+
+	RolePermissions struct {
+		PermissionIDs []uuid.UUID `json:"permission_ids" validate:"required,min=1,max=100,dive,required"`
+	}
+
+	RoleParent struct {
+		ParentID uuid.UUID `json:"parent_id" validate:"required"`
+	}
+
+	Permission struct {
+		Name        string `json:"name"        validate:"required,min=3,max=164"`
+		Key         string `json:"key"         validate:"required,min=3,max=164"`
+		Domain      string `json:"domain"      validate:"required,min=1,max=164"`
+		Resource    string `json:"resource"    validate:"required,startswith=/,max=255"`
+		Action      string `json:"action"      validate:"required,max=64"`
+		Condition   string `json:"condition"   validate:"omitempty,max=64"`
+		Description string `json:"description" validate:"omitempty,min=30,max=300"`
+	}
+
+	PermissionUpdate struct {
+		Name        string `json:"name"        validate:"required,min=3,max=164"`
+		Resource    string `json:"resource"    validate:"required,startswith=/,max=255"`
+		Action      string `json:"action"      validate:"required,max=64"`
+		Condition   string `json:"condition"   validate:"omitempty,max=64"`
+		Description string `json:"description" validate:"omitempty,min=30,max=300"`
+	}
+
+	RoleAssignment struct {
+		AccountID uuid.UUID `json:"account_id" validate:"required"`
+		RoleID    uuid.UUID `json:"role_id"    validate:"required"`
+		Domain    string    `json:"domain"     validate:"omitempty,min=1,max=164"`
+	}
+)
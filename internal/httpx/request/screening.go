@@ -0,0 +1,40 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+)
+
+type (
+	Questionnaire struct {
+		Title     string     `json:"title"     validate:"required,min=3,max=164"`
+		Questions []Question `json:"questions" validate:"required,min=1,dive"`
+	}
+
+	Question struct {
+		Key             string   `json:"key"              validate:"required,min=1,max=64"`
+		Text            string   `json:"text"             validate:"required"`
+		AnswerType      string   `json:"answer_type"      validate:"required,oneof=YES_NO CHOICE NUMBER DATE TEXT"                     reason:"oneof=answer_type must be one of YES_NO, CHOICE, NUMBER, DATE, TEXT"`
+		Options         []string `json:"options"          validate:"required_if=AnswerType CHOICE,dive,required"`
+		Optional        bool     `json:"optional"`
+		DeferralAnswers []string `json:"deferral_answers" validate:"omitempty,dive,required"`
+		DeferralType    string   `json:"deferral_type"    validate:"required_with=DeferralAnswers,omitempty,oneof=TEMPORARY PERMANENT" reason:"oneof=deferral_type must be one of TEMPORARY, PERMANENT"`
+		DeferralDays    int16    `json:"deferral_days"    validate:"required_if=DeferralType TEMPORARY,omitempty,min=1"`
+		DeferralReason  string   `json:"deferral_reason"  validate:"required_with=DeferralAnswers,omitempty,min=3,max=300"`
+	}
+
+	ScreeningSubmission struct {
+		QuestionnaireID uuid.UUID         `json:"questionnaire_id" validate:"required"`
+		AppointmentID   *uuid.UUID        `json:"appointment_id"   validate:"omitempty"`
+		Answers         map[string]string `json:"answers"          validate:"required,min=1"`
+		Declaration     bool              `json:"declaration"      validate:"required"       reason:"required=declaration must be accepted"`
+	}
+)
+
+func (q *Question) GetAnswerType() screeningquestion.AnswerType {
+	return screeningquestion.AnswerType(q.AnswerType)
+}
+
+func (q *Question) GetDeferralType() screeningquestion.DeferralType {
+	return screeningquestion.DeferralType(q.DeferralType)
+}
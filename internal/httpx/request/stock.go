@@ -0,0 +1,89 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+const movementTransfer = "TRANSFER"
+
+type (
+	BloodType struct {
+		Group  string `json:"group"  validate:"required,oneof=A B AB O"          reason:"oneof=group must be one of A, B, AB, O"`
+		Rhesus string `json:"rhesus" validate:"required,oneof=POSITIVE NEGATIVE" reason:"oneof=rhesus must be one of POSITIVE, NEGATIVE"`
+	}
+
+	StockMovement struct {
+		BloodType
+		PMILocationID       uuid.UUID  `json:"pmi_location_id"        validate:"required"`
+		Component           string     `json:"component"              validate:"required,oneof=WHOLE_BLOOD PRC TC FFP"                     reason:"oneof=component must be one of WHOLE_BLOOD, PRC, TC, FFP"`
+		Type                string     `json:"type"                   validate:"required,oneof=COLLECT ISSUE DISCARD TRANSFER"             reason:"oneof=type must be one of COLLECT, ISSUE, DISCARD, TRANSFER"`
+		Quantity            int        `json:"quantity"               validate:"required,min=1,max=10000"`
+		TargetPMILocationID *uuid.UUID `json:"target_pmi_location_id" validate:"required_if=Type TRANSFER,omitempty,nefield=PMILocationID"`
+		Note                string     `json:"note"                   validate:"omitempty,max=300"`
+	}
+
+	StockRegion struct {
+		Region string `form:"region" validate:"omitempty,numeric,max=10"`
+	}
+)
+
+func (b *BloodType) GetGroup() bloodtype.Group {
+	switch b.Group {
+	case "A":
+		return bloodtype.GroupBloodA
+	case "B":
+		return bloodtype.GroupBloodB
+	case "AB":
+		return bloodtype.GroupBloodAB
+	default:
+		return bloodtype.GroupBloodO
+	}
+}
+
+func (b *BloodType) GetRhesus() bloodtype.Rhesus {
+	switch b.Rhesus {
+	case "NEGATIVE":
+		return bloodtype.RhesusNegative
+	default:
+		return bloodtype.RhesusPositive
+	}
+}
+
+func (s *StockMovement) GetComponent() bloodstock.Component {
+	return getComponent(s.Component)
+}
+
+func getComponent(c string) bloodstock.Component {
+	switch c {
+	case "PRC":
+		return bloodstock.ComponentPRC
+	case "TC":
+		return bloodstock.ComponentTC
+	case "FFP":
+		return bloodstock.ComponentFFP
+	default:
+		return bloodstock.ComponentWholeBlood
+	}
+}
+
+func (s *StockMovement) IsTransfer() bool {
+	return s.Type == movementTransfer
+}
+
+// GetType returns the movement type applied to the source location. A
+// transfer is recorded as TRANSFER_OUT there and TRANSFER_IN at the target.
+func (s *StockMovement) GetType() stockmovement.Type {
+	switch s.Type {
+	case "ISSUE":
+		return stockmovement.TypeIssue
+	case "DISCARD":
+		return stockmovement.TypeDiscard
+	case movementTransfer:
+		return stockmovement.TypeTransferOut
+	default:
+		return stockmovement.TypeCollect
+	}
+}
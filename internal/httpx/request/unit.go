@@ -0,0 +1,40 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+)
+
+type (
+	BloodUnit struct {
+		BloodType
+		Barcode       string     `json:"barcode"         validate:"required,min=3,max=64"`
+		PMILocationID uuid.UUID  `json:"pmi_location_id" validate:"required"`
+		Component     string     `json:"component"       validate:"required,oneof=WHOLE_BLOOD PRC TC FFP" reason:"oneof=component must be one of WHOLE_BLOOD, PRC, TC, FFP"`
+		CollectedAt   int64      `json:"collected_at"    validate:"required,gt=0"`
+		ExpiresAt     int64      `json:"expires_at"      validate:"omitempty,gtfield=CollectedAt"`
+		DonationID    *uuid.UUID `json:"donation_id"`
+	}
+
+	BloodUnitStatus struct {
+		Status string `json:"status" validate:"required,oneof=TESTED AVAILABLE ISSUED DISCARDED" reason:"oneof=status must be one of TESTED, AVAILABLE, ISSUED, DISCARDED"`
+		Note   string `json:"note"   validate:"omitempty,max=300"`
+	}
+)
+
+func (b *BloodUnit) GetComponent() bloodunit.Component {
+	return bloodunit.Component(getComponent(b.Component))
+}
+
+func (b *BloodUnitStatus) GetStatus() bloodunit.Status {
+	switch b.Status {
+	case "TESTED":
+		return bloodunit.StatusTested
+	case "AVAILABLE":
+		return bloodunit.StatusAvailable
+	case "ISSUED":
+		return bloodunit.StatusIssued
+	default:
+		return bloodunit.StatusDiscarded
+	}
+}
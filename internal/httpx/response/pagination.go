@@ -8,10 +8,10 @@ import (
 
 type (
 	Pagination struct {
-		page   int    `form:"page"   validate:"omitempty,numeric,min=1"`
-		limit  int    `form:"limit"  validate:"omitempty,numeric,min=1,max=1000"`
-		search string `form:"search" validate:"omitempty,max=100"`
-		sort   string `form:"sort"   validate:"omitempty,oneof=ASC DESC"         reason:"oneof=Order must be one of ASC, DESC"`
+		Page   int    `form:"page"   validate:"omitempty,numeric,min=1"`
+		Limit  int    `form:"limit"  validate:"omitempty,numeric,min=1,max=1000"`
+		Search string `form:"search" validate:"omitempty,max=100"`
+		Sort   string `form:"sort"   validate:"omitempty,oneof=ASC DESC"         reason:"oneof=Order must be one of ASC, DESC"`
 	}
 
 	BaseEntries[T any] struct {
@@ -34,23 +34,27 @@ func Entries[T any](
 }
 
 func (p *Pagination) GetPage() int {
-	if p.page == 0 {
-		p.page = 1
+	if p.Page == 0 {
+		p.Page = 1
 	}
 
-	return p.page
+	return p.Page
 }
 
 func (p *Pagination) GetLimit() int {
-	if p.limit == 0 {
-		p.limit = 10
+	if p.Limit == 0 {
+		p.Limit = 10
 	}
 
-	return p.limit
+	return p.Limit
+}
+
+func (p *Pagination) GetOffset() int {
+	return (p.GetPage() - 1) * p.GetLimit()
 }
 
 func (p *Pagination) GetSearch() string {
-	s := strings.ToLower(strings.TrimSpace(p.search))
+	s := strings.ToLower(strings.TrimSpace(p.Search))
 	maxLen := 100
 
 	if (len(s)) > maxLen {
@@ -66,11 +70,11 @@ func (p *Pagination) GetSearch() string {
 }
 
 func (p *Pagination) GetSort() string {
-	if p.sort == "" {
-		p.sort = "DESC"
+	if p.Sort == "" {
+		p.Sort = "DESC"
 	}
 
-	return p.sort
+	return p.Sort
 }
 
 func (p *Pagination) GetTotalPages(items int64) int64 {
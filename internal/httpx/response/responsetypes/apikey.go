@@ -0,0 +1,72 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	APIKey struct {
+		*ent.APIKey
+	}
+
+	APIKeyResponse struct {
+		ID         uuid.UUID `json:"id"`
+		Name       string    `json:"name"`
+		Prefix     string    `json:"prefix"`
+		Scopes     []string  `json:"scopes"`
+		HospitalID uuid.UUID `json:"hospital_id"`
+		Hospital   string    `json:"hospital"`
+		ExpiresAt  int64     `json:"expires_at"`
+		LastUsedAt *int64    `json:"last_used_at"`
+		RevokedAt  *int64    `json:"revoked_at"`
+		CreatedAt  int64     `json:"created_at"`
+	}
+
+	IssuedAPIKey struct {
+		*service.IssuedAPIKey
+	}
+
+	IssuedAPIKeyResponse struct {
+		APIKeyResponse
+		// Token is shown once, when the key is created or rotated.
+		Token string `json:"token"`
+	}
+)
+
+func (a APIKey) ToResponse() APIKeyResponse {
+	res := APIKeyResponse{
+		ID:         a.ID,
+		Name:       a.Name,
+		Prefix:     a.Prefix,
+		Scopes:     a.Scopes,
+		ExpiresAt:  a.ExpiresAt,
+		LastUsedAt: a.LastUsedAt,
+		RevokedAt:  a.RevokedAt,
+		CreatedAt:  a.CreatedAt,
+	}
+
+	if h := a.Edges.Hospital; h != nil {
+		res.HospitalID = h.ID
+		res.Hospital = h.Name
+	}
+
+	return res
+}
+
+func APIKeys(keys []*ent.APIKey) []APIKeyResponse {
+	out := make([]APIKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, APIKey{APIKey: k}.ToResponse())
+	}
+
+	return out
+}
+
+func (i IssuedAPIKey) ToResponse() IssuedAPIKeyResponse {
+	return IssuedAPIKeyResponse{
+		APIKeyResponse: APIKey{APIKey: i.Key}.ToResponse(),
+		Token:          i.Token,
+	}
+}
@@ -0,0 +1,53 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+)
+
+type (
+	AuditLog struct {
+		*ent.AuditLog
+	}
+
+	AuditLogResponse struct {
+		ID        uuid.UUID      `json:"id"`
+		ActorID   *uuid.UUID     `json:"actor_id"`
+		SubjectID *uuid.UUID     `json:"subject_id,omitempty"`
+		SessionID string         `json:"session_id,omitempty"`
+		RequestID *uuid.UUID     `json:"request_id"`
+		Action    string         `json:"action"`
+		Entity    string         `json:"entity,omitempty"`
+		EntityID  string         `json:"entity_id,omitempty"`
+		Before    map[string]any `json:"before,omitempty"`
+		After     map[string]any `json:"after,omitempty"`
+		IP        string         `json:"ip"`
+		CreatedAt int64          `json:"created_at"`
+	}
+)
+
+func (a AuditLog) ToResponse() AuditLogResponse {
+	return AuditLogResponse{
+		ID:        a.ID,
+		ActorID:   a.ActorID,
+		SubjectID: a.SubjectID,
+		SessionID: a.SessionID,
+		RequestID: a.RequestID,
+		Action:    a.Action,
+		Entity:    a.Entity,
+		EntityID:  a.EntityID,
+		Before:    a.Before,
+		After:     a.After,
+		IP:        a.IP,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func AuditLogs(logs []*ent.AuditLog) []AuditLogResponse {
+	out := make([]AuditLogResponse, 0, len(logs))
+	for _, l := range logs {
+		out = append(out, AuditLog{AuditLog: l}.ToResponse())
+	}
+
+	return out
+}
@@ -0,0 +1,108 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+)
+
+type (
+	BloodRequest struct {
+		*ent.BloodRequest
+	}
+
+	BloodRequestResponse struct {
+		ID                  uuid.UUID              `json:"id"`
+		HospitalID          uuid.UUID              `json:"hospital_id"`
+		Hospital            string                 `json:"hospital"`
+		PMILocationID       uuid.UUID              `json:"pmi_location_id"`
+		PMILocation         string                 `json:"pmi_location"`
+		PatientName         string                 `json:"patient_name"`
+		PatientRecordNumber string                 `json:"patient_record_number"`
+		Group               bloodtype.Group        `json:"group"`
+		Rhesus              bloodtype.Rhesus       `json:"rhesus"`
+		Component           bloodrequest.Component `json:"component"`
+		Quantity            int                    `json:"quantity"`
+		FulfilledQuantity   int                    `json:"fulfilled_quantity"`
+		Urgency             bloodrequest.Urgency   `json:"urgency"`
+		RequiredBy          int64                  `json:"required_by"`
+		Status              bloodrequest.Status    `json:"status"`
+		Note                string                 `json:"note"`
+		Reason              string                 `json:"reason"`
+		Units               []RequestUnitResponse  `json:"units"`
+		CreatedAt           int64                  `json:"created_at"`
+		UpdatedAt           int64                  `json:"updated_at"`
+	}
+
+	RequestUnitResponse struct {
+		ID        uuid.UUID        `json:"id"`
+		Barcode   string           `json:"barcode"`
+		Group     bloodtype.Group  `json:"group"`
+		Rhesus    bloodtype.Rhesus `json:"rhesus"`
+		Status    bloodunit.Status `json:"status"`
+		ExpiresAt int64            `json:"expires_at"`
+	}
+)
+
+func (b BloodRequest) ToResponse() BloodRequestResponse {
+	res := BloodRequestResponse{
+		ID:                  b.ID,
+		PatientName:         b.PatientName,
+		PatientRecordNumber: b.PatientRecordNumber,
+		Component:           b.Component,
+		Quantity:            b.Quantity,
+		FulfilledQuantity:   b.FulfilledQuantity,
+		Urgency:             b.Urgency,
+		RequiredBy:          b.RequiredBy,
+		Status:              b.Status,
+		Note:                b.Note,
+		Reason:              b.Reason,
+		Units:               make([]RequestUnitResponse, 0, len(b.Edges.Units)),
+		CreatedAt:           b.CreatedAt,
+		UpdatedAt:           b.UpdatedAt,
+	}
+
+	if h := b.Edges.Hospital; h != nil {
+		res.HospitalID = h.ID
+		res.Hospital = h.Name
+	}
+
+	if loc := b.Edges.PmiLocation; loc != nil {
+		res.PMILocationID = loc.ID
+		res.PMILocation = loc.Name
+	}
+
+	if bt := b.Edges.BloodType; bt != nil {
+		res.Group = bt.Group
+		res.Rhesus = bt.Rhesus
+	}
+
+	for _, u := range b.Edges.Units {
+		unit := RequestUnitResponse{
+			ID:        u.ID,
+			Barcode:   u.Barcode,
+			Status:    u.Status,
+			ExpiresAt: u.ExpiresAt,
+		}
+
+		if bt := u.Edges.BloodType; bt != nil {
+			unit.Group = bt.Group
+			unit.Rhesus = bt.Rhesus
+		}
+
+		res.Units = append(res.Units, unit)
+	}
+
+	return res
+}
+
+func BloodRequests(requests []*ent.BloodRequest) []BloodRequestResponse {
+	out := make([]BloodRequestResponse, 0, len(requests))
+	for _, r := range requests {
+		out = append(out, BloodRequest{BloodRequest: r}.ToResponse())
+	}
+
+	return out
+}
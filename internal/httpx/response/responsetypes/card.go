@@ -0,0 +1,91 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	DonorCard struct {
+		*service.DonorCard
+	}
+
+	DonorCardResponse struct {
+		Token            string           `json:"token"`
+		ExpiresAt        int64            `json:"expires_at"`
+		AccountID        uuid.UUID        `json:"account_id"`
+		FullName         string           `json:"full_name"`
+		NationalIDMasked string           `json:"national_id_masked"`
+		Group            bloodtype.Group  `json:"group"`
+		Rhesus           bloodtype.Rhesus `json:"rhesus"`
+	}
+
+	CheckIn struct {
+		*service.CheckIn
+	}
+
+	CheckInResponse struct {
+		AccountID        uuid.UUID            `json:"account_id"`
+		FullName         string               `json:"full_name"`
+		NationalIDMasked string               `json:"national_id_masked"`
+		Gender           account.Gender       `json:"gender"`
+		Group            bloodtype.Group      `json:"group"`
+		Rhesus           bloodtype.Rhesus     `json:"rhesus"`
+		Eligibility      EligibilityResponse  `json:"eligibility"`
+		Appointment      *AppointmentResponse `json:"appointment"`
+	}
+
+	AppointmentResponse struct {
+		ID          uuid.UUID          `json:"id"`
+		ScheduledAt int64              `json:"scheduled_at"`
+		Status      appointment.Status `json:"status"`
+	}
+)
+
+func (d DonorCard) ToResponse() DonorCardResponse {
+	res := DonorCardResponse{
+		Token:            d.Token,
+		ExpiresAt:        d.ExpiresAt.UnixMilli(),
+		AccountID:        d.Account.ID,
+		FullName:         d.Account.FullName,
+		NationalIDMasked: d.Account.NationalIDMasked,
+	}
+
+	if bt := d.Account.Edges.BloodType; bt != nil {
+		res.Group = bt.Group
+		res.Rhesus = bt.Rhesus
+	}
+
+	return res
+}
+
+func (c CheckIn) ToResponse() CheckInResponse {
+	res := CheckInResponse{
+		AccountID:        c.Account.ID,
+		FullName:         c.Account.FullName,
+		NationalIDMasked: c.Account.NationalIDMasked,
+		Gender:           c.Account.Gender,
+		Eligibility: Eligibility{
+			Result: c.Eligibility,
+			Type:   c.Type,
+		}.ToResponse(),
+	}
+
+	if bt := c.Account.Edges.BloodType; bt != nil {
+		res.Group = bt.Group
+		res.Rhesus = bt.Rhesus
+	}
+
+	if a := c.Appointment; a != nil {
+		res.Appointment = &AppointmentResponse{
+			ID:          a.ID,
+			ScheduledAt: a.ScheduledAt,
+			Status:      a.Status,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,79 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/certificate"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Milestones struct {
+		*service.Milestones
+	}
+
+	MilestonesResponse struct {
+		Donations     int                 `json:"donations"`
+		NextMilestone int                 `json:"next_milestone"`
+		Milestones    []MilestoneResponse `json:"milestones"`
+	}
+
+	MilestoneResponse struct {
+		Milestone     int        `json:"milestone"`
+		Reached       bool       `json:"reached"`
+		CertificateID *uuid.UUID `json:"certificate_id"`
+		AwardedAt     int64      `json:"awarded_at"`
+	}
+
+	Certificate struct {
+		*ent.Certificate
+	}
+
+	CertificateResponse struct {
+		ID         uuid.UUID `json:"id"`
+		HolderName string    `json:"holder_name"`
+		Milestone  int       `json:"milestone"`
+		AwardedAt  int64     `json:"awarded_at"`
+		Authentic  bool      `json:"authentic"`
+	}
+)
+
+func (m Milestones) ToResponse() MilestonesResponse {
+	awarded := make(map[int]*ent.Certificate, len(m.Certificates))
+	for _, c := range m.Certificates {
+		awarded[c.Milestone] = c
+	}
+
+	res := MilestonesResponse{
+		Donations:     m.Donations,
+		NextMilestone: m.Next,
+		Milestones: make(
+			[]MilestoneResponse,
+			0,
+			len(certificate.Milestones),
+		),
+	}
+
+	for _, milestone := range certificate.Milestones {
+		item := MilestoneResponse{Milestone: milestone}
+		if c, ok := awarded[milestone]; ok {
+			item.Reached = true
+			item.CertificateID = &c.ID
+			item.AwardedAt = c.AwardedAt
+		}
+
+		res.Milestones = append(res.Milestones, item)
+	}
+
+	return res
+}
+
+func (c Certificate) ToResponse() CertificateResponse {
+	return CertificateResponse{
+		ID:         c.ID,
+		HolderName: c.HolderName,
+		Milestone:  c.Milestone,
+		AwardedAt:  c.AwardedAt,
+		Authentic:  true,
+	}
+}
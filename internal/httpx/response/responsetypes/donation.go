@@ -0,0 +1,51 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+)
+
+type (
+	Donation struct {
+		*ent.Donation
+	}
+
+	DonationResponse struct {
+		ID            uuid.UUID     `json:"id"`
+		DonatedAt     int64         `json:"donated_at"`
+		Type          donation.Type `json:"type"`
+		VolumeML      int16         `json:"volume_ml"`
+		BagNumber     string        `json:"bag_number"`
+		PMILocationID uuid.UUID     `json:"pmi_location_id"`
+		PMILocation   string        `json:"pmi_location"`
+		CreatedAt     int64         `json:"created_at"`
+	}
+)
+
+func (d Donation) ToResponse() DonationResponse {
+	res := DonationResponse{
+		ID:        d.ID,
+		DonatedAt: d.DonatedAt,
+		Type:      d.Type,
+		VolumeML:  d.VolumeMl,
+		BagNumber: d.BagNumber,
+		CreatedAt: d.CreatedAt,
+	}
+
+	if loc := d.Edges.PmiLocation; loc != nil {
+		res.PMILocationID = loc.ID
+		res.PMILocation = loc.Name
+	}
+
+	return res
+}
+
+func Donations(donations []*ent.Donation) []DonationResponse {
+	out := make([]DonationResponse, 0, len(donations))
+	for _, d := range donations {
+		out = append(out, Donation{Donation: d}.ToResponse())
+	}
+
+	return out
+}
@@ -0,0 +1,78 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/eligibility"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+)
+
+type (
+	Eligibility struct {
+		*eligibility.Result
+		Type donation.Type
+	}
+
+	EligibilityResponse struct {
+		Type           donation.Type    `json:"type"`
+		Eligible       bool             `json:"eligible"`
+		Permanent      bool             `json:"permanent"`
+		NextEligibleAt int64            `json:"next_eligible_at"`
+		Reasons        []ReasonResponse `json:"reasons"`
+	}
+
+	ReasonResponse struct {
+		Code    eligibility.ReasonCode `json:"code"`
+		Message string                 `json:"message"`
+		Until   int64                  `json:"until"`
+	}
+
+	Deferral struct {
+		*ent.Deferral
+	}
+
+	DeferralResponse struct {
+		ID        uuid.UUID     `json:"id"`
+		Type      deferral.Type `json:"type"`
+		Reason    string        `json:"reason"`
+		StartsAt  int64         `json:"starts_at"`
+		EndsAt    int64         `json:"ends_at"`
+		CreatedAt int64         `json:"created_at"`
+	}
+)
+
+func (e Eligibility) ToResponse() EligibilityResponse {
+	res := EligibilityResponse{
+		Type:      e.Type,
+		Eligible:  e.Eligible,
+		Permanent: e.Permanent,
+		Reasons:   make([]ReasonResponse, 0, len(e.Reasons)),
+	}
+
+	if e.NextEligibleAt != nil {
+		res.NextEligibleAt = e.NextEligibleAt.UnixMilli()
+	}
+
+	for _, r := range e.Reasons {
+		reason := ReasonResponse{Code: r.Code, Message: r.Message}
+		if r.Until != nil {
+			reason.Until = r.Until.UnixMilli()
+		}
+
+		res.Reasons = append(res.Reasons, reason)
+	}
+
+	return res
+}
+
+func (d Deferral) ToResponse() DeferralResponse {
+	return DeferralResponse{
+		ID:        d.ID,
+		Type:      d.Type,
+		Reason:    d.Reason,
+		StartsAt:  d.StartsAt,
+		EndsAt:    d.EndsAt,
+		CreatedAt: d.CreatedAt,
+	}
+}
@@ -0,0 +1,155 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+)
+
+type (
+	EmergencyCampaign struct {
+		*ent.EmergencyCampaign
+	}
+
+	EmergencyCampaignResponse struct {
+		ID              uuid.UUID                   `json:"id"`
+		PMILocationID   uuid.UUID                   `json:"pmi_location_id"`
+		PMILocation     string                      `json:"pmi_location"`
+		Group           bloodtype.Group             `json:"group"`
+		Rhesus          bloodtype.Rhesus            `json:"rhesus"`
+		Component       emergencycampaign.Component `json:"component"`
+		NeededDonors    int                         `json:"needed_donors"`
+		CommittedDonors int                         `json:"committed_donors"`
+		RadiusM         int                         `json:"radius_m"`
+		WaveSize        int                         `json:"wave_size"`
+		Waves           int                         `json:"waves"`
+		LastWaveAt      int64                       `json:"last_wave_at"`
+		Status          emergencycampaign.Status    `json:"status"`
+		Message         string                      `json:"message"`
+		Contacts        []EmergencyContactResponse  `json:"contacts"`
+		CreatedAt       int64                       `json:"created_at"`
+	}
+
+	EmergencyContactResponse struct {
+		ID          uuid.UUID               `json:"id"`
+		AccountID   uuid.UUID               `json:"account_id"`
+		FullName    string                  `json:"full_name"`
+		Wave        int                     `json:"wave"`
+		DistanceM   int                     `json:"distance_m"`
+		Status      emergencycontact.Status `json:"status"`
+		RespondedAt int64                   `json:"responded_at"`
+	}
+
+	EmergencyCallOut struct {
+		*ent.EmergencyContact
+	}
+
+	EmergencyCallOutResponse struct {
+		ID            uuid.UUID                   `json:"id"`
+		CampaignID    uuid.UUID                   `json:"campaign_id"`
+		PMILocationID uuid.UUID                   `json:"pmi_location_id"`
+		PMILocation   string                      `json:"pmi_location"`
+		Group         bloodtype.Group             `json:"group"`
+		Rhesus        bloodtype.Rhesus            `json:"rhesus"`
+		Component     emergencycampaign.Component `json:"component"`
+		Message       string                      `json:"message"`
+		DistanceM     int                         `json:"distance_m"`
+		Status        emergencycontact.Status     `json:"status"`
+		RespondedAt   int64                       `json:"responded_at"`
+		CreatedAt     int64                       `json:"created_at"`
+	}
+)
+
+func (e EmergencyCampaign) ToResponse() EmergencyCampaignResponse {
+	res := EmergencyCampaignResponse{
+		ID:              e.ID,
+		Component:       e.Component,
+		NeededDonors:    e.NeededDonors,
+		CommittedDonors: e.CommittedDonors,
+		RadiusM:         e.RadiusM,
+		WaveSize:        e.WaveSize,
+		Waves:           e.Waves,
+		LastWaveAt:      e.LastWaveAt,
+		Status:          e.Status,
+		Message:         e.Message,
+		Contacts: make(
+			[]EmergencyContactResponse,
+			0,
+			len(e.Edges.Contacts),
+		),
+		CreatedAt: e.CreatedAt,
+	}
+
+	if loc := e.Edges.PmiLocation; loc != nil {
+		res.PMILocationID = loc.ID
+		res.PMILocation = loc.Name
+	}
+
+	if bt := e.Edges.BloodType; bt != nil {
+		res.Group = bt.Group
+		res.Rhesus = bt.Rhesus
+	}
+
+	for _, c := range e.Edges.Contacts {
+		contact := EmergencyContactResponse{
+			ID:          c.ID,
+			Wave:        c.Wave,
+			DistanceM:   c.DistanceM,
+			Status:      c.Status,
+			RespondedAt: c.RespondedAt,
+		}
+
+		if acc := c.Edges.Account; acc != nil {
+			contact.AccountID = acc.ID
+			contact.FullName = acc.FullName
+		}
+
+		res.Contacts = append(res.Contacts, contact)
+	}
+
+	return res
+}
+
+func (e EmergencyCallOut) ToResponse() EmergencyCallOutResponse {
+	res := EmergencyCallOutResponse{
+		ID:          e.ID,
+		DistanceM:   e.DistanceM,
+		Status:      e.Status,
+		RespondedAt: e.RespondedAt,
+		CreatedAt:   e.CreatedAt,
+	}
+
+	c := e.Edges.Campaign
+	if c == nil {
+		return res
+	}
+
+	res.CampaignID = c.ID
+	res.Component = c.Component
+	res.Message = c.Message
+
+	if loc := c.Edges.PmiLocation; loc != nil {
+		res.PMILocationID = loc.ID
+		res.PMILocation = loc.Name
+	}
+
+	if bt := c.Edges.BloodType; bt != nil {
+		res.Group = bt.Group
+		res.Rhesus = bt.Rhesus
+	}
+
+	return res
+}
+
+func EmergencyCallOuts(
+	contacts []*ent.EmergencyContact,
+) []EmergencyCallOutResponse {
+	out := make([]EmergencyCallOutResponse, 0, len(contacts))
+	for _, c := range contacts {
+		out = append(out, EmergencyCallOut{EmergencyContact: c}.ToResponse())
+	}
+
+	return out
+}
@@ -0,0 +1,79 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+)
+
+type (
+	DonationEvent struct {
+		*ent.DonationEvent
+	}
+
+	DonationEventResponse struct {
+		ID            uuid.UUID              `json:"id"`
+		Title         string                 `json:"title"`
+		Description   string                 `json:"description"`
+		HostName      string                 `json:"host_name"`
+		HostType      donationevent.HostType `json:"host_type"`
+		Venue         string                 `json:"venue"`
+		Street        string                 `json:"street"`
+		Latitude      float64                `json:"latitude"`
+		Longitude     float64                `json:"longitude"`
+		SubdistrictID uuid.UUID              `json:"subdistrict_id"`
+		Subdistrict   string                 `json:"subdistrict"`
+		PMILocationID uuid.UUID              `json:"pmi_location_id"`
+		PMILocation   string                 `json:"pmi_location"`
+		StartsAt      int64                  `json:"starts_at"`
+		EndsAt        int64                  `json:"ends_at"`
+		Capacity      int                    `json:"capacity"`
+		Status        donationevent.Status   `json:"status"`
+		ReviewNote    string                 `json:"review_note,omitempty"`
+		CreatedAt     int64                  `json:"created_at"`
+	}
+)
+
+func (d DonationEvent) ToResponse() DonationEventResponse {
+	res := DonationEventResponse{
+		ID:          d.ID,
+		Title:       d.Title,
+		Description: d.Description,
+		HostName:    d.HostName,
+		HostType:    d.HostType,
+		Venue:       d.Venue,
+		Street:      d.Street,
+		StartsAt:    d.StartsAt,
+		EndsAt:      d.EndsAt,
+		Capacity:    d.Capacity,
+		Status:      d.Status,
+		ReviewNote:  d.ReviewNote,
+		CreatedAt:   d.CreatedAt,
+	}
+
+	if d.LatLng != nil && d.LatLng.Point != nil {
+		res.Latitude = d.LatLng.Y()
+		res.Longitude = d.LatLng.X()
+	}
+
+	if s := d.Edges.Subdistrict; s != nil {
+		res.SubdistrictID = s.ID
+		res.Subdistrict = s.Name
+	}
+
+	if loc := d.Edges.PmiLocation; loc != nil {
+		res.PMILocationID = loc.ID
+		res.PMILocation = loc.Name
+	}
+
+	return res
+}
+
+func DonationEvents(events []*ent.DonationEvent) []DonationEventResponse {
+	out := make([]DonationEventResponse, 0, len(events))
+	for _, e := range events {
+		out = append(out, DonationEvent{DonationEvent: e}.ToResponse())
+	}
+
+	return out
+}
@@ -0,0 +1,38 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+)
+
+type (
+	Hospital struct {
+		*ent.Hospital
+	}
+
+	HospitalResponse struct {
+		ID          uuid.UUID `json:"id"`
+		Code        string    `json:"code"`
+		Name        string    `json:"name"`
+		Street      string    `json:"street"`
+		Email       string    `json:"email"`
+		DialCode    string    `json:"dial_code"`
+		PhoneNumber string    `json:"phone_number"`
+		Activated   bool      `json:"activated"`
+		CreatedAt   int64     `json:"created_at"`
+	}
+)
+
+func (h Hospital) ToResponse() HospitalResponse {
+	return HospitalResponse{
+		ID:          h.ID,
+		Code:        h.Code,
+		Name:        h.Name,
+		Street:      h.Street,
+		Email:       h.Email,
+		DialCode:    h.DialCode,
+		PhoneNumber: h.PhoneNumber,
+		Activated:   h.Activated,
+		CreatedAt:   h.CreatedAt,
+	}
+}
@@ -0,0 +1,33 @@
+package responsetypes
+
+import "github.com/sembraniteam/setetes/internal/ent"
+
+type (
+	NotificationPreference struct {
+		*ent.NotificationPreference
+	}
+
+	NotificationPreferenceResponse struct {
+		RemindersEnabled bool   `json:"reminders_enabled"`
+		PushEnabled      bool   `json:"push_enabled"`
+		EmailEnabled     bool   `json:"email_enabled"`
+		SMSEnabled       bool   `json:"sms_enabled"`
+		HasPushToken     bool   `json:"has_push_token"`
+		Timezone         string `json:"timezone"`
+		QuietHoursStart  string `json:"quiet_hours_start"`
+		QuietHoursEnd    string `json:"quiet_hours_end"`
+	}
+)
+
+func (n NotificationPreference) ToResponse() NotificationPreferenceResponse {
+	return NotificationPreferenceResponse{
+		RemindersEnabled: n.RemindersEnabled,
+		PushEnabled:      n.PushEnabled,
+		EmailEnabled:     n.EmailEnabled,
+		SMSEnabled:       n.SmsEnabled,
+		HasPushToken:     n.PushToken != "",
+		Timezone:         n.Timezone,
+		QuietHoursStart:  n.QuietHoursStart,
+		QuietHoursEnd:    n.QuietHoursEnd,
+	}
+}
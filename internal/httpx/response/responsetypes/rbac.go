@@ -0,0 +1,171 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/service"
+)
+
+type (
+	Role struct {
+		*ent.Role
+	}
+
+	RoleResponse struct {
+		ID          uuid.UUID            `json:"id"`
+		Name        string               `json:"name"`
+		Key         string               `json:"key"`
+		Domain      string               `json:"domain"`
+		Description string               `json:"description"`
+		Activated   bool                 `json:"activated"`
+		Permissions []PermissionResponse `json:"permissions,omitempty"`
+		Parents     []RoleResponse       `json:"parents,omitempty"`
+		CreatedAt   int64                `json:"created_at"`
+	}
+
+	Permission struct {
+		*ent.Permission
+	}
+
+	PermissionResponse struct {
+		ID          uuid.UUID `json:"id"`
+		Name        string    `json:"name"`
+		Key         string    `json:"key"`
+		Domain      string    `json:"domain"`
+		Resource    string    `json:"resource"`
+		Action      string    `json:"action"`
+		Condition   string    `json:"condition,omitempty"`
+		Description string    `json:"description"`
+		CreatedAt   int64     `json:"created_at"`
+	}
+
+	EffectivePermission struct {
+		service.EffectivePermission
+	}
+
+	EffectivePermissionResponse struct {
+		PermissionResponse
+
+		RoleID    uuid.UUID `json:"role_id"`
+		RoleKey   string    `json:"role_key"`
+		Inherited bool      `json:"inherited"`
+	}
+
+	RoleAssignment struct {
+		*ent.RoleAssignment
+	}
+
+	RoleAssignmentResponse struct {
+		ID        uuid.UUID `json:"id"`
+		Domain    string    `json:"domain"`
+		RoleID    uuid.UUID `json:"role_id"`
+		Role      string    `json:"role"`
+		RoleKey   string    `json:"role_key"`
+		CreatedAt int64     `json:"created_at"`
+	}
+)
+
+func (r Role) ToResponse() RoleResponse {
+	res := RoleResponse{
+		ID:          r.ID,
+		Name:        r.Name,
+		Key:         r.Key,
+		Domain:      r.Domain,
+		Description: r.Description,
+		Activated:   r.Activated,
+		CreatedAt:   r.CreatedAt,
+	}
+
+	if r.Edges.Permissions != nil {
+		res.Permissions = Permissions(r.Edges.Permissions)
+	}
+
+	if r.Edges.Parent != nil {
+		res.Parents = Roles(r.Edges.Parent)
+	}
+
+	return res
+}
+
+func Roles(roles []*ent.Role) []RoleResponse {
+	out := make([]RoleResponse, 0, len(roles))
+	for _, r := range roles {
+		out = append(out, Role{Role: r}.ToResponse())
+	}
+
+	return out
+}
+
+func (p Permission) ToResponse() PermissionResponse {
+	return PermissionResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		Key:         p.Key,
+		Domain:      p.Domain,
+		Resource:    p.Resource,
+		Action:      p.Action,
+		Condition:   p.Condition,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+	}
+}
+
+func Permissions(perms []*ent.Permission) []PermissionResponse {
+	out := make([]PermissionResponse, 0, len(perms))
+	for _, p := range perms {
+		out = append(out, Permission{Permission: p}.ToResponse())
+	}
+
+	return out
+}
+
+func (e EffectivePermission) ToResponse() EffectivePermissionResponse {
+	return EffectivePermissionResponse{
+		PermissionResponse: Permission{
+			Permission: e.Permission,
+		}.ToResponse(),
+		RoleID:    e.Role.ID,
+		RoleKey:   e.Role.Key,
+		Inherited: e.Inherited,
+	}
+}
+
+func EffectivePermissions(
+	perms []service.EffectivePermission,
+) []EffectivePermissionResponse {
+	out := make([]EffectivePermissionResponse, 0, len(perms))
+	for _, p := range perms {
+		out = append(out, EffectivePermission{
+			EffectivePermission: p,
+		}.ToResponse())
+	}
+
+	return out
+}
+
+func (r RoleAssignment) ToResponse() RoleAssignmentResponse {
+	res := RoleAssignmentResponse{
+		ID:        r.ID,
+		Domain:    r.Domain,
+		CreatedAt: r.CreatedAt,
+	}
+
+	if rl := r.Edges.Role; rl != nil {
+		res.RoleID = rl.ID
+		res.Role = rl.Name
+		res.RoleKey = rl.Key
+	}
+
+	return res
+}
+
+func RoleAssignments(
+	assignments []*ent.RoleAssignment,
+) []RoleAssignmentResponse {
+	out := make([]RoleAssignmentResponse, 0, len(assignments))
+	for _, a := range assignments {
+		out = append(out, RoleAssignment{RoleAssignment: a}.ToResponse())
+	}
+
+	return out
+}
@@ -0,0 +1,55 @@
+package responsetypes
+
+import "github.com/sembraniteam/setetes/internal/httpx/route"
+
+type (
+	Route struct {
+		route.Route
+	}
+
+	RouteResponse struct {
+		Method     string                   `json:"method"`
+		Path       string                   `json:"path"`
+		Public     bool                     `json:"public"`
+		Sensitive  bool                     `json:"sensitive"`
+		Permission *RoutePermissionResponse `json:"permission,omitempty"`
+	}
+
+	RoutePermissionResponse struct {
+		Key         string   `json:"key"`
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Condition   string   `json:"condition,omitempty"`
+		Roles       []string `json:"roles"`
+	}
+)
+
+func (r Route) ToResponse() RouteResponse {
+	res := RouteResponse{
+		Method:    r.Method,
+		Path:      r.Path,
+		Public:    r.Public,
+		Sensitive: r.IsSensitive(),
+	}
+
+	if p := r.Permission; p != nil {
+		res.Permission = &RoutePermissionResponse{
+			Key:         p.Key,
+			Name:        p.Name,
+			Description: p.Description,
+			Condition:   p.Condition,
+			Roles:       p.Roles,
+		}
+	}
+
+	return res
+}
+
+func Routes(routes []route.Route) []RouteResponse {
+	out := make([]RouteResponse, 0, len(routes))
+	for _, r := range routes {
+		out = append(out, Route{Route: r}.ToResponse())
+	}
+
+	return out
+}
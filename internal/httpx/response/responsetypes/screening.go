@@ -0,0 +1,86 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+)
+
+type (
+	Questionnaire struct {
+		*ent.Questionnaire
+	}
+
+	QuestionnaireResponse struct {
+		ID        uuid.UUID          `json:"id"`
+		Version   int                `json:"version"`
+		Title     string             `json:"title"`
+		Activated bool               `json:"activated"`
+		Questions []QuestionResponse `json:"questions"`
+		CreatedAt int64              `json:"created_at"`
+	}
+
+	QuestionResponse struct {
+		Key        string                       `json:"key"`
+		Text       string                       `json:"text"`
+		AnswerType screeningquestion.AnswerType `json:"answer_type"`
+		Options    []string                     `json:"options"`
+		Required   bool                         `json:"required"`
+	}
+
+	ScreeningSubmission struct {
+		*ent.ScreeningSubmission
+	}
+
+	ScreeningSubmissionResponse struct {
+		ID          uuid.UUID          `json:"id"`
+		Deferred    bool               `json:"deferred"`
+		SignedAt    int64              `json:"signed_at"`
+		PayloadHash string             `json:"payload_hash"`
+		Signature   string             `json:"signature"`
+		Deferrals   []DeferralResponse `json:"deferrals"`
+	}
+)
+
+func (q Questionnaire) ToResponse() QuestionnaireResponse {
+	res := QuestionnaireResponse{
+		ID:        q.ID,
+		Version:   q.Version,
+		Title:     q.Title,
+		Activated: q.Activated,
+		Questions: make([]QuestionResponse, 0, len(q.Edges.Questions)),
+		CreatedAt: q.CreatedAt,
+	}
+
+	for _, question := range q.Edges.Questions {
+		res.Questions = append(res.Questions, QuestionResponse{
+			Key:        question.Key,
+			Text:       question.Text,
+			AnswerType: question.AnswerType,
+			Options:    question.Options,
+			Required:   question.Required,
+		})
+	}
+
+	return res
+}
+
+func (s ScreeningSubmission) ToResponse() ScreeningSubmissionResponse {
+	res := ScreeningSubmissionResponse{
+		ID:          s.ID,
+		Deferred:    s.Deferred,
+		SignedAt:    s.SignedAt,
+		PayloadHash: s.PayloadHash,
+		Signature:   s.Signature,
+		Deferrals:   make([]DeferralResponse, 0, len(s.Edges.Deferrals)),
+	}
+
+	for _, d := range s.Edges.Deferrals {
+		res.Deferrals = append(
+			res.Deferrals,
+			Deferral{Deferral: d}.ToResponse(),
+		)
+	}
+
+	return res
+}
@@ -0,0 +1,158 @@
+package responsetypes
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+type (
+	BloodStock struct {
+		*ent.BloodStock
+	}
+
+	BloodStockResponse struct {
+		ID        uuid.UUID            `json:"id"`
+		Group     bloodtype.Group      `json:"group"`
+		Rhesus    bloodtype.Rhesus     `json:"rhesus"`
+		Component bloodstock.Component `json:"component"`
+		Quantity  int                  `json:"quantity"`
+		UpdatedAt int64                `json:"updated_at"`
+	}
+
+	StockMovement struct {
+		*ent.StockMovement
+	}
+
+	StockMovementResponse struct {
+		ID         uuid.UUID            `json:"id"`
+		StockID    uuid.UUID            `json:"stock_id"`
+		Group      bloodtype.Group      `json:"group,omitempty"`
+		Rhesus     bloodtype.Rhesus     `json:"rhesus,omitempty"`
+		Component  bloodstock.Component `json:"component"`
+		Type       stockmovement.Type   `json:"type"`
+		Quantity   int                  `json:"quantity"`
+		Balance    int                  `json:"balance"`
+		TransferID *uuid.UUID           `json:"transfer_id"`
+		Note       string               `json:"note"`
+		CreatedAt  int64                `json:"created_at"`
+	}
+
+	RegionStockResponse struct {
+		Group     bloodtype.Group      `json:"group"`
+		Rhesus    bloodtype.Rhesus     `json:"rhesus"`
+		Component bloodstock.Component `json:"component"`
+		Quantity  int                  `json:"quantity"`
+		Locations int                  `json:"locations"`
+	}
+
+	regionStockKey struct {
+		group     bloodtype.Group
+		rhesus    bloodtype.Rhesus
+		component bloodstock.Component
+	}
+)
+
+func (b BloodStock) ToResponse() BloodStockResponse {
+	res := BloodStockResponse{
+		ID:        b.ID,
+		Component: b.Component,
+		Quantity:  b.Quantity,
+		UpdatedAt: b.UpdatedAt,
+	}
+
+	if bt := b.Edges.BloodType; bt != nil {
+		res.Group = bt.Group
+		res.Rhesus = bt.Rhesus
+	}
+
+	return res
+}
+
+func BloodStocks(stocks []*ent.BloodStock) []BloodStockResponse {
+	out := make([]BloodStockResponse, 0, len(stocks))
+	for _, s := range stocks {
+		out = append(out, BloodStock{BloodStock: s}.ToResponse())
+	}
+
+	return out
+}
+
+func (m StockMovement) ToResponse() StockMovementResponse {
+	res := StockMovementResponse{
+		ID:         m.ID,
+		Type:       m.Type,
+		Quantity:   m.Quantity,
+		Balance:    m.Balance,
+		TransferID: m.TransferID,
+		Note:       m.Note,
+		CreatedAt:  m.CreatedAt,
+	}
+
+	if stock := m.Edges.Stock; stock != nil {
+		res.StockID = stock.ID
+		res.Component = stock.Component
+		if bt := stock.Edges.BloodType; bt != nil {
+			res.Group = bt.Group
+			res.Rhesus = bt.Rhesus
+		}
+	}
+
+	return res
+}
+
+func StockMovements(movements []*ent.StockMovement) []StockMovementResponse {
+	out := make([]StockMovementResponse, 0, len(movements))
+	for _, m := range movements {
+		out = append(out, StockMovement{StockMovement: m}.ToResponse())
+	}
+
+	return out
+}
+
+// RegionStocks sums the stock of every location per blood type and
+// component. Only the totals are public, the per location rows are not.
+func RegionStocks(stocks []*ent.BloodStock) []RegionStockResponse {
+	index := make(map[regionStockKey]int)
+	out := make([]RegionStockResponse, 0)
+
+	for _, s := range stocks {
+		res := BloodStock{BloodStock: s}.ToResponse()
+		key := regionStockKey{
+			group:     res.Group,
+			rhesus:    res.Rhesus,
+			component: res.Component,
+		}
+
+		i, ok := index[key]
+		if !ok {
+			i = len(out)
+			index[key] = i
+			out = append(out, RegionStockResponse{
+				Group:     res.Group,
+				Rhesus:    res.Rhesus,
+				Component: res.Component,
+			})
+		}
+
+		out[i].Quantity += res.Quantity
+		if res.Quantity > 0 {
+			out[i].Locations++
+		}
+	}
+
+	slices.SortFunc(out, func(a, b RegionStockResponse) int {
+		return cmp.Or(
+			cmp.Compare(a.Group, b.Group),
+			cmp.Compare(a.Rhesus, b.Rhesus),
+			cmp.Compare(a.Component, b.Component),
+		)
+	})
+
+	return out
+}
@@ -0,0 +1,70 @@
+package responsetypes
+
+import (
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+)
+
+type (
+	BloodUnit struct {
+		*ent.BloodUnit
+	}
+
+	BloodUnitResponse struct {
+		ID            uuid.UUID                `json:"id"`
+		Barcode       string                   `json:"barcode"`
+		Group         bloodtype.Group          `json:"group"`
+		Rhesus        bloodtype.Rhesus         `json:"rhesus"`
+		Component     bloodunit.Component      `json:"component"`
+		Status        bloodunit.Status         `json:"status"`
+		CollectedAt   int64                    `json:"collected_at"`
+		ExpiresAt     int64                    `json:"expires_at"`
+		PMILocationID uuid.UUID                `json:"pmi_location_id"`
+		PMILocation   string                   `json:"pmi_location"`
+		History       []BloodUnitEventResponse `json:"history"`
+		CreatedAt     int64                    `json:"created_at"`
+	}
+
+	BloodUnitEventResponse struct {
+		FromStatus string `json:"from_status"`
+		ToStatus   string `json:"to_status"`
+		Note       string `json:"note"`
+		CreatedAt  int64  `json:"created_at"`
+	}
+)
+
+func (b BloodUnit) ToResponse() BloodUnitResponse {
+	res := BloodUnitResponse{
+		ID:          b.ID,
+		Barcode:     b.Barcode,
+		Component:   b.Component,
+		Status:      b.Status,
+		CollectedAt: b.CollectedAt,
+		ExpiresAt:   b.ExpiresAt,
+		History:     make([]BloodUnitEventResponse, 0, len(b.Edges.Events)),
+		CreatedAt:   b.CreatedAt,
+	}
+
+	if bt := b.Edges.BloodType; bt != nil {
+		res.Group = bt.Group
+		res.Rhesus = bt.Rhesus
+	}
+
+	if loc := b.Edges.PmiLocation; loc != nil {
+		res.PMILocationID = loc.ID
+		res.PMILocation = loc.Name
+	}
+
+	for _, e := range b.Edges.Events {
+		res.History = append(res.History, BloodUnitEventResponse{
+			FromStatus: e.FromStatus,
+			ToStatus:   e.ToStatus,
+			Note:       e.Note,
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+
+	return res
+}
@@ -96,14 +96,18 @@ func ValidateForm[T any](c *gin.Context) (*T, *ErrorValidate) {
 }
 
 func ValidateQuery[T any](c *gin.Context) (*T, *ErrorValidate) {
-	body, err := ValidateForm[T](c)
-	if err != nil {
+	body := new(T)
+	if err := c.ShouldBindQuery(body); err != nil {
 		return nil, &ErrorValidate{
 			code:    &httpx.InvalidQueryCode,
 			message: MsgInvalidQuery,
 		}
 	}
 
+	if err := validateStruct(body); err != nil {
+		return nil, err
+	}
+
 	return body, nil
 }
 
@@ -0,0 +1,128 @@
+package route
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do/v2"
+)
+
+var paramPattern = regexp.MustCompile(`[:*][^/]+`)
+
+type (
+	// Handler resolves the handler of a route from the injector when the
+	// routes are registered.
+	Handler func(i do.Injector) gin.HandlerFunc
+
+	// Permission describes the permission guarding a protected route. Roles
+	// lists the keys of the seeded roles granted it.
+	Permission struct {
+		Key         string
+		Name        string
+		Description string
+		Condition   string
+		Roles       []string
+	}
+
+	// Route declares an endpoint with its access metadata. Path is relative
+	// to the group until the routes are flattened by Registry.Routes.
+	// Sensitive routes are forbidden to impersonating sessions, see
+	// Route.IsSensitive.
+	Route struct {
+		Method     string
+		Path       string
+		Handler    Handler
+		Public     bool
+		Limited    bool
+		Sensitive  bool
+		Permission *Permission
+	}
+
+	// Group is routes sharing a prefix. Middlewares run on every route of
+	// the group, after the middlewares of the server.
+	Group struct {
+		Prefix      string
+		Middlewares []Handler
+		Routes      []Route
+	}
+
+	// Registry is the single source of the routes, the public path globs of
+	// the authorization middleware and the seeded permissions.
+	Registry []Group
+)
+
+// Handle resolves a handler method, such as (*handler.Account).Self, on the
+// handler registered in the injector.
+func Handle[H any](method func(*H, *gin.Context)) Handler {
+	return func(i do.Injector) gin.HandlerFunc {
+		h := do.MustInvoke[H](i)
+
+		return func(c *gin.Context) {
+			method(&h, c)
+		}
+	}
+}
+
+// Func uses a handler that needs nothing from the injector.
+func Func(fn gin.HandlerFunc) Handler {
+	return func(_ do.Injector) gin.HandlerFunc {
+		return fn
+	}
+}
+
+// Handlers resolves the middlewares of the group.
+func (g Group) Handlers(i do.Injector) []gin.HandlerFunc {
+	out := make([]gin.HandlerFunc, 0, len(g.Middlewares))
+	for _, m := range g.Middlewares {
+		out = append(out, m(i))
+	}
+
+	return out
+}
+
+// IsSensitive reports whether the route is flagged sensitive or deletes
+// data, which is sensitive on every route.
+func (r Route) IsSensitive() bool {
+	return r.Sensitive || r.Method == http.MethodDelete
+}
+
+// Routes returns every route with the prefix of its group joined to its
+// path.
+func (r Registry) Routes() []Route {
+	out := make([]Route, 0)
+	for _, g := range r {
+		for _, rt := range g.Routes {
+			rt.Path = g.Prefix + rt.Path
+			out = append(out, rt)
+		}
+	}
+
+	return out
+}
+
+// PublicPaths returns the glob of every public route, matching any value of
+// its path parameters, such as /event/v1/events/* for /event/v1/events/:id.
+func (r Registry) PublicPaths() []string {
+	out := make([]string, 0)
+	for _, rt := range r.Routes() {
+		if rt.Public {
+			out = append(out, paramPattern.ReplaceAllString(rt.Path, "*"))
+		}
+	}
+
+	return out
+}
+
+// Permissions returns the protected routes granted to the role.
+func (r Registry) Permissions(role string) []Route {
+	out := make([]Route, 0)
+	for _, rt := range r.Routes() {
+		if rt.Permission != nil && slices.Contains(rt.Permission.Roles, role) {
+			out = append(out, rt)
+		}
+	}
+
+	return out
+}
@@ -7,11 +7,26 @@ import (
 
 type (
 	UserSession struct {
-		ID        uuid.UUID
+		ID uuid.UUID
+		// ActorID is the account making the request. It differs from ID,
+		// the subject the request is authorized as, when the actor
+		// impersonates the subject.
+		ActorID   uuid.UUID
 		Anonymous bool
+		// Domain is the domain the request was authorized in.
+		Domain string
+		// APIKeyID is the API key the request was authenticated with. It is
+		// uuid.Nil for a bearer token.
+		APIKeyID uuid.UUID
 	}
 
 	UserSessionClaims struct {
 		Claims pasetox.Claims
 	}
 )
+
+// Impersonated reports whether the actor of the session impersonates its
+// subject.
+func (us UserSession) Impersonated() bool {
+	return !us.Anonymous && us.ActorID != us.ID
+}
@@ -1,40 +1,1038 @@
 package web
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/abac"
 	"github.com/sembraniteam/setetes/internal/httpx/handler"
 	"github.com/sembraniteam/setetes/internal/httpx/middleware"
 	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/route"
 )
 
 func Routes(e *gin.Engine, i do.Injector) {
 	rateLimiter := middleware.NewTokenBucket(1, time.Minute*1)
-	accountH := do.MustInvoke[handler.Account](i)
-
-	e.GET("/ping", func(c *gin.Context) {
-		response.Ok(c, response.MsgPong, nil)
-	})
-
-	accountG := e.Group("/account/v1")
-	{
-		accountG.POST("/authorization", accountH.Authorize)
-		accountG.POST("/activate", accountH.Activate)
-		accountG.POST("/register", accountH.Register)
-		accountG.GET("/self", accountH.Self)
-		accountG.Use(middleware.RateLimitByIP(rateLimiter)).
-			POST("/resend-otp", accountH.ResendOTP)
+
+	for _, g := range Registry() {
+		group := e.Group(g.Prefix, g.Handlers(i)...)
+		for _, r := range g.Routes {
+			var handlers []gin.HandlerFunc
+			if r.Limited {
+				handlers = append(
+					handlers,
+					middleware.RateLimitByIP(rateLimiter),
+				)
+			}
+
+			if r.IsSensitive() {
+				handlers = append(handlers, middleware.NoImpersonation())
+			}
+
+			group.Handle(r.Method, r.Path, append(handlers, r.Handler(i))...)
+		}
 	}
 }
 
 func PublicRoutes() []string {
-	return []string{
-		"/ping",
-		"/account/v1/authorization",
-		"/account/v1/register",
-		"/account/v1/activate",
-		"/account/v1/resend-otp",
+	return Registry().PublicPaths()
+}
+
+// Registry declares every route together with whether it is public and the
+// permission guarding it.
+func Registry() route.Registry {
+	return route.Registry{
+		{
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/ping",
+					Handler: route.Func(ping),
+					Public:  true,
+				},
+			},
+		},
+		{
+			Prefix: "/account/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/authorization",
+					Handler: route.Handle((*handler.Account).Authorize),
+					Public:  true,
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/activate",
+					Handler: route.Handle((*handler.Account).Activate),
+					Public:  true,
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/register",
+					Handler: route.Handle((*handler.Account).Register),
+					Public:  true,
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/self",
+					Handler: route.Handle((*handler.Account).Self),
+					Permission: &route.Permission{
+						Key:         "get-self-profile",
+						Name:        "Get self profile",
+						Description: "Allow donor to view their own profile details.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/eligibility",
+					Handler: route.Handle((*handler.Eligibility).Self),
+					Permission: &route.Permission{
+						Key:         "get-self-eligibility",
+						Name:        "Get self eligibility",
+						Description: "Allow donor to check whether they are eligible to donate.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/home-location",
+					Handler: route.Handle((*handler.Account).HomeLocation),
+					Permission: &route.Permission{
+						Key:         "update-home-location",
+						Name:        "Set home location",
+						Description: "Allow donor to share their home location for emergency call-outs.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/resend-otp",
+					Handler: route.Handle((*handler.Account).ResendOTP),
+					Public:  true,
+					Limited: true,
+				},
+				{
+					Method:    http.MethodPost,
+					Path:      "/impersonate",
+					Handler:   route.Handle((*handler.Account).Impersonate),
+					Sensitive: true,
+					Permission: &route.Permission{
+						Key:         "impersonate-account",
+						Name:        "Impersonate account",
+						Description: "Allow support staff to act as an account for a limited time to reproduce an issue it reports.",
+						Roles:       []string{"admin", "support"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/donation/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/self",
+					Handler: route.Handle((*handler.Donation).Self),
+					Permission: &route.Permission{
+						Key:         "get-self-donations",
+						Name:        "Get self donation history",
+						Description: "Allow donor to view their own donation history.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/donations",
+					Handler: route.Handle((*handler.Donation).Record),
+					Permission: &route.Permission{
+						Key:         "record-donation",
+						Name:        "Record donation",
+						Description: "Allow staff to record a donation collected from a donor.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/accounts/:id",
+					Handler: route.Handle((*handler.Donation).Account),
+					Permission: &route.Permission{
+						Key:         "get-donor-donations",
+						Name:        "Get donor donation history",
+						Description: "Allow staff to view the donation history of a donor.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/deferrals",
+					Handler: route.Handle((*handler.Eligibility).Defer),
+					Permission: &route.Permission{
+						Key:         "create-deferral",
+						Name:        "Defer donor",
+						Description: "Allow staff to defer a donor temporarily or permanently.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/screening/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/questionnaire",
+					Handler: route.Handle((*handler.Screening).Active),
+					Permission: &route.Permission{
+						Key:         "get-screening-questionnaire",
+						Name:        "Get screening questionnaire",
+						Description: "Allow donor to read the active health screening questionnaire.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/submissions",
+					Handler: route.Handle((*handler.Screening).Submit),
+					Permission: &route.Permission{
+						Key:         "submit-screening",
+						Name:        "Submit screening",
+						Description: "Allow donor to submit health screening answers before donating.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/questionnaires",
+					Handler: route.Handle((*handler.Screening).Create),
+					Permission: &route.Permission{
+						Key:         "create-screening-questionnaire",
+						Name:        "Create screening questionnaire",
+						Description: "Allow staff to publish a new health screening questionnaire version.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/questionnaires/:id/activate",
+					Handler: route.Handle((*handler.Screening).Activate),
+					Permission: &route.Permission{
+						Key:         "activate-screening-questionnaire",
+						Name:        "Activate screening questionnaire",
+						Description: "Allow staff to choose the questionnaire version presented to donors.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/stock/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/regions",
+					Handler: route.Handle((*handler.Stock).Region),
+					Public:  true,
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/movements",
+					Handler: route.Handle((*handler.Stock).Move),
+					Permission: &route.Permission{
+						Key:         "create-stock-movement",
+						Name:        "Move blood stock",
+						Description: "Allow staff to collect, issue, discard or transfer blood stock.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/locations/:id",
+					Handler: route.Handle((*handler.Stock).Location),
+					Permission: &route.Permission{
+						Key:         "get-location-stock",
+						Name:        "Get location blood stock",
+						Description: "Allow staff to view the blood stock of a PMI location.",
+						Condition:   abac.LocationInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/locations/:id/movements",
+					Handler: route.Handle((*handler.Stock).Movements),
+					Permission: &route.Permission{
+						Key:         "get-location-stock-movements",
+						Name:        "Get location stock movements",
+						Description: "Allow staff to view the stock movement history of a PMI location.",
+						Condition:   abac.LocationInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/unit/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/units",
+					Handler: route.Handle((*handler.BloodUnit).Register),
+					Permission: &route.Permission{
+						Key:         "create-blood-unit",
+						Name:        "Register blood unit",
+						Description: "Allow staff to register a collected blood bag by its barcode.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/units/:id",
+					Handler: route.Handle((*handler.BloodUnit).Get),
+					Permission: &route.Permission{
+						Key:         "get-blood-unit",
+						Name:        "Get blood unit",
+						Description: "Allow staff to trace a blood unit and its status history.",
+						Condition:   abac.UnitInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/units/:id/status",
+					Handler: route.Handle((*handler.BloodUnit).Transition),
+					Permission: &route.Permission{
+						Key:         "update-blood-unit-status",
+						Name:        "Change blood unit status",
+						Description: "Allow staff to move a blood unit through its lifecycle.",
+						Condition:   abac.UnitInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/barcodes/:barcode",
+					Handler: route.Handle((*handler.BloodUnit).Barcode),
+					Permission: &route.Permission{
+						Key:         "get-blood-unit-barcode",
+						Name:        "Get blood unit by barcode",
+						Description: "Allow staff to look up a blood unit by scanning its barcode.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/hospital/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/hospitals",
+					Handler: route.Handle((*handler.Hospital).Create),
+					Permission: &route.Permission{
+						Key:         "create-hospital",
+						Name:        "Create hospital",
+						Description: "Allow staff to register a hospital that may request blood.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/hospitals/:id/members",
+					Handler: route.Handle((*handler.Hospital).AddMember),
+					Permission: &route.Permission{
+						Key:         "create-hospital-member",
+						Name:        "Add hospital member",
+						Description: "Allow staff to link an account to a hospital as hospital staff.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/request/v1",
+			// Hospital information systems submit and follow blood
+			// requests with calls signed by their hospital. PMI staff serve
+			// the requests from the group below, which is not signed.
+			Middlewares: []route.Handler{signed},
+			Routes: []route.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/requests",
+					Handler: route.Handle((*handler.BloodRequest).Submit),
+					Permission: &route.Permission{
+						Key:         "submit-blood-request",
+						Name:        "Submit blood request",
+						Description: "Allow hospital staff to request blood for a patient from PMI.",
+						Roles:       []string{"hospital-staff"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/hospital",
+					Handler: route.Handle((*handler.BloodRequest).Hospital),
+					Permission: &route.Permission{
+						Key:         "get-hospital-blood-requests",
+						Name:        "Get hospital blood requests",
+						Description: "Allow hospital staff to list the blood requests of their hospital.",
+						Roles:       []string{"hospital-staff"},
+					},
+				},
+				{
+					Method: http.MethodGet,
+					Path:   "/hospital/:id",
+					Handler: route.Handle(
+						(*handler.BloodRequest).HospitalRequest,
+					),
+					Permission: &route.Permission{
+						Key:         "get-hospital-blood-request",
+						Name:        "Get hospital blood request",
+						Description: "Allow hospital staff to follow a blood request of their hospital.",
+						Condition:   abac.HospitalRequest,
+						Roles:       []string{"hospital-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/hospital/:id/cancel",
+					Handler: route.Handle((*handler.BloodRequest).Cancel),
+					Permission: &route.Permission{
+						Key:         "cancel-blood-request",
+						Name:        "Cancel blood request",
+						Description: "Allow hospital staff to cancel a blood request that is no longer needed.",
+						Condition:   abac.HospitalRequest,
+						Roles:       []string{"hospital-staff"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/request/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/locations/:id",
+					Handler: route.Handle((*handler.BloodRequest).Location),
+					Permission: &route.Permission{
+						Key:         "get-location-blood-requests",
+						Name:        "Get location blood requests",
+						Description: "Allow staff to list blood requests sent to a PMI location.",
+						Condition:   abac.LocationInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/requests/:id",
+					Handler: route.Handle((*handler.BloodRequest).Get),
+					Permission: &route.Permission{
+						Key:         "get-blood-request",
+						Name:        "Get blood request",
+						Description: "Allow staff to view a blood request and its reserved units.",
+						Condition:   abac.RequestInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/requests/:id/accept",
+					Handler: route.Handle((*handler.BloodRequest).Accept),
+					Permission: &route.Permission{
+						Key:         "accept-blood-request",
+						Name:        "Accept blood request",
+						Description: "Allow staff to accept a blood request and reserve compatible units.",
+						Condition:   abac.RequestInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/requests/:id/reject",
+					Handler: route.Handle((*handler.BloodRequest).Reject),
+					Permission: &route.Permission{
+						Key:         "reject-blood-request",
+						Name:        "Reject blood request",
+						Description: "Allow staff to reject a blood request that cannot be served.",
+						Condition:   abac.RequestInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/requests/:id/fulfil",
+					Handler: route.Handle((*handler.BloodRequest).Fulfil),
+					Permission: &route.Permission{
+						Key:         "fulfil-blood-request",
+						Name:        "Fulfil blood request",
+						Description: "Allow staff to issue reserved blood units to the requesting hospital.",
+						Condition:   abac.RequestInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/emergency/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/self",
+					Handler: route.Handle((*handler.Emergency).Self),
+					Permission: &route.Permission{
+						Key:         "get-self-emergency-call-outs",
+						Name:        "Get emergency call-outs",
+						Description: "Allow donor to view the emergency call-outs they were notified of.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/call-outs/:id/respond",
+					Handler: route.Handle((*handler.Emergency).Respond),
+					Permission: &route.Permission{
+						Key:         "respond-emergency-call-out",
+						Name:        "Respond emergency call-out",
+						Description: "Allow donor to accept or decline an emergency call-out.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/campaigns",
+					Handler: route.Handle((*handler.Emergency).Create),
+					Permission: &route.Permission{
+						Key:         "create-emergency-campaign",
+						Name:        "Create emergency campaign",
+						Description: "Allow staff to call out nearby compatible donors in an emergency.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/campaigns/:id",
+					Handler: route.Handle((*handler.Emergency).Get),
+					Permission: &route.Permission{
+						Key:         "get-emergency-campaign",
+						Name:        "Get emergency campaign",
+						Description: "Allow staff to follow the donors notified by an emergency campaign.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/campaigns/:id/cancel",
+					Handler: route.Handle((*handler.Emergency).Cancel),
+					Permission: &route.Permission{
+						Key:         "cancel-emergency-campaign",
+						Name:        "Cancel emergency campaign",
+						Description: "Allow staff to stop an emergency campaign that is no longer needed.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/campaigns/:id/waves",
+					Handler: route.Handle((*handler.Emergency).NextWave),
+					Permission: &route.Permission{
+						Key:         "create-emergency-wave",
+						Name:        "Send emergency wave",
+						Description: "Allow staff to notify the next wave of donors without waiting.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/event/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/events",
+					Handler: route.Handle((*handler.Event).Discover),
+					Public:  true,
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/events/:id",
+					Handler: route.Handle((*handler.Event).Get),
+					Public:  true,
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/organizer/events",
+					Handler: route.Handle((*handler.Event).Organizer),
+					Permission: &route.Permission{
+						Key:         "get-organizer-donation-events",
+						Name:        "Get organizer donation events",
+						Description: "Allow organizers to list the blood drive events they created.",
+						Roles:       []string{"organizer"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/organizer/events",
+					Handler: route.Handle((*handler.Event).Create),
+					Permission: &route.Permission{
+						Key:         "create-donation-event",
+						Name:        "Create donation event",
+						Description: "Allow organizers to propose a blood drive event for PMI approval.",
+						Roles:       []string{"organizer"},
+					},
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/organizer/events/:id",
+					Handler: route.Handle((*handler.Event).Update),
+					Permission: &route.Permission{
+						Key:         "update-donation-event",
+						Name:        "Update donation event",
+						Description: "Allow organizers to change a blood drive event before it starts.",
+						Condition:   abac.OwnEvent,
+						Roles:       []string{"organizer"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/organizer/events/:id/cancel",
+					Handler: route.Handle((*handler.Event).Cancel),
+					Permission: &route.Permission{
+						Key:         "cancel-donation-event",
+						Name:        "Cancel donation event",
+						Description: "Allow organizers to cancel a blood drive event they created.",
+						Condition:   abac.OwnEvent,
+						Roles:       []string{"organizer"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/organizers",
+					Handler: route.Handle((*handler.Event).GrantOrganizer),
+					Permission: &route.Permission{
+						Key:         "create-event-organizer",
+						Name:        "Grant event organizer",
+						Description: "Allow staff to let an account organize mobile blood drive events.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/locations/:id",
+					Handler: route.Handle((*handler.Event).Location),
+					Permission: &route.Permission{
+						Key:         "get-location-donation-events",
+						Name:        "Get location donation events",
+						Description: "Allow staff to list the blood drive events of a PMI location.",
+						Condition:   abac.LocationInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/reviews/:id/approve",
+					Handler: route.Handle((*handler.Event).Approve),
+					Permission: &route.Permission{
+						Key:         "approve-donation-event",
+						Name:        "Approve donation event",
+						Description: "Allow staff to approve a blood drive event so donors can find it.",
+						Condition:   abac.EventInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/reviews/:id/reject",
+					Handler: route.Handle((*handler.Event).Reject),
+					Permission: &route.Permission{
+						Key:         "reject-donation-event",
+						Name:        "Reject donation event",
+						Description: "Allow staff to reject a blood drive event the PMI cannot serve.",
+						Condition:   abac.EventInRegion,
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/notification/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/preferences",
+					Handler: route.Handle((*handler.Reminder).Preference),
+					Permission: &route.Permission{
+						Key:         "get-notification-preferences",
+						Name:        "Get notification preferences",
+						Description: "Allow donor to view how and when they receive reminders.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/preferences",
+					Handler: route.Handle((*handler.Reminder).SetPreference),
+					Permission: &route.Permission{
+						Key:         "update-notification-preferences",
+						Name:        "Update notification preferences",
+						Description: "Allow donor to choose reminder channels, time zone and quiet hours.",
+						Roles:       []string{"donor"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/card/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/self",
+					Handler: route.Handle((*handler.Card).Self),
+					Permission: &route.Permission{
+						Key:         "get-self-donor-card",
+						Name:        "Get donor card",
+						Description: "Allow donor to show their digital donor card with a QR code at the counter.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/check-ins",
+					Handler: route.Handle((*handler.Card).CheckIn),
+					Permission: &route.Permission{
+						Key:         "create-donor-check-in",
+						Name:        "Check in donor",
+						Description: "Allow staff to scan a donor card, check eligibility and mark attendance.",
+						Roles:       []string{"pmi-staff"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/certificate/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/self",
+					Handler: route.Handle((*handler.Certificate).Self),
+					Permission: &route.Permission{
+						Key:         "get-self-milestones",
+						Name:        "Get donation milestones",
+						Description: "Allow donor to follow their donation milestones and certificates.",
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/certificates/:id/pdf",
+					Handler: route.Handle((*handler.Certificate).Download),
+					Permission: &route.Permission{
+						Key:         "get-self-certificate-pdf",
+						Name:        "Download certificate",
+						Description: "Allow donor to download the PDF certificate of a reached milestone.",
+						Condition:   abac.OwnCertificate,
+						Roles:       []string{"donor"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/verify/:id",
+					Handler: route.Handle((*handler.Certificate).Verify),
+					Public:  true,
+				},
+			},
+		},
+		{
+			Prefix: "/rbac/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/roles",
+					Handler: route.Handle((*handler.RBAC).Roles),
+					Permission: &route.Permission{
+						Key:         "get-roles",
+						Name:        "Get roles",
+						Description: "Allow administrators to list the roles and search them by name or key.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/roles",
+					Handler: route.Handle((*handler.RBAC).CreateRole),
+					Permission: &route.Permission{
+						Key:         "create-role",
+						Name:        "Create role",
+						Description: "Allow administrators to create a role that permissions can be attached to.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/roles/:id",
+					Handler: route.Handle((*handler.RBAC).Role),
+					Permission: &route.Permission{
+						Key:         "get-role",
+						Name:        "Get role",
+						Description: "Allow administrators to view a role together with its permissions.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/roles/:id",
+					Handler: route.Handle((*handler.RBAC).UpdateRole),
+					Permission: &route.Permission{
+						Key:         "update-role",
+						Name:        "Update role",
+						Description: "Allow administrators to rename, describe, activate or deactivate a role.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/roles/:id",
+					Handler: route.Handle((*handler.RBAC).DeleteRole),
+					Permission: &route.Permission{
+						Key:         "delete-role",
+						Name:        "Delete role",
+						Description: "Allow administrators to delete a role with its assignments and policies.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/roles/:id/permissions",
+					Handler: route.Handle((*handler.RBAC).AttachPermissions),
+					Permission: &route.Permission{
+						Key:         "attach-role-permissions",
+						Name:        "Attach role permissions",
+						Description: "Allow administrators to grant permissions to every account holding a role.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/roles/:id/permissions/:permission_id",
+					Handler: route.Handle((*handler.RBAC).DetachPermission),
+					Permission: &route.Permission{
+						Key:         "detach-role-permission",
+						Name:        "Detach role permission",
+						Description: "Allow administrators to take a permission away from a role.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/roles/:id/parents",
+					Handler: route.Handle((*handler.RBAC).AddParent),
+					Permission: &route.Permission{
+						Key:         "add-parent-role",
+						Name:        "Add parent role",
+						Description: "Allow administrators to let a role inherit the permissions of another role.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/roles/:id/parents/:parent_id",
+					Handler: route.Handle((*handler.RBAC).RemoveParent),
+					Permission: &route.Permission{
+						Key:         "remove-parent-role",
+						Name:        "Remove parent role",
+						Description: "Allow administrators to stop a role inheriting the permissions of a parent role.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/roles/:id/effective-permissions",
+					Handler: route.Handle((*handler.RBAC).EffectivePermissions),
+					Permission: &route.Permission{
+						Key:         "get-effective-role-permissions",
+						Name:        "Get effective role permissions",
+						Description: "Allow administrators to view every permission a role grants, including inherited ones.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/permissions",
+					Handler: route.Handle((*handler.RBAC).Permissions),
+					Permission: &route.Permission{
+						Key:         "get-permissions",
+						Name:        "Get permissions",
+						Description: "Allow administrators to list the permissions and search them by resource.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/permissions",
+					Handler: route.Handle((*handler.RBAC).CreatePermission),
+					Permission: &route.Permission{
+						Key:         "create-permission",
+						Name:        "Create permission",
+						Description: "Allow administrators to define a permission for a resource and action.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/permissions/:id",
+					Handler: route.Handle((*handler.RBAC).Permission),
+					Permission: &route.Permission{
+						Key:         "get-permission",
+						Name:        "Get permission",
+						Description: "Allow administrators to view the resource and action of a permission.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/permissions/:id",
+					Handler: route.Handle((*handler.RBAC).UpdatePermission),
+					Permission: &route.Permission{
+						Key:         "update-permission",
+						Name:        "Update permission",
+						Description: "Allow administrators to change a permission and the policies of its roles.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/permissions/:id",
+					Handler: route.Handle((*handler.RBAC).DeletePermission),
+					Permission: &route.Permission{
+						Key:         "delete-permission",
+						Name:        "Delete permission",
+						Description: "Allow administrators to delete a permission and remove it from every role.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/accounts/:id/assignments",
+					Handler: route.Handle((*handler.RBAC).Assignments),
+					Permission: &route.Permission{
+						Key:         "get-account-role-assignments",
+						Name:        "Get account role assignments",
+						Description: "Allow administrators to view the roles assigned to an account per domain.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/assignments",
+					Handler: route.Handle((*handler.RBAC).Assign),
+					Permission: &route.Permission{
+						Key:         "create-role-assignment",
+						Name:        "Assign role",
+						Description: "Allow administrators to assign a role to an account within a domain.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/assignments/:id",
+					Handler: route.Handle((*handler.RBAC).Unassign),
+					Permission: &route.Permission{
+						Key:         "delete-role-assignment",
+						Name:        "Unassign role",
+						Description: "Allow administrators to take a role assigned within a domain away from an account.",
+						Roles:       []string{"admin"},
+					},
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/routes",
+					Handler: route.Handle((*handler.RBAC).Routes),
+					Permission: &route.Permission{
+						Key:         "get-routes",
+						Name:        "Get routes",
+						Description: "Allow administrators to list every route with its access and the permission guarding it.",
+						Roles:       []string{"admin"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/audit/v1",
+			Routes: []route.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/logs",
+					Handler: route.Handle((*handler.Audit).Logs),
+					Permission: &route.Permission{
+						Key:         "get-audit-logs",
+						Name:        "Get audit logs",
+						Description: "Allow administrators to search the audit log of data changes, logins, lockouts and role changes.",
+						Roles:       []string{"admin"},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "/apikey/v1",
+			Routes: []route.Route{
+				{
+					Method:    http.MethodGet,
+					Path:      "/keys",
+					Handler:   route.Handle((*handler.APIKey).Keys),
+					Sensitive: true,
+					Permission: &route.Permission{
+						Key:         "get-api-keys",
+						Name:        "Get API keys",
+						Description: "Allow hospital staff to list the API keys their hospital information system calls Setetes with.",
+						Roles:       []string{"hospital-staff"},
+					},
+				},
+				{
+					Method:    http.MethodPost,
+					Path:      "/keys",
+					Handler:   route.Handle((*handler.APIKey).Create),
+					Sensitive: true,
+					Permission: &route.Permission{
+						Key:         "create-api-key",
+						Name:        "Create API key",
+						Description: "Allow hospital staff to issue an API key scoped to permissions for their hospital information system.",
+						Roles:       []string{"hospital-staff"},
+					},
+				},
+				{
+					Method:    http.MethodPost,
+					Path:      "/keys/:id/rotate",
+					Handler:   route.Handle((*handler.APIKey).Rotate),
+					Sensitive: true,
+					Permission: &route.Permission{
+						Key:         "rotate-api-key",
+						Name:        "Rotate API key",
+						Description: "Allow hospital staff to replace an API key, keeping the old key working for a grace period.",
+						Roles:       []string{"hospital-staff"},
+					},
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/keys/:id",
+					Handler: route.Handle((*handler.APIKey).Revoke),
+					Permission: &route.Permission{
+						Key:         "revoke-api-key",
+						Name:        "Revoke API key",
+						Description: "Allow hospital staff to revoke an API key so it is no longer accepted.",
+						Roles:       []string{"hospital-staff"},
+					},
+				},
+			},
+		},
 	}
 }
+
+// signed verifies the signature of partner calls, see
+// middleware.SignatureConfig.
+func signed(i do.Injector) gin.HandlerFunc {
+	return do.MustInvoke[*middleware.SignatureConfig](i).Signature()
+}
+
+func ping(c *gin.Context) {
+	response.Ok(c, response.MsgPong, nil)
+}
@@ -0,0 +1,82 @@
+package job
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type (
+	// Job is a task run periodically in the background of the API server.
+	Job struct {
+		Name     string
+		Interval time.Duration
+		Run      func(now time.Time) error
+	}
+
+	Runner struct {
+		jobs []Job
+		stop chan struct{}
+		wg   sync.WaitGroup
+		log  *slog.Logger
+	}
+)
+
+func NewRunner(jobs ...Job) *Runner {
+	return &Runner{
+		jobs: jobs,
+		stop: make(chan struct{}),
+		log:  slog.Default(),
+	}
+}
+
+// Start runs every job once and then on its interval until Stop is called.
+func (r *Runner) Start() {
+	for _, j := range r.jobs {
+		r.wg.Add(1)
+		go r.loop(j)
+	}
+}
+
+// Stop signals the jobs to exit and waits for a running job to finish.
+func (r *Runner) Stop() {
+	close(r.stop)
+	r.wg.Wait()
+}
+
+func (r *Runner) loop(j Job) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+
+	r.run(j, time.Now())
+	for {
+		select {
+		case <-r.stop:
+			return
+		case now := <-ticker.C:
+			r.run(j, now)
+		}
+	}
+}
+
+func (r *Runner) run(j Job, now time.Time) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			r.log.Error(
+				"job panicked",
+				slog.String("job", j.Name),
+				slog.Any("panic", rec),
+			)
+		}
+	}()
+
+	if err := j.Run(now); err != nil {
+		r.log.Error(
+			"job failed",
+			slog.String("job", j.Name),
+			slog.Any("error", err),
+		)
+	}
+}
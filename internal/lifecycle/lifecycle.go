@@ -0,0 +1,120 @@
+package lifecycle
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/sembraniteam/setetes/internal/config"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+)
+
+const (
+	day = time.Hour * 24
+
+	defaultWholeBloodDays = 35
+	defaultPRCDays        = 42
+	defaultTCDays         = 5
+	defaultFFPDays        = 365
+)
+
+// transitions lists the statuses a unit may move to from each status.
+// ISSUED, EXPIRED and DISCARDED are final.
+var transitions = map[bloodunit.Status][]bloodunit.Status{
+	bloodunit.StatusQuarantined: {
+		bloodunit.StatusTested,
+		bloodunit.StatusExpired,
+		bloodunit.StatusDiscarded,
+	},
+	bloodunit.StatusTested: {
+		bloodunit.StatusAvailable,
+		bloodunit.StatusExpired,
+		bloodunit.StatusDiscarded,
+	},
+	bloodunit.StatusAvailable: {
+		bloodunit.StatusReserved,
+		bloodunit.StatusIssued,
+		bloodunit.StatusExpired,
+		bloodunit.StatusDiscarded,
+	},
+	bloodunit.StatusReserved: {
+		bloodunit.StatusAvailable,
+		bloodunit.StatusIssued,
+		bloodunit.StatusExpired,
+		bloodunit.StatusDiscarded,
+	},
+}
+
+// Transition returns an error when the state machine does not allow moving a
+// unit from one status to the other.
+func Transition(from, to bloodunit.Status) error {
+	if !slices.Contains(transitions[from], to) {
+		return fmt.Errorf("blood unit cannot change from %s to %s", from, to)
+	}
+
+	return nil
+}
+
+// Final reports whether no transition leaves the status.
+func Final(s bloodunit.Status) bool {
+	return len(transitions[s]) == 0
+}
+
+// Active returns the statuses that are not final, which are the statuses the
+// expiry job has to look at.
+func Active() []bloodunit.Status {
+	return []bloodunit.Status{
+		bloodunit.StatusQuarantined,
+		bloodunit.StatusTested,
+		bloodunit.StatusAvailable,
+		bloodunit.StatusReserved,
+	}
+}
+
+// InStock reports whether a unit with the status is counted in the blood
+// stock. Reserved units stay on the shelf until they are issued.
+func InStock(s bloodunit.Status) bool {
+	return s == bloodunit.StatusAvailable || s == bloodunit.StatusReserved
+}
+
+// Movement returns the stock movement caused by a transition, if any. Units
+// enter the stock once they pass testing and leave it when issued, expired
+// or discarded.
+func Movement(from, to bloodunit.Status) (stockmovement.Type, bool) {
+	switch {
+	case !InStock(from) && InStock(to):
+		return stockmovement.TypeCollect, true
+	case InStock(from) && to == bloodunit.StatusIssued:
+		return stockmovement.TypeIssue, true
+	case InStock(from) && !InStock(to):
+		return stockmovement.TypeDiscard, true
+	default:
+		return "", false
+	}
+}
+
+// ShelfLife returns how long a component may be stored, with any value set
+// in the inventory config section taking precedence.
+func ShelfLife(c bloodunit.Component) time.Duration {
+	cfg := config.Get().Inventory.ShelfLife
+
+	switch c {
+	case bloodunit.ComponentPRC:
+		return days(cfg.PRC, defaultPRCDays)
+	case bloodunit.ComponentTC:
+		return days(cfg.TC, defaultTCDays)
+	case bloodunit.ComponentFFP:
+		return days(cfg.FFP, defaultFFPDays)
+	default:
+		return days(cfg.WholeBlood, defaultWholeBloodDays)
+	}
+}
+
+func days(v, def int) time.Duration {
+	if v <= 0 {
+		v = def
+	}
+
+	return time.Duration(v) * day
+}
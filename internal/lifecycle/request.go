@@ -0,0 +1,49 @@
+package lifecycle
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+)
+
+// requestTransitions lists the statuses a blood request may move to from
+// each status. A partially fulfilled request stays partially fulfilled until
+// the last bag is issued.
+var requestTransitions = map[bloodrequest.Status][]bloodrequest.Status{
+	bloodrequest.StatusSubmitted: {
+		bloodrequest.StatusAccepted,
+		bloodrequest.StatusRejected,
+		bloodrequest.StatusCancelled,
+	},
+	bloodrequest.StatusAccepted: {
+		bloodrequest.StatusPartiallyFulfilled,
+		bloodrequest.StatusFulfilled,
+		bloodrequest.StatusCancelled,
+	},
+	bloodrequest.StatusPartiallyFulfilled: {
+		bloodrequest.StatusPartiallyFulfilled,
+		bloodrequest.StatusFulfilled,
+		bloodrequest.StatusCancelled,
+	},
+}
+
+// RequestTransition returns an error when a blood request may not move from
+// one status to the other.
+func RequestTransition(from, to bloodrequest.Status) error {
+	if !slices.Contains(requestTransitions[from], to) {
+		return fmt.Errorf("blood request cannot change from %s to %s", from, to)
+	}
+
+	return nil
+}
+
+// Fulfilment returns the request status once the issued number of bags has
+// been handed over.
+func Fulfilment(issued, quantity int) bloodrequest.Status {
+	if issued >= quantity {
+		return bloodrequest.StatusFulfilled
+	}
+
+	return bloodrequest.StatusPartiallyFulfilled
+}
@@ -0,0 +1,59 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+)
+
+// EmailSender delivers messages through an SMTP server.
+type EmailSender struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewEmailSender(
+	host string,
+	port int,
+	username string,
+	password string,
+	from string,
+) *EmailSender {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &EmailSender{
+		addr: net.JoinHostPort(host, strconv.Itoa(port)),
+		from: from,
+		auth: auth,
+	}
+}
+
+func (e *EmailSender) Send(_ context.Context, msg Message) error {
+	if msg.To == "" {
+		return errors.New("email recipient is empty")
+	}
+
+	if strings.ContainsAny(msg.To+msg.Subject, "\r\n") {
+		return errors.New("email header contains a line break")
+	}
+
+	body := fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: %s\r\n"+
+			"MIME-Version: 1.0\r\n"+
+			"Content-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		e.from,
+		msg.To,
+		msg.Subject,
+		msg.Body,
+	)
+
+	return smtp.SendMail(e.addr, e.auth, e.from, []string{msg.To}, []byte(body))
+}
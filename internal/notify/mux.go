@@ -0,0 +1,32 @@
+package notify
+
+import "context"
+
+// Mux delivers every message with the sender registered for its channel, and
+// with the fallback sender when no sender is registered for it.
+type Mux struct {
+	senders  map[Channel]Sender
+	fallback Sender
+}
+
+func NewMux(fallback Sender) *Mux {
+	return &Mux{
+		senders:  make(map[Channel]Sender),
+		fallback: fallback,
+	}
+}
+
+// Handle registers s for messages sent through c. It must be called before
+// the mux is used.
+func (m *Mux) Handle(c Channel, s Sender) *Mux {
+	m.senders[c] = s
+	return m
+}
+
+func (m *Mux) Send(ctx context.Context, msg Message) error {
+	if s, ok := m.senders[msg.Channel]; ok {
+		return s.Send(ctx, msg)
+	}
+
+	return m.fallback.Send(ctx, msg)
+}
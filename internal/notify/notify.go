@@ -0,0 +1,58 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ChannelPush  Channel = "PUSH"
+	ChannelEmail Channel = "EMAIL"
+	ChannelSMS   Channel = "SMS"
+)
+
+type (
+	// Channel is the medium a message is delivered through.
+	Channel string
+
+	// Message is a notification addressed to one account. To is the address
+	// on the channel, e.g. an email address, a phone number or a device
+	// token, and is empty when the channel is not known.
+	Message struct {
+		AccountID uuid.UUID
+		Channel   Channel
+		To        string
+		Subject   string
+		Body      string
+	}
+
+	// Sender delivers messages to donors. Implementations must be safe for
+	// concurrent use.
+	Sender interface {
+		Send(ctx context.Context, msg Message) error
+	}
+
+	// LogSender writes messages to the log instead of delivering them. It is
+	// used until a delivery provider is configured.
+	LogSender struct {
+		log *slog.Logger
+	}
+)
+
+func NewLogSender() *LogSender {
+	return &LogSender{log: slog.Default()}
+}
+
+func (l *LogSender) Send(_ context.Context, msg Message) error {
+	l.log.Info(
+		"notification",
+		slog.String("account_id", msg.AccountID.String()),
+		slog.String("channel", string(msg.Channel)),
+		slog.String("subject", msg.Subject),
+		slog.String("body", msg.Body),
+	)
+
+	return nil
+}
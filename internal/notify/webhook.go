@@ -0,0 +1,83 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const webhookTimeout = time.Second * 10
+
+type (
+	// WebhookSender posts messages as JSON to a delivery gateway, e.g. a push
+	// or SMS provider.
+	WebhookSender struct {
+		url    string
+		token  string
+		client *http.Client
+	}
+
+	webhookPayload struct {
+		AccountID string  `json:"account_id"`
+		Channel   Channel `json:"channel"`
+		To        string  `json:"to"`
+		Subject   string  `json:"subject"`
+		Body      string  `json:"body"`
+	}
+)
+
+func NewWebhookSender(url, token string) *WebhookSender {
+	return &WebhookSender{
+		url:    url,
+		token:  token,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+func (w *WebhookSender) Send(ctx context.Context, msg Message) error {
+	if msg.To == "" {
+		return fmt.Errorf("%s recipient is empty", msg.Channel)
+	}
+
+	payload, err := json.Marshal(webhookPayload{
+		AccountID: msg.AccountID.String(),
+		Channel:   msg.Channel,
+		To:        msg.To,
+		Subject:   msg.Subject,
+		Body:      msg.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		w.url,
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if w.token != "" {
+		req.Header.Set("Authorization", "Bearer "+w.token)
+	}
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return errors.New("webhook responded with " + res.Status)
+	}
+
+	return nil
+}
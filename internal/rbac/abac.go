@@ -0,0 +1,197 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/sembraniteam/setetes/internal/ent"
+)
+
+// Positions of the abacMatch arguments.
+const (
+	argRequestSubject = iota
+	argRequestDomain
+	argRequestObject
+	argRequestAction
+	argPolicySubject
+	argPolicyDomain
+	argPolicyObject
+	argPolicyAction
+	argRequestContext
+	abacArgsLen
+)
+
+type (
+	// Request is the request an attribute condition is evaluated against.
+	// Params holds the values of the path parameters of the matched policy,
+	// such as id for /unit/v1/units/:id.
+	Request struct {
+		Subject string
+		Domain  string
+		Object  string
+		Action  string
+		Params  map[string]string
+	}
+
+	// Condition reports whether the request satisfies an attribute rule,
+	// usually by comparing attributes of the subject with attributes of the
+	// entity the request targets.
+	Condition func(ctx context.Context, req Request) (bool, error)
+
+	ruleKey struct {
+		role     string
+		domain   string
+		resource string
+		action   string
+	}
+)
+
+// RegisterConditions makes the conditions available to permissions by name.
+func (m *Manager) RegisterConditions(conditions map[string]Condition) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for name, c := range conditions {
+		m.conditions[name] = c
+	}
+}
+
+// HasCondition reports whether a condition is registered under the name.
+func (m *Manager) HasCondition(name string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	_, ok := m.conditions[name]
+
+	return ok
+}
+
+// loadRules maps every policy of a role to the conditions of
+// the permissions it comes from. A policy granted by several permissions
+// holds when any of their conditions holds, and an empty condition always
+// holds.
+func (m *Manager) loadRules() error {
+	perms, err := m.client.Permission.Query().
+		WithRoles().
+		All(context.Background())
+	if err != nil {
+		return err
+	}
+
+	rules := make(map[ruleKey][]string)
+	for _, p := range perms {
+		for _, rl := range p.Edges.Roles {
+			key := ruleKey{
+				role:     rl.Key,
+				domain:   p.Domain,
+				resource: p.Resource,
+				action:   p.Action,
+			}
+			rules[key] = append(rules[key], p.Condition)
+		}
+	}
+
+	m.mu.Lock()
+	m.rules = rules
+	m.mu.Unlock()
+
+	return nil
+}
+
+// abacMatch evaluates the conditions of the matched policy. It is called as
+// abacMatch(r.sub, r.dom, r.obj, r.act, p.sub, p.dom, p.obj, p.act, r.ctx),
+// where r.ctx is the context of the request being enforced, so the queries
+// of the conditions end with it. A policy without conditions, such as one
+// written outside of the permission table, always matches, while an unknown
+// condition never does.
+func (m *Manager) abacMatch(args ...any) (any, error) {
+	if len(args) != abacArgsLen {
+		return false, errors.New("abacMatch expects 9 arguments")
+	}
+
+	ctx, ok := args[argRequestContext].(context.Context)
+	if !ok {
+		return false, errors.New("abacMatch expects a request context")
+	}
+
+	values := make([]string, 0, argRequestContext)
+	for _, arg := range args[:argRequestContext] {
+		v, ok := arg.(string)
+		if !ok {
+			return false, errors.New("abacMatch expects string arguments")
+		}
+
+		values = append(values, v)
+	}
+
+	m.mu.RLock()
+	names := m.rules[ruleKey{
+		role:     values[argPolicySubject],
+		domain:   values[argPolicyDomain],
+		resource: values[argPolicyObject],
+		action:   values[argPolicyAction],
+	}]
+	conditions := make([]Condition, 0, len(names))
+	for _, name := range names {
+		conditions = append(conditions, m.conditions[name])
+	}
+	m.mu.RUnlock()
+
+	if len(names) == 0 || slices.Contains(names, "") {
+		return true, nil
+	}
+
+	req := Request{
+		Subject: values[argRequestSubject],
+		Domain:  values[argRequestDomain],
+		Object:  values[argRequestObject],
+		Action:  values[argRequestAction],
+		Params: params(
+			values[argPolicyObject],
+			values[argRequestObject],
+		),
+	}
+
+	for _, c := range conditions {
+		if c == nil {
+			continue
+		}
+
+		ok, err := c(ctx, req)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+
+			return false, err
+		}
+
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// params extracts the values of the :name segments of a keyMatch2 pattern
+// from the path.
+func params(pattern, path string) map[string]string {
+	keys := strings.Split(pattern, "/")
+	values := strings.Split(path, "/")
+
+	out := make(map[string]string)
+	for i, key := range keys {
+		if i >= len(values) {
+			break
+		}
+
+		if name, ok := strings.CutPrefix(key, ":"); ok {
+			out[name] = values[i]
+		}
+	}
+
+	return out
+}
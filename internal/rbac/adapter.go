@@ -135,36 +135,25 @@ func (a *Adapter) SavePolicy(m model.Model) error {
 		lines := make([]*ent.CasbinRuleCreate, 0)
 		for ptype, ast := range m["p"] {
 			for _, rule := range ast.Policy {
-				line := a.savePolicyLine(tx, ptype, rule)
+				line := savePolicyLine(tx, ptype, rule)
 				lines = append(lines, line)
 			}
 		}
 
 		for ptype, ast := range m["g"] {
 			for _, rule := range ast.Policy {
-				line := a.savePolicyLine(tx, ptype, rule)
+				line := savePolicyLine(tx, ptype, rule)
 				lines = append(lines, line)
 			}
 		}
 
-		batchSize := 5000
-		for i := 0; i < len(lines); i += batchSize {
-			end := min(i+batchSize, len(lines))
-
-			batch := lines[i:end]
-			if _, err := tx.CasbinRule.CreateBulk(batch...).
-				Save(a.ctx); err != nil {
-				return err
-			}
-		}
-
-		return nil
+		return a.createBulk(tx, lines)
 	})
 }
 
 func (a *Adapter) AddPolicy(sec, ptype string, rules []string) error {
 	return a.WithTx(func(tx *ent.Tx) error {
-		_, err := a.savePolicyLine(tx, ptype, rules).Save(a.ctx)
+		_, err := savePolicyLine(tx, ptype, rules).Save(a.ctx)
 		return err
 	})
 }
@@ -258,17 +247,7 @@ func (a *Adapter) UpdatePolicies(
 			}
 		}
 
-		lines := make([]*ent.CasbinRuleCreate, 0)
-		for _, policy := range newRules {
-			lines = append(lines, a.savePolicyLine(tx, ptype, policy))
-		}
-
-		if _, err := tx.CasbinRule.CreateBulk(lines...).
-			Save(a.ctx); err != nil {
-			return err
-		}
-
-		return nil
+		return a.createPolicies(tx, ptype, newRules)
 	})
 }
 
@@ -342,7 +321,7 @@ func ToStringArray(rule *ent.CasbinRule) []string {
 	return arr
 }
 
-func (a *Adapter) savePolicyLine(
+func savePolicyLine(
 	tx *ent.Tx,
 	ptype string,
 	rules []string,
@@ -413,13 +392,25 @@ func (a *Adapter) createPolicies(
 	ptype string,
 	policies [][]string,
 ) error {
-	lines := make([]*ent.CasbinRuleCreate, 0)
+	lines := make([]*ent.CasbinRuleCreate, 0, len(policies))
 	for _, policy := range policies {
-		lines = append(lines, a.savePolicyLine(tx, ptype, policy))
+		lines = append(lines, savePolicyLine(tx, ptype, policy))
 	}
 
-	if _, err := tx.CasbinRule.CreateBulk(lines...).Save(a.ctx); err != nil {
-		return err
+	return a.createBulk(tx, lines)
+}
+
+// createBulk inserts the rules DefaultBatchSize at a time, keeping every
+// statement below the parameter limit of the database.
+func (a *Adapter) createBulk(tx *ent.Tx, lines []*ent.CasbinRuleCreate) error {
+	for i := 0; i < len(lines); i += DefaultBatchSize {
+		end := min(i+DefaultBatchSize, len(lines))
+
+		batch := lines[i:end]
+		if _, err := tx.CasbinRule.CreateBulk(batch...).
+			Save(a.ctx); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1,25 +1,36 @@
 package rbac
 
 import (
+	"context"
 	_ "embed"
 	"errors"
+	"sync"
 
 	"github.com/casbin/casbin/v3"
 	"github.com/casbin/casbin/v3/model"
-	"github.com/casbin/casbin/v3/util"
 	"github.com/sembraniteam/setetes/internal/ent"
 )
 
 const (
 	args2Len = 2
-	args4Len = 4
+
+	// AnyDomain is the domain of rules that apply in every domain, such as
+	// the links between a role and its parents.
+	AnyDomain = "*"
 )
 
 //go:embed model.conf
 var modelFile string
 
 type Manager struct {
-	enforcer *casbin.SyncedEnforcer
+	enforcer   *casbin.SyncedEnforcer
+	client     *ent.Client
+	mu         sync.RWMutex
+	conditions map[string]Condition
+	rules      map[ruleKey][]string
+	resolvers  map[string]DomainResolver
+	pending    map[*ent.Tx]*changeSet
+	watcher    *Watcher
 }
 
 func New(client *ent.Client) (*Manager, error) {
@@ -38,22 +49,47 @@ func New(client *ent.Client) (*Manager, error) {
 		return nil, err
 	}
 
-	e.AddFunction("abacMatch", abacMatch)
+	rm := &Manager{
+		enforcer:   e,
+		client:     client,
+		conditions: make(map[string]Condition),
+		resolvers:  make(map[string]DomainResolver),
+		pending:    make(map[*ent.Tx]*changeSet),
+	}
+
+	e.AddFunction("abacMatch", rm.abacMatch)
 	e.AddFunction("domMatch", domainMatch)
+	e.AddNamedDomainMatchingFunc("g", "domMatch", matchDomain)
 
-	if err = e.LoadPolicy(); err != nil {
+	if err = rm.LoadPolicy(); err != nil {
 		return nil, err
 	}
 
-	return &Manager{enforcer: e}, nil
+	return rm, nil
 }
 
 func (m *Manager) GetEnforcer() casbin.IEnforcer {
 	return m.enforcer
 }
 
+// LoadPolicy loads the policies and the conditions of the permissions they
+// come from.
 func (m *Manager) LoadPolicy() error {
-	return m.enforcer.LoadPolicy()
+	if err := m.enforcer.LoadPolicy(); err != nil {
+		return err
+	}
+
+	return m.loadRules()
+}
+
+// Enforce reports whether the subject may take the action on the object in
+// the domain. The conditions of the matched policies run their queries in
+// ctx.
+func (m *Manager) Enforce(
+	ctx context.Context,
+	subject, domain, object, action string,
+) (bool, error) {
+	return m.enforcer.Enforce(subject, domain, object, action, ctx)
 }
 
 func (m *Manager) HasRole(user, role string, domain ...string) (bool, error) {
@@ -65,48 +101,47 @@ func (m *Manager) HasRole(user, role string, domain ...string) (bool, error) {
 	return has, nil
 }
 
+// AddRoleForUser grants the role. Like the other changes made through the
+// enforcer, only the changed rule is stored, through the adapter, and
+// broadcast by the watcher.
 func (m *Manager) AddRoleForUser(user, role string, domain ...string) error {
 	_, err := m.enforcer.AddRoleForUser(user, role, domain...)
-	if err != nil {
-		return err
-	}
 
-	return m.enforcer.SavePolicy()
+	return err
 }
 
 func (m *Manager) RemoveRoleForUser(user, role string, domain ...string) error {
 	_, err := m.enforcer.DeleteRoleForUser(user, role, domain...)
-	if err != nil {
-		return err
-	}
 
-	return m.enforcer.SavePolicy()
+	return err
 }
 
 func (m *Manager) AddPolicy(role, domain, resource, action string) error {
 	_, err := m.enforcer.AddPolicy(role, domain, resource, action)
-	if err != nil {
-		return err
-	}
 
-	return m.enforcer.SavePolicy()
+	return err
 }
 
 func (m *Manager) RemovePolicy(role, domain, resource, action string) error {
 	_, err := m.enforcer.RemovePolicy(role, domain, resource, action)
-	if err != nil {
-		return err
-	}
 
-	return m.enforcer.SavePolicy()
+	return err
 }
 
-func abacMatch(args ...any) (any, error) {
-	if len(args) != args4Len {
-		return false, errors.New("abacMatch expects 4 arguments")
-	}
+// AddPolicies adds the policies, each one as role, domain, resource and
+// action, in a single transaction.
+func (m *Manager) AddPolicies(rules [][]string) error {
+	_, err := m.enforcer.AddPolicies(rules)
+
+	return err
+}
 
-	return true, nil
+// AddRolesForUsers adds the groupings, each one as user, role and domain, in
+// a single transaction.
+func (m *Manager) AddRolesForUsers(rules [][]string) error {
+	_, err := m.enforcer.AddGroupingPolicies(rules)
+
+	return err
 }
 
 func domainMatch(args ...any) (any, error) {
@@ -124,9 +159,5 @@ func domainMatch(args ...any) (any, error) {
 		return false, errors.New("domainMatch expects string policy")
 	}
 
-	if policy == "" || policy == "*" {
-		return true, nil
-	}
-
-	return util.KeyMatch2(domain, policy), nil
+	return matchDomain(domain, policy), nil
 }
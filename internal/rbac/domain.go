@@ -0,0 +1,107 @@
+package rbac
+
+import (
+	"context"
+	"strings"
+
+	"github.com/casbin/casbin/v3/util"
+)
+
+// RegionPrefix prefixes the BPS code of region domains, such as region:31
+// for a province or region:3171 for one of its cities.
+const RegionPrefix = "region:"
+
+// DomainResolver returns the domain of the resource a request targets from
+// the parameters of the request, or an empty string when the resource has
+// none. The parameters are the path parameters of the request and the
+// top-level string fields of its JSON body, such as pmi_location_id for a
+// resource created at a PMI location.
+type DomainResolver func(
+	ctx context.Context,
+	params map[string]string,
+) (string, error)
+
+// RegionDomain returns the domain of the region with the BPS code.
+func RegionDomain(code string) string {
+	return RegionPrefix + code
+}
+
+// RegisterDomainResolvers registers the resolvers by route pattern, such as
+// /stock/v1/locations/:id.
+func (m *Manager) RegisterDomainResolvers(resolvers map[string]DomainResolver) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for route, r := range resolvers {
+		m.resolvers[route] = r
+	}
+}
+
+// HasDomainResolver reports whether a resolver is registered for the route.
+func (m *Manager) HasDomainResolver(route string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	_, ok := m.resolvers[route]
+
+	return ok
+}
+
+// ResolveDomain returns the domain of the resource targeted by a request to
+// the route, or an empty string when no resolver is registered for it.
+func (m *Manager) ResolveDomain(
+	ctx context.Context,
+	route string,
+	params map[string]string,
+) (string, error) {
+	m.mu.RLock()
+	r, ok := m.resolvers[route]
+	m.mu.RUnlock()
+
+	if !ok {
+		return "", nil
+	}
+
+	return r(ctx, params)
+}
+
+// Domains returns the domains the subject holds a role in, in the order the
+// roles were granted.
+func (m *Manager) Domains(subject string) ([]string, error) {
+	grouping, err := m.enforcer.GetFilteredGroupingPolicy(0, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	domains := make([]string, 0, len(grouping))
+	seen := make(map[string]bool, len(grouping))
+	for _, rule := range grouping {
+		if len(rule) <= rule2 || seen[rule[rule2]] {
+			continue
+		}
+
+		seen[rule[rule2]] = true
+		domains = append(domains, rule[rule2])
+	}
+
+	return domains, nil
+}
+
+// matchDomain reports whether the domain matches the domain pattern of a
+// rule. A region pattern covers the regions below it, so region:31 matches
+// region:3171. It is also used by the role manager, so grouping rules stored
+// in AnyDomain apply to every domain and a role held in a province applies
+// to its cities.
+func matchDomain(domain, pattern string) bool {
+	if pattern == "" || pattern == AnyDomain || domain == pattern {
+		return true
+	}
+
+	code, ok := strings.CutPrefix(domain, RegionPrefix)
+	scope, scoped := strings.CutPrefix(pattern, RegionPrefix)
+	if ok && scoped && scope != AnyDomain {
+		return code != AnyDomain && strings.HasPrefix(code, scope)
+	}
+
+	return util.KeyMatch2(domain, pattern)
+}
@@ -0,0 +1,143 @@
+package rbac
+
+import (
+	"context"
+	"slices"
+
+	"github.com/casbin/casbin/v3/util"
+)
+
+// Outcomes of a policy in a Trace.
+const (
+	OutcomeAllowed         = "allowed"
+	OutcomeActionMismatch  = "action mismatch"
+	OutcomeConditionFailed = "condition failed"
+)
+
+type (
+	// Trace explains an enforcement. Roles are the roles the subject holds in
+	// the domain, directly or inherited, and Steps the policies of those
+	// roles whose resource matches the object.
+	Trace struct {
+		Allowed bool
+		Matched []string
+		Roles   []string
+		Steps   []TraceStep
+	}
+
+	// TraceStep is a policy considered by an enforcement with the names of
+	// its conditions and the outcome of matching it.
+	TraceStep struct {
+		Policy     []string
+		Conditions []string
+		Outcome    string
+		Error      error
+	}
+)
+
+// ListPolicies returns every policy as role, domain, resource and action.
+func (m *Manager) ListPolicies() ([][]string, error) {
+	return m.enforcer.GetPolicy()
+}
+
+// ListGroupings returns every grouping as user or role, role and domain.
+func (m *Manager) ListGroupings() ([][]string, error) {
+	return m.enforcer.GetGroupingPolicy()
+}
+
+// Roles returns the roles the subject holds in the domain, including the
+// ones inherited from parent roles.
+func (m *Manager) Roles(subject, domain string) ([]string, error) {
+	return m.enforcer.GetImplicitRolesForUser(subject, domain)
+}
+
+// Permissions returns the policies the subject is granted in the domain
+// through its roles.
+func (m *Manager) Permissions(subject, domain string) ([][]string, error) {
+	roles, err := m.Roles(subject, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := m.enforcer.GetPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][]string, 0)
+	for _, p := range policies {
+		if len(p) > rule3 &&
+			slices.Contains(roles, p[rule0]) &&
+			matchDomain(domain, p[rule1]) {
+			out = append(out, p)
+		}
+	}
+
+	return out, nil
+}
+
+// Explain enforces the request and traces every policy of the roles of the
+// subject whose resource matches the object, so a denial can be told apart
+// from a missing role, a mismatched action or a failed condition.
+func (m *Manager) Explain(
+	ctx context.Context,
+	subject, domain, object, action string,
+) (Trace, error) {
+	allowed, matched, err := m.enforcer.EnforceEx(
+		subject,
+		domain,
+		object,
+		action,
+		ctx,
+	)
+	if err != nil {
+		return Trace{}, err
+	}
+
+	roles, err := m.Roles(subject, domain)
+	if err != nil {
+		return Trace{}, err
+	}
+
+	policies, err := m.Permissions(subject, domain)
+	if err != nil {
+		return Trace{}, err
+	}
+
+	trace := Trace{Allowed: allowed, Matched: matched, Roles: roles}
+	for _, p := range policies {
+		if !util.KeyMatch2(object, p[rule2]) {
+			continue
+		}
+
+		m.mu.RLock()
+		conditions := m.rules[ruleKey{
+			role:     p[rule0],
+			domain:   p[rule1],
+			resource: p[rule2],
+			action:   p[rule3],
+		}]
+		m.mu.RUnlock()
+
+		step := TraceStep{
+			Policy:     p,
+			Conditions: conditions,
+			Outcome:    OutcomeActionMismatch,
+		}
+		if util.RegexMatch(action, p[rule3]) {
+			ok, err := m.abacMatch(
+				subject, domain, object, action,
+				p[rule0], p[rule1], p[rule2], p[rule3], ctx,
+			)
+			step.Outcome = OutcomeConditionFailed
+			step.Error = err
+			if pass, _ := ok.(bool); pass && err == nil {
+				step.Outcome = OutcomeAllowed
+			}
+		}
+
+		trace.Steps = append(trace.Steps, step)
+	}
+
+	return trace, nil
+}
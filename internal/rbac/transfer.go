@@ -0,0 +1,248 @@
+package rbac
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/casbinrule"
+)
+
+// DefaultBatchSize is the number of rules read or written per query.
+const DefaultBatchSize = 5000
+
+// ImportReport counts the rules of an import.
+type ImportReport struct {
+	Inserted int
+	Skipped  int
+}
+
+// Export writes every rule in the casbin policy file format, one rule per
+// line such as "p,admin,*,/rbac/v1/roles,GET", reading batchSize rules at a
+// time. It returns the number of rules written.
+func (m *Manager) Export(
+	ctx context.Context,
+	w io.Writer,
+	batchSize int,
+) (int, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	cw := csv.NewWriter(w)
+	written := 0
+	last := 0
+	for {
+		rules, err := m.client.CasbinRule.Query().
+			Where(casbinrule.IDGT(last)).
+			Order(ent.Asc(casbinrule.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return written, err
+		}
+
+		for _, rule := range rules {
+			record := append([]string{rule.Ptype}, ToStringArray(rule)...)
+			if err = cw.Write(record); err != nil {
+				return written, err
+			}
+
+			last = rule.ID
+		}
+
+		cw.Flush()
+		if err = cw.Error(); err != nil {
+			return written, err
+		}
+
+		written += len(rules)
+		if len(rules) < batchSize {
+			return written, nil
+		}
+	}
+}
+
+// Import adds the rules read in the casbin policy file format, as written by
+// Export, in one transaction of batchSize inserts. Rules that are already
+// stored or repeated in the input are skipped, which makes repeated imports
+// idempotent. The other instances load every policy again afterwards.
+func (m *Manager) Import(
+	ctx context.Context,
+	r io.Reader,
+	batchSize int,
+) (ImportReport, error) {
+	report := ImportReport{}
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	rules, err := m.readRules(r)
+	if err != nil {
+		return report, err
+	}
+
+	seen, err := m.storedRules(ctx, batchSize)
+	if err != nil {
+		return report, err
+	}
+
+	tx, err := m.client.Tx(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	lines := make([]*ent.CasbinRuleCreate, 0, batchSize)
+	flush := func() error {
+		if len(lines) == 0 {
+			return nil
+		}
+
+		_, err := tx.CasbinRule.CreateBulk(lines...).Save(ctx)
+		lines = lines[:0]
+
+		return err
+	}
+
+	for _, rule := range rules {
+		key := ruleLine(rule[0], rule[1:])
+		if seen[key] {
+			report.Skipped++
+
+			continue
+		}
+
+		seen[key] = true
+		lines = append(lines, savePolicyLine(tx, rule[0], rule[1:]))
+		report.Inserted++
+
+		if len(lines) < batchSize {
+			continue
+		}
+
+		if err = flush(); err != nil {
+			return ImportReport{}, rollback(tx, err)
+		}
+	}
+
+	if err = flush(); err != nil {
+		return ImportReport{}, rollback(tx, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return ImportReport{}, err
+	}
+
+	if err = m.LoadPolicy(); err != nil {
+		return report, err
+	}
+
+	m.mu.RLock()
+	w := m.watcher
+	m.mu.RUnlock()
+
+	if w != nil {
+		err = w.Update()
+	}
+
+	return report, err
+}
+
+// readRules parses the rules and checks them against the policy and role
+// definitions of the model.
+func (m *Manager) readRules(r io.Reader) ([][]string, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	definitions := m.enforcer.GetModel()
+	rules := make([][]string, 0)
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return rules, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := cr.FieldPos(0)
+		ptype := strings.TrimSpace(record[0])
+		if ptype == "" {
+			return nil, fmt.Errorf("line %d: missing policy type", line)
+		}
+
+		ast, ok := definitions[ptype[:1]][ptype]
+		if !ok {
+			return nil, fmt.Errorf(
+				"line %d: unknown policy type %q",
+				line,
+				ptype,
+			)
+		}
+
+		values := make([]string, 0, len(record)-1)
+		for _, v := range record[1:] {
+			values = append(values, strings.TrimSpace(v))
+		}
+
+		if len(values) != len(ast.Tokens) || slices.Contains(values, "") {
+			return nil, fmt.Errorf(
+				"line %d: %s expects %d values",
+				line,
+				ptype,
+				len(ast.Tokens),
+			)
+		}
+
+		rules = append(rules, append([]string{ptype}, values...))
+	}
+}
+
+// storedRules returns the lines of the stored rules, reading batchSize rules
+// at a time.
+func (m *Manager) storedRules(
+	ctx context.Context,
+	batchSize int,
+) (map[string]bool, error) {
+	seen := make(map[string]bool)
+	last := 0
+	for {
+		rules, err := m.client.CasbinRule.Query().
+			Where(casbinrule.IDGT(last)).
+			Order(ent.Asc(casbinrule.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules {
+			seen[ruleLine(rule.Ptype, ToStringArray(rule))] = true
+			last = rule.ID
+		}
+
+		if len(rules) < batchSize {
+			return seen, nil
+		}
+	}
+}
+
+func ruleLine(ptype string, values []string) string {
+	return ptype + "," + strings.Join(values, ",")
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rollback failed: %w", err, rerr)
+	}
+
+	return err
+}
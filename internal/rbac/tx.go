@@ -0,0 +1,243 @@
+package rbac
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/casbinrule"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+)
+
+const (
+	policyType   = "p"
+	groupingType = "g"
+)
+
+// Policies writes casbin rules inside an ent transaction, so they are stored
+// or rolled back together with the roles, permissions and assignments they
+// mirror. The enforcer sees the rules once the transaction is committed with
+// Manager.Commit.
+type (
+	Policies struct {
+		tx      *ent.Tx
+		ctx     context.Context
+		changes *changeSet
+	}
+
+	// changeSet collects the changes written by every Policies of a
+	// transaction.
+	changeSet struct {
+		mu    sync.Mutex
+		items []Change
+	}
+)
+
+func (m *Manager) Policies(ctx context.Context, tx *ent.Tx) *Policies {
+	return &Policies{tx: tx, ctx: ctx, changes: m.changeSet(tx)}
+}
+
+// Commit commits the transaction, applies the rules it changed to the
+// enforcer and broadcasts them to the other instances.
+func (m *Manager) Commit(tx *ent.Tx) error {
+	changes := m.takeChanges(tx)
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if err := m.apply(changes); err != nil {
+		return err
+	}
+
+	m.notify(changes)
+
+	return nil
+}
+
+// changeSet returns the changes of the transaction. They are dropped when
+// the transaction is rolled back.
+func (m *Manager) changeSet(tx *ent.Tx) *changeSet {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if cs, ok := m.pending[tx]; ok {
+		return cs
+	}
+
+	cs := &changeSet{}
+	m.pending[tx] = cs
+	tx.OnRollback(func(next ent.Rollbacker) ent.Rollbacker {
+		return ent.RollbackFunc(func(ctx context.Context, tx *ent.Tx) error {
+			m.takeChanges(tx)
+
+			return next.Rollback(ctx, tx)
+		})
+	})
+
+	return cs
+}
+
+func (m *Manager) takeChanges(tx *ent.Tx) []Change {
+	m.mu.Lock()
+	cs, ok := m.pending[tx]
+	delete(m.pending, tx)
+	m.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	return cs.items
+}
+
+func (c *changeSet) record(op, ptype string, rule []string) {
+	c.mu.Lock()
+	c.items = append(c.items, Change{
+		Op:    op,
+		Sec:   ptype,
+		Ptype: ptype,
+		Rule:  rule,
+	})
+	c.mu.Unlock()
+}
+
+func (p *Policies) AddPolicy(role, domain, resource, action string) error {
+	return p.add(policyType, role, domain, resource, action)
+}
+
+func (p *Policies) RemovePolicy(role, domain, resource, action string) error {
+	return p.remove(
+		casbinrule.PtypeEQ(policyType),
+		casbinrule.V0EQ(role),
+		casbinrule.V1EQ(domain),
+		casbinrule.V2EQ(resource),
+		casbinrule.V3EQ(action),
+	)
+}
+
+func (p *Policies) AddRoleForUser(user, role, domain string) error {
+	return p.add(groupingType, user, role, domain)
+}
+
+func (p *Policies) RemoveRoleForUser(user, role, domain string) error {
+	return p.remove(
+		casbinrule.PtypeEQ(groupingType),
+		casbinrule.V0EQ(user),
+		casbinrule.V1EQ(role),
+		casbinrule.V2EQ(domain),
+	)
+}
+
+// AddParent lets the role inherit the policies of the parent role in every
+// domain.
+func (p *Policies) AddParent(role, parent string) error {
+	return p.add(groupingType, role, parent, AnyDomain)
+}
+
+func (p *Policies) RemoveParent(role, parent string) error {
+	return p.RemoveRoleForUser(role, parent, AnyDomain)
+}
+
+// RemovePolicies removes every policy of the role and the links to its
+// parents, and keeps its grants, so the role grants nothing until they are
+// added again.
+func (p *Policies) RemovePolicies(role string) error {
+	return p.remove(
+		casbinrule.PtypeIn(policyType, groupingType),
+		casbinrule.V0EQ(role),
+	)
+}
+
+// RemoveRole removes every policy of the role, every grant of it and every
+// link between it and other roles.
+func (p *Policies) RemoveRole(role string) error {
+	return p.remove(casbinrule.Or(
+		casbinrule.And(
+			casbinrule.PtypeIn(policyType, groupingType),
+			casbinrule.V0EQ(role),
+		),
+		casbinrule.And(
+			casbinrule.PtypeEQ(groupingType),
+			casbinrule.V1EQ(role),
+		),
+	))
+}
+
+// add stores the rule unless it already exists, matching the enforcer which
+// ignores duplicated rules. Values past the given ones stay empty.
+func (p *Policies) add(ptype string, values ...string) error {
+	v := make([]string, rule4)
+	copy(v, values)
+
+	exists, err := p.tx.CasbinRule.Query().
+		Where(
+			casbinrule.PtypeEQ(ptype),
+			valueEQ(v[rule0], casbinrule.V0EQ, casbinrule.V0IsNil),
+			valueEQ(v[rule1], casbinrule.V1EQ, casbinrule.V1IsNil),
+			valueEQ(v[rule2], casbinrule.V2EQ, casbinrule.V2IsNil),
+			valueEQ(v[rule3], casbinrule.V3EQ, casbinrule.V3IsNil),
+		).
+		Exist(p.ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	rule, err := p.tx.CasbinRule.Create().
+		SetPtype(ptype).
+		SetV0(v[rule0]).
+		SetV1(v[rule1]).
+		SetV2(v[rule2]).
+		SetV3(v[rule3]).
+		Save(p.ctx)
+	if err != nil {
+		return err
+	}
+
+	p.changes.record(opAdd, ptype, ToStringArray(rule))
+
+	return nil
+}
+
+// remove deletes the matching rules and records them, so they are removed
+// from the enforcer on commit.
+func (p *Policies) remove(where ...predicate.CasbinRule) error {
+	rules, err := p.tx.CasbinRule.Query().Where(where...).All(p.ctx)
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+
+	ids := make([]int, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ID)
+	}
+
+	_, err = p.tx.CasbinRule.Delete().
+		Where(casbinrule.IDIn(ids...)).
+		Exec(p.ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		p.changes.record(opRemove, rule.Ptype, ToStringArray(rule))
+	}
+
+	return nil
+}
+
+// valueEQ matches a rule value. SavePolicy leaves unused values NULL while
+// Policies stores them empty, so an empty value matches both.
+func valueEQ(
+	v string,
+	eq func(string) predicate.CasbinRule,
+	isNil func() predicate.CasbinRule,
+) predicate.CasbinRule {
+	if v == "" {
+		return casbinrule.Or(eq(v), isNil())
+	}
+
+	return eq(v)
+}
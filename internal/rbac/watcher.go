@@ -0,0 +1,318 @@
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/casbin/casbin/v3/model"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	opAdd    = "add"
+	opRemove = "remove"
+)
+
+var log = slog.Default()
+
+type (
+	// Change is a rule added to or removed from the policies.
+	Change struct {
+		Op    string   `json:"op"`
+		Sec   string   `json:"sec"`
+		Ptype string   `json:"ptype"`
+		Rule  []string `json:"rule"`
+	}
+
+	// Message is published on the policy channel whenever an instance
+	// changes the policies. Peers apply the changes in order, or load every
+	// policy again when Reload is set.
+	Message struct {
+		ID      string   `json:"id"`
+		Reload  bool     `json:"reload,omitempty"`
+		Changes []Change `json:"changes,omitempty"`
+	}
+
+	// Watcher broadcasts policy changes to the other instances over a Redis
+	// channel and hands the messages they publish to the update callback.
+	// Messages published by the watcher itself are ignored.
+	Watcher struct {
+		rdb      *redis.Client
+		pubsub   *redis.PubSub
+		channel  string
+		id       string
+		mu       sync.RWMutex
+		callback func(string)
+		done     chan struct{}
+	}
+)
+
+// NewWatcher subscribes to the channel and starts receiving the messages of
+// the other instances.
+func NewWatcher(rdb *redis.Client, channel string) (*Watcher, error) {
+	ctx := context.Background()
+	pubsub := rdb.Subscribe(ctx, channel)
+
+	// Wait for the subscription, so no message published after this returns
+	// is missed.
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+
+		return nil, err
+	}
+
+	w := &Watcher{
+		rdb:     rdb,
+		pubsub:  pubsub,
+		channel: channel,
+		id:      uuid.NewString(),
+		done:    make(chan struct{}),
+	}
+
+	go w.receive()
+
+	return w, nil
+}
+
+func (w *Watcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	w.callback = callback
+	w.mu.Unlock()
+
+	return nil
+}
+
+// Update asks the other instances to load every policy again.
+func (w *Watcher) Update() error {
+	return w.Publish(Message{Reload: true})
+}
+
+// Close stops receiving messages.
+func (w *Watcher) Close() {
+	if err := w.pubsub.Close(); err != nil {
+		log.Error(
+			"Error closing policy watcher",
+			slog.String("error", err.Error()),
+		)
+	}
+
+	<-w.done
+}
+
+func (w *Watcher) UpdateForAddPolicy(
+	sec, ptype string,
+	params ...string,
+) error {
+	return w.UpdateForAddPolicies(sec, ptype, params)
+}
+
+func (w *Watcher) UpdateForRemovePolicy(
+	sec, ptype string,
+	params ...string,
+) error {
+	return w.UpdateForRemovePolicies(sec, ptype, params)
+}
+
+func (w *Watcher) UpdateForRemoveFilteredPolicy(
+	_, _ string,
+	_ int,
+	_ ...string,
+) error {
+	return w.Update()
+}
+
+func (w *Watcher) UpdateForSavePolicy(_ model.Model) error {
+	return w.Update()
+}
+
+func (w *Watcher) UpdateForAddPolicies(
+	sec, ptype string,
+	rules ...[]string,
+) error {
+	return w.Publish(Message{Changes: changes(opAdd, sec, ptype, rules)})
+}
+
+func (w *Watcher) UpdateForRemovePolicies(
+	sec, ptype string,
+	rules ...[]string,
+) error {
+	return w.Publish(Message{Changes: changes(opRemove, sec, ptype, rules)})
+}
+
+// Publish sends the message to the other instances.
+func (w *Watcher) Publish(msg Message) error {
+	msg.ID = w.id
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return w.rdb.Publish(context.Background(), w.channel, payload).Err()
+}
+
+// receive hands the messages of the other instances to the callback until
+// the watcher is closed.
+func (w *Watcher) receive() {
+	defer close(w.done)
+
+	for m := range w.pubsub.Channel() {
+		var msg Message
+		if err := json.Unmarshal([]byte(m.Payload), &msg); err != nil {
+			log.Error(
+				"Error decoding policy message",
+				slog.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		if msg.ID == w.id {
+			continue
+		}
+
+		w.mu.RLock()
+		callback := w.callback
+		w.mu.RUnlock()
+
+		if callback != nil {
+			callback(m.Payload)
+		}
+	}
+}
+
+// SetWatcher broadcasts the changes committed by this instance through the
+// watcher and applies the changes committed by the others.
+func (m *Manager) SetWatcher(w *Watcher) error {
+	if err := m.enforcer.SetWatcher(w); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.watcher = w
+	m.mu.Unlock()
+
+	return w.SetUpdateCallback(m.onUpdate)
+}
+
+// onUpdate applies a message published by another instance.
+func (m *Manager) onUpdate(payload string) {
+	var msg Message
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		return
+	}
+
+	var err error
+	if msg.Reload {
+		err = m.LoadPolicy()
+	} else {
+		err = m.apply(msg.Changes)
+	}
+
+	if err != nil {
+		log.Error(
+			"Error applying policy changes",
+			slog.String("from", msg.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// apply applies the changes to the policies held in memory, and loads the
+// conditions of the permissions again. The changes are already stored, by
+// the transaction that made them on this instance or by the instance that
+// broadcast them, so they are neither written through the adapter again nor
+// broadcast. When a change cannot be applied, every policy is loaded again
+// instead.
+func (m *Manager) apply(changes []Change) error {
+	if err := m.applyToModel(changes); err != nil {
+		return m.LoadPolicy()
+	}
+
+	return m.loadRules()
+}
+
+// applyToModel adds the rules to or removes them from the model of the
+// enforcer, under its lock, and updates the role links of grouping rules.
+// Adding a rule the model holds or removing one it does not hold does
+// nothing.
+func (m *Manager) applyToModel(changes []Change) error {
+	lock := m.enforcer.GetLock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	mdl := m.enforcer.GetModel()
+	for _, c := range changes {
+		op, changed, err := applyChange(mdl, c)
+		if err != nil {
+			return err
+		}
+
+		if changed && c.Sec == groupingType {
+			if err = m.enforcer.BuildIncrementalRoleLinks(
+				op,
+				c.Ptype,
+				[][]string{c.Rule},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyChange applies the change to the model and reports whether the
+// model changed.
+func applyChange(mdl model.Model, c Change) (model.PolicyOp, bool, error) {
+	switch c.Op {
+	case opAdd:
+		has, err := mdl.HasPolicy(c.Sec, c.Ptype, c.Rule)
+		if err != nil || has {
+			return model.PolicyAdd, false, err
+		}
+
+		return model.PolicyAdd, true, mdl.AddPolicy(c.Sec, c.Ptype, c.Rule)
+	case opRemove:
+		removed, err := mdl.RemovePolicy(c.Sec, c.Ptype, c.Rule)
+
+		return model.PolicyRemove, removed, err
+	default:
+		return model.PolicyAdd, false, fmt.Errorf(
+			"unknown policy change %q",
+			c.Op,
+		)
+	}
+}
+
+// notify broadcasts the changes committed by this instance. The changes are
+// already stored, so a failure is only logged and the other instances catch
+// up on their next reload.
+func (m *Manager) notify(changes []Change) {
+	m.mu.RLock()
+	w := m.watcher
+	m.mu.RUnlock()
+
+	if w == nil {
+		return
+	}
+
+	if err := w.Publish(Message{Changes: changes}); err != nil {
+		log.Error(
+			"Error broadcasting policy changes",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func changes(op, sec, ptype string, rules [][]string) []Change {
+	out := make([]Change, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, Change{Op: op, Sec: sec, Ptype: ptype, Rule: rule})
+	}
+
+	return out
+}
@@ -0,0 +1,204 @@
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sembraniteam/setetes/internal/database/sqlitex"
+	"github.com/sembraniteam/setetes/internal/ent"
+)
+
+const (
+	user     = "user"
+	role     = "staff"
+	domain   = "region:31"
+	resource = "/stock/v1/movements"
+	action   = "POST"
+)
+
+func TestCommitAppliesChangesToTheModelOnly(t *testing.T) {
+	ctx := context.Background()
+	client := sqlitex.Open(t)
+	m := newManager(t, client)
+
+	// A rule stored behind the back of the manager is only seen after the
+	// policies are loaded again, which Commit must not need.
+	if err := client.CasbinRule.Create().
+		SetPtype(policyType).
+		SetV0(role).
+		SetV1(AnyDomain).
+		SetV2("/stock/v1/summary").
+		SetV3("GET").
+		Exec(ctx); err != nil {
+		t.Fatalf("store rule: %v", err)
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+
+	p := m.Policies(ctx, tx)
+	if err = p.AddPolicy(role, AnyDomain, resource, action); err != nil {
+		t.Fatalf("add policy: %v", err)
+	}
+
+	if err = p.AddRoleForUser(user, role, domain); err != nil {
+		t.Fatalf("add role: %v", err)
+	}
+
+	if err = m.Commit(tx); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	assertAllowed(t, m, resource, action, true)
+	assertAllowed(t, m, "/stock/v1/summary", "GET", false)
+	assertStored(t, client, 3)
+
+	tx, err = client.Tx(ctx)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+
+	if err = m.Policies(ctx, tx).RemoveRoleForUser(
+		user,
+		role,
+		domain,
+	); err != nil {
+		t.Fatalf("remove role: %v", err)
+	}
+
+	if err = m.Commit(tx); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	assertAllowed(t, m, resource, action, false)
+	assertStored(t, client, 2)
+}
+
+func TestOnUpdateAppliesChangesToTheModelOnly(t *testing.T) {
+	client := sqlitex.Open(t)
+	m := newManager(t, client)
+
+	// The peer stored the rules, so only its message tells this instance
+	// about them and nothing is written here.
+	update(t, m, Message{Changes: []Change{
+		{
+			Op:    opAdd,
+			Sec:   policyType,
+			Ptype: policyType,
+			Rule:  []string{role, AnyDomain, resource, action},
+		},
+		{
+			Op:    opAdd,
+			Sec:   groupingType,
+			Ptype: groupingType,
+			Rule:  []string{user, role, domain},
+		},
+	}})
+
+	assertAllowed(t, m, resource, action, true)
+	assertStored(t, client, 0)
+
+	// A change seen twice leaves the model as it is.
+	update(t, m, Message{Changes: []Change{
+		{
+			Op:    opAdd,
+			Sec:   groupingType,
+			Ptype: groupingType,
+			Rule:  []string{user, role, domain},
+		},
+	}})
+
+	update(t, m, Message{Changes: []Change{
+		{
+			Op:    opRemove,
+			Sec:   groupingType,
+			Ptype: groupingType,
+			Rule:  []string{user, role, domain},
+		},
+	}})
+
+	assertAllowed(t, m, resource, action, false)
+	assertStored(t, client, 0)
+}
+
+func TestOnUpdateReloadsPolicies(t *testing.T) {
+	ctx := context.Background()
+	client := sqlitex.Open(t)
+	m := newManager(t, client)
+
+	for _, rule := range [][]string{
+		{policyType, role, AnyDomain, resource, action},
+		{groupingType, user, role, domain, ""},
+	} {
+		if err := client.CasbinRule.Create().
+			SetPtype(rule[0]).
+			SetV0(rule[1]).
+			SetV1(rule[2]).
+			SetV2(rule[3]).
+			SetV3(rule[4]).
+			Exec(ctx); err != nil {
+			t.Fatalf("store rule: %v", err)
+		}
+	}
+
+	assertAllowed(t, m, resource, action, false)
+	update(t, m, Message{Reload: true})
+	assertAllowed(t, m, resource, action, true)
+}
+
+func newManager(t *testing.T, client *ent.Client) *Manager {
+	t.Helper()
+
+	m, err := New(client)
+	if err != nil {
+		t.Fatalf("new manager: %v", err)
+	}
+
+	return m
+}
+
+func update(t *testing.T, m *Manager, msg Message) {
+	t.Helper()
+
+	msg.ID = "peer"
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("encode message: %v", err)
+	}
+
+	m.onUpdate(string(payload))
+}
+
+func assertAllowed(
+	t *testing.T,
+	m *Manager,
+	object, act string,
+	want bool,
+) {
+	t.Helper()
+
+	got, err := m.Enforce(context.Background(), user, domain, object, act)
+	if err != nil {
+		t.Fatalf("enforce: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("Enforce(%s %s) = %t, want %t", act, object, got, want)
+	}
+}
+
+func assertStored(t *testing.T, client *ent.Client, want int) {
+	t.Helper()
+
+	got, err := client.CasbinRule.Query().Count(context.Background())
+	if err != nil {
+		t.Fatalf("count rules: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("stored %d rules, want %d", got, want)
+	}
+}
@@ -0,0 +1,392 @@
+package region
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/city"
+	"github.com/sembraniteam/setetes/internal/ent/district"
+	"github.com/sembraniteam/setetes/internal/ent/province"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+)
+
+const DefaultBatchSize = 1000
+
+type (
+	Counts struct {
+		Inserted int
+		Updated  int
+		Skipped  int
+	}
+
+	Report map[Level]*Counts
+
+	Importer struct {
+		client    *ent.Client
+		ctx       context.Context
+		batchSize int
+	}
+
+	stored struct {
+		id         uuid.UUID
+		name       string
+		postalCode string
+	}
+
+	// store abstracts the per level ent builders so every level is imported
+	// by the same batching logic.
+	store struct {
+		find   func(tx *ent.Tx, codes []string) (map[string]stored, error)
+		create func(tx *ent.Tx, recs []Record, parents map[string]stored) error
+		update func(tx *ent.Tx, id uuid.UUID, rec Record) error
+	}
+)
+
+var Levels = []Level{
+	LevelProvince,
+	LevelCity,
+	LevelDistrict,
+	LevelSubdistrict,
+}
+
+func NewImporter(client *ent.Client, batchSize int) *Importer {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	return &Importer{
+		client:    client,
+		ctx:       context.Background(),
+		batchSize: batchSize,
+	}
+}
+
+// Import validates the records and upserts them by BPS code, level by level
+// so parents always exist before their children. Records that already match
+// the stored row are skipped, which makes repeated imports idempotent.
+func (i *Importer) Import(records []Record) (Report, error) {
+	known, err := i.knownParents(records)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = Validate(records, known); err != nil {
+		return nil, err
+	}
+
+	grouped := make(map[Level][]Record, len(Levels))
+	for _, rec := range records {
+		level := LevelOf(rec.Code)
+		if level != LevelSubdistrict {
+			rec.PostalCode = ""
+		}
+
+		grouped[level] = append(grouped[level], rec)
+	}
+
+	report := make(Report, len(Levels))
+	for _, level := range Levels {
+		counts := &Counts{}
+		report[level] = counts
+
+		recs := grouped[level]
+		for start := 0; start < len(recs); start += i.batchSize {
+			end := min(start+i.batchSize, len(recs))
+			if err = i.importBatch(level, recs[start:end], counts); err != nil {
+				return report, fmt.Errorf(
+					"import %s batch %d-%d: %w",
+					level,
+					start,
+					end,
+					err,
+				)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func (i *Importer) importBatch(
+	level Level,
+	recs []Record,
+	counts *Counts,
+) error {
+	tx, err := i.client.Tx(i.ctx)
+	if err != nil {
+		return err
+	}
+
+	s := i.store(level)
+	codes := make([]string, 0, len(recs))
+	for _, rec := range recs {
+		codes = append(codes, rec.Code)
+	}
+
+	existing, err := s.find(tx, codes)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	inserts := make([]Record, 0, len(recs))
+	for _, rec := range recs {
+		row, ok := existing[rec.Code]
+		switch {
+		case !ok:
+			inserts = append(inserts, rec)
+		case row.name == rec.Name && row.postalCode == rec.PostalCode:
+			counts.Skipped++
+		default:
+			if err = s.update(tx, row.id, rec); err != nil {
+				return rollback(tx, err)
+			}
+			counts.Updated++
+		}
+	}
+
+	if len(inserts) > 0 {
+		parents := map[string]stored{}
+		if level != LevelProvince {
+			parentCodes := make([]string, 0, len(inserts))
+			for _, rec := range inserts {
+				parentCodes = append(parentCodes, ParentCode(rec.Code))
+			}
+
+			parents, err = i.store(level-1).find(tx, parentCodes)
+			if err != nil {
+				return rollback(tx, err)
+			}
+		}
+
+		if err = s.create(tx, inserts, parents); err != nil {
+			return rollback(tx, err)
+		}
+		counts.Inserted += len(inserts)
+	}
+
+	return tx.Commit()
+}
+
+// knownParents looks up parent codes that are referenced by the records but
+// not part of them, so an import may extend regions stored earlier.
+func (i *Importer) knownParents(records []Record) (map[string]bool, error) {
+	inFile := make(map[string]bool, len(records))
+	for _, rec := range records {
+		inFile[rec.Code] = true
+	}
+
+	missing := make(map[Level][]string)
+	for _, rec := range records {
+		parent := ParentCode(rec.Code)
+		if parent == "" || inFile[parent] {
+			continue
+		}
+
+		level := LevelOf(parent)
+		if !slices.Contains(missing[level], parent) {
+			missing[level] = append(missing[level], parent)
+		}
+	}
+
+	known := make(map[string]bool)
+	if len(missing) == 0 {
+		return known, nil
+	}
+
+	tx, err := i.client.Tx(i.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for level, codes := range missing {
+		rows, err := i.store(level).find(tx, codes)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		for code := range rows {
+			known[code] = true
+		}
+	}
+
+	return known, tx.Rollback()
+}
+
+func (i *Importer) store(level Level) store {
+	switch level {
+	case LevelProvince:
+		return i.provinceStore()
+	case LevelCity:
+		return i.cityStore()
+	case LevelDistrict:
+		return i.districtStore()
+	default:
+		return i.subdistrictStore()
+	}
+}
+
+func (i *Importer) provinceStore() store {
+	return store{
+		find: func(tx *ent.Tx, codes []string) (map[string]stored, error) {
+			rows, err := tx.Province.Query().
+				Where(province.BpsCodeIn(codes...)).
+				All(i.ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			out := make(map[string]stored, len(rows))
+			for _, r := range rows {
+				out[r.BpsCode] = stored{id: r.ID, name: r.Name}
+			}
+
+			return out, nil
+		},
+		create: func(tx *ent.Tx, recs []Record, _ map[string]stored) error {
+			builders := make([]*ent.ProvinceCreate, 0, len(recs))
+			for _, rec := range recs {
+				builders = append(builders, tx.Province.Create().
+					SetBpsCode(rec.Code).
+					SetName(rec.Name))
+			}
+
+			return tx.Province.CreateBulk(builders...).Exec(i.ctx)
+		},
+		update: func(tx *ent.Tx, id uuid.UUID, rec Record) error {
+			return tx.Province.UpdateOneID(id).SetName(rec.Name).Exec(i.ctx)
+		},
+	}
+}
+
+func (i *Importer) cityStore() store {
+	return store{
+		find: func(tx *ent.Tx, codes []string) (map[string]stored, error) {
+			rows, err := tx.City.Query().
+				Where(city.BpsCodeIn(codes...)).
+				All(i.ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			out := make(map[string]stored, len(rows))
+			for _, r := range rows {
+				out[r.BpsCode] = stored{id: r.ID, name: r.Name}
+			}
+
+			return out, nil
+		},
+		create: func(
+			tx *ent.Tx,
+			recs []Record,
+			parents map[string]stored,
+		) error {
+			builders := make([]*ent.CityCreate, 0, len(recs))
+			for _, rec := range recs {
+				builders = append(builders, tx.City.Create().
+					SetBpsCode(rec.Code).
+					SetName(rec.Name).
+					SetProvinceID(parents[ParentCode(rec.Code)].id))
+			}
+
+			return tx.City.CreateBulk(builders...).Exec(i.ctx)
+		},
+		update: func(tx *ent.Tx, id uuid.UUID, rec Record) error {
+			return tx.City.UpdateOneID(id).SetName(rec.Name).Exec(i.ctx)
+		},
+	}
+}
+
+func (i *Importer) districtStore() store {
+	return store{
+		find: func(tx *ent.Tx, codes []string) (map[string]stored, error) {
+			rows, err := tx.District.Query().
+				Where(district.BpsCodeIn(codes...)).
+				All(i.ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			out := make(map[string]stored, len(rows))
+			for _, r := range rows {
+				out[r.BpsCode] = stored{id: r.ID, name: r.Name}
+			}
+
+			return out, nil
+		},
+		create: func(
+			tx *ent.Tx,
+			recs []Record,
+			parents map[string]stored,
+		) error {
+			builders := make([]*ent.DistrictCreate, 0, len(recs))
+			for _, rec := range recs {
+				builders = append(builders, tx.District.Create().
+					SetBpsCode(rec.Code).
+					SetName(rec.Name).
+					SetCityID(parents[ParentCode(rec.Code)].id))
+			}
+
+			return tx.District.CreateBulk(builders...).Exec(i.ctx)
+		},
+		update: func(tx *ent.Tx, id uuid.UUID, rec Record) error {
+			return tx.District.UpdateOneID(id).SetName(rec.Name).Exec(i.ctx)
+		},
+	}
+}
+
+func (i *Importer) subdistrictStore() store {
+	return store{
+		find: func(tx *ent.Tx, codes []string) (map[string]stored, error) {
+			rows, err := tx.Subdistrict.Query().
+				Where(subdistrict.BpsCodeIn(codes...)).
+				All(i.ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			out := make(map[string]stored, len(rows))
+			for _, r := range rows {
+				out[r.BpsCode] = stored{
+					id:         r.ID,
+					name:       r.Name,
+					postalCode: r.PostalCode,
+				}
+			}
+
+			return out, nil
+		},
+		create: func(
+			tx *ent.Tx,
+			recs []Record,
+			parents map[string]stored,
+		) error {
+			builders := make([]*ent.SubdistrictCreate, 0, len(recs))
+			for _, rec := range recs {
+				builders = append(builders, tx.Subdistrict.Create().
+					SetBpsCode(rec.Code).
+					SetName(rec.Name).
+					SetPostalCode(rec.PostalCode).
+					SetDistrictID(parents[ParentCode(rec.Code)].id))
+			}
+
+			return tx.Subdistrict.CreateBulk(builders...).Exec(i.ctx)
+		},
+		update: func(tx *ent.Tx, id uuid.UUID, rec Record) error {
+			return tx.Subdistrict.UpdateOneID(id).
+				SetName(rec.Name).
+				SetPostalCode(rec.PostalCode).
+				Exec(i.ctx)
+		},
+	}
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+
+	return err
+}
@@ -0,0 +1,327 @@
+package region
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	ProvinceCodeLen    = 2
+	CityCodeLen        = 4
+	DistrictCodeLen    = 7
+	SubdistrictCodeLen = 10
+	postalCodeLen      = 5
+)
+
+type (
+	Level int
+
+	Record struct {
+		Code       string
+		Name       string
+		PostalCode string
+	}
+
+	// node is a single entry of the JSON hierarchy. Children of a node must
+	// carry the code of their parent as a prefix.
+	node struct {
+		Code       string `json:"bps_code"`
+		Name       string `json:"name"`
+		PostalCode string `json:"postal_code"`
+		Children   []node `json:"children"`
+	}
+)
+
+const (
+	LevelUnknown Level = iota
+	LevelProvince
+	LevelCity
+	LevelDistrict
+	LevelSubdistrict
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelProvince:
+		return "province"
+	case LevelCity:
+		return "city"
+	case LevelDistrict:
+		return "district"
+	case LevelSubdistrict:
+		return "subdistrict"
+	default:
+		return "unknown"
+	}
+}
+
+// LevelOf returns the administrative level of a BPS code based on its length.
+func LevelOf(code string) Level {
+	switch len(code) {
+	case ProvinceCodeLen:
+		return LevelProvince
+	case CityCodeLen:
+		return LevelCity
+	case DistrictCodeLen:
+		return LevelDistrict
+	case SubdistrictCodeLen:
+		return LevelSubdistrict
+	default:
+		return LevelUnknown
+	}
+}
+
+// ParentCode returns the BPS code of the parent region, or an empty string for
+// provinces and unknown codes.
+func ParentCode(code string) string {
+	switch LevelOf(code) {
+	case LevelCity:
+		return code[:ProvinceCodeLen]
+	case LevelDistrict:
+		return code[:CityCodeLen]
+	case LevelSubdistrict:
+		return code[:DistrictCodeLen]
+	default:
+		return ""
+	}
+}
+
+// NormalizeCode trims the spaces around a BPS code.
+//
+// Only BPS codes are supported. Kemendagri codes, such as 11.01.01.2001,
+// number districts and villages differently, so they cannot be converted by
+// stripping their dots and are rejected by Validate.
+func NormalizeCode(code string) string {
+	return strings.TrimSpace(code)
+}
+
+// ReadFile reads region records from a CSV or JSON file, chosen by extension.
+func ReadFile(path string) ([]Record, error) {
+	// #nosec G304 -- path is provided by the operator running the command
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ReadCSV(f)
+	case ".json":
+		return ReadJSON(f)
+	default:
+		return nil, fmt.Errorf(
+			"unsupported file extension %q, must be .csv or .json",
+			filepath.Ext(path),
+		)
+	}
+}
+
+// ReadCSV reads a flat CSV with a header row containing at least the
+// bps_code and name columns, and optionally postal_code. The level of each
+// row is derived from the length of its code.
+func ReadCSV(r io.Reader) ([]Record, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+
+	codeIdx, ok := columns["bps_code"]
+	if !ok {
+		return nil, errors.New("csv header must contain bps_code column")
+	}
+
+	nameIdx, ok := columns["name"]
+	if !ok {
+		return nil, errors.New("csv header must contain name column")
+	}
+
+	postalIdx, hasPostal := columns["postal_code"]
+
+	records := make([]Record, 0)
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("read csv line %d: %w", line, err)
+		}
+
+		rec := Record{
+			Code: NormalizeCode(column(row, codeIdx)),
+			Name: strings.TrimSpace(column(row, nameIdx)),
+		}
+
+		if hasPostal {
+			rec.PostalCode = strings.TrimSpace(column(row, postalIdx))
+		}
+
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+// ReadJSON reads a nested hierarchy of provinces, cities, districts and
+// subdistricts. The children of every node must share its code as prefix.
+func ReadJSON(r io.Reader) ([]Record, error) {
+	var roots []node
+	if err := json.NewDecoder(r).Decode(&roots); err != nil {
+		return nil, fmt.Errorf("decode json: %w", err)
+	}
+
+	records := make([]Record, 0)
+	var walk func(parent string, nodes []node) error
+	walk = func(parent string, nodes []node) error {
+		for _, n := range nodes {
+			code := NormalizeCode(n.Code)
+			if isKemendagri(code) {
+				return kemendagriError(code)
+			}
+
+			if ParentCode(code) != parent {
+				return fmt.Errorf(
+					"region %q is not a direct child of %q",
+					n.Code,
+					parent,
+				)
+			}
+
+			records = append(records, Record{
+				Code:       code,
+				Name:       strings.TrimSpace(n.Name),
+				PostalCode: strings.TrimSpace(n.PostalCode),
+			})
+
+			if err := walk(code, n.Children); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := walk("", roots); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// Validate checks code format, duplicates and that every parent code is
+// present either in the records or in known (codes already stored).
+func Validate(records []Record, known map[string]bool) error {
+	seen := make(map[string]bool, len(records))
+	for _, rec := range records {
+		seen[rec.Code] = true
+	}
+
+	errs := make([]error, 0)
+	dup := make(map[string]bool, len(records))
+	for _, rec := range records {
+		if err := validateRecord(rec, seen, known); err != nil {
+			errs = append(errs, err)
+		}
+
+		if dup[rec.Code] {
+			errs = append(errs, fmt.Errorf("duplicate code %q", rec.Code))
+		}
+
+		dup[rec.Code] = true
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateRecord(rec Record, seen, known map[string]bool) error {
+	if isKemendagri(rec.Code) {
+		return kemendagriError(rec.Code)
+	}
+
+	if !isDigits(rec.Code) {
+		return fmt.Errorf("code %q must only contain digits", rec.Code)
+	}
+
+	level := LevelOf(rec.Code)
+	if level == LevelUnknown {
+		return fmt.Errorf(
+			"code %q must be 2, 4, 7 or 10 digits long",
+			rec.Code,
+		)
+	}
+
+	if rec.Name == "" {
+		return fmt.Errorf("%s %q has an empty name", level, rec.Code)
+	}
+
+	if level == LevelSubdistrict &&
+		(len(rec.PostalCode) != postalCodeLen || !isDigits(rec.PostalCode)) {
+		return fmt.Errorf(
+			"subdistrict %q must have a 5 digit postal code",
+			rec.Code,
+		)
+	}
+
+	parent := ParentCode(rec.Code)
+	if parent != "" && !seen[parent] && !known[parent] {
+		return fmt.Errorf(
+			"%s %q references unknown parent %q",
+			level,
+			rec.Code,
+			parent,
+		)
+	}
+
+	return nil
+}
+
+// isKemendagri reports whether the code uses the dotted Kemendagri format.
+func isKemendagri(code string) bool {
+	return strings.Contains(code, ".")
+}
+
+func kemendagriError(code string) error {
+	return fmt.Errorf(
+		"code %q is a Kemendagri code, only BPS codes are supported",
+		code,
+	)
+}
+
+func column(row []string, idx int) string {
+	if idx < len(row) {
+		return row[idx]
+	}
+
+	return ""
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,100 @@
+package reminder
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sembraniteam/setetes/internal/config"
+)
+
+const (
+	day = time.Hour * 24
+
+	ClockLayout = "15:04"
+
+	defaultDaysBefore = 3
+	defaultTimezone   = "Asia/Jakarta"
+)
+
+// DaysBefore returns how many days before the next eligible date a donor is
+// reminded.
+func DaysBefore() int {
+	if n := config.Get().Reminder.DaysBefore; n > 0 {
+		return n
+	}
+
+	return defaultDaysBefore
+}
+
+// Lead returns how long before the next eligible date a donor is reminded.
+func Lead(daysBefore int) time.Duration {
+	return time.Duration(daysBefore) * day
+}
+
+// Due reports whether the reminder for a donor eligible again at eligibleAt
+// should be sent at now.
+func Due(eligibleAt, now time.Time, daysBefore int) bool {
+	return !now.Before(eligibleAt.Add(-Lead(daysBefore)))
+}
+
+// Location loads the IANA time zone name, falling back to the configured
+// default zone when name is empty or unknown.
+func Location(name string) *time.Location {
+	if name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+
+	name = config.Get().Reminder.Timezone
+	if name == "" {
+		name = defaultTimezone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// Quiet reports whether now falls within the quiet hours from start until
+// end, both HH:MM read in loc. A window ending before it starts runs past
+// midnight, and an empty window is never quiet.
+func Quiet(now time.Time, loc *time.Location, start, end string) bool {
+	if start == "" || end == "" {
+		return false
+	}
+
+	from, err := clock(start)
+	if err != nil {
+		return false
+	}
+
+	until, err := clock(end)
+	if err != nil {
+		return false
+	}
+
+	local := now.In(loc)
+	at := time.Duration(local.Hour())*time.Hour +
+		time.Duration(local.Minute())*time.Minute
+
+	if from <= until {
+		return at >= from && at < until
+	}
+
+	return at >= from || at < until
+}
+
+// clock returns the time elapsed since midnight at s, formatted as HH:MM.
+func clock(s string) (time.Duration, error) {
+	t, err := time.Parse(ClockLayout, s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not formatted as HH:MM", s)
+	}
+
+	return time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute, nil
+}
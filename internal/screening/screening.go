@@ -0,0 +1,120 @@
+package screening
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+)
+
+const (
+	AnswerYes  = "YES"
+	AnswerNo   = "NO"
+	dateLayout = time.DateOnly
+)
+
+// Trigger is a deferral caused by an answer of a screening question.
+type Trigger struct {
+	Question *ent.ScreeningQuestion
+	Answer   string
+}
+
+// Normalize trims the answers and upper cases YES_NO answers so they can be
+// compared with AnswerYes and AnswerNo.
+func Normalize(
+	questions []*ent.ScreeningQuestion,
+	answers map[string]string,
+) map[string]string {
+	types := make(map[string]screeningquestion.AnswerType, len(questions))
+	for _, q := range questions {
+		types[q.Key] = q.AnswerType
+	}
+
+	out := make(map[string]string, len(answers))
+	for key, value := range answers {
+		value = strings.TrimSpace(value)
+		if types[key] == screeningquestion.AnswerTypeYesNo {
+			value = strings.ToUpper(value)
+		}
+
+		out[key] = value
+	}
+
+	return out
+}
+
+// Evaluate validates normalized answers against the questions and returns
+// the deferral triggers they produce.
+func Evaluate(
+	questions []*ent.ScreeningQuestion,
+	answers map[string]string,
+) ([]Trigger, error) {
+	known := make(map[string]bool, len(questions))
+	triggers := make([]Trigger, 0)
+
+	for _, q := range questions {
+		known[q.Key] = true
+
+		answer, ok := answers[q.Key]
+		if !ok || answer == "" {
+			if q.Required {
+				return nil, fmt.Errorf("question %q must be answered", q.Key)
+			}
+
+			continue
+		}
+
+		if err := validate(q, answer); err != nil {
+			return nil, err
+		}
+
+		if slices.ContainsFunc(q.DeferralAnswers, func(s string) bool {
+			return strings.EqualFold(s, answer)
+		}) {
+			triggers = append(triggers, Trigger{Question: q, Answer: answer})
+		}
+	}
+
+	for key := range answers {
+		if !known[key] {
+			return nil, fmt.Errorf("question %q does not exist", key)
+		}
+	}
+
+	return triggers, nil
+}
+
+func validate(q *ent.ScreeningQuestion, answer string) error {
+	switch q.AnswerType {
+	case screeningquestion.AnswerTypeYesNo:
+		if answer != AnswerYes && answer != AnswerNo {
+			return fmt.Errorf("question %q must be answered YES or NO", q.Key)
+		}
+	case screeningquestion.AnswerTypeChoice:
+		if !slices.Contains(q.Options, answer) {
+			return fmt.Errorf(
+				"question %q must be one of %s",
+				q.Key,
+				strings.Join(q.Options, ", "),
+			)
+		}
+	case screeningquestion.AnswerTypeNumber:
+		if _, err := strconv.ParseFloat(answer, 64); err != nil {
+			return fmt.Errorf("question %q must be a number", q.Key)
+		}
+	case screeningquestion.AnswerTypeDate:
+		if _, err := time.Parse(dateLayout, answer); err != nil {
+			return fmt.Errorf(
+				"question %q must be a date formatted as YYYY-MM-DD",
+				q.Key,
+			)
+		}
+	case screeningquestion.AnswerTypeText:
+	}
+
+	return nil
+}
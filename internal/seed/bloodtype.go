@@ -0,0 +1,34 @@
+package seed
+
+import (
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+)
+
+func (s *seedBuilder) BloodType() {
+	groups := []bloodtype.Group{
+		bloodtype.GroupBloodA,
+		bloodtype.GroupBloodB,
+		bloodtype.GroupBloodAB,
+		bloodtype.GroupBloodO,
+	}
+
+	rhesus := []bloodtype.Rhesus{
+		bloodtype.RhesusPositive,
+		bloodtype.RhesusNegative,
+	}
+
+	builders := make([]*ent.BloodTypeCreate, 0, len(groups)*len(rhesus))
+	for _, g := range groups {
+		for _, r := range rhesus {
+			builders = append(builders, s.client.BloodType.Create().
+				SetGroup(g).
+				SetRhesus(r))
+		}
+	}
+
+	if err := s.client.BloodType.CreateBulk(builders...).
+		Exec(s.ctx); err != nil {
+		panic(err)
+	}
+}
@@ -1,50 +1,192 @@
 package seed
 
+import (
+	"slices"
+
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/permission"
+	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/httpx/web"
+	"github.com/sembraniteam/setetes/internal/rbac"
+)
+
+// roleSeed is a seeded role. The permissions it is granted are declared on
+// the routes they guard, see web.Registry.
+type roleSeed struct {
+	name        string
+	key         string
+	domain      string
+	description string
+}
+
+var roles = []roleSeed{
+	{
+		name:        "Donor",
+		key:         "donor",
+		domain:      "region:*",
+		description: "General blood donor role with limited access to donation features.",
+	},
+	{
+		name:        "PMI Staff",
+		key:         "pmi-staff",
+		domain:      "region:*",
+		description: "PMI staff role that records donations at a PMI location.",
+	},
+	{
+		name:        "Hospital Staff",
+		key:         "hospital-staff",
+		domain:      "region:*",
+		description: "Hospital staff role that requests blood from PMI locations for patients.",
+	},
+	{
+		name:        "Event Organizer",
+		key:         "organizer",
+		domain:      "region:*",
+		description: "Organizer role for offices, campuses and communities hosting blood drive events.",
+	},
+	{
+		name:        "Support",
+		key:         "support",
+		domain:      "*",
+		description: "Support staff role that acts as accounts to reproduce the issues they report.",
+	},
+	{
+		name:        "Administrator",
+		key:         "admin",
+		domain:      "*",
+		description: "Administrator role that manages roles, permissions and their assignments.",
+	},
+}
+
+// Role seeds the roles with the permissions the registry grants them. Every
+// permission is created once, before the roles, and attached to each role
+// it is declared for, such as impersonate-account for both admin and
+// support. Permissions and roles seeded before are reused, so seeding again
+// only adds what the registry gained since.
 func (s *seedBuilder) Role() {
 	tx, err := s.client.Tx(s.ctx)
 	if err != nil {
 		panic(err)
 	}
 
-	role, err := tx.Role.Create().
-		SetName("Donor").
-		SetKey("donor").
-		SetActivated(true).
-		SetDomain("region:*").
-		SetDescription("General blood donor role with limited access to donation features.").
-		Save(s.ctx)
-	if err != nil {
-		if rerr := tx.Rollback(); rerr != nil {
-			panic(rerr)
-		}
+	permissions := s.permissions(tx)
+	for _, r := range roles {
+		s.role(tx, r, permissions)
+	}
 
+	if err = s.rbac.Commit(tx); err != nil {
 		panic(err)
 	}
+}
 
-	permission, err := tx.Permission.Create().
-		SetName("Get self profile").
-		SetKey("get-self-profile").
-		SetDomain("*").
-		SetDescription("Allow donor to view their own profile details.").
-		SetResource("/account/v1/self").SetAction("GET").Save(s.ctx)
+// permissions creates the permissions of the registry that do not exist yet
+// and returns every one of them by key.
+func (s *seedBuilder) permissions(tx *ent.Tx) map[string]*ent.Permission {
+	existing, err := tx.Permission.Query().
+		Where(permission.DomainEQ(rbac.AnyDomain)).
+		All(s.ctx)
 	if err != nil {
-		if rerr := tx.Rollback(); rerr != nil {
-			panic(rerr)
+		s.rollback(tx, err)
+	}
+
+	out := make(map[string]*ent.Permission, len(existing))
+	for _, p := range existing {
+		out[p.Key] = p
+	}
+
+	for _, rt := range web.Registry().Routes() {
+		p := rt.Permission
+		if p == nil || out[p.Key] != nil {
+			continue
 		}
 
-		panic(err)
+		create := tx.Permission.Create().
+			SetName(p.Name).
+			SetKey(p.Key).
+			SetDomain(rbac.AnyDomain).
+			SetDescription(p.Description).
+			SetResource(rt.Path).SetAction(rt.Method)
+		if p.Condition != "" {
+			create.SetCondition(p.Condition)
+		}
+
+		out[p.Key], err = create.Save(s.ctx)
+		if err != nil {
+			s.rollback(tx, err)
+		}
 	}
 
-	if err = tx.Commit(); err != nil {
-		panic(err)
+	return out
+}
+
+// role creates the role, or attaches the permissions it lacks when it
+// exists, and adds a policy for each of its permissions.
+func (s *seedBuilder) role(
+	tx *ent.Tx,
+	r roleSeed,
+	permissions map[string]*ent.Permission,
+) {
+	routes := web.Registry().Permissions(r.key)
+	granted := make([]*ent.Permission, 0, len(routes))
+	for _, rt := range routes {
+		granted = append(granted, permissions[rt.Permission.Key])
 	}
 
-	if err = s.rbac.AddPolicy(
-		role.Key,
-		permission.Domain,
-		permission.Resource,
-		permission.Action,
-	); err != nil {
-		panic(err)
+	rl, err := tx.Role.Query().
+		Where(role.DomainEQ(r.domain), role.KeyEQ(r.key)).
+		WithPermissions().
+		Only(s.ctx)
+	switch {
+	case ent.IsNotFound(err):
+		rl, err = tx.Role.Create().
+			SetName(r.name).
+			SetKey(r.key).
+			SetActivated(true).
+			SetDomain(r.domain).
+			SetDescription(r.description).
+			AddPermissions(granted...).
+			Save(s.ctx)
+	case err == nil:
+		err = tx.Role.UpdateOne(rl).
+			AddPermissions(missing(granted, rl.Edges.Permissions)...).
+			Exec(s.ctx)
+	}
+
+	if err != nil {
+		s.rollback(tx, err)
+	}
+
+	policies := s.rbac.Policies(s.ctx, tx)
+	for _, p := range granted {
+		if err = policies.AddPolicy(
+			rl.Key,
+			p.Domain,
+			p.Resource,
+			p.Action,
+		); err != nil {
+			s.rollback(tx, err)
+		}
 	}
 }
+
+// missing returns the permissions of want that are not in have.
+func missing(want, have []*ent.Permission) []*ent.Permission {
+	out := make([]*ent.Permission, 0, len(want))
+	for _, p := range want {
+		if !slices.ContainsFunc(have, func(h *ent.Permission) bool {
+			return h.ID == p.ID
+		}) {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
+
+func (s *seedBuilder) rollback(tx *ent.Tx, err error) {
+	if rerr := tx.Rollback(); rerr != nil {
+		panic(rerr)
+	}
+
+	panic(err)
+}
@@ -0,0 +1,124 @@
+package seed
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/sembraniteam/setetes/internal/database/sqlitex"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/casbinrule"
+	"github.com/sembraniteam/setetes/internal/ent/permission"
+	"github.com/sembraniteam/setetes/internal/httpx/web"
+	"github.com/sembraniteam/setetes/internal/rbac"
+)
+
+func TestRoleSeedsSharedPermissionsOnce(t *testing.T) {
+	ctx := context.Background()
+	client := sqlitex.Open(t)
+	manager, err := rbac.New(client)
+	if err != nil {
+		t.Fatalf("new rbac manager: %v", err)
+	}
+
+	s := &seedBuilder{client: client, rbac: manager, ctx: ctx}
+
+	// The second run finds everything seeded and must neither fail nor
+	// duplicate anything.
+	for run := 1; run <= 2; run++ {
+		s.Role()
+
+		permissions, err := client.Permission.Query().
+			WithRoles().
+			All(ctx)
+		if err != nil {
+			t.Fatalf("run %d: query permissions: %v", run, err)
+		}
+
+		declared := declaredPermissions()
+		if len(permissions) != len(declared) {
+			t.Errorf(
+				"run %d: seeded %d permissions, want %d",
+				run, len(permissions), len(declared),
+			)
+		}
+
+		for _, p := range permissions {
+			got := roleKeys(p.Edges.Roles)
+			if want := declared[p.Key]; !slices.Equal(got, want) {
+				t.Errorf(
+					"run %d: permission %s granted to %v, want %v",
+					run, p.Key, got, want,
+				)
+			}
+		}
+
+		policies, err := client.CasbinRule.Query().
+			Where(casbinrule.PtypeEQ("p")).
+			Count(ctx)
+		if err != nil {
+			t.Fatalf("run %d: count policies: %v", run, err)
+		}
+
+		if want := grants(); policies != want {
+			t.Errorf("run %d: stored %d policies, want %d", run, policies, want)
+		}
+	}
+
+	impersonate, err := client.Permission.Query().
+		Where(permission.KeyEQ("impersonate-account")).
+		WithRoles().
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("query impersonate-account: %v", err)
+	}
+
+	got := roleKeys(impersonate.Edges.Roles)
+	if !slices.Equal(got, []string{"admin", "support"}) {
+		t.Errorf("impersonate-account granted to %v", got)
+	}
+}
+
+// declaredPermissions returns the seeded roles each permission of the
+// registry is declared for, sorted.
+func declaredPermissions() map[string][]string {
+	out := make(map[string][]string)
+	for _, rt := range web.Registry().Routes() {
+		if rt.Permission == nil {
+			continue
+		}
+
+		keys := make([]string, 0)
+		for _, r := range roles {
+			if slices.Contains(rt.Permission.Roles, r.key) {
+				keys = append(keys, r.key)
+			}
+		}
+
+		slices.Sort(keys)
+		out[rt.Permission.Key] = keys
+	}
+
+	return out
+}
+
+// grants counts the policies of the seeded roles.
+func grants() int {
+	n := 0
+	for _, r := range roles {
+		n += len(web.Registry().Permissions(r.key))
+	}
+
+	return n
+}
+
+func roleKeys(roles []*ent.Role) []string {
+	out := make([]string, 0, len(roles))
+	for _, r := range roles {
+		out = append(out, r.Key)
+	}
+
+	slices.Sort(out)
+
+	return out
+}
@@ -29,5 +29,6 @@ func NewSeeder(i do.Injector) (Seeder, error) {
 }
 
 func (s *seedBuilder) RunAll() {
+	s.BloodType()
 	s.Role()
 }
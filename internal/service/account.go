@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/audit"
 	"github.com/sembraniteam/setetes/internal/config"
 	"github.com/sembraniteam/setetes/internal/cryptox"
 	"github.com/sembraniteam/setetes/internal/cryptox/argon2x"
@@ -16,21 +17,27 @@ import (
 	"github.com/sembraniteam/setetes/internal/ent/account"
 	"github.com/sembraniteam/setetes/internal/ent/otp"
 	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/roleassignment"
 	"github.com/sembraniteam/setetes/internal/httpx/request"
 	"github.com/sembraniteam/setetes/internal/rbac"
 )
 
 const (
-	exp     = time.Minute * 30
-	skew    = time.Second * -30
-	charLen = 6
+	exp                     = time.Minute * 30
+	skew                    = time.Second * -30
+	charLen                 = 6
+	defaultImpersonationTTL = time.Minute * 15
 )
 
+// privilegedRoles are the roles whose holders cannot be impersonated.
+var privilegedRoles = []string{"admin", "support"}
+
 type (
 	AccountQuery struct {
-		client *ent.Client
-		rbac   *rbac.Manager
-		ctx    context.Context
+		scope
+		client  *ent.Client
+		rbac    *rbac.Manager
+		keypair *cryptox.Keypair
 	}
 
 	Account interface {
@@ -39,17 +46,29 @@ type (
 		Activate(body request.Activation) error
 		ResendOTP(body request.ResendOTP) error
 		Self(id uuid.UUID) (*ent.Account, error)
+		SetHomeLocation(id uuid.UUID, body request.HomeLocation) error
+		Impersonate(
+			actorID uuid.UUID,
+			platform string,
+			body request.Impersonation,
+		) (*pasetox.TokenPair, error)
+		Scoped[Account]
 	}
 )
 
 func NewAccount(i do.Injector) (Account, error) {
 	return &AccountQuery{
-		client: do.MustInvoke[*ent.Client](i),
-		rbac:   do.MustInvoke[*rbac.Manager](i),
-		ctx:    context.Background(),
+		client:  do.MustInvoke[*ent.Client](i),
+		rbac:    do.MustInvoke[*rbac.Manager](i),
+		keypair: do.MustInvoke[*cryptox.Keypair](i),
+		scope:   newScope(),
 	}, nil
 }
 
+func (a *AccountQuery) WithContext(ctx context.Context) Account {
+	return withContext(a, ctx)
+}
+
 func (a *AccountQuery) Authorize(
 	body request.Authorize,
 ) (*pasetox.TokenPair, error) {
@@ -62,7 +81,7 @@ func (a *AccountQuery) Authorize(
 		WithPassword().
 		Only(a.ctx)
 	if err != nil {
-		return nil, err
+		return nil, a.loginFailed(body.Email, err)
 	}
 
 	ac := argon2x.Default()
@@ -71,18 +90,75 @@ func (a *AccountQuery) Authorize(
 		acc.Edges.Password.Hash,
 	)
 	if err != nil {
+		if rerr := audit.Record(a.ctx, a.client, audit.Entry{
+			Action:   audit.ActionLoginFailed,
+			Entity:   ent.TypeAccount,
+			EntityID: acc.ID.String(),
+			ActorID:  &acc.ID,
+		}); rerr != nil {
+			err = errors.Join(err, rerr)
+		}
+
 		return nil, err
 	}
 
-	tokenPair, err := generateToken(acc.ID, body.Platform)
+	jti := uuid.NewString()
+	tokenPair, err := generateToken(acc.ID, jti, body.Platform)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = audit.Record(a.ctx, a.client, audit.Entry{
+		Action:    audit.ActionLogin,
+		Entity:    ent.TypeAccount,
+		EntityID:  acc.ID.String(),
+		ActorID:   &acc.ID,
+		SessionID: jti,
+	}); err != nil {
+		return nil, err
+	}
+
 	return tokenPair, nil
 }
 
+// loginFailed records a login with an unknown email, or with the email of a
+// locked account as a lockout, and returns err.
+func (a *AccountQuery) loginFailed(email string, err error) error {
+	if !ent.IsNotFound(err) {
+		return err
+	}
+
+	e := audit.Entry{
+		Action: audit.ActionLoginFailed,
+		Entity: ent.TypeAccount,
+		After:  map[string]any{"email": email},
+	}
+
+	locked, qerr := a.client.Account.Query().
+		Where(account.EmailEQ(email), account.LockedEQ(true)).
+		Only(a.ctx)
+	switch {
+	case qerr == nil:
+		e.Action = audit.ActionLoginLocked
+		e.EntityID = locked.ID.String()
+		e.ActorID = &locked.ID
+	case !ent.IsNotFound(qerr):
+		return errors.Join(err, qerr)
+	}
+
+	if rerr := audit.Record(a.ctx, a.client, e); rerr != nil {
+		return errors.Join(err, rerr)
+	}
+
+	return err
+}
+
 func (a *AccountQuery) Register(body request.Account) error {
+	birthDate, err := body.GetBirthDate()
+	if err != nil {
+		return err
+	}
+
 	tx, err := a.client.Tx(a.ctx)
 	if err != nil {
 		return err
@@ -93,6 +169,7 @@ func (a *AccountQuery) Register(body request.Account) error {
 		SetNationalIDMasked(cryptox.MaskNumber(body.NationalID)).
 		SetFullName(body.FullName).
 		SetGender(body.GetGender()).
+		SetBirthDate(birthDate).
 		SetEmail(body.Email).
 		SetCountryIsoCode(body.CountryISOCode).
 		SetDialCode(body.DialCode).
@@ -191,15 +268,19 @@ func (a *AccountQuery) Activate(body request.Activation) error {
 		return rollback(tx, err)
 	}
 
-	if err = a.rbac.AddRoleForUser(
-		acc.ID.String(),
-		rl.Key,
+	if err = assignRole(
+		a.ctx,
+		tx,
+		a.rbac,
+		acc.ID,
+		rl,
 		rl.Domain,
+		nil,
 	); err != nil {
 		return rollback(tx, err)
 	}
 
-	return tx.Commit()
+	return a.rbac.Commit(tx)
 }
 
 func (a *AccountQuery) ResendOTP(body request.ResendOTP) error {
@@ -249,6 +330,164 @@ func (a *AccountQuery) Self(id uuid.UUID) (*ent.Account, error) {
 	return a.client.Account.Get(a.ctx, id)
 }
 
+func (a *AccountQuery) SetHomeLocation(
+	id uuid.UUID,
+	body request.HomeLocation,
+) error {
+	return a.client.Account.UpdateOneID(id).
+		SetHomeLatLng(body.GetGeoPoint()).
+		Exec(a.ctx)
+}
+
+// Impersonate issues a short-lived access token authorized as the account
+// whose claims carry the actor, so support staff can reproduce an issue the
+// account reports. Holders of a privileged role cannot be impersonated, and
+// the token is not refreshable.
+func (a *AccountQuery) Impersonate(
+	actorID uuid.UUID,
+	platform string,
+	body request.Impersonation,
+) (*pasetox.TokenPair, error) {
+	if body.AccountID == actorID {
+		return nil, errors.New("cannot impersonate yourself")
+	}
+
+	exists, err := a.client.Account.Query().
+		Where(
+			account.IDEQ(body.AccountID),
+			account.ActivatedEQ(true),
+			account.LockedEQ(false),
+			account.DeletedAtIsNil(),
+		).
+		Exist(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.New("account not found")
+	}
+
+	privileged, err := a.client.RoleAssignment.Query().
+		Where(
+			roleassignment.HasAccountWith(account.IDEQ(body.AccountID)),
+			roleassignment.HasRoleWith(role.KeyIn(privilegedRoles...)),
+		).
+		Exist(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if privileged {
+		return nil, errors.New("account holds a privileged role")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(impersonationTTL())
+	jti := uuid.NewString()
+	token, err := pasetox.New(a.keypair, pasetox.Claims{
+		Platform:        platform,
+		Subject:         body.AccountID.String(),
+		Actor:           actorID.String(),
+		TokenIdentifier: jti,
+		Expiration:      expiresAt,
+		IssuedAt:        now.Add(skew),
+		NotBefore:       now.Add(skew),
+	}).Signed()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = audit.Record(a.ctx, a.client, audit.Entry{
+		Action:   audit.ActionImpersonationStart,
+		Entity:   ent.TypeAccount,
+		EntityID: body.AccountID.String(),
+		After: map[string]any{
+			"reason":     body.Reason,
+			"session_id": jti,
+			"expires_at": expiresAt.UnixMilli(),
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return &pasetox.TokenPair{
+		AccessToken: token,
+		ExpiresIn:   expiresAt.UnixMilli(),
+	}, nil
+}
+
+// grantRole adds a role to the roles the account already has, within the
+// domain of the role.
+func grantRole(
+	ctx context.Context,
+	tx *ent.Tx,
+	rm *rbac.Manager,
+	accountID uuid.UUID,
+	key string,
+) error {
+	rl, err := tx.Role.Query().
+		Where(role.KeyEQ(key), role.ActivatedEQ(true)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("role not found")
+		}
+
+		return err
+	}
+
+	return assignRole(ctx, tx, rm, accountID, rl, rl.Domain, nil)
+}
+
+// assignRole stores the assignment of the role to the account together with
+// its grouping policy. Assigning a role twice in the same domain is a no-op.
+// The policy is visible to the enforcer once the transaction is committed
+// with rbac.Manager.Commit.
+func assignRole(
+	ctx context.Context,
+	tx *ent.Tx,
+	rm *rbac.Manager,
+	accountID uuid.UUID,
+	rl *ent.Role,
+	domain string,
+	assignedBy *uuid.UUID,
+) error {
+	exists, err := tx.RoleAssignment.Query().
+		Where(
+			roleassignment.DomainEQ(domain),
+			roleassignment.HasAccountWith(account.IDEQ(accountID)),
+			roleassignment.HasRoleWith(role.IDEQ(rl.ID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		if err = tx.RoleAssignment.Create().
+			SetDomain(domain).
+			SetAccountID(accountID).
+			SetRole(rl).
+			SetNillableAssignedByID(assignedBy).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if err = audit.Record(ctx, tx.Client(), audit.Entry{
+			Action:   audit.ActionRoleAssign,
+			Entity:   ent.TypeAccount,
+			EntityID: accountID.String(),
+			After:    map[string]any{"role": rl.Key, "domain": domain},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return rm.Policies(ctx, tx).
+		AddRoleForUser(accountID.String(), rl.Key, domain)
+}
+
 func genOTP() (string, error) {
 	return cryptox.RandChars(charLen)
 }
@@ -263,7 +502,7 @@ func rollback(tx *ent.Tx, err error) error {
 
 func generateToken(
 	subject uuid.UUID,
-	platform string,
+	jti, platform string,
 ) (*pasetox.TokenPair, error) {
 	ed := config.Get().ED25519
 	privateKey, err := cryptox.LoadPrivateKey(ed.PrivateKeyPath)
@@ -284,7 +523,7 @@ func generateToken(
 	token := pasetox.New(kp, pasetox.Claims{
 		Platform:        platform,
 		Subject:         subject.String(),
-		TokenIdentifier: uuid.NewString(),
+		TokenIdentifier: jti,
 		Expiration:      now.Add(exp),
 		IssuedAt:        now.Add(skew),
 		NotBefore:       now.Add(skew),
@@ -301,3 +540,11 @@ func generateToken(
 		ExpiresIn:    now.Add(exp).UnixMilli(),
 	}, nil
 }
+
+func impersonationTTL() time.Duration {
+	if d := config.Get().Impersonation.TokenTTL; d > 0 {
+		return d
+	}
+
+	return defaultImpersonationTTL
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/config"
+	"github.com/sembraniteam/setetes/internal/credential"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/apikey"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/httpx/route"
+)
+
+const (
+	defaultAPIKeyTTL           = time.Hour * 24 * 365
+	defaultAPIKeyRotationGrace = time.Hour * 24
+)
+
+type (
+	APIKeyQuery struct {
+		scope
+		client   *ent.Client
+		registry route.Registry
+	}
+
+	APIKey interface {
+		Keys(
+			accountID uuid.UUID,
+			page response.Pagination,
+		) ([]*ent.APIKey, int, error)
+		Create(accountID uuid.UUID, body request.APIKey) (*IssuedAPIKey, error)
+		Rotate(accountID, id uuid.UUID) (*IssuedAPIKey, error)
+		Revoke(accountID, id uuid.UUID) error
+		Scoped[APIKey]
+	}
+
+	// IssuedAPIKey is a created or rotated key with its token, which is
+	// shown once and cannot be retrieved again.
+	IssuedAPIKey struct {
+		Key   *ent.APIKey
+		Token string
+	}
+)
+
+func NewAPIKey(i do.Injector) (APIKey, error) {
+	return &APIKeyQuery{
+		client:   do.MustInvoke[*ent.Client](i),
+		registry: do.MustInvoke[route.Registry](i),
+		scope:    newScope(),
+	}, nil
+}
+
+func (a *APIKeyQuery) WithContext(ctx context.Context) APIKey {
+	return withContext(a, ctx)
+}
+
+// Keys returns the keys of the account, revoked and expired ones included.
+func (a *APIKeyQuery) Keys(
+	accountID uuid.UUID,
+	page response.Pagination,
+) ([]*ent.APIKey, int, error) {
+	q := a.client.APIKey.Query().
+		Where(
+			apikey.HasAccountWith(account.IDEQ(accountID)),
+			apikey.DeletedAtIsNil(),
+		)
+	if search := page.GetSearch(); search != "" {
+		q.Where(apikey.NameContainsFold(search))
+	}
+
+	total, err := q.Clone().Count(a.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := ent.Desc(apikey.FieldCreatedAt)
+	if page.GetSort() == "ASC" {
+		order = ent.Asc(apikey.FieldCreatedAt)
+	}
+
+	keys, err := q.
+		WithHospital().
+		Order(order).
+		Offset(page.GetOffset()).
+		Limit(page.GetLimit()).
+		All(a.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return keys, total, nil
+}
+
+// Create issues a key acting as the account for the hospital it belongs to.
+// The scopes must be permissions of routes a key may call, and the key
+// expires at the latest after the configured lifetime.
+func (a *APIKeyQuery) Create(
+	accountID uuid.UUID,
+	body request.APIKey,
+) (*IssuedAPIKey, error) {
+	if err := a.validateScopes(body.Scopes); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	maxExpiresAt := now.Add(apiKeyTTL()).UnixMilli()
+	expiresAt := body.ExpiresAt
+	switch {
+	case expiresAt == 0:
+		expiresAt = maxExpiresAt
+	case expiresAt <= now.UnixMilli():
+		return nil, errors.New("expires_at must be in the future")
+	case expiresAt > maxExpiresAt:
+		return nil, errors.New(
+			"expires_at exceeds the longest lifetime of a key",
+		)
+	}
+
+	h, err := a.client.Hospital.Query().
+		Where(
+			hospital.HasMembersWith(account.IDEQ(accountID)),
+			hospital.ActivatedEQ(true),
+			hospital.DeletedAtIsNil(),
+		).
+		Only(a.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("account does not belong to a hospital")
+		}
+
+		return nil, err
+	}
+
+	return a.issue(
+		a.client.APIKey.Create().
+			SetName(body.Name).
+			SetScopes(body.Scopes).
+			SetExpiresAt(expiresAt).
+			SetAccountID(accountID).
+			SetHospital(h),
+	)
+}
+
+// Rotate issues a new key with the name, scopes and hospital of the key and
+// shortens the lifetime of the old key to the rotation grace period, so the
+// integration can switch keys without downtime.
+func (a *APIKeyQuery) Rotate(
+	accountID, id uuid.UUID,
+) (*IssuedAPIKey, error) {
+	tx, err := a.client.Tx(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	old, err := tx.APIKey.Query().
+		Where(
+			apikey.IDEQ(id),
+			apikey.HasAccountWith(account.IDEQ(accountID)),
+			apikey.RevokedAtIsNil(),
+			apikey.ExpiresAtGT(time.Now().UnixMilli()),
+			apikey.DeletedAtIsNil(),
+		).
+		WithHospital().
+		Only(a.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, errors.New("api key not found"))
+		}
+
+		return nil, rollback(tx, err)
+	}
+
+	now := time.Now()
+	graceEnd := now.Add(apiKeyRotationGrace()).UnixMilli()
+	if old.ExpiresAt > graceEnd {
+		if err = tx.APIKey.UpdateOne(old).
+			SetExpiresAt(graceEnd).
+			Exec(a.ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	issued, err := a.issue(
+		tx.APIKey.Create().
+			SetName(old.Name).
+			SetScopes(old.Scopes).
+			SetExpiresAt(now.Add(apiKeyTTL()).UnixMilli()).
+			SetAccountID(accountID).
+			SetHospital(old.Edges.Hospital),
+	)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return issued, nil
+}
+
+// Revoke stops the key from being accepted immediately.
+func (a *APIKeyQuery) Revoke(accountID, id uuid.UUID) error {
+	n, err := a.client.APIKey.Update().
+		Where(
+			apikey.IDEQ(id),
+			apikey.HasAccountWith(account.IDEQ(accountID)),
+			apikey.RevokedAtIsNil(),
+			apikey.DeletedAtIsNil(),
+		).
+		SetRevokedAt(time.Now().UnixMilli()).
+		Save(a.ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return errors.New("api key not found")
+	}
+
+	return nil
+}
+
+// issue generates the key of the builder and saves it.
+func (a *APIKeyQuery) issue(create *ent.APIKeyCreate) (*IssuedAPIKey, error) {
+	generated, err := credential.NewAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := create.
+		SetPrefix(generated.Prefix).
+		SetSecretHash(generated.SecretHash).
+		Save(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssuedAPIKey{Key: key, Token: generated.Token}, nil
+}
+
+// validateScopes accepts the permission keys of protected routes that are
+// not sensitive, so a key never manages keys, deletes data or impersonates.
+func (a *APIKeyQuery) validateScopes(scopes []string) error {
+	allowed := make([]string, 0)
+	for _, rt := range a.registry.Routes() {
+		if rt.Permission != nil && !rt.IsSensitive() {
+			allowed = append(allowed, rt.Permission.Key)
+		}
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(allowed, scope) {
+			return fmt.Errorf(
+				"scope %q is not a permission an api key may use",
+				scope,
+			)
+		}
+	}
+
+	return nil
+}
+
+func apiKeyTTL() time.Duration {
+	if d := config.Get().APIKey.TTL; d > 0 {
+		return d
+	}
+
+	return defaultAPIKeyTTL
+}
+
+func apiKeyRotationGrace() time.Duration {
+	if d := config.Get().APIKey.RotationGrace; d > 0 {
+		return d
+	}
+
+	return defaultAPIKeyRotationGrace
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/auditlog"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+)
+
+type (
+	AuditQuery struct {
+		scope
+		client *ent.Client
+	}
+
+	Audit interface {
+		Logs(filter request.AuditFilter) ([]*ent.AuditLog, int, error)
+		Scoped[Audit]
+	}
+)
+
+func NewAudit(i do.Injector) (Audit, error) {
+	return &AuditQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		scope:  newScope(),
+	}, nil
+}
+
+func (a *AuditQuery) WithContext(ctx context.Context) Audit {
+	return withContext(a, ctx)
+}
+
+// Logs returns the audit records matching every given filter, newest first
+// unless sorted ascending.
+func (a *AuditQuery) Logs(
+	filter request.AuditFilter,
+) ([]*ent.AuditLog, int, error) {
+	where := make([]predicate.AuditLog, 0)
+	if filter.ActorID != "" {
+		id, err := uuid.Parse(filter.ActorID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		where = append(where, auditlog.ActorIDEQ(id))
+	}
+
+	if filter.SubjectID != "" {
+		id, err := uuid.Parse(filter.SubjectID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		where = append(where, auditlog.SubjectIDEQ(id))
+	}
+
+	if filter.RequestID != "" {
+		id, err := uuid.Parse(filter.RequestID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		where = append(where, auditlog.RequestIDEQ(id))
+	}
+
+	if filter.SessionID != "" {
+		where = append(where, auditlog.SessionIDEQ(filter.SessionID))
+	}
+
+	if filter.Action != "" {
+		where = append(where, auditlog.ActionEQ(filter.Action))
+	}
+
+	if filter.Entity != "" {
+		where = append(where, auditlog.EntityEQ(filter.Entity))
+	}
+
+	if filter.EntityID != "" {
+		where = append(where, auditlog.EntityIDEQ(filter.EntityID))
+	}
+
+	if filter.From > 0 {
+		where = append(where, auditlog.CreatedAtGTE(filter.From))
+	}
+
+	if filter.To > 0 {
+		where = append(where, auditlog.CreatedAtLTE(filter.To))
+	}
+
+	q := a.client.AuditLog.Query().Where(where...)
+	total, err := q.Clone().Count(a.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := ent.Desc(auditlog.FieldCreatedAt)
+	if filter.GetSort() == "ASC" {
+		order = ent.Asc(auditlog.FieldCreatedAt)
+	}
+
+	logs, err := q.
+		Order(order).
+		Offset(filter.GetOffset()).
+		Limit(filter.GetLimit()).
+		All(a.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
@@ -0,0 +1,549 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/compatibility"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodrequest"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/lifecycle"
+)
+
+var errRequestStatusChanged = errors.New(
+	"blood request status has changed, please try again",
+)
+
+type (
+	BloodRequestQuery struct {
+		scope
+		client *ent.Client
+	}
+
+	BloodRequest interface {
+		Submit(
+			accountID uuid.UUID,
+			body request.BloodRequest,
+		) (*ent.BloodRequest, error)
+		Hospital(
+			accountID uuid.UUID,
+			page response.Pagination,
+		) ([]*ent.BloodRequest, int, error)
+		HospitalRequest(accountID, id uuid.UUID) (*ent.BloodRequest, error)
+		Cancel(
+			accountID uuid.UUID,
+			id uuid.UUID,
+			body request.BloodRequestReason,
+		) (*ent.BloodRequest, error)
+		Location(
+			locationID uuid.UUID,
+			page response.Pagination,
+		) ([]*ent.BloodRequest, int, error)
+		Get(id uuid.UUID) (*ent.BloodRequest, error)
+		Accept(staffID, id uuid.UUID) (*ent.BloodRequest, error)
+		Reject(
+			staffID uuid.UUID,
+			id uuid.UUID,
+			body request.BloodRequestReason,
+		) (*ent.BloodRequest, error)
+		Fulfil(staffID, id uuid.UUID) (*ent.BloodRequest, error)
+		Scoped[BloodRequest]
+	}
+)
+
+func NewBloodRequest(i do.Injector) (BloodRequest, error) {
+	return &BloodRequestQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		scope:  newScope(),
+	}, nil
+}
+
+func (b *BloodRequestQuery) WithContext(ctx context.Context) BloodRequest {
+	return withContext(b, ctx)
+}
+
+func (b *BloodRequestQuery) Submit(
+	accountID uuid.UUID,
+	body request.BloodRequest,
+) (*ent.BloodRequest, error) {
+	h, err := b.hospitalOf(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if body.RequiredBy <= time.Now().UnixMilli() {
+		return nil, errors.New("required_by must be in the future")
+	}
+
+	bt, err := findBloodType(b.ctx, b.client, body.BloodType)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := b.client.PMILocation.Query().
+		Where(
+			pmilocation.IDEQ(body.PMILocationID),
+			pmilocation.DeletedAtIsNil(),
+		).
+		Exist(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.New("PMI location not found")
+	}
+
+	req, err := b.client.BloodRequest.Create().
+		SetHospital(h).
+		SetBloodType(bt).
+		SetPmiLocationID(body.PMILocationID).
+		SetRequestedByID(accountID).
+		SetPatientName(body.PatientName).
+		SetPatientRecordNumber(body.PatientRecordNumber).
+		SetComponent(body.GetComponent()).
+		SetQuantity(body.Quantity).
+		SetUrgency(body.GetUrgency()).
+		SetRequiredBy(body.RequiredBy).
+		SetNote(body.Note).
+		Save(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.find(bloodrequest.IDEQ(req.ID))
+}
+
+func (b *BloodRequestQuery) Hospital(
+	accountID uuid.UUID,
+	page response.Pagination,
+) ([]*ent.BloodRequest, int, error) {
+	h, err := b.hospitalOf(accountID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return b.list(bloodrequest.HasHospitalWith(hospital.IDEQ(h.ID)), page)
+}
+
+func (b *BloodRequestQuery) HospitalRequest(
+	accountID uuid.UUID,
+	id uuid.UUID,
+) (*ent.BloodRequest, error) {
+	h, err := b.hospitalOf(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.find(
+		bloodrequest.IDEQ(id),
+		bloodrequest.HasHospitalWith(hospital.IDEQ(h.ID)),
+	)
+}
+
+// Cancel withdraws a request of the hospital of the account and puts every
+// unit reserved for it back on the shelf.
+func (b *BloodRequestQuery) Cancel(
+	accountID uuid.UUID,
+	id uuid.UUID,
+	body request.BloodRequestReason,
+) (*ent.BloodRequest, error) {
+	req, err := b.HospitalRequest(accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := b.client.Tx(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = setRequestStatus(
+		b.ctx,
+		tx,
+		req,
+		tx.BloodRequest.UpdateOneID(req.ID).
+			SetStatus(bloodrequest.StatusCancelled).
+			SetReason(body.Reason),
+		bloodrequest.StatusCancelled,
+	); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	for _, unit := range req.Edges.Units {
+		if unit.Status != bloodunit.StatusReserved {
+			continue
+		}
+
+		if err = transitionUnit(b.ctx, tx, unit, unitChange{
+			to:          bloodunit.StatusAvailable,
+			performedBy: &accountID,
+			note:        "blood request cancelled",
+		}); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return b.find(bloodrequest.IDEQ(id))
+}
+
+func (b *BloodRequestQuery) Location(
+	locationID uuid.UUID,
+	page response.Pagination,
+) ([]*ent.BloodRequest, int, error) {
+	return b.list(
+		bloodrequest.HasPmiLocationWith(pmilocation.IDEQ(locationID)),
+		page,
+	)
+}
+
+func (b *BloodRequestQuery) Get(id uuid.UUID) (*ent.BloodRequest, error) {
+	return b.find(bloodrequest.IDEQ(id))
+}
+
+// Accept takes the request on and reserves as many compatible available
+// units as the location has, up to the requested quantity.
+func (b *BloodRequestQuery) Accept(
+	staffID uuid.UUID,
+	id uuid.UUID,
+) (*ent.BloodRequest, error) {
+	req, err := b.find(bloodrequest.IDEQ(id))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := b.client.Tx(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = setRequestStatus(
+		b.ctx,
+		tx,
+		req,
+		tx.BloodRequest.UpdateOneID(req.ID).
+			SetStatus(bloodrequest.StatusAccepted).
+			SetHandledByID(staffID),
+		bloodrequest.StatusAccepted,
+	); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if _, err = reserveUnits(b.ctx, tx, req, staffID, req.Quantity); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return b.find(bloodrequest.IDEQ(id))
+}
+
+func (b *BloodRequestQuery) Reject(
+	staffID uuid.UUID,
+	id uuid.UUID,
+	body request.BloodRequestReason,
+) (*ent.BloodRequest, error) {
+	req, err := b.find(bloodrequest.IDEQ(id))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := b.client.Tx(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = setRequestStatus(
+		b.ctx,
+		tx,
+		req,
+		tx.BloodRequest.UpdateOneID(req.ID).
+			SetStatus(bloodrequest.StatusRejected).
+			SetHandledByID(staffID).
+			SetReason(body.Reason),
+		bloodrequest.StatusRejected,
+	); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return b.find(bloodrequest.IDEQ(id))
+}
+
+// Fulfil issues the units reserved for the request, topping the reservation
+// up first with units that became available since it was accepted.
+func (b *BloodRequestQuery) Fulfil(
+	staffID uuid.UUID,
+	id uuid.UUID,
+) (*ent.BloodRequest, error) {
+	req, err := b.find(bloodrequest.IDEQ(id))
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := make([]*ent.BloodUnit, 0, len(req.Edges.Units))
+	for _, unit := range req.Edges.Units {
+		if unit.Status == bloodunit.StatusReserved {
+			reserved = append(reserved, unit)
+		}
+	}
+
+	tx, err := b.client.Tx(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := req.Quantity - req.FulfilledQuantity - len(reserved)
+	if missing > 0 {
+		more, err := reserveUnits(b.ctx, tx, req, staffID, missing)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		reserved = append(reserved, more...)
+	}
+
+	if len(reserved) == 0 {
+		return nil, rollback(
+			tx,
+			errors.New("no compatible blood unit is available"),
+		)
+	}
+
+	for _, unit := range reserved {
+		if err = transitionUnit(b.ctx, tx, unit, unitChange{
+			to:          bloodunit.StatusIssued,
+			performedBy: &staffID,
+			note:        "issued for blood request",
+		}); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	fulfilled := req.FulfilledQuantity + len(reserved)
+	status := lifecycle.Fulfilment(fulfilled, req.Quantity)
+	if err = setRequestStatus(
+		b.ctx,
+		tx,
+		req,
+		tx.BloodRequest.UpdateOneID(req.ID).
+			SetStatus(status).
+			SetFulfilledQuantity(fulfilled),
+		status,
+	); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return b.find(bloodrequest.IDEQ(id))
+}
+
+func (b *BloodRequestQuery) hospitalOf(
+	accountID uuid.UUID,
+) (*ent.Hospital, error) {
+	h, err := b.client.Hospital.Query().
+		Where(
+			hospital.HasMembersWith(account.IDEQ(accountID)),
+			hospital.ActivatedEQ(true),
+			hospital.DeletedAtIsNil(),
+		).
+		Only(b.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("account is not a member of a hospital")
+		}
+
+		return nil, err
+	}
+
+	return h, nil
+}
+
+func (b *BloodRequestQuery) list(
+	where predicate.BloodRequest,
+	page response.Pagination,
+) ([]*ent.BloodRequest, int, error) {
+	q := b.client.BloodRequest.Query().
+		Where(where, bloodrequest.DeletedAtIsNil())
+
+	total, err := q.Clone().Count(b.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := ent.Desc(bloodrequest.FieldCreatedAt)
+	if page.GetSort() == "ASC" {
+		order = ent.Asc(bloodrequest.FieldCreatedAt)
+	}
+
+	requests, err := q.
+		WithHospital().
+		WithBloodType().
+		WithPmiLocation().
+		Order(order).
+		Offset(page.GetOffset()).
+		Limit(page.GetLimit()).
+		All(b.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return requests, total, nil
+}
+
+func (b *BloodRequestQuery) find(
+	where ...predicate.BloodRequest,
+) (*ent.BloodRequest, error) {
+	req, err := b.client.BloodRequest.Query().
+		Where(append(where, bloodrequest.DeletedAtIsNil())...).
+		WithHospital().
+		WithBloodType().
+		WithPmiLocation().
+		WithUnits(func(q *ent.BloodUnitQuery) {
+			q.WithPmiLocation().WithBloodType()
+		}).
+		Only(b.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("blood request not found")
+		}
+
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// setRequestStatus runs the update only while the request still has the
+// status it was loaded with, after checking the transition is allowed.
+func setRequestStatus(
+	ctx context.Context,
+	tx *ent.Tx,
+	req *ent.BloodRequest,
+	update *ent.BloodRequestUpdateOne,
+	to bloodrequest.Status,
+) error {
+	if err := lifecycle.RequestTransition(req.Status, to); err != nil {
+		return err
+	}
+
+	err := update.Where(bloodrequest.StatusEQ(req.Status)).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return errRequestStatusChanged
+	}
+
+	return err
+}
+
+// reserveUnits reserves up to limit available units at the requested PMI
+// location that are compatible with the patient and do not expire before
+// the blood is required. Closer blood types are taken first and, within a
+// type, the units expiring first.
+func reserveUnits(
+	ctx context.Context,
+	tx *ent.Tx,
+	req *ent.BloodRequest,
+	staffID uuid.UUID,
+	limit int,
+) ([]*ent.BloodUnit, error) {
+	donors := compatibility.Donors(
+		compatibility.Of(req.Edges.BloodType),
+		bloodstock.Component(req.Component),
+	)
+
+	types, err := tx.BloodType.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rank := make(map[uuid.UUID]int, len(types))
+	ids := make([]uuid.UUID, 0, len(types))
+	for _, bt := range types {
+		if i := slices.Index(donors, compatibility.Of(bt)); i >= 0 {
+			rank[bt.ID] = i
+			ids = append(ids, bt.ID)
+		}
+	}
+
+	units, err := tx.BloodUnit.Query().
+		Where(
+			bloodunit.StatusEQ(bloodunit.StatusAvailable),
+			bloodunit.ComponentEQ(bloodunit.Component(req.Component)),
+			bloodunit.ExpiresAtGT(max(time.Now().UnixMilli(), req.RequiredBy)),
+			bloodunit.HasPmiLocationWith(
+				pmilocation.IDEQ(req.Edges.PmiLocation.ID),
+			),
+			bloodunit.HasBloodTypeWith(bloodtype.IDIn(ids...)),
+			bloodunit.DeletedAtIsNil(),
+		).
+		WithPmiLocation().
+		WithBloodType().
+		Order(ent.Asc(bloodunit.FieldExpiresAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(units, func(a, b *ent.BloodUnit) int {
+		return cmp.Compare(
+			rank[a.Edges.BloodType.ID],
+			rank[b.Edges.BloodType.ID],
+		)
+	})
+
+	reserved := make([]*ent.BloodUnit, 0, limit)
+	for _, unit := range units {
+		if len(reserved) == limit {
+			break
+		}
+
+		err = transitionUnit(ctx, tx, unit, unitChange{
+			to:          bloodunit.StatusReserved,
+			performedBy: &staffID,
+			note:        "reserved for blood request",
+		})
+		if errors.Is(err, errUnitStatusChanged) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err = tx.BloodUnit.UpdateOneID(unit.ID).
+			SetRequestID(req.ID).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		reserved = append(reserved, unit)
+	}
+
+	return reserved, nil
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/config"
+	"github.com/sembraniteam/setetes/internal/cryptox"
+	"github.com/sembraniteam/setetes/internal/cryptox/pasetox"
+	"github.com/sembraniteam/setetes/internal/eligibility"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/reminder"
+)
+
+const defaultCardTokenTTL = time.Minute * 5
+
+type (
+	CardQuery struct {
+		scope
+		client   *ent.Client
+		keypair  *cryptox.Keypair
+		verifier *pasetox.Verifier
+	}
+
+	Card interface {
+		Issue(accountID uuid.UUID, platform string) (*DonorCard, error)
+		CheckIn(body request.CheckIn) (*CheckIn, error)
+		Scoped[Card]
+	}
+
+	// DonorCard is the digital card of a donor. Token is encoded in the QR
+	// code and expires at ExpiresAt.
+	DonorCard struct {
+		Account   *ent.Account
+		Token     string
+		ExpiresAt time.Time
+	}
+
+	// CheckIn is what the counter sees after scanning a donor card.
+	// Appointment is nil for a walk-in donor.
+	CheckIn struct {
+		Account     *ent.Account
+		Type        donation.Type
+		Eligibility *eligibility.Result
+		Appointment *ent.Appointment
+	}
+)
+
+func NewCard(i do.Injector) (Card, error) {
+	keypair := do.MustInvoke[*cryptox.Keypair](i)
+
+	return &CardQuery{
+		client:   do.MustInvoke[*ent.Client](i),
+		keypair:  keypair,
+		verifier: pasetox.NewVerifier(keypair),
+		scope:    newScope(),
+	}, nil
+}
+
+func (c *CardQuery) WithContext(ctx context.Context) Card {
+	return withContext(c, ctx)
+}
+
+func (c *CardQuery) Issue(
+	accountID uuid.UUID,
+	platform string,
+) (*DonorCard, error) {
+	acc, err := c.client.Account.Query().
+		Where(account.IDEQ(accountID), account.DeletedAtIsNil()).
+		WithBloodType().
+		Only(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(cardTokenTTL())
+	token, err := pasetox.New(c.keypair, pasetox.Claims{
+		Purpose:         pasetox.PurposeDonorCard,
+		Platform:        platform,
+		Subject:         acc.ID.String(),
+		TokenIdentifier: uuid.NewString(),
+		Expiration:      expiresAt,
+		IssuedAt:        now.Add(skew),
+		NotBefore:       now.Add(skew),
+	}).Signed()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DonorCard{Account: acc, Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// CheckIn verifies a scanned donor card, evaluates the donor's eligibility
+// and marks today's appointment at the location as attended.
+func (c *CardQuery) CheckIn(body request.CheckIn) (*CheckIn, error) {
+	claims, err := c.verifier.VerifyPurpose(
+		body.Token,
+		pasetox.PurposeDonorCard,
+	)
+	if err != nil {
+		return nil, errors.New("donor card is invalid or expired")
+	}
+
+	accountID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, errors.New("donor card is invalid or expired")
+	}
+
+	acc, err := c.client.Account.Query().
+		Where(
+			account.IDEQ(accountID),
+			account.ActivatedEQ(true),
+			account.DeletedAtIsNil(),
+		).
+		WithBloodType().
+		WithNotificationPreference().
+		Only(c.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("donor not found")
+		}
+
+		return nil, err
+	}
+
+	now := time.Now()
+	t := body.GetType()
+	res, err := checkEligibility(c.ctx, c.client, acc.ID, t, now)
+	if err != nil {
+		return nil, err
+	}
+
+	appt, err := c.attend(acc, body.PMILocationID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CheckIn{
+		Account:     acc,
+		Type:        t,
+		Eligibility: res,
+		Appointment: appt,
+	}, nil
+}
+
+// attend marks the donor's appointment at the location on the donor's
+// current day as attended. Checking in twice returns the attended
+// appointment again.
+func (c *CardQuery) attend(
+	acc *ent.Account,
+	locationID uuid.UUID,
+	now time.Time,
+) (*ent.Appointment, error) {
+	tz := ""
+	if pref := acc.Edges.NotificationPreference; pref != nil {
+		tz = pref.Timezone
+	}
+
+	local := now.In(reminder.Location(tz))
+	start := time.Date(
+		local.Year(),
+		local.Month(),
+		local.Day(),
+		0,
+		0,
+		0,
+		0,
+		local.Location(),
+	)
+
+	appt, err := c.client.Appointment.Query().
+		Where(
+			appointment.HasAccountWith(account.IDEQ(acc.ID)),
+			appointment.HasPmiLocationWith(pmilocation.IDEQ(locationID)),
+			appointment.StatusIn(
+				appointment.StatusBooked,
+				appointment.StatusAttended,
+			),
+			appointment.ScheduledAtGTE(start.UnixMilli()),
+			appointment.ScheduledAtLT(start.AddDate(0, 0, 1).UnixMilli()),
+			appointment.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(appointment.FieldScheduledAt)).
+		First(c.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if appt.Status == appointment.StatusAttended {
+		return appt, nil
+	}
+
+	n, err := c.client.Appointment.Update().
+		Where(
+			appointment.IDEQ(appt.ID),
+			appointment.StatusEQ(appointment.StatusBooked),
+		).
+		SetStatus(appointment.StatusAttended).
+		Save(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		return c.client.Appointment.Get(c.ctx, appt.ID)
+	}
+
+	appt.Status = appointment.StatusAttended
+
+	return appt, nil
+}
+
+func cardTokenTTL() time.Duration {
+	if d := config.Get().DonorCard.TokenTTL; d > 0 {
+		return d
+	}
+
+	return defaultCardTokenTTL
+}
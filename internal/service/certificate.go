@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/certificate"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	entcertificate "github.com/sembraniteam/setetes/internal/ent/certificate"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/reminder"
+)
+
+type (
+	CertificateQuery struct {
+		scope
+		client *ent.Client
+	}
+
+	Certificate interface {
+		Self(accountID uuid.UUID) (*Milestones, error)
+		Download(accountID, id uuid.UUID) (*ent.Certificate, []byte, error)
+		Verify(id uuid.UUID) (*ent.Certificate, error)
+		Scoped[Certificate]
+	}
+
+	// Milestones is the progress of a donor towards the milestones. Next is 0
+	// once every milestone is reached.
+	Milestones struct {
+		Donations    int
+		Next         int
+		Certificates []*ent.Certificate
+	}
+)
+
+func NewCertificate(i do.Injector) (Certificate, error) {
+	return &CertificateQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		scope:  newScope(),
+	}, nil
+}
+
+func (c *CertificateQuery) WithContext(ctx context.Context) Certificate {
+	return withContext(c, ctx)
+}
+
+// Self awards the certificates of milestones the donor reached since the
+// last call and returns the donor's progress.
+func (c *CertificateQuery) Self(accountID uuid.UUID) (*Milestones, error) {
+	acc, err := c.client.Account.Query().
+		Where(account.IDEQ(accountID), account.DeletedAtIsNil()).
+		Only(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	donations := c.client.Donation.Query().
+		Where(
+			donation.HasAccountWith(account.IDEQ(accountID)),
+			donation.DeletedAtIsNil(),
+		)
+
+	count, err := donations.Clone().Count(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	awarded, err := c.certificates(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	has := make(map[int]bool, len(awarded))
+	for _, a := range awarded {
+		has[a.Milestone] = true
+	}
+
+	reached := certificate.Reached(count)
+	for _, m := range reached {
+		if has[m] {
+			continue
+		}
+
+		// The certificate is dated at the donation that reached the
+		// milestone.
+		d, err := donations.Clone().
+			Order(ent.Asc(donation.FieldDonatedAt)).
+			Offset(m - 1).
+			First(c.ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = c.client.Certificate.Create().
+			SetAccountID(accountID).
+			SetDonationID(d.ID).
+			SetMilestone(m).
+			SetHolderName(acc.FullName).
+			SetAwardedAt(d.DonatedAt).
+			Exec(c.ctx)
+		if err != nil && !ent.IsConstraintError(err) {
+			return nil, err
+		}
+	}
+
+	if len(reached) > len(awarded) {
+		if awarded, err = c.certificates(accountID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Milestones{
+		Donations:    count,
+		Next:         certificate.Next(count),
+		Certificates: awarded,
+	}, nil
+}
+
+// Download renders the certificate of the account as a PDF.
+func (c *CertificateQuery) Download(
+	accountID uuid.UUID,
+	id uuid.UUID,
+) (*ent.Certificate, []byte, error) {
+	cert, err := c.client.Certificate.Query().
+		Where(
+			entcertificate.IDEQ(id),
+			entcertificate.HasAccountWith(account.IDEQ(accountID)),
+			entcertificate.DeletedAtIsNil(),
+		).
+		WithAccount(func(q *ent.AccountQuery) {
+			q.WithNotificationPreference()
+		}).
+		Only(c.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, errors.New("certificate not found")
+		}
+
+		return nil, nil, err
+	}
+
+	tz := ""
+	if pref := cert.Edges.Account.Edges.NotificationPreference; pref != nil {
+		tz = pref.Timezone
+	}
+
+	pdf, err := certificate.Render(cert, reminder.Location(tz))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, pdf, nil
+}
+
+// Verify looks up a certificate by the ID printed on it, so anyone can
+// confirm it was issued by PMI.
+func (c *CertificateQuery) Verify(id uuid.UUID) (*ent.Certificate, error) {
+	cert, err := c.client.Certificate.Query().
+		Where(
+			entcertificate.IDEQ(id),
+			entcertificate.DeletedAtIsNil(),
+		).
+		Only(c.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("certificate not found")
+		}
+
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+func (c *CertificateQuery) certificates(
+	accountID uuid.UUID,
+) ([]*ent.Certificate, error) {
+	return c.client.Certificate.Query().
+		Where(
+			entcertificate.HasAccountWith(account.IDEQ(accountID)),
+			entcertificate.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(entcertificate.FieldMilestone)).
+		All(c.ctx)
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+)
+
+type (
+	DonationQuery struct {
+		scope
+		client *ent.Client
+	}
+
+	Donation interface {
+		Record(staffID uuid.UUID, body request.Donation) (*ent.Donation, error)
+		History(
+			accountID uuid.UUID,
+			page response.Pagination,
+		) ([]*ent.Donation, int, error)
+		Scoped[Donation]
+	}
+)
+
+func NewDonation(i do.Injector) (Donation, error) {
+	return &DonationQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		scope:  newScope(),
+	}, nil
+}
+
+func (d *DonationQuery) WithContext(ctx context.Context) Donation {
+	return withContext(d, ctx)
+}
+
+func (d *DonationQuery) Record(
+	staffID uuid.UUID,
+	body request.Donation,
+) (*ent.Donation, error) {
+	tx, err := d.client.Tx(d.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	donor, err := tx.Account.Query().
+		Where(
+			account.IDEQ(body.AccountID),
+			account.ActivatedEQ(true),
+			account.DeletedAtIsNil(),
+		).
+		Only(d.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, errors.New("donor not found"))
+		}
+
+		return nil, rollback(tx, err)
+	}
+
+	eligible, err := checkEligibility(
+		d.ctx,
+		tx.Client(),
+		donor.ID,
+		body.GetType(),
+		time.UnixMilli(body.DonatedAt),
+	)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if !eligible.Eligible {
+		return nil, rollback(tx, fmt.Errorf(
+			"donor is not eligible: %s",
+			eligible.Reasons[0].Message,
+		))
+	}
+
+	loc, err := tx.PMILocation.Query().
+		Where(
+			pmilocation.IDEQ(body.PMILocationID),
+			pmilocation.DeletedAtIsNil(),
+		).
+		Only(d.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, errors.New("PMI location not found"))
+		}
+
+		return nil, rollback(tx, err)
+	}
+
+	create := tx.Donation.Create().
+		SetAccount(donor).
+		SetPmiLocation(loc).
+		SetRecordedByID(staffID).
+		SetDonatedAt(body.DonatedAt).
+		SetType(body.GetType()).
+		SetVolumeMl(body.VolumeML).
+		SetBagNumber(body.BagNumber)
+
+	if body.AppointmentID != nil {
+		appt, err := tx.Appointment.Query().
+			Where(
+				appointment.IDEQ(*body.AppointmentID),
+				appointment.HasAccountWith(account.IDEQ(donor.ID)),
+				appointment.StatusIn(
+					appointment.StatusBooked,
+					appointment.StatusAttended,
+				),
+				appointment.Not(appointment.HasDonation()),
+			).
+			Only(d.ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, rollback(tx, errors.New("appointment not found"))
+			}
+
+			return nil, rollback(tx, err)
+		}
+
+		if err = tx.Appointment.UpdateOne(appt).
+			SetStatus(appointment.StatusAttended).
+			Exec(d.ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		create.SetAppointment(appt)
+	}
+
+	don, err := create.Save(d.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	don.Edges.PmiLocation = loc
+
+	return don, nil
+}
+
+func (d *DonationQuery) History(
+	accountID uuid.UUID,
+	page response.Pagination,
+) ([]*ent.Donation, int, error) {
+	q := d.client.Donation.Query().
+		Where(
+			donation.HasAccountWith(account.IDEQ(accountID)),
+			donation.DeletedAtIsNil(),
+		)
+
+	total, err := q.Clone().Count(d.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := ent.Desc(donation.FieldDonatedAt)
+	if page.GetSort() == "ASC" {
+		order = ent.Asc(donation.FieldDonatedAt)
+	}
+
+	donations, err := q.
+		WithPmiLocation().
+		Order(order).
+		Offset(page.GetOffset()).
+		Limit(page.GetLimit()).
+		All(d.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return donations, total, nil
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/eligibility"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+)
+
+// lookback bounds how far back donations are loaded for an eligibility
+// check. It must cover the longest interval and the yearly limit window.
+const lookback = time.Hour * 24 * 366
+
+type (
+	EligibilityQuery struct {
+		scope
+		client *ent.Client
+	}
+
+	Eligibility interface {
+		Check(
+			accountID uuid.UUID,
+			t donation.Type,
+		) (*eligibility.Result, error)
+		Defer(staffID uuid.UUID, body request.Deferral) (*ent.Deferral, error)
+		Scoped[Eligibility]
+	}
+)
+
+func NewEligibility(i do.Injector) (Eligibility, error) {
+	return &EligibilityQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		scope:  newScope(),
+	}, nil
+}
+
+func (e *EligibilityQuery) WithContext(ctx context.Context) Eligibility {
+	return withContext(e, ctx)
+}
+
+func (e *EligibilityQuery) Check(
+	accountID uuid.UUID,
+	t donation.Type,
+) (*eligibility.Result, error) {
+	return checkEligibility(e.ctx, e.client, accountID, t, time.Now())
+}
+
+func (e *EligibilityQuery) Defer(
+	staffID uuid.UUID,
+	body request.Deferral,
+) (*ent.Deferral, error) {
+	startsAt := body.StartsAt
+	if startsAt == 0 {
+		startsAt = time.Now().UnixMilli()
+	}
+
+	t := body.GetType()
+	if t == deferral.TypeTemporary && body.EndsAt <= startsAt {
+		return nil, errors.New("ends_at must be after starts_at")
+	}
+
+	exists, err := e.client.Account.Query().
+		Where(account.IDEQ(body.AccountID), account.DeletedAtIsNil()).
+		Exist(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.New("donor not found")
+	}
+
+	create := e.client.Deferral.Create().
+		SetAccountID(body.AccountID).
+		SetCreatedByID(staffID).
+		SetType(t).
+		SetReason(body.Reason).
+		SetStartsAt(startsAt)
+
+	if t == deferral.TypeTemporary {
+		create.SetEndsAt(body.EndsAt)
+	}
+
+	return create.Save(e.ctx)
+}
+
+// checkEligibility loads the donor's recent donations and active deferrals
+// through client, which may be bound to a transaction, and evaluates them
+// against the configured rules.
+func checkEligibility(
+	ctx context.Context,
+	client *ent.Client,
+	accountID uuid.UUID,
+	t donation.Type,
+	now time.Time,
+) (*eligibility.Result, error) {
+	acc, err := withEligibilityHistory(client.Account.Query(), now).
+		Where(account.IDEQ(accountID), account.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := evaluateEligibility(eligibility.DefaultRules(), acc, t, now)
+
+	return &res, nil
+}
+
+// withEligibilityHistory loads the recent donations and active deferrals of
+// the accounts of q, with one query each however many accounts it matches.
+func withEligibilityHistory(
+	q *ent.AccountQuery,
+	now time.Time,
+) *ent.AccountQuery {
+	return q.
+		WithDonations(func(dq *ent.DonationQuery) {
+			dq.Where(
+				donation.DeletedAtIsNil(),
+				donation.DonatedAtGTE(now.Add(-lookback).UnixMilli()),
+			)
+		}).
+		WithDeferrals(func(dq *ent.DeferralQuery) {
+			dq.Where(
+				deferral.DeletedAtIsNil(),
+				deferral.Or(
+					deferral.TypeEQ(deferral.TypePermanent),
+					deferral.EndsAtGT(now.UnixMilli()),
+				),
+			)
+		})
+}
+
+// evaluateEligibility evaluates an account loaded withEligibilityHistory
+// against rules.
+func evaluateEligibility(
+	rules eligibility.Rules,
+	acc *ent.Account,
+	t donation.Type,
+	now time.Time,
+) eligibility.Result {
+	donations := acc.Edges.Donations
+	deferrals := acc.Edges.Deferrals
+	donor := eligibility.Donor{
+		BirthDate: acc.BirthDate,
+		Gender:    acc.Gender,
+		Donations: make([]eligibility.Donation, 0, len(donations)),
+		Deferrals: make([]eligibility.Deferral, 0, len(deferrals)),
+	}
+
+	for _, d := range donations {
+		donor.Donations = append(donor.Donations, eligibility.Donation{
+			Type:      d.Type,
+			DonatedAt: time.UnixMilli(d.DonatedAt),
+		})
+	}
+
+	for _, d := range deferrals {
+		def := eligibility.Deferral{
+			Type:     d.Type,
+			Reason:   d.Reason,
+			StartsAt: time.UnixMilli(d.StartsAt),
+		}
+
+		if d.EndsAt > 0 {
+			endsAt := time.UnixMilli(d.EndsAt)
+			def.EndsAt = &endsAt
+		}
+
+		donor.Deferrals = append(donor.Deferrals, def)
+	}
+
+	return rules.Evaluate(donor, t, now)
+}
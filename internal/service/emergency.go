@@ -0,0 +1,610 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/compatibility"
+	"github.com/sembraniteam/setetes/internal/config"
+	"github.com/sembraniteam/setetes/internal/eligibility"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycampaign"
+	"github.com/sembraniteam/setetes/internal/ent/emergencycontact"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/schema"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/notify"
+	"github.com/twpayne/go-geom"
+)
+
+const (
+	earthRadius         = 6371000
+	defaultWaveInterval = time.Minute * 30
+)
+
+type (
+	EmergencyQuery struct {
+		scope
+		client *ent.Client
+		sender notify.Sender
+		log    *slog.Logger
+	}
+
+	Emergency interface {
+		Create(
+			staffID uuid.UUID,
+			body request.EmergencyCampaign,
+		) (*ent.EmergencyCampaign, error)
+		Get(id uuid.UUID) (*ent.EmergencyCampaign, error)
+		Cancel(id uuid.UUID) (*ent.EmergencyCampaign, error)
+		NextWave(id uuid.UUID) (*ent.EmergencyCampaign, error)
+		Self(accountID uuid.UUID) ([]*ent.EmergencyContact, error)
+		Respond(
+			accountID uuid.UUID,
+			contactID uuid.UUID,
+			body request.EmergencyResponse,
+		) (*ent.EmergencyContact, error)
+		Advance(now time.Time) error
+		Scoped[Emergency]
+	}
+
+	// candidate is a donor found within the campaign radius.
+	candidate struct {
+		account  *ent.Account
+		rank     int
+		distance int
+	}
+)
+
+func NewEmergency(i do.Injector) (Emergency, error) {
+	return &EmergencyQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		sender: do.MustInvoke[notify.Sender](i),
+		scope:  newScope(),
+		log:    slog.Default(),
+	}, nil
+}
+
+func (e *EmergencyQuery) WithContext(ctx context.Context) Emergency {
+	return withContext(e, ctx)
+}
+
+// Create starts a call-out and sends its first wave right away.
+func (e *EmergencyQuery) Create(
+	staffID uuid.UUID,
+	body request.EmergencyCampaign,
+) (*ent.EmergencyCampaign, error) {
+	bt, err := findBloodType(e.ctx, e.client, body.BloodType)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := e.client.PMILocation.Query().
+		Where(
+			pmilocation.IDEQ(body.PMILocationID),
+			pmilocation.DeletedAtIsNil(),
+		).
+		Only(e.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("PMI location not found")
+		}
+
+		return nil, err
+	}
+
+	c, err := e.client.EmergencyCampaign.Create().
+		SetPmiLocation(loc).
+		SetBloodType(bt).
+		SetCreatedByID(staffID).
+		SetComponent(body.GetComponent()).
+		SetNeededDonors(body.NeededDonors).
+		SetRadiusM(body.GetRadius()).
+		SetWaveSize(body.GetWaveSize()).
+		SetMessage(body.Message).
+		Save(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Edges.PmiLocation = loc
+	c.Edges.BloodType = bt
+	if err = e.sendWave(c, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return e.Get(c.ID)
+}
+
+func (e *EmergencyQuery) Get(id uuid.UUID) (*ent.EmergencyCampaign, error) {
+	c, err := e.client.EmergencyCampaign.Query().
+		Where(
+			emergencycampaign.IDEQ(id),
+			emergencycampaign.DeletedAtIsNil(),
+		).
+		WithPmiLocation().
+		WithBloodType().
+		WithContacts(func(q *ent.EmergencyContactQuery) {
+			q.WithAccount().
+				Order(
+					ent.Asc(emergencycontact.FieldWave),
+					ent.Asc(emergencycontact.FieldDistanceM),
+				)
+		}).
+		Only(e.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("emergency campaign not found")
+		}
+
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (e *EmergencyQuery) Cancel(id uuid.UUID) (*ent.EmergencyCampaign, error) {
+	n, err := e.client.EmergencyCampaign.Update().
+		Where(
+			emergencycampaign.IDEQ(id),
+			emergencycampaign.StatusEQ(emergencycampaign.StatusActive),
+		).
+		SetStatus(emergencycampaign.StatusCancelled).
+		Save(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		return nil, errors.New("emergency campaign is not active")
+	}
+
+	return e.Get(id)
+}
+
+// NextWave sends the next wave without waiting for the wave interval.
+func (e *EmergencyQuery) NextWave(
+	id uuid.UUID,
+) (*ent.EmergencyCampaign, error) {
+	c, err := e.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Status != emergencycampaign.StatusActive {
+		return nil, errors.New("emergency campaign is not active")
+	}
+
+	if err = e.sendWave(c, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return e.Get(id)
+}
+
+// Self returns the call-outs of active campaigns the donor was notified of.
+func (e *EmergencyQuery) Self(
+	accountID uuid.UUID,
+) ([]*ent.EmergencyContact, error) {
+	return e.client.EmergencyContact.Query().
+		Where(
+			emergencycontact.HasAccountWith(account.IDEQ(accountID)),
+			emergencycontact.HasCampaignWith(
+				emergencycampaign.StatusEQ(emergencycampaign.StatusActive),
+			),
+		).
+		WithCampaign(func(q *ent.EmergencyCampaignQuery) {
+			q.WithPmiLocation().WithBloodType()
+		}).
+		Order(ent.Desc(emergencycontact.FieldCreatedAt)).
+		All(e.ctx)
+}
+
+// Respond records the answer of a notified donor. Commitments are counted
+// with a guarded increment, so a campaign never takes more donors than it
+// needs and is fulfilled by the last one.
+func (e *EmergencyQuery) Respond(
+	accountID uuid.UUID,
+	contactID uuid.UUID,
+	body request.EmergencyResponse,
+) (*ent.EmergencyContact, error) {
+	contact, err := e.client.EmergencyContact.Query().
+		Where(
+			emergencycontact.IDEQ(contactID),
+			emergencycontact.HasAccountWith(account.IDEQ(accountID)),
+		).
+		WithCampaign().
+		Only(e.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("emergency call-out not found")
+		}
+
+		return nil, err
+	}
+
+	if contact.Status != emergencycontact.StatusNotified {
+		return nil, errors.New("emergency call-out is already answered")
+	}
+
+	campaignID := contact.Edges.Campaign.ID
+	tx, err := e.client.Tx(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := emergencycontact.StatusDeclined
+	if body.IsAccepted() {
+		status = emergencycontact.StatusAccepted
+
+		n, err := tx.EmergencyCampaign.Update().
+			Where(
+				emergencycampaign.IDEQ(campaignID),
+				emergencycampaign.StatusEQ(emergencycampaign.StatusActive),
+				needsDonors(),
+			).
+			AddCommittedDonors(1).
+			Save(e.ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		if n == 0 {
+			return nil, rollback(tx, errors.New(
+				"enough donors have already committed, thank you",
+			))
+		}
+
+		if _, err = tx.EmergencyCampaign.Update().
+			Where(
+				emergencycampaign.IDEQ(campaignID),
+				emergencycampaign.Not(needsDonors()),
+			).
+			SetStatus(emergencycampaign.StatusFulfilled).
+			Save(e.ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	n, err := tx.EmergencyContact.Update().
+		Where(
+			emergencycontact.IDEQ(contact.ID),
+			emergencycontact.StatusEQ(emergencycontact.StatusNotified),
+		).
+		SetStatus(status).
+		SetRespondedAt(time.Now().UnixMilli()).
+		Save(e.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if n == 0 {
+		return nil, rollback(
+			tx,
+			errors.New("emergency call-out is already answered"),
+		)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return e.client.EmergencyContact.Query().
+		Where(emergencycontact.IDEQ(contact.ID)).
+		WithCampaign(func(q *ent.EmergencyCampaignQuery) {
+			q.WithPmiLocation().WithBloodType()
+		}).
+		Only(e.ctx)
+}
+
+// Advance sends the next wave of every active campaign whose last wave is
+// older than the wave interval.
+func (e *EmergencyQuery) Advance(now time.Time) error {
+	interval := config.Get().Emergency.WaveInterval
+	if interval <= 0 {
+		interval = defaultWaveInterval
+	}
+
+	campaigns, err := e.client.EmergencyCampaign.Query().
+		Where(
+			emergencycampaign.StatusEQ(emergencycampaign.StatusActive),
+			emergencycampaign.Or(
+				emergencycampaign.LastWaveAtIsNil(),
+				emergencycampaign.LastWaveAtLTE(now.Add(-interval).UnixMilli()),
+			),
+			emergencycampaign.DeletedAtIsNil(),
+		).
+		WithPmiLocation().
+		WithBloodType().
+		All(e.ctx)
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, 0)
+	for _, c := range campaigns {
+		if err = e.sendWave(c, now); err != nil {
+			errs = append(errs, fmt.Errorf("campaign %s: %w", c.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sendWave notifies the next donors of a campaign loaded with its PMI
+// location and blood type. The campaign is marked EXHAUSTED when nobody is
+// left to notify.
+//
+// The campaign row is locked while the donors are chosen, so instances
+// sending a wave of the same campaign at the same time, such as the
+// advance job of every instance and staff asking for the next wave, choose
+// donors one after the other and never notify the same donors twice.
+func (e *EmergencyQuery) sendWave(
+	c *ent.EmergencyCampaign,
+	now time.Time,
+) error {
+	tx, err := e.client.Tx(e.ctx)
+	if err != nil {
+		return err
+	}
+
+	locked, err := tx.EmergencyCampaign.Query().
+		Where(
+			emergencycampaign.IDEQ(c.ID),
+			forUpdate[predicate.EmergencyCampaign](),
+		).
+		Only(e.ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	// Another wave was sent or the campaign ended while waiting for the
+	// lock.
+	if locked.Status != emergencycampaign.StatusActive ||
+		locked.Waves != c.Waves {
+		return tx.Rollback()
+	}
+
+	donors, err := e.nextDonors(tx.Client(), c, now)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	update := tx.EmergencyCampaign.Update().
+		Where(
+			emergencycampaign.IDEQ(c.ID),
+			emergencycampaign.StatusEQ(emergencycampaign.StatusActive),
+			emergencycampaign.WavesEQ(c.Waves),
+		).
+		SetLastWaveAt(now.UnixMilli())
+
+	if len(donors) == 0 {
+		update.SetStatus(emergencycampaign.StatusExhausted)
+	} else {
+		update.AddWaves(1)
+	}
+
+	n, err := update.Save(e.ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	if n == 0 || len(donors) == 0 {
+		return tx.Commit()
+	}
+
+	builders := make([]*ent.EmergencyContactCreate, 0, len(donors))
+	for _, d := range donors {
+		builders = append(builders, tx.EmergencyContact.Create().
+			SetCampaignID(c.ID).
+			SetAccount(d.account).
+			SetWave(c.Waves+1).
+			SetDistanceM(d.distance))
+	}
+
+	if err = tx.EmergencyContact.CreateBulk(builders...).
+		Exec(e.ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	for _, d := range donors {
+		if err = e.sender.Send(e.ctx, notify.Message{
+			AccountID: d.account.ID,
+			Subject:   "Urgent blood donation needed",
+			Body:      emergencyMessage(c, d),
+		}); err != nil {
+			e.log.Error(
+				"send emergency call-out failed",
+				slog.String("account_id", d.account.ID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// nextDonors finds the donors of the next wave: compatible donors within the
+// radius who were not notified by the campaign yet and are eligible now,
+// closest blood type first and nearest first within a blood type. The
+// donation and deferral history of the candidates is loaded with them, so
+// their eligibility is evaluated without a query per candidate.
+func (e *EmergencyQuery) nextDonors(
+	client *ent.Client,
+	c *ent.EmergencyCampaign,
+	now time.Time,
+) ([]candidate, error) {
+	loc := c.Edges.PmiLocation
+	if loc.LatLng == nil || loc.LatLng.Point == nil {
+		return nil, errors.New("PMI location has no coordinates")
+	}
+
+	donors := compatibility.Donors(
+		compatibility.Of(c.Edges.BloodType),
+		bloodstock.Component(c.Component),
+	)
+
+	accounts, err := withEligibilityHistory(client.Account.Query(), now).
+		Where(
+			account.ActivatedEQ(true),
+			account.LockedEQ(false),
+			account.DeletedAtIsNil(),
+			account.HasBloodTypeWith(bloodTypesOf(donors)),
+			account.Not(account.HasEmergencyContactsWith(
+				emergencycontact.HasCampaignWith(
+					emergencycampaign.IDEQ(c.ID),
+				),
+			)),
+			withinRadius(loc.LatLng, c.RadiusM),
+		).
+		WithBloodType().
+		All(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]candidate, 0, len(accounts))
+	for _, acc := range accounts {
+		candidates = append(candidates, candidate{
+			account: acc,
+			rank: slices.Index(
+				donors,
+				compatibility.Of(acc.Edges.BloodType),
+			),
+			distance: distance(acc.HomeLatLng.Point, loc.LatLng.Point),
+		})
+	}
+
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return cmp.Or(
+			cmp.Compare(a.rank, b.rank),
+			cmp.Compare(a.distance, b.distance),
+		)
+	})
+
+	t := donationTypeOf(bloodstock.Component(c.Component))
+	rules := eligibility.DefaultRules()
+	wave := make([]candidate, 0, c.WaveSize)
+	for _, cand := range candidates {
+		if len(wave) == c.WaveSize {
+			break
+		}
+
+		if evaluateEligibility(rules, cand.account, t, now).Eligible {
+			wave = append(wave, cand)
+		}
+	}
+
+	return wave, nil
+}
+
+func emergencyMessage(c *ent.EmergencyCampaign, d candidate) string {
+	msg := fmt.Sprintf(
+		"%s needs %s %s donors. You are %.1f km away.",
+		c.Edges.PmiLocation.Name,
+		c.Edges.BloodType.Group,
+		c.Edges.BloodType.Rhesus,
+		float64(d.distance)/1000,
+	)
+
+	if c.Message != "" {
+		msg += " " + c.Message
+	}
+
+	return msg
+}
+
+// forUpdate locks the matched rows until the transaction ends.
+func forUpdate[P ~func(*sql.Selector)]() P {
+	return func(s *sql.Selector) {
+		s.ForUpdate()
+	}
+}
+
+// needsDonors matches campaigns with fewer committed donors than needed.
+func needsDonors() predicate.EmergencyCampaign {
+	return func(s *sql.Selector) {
+		s.Where(sql.ColumnsLT(
+			s.C(emergencycampaign.FieldCommittedDonors),
+			s.C(emergencycampaign.FieldNeededDonors),
+		))
+	}
+}
+
+// bloodTypesOf matches the stored blood types of the given types.
+func bloodTypesOf(types []compatibility.Type) predicate.BloodType {
+	ps := make([]predicate.BloodType, 0, len(types))
+	for _, t := range types {
+		ps = append(ps, bloodtype.And(
+			bloodtype.GroupEQ(t.Group),
+			bloodtype.RhesusEQ(t.Rhesus),
+		))
+	}
+
+	return bloodtype.Or(ps...)
+}
+
+// withinRadius matches accounts whose home is within meters of the point,
+// using the GIST index on the home location.
+func withinRadius(p *schema.GeoPoint, meters int) predicate.Account {
+	return func(s *sql.Selector) {
+		point, err := p.Value()
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("ST_DWithin(").
+				Ident(s.C(account.FieldHomeLatLng)).
+				WriteString(", ST_GeogFromText(").
+				Arg(point).
+				WriteString("), ").
+				Arg(meters).
+				WriteString(")")
+		}))
+	}
+}
+
+// distance returns the great circle distance between two points in meters.
+func distance(a, b *geom.Point) int {
+	lat1 := a.Y() * math.Pi / 180
+	lat2 := b.Y() * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (b.X() - a.X()) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return int(2 * earthRadius * math.Asin(math.Sqrt(h)))
+}
+
+// donationTypeOf returns the donation a donor gives for the component.
+func donationTypeOf(c bloodstock.Component) donation.Type {
+	switch c {
+	case bloodstock.ComponentTC:
+		return donation.TypeApheresisPlatelets
+	case bloodstock.ComponentFFP:
+		return donation.TypeApheresisPlasma
+	default:
+		return donation.TypeWholeBlood
+	}
+}
@@ -0,0 +1,415 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/donationevent"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/rbac"
+	"github.com/sembraniteam/setetes/internal/region"
+)
+
+const organizerRole = "organizer"
+
+type (
+	EventQuery struct {
+		scope
+		client *ent.Client
+		rbac   *rbac.Manager
+	}
+
+	Event interface {
+		GrantOrganizer(body request.Organizer) error
+		Create(
+			organizerID uuid.UUID,
+			body request.DonationEvent,
+		) (*ent.DonationEvent, error)
+		Update(
+			organizerID uuid.UUID,
+			id uuid.UUID,
+			body request.DonationEvent,
+		) (*ent.DonationEvent, error)
+		Cancel(organizerID, id uuid.UUID) (*ent.DonationEvent, error)
+		Organizer(
+			organizerID uuid.UUID,
+			page response.Pagination,
+		) ([]*ent.DonationEvent, int, error)
+		Location(
+			locationID uuid.UUID,
+			page response.Pagination,
+		) ([]*ent.DonationEvent, int, error)
+		Approve(staffID, id uuid.UUID) (*ent.DonationEvent, error)
+		Reject(
+			staffID uuid.UUID,
+			id uuid.UUID,
+			body request.EventRejection,
+		) (*ent.DonationEvent, error)
+		Discover(
+			filter request.EventFilter,
+		) ([]*ent.DonationEvent, int, error)
+		Get(id uuid.UUID) (*ent.DonationEvent, error)
+		Scoped[Event]
+	}
+)
+
+func NewEvent(i do.Injector) (Event, error) {
+	return &EventQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		rbac:   do.MustInvoke[*rbac.Manager](i),
+		scope:  newScope(),
+	}, nil
+}
+
+func (e *EventQuery) WithContext(ctx context.Context) Event {
+	return withContext(e, ctx)
+}
+
+func (e *EventQuery) GrantOrganizer(body request.Organizer) error {
+	tx, err := e.client.Tx(e.ctx)
+	if err != nil {
+		return err
+	}
+
+	exists, err := tx.Account.Query().
+		Where(
+			account.IDEQ(body.AccountID),
+			account.ActivatedEQ(true),
+			account.DeletedAtIsNil(),
+		).
+		Exist(e.ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	if !exists {
+		return rollback(tx, errors.New("account not found"))
+	}
+
+	if err = grantRole(
+		e.ctx,
+		tx,
+		e.rbac,
+		body.AccountID,
+		organizerRole,
+	); err != nil {
+		return rollback(tx, err)
+	}
+
+	return e.rbac.Commit(tx)
+}
+
+// Create submits an event for approval by its PMI location.
+func (e *EventQuery) Create(
+	organizerID uuid.UUID,
+	body request.DonationEvent,
+) (*ent.DonationEvent, error) {
+	if err := e.validate(body); err != nil {
+		return nil, err
+	}
+
+	event, err := e.client.DonationEvent.Create().
+		SetOrganizerID(organizerID).
+		SetTitle(body.Title).
+		SetDescription(body.Description).
+		SetHostName(body.HostName).
+		SetHostType(body.GetHostType()).
+		SetVenue(body.Venue).
+		SetStreet(body.Street).
+		SetLatLng(body.GetGeoPoint()).
+		SetSubdistrictID(body.SubdistrictID).
+		SetPmiLocationID(body.PMILocationID).
+		SetStartsAt(body.StartsAt).
+		SetEndsAt(body.EndsAt).
+		SetCapacity(body.Capacity).
+		Save(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.find(donationevent.IDEQ(event.ID))
+}
+
+// Update changes an event that has not started yet. An approved event goes
+// back to PENDING, since PMI approved the old details.
+func (e *EventQuery) Update(
+	organizerID uuid.UUID,
+	id uuid.UUID,
+	body request.DonationEvent,
+) (*ent.DonationEvent, error) {
+	if err := e.validate(body); err != nil {
+		return nil, err
+	}
+
+	n, err := e.client.DonationEvent.Update().
+		Where(
+			donationevent.IDEQ(id),
+			donationevent.HasOrganizerWith(account.IDEQ(organizerID)),
+			donationevent.StatusIn(
+				donationevent.StatusPending,
+				donationevent.StatusApproved,
+			),
+			donationevent.StartsAtGT(time.Now().UnixMilli()),
+			donationevent.DeletedAtIsNil(),
+		).
+		SetTitle(body.Title).
+		SetDescription(body.Description).
+		SetHostName(body.HostName).
+		SetHostType(body.GetHostType()).
+		SetVenue(body.Venue).
+		SetStreet(body.Street).
+		SetLatLng(body.GetGeoPoint()).
+		SetSubdistrictID(body.SubdistrictID).
+		SetPmiLocationID(body.PMILocationID).
+		SetStartsAt(body.StartsAt).
+		SetEndsAt(body.EndsAt).
+		SetCapacity(body.Capacity).
+		SetStatus(donationevent.StatusPending).
+		ClearReviewedBy().
+		ClearReviewNote().
+		Save(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		return nil, errors.New("event not found or can no longer be changed")
+	}
+
+	return e.find(donationevent.IDEQ(id))
+}
+
+func (e *EventQuery) Cancel(
+	organizerID uuid.UUID,
+	id uuid.UUID,
+) (*ent.DonationEvent, error) {
+	n, err := e.client.DonationEvent.Update().
+		Where(
+			donationevent.IDEQ(id),
+			donationevent.HasOrganizerWith(account.IDEQ(organizerID)),
+			donationevent.StatusIn(
+				donationevent.StatusPending,
+				donationevent.StatusApproved,
+			),
+			donationevent.DeletedAtIsNil(),
+		).
+		SetStatus(donationevent.StatusCancelled).
+		Save(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		return nil, errors.New("event not found or can no longer be cancelled")
+	}
+
+	return e.find(donationevent.IDEQ(id))
+}
+
+func (e *EventQuery) Organizer(
+	organizerID uuid.UUID,
+	page response.Pagination,
+) ([]*ent.DonationEvent, int, error) {
+	return e.list(
+		page,
+		donationevent.HasOrganizerWith(account.IDEQ(organizerID)),
+	)
+}
+
+func (e *EventQuery) Location(
+	locationID uuid.UUID,
+	page response.Pagination,
+) ([]*ent.DonationEvent, int, error) {
+	return e.list(
+		page,
+		donationevent.HasPmiLocationWith(pmilocation.IDEQ(locationID)),
+	)
+}
+
+func (e *EventQuery) Approve(
+	staffID uuid.UUID,
+	id uuid.UUID,
+) (*ent.DonationEvent, error) {
+	return e.review(
+		id,
+		e.client.DonationEvent.Update().
+			SetStatus(donationevent.StatusApproved).
+			SetReviewedByID(staffID),
+	)
+}
+
+func (e *EventQuery) Reject(
+	staffID uuid.UUID,
+	id uuid.UUID,
+	body request.EventRejection,
+) (*ent.DonationEvent, error) {
+	return e.review(
+		id,
+		e.client.DonationEvent.Update().
+			SetStatus(donationevent.StatusRejected).
+			SetReviewedByID(staffID).
+			SetReviewNote(body.Reason),
+	)
+}
+
+// Discover lists approved events that have not ended, optionally within a
+// region and a date range.
+func (e *EventQuery) Discover(
+	filter request.EventFilter,
+) ([]*ent.DonationEvent, int, error) {
+	from := filter.From
+	if from == 0 {
+		from = time.Now().UnixMilli()
+	}
+
+	where := []predicate.DonationEvent{
+		donationevent.StatusEQ(donationevent.StatusApproved),
+		donationevent.EndsAtGTE(from),
+	}
+
+	if filter.To > 0 {
+		where = append(where, donationevent.StartsAtLTE(filter.To))
+	}
+
+	if filter.Region != "" {
+		if region.LevelOf(filter.Region) == region.LevelUnknown {
+			return nil, 0, errors.New("region must be a BPS code")
+		}
+
+		where = append(where, donationevent.HasSubdistrictWith(
+			subdistrict.BpsCodeHasPrefix(filter.Region),
+		))
+	}
+
+	if search := filter.GetSearch(); search != "" {
+		where = append(where, donationevent.Or(
+			donationevent.TitleContainsFold(search),
+			donationevent.HostNameContainsFold(search),
+			donationevent.VenueContainsFold(search),
+		))
+	}
+
+	return e.list(filter.Pagination, where...)
+}
+
+// Get returns an approved event, the only events visible to the public.
+func (e *EventQuery) Get(id uuid.UUID) (*ent.DonationEvent, error) {
+	return e.find(
+		donationevent.IDEQ(id),
+		donationevent.StatusEQ(donationevent.StatusApproved),
+	)
+}
+
+func (e *EventQuery) review(
+	id uuid.UUID,
+	update *ent.DonationEventUpdate,
+) (*ent.DonationEvent, error) {
+	n, err := update.
+		Where(
+			donationevent.IDEQ(id),
+			donationevent.StatusEQ(donationevent.StatusPending),
+			donationevent.DeletedAtIsNil(),
+		).
+		Save(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		return nil, errors.New("event not found or already reviewed")
+	}
+
+	return e.find(donationevent.IDEQ(id))
+}
+
+func (e *EventQuery) validate(body request.DonationEvent) error {
+	if body.StartsAt <= time.Now().UnixMilli() {
+		return errors.New("starts_at must be in the future")
+	}
+
+	exists, err := e.client.Subdistrict.Query().
+		Where(subdistrict.IDEQ(body.SubdistrictID)).
+		Exist(e.ctx)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.New("subdistrict not found")
+	}
+
+	exists, err = e.client.PMILocation.Query().
+		Where(
+			pmilocation.IDEQ(body.PMILocationID),
+			pmilocation.DeletedAtIsNil(),
+		).
+		Exist(e.ctx)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.New("PMI location not found")
+	}
+
+	return nil
+}
+
+func (e *EventQuery) list(
+	page response.Pagination,
+	where ...predicate.DonationEvent,
+) ([]*ent.DonationEvent, int, error) {
+	q := e.client.DonationEvent.Query().
+		Where(append(where, donationevent.DeletedAtIsNil())...)
+
+	total, err := q.Clone().Count(e.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := ent.Asc(donationevent.FieldStartsAt)
+	if page.GetSort() == "DESC" {
+		order = ent.Desc(donationevent.FieldStartsAt)
+	}
+
+	events, err := q.
+		WithSubdistrict().
+		WithPmiLocation().
+		Order(order).
+		Offset(page.GetOffset()).
+		Limit(page.GetLimit()).
+		All(e.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
+func (e *EventQuery) find(
+	where ...predicate.DonationEvent,
+) (*ent.DonationEvent, error) {
+	event, err := e.client.DonationEvent.Query().
+		Where(append(where, donationevent.DeletedAtIsNil())...).
+		WithSubdistrict().
+		WithPmiLocation().
+		Only(e.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("event not found")
+		}
+
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/hospital"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/rbac"
+)
+
+const hospitalStaffRole = "hospital-staff"
+
+type (
+	HospitalQuery struct {
+		scope
+		client *ent.Client
+		rbac   *rbac.Manager
+	}
+
+	Hospital interface {
+		Create(body request.Hospital) (*ent.Hospital, error)
+		AddMember(hospitalID uuid.UUID, body request.HospitalMember) error
+		Scoped[Hospital]
+	}
+)
+
+func NewHospital(i do.Injector) (Hospital, error) {
+	return &HospitalQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		rbac:   do.MustInvoke[*rbac.Manager](i),
+		scope:  newScope(),
+	}, nil
+}
+
+func (h *HospitalQuery) WithContext(ctx context.Context) Hospital {
+	return withContext(h, ctx)
+}
+
+func (h *HospitalQuery) Create(body request.Hospital) (*ent.Hospital, error) {
+	exists, err := h.client.Subdistrict.Query().
+		Where(subdistrict.IDEQ(body.SubdistrictID)).
+		Exist(h.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.New("subdistrict not found")
+	}
+
+	create := h.client.Hospital.Create().
+		SetCode(body.Code).
+		SetName(body.Name).
+		SetStreet(body.Street).
+		SetDialCode(body.DialCode).
+		SetPhoneNumber(body.PhoneNumber).
+		SetSubdistrictID(body.SubdistrictID)
+
+	if body.Email != "" {
+		create.SetEmail(body.Email)
+	}
+
+	return create.Save(h.ctx)
+}
+
+// AddMember links an activated account to the hospital and grants it the
+// hospital staff role on top of the role it already has, so a donor who
+// works at a hospital keeps the donor features.
+func (h *HospitalQuery) AddMember(
+	hospitalID uuid.UUID,
+	body request.HospitalMember,
+) error {
+	tx, err := h.client.Tx(h.ctx)
+	if err != nil {
+		return err
+	}
+
+	exists, err := tx.Hospital.Query().
+		Where(
+			hospital.IDEQ(hospitalID),
+			hospital.ActivatedEQ(true),
+			hospital.DeletedAtIsNil(),
+		).
+		Exist(h.ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	if !exists {
+		return rollback(tx, errors.New("hospital not found"))
+	}
+
+	n, err := tx.Account.Update().
+		Where(
+			account.IDEQ(body.AccountID),
+			account.ActivatedEQ(true),
+			account.DeletedAtIsNil(),
+		).
+		SetHospitalID(hospitalID).
+		Save(h.ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	if n == 0 {
+		return rollback(tx, errors.New("account not found"))
+	}
+
+	if err = grantRole(
+		h.ctx,
+		tx,
+		h.rbac,
+		body.AccountID,
+		hospitalStaffRole,
+	); err != nil {
+		return rollback(tx, err)
+	}
+
+	return h.rbac.Commit(tx)
+}
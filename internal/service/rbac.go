@@ -0,0 +1,951 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/audit"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/permission"
+	"github.com/sembraniteam/setetes/internal/ent/role"
+	"github.com/sembraniteam/setetes/internal/ent/roleassignment"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/rbac"
+)
+
+type (
+	// EffectivePermission is a permission a role grants, either attached to
+	// the role itself or inherited from one of its ancestors.
+	EffectivePermission struct {
+		Permission *ent.Permission
+		Role       *ent.Role
+		Inherited  bool
+	}
+
+	RBACQuery struct {
+		scope
+		client *ent.Client
+		rbac   *rbac.Manager
+	}
+
+	RBAC interface {
+		Roles(page response.Pagination) ([]*ent.Role, int, error)
+		Role(id uuid.UUID) (*ent.Role, error)
+		CreateRole(body request.Role) (*ent.Role, error)
+		UpdateRole(id uuid.UUID, body request.RoleUpdate) (*ent.Role, error)
+		DeleteRole(id uuid.UUID) error
+		AttachPermissions(
+			roleID uuid.UUID,
+			body request.RolePermissions,
+		) (*ent.Role, error)
+		DetachPermission(roleID, permissionID uuid.UUID) error
+		AddParent(roleID uuid.UUID, body request.RoleParent) (*ent.Role, error)
+		RemoveParent(roleID, parentID uuid.UUID) error
+		EffectivePermissions(roleID uuid.UUID) ([]EffectivePermission, error)
+		Permissions(page response.Pagination) ([]*ent.Permission, int, error)
+		Permission(id uuid.UUID) (*ent.Permission, error)
+		CreatePermission(body request.Permission) (*ent.Permission, error)
+		UpdatePermission(
+			id uuid.UUID,
+			body request.PermissionUpdate,
+		) (*ent.Permission, error)
+		DeletePermission(id uuid.UUID) error
+		Assignments(accountID uuid.UUID) ([]*ent.RoleAssignment, error)
+		Assign(
+			assignedBy uuid.UUID,
+			body request.RoleAssignment,
+		) (*ent.RoleAssignment, error)
+		Unassign(id uuid.UUID) error
+		Scoped[RBAC]
+	}
+)
+
+func NewRBAC(i do.Injector) (RBAC, error) {
+	return &RBACQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		rbac:   do.MustInvoke[*rbac.Manager](i),
+		scope:  newScope(),
+	}, nil
+}
+
+func (r *RBACQuery) WithContext(ctx context.Context) RBAC {
+	return withContext(r, ctx)
+}
+
+func (r *RBACQuery) Roles(
+	page response.Pagination,
+) ([]*ent.Role, int, error) {
+	q := r.client.Role.Query()
+	if search := page.GetSearch(); search != "" {
+		q.Where(role.Or(
+			role.NameContainsFold(search),
+			role.KeyContainsFold(search),
+		))
+	}
+
+	total, err := q.Clone().Count(r.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := ent.Asc(role.FieldKey)
+	if page.GetSort() == "DESC" {
+		order = ent.Desc(role.FieldKey)
+	}
+
+	roles, err := q.
+		Order(order).
+		Offset(page.GetOffset()).
+		Limit(page.GetLimit()).
+		All(r.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return roles, total, nil
+}
+
+func (r *RBACQuery) Role(id uuid.UUID) (*ent.Role, error) {
+	rl, err := r.client.Role.Query().
+		Where(role.IDEQ(id)).
+		WithPermissions(func(q *ent.PermissionQuery) {
+			q.Order(ent.Asc(permission.FieldKey))
+		}).
+		WithParent().
+		Only(r.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("role not found")
+		}
+
+		return nil, err
+	}
+
+	return rl, nil
+}
+
+// CreateRole creates a role without permissions. Casbin policies refer to a
+// role by its key, so the key must be unique across domains.
+func (r *RBACQuery) CreateRole(body request.Role) (*ent.Role, error) {
+	exists, err := r.client.Role.Query().
+		Where(role.KeyEQ(body.Key)).
+		Exist(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		return nil, errors.New("role key is already used")
+	}
+
+	create := r.client.Role.Create().
+		SetName(body.Name).
+		SetKey(body.Key).
+		SetDomain(body.Domain).
+		SetActivated(body.Activated)
+
+	if body.Description != "" {
+		create.SetDescription(body.Description)
+	}
+
+	return create.Save(r.ctx)
+}
+
+// UpdateRole changes the role details. Deactivating a role removes its
+// policies and the links to its parents and keeps its assignments,
+// activating it adds them again.
+func (r *RBACQuery) UpdateRole(
+	id uuid.UUID,
+	body request.RoleUpdate,
+) (*ent.Role, error) {
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rl, err := tx.Role.Query().
+		Where(role.IDEQ(id)).
+		WithPermissions().
+		WithParent().
+		Only(r.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, errors.New("role not found"))
+		}
+
+		return nil, rollback(tx, err)
+	}
+
+	update := tx.Role.UpdateOne(rl).
+		SetName(body.Name).
+		SetActivated(*body.Activated)
+
+	if body.Description != "" {
+		update.SetDescription(body.Description)
+	} else {
+		update.ClearDescription()
+	}
+
+	updated, err := update.Save(r.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	policies := r.rbac.Policies(r.ctx, tx)
+	switch {
+	case rl.Activated && !updated.Activated:
+		err = policies.RemovePolicies(rl.Key)
+	case !rl.Activated && updated.Activated:
+		err = r.restore(policies, rl)
+	default:
+	}
+
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = r.rbac.Commit(tx); err != nil {
+		return nil, err
+	}
+
+	updated.Edges.Permissions = rl.Edges.Permissions
+	updated.Edges.Parent = rl.Edges.Parent
+
+	return updated, nil
+}
+
+// DeleteRole deletes the role with its permission links, assignments and
+// policies.
+func (r *RBACQuery) DeleteRole(id uuid.UUID) error {
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	rl, err := tx.Role.Get(r.ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(tx, errors.New("role not found"))
+		}
+
+		return rollback(tx, err)
+	}
+
+	if _, err = tx.Account.Update().
+		Where(account.HasRoleWith(role.IDEQ(rl.ID))).
+		ClearRole().
+		Save(r.ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err = r.rbac.Policies(r.ctx, tx).RemoveRole(rl.Key); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err = tx.Role.DeleteOne(rl).Exec(r.ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	return r.rbac.Commit(tx)
+}
+
+// AttachPermissions links the permissions to the role and, when the role is
+// activated, adds their policies.
+func (r *RBACQuery) AttachPermissions(
+	roleID uuid.UUID,
+	body request.RolePermissions,
+) (*ent.Role, error) {
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rl, err := tx.Role.Get(r.ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, errors.New("role not found"))
+		}
+
+		return nil, rollback(tx, err)
+	}
+
+	perms, err := tx.Permission.Query().
+		Where(
+			permission.IDIn(body.PermissionIDs...),
+			permission.Not(permission.HasRolesWith(role.IDEQ(rl.ID))),
+		).
+		All(r.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	attached, err := tx.Permission.Query().
+		Where(
+			permission.IDIn(body.PermissionIDs...),
+			permission.HasRolesWith(role.IDEQ(rl.ID)),
+		).
+		Count(r.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if len(perms)+attached != len(uniqueIDs(body.PermissionIDs)) {
+		return nil, rollback(tx, errors.New("permission not found"))
+	}
+
+	if len(perms) > 0 {
+		if err = tx.Role.UpdateOne(rl).
+			AddPermissions(perms...).
+			Exec(r.ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if rl.Activated {
+		policies := r.rbac.Policies(r.ctx, tx)
+		for _, p := range perms {
+			if err = policies.AddPolicy(
+				rl.Key,
+				p.Domain,
+				p.Resource,
+				p.Action,
+			); err != nil {
+				return nil, rollback(tx, err)
+			}
+		}
+	}
+
+	if err = r.rbac.Commit(tx); err != nil {
+		return nil, err
+	}
+
+	return r.Role(rl.ID)
+}
+
+func (r *RBACQuery) DetachPermission(roleID, permissionID uuid.UUID) error {
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	rl, err := tx.Role.Get(r.ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(tx, errors.New("role not found"))
+		}
+
+		return rollback(tx, err)
+	}
+
+	perm, err := tx.Permission.Query().
+		Where(
+			permission.IDEQ(permissionID),
+			permission.HasRolesWith(role.IDEQ(rl.ID)),
+		).
+		Only(r.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(
+				tx,
+				errors.New("permission is not attached to the role"),
+			)
+		}
+
+		return rollback(tx, err)
+	}
+
+	if err = tx.Role.UpdateOne(rl).
+		RemovePermissions(perm).
+		Exec(r.ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err = r.revoke(tx, rl, perm); err != nil {
+		return rollback(tx, err)
+	}
+
+	return r.rbac.Commit(tx)
+}
+
+// AddParent lets the role inherit the permissions of the parent role. A
+// parent may not be the role itself or one of its descendants.
+func (r *RBACQuery) AddParent(
+	roleID uuid.UUID,
+	body request.RoleParent,
+) (*ent.Role, error) {
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rl, err := tx.Role.Get(r.ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, errors.New("role not found"))
+		}
+
+		return nil, rollback(tx, err)
+	}
+
+	parent, err := tx.Role.Get(r.ctx, body.ParentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, errors.New("parent role not found"))
+		}
+
+		return nil, rollback(tx, err)
+	}
+
+	ancestors, err := r.ancestors(tx, parent.ID)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if parent.ID == rl.ID || ancestors[rl.ID] {
+		return nil, rollback(
+			tx,
+			errors.New("parent role would make the role inherit itself"),
+		)
+	}
+
+	exists, err := tx.Role.Query().
+		Where(role.IDEQ(rl.ID), role.HasParentWith(role.IDEQ(parent.ID))).
+		Exist(r.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if !exists {
+		if err = tx.Role.UpdateOne(rl).
+			AddParent(parent).
+			Exec(r.ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if rl.Activated {
+		if err = r.rbac.Policies(r.ctx, tx).
+			AddParent(rl.Key, parent.Key); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err = r.rbac.Commit(tx); err != nil {
+		return nil, err
+	}
+
+	return r.Role(rl.ID)
+}
+
+func (r *RBACQuery) RemoveParent(roleID, parentID uuid.UUID) error {
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	rl, err := tx.Role.Get(r.ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(tx, errors.New("role not found"))
+		}
+
+		return rollback(tx, err)
+	}
+
+	parent, err := rl.QueryParent().
+		Where(role.IDEQ(parentID)).
+		Only(r.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(
+				tx,
+				errors.New("role is not a parent of the role"),
+			)
+		}
+
+		return rollback(tx, err)
+	}
+
+	if err = tx.Role.UpdateOne(rl).
+		RemoveParent(parent).
+		Exec(r.ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err = r.rbac.Policies(r.ctx, tx).
+		RemoveParent(rl.Key, parent.Key); err != nil {
+		return rollback(tx, err)
+	}
+
+	return r.rbac.Commit(tx)
+}
+
+// EffectivePermissions returns the permissions of the role and of its
+// activated ancestors, as the enforcer sees them. A permission reachable
+// through several roles is reported once, for the nearest role. A
+// deactivated role grants nothing.
+func (r *RBACQuery) EffectivePermissions(
+	roleID uuid.UUID,
+) ([]EffectivePermission, error) {
+	rl, err := r.client.Role.Get(r.ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("role not found")
+		}
+
+		return nil, err
+	}
+
+	out := make([]EffectivePermission, 0)
+	if !rl.Activated {
+		return out, nil
+	}
+
+	seen := map[uuid.UUID]bool{rl.ID: true}
+	granted := make(map[uuid.UUID]bool)
+	level := []*ent.Role{rl}
+	for len(level) > 0 {
+		next := make([]*ent.Role, 0)
+		for _, current := range level {
+			perms, err := current.QueryPermissions().
+				Order(ent.Asc(permission.FieldKey)).
+				All(r.ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, p := range perms {
+				if granted[p.ID] {
+					continue
+				}
+
+				granted[p.ID] = true
+				out = append(out, EffectivePermission{
+					Permission: p,
+					Role:       current,
+					Inherited:  current.ID != rl.ID,
+				})
+			}
+
+			parents, err := current.QueryParent().
+				Where(role.ActivatedEQ(true)).
+				All(r.ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, p := range parents {
+				if !seen[p.ID] {
+					seen[p.ID] = true
+					next = append(next, p)
+				}
+			}
+		}
+
+		level = next
+	}
+
+	return out, nil
+}
+
+func (r *RBACQuery) Permissions(
+	page response.Pagination,
+) ([]*ent.Permission, int, error) {
+	q := r.client.Permission.Query()
+	if search := page.GetSearch(); search != "" {
+		q.Where(permission.Or(
+			permission.NameContainsFold(search),
+			permission.KeyContainsFold(search),
+			permission.ResourceContainsFold(search),
+		))
+	}
+
+	total, err := q.Clone().Count(r.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := ent.Asc(permission.FieldKey)
+	if page.GetSort() == "DESC" {
+		order = ent.Desc(permission.FieldKey)
+	}
+
+	perms, err := q.
+		Order(order).
+		Offset(page.GetOffset()).
+		Limit(page.GetLimit()).
+		All(r.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return perms, total, nil
+}
+
+func (r *RBACQuery) Permission(id uuid.UUID) (*ent.Permission, error) {
+	perm, err := r.client.Permission.Get(r.ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("permission not found")
+		}
+
+		return nil, err
+	}
+
+	return perm, nil
+}
+
+// CreatePermission creates a permission. A condition must name one of the
+// conditions registered with the enforcer.
+func (r *RBACQuery) CreatePermission(
+	body request.Permission,
+) (*ent.Permission, error) {
+	if err := r.validateCondition(body.Condition); err != nil {
+		return nil, err
+	}
+
+	create := r.client.Permission.Create().
+		SetName(body.Name).
+		SetKey(body.Key).
+		SetDomain(body.Domain).
+		SetResource(body.Resource).
+		SetAction(body.Action)
+
+	if body.Condition != "" {
+		create.SetCondition(body.Condition)
+	}
+
+	if body.Description != "" {
+		create.SetDescription(body.Description)
+	}
+
+	return create.Save(r.ctx)
+}
+
+// UpdatePermission changes the permission and moves the policies of the
+// activated roles it is attached to onto the new resource and action.
+func (r *RBACQuery) UpdatePermission(
+	id uuid.UUID,
+	body request.PermissionUpdate,
+) (*ent.Permission, error) {
+	if err := r.validateCondition(body.Condition); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perm, err := tx.Permission.Query().
+		Where(permission.IDEQ(id)).
+		WithRoles(func(q *ent.RoleQuery) {
+			q.Where(role.ActivatedEQ(true))
+		}).
+		Only(r.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, errors.New("permission not found"))
+		}
+
+		return nil, rollback(tx, err)
+	}
+
+	update := tx.Permission.UpdateOne(perm).
+		SetName(body.Name).
+		SetResource(body.Resource).
+		SetAction(body.Action)
+
+	if body.Condition != "" {
+		update.SetCondition(body.Condition)
+	} else {
+		update.ClearCondition()
+	}
+
+	if body.Description != "" {
+		update.SetDescription(body.Description)
+	} else {
+		update.ClearDescription()
+	}
+
+	updated, err := update.Save(r.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	policies := r.rbac.Policies(r.ctx, tx)
+	for _, rl := range perm.Edges.Roles {
+		if err = r.revoke(tx, rl, perm); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		if err = policies.AddPolicy(
+			rl.Key,
+			updated.Domain,
+			updated.Resource,
+			updated.Action,
+		); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err = r.rbac.Commit(tx); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeletePermission deletes the permission and removes its policies from the
+// roles it is attached to.
+func (r *RBACQuery) DeletePermission(id uuid.UUID) error {
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	perm, err := tx.Permission.Query().
+		Where(permission.IDEQ(id)).
+		WithRoles(func(q *ent.RoleQuery) {
+			q.Where(role.ActivatedEQ(true))
+		}).
+		Only(r.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(tx, errors.New("permission not found"))
+		}
+
+		return rollback(tx, err)
+	}
+
+	if err = tx.Permission.DeleteOne(perm).Exec(r.ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	for _, rl := range perm.Edges.Roles {
+		if err = r.revoke(tx, rl, perm); err != nil {
+			return rollback(tx, err)
+		}
+	}
+
+	return r.rbac.Commit(tx)
+}
+
+func (r *RBACQuery) Assignments(
+	accountID uuid.UUID,
+) ([]*ent.RoleAssignment, error) {
+	return r.client.RoleAssignment.Query().
+		Where(roleassignment.HasAccountWith(account.IDEQ(accountID))).
+		WithRole().
+		Order(ent.Asc(roleassignment.FieldCreatedAt)).
+		All(r.ctx)
+}
+
+// Assign grants an activated role to an account within a domain, which
+// defaults to the domain of the role.
+func (r *RBACQuery) Assign(
+	assignedBy uuid.UUID,
+	body request.RoleAssignment,
+) (*ent.RoleAssignment, error) {
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := tx.Account.Query().
+		Where(
+			account.IDEQ(body.AccountID),
+			account.ActivatedEQ(true),
+			account.DeletedAtIsNil(),
+		).
+		Exist(r.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if !exists {
+		return nil, rollback(tx, errors.New("account not found"))
+	}
+
+	rl, err := tx.Role.Query().
+		Where(role.IDEQ(body.RoleID), role.ActivatedEQ(true)).
+		Only(r.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, errors.New("role not found"))
+		}
+
+		return nil, rollback(tx, err)
+	}
+
+	domain := body.Domain
+	if domain == "" {
+		domain = rl.Domain
+	}
+
+	if err = assignRole(
+		r.ctx,
+		tx,
+		r.rbac,
+		body.AccountID,
+		rl,
+		domain,
+		&assignedBy,
+	); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = r.rbac.Commit(tx); err != nil {
+		return nil, err
+	}
+
+	return r.client.RoleAssignment.Query().
+		Where(
+			roleassignment.DomainEQ(domain),
+			roleassignment.HasAccountWith(account.IDEQ(body.AccountID)),
+			roleassignment.HasRoleWith(role.IDEQ(rl.ID)),
+		).
+		WithRole().
+		Only(r.ctx)
+}
+
+// Unassign removes the assignment and its grouping policy.
+func (r *RBACQuery) Unassign(id uuid.UUID) error {
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	a, err := tx.RoleAssignment.Query().
+		Where(roleassignment.IDEQ(id)).
+		WithAccount().
+		WithRole().
+		Only(r.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(tx, errors.New("role assignment not found"))
+		}
+
+		return rollback(tx, err)
+	}
+
+	if err = tx.RoleAssignment.DeleteOne(a).Exec(r.ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err = audit.Record(r.ctx, tx.Client(), audit.Entry{
+		Action:   audit.ActionRoleUnassign,
+		Entity:   ent.TypeAccount,
+		EntityID: a.Edges.Account.ID.String(),
+		Before:   map[string]any{"role": a.Edges.Role.Key, "domain": a.Domain},
+	}); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err = r.rbac.Policies(r.ctx, tx).RemoveRoleForUser(
+		a.Edges.Account.ID.String(),
+		a.Edges.Role.Key,
+		a.Domain,
+	); err != nil {
+		return rollback(tx, err)
+	}
+
+	return r.rbac.Commit(tx)
+}
+
+func (r *RBACQuery) validateCondition(name string) error {
+	if name != "" && !r.rbac.HasCondition(name) {
+		return fmt.Errorf("condition %q does not exist", name)
+	}
+
+	return nil
+}
+
+// restore adds the policies of the permissions of the role and the links to
+// its parents.
+func (r *RBACQuery) restore(policies *rbac.Policies, rl *ent.Role) error {
+	for _, p := range rl.Edges.Permissions {
+		if err := policies.AddPolicy(
+			rl.Key,
+			p.Domain,
+			p.Resource,
+			p.Action,
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, parent := range rl.Edges.Parent {
+		if err := policies.AddParent(rl.Key, parent.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ancestors returns the IDs of every role the role inherits from, directly
+// or through its parents.
+func (r *RBACQuery) ancestors(
+	tx *ent.Tx,
+	id uuid.UUID,
+) (map[uuid.UUID]bool, error) {
+	out := make(map[uuid.UUID]bool)
+	level := []uuid.UUID{id}
+	for len(level) > 0 {
+		parents, err := tx.Role.Query().
+			Where(role.HasChildrenWith(role.IDIn(level...))).
+			IDs(r.ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		level = level[:0]
+		for _, p := range parents {
+			if !out[p] {
+				out[p] = true
+				level = append(level, p)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// revoke removes the policy of the permission from the role unless another
+// permission of the role grants the same rule.
+func (r *RBACQuery) revoke(
+	tx *ent.Tx,
+	rl *ent.Role,
+	perm *ent.Permission,
+) error {
+	shared, err := tx.Permission.Query().
+		Where(
+			permission.IDNEQ(perm.ID),
+			permission.DomainEQ(perm.Domain),
+			permission.ResourceEQ(perm.Resource),
+			permission.ActionEQ(perm.Action),
+			permission.HasRolesWith(role.IDEQ(rl.ID)),
+		).
+		Exist(r.ctx)
+	if err != nil || shared {
+		return err
+	}
+
+	return r.rbac.Policies(r.ctx, tx).
+		RemovePolicy(rl.Key, perm.Domain, perm.Resource, perm.Action)
+}
+
+func uniqueIDs(ids []uuid.UUID) map[uuid.UUID]bool {
+	out := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		out[id] = true
+	}
+
+	return out
+}
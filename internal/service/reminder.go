@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/eligibility"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/notificationpreference"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/notify"
+	"github.com/sembraniteam/setetes/internal/reminder"
+)
+
+const reminderDateLayout = "2 January 2006"
+
+type (
+	ReminderQuery struct {
+		scope
+		client *ent.Client
+		sender notify.Sender
+		log    *slog.Logger
+	}
+
+	Reminder interface {
+		Preference(accountID uuid.UUID) (*ent.NotificationPreference, error)
+		SetPreference(
+			accountID uuid.UUID,
+			body request.NotificationPreference,
+		) (*ent.NotificationPreference, error)
+		Send(now time.Time) error
+		Scoped[Reminder]
+	}
+)
+
+func NewReminder(i do.Injector) (Reminder, error) {
+	return &ReminderQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		sender: do.MustInvoke[notify.Sender](i),
+		scope:  newScope(),
+		log:    slog.Default(),
+	}, nil
+}
+
+func (r *ReminderQuery) WithContext(ctx context.Context) Reminder {
+	return withContext(r, ctx)
+}
+
+// Preference returns the stored preference of the account, or the defaults
+// when the account never changed them.
+func (r *ReminderQuery) Preference(
+	accountID uuid.UUID,
+) (*ent.NotificationPreference, error) {
+	pref, err := r.client.NotificationPreference.Query().
+		Where(notificationpreference.HasAccountWith(account.IDEQ(accountID))).
+		Only(r.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return defaultPreference(), nil
+		}
+
+		return nil, err
+	}
+
+	return pref, nil
+}
+
+func (r *ReminderQuery) SetPreference(
+	accountID uuid.UUID,
+	body request.NotificationPreference,
+) (*ent.NotificationPreference, error) {
+	pref, err := r.client.NotificationPreference.Query().
+		Where(notificationpreference.HasAccountWith(account.IDEQ(accountID))).
+		Only(r.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if pref == nil {
+		return r.client.NotificationPreference.Create().
+			SetAccountID(accountID).
+			SetRemindersEnabled(*body.RemindersEnabled).
+			SetPushEnabled(*body.PushEnabled).
+			SetEmailEnabled(*body.EmailEnabled).
+			SetSmsEnabled(*body.SMSEnabled).
+			SetPushToken(body.PushToken).
+			SetTimezone(body.Timezone).
+			SetQuietHoursStart(body.QuietHoursStart).
+			SetQuietHoursEnd(body.QuietHoursEnd).
+			Save(r.ctx)
+	}
+
+	return pref.Update().
+		SetRemindersEnabled(*body.RemindersEnabled).
+		SetPushEnabled(*body.PushEnabled).
+		SetEmailEnabled(*body.EmailEnabled).
+		SetSmsEnabled(*body.SMSEnabled).
+		SetPushToken(body.PushToken).
+		SetTimezone(body.Timezone).
+		SetQuietHoursStart(body.QuietHoursStart).
+		SetQuietHoursEnd(body.QuietHoursEnd).
+		Save(r.ctx)
+}
+
+// Send reminds donors whose latest donation has not been followed by a
+// reminder yet and who become eligible again within the configured number
+// of days. Only donations whose minimum interval ends within that many days
+// of now are loaded, so a donor eligible for longer is not reminded anymore.
+// Donors in their quiet hours are tried again on a later run.
+func (r *ReminderQuery) Send(now time.Time) error {
+	rules := eligibility.DefaultRules()
+	daysBefore := reminder.DaysBefore()
+	lead := reminder.Lead(daysBefore)
+
+	due := make([]predicate.Donation, 0, len(rules.Intervals))
+	for t, interval := range rules.Intervals {
+		eligibleAt := now.Add(-interval)
+		due = append(due, donation.And(
+			donation.TypeEQ(t),
+			donation.DonatedAtGTE(eligibleAt.Add(-lead).UnixMilli()),
+			donation.DonatedAtLTE(eligibleAt.Add(lead).UnixMilli()),
+		))
+	}
+
+	donations, err := r.client.Donation.Query().
+		Where(
+			donation.DeletedAtIsNil(),
+			donation.Or(due...),
+			donation.Not(donation.HasReminder()),
+			donation.HasAccountWith(
+				account.ActivatedEQ(true),
+				account.DeletedAtIsNil(),
+			),
+		).
+		WithAccount(func(q *ent.AccountQuery) {
+			withEligibilityHistory(q.WithNotificationPreference(), now)
+		}).
+		Order(ent.Desc(donation.FieldDonatedAt)).
+		All(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for _, d := range donations {
+		acc := d.Edges.Account
+		if seen[acc.ID] {
+			continue
+		}
+		seen[acc.ID] = true
+
+		if !latest(acc, d) {
+			continue
+		}
+
+		if err = r.remind(d, rules, now, daysBefore); err != nil {
+			r.log.Error(
+				"send donation reminder failed",
+				slog.String("account_id", acc.ID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (r *ReminderQuery) remind(
+	d *ent.Donation,
+	rules eligibility.Rules,
+	now time.Time,
+	daysBefore int,
+) error {
+	acc := d.Edges.Account
+	pref := acc.Edges.NotificationPreference
+	if pref == nil {
+		pref = defaultPreference()
+	}
+
+	if !pref.RemindersEnabled {
+		return nil
+	}
+
+	res := evaluateEligibility(rules, acc, d.Type, now)
+	if res.Permanent {
+		return nil
+	}
+
+	eligibleAt := now
+	if !res.Eligible {
+		eligibleAt = *res.NextEligibleAt
+		if !reminder.Due(eligibleAt, now, daysBefore) {
+			return nil
+		}
+	}
+
+	loc := reminder.Location(pref.Timezone)
+	if reminder.Quiet(now, loc, pref.QuietHoursStart, pref.QuietHoursEnd) {
+		return nil
+	}
+
+	body := "You may donate blood again today. Thank you for saving lives."
+	if eligibleAt.After(now) {
+		body = fmt.Sprintf(
+			"You may donate blood again from %s. Thank you for saving lives.",
+			eligibleAt.In(loc).Format(reminderDateLayout),
+		)
+	}
+
+	tx, err := r.client.Tx(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	// The donation is locked until the reminder is recorded, so when the
+	// job runs on several instances at once the others wait and then find
+	// the donor reminded already.
+	if _, err = tx.Donation.Query().
+		Where(
+			donation.IDEQ(d.ID),
+			donation.Not(donation.HasReminder()),
+			forUpdate[predicate.Donation](),
+		).
+		Only(r.ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return tx.Rollback()
+		}
+
+		return rollback(tx, err)
+	}
+
+	to := recipients(acc, pref)
+	channels := make([]string, 0, len(to))
+	for c, addr := range to {
+		if err := r.sender.Send(r.ctx, notify.Message{
+			AccountID: acc.ID,
+			Channel:   c,
+			To:        addr,
+			Subject:   "You can donate again soon",
+			Body:      body,
+		}); err != nil {
+			r.log.Error(
+				"notify donor failed",
+				slog.String("account_id", acc.ID.String()),
+				slog.String("channel", string(c)),
+				slog.Any("error", err),
+			)
+
+			continue
+		}
+
+		channels = append(channels, string(c))
+	}
+
+	// Nothing is recorded when every channel failed, so the reminder is
+	// retried on the next run.
+	if len(channels) == 0 {
+		return tx.Rollback()
+	}
+
+	if err = tx.DonationReminder.Create().
+		SetAccountID(acc.ID).
+		SetDonationID(d.ID).
+		SetEligibleAt(eligibleAt.UnixMilli()).
+		SetSentAt(now.UnixMilli()).
+		SetChannels(channels).
+		Exec(r.ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// latest reports whether d is the latest donation of the account, which was
+// loaded withEligibilityHistory, so a donor who donated again is reminded of
+// the new donation only.
+func latest(acc *ent.Account, d *ent.Donation) bool {
+	for _, other := range acc.Edges.Donations {
+		if other.DonatedAt > d.DonatedAt {
+			return false
+		}
+	}
+
+	return true
+}
+
+// recipients returns the address of the account on every channel it enabled
+// and can be reached on.
+func recipients(
+	acc *ent.Account,
+	pref *ent.NotificationPreference,
+) map[notify.Channel]string {
+	out := make(map[notify.Channel]string)
+	if pref.PushEnabled && pref.PushToken != "" {
+		out[notify.ChannelPush] = pref.PushToken
+	}
+
+	if pref.EmailEnabled {
+		out[notify.ChannelEmail] = acc.Email
+	}
+
+	if pref.SmsEnabled {
+		out[notify.ChannelSMS] = "+" + acc.DialCode +
+			strings.TrimPrefix(acc.PhoneNumber, "0")
+	}
+
+	return out
+}
+
+func defaultPreference() *ent.NotificationPreference {
+	return &ent.NotificationPreference{
+		RemindersEnabled: notificationpreference.DefaultRemindersEnabled,
+		PushEnabled:      notificationpreference.DefaultPushEnabled,
+		EmailEnabled:     notificationpreference.DefaultEmailEnabled,
+		SmsEnabled:       notificationpreference.DefaultSmsEnabled,
+		Timezone:         notificationpreference.DefaultTimezone,
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import "context"
+
+type (
+	// Scoped is a service that can be bound to the context of its caller.
+	Scoped[S any] interface {
+		// WithContext returns a copy of the service that runs its queries in
+		// ctx, such as the context of the request being served, so they end
+		// with the request and the audit log attributes their changes to its
+		// actor.
+		WithContext(ctx context.Context) S
+	}
+
+	// scope holds the context the queries of a service run in. Every
+	// service embeds it and implements WithContext with withContext.
+	scope struct {
+		ctx context.Context
+	}
+)
+
+func newScope() scope {
+	return scope{ctx: context.Background()}
+}
+
+func (s *scope) bind(ctx context.Context) {
+	s.ctx = ctx
+}
+
+// withContext returns a copy of the service q bound to ctx.
+func withContext[Q any, P interface {
+	*Q
+	bind(ctx context.Context)
+}](q P, ctx context.Context) P {
+	c := *q
+	P(&c).bind(ctx)
+
+	return &c
+}
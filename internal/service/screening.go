@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/cryptox"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/account"
+	"github.com/sembraniteam/setetes/internal/ent/appointment"
+	"github.com/sembraniteam/setetes/internal/ent/deferral"
+	"github.com/sembraniteam/setetes/internal/ent/questionnaire"
+	"github.com/sembraniteam/setetes/internal/ent/screeningquestion"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/screening"
+)
+
+const day = time.Hour * 24
+
+type (
+	ScreeningQuery struct {
+		scope
+		client  *ent.Client
+		keypair *cryptox.Keypair
+	}
+
+	Screening interface {
+		Active() (*ent.Questionnaire, error)
+		CreateQuestionnaire(
+			body request.Questionnaire,
+		) (*ent.Questionnaire, error)
+		Activate(id uuid.UUID) error
+		Submit(
+			accountID uuid.UUID,
+			body request.ScreeningSubmission,
+		) (*ent.ScreeningSubmission, error)
+		Scoped[Screening]
+	}
+)
+
+func NewScreening(i do.Injector) (Screening, error) {
+	return &ScreeningQuery{
+		client:  do.MustInvoke[*ent.Client](i),
+		keypair: do.MustInvoke[*cryptox.Keypair](i),
+		scope:   newScope(),
+	}, nil
+}
+
+func (s *ScreeningQuery) WithContext(ctx context.Context) Screening {
+	return withContext(s, ctx)
+}
+
+func (s *ScreeningQuery) Active() (*ent.Questionnaire, error) {
+	q, err := s.client.Questionnaire.Query().
+		Where(
+			questionnaire.ActivatedEQ(true),
+			questionnaire.DeletedAtIsNil(),
+		).
+		WithQuestions(func(q *ent.ScreeningQuestionQuery) {
+			q.Order(ent.Asc(screeningquestion.FieldPosition))
+		}).
+		Only(s.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("no active questionnaire")
+		}
+
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (s *ScreeningQuery) CreateQuestionnaire(
+	body request.Questionnaire,
+) (*ent.Questionnaire, error) {
+	keys := make(map[string]bool, len(body.Questions))
+	for _, q := range body.Questions {
+		if keys[q.Key] {
+			return nil, fmt.Errorf("question key %q is duplicated", q.Key)
+		}
+		keys[q.Key] = true
+
+		if err := validateQuestion(q); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := tx.Questionnaire.Query().
+		Order(ent.Desc(questionnaire.FieldVersion)).
+		First(s.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, rollback(tx, err)
+	}
+
+	version := 1
+	if latest != nil {
+		version = latest.Version + 1
+	}
+
+	qn, err := tx.Questionnaire.Create().
+		SetVersion(version).
+		SetTitle(body.Title).
+		Save(s.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	builders := make([]*ent.ScreeningQuestionCreate, 0, len(body.Questions))
+	for i, q := range body.Questions {
+		b := tx.ScreeningQuestion.Create().
+			SetQuestionnaire(qn).
+			SetKey(q.Key).
+			SetPosition(int16(i)). // #nosec G115 -- bounded by request size
+			SetText(q.Text).
+			SetAnswerType(q.GetAnswerType()).
+			SetOptions(q.Options).
+			SetRequired(!q.Optional).
+			SetDeferralAnswers(q.DeferralAnswers)
+
+		if len(q.DeferralAnswers) > 0 {
+			b.SetDeferralType(q.GetDeferralType()).
+				SetDeferralReason(q.DeferralReason)
+			if q.DeferralDays > 0 {
+				b.SetDeferralDays(q.DeferralDays)
+			}
+		}
+
+		builders = append(builders, b)
+	}
+
+	questions, err := tx.ScreeningQuestion.CreateBulk(builders...).
+		Save(s.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	qn.Edges.Questions = questions
+
+	return qn, nil
+}
+
+func (s *ScreeningQuery) Activate(id uuid.UUID) error {
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Questionnaire.Update().
+		Where(questionnaire.ActivatedEQ(true)).
+		SetActivated(false).
+		Save(s.ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	n, err := tx.Questionnaire.Update().
+		Where(questionnaire.IDEQ(id), questionnaire.DeletedAtIsNil()).
+		SetActivated(true).
+		Save(s.ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	if n == 0 {
+		return rollback(tx, errors.New("questionnaire not found"))
+	}
+
+	return tx.Commit()
+}
+
+func (s *ScreeningQuery) Submit(
+	accountID uuid.UUID,
+	body request.ScreeningSubmission,
+) (*ent.ScreeningSubmission, error) {
+	qn, err := s.client.Questionnaire.Query().
+		Where(
+			questionnaire.IDEQ(body.QuestionnaireID),
+			questionnaire.ActivatedEQ(true),
+			questionnaire.DeletedAtIsNil(),
+		).
+		WithQuestions().
+		Only(s.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("questionnaire is not active")
+		}
+
+		return nil, err
+	}
+
+	answers := screening.Normalize(qn.Edges.Questions, body.Answers)
+	triggers, err := screening.Evaluate(qn.Edges.Questions, answers)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	hash, signature, err := s.sign(accountID, qn.ID, answers, now)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := tx.ScreeningSubmission.Create().
+		SetAccountID(accountID).
+		SetQuestionnaire(qn).
+		SetAnswers(answers).
+		SetDeferred(len(triggers) > 0).
+		SetSignedAt(now.UnixMilli()).
+		SetPayloadHash(hash).
+		SetSignature(signature)
+
+	if body.AppointmentID != nil {
+		exists, err := tx.Appointment.Query().
+			Where(
+				appointment.IDEQ(*body.AppointmentID),
+				appointment.HasAccountWith(account.IDEQ(accountID)),
+				appointment.StatusEQ(appointment.StatusBooked),
+			).
+			Exist(s.ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		if !exists {
+			return nil, rollback(tx, errors.New("appointment not found"))
+		}
+
+		create.SetAppointmentID(*body.AppointmentID)
+	}
+
+	submission, err := create.Save(s.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	deferrals := make([]*ent.DeferralCreate, 0, len(triggers))
+	for _, t := range triggers {
+		d := tx.Deferral.Create().
+			SetAccountID(accountID).
+			SetScreening(submission).
+			SetReason(t.Question.DeferralReason).
+			SetStartsAt(now.UnixMilli())
+
+		if t.Question.DeferralType == screeningquestion.DeferralTypePermanent {
+			d.SetType(deferral.TypePermanent)
+		} else {
+			days := time.Duration(t.Question.DeferralDays) * day
+			d.SetType(deferral.TypeTemporary).
+				SetEndsAt(now.Add(days).UnixMilli())
+		}
+
+		deferrals = append(deferrals, d)
+	}
+
+	if len(deferrals) > 0 {
+		created, err := tx.Deferral.CreateBulk(deferrals...).Save(s.ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		submission.Edges.Deferrals = created
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return submission, nil
+}
+
+// sign hashes the canonical submission payload and signs the hash with the
+// server key, so any later change to the stored answers is detectable.
+func (s *ScreeningQuery) sign(
+	accountID uuid.UUID,
+	questionnaireID uuid.UUID,
+	answers map[string]string,
+	signedAt time.Time,
+) (string, string, error) {
+	// encoding/json writes map keys in sorted order, which keeps the payload
+	// canonical.
+	encoded, err := json.Marshal(answers)
+	if err != nil {
+		return "", "", err
+	}
+
+	hash := cryptox.Sha256(fmt.Sprintf(
+		"%s|%s|%d|%s",
+		accountID,
+		questionnaireID,
+		signedAt.UnixMilli(),
+		encoded,
+	))
+	signature := s.keypair.Sign([]byte(hash))
+
+	return hash, base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func validateQuestion(q request.Question) error {
+	if len(q.DeferralAnswers) == 0 {
+		return nil
+	}
+
+	if q.GetDeferralType() == screeningquestion.DeferralTypeTemporary &&
+		q.DeferralDays <= 0 {
+		return fmt.Errorf(
+			"question %q needs deferral_days for a temporary deferral",
+			q.Key,
+		)
+	}
+
+	switch q.GetAnswerType() {
+	case screeningquestion.AnswerTypeYesNo:
+		for _, a := range q.DeferralAnswers {
+			if a != screening.AnswerYes && a != screening.AnswerNo {
+				return fmt.Errorf(
+					"question %q deferral answers must be YES or NO",
+					q.Key,
+				)
+			}
+		}
+	case screeningquestion.AnswerTypeChoice:
+		for _, a := range q.DeferralAnswers {
+			if !slices.Contains(q.Options, a) {
+				return fmt.Errorf(
+					"question %q deferral answer %q is not an option",
+					q.Key,
+					a,
+				)
+			}
+		}
+	default:
+	}
+
+	return nil
+}
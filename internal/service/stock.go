@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodtype"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/stockmovement"
+	"github.com/sembraniteam/setetes/internal/ent/subdistrict"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/httpx/response"
+	"github.com/sembraniteam/setetes/internal/region"
+)
+
+var errInsufficientStock = errors.New("insufficient stock")
+
+type (
+	StockQuery struct {
+		scope
+		client *ent.Client
+	}
+
+	Stock interface {
+		Move(
+			staffID uuid.UUID,
+			body request.StockMovement,
+		) ([]*ent.StockMovement, error)
+		Location(locationID uuid.UUID) ([]*ent.BloodStock, error)
+		Movements(
+			locationID uuid.UUID,
+			page response.Pagination,
+		) ([]*ent.StockMovement, int, error)
+		Region(code string) ([]*ent.BloodStock, error)
+		Scoped[Stock]
+	}
+
+	// stockKey identifies one inventory row.
+	stockKey struct {
+		locationID  uuid.UUID
+		bloodTypeID uuid.UUID
+		component   bloodstock.Component
+	}
+
+	stockChange struct {
+		kind        stockmovement.Type
+		quantity    int
+		performedBy *uuid.UUID
+		transferID  *uuid.UUID
+		unitID      *uuid.UUID
+		note        string
+	}
+)
+
+func NewStock(i do.Injector) (Stock, error) {
+	return &StockQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		scope:  newScope(),
+	}, nil
+}
+
+func (s *StockQuery) WithContext(ctx context.Context) Stock {
+	return withContext(s, ctx)
+}
+
+func (s *StockQuery) Move(
+	staffID uuid.UUID,
+	body request.StockMovement,
+) ([]*ent.StockMovement, error) {
+	bt, err := findBloodType(s.ctx, s.client, body.BloodType)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := []uuid.UUID{body.PMILocationID}
+	if body.IsTransfer() {
+		locations = append(locations, *body.TargetPMILocationID)
+	}
+
+	count, err := s.client.PMILocation.Query().
+		Where(
+			pmilocation.IDIn(locations...),
+			pmilocation.DeletedAtIsNil(),
+		).
+		Count(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if count != len(locations) {
+		return nil, errors.New("PMI location not found")
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	change := stockChange{
+		kind:        body.GetType(),
+		quantity:    body.Quantity,
+		performedBy: &staffID,
+		note:        body.Note,
+	}
+
+	if body.IsTransfer() {
+		transferID := uuid.New()
+		change.transferID = &transferID
+	}
+
+	source, err := moveStock(s.ctx, tx, stockKey{
+		locationID:  body.PMILocationID,
+		bloodTypeID: bt.ID,
+		component:   body.GetComponent(),
+	}, change)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	movements := []*ent.StockMovement{source}
+	if body.IsTransfer() {
+		change.kind = stockmovement.TypeTransferIn
+		target, err := moveStock(s.ctx, tx, stockKey{
+			locationID:  *body.TargetPMILocationID,
+			bloodTypeID: bt.ID,
+			component:   body.GetComponent(),
+		}, change)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		movements = append(movements, target)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
+func (s *StockQuery) Location(locationID uuid.UUID) ([]*ent.BloodStock, error) {
+	return s.client.BloodStock.Query().
+		Where(
+			bloodstock.HasPmiLocationWith(pmilocation.IDEQ(locationID)),
+			bloodstock.DeletedAtIsNil(),
+		).
+		WithBloodType().
+		All(s.ctx)
+}
+
+func (s *StockQuery) Movements(
+	locationID uuid.UUID,
+	page response.Pagination,
+) ([]*ent.StockMovement, int, error) {
+	q := s.client.StockMovement.Query().
+		Where(stockmovement.HasStockWith(
+			bloodstock.HasPmiLocationWith(pmilocation.IDEQ(locationID)),
+		))
+
+	total, err := q.Clone().Count(s.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := ent.Desc(stockmovement.FieldCreatedAt)
+	if page.GetSort() == "ASC" {
+		order = ent.Asc(stockmovement.FieldCreatedAt)
+	}
+
+	movements, err := q.
+		WithStock(func(q *ent.BloodStockQuery) {
+			q.WithBloodType()
+		}).
+		Order(order).
+		Offset(page.GetOffset()).
+		Limit(page.GetLimit()).
+		All(s.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return movements, total, nil
+}
+
+// Region returns the stock of every PMI location inside the region. Since BPS
+// codes are hierarchical, a province, city or district matches every
+// subdistrict whose code starts with it.
+func (s *StockQuery) Region(code string) ([]*ent.BloodStock, error) {
+	q := s.client.BloodStock.Query().
+		Where(bloodstock.DeletedAtIsNil())
+
+	if code != "" {
+		if region.LevelOf(code) == region.LevelUnknown {
+			return nil, errors.New("region must be a BPS code")
+		}
+
+		q.Where(bloodstock.HasPmiLocationWith(
+			pmilocation.DeletedAtIsNil(),
+			pmilocation.HasSubdistrictWith(
+				subdistrict.BpsCodeHasPrefix(code),
+			),
+		))
+	}
+
+	return q.WithBloodType().All(s.ctx)
+}
+
+func findBloodType(
+	ctx context.Context,
+	client *ent.Client,
+	body request.BloodType,
+) (*ent.BloodType, error) {
+	bt, err := client.BloodType.Query().
+		Where(
+			bloodtype.GroupEQ(body.GetGroup()),
+			bloodtype.RhesusEQ(body.GetRhesus()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("blood type not found")
+		}
+
+		return nil, err
+	}
+
+	return bt, nil
+}
+
+// moveStock applies a movement to the inventory row, creating the row on
+// the first inbound movement. Outbound movements are guarded by the quantity
+// in the same UPDATE, so concurrent movements can never drive stock below
+// zero.
+func moveStock(
+	ctx context.Context,
+	tx *ent.Tx,
+	key stockKey,
+	change stockChange,
+) (*ent.StockMovement, error) {
+	delta := change.quantity
+	if change.kind != stockmovement.TypeCollect &&
+		change.kind != stockmovement.TypeTransferIn {
+		delta = -delta
+	}
+
+	stock, err := tx.BloodStock.Query().
+		Where(
+			bloodstock.HasPmiLocationWith(pmilocation.IDEQ(key.locationID)),
+			bloodstock.HasBloodTypeWith(bloodtype.IDEQ(key.bloodTypeID)),
+			bloodstock.ComponentEQ(key.component),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if stock == nil {
+		if delta < 0 {
+			return nil, errInsufficientStock
+		}
+
+		stock, err = tx.BloodStock.Create().
+			SetPmiLocationID(key.locationID).
+			SetBloodTypeID(key.bloodTypeID).
+			SetComponent(key.component).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	n, err := tx.BloodStock.Update().
+		Where(
+			bloodstock.IDEQ(stock.ID),
+			bloodstock.QuantityGTE(max(-delta, 0)),
+		).
+		AddQuantity(delta).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		return nil, errInsufficientStock
+	}
+
+	balance, err := tx.BloodStock.Query().
+		Where(bloodstock.IDEQ(stock.ID)).
+		Select(bloodstock.FieldQuantity).
+		Int(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	movement, err := tx.StockMovement.Create().
+		SetStock(stock).
+		SetType(change.kind).
+		SetQuantity(change.quantity).
+		SetBalance(balance).
+		SetNillableTransferID(change.transferID).
+		SetNillablePerformedByID(change.performedBy).
+		SetNillableUnitID(change.unitID).
+		SetNote(change.note).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stock.Quantity = balance
+	movement.Edges.Stock = stock
+
+	return movement, nil
+}
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/sembraniteam/setetes/internal/ent"
+	"github.com/sembraniteam/setetes/internal/ent/bloodstock"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunit"
+	"github.com/sembraniteam/setetes/internal/ent/bloodunitevent"
+	"github.com/sembraniteam/setetes/internal/ent/donation"
+	"github.com/sembraniteam/setetes/internal/ent/pmilocation"
+	"github.com/sembraniteam/setetes/internal/ent/predicate"
+	"github.com/sembraniteam/setetes/internal/httpx/request"
+	"github.com/sembraniteam/setetes/internal/lifecycle"
+)
+
+var (
+	errUnitStatusChanged = errors.New(
+		"blood unit status has changed, please try again",
+	)
+	errUnitReserved = errors.New(
+		"blood unit is reserved for a blood request, change it through the request",
+	)
+)
+
+type (
+	BloodUnitQuery struct {
+		scope
+		client *ent.Client
+		log    *slog.Logger
+	}
+
+	BloodUnit interface {
+		Register(
+			staffID uuid.UUID,
+			body request.BloodUnit,
+		) (*ent.BloodUnit, error)
+		Get(id uuid.UUID) (*ent.BloodUnit, error)
+		Barcode(barcode string) (*ent.BloodUnit, error)
+		Transition(
+			staffID uuid.UUID,
+			id uuid.UUID,
+			body request.BloodUnitStatus,
+		) (*ent.BloodUnit, error)
+		Expire(now time.Time) error
+		Scoped[BloodUnit]
+	}
+
+	// unitChange is a transition of a unit. A manual change is made by
+	// staff on the unit itself, so it never touches a unit reserved for a
+	// blood request, which only the request releases or issues.
+	unitChange struct {
+		to          bloodunit.Status
+		performedBy *uuid.UUID
+		note        string
+		manual      bool
+	}
+)
+
+func NewBloodUnit(i do.Injector) (BloodUnit, error) {
+	return &BloodUnitQuery{
+		client: do.MustInvoke[*ent.Client](i),
+		scope:  newScope(),
+		log:    slog.Default(),
+	}, nil
+}
+
+func (b *BloodUnitQuery) WithContext(ctx context.Context) BloodUnit {
+	return withContext(b, ctx)
+}
+
+func (b *BloodUnitQuery) Register(
+	staffID uuid.UUID,
+	body request.BloodUnit,
+) (*ent.BloodUnit, error) {
+	bt, err := findBloodType(b.ctx, b.client, body.BloodType)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := b.client.PMILocation.Query().
+		Where(
+			pmilocation.IDEQ(body.PMILocationID),
+			pmilocation.DeletedAtIsNil(),
+		).
+		Only(b.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("PMI location not found")
+		}
+
+		return nil, err
+	}
+
+	expiresAt := body.ExpiresAt
+	if expiresAt == 0 {
+		shelfLife := lifecycle.ShelfLife(body.GetComponent())
+		expiresAt = time.UnixMilli(body.CollectedAt).Add(shelfLife).UnixMilli()
+	}
+
+	if expiresAt <= time.Now().UnixMilli() {
+		return nil, errors.New("blood unit is already past its shelf life")
+	}
+
+	tx, err := b.client.Tx(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := tx.BloodUnit.Create().
+		SetBarcode(body.Barcode).
+		SetComponent(body.GetComponent()).
+		SetCollectedAt(body.CollectedAt).
+		SetExpiresAt(expiresAt).
+		SetPmiLocation(loc).
+		SetBloodType(bt)
+
+	if body.DonationID != nil {
+		exists, err := tx.Donation.Query().
+			Where(donation.IDEQ(*body.DonationID)).
+			Exist(b.ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		if !exists {
+			return nil, rollback(tx, errors.New("donation not found"))
+		}
+
+		create.SetDonationID(*body.DonationID)
+	}
+
+	unit, err := create.Save(b.ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = tx.BloodUnitEvent.Create().
+		SetUnit(unit).
+		SetToStatus(unit.Status.String()).
+		SetPerformedByID(staffID).
+		Exec(b.ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	unit.Edges.BloodType = bt
+	unit.Edges.PmiLocation = loc
+
+	return unit, nil
+}
+
+func (b *BloodUnitQuery) Get(id uuid.UUID) (*ent.BloodUnit, error) {
+	return b.find(bloodunit.IDEQ(id))
+}
+
+func (b *BloodUnitQuery) Barcode(barcode string) (*ent.BloodUnit, error) {
+	return b.find(bloodunit.BarcodeEQ(barcode))
+}
+
+func (b *BloodUnitQuery) Transition(
+	staffID uuid.UUID,
+	id uuid.UUID,
+	body request.BloodUnitStatus,
+) (*ent.BloodUnit, error) {
+	unit, err := b.find(bloodunit.IDEQ(id))
+	if err != nil {
+		return nil, err
+	}
+
+	if unit.Status == bloodunit.StatusReserved {
+		reserved, err := b.client.BloodUnit.Query().
+			Where(bloodunit.IDEQ(id), bloodunit.HasRequest()).
+			Exist(b.ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if reserved {
+			return nil, errUnitReserved
+		}
+	}
+
+	tx, err := b.client.Tx(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = transitionUnit(b.ctx, tx, unit, unitChange{
+		to:          body.GetStatus(),
+		performedBy: &staffID,
+		note:        body.Note,
+		manual:      true,
+	}); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return b.find(bloodunit.IDEQ(id))
+}
+
+// Expire moves every unit past its shelf life to EXPIRED. Each unit is
+// expired in its own transaction so one failure does not hold back the rest.
+func (b *BloodUnitQuery) Expire(now time.Time) error {
+	units, err := b.client.BloodUnit.Query().
+		Where(
+			bloodunit.StatusIn(lifecycle.Active()...),
+			bloodunit.ExpiresAtLTE(now.UnixMilli()),
+			bloodunit.DeletedAtIsNil(),
+		).
+		WithPmiLocation().
+		WithBloodType().
+		All(b.ctx)
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, 0)
+	expired := 0
+	for _, unit := range units {
+		tx, err := b.client.Tx(b.ctx)
+		if err != nil {
+			return err
+		}
+
+		err = transitionUnit(b.ctx, tx, unit, unitChange{
+			to:   bloodunit.StatusExpired,
+			note: "shelf life ended",
+		})
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = rollback(tx, err)
+		}
+
+		switch {
+		case err == nil:
+			expired++
+		case !errors.Is(err, errUnitStatusChanged):
+			errs = append(errs, err)
+		}
+	}
+
+	if expired > 0 {
+		b.log.Info("blood units expired", slog.Int("count", expired))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (b *BloodUnitQuery) find(
+	where ...predicate.BloodUnit,
+) (*ent.BloodUnit, error) {
+	unit, err := b.client.BloodUnit.Query().
+		Where(append(where, bloodunit.DeletedAtIsNil())...).
+		WithPmiLocation().
+		WithBloodType().
+		WithEvents(func(q *ent.BloodUnitEventQuery) {
+			q.Order(ent.Asc(bloodunitevent.FieldCreatedAt))
+		}).
+		Only(b.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("blood unit not found")
+		}
+
+		return nil, err
+	}
+
+	return unit, nil
+}
+
+// transitionUnit applies a lifecycle transition to a unit loaded with its PMI
+// location and blood type, and records the stock movement it causes. The
+// status is compared in the UPDATE, so two concurrent transitions of the
+// same unit cannot both succeed.
+func transitionUnit(
+	ctx context.Context,
+	tx *ent.Tx,
+	unit *ent.BloodUnit,
+	change unitChange,
+) error {
+	from := unit.Status
+	if err := lifecycle.Transition(from, change.to); err != nil {
+		return err
+	}
+
+	update := tx.BloodUnit.Update().
+		Where(bloodunit.IDEQ(unit.ID), bloodunit.StatusEQ(from)).
+		SetStatus(change.to)
+
+	// The unit may have been released and reserved for a request since it
+	// was loaded.
+	if change.manual && from == bloodunit.StatusReserved {
+		update.Where(bloodunit.Not(bloodunit.HasRequest()))
+	}
+
+	// A reservation released back to the shelf no longer belongs to the
+	// blood request it was reserved for.
+	if from == bloodunit.StatusReserved &&
+		change.to == bloodunit.StatusAvailable {
+		update.ClearRequest()
+	}
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return errUnitStatusChanged
+	}
+
+	if kind, ok := lifecycle.Movement(from, change.to); ok {
+		if _, err = moveStock(ctx, tx, stockKey{
+			locationID:  unit.Edges.PmiLocation.ID,
+			bloodTypeID: unit.Edges.BloodType.ID,
+			component:   bloodstock.Component(unit.Component),
+		}, stockChange{
+			kind:        kind,
+			quantity:    1,
+			performedBy: change.performedBy,
+			unitID:      &unit.ID,
+			note:        change.note,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.BloodUnitEvent.Create().
+		SetUnitID(unit.ID).
+		SetFromStatus(from.String()).
+		SetToStatus(change.to.String()).
+		SetNillablePerformedByID(change.performedBy).
+		SetNote(change.note).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	unit.Status = change.to
+
+	return nil
+}